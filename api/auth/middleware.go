@@ -2,7 +2,9 @@ package auth
 
 import (
 	"net/http"
+	"strings"
 
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
 )
 
@@ -14,3 +16,40 @@ func Middleware(fixedUserId entity.Id) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// TokenMiddleware authenticate the requests carrying the API token of a bot,
+// as in "Authorization: Bearer <token>", as that bot. The requests without
+// token are left untouched, and the ones with an invalid token are refused.
+func TokenMiddleware(mrc *cache.MultiRepoCache) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bot, err := mrc.ResolveBotToken(token)
+			if err == cache.ErrInvalidBotToken {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			ctx := CtxWithUser(r.Context(), bot.Id())
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	token := strings.TrimPrefix(header, "Bearer ")
+	if token == header || token == "" {
+		return "", false
+	}
+	return token, true
+}
@@ -0,0 +1,41 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// Error codes exposed in the "code" extension of the errors, so that clients
+// can branch on the kind of error.
+const (
+	ErrCodeNotFound  = "NOT_FOUND"
+	ErrCodeAmbiguous = "AMBIGUOUS"
+)
+
+// errorPresenter add a machine-readable code to the errors of a known kind.
+func errorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	var code string
+	switch {
+	case entity.IsErrNotFound(err), errors.Is(err, cache.ErrUnknownRepo):
+		code = ErrCodeNotFound
+	case entity.IsErrMultipleMatch(err):
+		code = ErrCodeAmbiguous
+	default:
+		return gqlErr
+	}
+
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = make(map[string]interface{})
+	}
+	gqlErr.Extensions["code"] = code
+
+	return gqlErr
+}
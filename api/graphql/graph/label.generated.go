@@ -22,6 +22,7 @@ import (
 type LabelResolver interface {
 	Name(ctx context.Context, obj *bug.Label) (string, error)
 	Color(ctx context.Context, obj *bug.Label) (*color.RGBA, error)
+	Description(ctx context.Context, obj *bug.Label) (*string, error)
 }
 
 // endregion ************************** generated!.gotpl **************************
@@ -132,6 +133,47 @@ func (ec *executionContext) fieldContext_Label_color(ctx context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _Label_description(ctx context.Context, field graphql.CollectedField, obj *bug.Label) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Label_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Label().Description(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Label_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Label",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _LabelConnection_edges(ctx context.Context, field graphql.CollectedField, obj *models.LabelConnection) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_LabelConnection_edges(ctx, field)
 	if err != nil {
@@ -225,6 +267,8 @@ func (ec *executionContext) fieldContext_LabelConnection_nodes(ctx context.Conte
 				return ec.fieldContext_Label_name(ctx, field)
 			case "color":
 				return ec.fieldContext_Label_color(ctx, field)
+			case "description":
+				return ec.fieldContext_Label_description(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Label", field.Name)
 		},
@@ -417,6 +461,8 @@ func (ec *executionContext) fieldContext_LabelEdge_node(ctx context.Context, fie
 				return ec.fieldContext_Label_name(ctx, field)
 			case "color":
 				return ec.fieldContext_Label_color(ctx, field)
+			case "description":
+				return ec.fieldContext_Label_description(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Label", field.Name)
 		},
@@ -482,6 +528,23 @@ func (ec *executionContext) _Label(ctx context.Context, sel ast.SelectionSet, ob
 				return res
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "description":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Label_description(ctx, field, obj)
+				return res
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return innerFunc(ctx)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/MichaelMure/git-bug/api/graphql/models"
 	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity/dag"
 	"github.com/vektah/gqlparser/v2/ast"
 )
 
@@ -29,6 +30,284 @@ import (
 
 // region    **************************** field.gotpl *****************************
 
+func (ec *executionContext) _AckChangeFeedPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.AckChangeFeedPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AckChangeFeedPayload_clientMutationId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClientMutationID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AckChangeFeedPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AckChangeFeedPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AckChangeFeedPayload_cursor(ctx context.Context, field graphql.CollectedField, obj *models.AckChangeFeedPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AckChangeFeedPayload_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AckChangeFeedPayload_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AckChangeFeedPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AddAttachmentPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.AddAttachmentPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AddAttachmentPayload_clientMutationId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClientMutationID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AddAttachmentPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AddAttachmentPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AddAttachmentPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.AddAttachmentPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AddAttachmentPayload_bug(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bug, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.BugWrapper)
+	fc.Result = res
+	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AddAttachmentPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AddAttachmentPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Bug_id(ctx, field)
+			case "humanId":
+				return ec.fieldContext_Bug_humanId(ctx, field)
+			case "status":
+				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
+			case "title":
+				return ec.fieldContext_Bug_title(ctx, field)
+			case "labels":
+				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
+			case "author":
+				return ec.fieldContext_Bug_author(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Bug_createdAt(ctx, field)
+			case "lastEdit":
+				return ec.fieldContext_Bug_lastEdit(ctx, field)
+			case "actors":
+				return ec.fieldContext_Bug_actors(ctx, field)
+			case "participants":
+				return ec.fieldContext_Bug_participants(ctx, field)
+			case "comments":
+				return ec.fieldContext_Bug_comments(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Bug_timeline(ctx, field)
+			case "operations":
+				return ec.fieldContext_Bug_operations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Bug", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AddAttachmentPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.AddAttachmentPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AddAttachmentPayload_operation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Operation, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*bug.AddAttachmentOperation)
+	fc.Result = res
+	return ec.marshalNAddAttachmentOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐAddAttachmentOperation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AddAttachmentPayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AddAttachmentPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AddAttachmentOperation_id(ctx, field)
+			case "author":
+				return ec.fieldContext_AddAttachmentOperation_author(ctx, field)
+			case "date":
+				return ec.fieldContext_AddAttachmentOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_AddAttachmentOperation_signed(ctx, field)
+			case "name":
+				return ec.fieldContext_AddAttachmentOperation_name(ctx, field)
+			case "hash":
+				return ec.fieldContext_AddAttachmentOperation_hash(ctx, field)
+			case "size":
+				return ec.fieldContext_AddAttachmentOperation_size(ctx, field)
+			case "contentType":
+				return ec.fieldContext_AddAttachmentOperation_contentType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AddAttachmentOperation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _AddCommentAndCloseBugPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.AddCommentAndCloseBugPayload) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_AddCommentAndCloseBugPayload_clientMutationId(ctx, field)
 	if err != nil {
@@ -115,10 +394,28 @@ func (ec *executionContext) fieldContext_AddCommentAndCloseBugPayload_bug(ctx co
 				return ec.fieldContext_Bug_humanId(ctx, field)
 			case "status":
 				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
 			case "title":
 				return ec.fieldContext_Bug_title(ctx, field)
 			case "labels":
 				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
 			case "author":
 				return ec.fieldContext_Bug_author(ctx, field)
 			case "createdAt":
@@ -187,6 +484,8 @@ func (ec *executionContext) fieldContext_AddCommentAndCloseBugPayload_commentOpe
 				return ec.fieldContext_AddCommentOperation_author(ctx, field)
 			case "date":
 				return ec.fieldContext_AddCommentOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_AddCommentOperation_signed(ctx, field)
 			case "message":
 				return ec.fieldContext_AddCommentOperation_message(ctx, field)
 			case "files":
@@ -243,8 +542,12 @@ func (ec *executionContext) fieldContext_AddCommentAndCloseBugPayload_statusOper
 				return ec.fieldContext_SetStatusOperation_author(ctx, field)
 			case "date":
 				return ec.fieldContext_SetStatusOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_SetStatusOperation_signed(ctx, field)
 			case "status":
 				return ec.fieldContext_SetStatusOperation_status(ctx, field)
+			case "state":
+				return ec.fieldContext_SetStatusOperation_state(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type SetStatusOperation", field.Name)
 		},
@@ -338,10 +641,28 @@ func (ec *executionContext) fieldContext_AddCommentAndReopenBugPayload_bug(ctx c
 				return ec.fieldContext_Bug_humanId(ctx, field)
 			case "status":
 				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
 			case "title":
 				return ec.fieldContext_Bug_title(ctx, field)
 			case "labels":
 				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
 			case "author":
 				return ec.fieldContext_Bug_author(ctx, field)
 			case "createdAt":
@@ -410,6 +731,8 @@ func (ec *executionContext) fieldContext_AddCommentAndReopenBugPayload_commentOp
 				return ec.fieldContext_AddCommentOperation_author(ctx, field)
 			case "date":
 				return ec.fieldContext_AddCommentOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_AddCommentOperation_signed(ctx, field)
 			case "message":
 				return ec.fieldContext_AddCommentOperation_message(ctx, field)
 			case "files":
@@ -466,8 +789,12 @@ func (ec *executionContext) fieldContext_AddCommentAndReopenBugPayload_statusOpe
 				return ec.fieldContext_SetStatusOperation_author(ctx, field)
 			case "date":
 				return ec.fieldContext_SetStatusOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_SetStatusOperation_signed(ctx, field)
 			case "status":
 				return ec.fieldContext_SetStatusOperation_status(ctx, field)
+			case "state":
+				return ec.fieldContext_SetStatusOperation_state(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type SetStatusOperation", field.Name)
 		},
@@ -561,10 +888,28 @@ func (ec *executionContext) fieldContext_AddCommentPayload_bug(ctx context.Conte
 				return ec.fieldContext_Bug_humanId(ctx, field)
 			case "status":
 				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
 			case "title":
 				return ec.fieldContext_Bug_title(ctx, field)
 			case "labels":
 				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
 			case "author":
 				return ec.fieldContext_Bug_author(ctx, field)
 			case "createdAt":
@@ -633,6 +978,8 @@ func (ec *executionContext) fieldContext_AddCommentPayload_operation(ctx context
 				return ec.fieldContext_AddCommentOperation_author(ctx, field)
 			case "date":
 				return ec.fieldContext_AddCommentOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_AddCommentOperation_signed(ctx, field)
 			case "message":
 				return ec.fieldContext_AddCommentOperation_message(ctx, field)
 			case "files":
@@ -644,8 +991,8 @@ func (ec *executionContext) fieldContext_AddCommentPayload_operation(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _ChangeLabelPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.ChangeLabelPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ChangeLabelPayload_clientMutationId(ctx, field)
+func (ec *executionContext) _ChangeAssigneePayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.ChangeAssigneePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChangeAssigneePayload_clientMutationId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -672,9 +1019,9 @@ func (ec *executionContext) _ChangeLabelPayload_clientMutationId(ctx context.Con
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ChangeLabelPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChangeAssigneePayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ChangeLabelPayload",
+		Object:     "ChangeAssigneePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -685,8 +1032,8 @@ func (ec *executionContext) fieldContext_ChangeLabelPayload_clientMutationId(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _ChangeLabelPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.ChangeLabelPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ChangeLabelPayload_bug(ctx, field)
+func (ec *executionContext) _ChangeAssigneePayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.ChangeAssigneePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChangeAssigneePayload_bug(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -716,9 +1063,9 @@ func (ec *executionContext) _ChangeLabelPayload_bug(ctx context.Context, field g
 	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ChangeLabelPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChangeAssigneePayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ChangeLabelPayload",
+		Object:     "ChangeAssigneePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -730,10 +1077,28 @@ func (ec *executionContext) fieldContext_ChangeLabelPayload_bug(ctx context.Cont
 				return ec.fieldContext_Bug_humanId(ctx, field)
 			case "status":
 				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
 			case "title":
 				return ec.fieldContext_Bug_title(ctx, field)
 			case "labels":
 				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
 			case "author":
 				return ec.fieldContext_Bug_author(ctx, field)
 			case "createdAt":
@@ -757,8 +1122,8 @@ func (ec *executionContext) fieldContext_ChangeLabelPayload_bug(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _ChangeLabelPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.ChangeLabelPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ChangeLabelPayload_operation(ctx, field)
+func (ec *executionContext) _ChangeAssigneePayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.ChangeAssigneePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChangeAssigneePayload_operation(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -783,38 +1148,40 @@ func (ec *executionContext) _ChangeLabelPayload_operation(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*bug.LabelChangeOperation)
+	res := resTmp.(*bug.SetAssigneeOperation)
 	fc.Result = res
-	return ec.marshalNLabelChangeOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐLabelChangeOperation(ctx, field.Selections, res)
+	return ec.marshalNSetAssigneeOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐSetAssigneeOperation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ChangeLabelPayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChangeAssigneePayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ChangeLabelPayload",
+		Object:     "ChangeAssigneePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_LabelChangeOperation_id(ctx, field)
+				return ec.fieldContext_SetAssigneeOperation_id(ctx, field)
 			case "author":
-				return ec.fieldContext_LabelChangeOperation_author(ctx, field)
+				return ec.fieldContext_SetAssigneeOperation_author(ctx, field)
 			case "date":
-				return ec.fieldContext_LabelChangeOperation_date(ctx, field)
+				return ec.fieldContext_SetAssigneeOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_SetAssigneeOperation_signed(ctx, field)
 			case "added":
-				return ec.fieldContext_LabelChangeOperation_added(ctx, field)
+				return ec.fieldContext_SetAssigneeOperation_added(ctx, field)
 			case "removed":
-				return ec.fieldContext_LabelChangeOperation_removed(ctx, field)
+				return ec.fieldContext_SetAssigneeOperation_removed(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LabelChangeOperation", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SetAssigneeOperation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ChangeLabelPayload_results(ctx context.Context, field graphql.CollectedField, obj *models.ChangeLabelPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ChangeLabelPayload_results(ctx, field)
+func (ec *executionContext) _ChangeLabelPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.ChangeLabelPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChangeLabelPayload_clientMutationId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -827,24 +1194,213 @@ func (ec *executionContext) _ChangeLabelPayload_results(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Results, nil
+		return obj.ClientMutationID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*bug.LabelChangeResult)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNLabelChangeResult2ᚕᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐLabelChangeResult(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ChangeLabelPayload_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChangeLabelPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChangeLabelPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChangeLabelPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.ChangeLabelPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChangeLabelPayload_bug(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bug, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.BugWrapper)
+	fc.Result = res
+	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ChangeLabelPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChangeLabelPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Bug_id(ctx, field)
+			case "humanId":
+				return ec.fieldContext_Bug_humanId(ctx, field)
+			case "status":
+				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
+			case "title":
+				return ec.fieldContext_Bug_title(ctx, field)
+			case "labels":
+				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
+			case "author":
+				return ec.fieldContext_Bug_author(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Bug_createdAt(ctx, field)
+			case "lastEdit":
+				return ec.fieldContext_Bug_lastEdit(ctx, field)
+			case "actors":
+				return ec.fieldContext_Bug_actors(ctx, field)
+			case "participants":
+				return ec.fieldContext_Bug_participants(ctx, field)
+			case "comments":
+				return ec.fieldContext_Bug_comments(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Bug_timeline(ctx, field)
+			case "operations":
+				return ec.fieldContext_Bug_operations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Bug", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChangeLabelPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.ChangeLabelPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChangeLabelPayload_operation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Operation, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*bug.LabelChangeOperation)
+	fc.Result = res
+	return ec.marshalNLabelChangeOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐLabelChangeOperation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ChangeLabelPayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChangeLabelPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LabelChangeOperation_id(ctx, field)
+			case "author":
+				return ec.fieldContext_LabelChangeOperation_author(ctx, field)
+			case "date":
+				return ec.fieldContext_LabelChangeOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_LabelChangeOperation_signed(ctx, field)
+			case "added":
+				return ec.fieldContext_LabelChangeOperation_added(ctx, field)
+			case "removed":
+				return ec.fieldContext_LabelChangeOperation_removed(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LabelChangeOperation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChangeLabelPayload_results(ctx context.Context, field graphql.CollectedField, obj *models.ChangeLabelPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChangeLabelPayload_results(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Results, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*bug.LabelChangeResult)
+	fc.Result = res
+	return ec.marshalNLabelChangeResult2ᚕᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐLabelChangeResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ChangeLabelPayload_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "ChangeLabelPayload",
 		Field:      field,
@@ -949,10 +1505,28 @@ func (ec *executionContext) fieldContext_CloseBugPayload_bug(ctx context.Context
 				return ec.fieldContext_Bug_humanId(ctx, field)
 			case "status":
 				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
 			case "title":
 				return ec.fieldContext_Bug_title(ctx, field)
 			case "labels":
 				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
 			case "author":
 				return ec.fieldContext_Bug_author(ctx, field)
 			case "createdAt":
@@ -1021,8 +1595,12 @@ func (ec *executionContext) fieldContext_CloseBugPayload_operation(ctx context.C
 				return ec.fieldContext_SetStatusOperation_author(ctx, field)
 			case "date":
 				return ec.fieldContext_SetStatusOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_SetStatusOperation_signed(ctx, field)
 			case "status":
 				return ec.fieldContext_SetStatusOperation_status(ctx, field)
+			case "state":
+				return ec.fieldContext_SetStatusOperation_state(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type SetStatusOperation", field.Name)
 		},
@@ -1116,10 +1694,28 @@ func (ec *executionContext) fieldContext_EditCommentPayload_bug(ctx context.Cont
 				return ec.fieldContext_Bug_humanId(ctx, field)
 			case "status":
 				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
 			case "title":
 				return ec.fieldContext_Bug_title(ctx, field)
 			case "labels":
 				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
 			case "author":
 				return ec.fieldContext_Bug_author(ctx, field)
 			case "createdAt":
@@ -1188,6 +1784,8 @@ func (ec *executionContext) fieldContext_EditCommentPayload_operation(ctx contex
 				return ec.fieldContext_EditCommentOperation_author(ctx, field)
 			case "date":
 				return ec.fieldContext_EditCommentOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_EditCommentOperation_signed(ctx, field)
 			case "target":
 				return ec.fieldContext_EditCommentOperation_target(ctx, field)
 			case "message":
@@ -1244,6 +1842,8 @@ func (ec *executionContext) fieldContext_LabelChangeResult_label(ctx context.Con
 				return ec.fieldContext_Label_name(ctx, field)
 			case "color":
 				return ec.fieldContext_Label_color(ctx, field)
+			case "description":
+				return ec.fieldContext_Label_description(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Label", field.Name)
 		},
@@ -1295,8 +1895,8 @@ func (ec *executionContext) fieldContext_LabelChangeResult_status(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _NewBugPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.NewBugPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NewBugPayload_clientMutationId(ctx, field)
+func (ec *executionContext) _MarkBugAsReadPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.MarkBugAsReadPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MarkBugAsReadPayload_clientMutationId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1323,9 +1923,9 @@ func (ec *executionContext) _NewBugPayload_clientMutationId(ctx context.Context,
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NewBugPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MarkBugAsReadPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NewBugPayload",
+		Object:     "MarkBugAsReadPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1336,8 +1936,8 @@ func (ec *executionContext) fieldContext_NewBugPayload_clientMutationId(ctx cont
 	return fc, nil
 }
 
-func (ec *executionContext) _NewBugPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.NewBugPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NewBugPayload_bug(ctx, field)
+func (ec *executionContext) _MarkBugAsReadPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.MarkBugAsReadPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MarkBugAsReadPayload_bug(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1367,9 +1967,9 @@ func (ec *executionContext) _NewBugPayload_bug(ctx context.Context, field graphq
 	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NewBugPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MarkBugAsReadPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NewBugPayload",
+		Object:     "MarkBugAsReadPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1381,10 +1981,28 @@ func (ec *executionContext) fieldContext_NewBugPayload_bug(ctx context.Context,
 				return ec.fieldContext_Bug_humanId(ctx, field)
 			case "status":
 				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
 			case "title":
 				return ec.fieldContext_Bug_title(ctx, field)
 			case "labels":
 				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
 			case "author":
 				return ec.fieldContext_Bug_author(ctx, field)
 			case "createdAt":
@@ -1408,66 +2026,8 @@ func (ec *executionContext) fieldContext_NewBugPayload_bug(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _NewBugPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.NewBugPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NewBugPayload_operation(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Operation, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(*bug.CreateOperation)
-	fc.Result = res
-	return ec.marshalNCreateOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐCreateOperation(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_NewBugPayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "NewBugPayload",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_CreateOperation_id(ctx, field)
-			case "author":
-				return ec.fieldContext_CreateOperation_author(ctx, field)
-			case "date":
-				return ec.fieldContext_CreateOperation_date(ctx, field)
-			case "title":
-				return ec.fieldContext_CreateOperation_title(ctx, field)
-			case "message":
-				return ec.fieldContext_CreateOperation_message(ctx, field)
-			case "files":
-				return ec.fieldContext_CreateOperation_files(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CreateOperation", field.Name)
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _OpenBugPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.OpenBugPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OpenBugPayload_clientMutationId(ctx, field)
+func (ec *executionContext) _NewBugPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.NewBugPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NewBugPayload_clientMutationId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1494,9 +2054,9 @@ func (ec *executionContext) _OpenBugPayload_clientMutationId(ctx context.Context
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OpenBugPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NewBugPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OpenBugPayload",
+		Object:     "NewBugPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1507,8 +2067,8 @@ func (ec *executionContext) fieldContext_OpenBugPayload_clientMutationId(ctx con
 	return fc, nil
 }
 
-func (ec *executionContext) _OpenBugPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.OpenBugPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OpenBugPayload_bug(ctx, field)
+func (ec *executionContext) _NewBugPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.NewBugPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NewBugPayload_bug(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1538,9 +2098,9 @@ func (ec *executionContext) _OpenBugPayload_bug(ctx context.Context, field graph
 	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OpenBugPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NewBugPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OpenBugPayload",
+		Object:     "NewBugPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1552,10 +2112,28 @@ func (ec *executionContext) fieldContext_OpenBugPayload_bug(ctx context.Context,
 				return ec.fieldContext_Bug_humanId(ctx, field)
 			case "status":
 				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
 			case "title":
 				return ec.fieldContext_Bug_title(ctx, field)
 			case "labels":
 				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
 			case "author":
 				return ec.fieldContext_Bug_author(ctx, field)
 			case "createdAt":
@@ -1579,8 +2157,199 @@ func (ec *executionContext) fieldContext_OpenBugPayload_bug(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _OpenBugPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.OpenBugPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OpenBugPayload_operation(ctx, field)
+func (ec *executionContext) _NewBugPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.NewBugPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NewBugPayload_operation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Operation, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*bug.CreateOperation)
+	fc.Result = res
+	return ec.marshalNCreateOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐCreateOperation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_NewBugPayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NewBugPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CreateOperation_id(ctx, field)
+			case "author":
+				return ec.fieldContext_CreateOperation_author(ctx, field)
+			case "date":
+				return ec.fieldContext_CreateOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_CreateOperation_signed(ctx, field)
+			case "title":
+				return ec.fieldContext_CreateOperation_title(ctx, field)
+			case "message":
+				return ec.fieldContext_CreateOperation_message(ctx, field)
+			case "files":
+				return ec.fieldContext_CreateOperation_files(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CreateOperation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OpenBugPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.OpenBugPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OpenBugPayload_clientMutationId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClientMutationID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OpenBugPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OpenBugPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OpenBugPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.OpenBugPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OpenBugPayload_bug(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bug, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.BugWrapper)
+	fc.Result = res
+	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OpenBugPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OpenBugPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Bug_id(ctx, field)
+			case "humanId":
+				return ec.fieldContext_Bug_humanId(ctx, field)
+			case "status":
+				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
+			case "title":
+				return ec.fieldContext_Bug_title(ctx, field)
+			case "labels":
+				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
+			case "author":
+				return ec.fieldContext_Bug_author(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Bug_createdAt(ctx, field)
+			case "lastEdit":
+				return ec.fieldContext_Bug_lastEdit(ctx, field)
+			case "actors":
+				return ec.fieldContext_Bug_actors(ctx, field)
+			case "participants":
+				return ec.fieldContext_Bug_participants(ctx, field)
+			case "comments":
+				return ec.fieldContext_Bug_comments(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Bug_timeline(ctx, field)
+			case "operations":
+				return ec.fieldContext_Bug_operations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Bug", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OpenBugPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.OpenBugPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OpenBugPayload_operation(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1624,8 +2393,12 @@ func (ec *executionContext) fieldContext_OpenBugPayload_operation(ctx context.Co
 				return ec.fieldContext_SetStatusOperation_author(ctx, field)
 			case "date":
 				return ec.fieldContext_SetStatusOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_SetStatusOperation_signed(ctx, field)
 			case "status":
 				return ec.fieldContext_SetStatusOperation_status(ctx, field)
+			case "state":
+				return ec.fieldContext_SetStatusOperation_state(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type SetStatusOperation", field.Name)
 		},
@@ -1633,8 +2406,8 @@ func (ec *executionContext) fieldContext_OpenBugPayload_operation(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _SetTitlePayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.SetTitlePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetTitlePayload_clientMutationId(ctx, field)
+func (ec *executionContext) _ReactionPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.ReactionPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReactionPayload_clientMutationId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1661,9 +2434,9 @@ func (ec *executionContext) _SetTitlePayload_clientMutationId(ctx context.Contex
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetTitlePayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ReactionPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetTitlePayload",
+		Object:     "ReactionPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1674,8 +2447,8 @@ func (ec *executionContext) fieldContext_SetTitlePayload_clientMutationId(ctx co
 	return fc, nil
 }
 
-func (ec *executionContext) _SetTitlePayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.SetTitlePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetTitlePayload_bug(ctx, field)
+func (ec *executionContext) _ReactionPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.ReactionPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReactionPayload_bug(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1705,9 +2478,9 @@ func (ec *executionContext) _SetTitlePayload_bug(ctx context.Context, field grap
 	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetTitlePayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ReactionPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetTitlePayload",
+		Object:     "ReactionPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1719,10 +2492,28 @@ func (ec *executionContext) fieldContext_SetTitlePayload_bug(ctx context.Context
 				return ec.fieldContext_Bug_humanId(ctx, field)
 			case "status":
 				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
 			case "title":
 				return ec.fieldContext_Bug_title(ctx, field)
 			case "labels":
 				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
 			case "author":
 				return ec.fieldContext_Bug_author(ctx, field)
 			case "createdAt":
@@ -1746,8 +2537,8 @@ func (ec *executionContext) fieldContext_SetTitlePayload_bug(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _SetTitlePayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.SetTitlePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetTitlePayload_operation(ctx, field)
+func (ec *executionContext) _ReactionPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.ReactionPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReactionPayload_operation(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1772,91 +2563,1698 @@ func (ec *executionContext) _SetTitlePayload_operation(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*bug.SetTitleOperation)
+	res := resTmp.(*bug.ReactionOperation)
 	fc.Result = res
-	return ec.marshalNSetTitleOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐSetTitleOperation(ctx, field.Selections, res)
+	return ec.marshalNReactionOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐReactionOperation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetTitlePayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ReactionPayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetTitlePayload",
+		Object:     "ReactionPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_SetTitleOperation_id(ctx, field)
+				return ec.fieldContext_ReactionOperation_id(ctx, field)
 			case "author":
-				return ec.fieldContext_SetTitleOperation_author(ctx, field)
+				return ec.fieldContext_ReactionOperation_author(ctx, field)
 			case "date":
-				return ec.fieldContext_SetTitleOperation_date(ctx, field)
-			case "title":
-				return ec.fieldContext_SetTitleOperation_title(ctx, field)
-			case "was":
-				return ec.fieldContext_SetTitleOperation_was(ctx, field)
+				return ec.fieldContext_ReactionOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_ReactionOperation_signed(ctx, field)
+			case "target":
+				return ec.fieldContext_ReactionOperation_target(ctx, field)
+			case "reaction":
+				return ec.fieldContext_ReactionOperation_reaction(ctx, field)
+			case "remove":
+				return ec.fieldContext_ReactionOperation_remove(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SetTitleOperation", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ReactionOperation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputAddCommentAndCloseBugInput(ctx context.Context, obj interface{}) (models.AddCommentAndCloseBugInput, error) {
-	var it models.AddCommentAndCloseBugInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+func (ec *executionContext) _RedactCommentPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.RedactCommentPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RedactCommentPayload_clientMutationId(ctx, field)
+	if err != nil {
+		return graphql.Null
 	}
-
-	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "message", "files"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		switch k {
-		case "clientMutationId":
-			var err error
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClientMutationID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
-			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "repoRef":
-			var err error
+func (ec *executionContext) fieldContext_RedactCommentPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RedactCommentPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
-			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+func (ec *executionContext) _RedactCommentPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.RedactCommentPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RedactCommentPayload_bug(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bug, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.BugWrapper)
+	fc.Result = res
+	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RedactCommentPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RedactCommentPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Bug_id(ctx, field)
+			case "humanId":
+				return ec.fieldContext_Bug_humanId(ctx, field)
+			case "status":
+				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
+			case "title":
+				return ec.fieldContext_Bug_title(ctx, field)
+			case "labels":
+				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
+			case "author":
+				return ec.fieldContext_Bug_author(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Bug_createdAt(ctx, field)
+			case "lastEdit":
+				return ec.fieldContext_Bug_lastEdit(ctx, field)
+			case "actors":
+				return ec.fieldContext_Bug_actors(ctx, field)
+			case "participants":
+				return ec.fieldContext_Bug_participants(ctx, field)
+			case "comments":
+				return ec.fieldContext_Bug_comments(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Bug_timeline(ctx, field)
+			case "operations":
+				return ec.fieldContext_Bug_operations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Bug", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RedactCommentPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.RedactCommentPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RedactCommentPayload_operation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Operation, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*bug.RedactCommentOperation)
+	fc.Result = res
+	return ec.marshalNRedactCommentOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐRedactCommentOperation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RedactCommentPayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RedactCommentPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RedactCommentOperation_id(ctx, field)
+			case "author":
+				return ec.fieldContext_RedactCommentOperation_author(ctx, field)
+			case "date":
+				return ec.fieldContext_RedactCommentOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_RedactCommentOperation_signed(ctx, field)
+			case "target":
+				return ec.fieldContext_RedactCommentOperation_target(ctx, field)
+			case "delete":
+				return ec.fieldContext_RedactCommentOperation_delete(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RedactCommentOperation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelationPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.RelationPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationPayload_clientMutationId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClientMutationID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelationPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelationPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelationPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.RelationPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationPayload_bug(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bug, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.BugWrapper)
+	fc.Result = res
+	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelationPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelationPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Bug_id(ctx, field)
+			case "humanId":
+				return ec.fieldContext_Bug_humanId(ctx, field)
+			case "status":
+				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
+			case "title":
+				return ec.fieldContext_Bug_title(ctx, field)
+			case "labels":
+				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
+			case "author":
+				return ec.fieldContext_Bug_author(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Bug_createdAt(ctx, field)
+			case "lastEdit":
+				return ec.fieldContext_Bug_lastEdit(ctx, field)
+			case "actors":
+				return ec.fieldContext_Bug_actors(ctx, field)
+			case "participants":
+				return ec.fieldContext_Bug_participants(ctx, field)
+			case "comments":
+				return ec.fieldContext_Bug_comments(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Bug_timeline(ctx, field)
+			case "operations":
+				return ec.fieldContext_Bug_operations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Bug", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelationPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.RelationPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationPayload_operation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Operation, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dag.Operation)
+	fc.Result = res
+	return ec.marshalNOperation2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋentityᚋdagᚐOperation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelationPayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelationPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("FieldContext.Child cannot be called on type INTERFACE")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetBugStatePayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.SetBugStatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetBugStatePayload_clientMutationId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClientMutationID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SetBugStatePayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetBugStatePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetBugStatePayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.SetBugStatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetBugStatePayload_bug(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bug, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.BugWrapper)
+	fc.Result = res
+	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SetBugStatePayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetBugStatePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Bug_id(ctx, field)
+			case "humanId":
+				return ec.fieldContext_Bug_humanId(ctx, field)
+			case "status":
+				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
+			case "title":
+				return ec.fieldContext_Bug_title(ctx, field)
+			case "labels":
+				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
+			case "author":
+				return ec.fieldContext_Bug_author(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Bug_createdAt(ctx, field)
+			case "lastEdit":
+				return ec.fieldContext_Bug_lastEdit(ctx, field)
+			case "actors":
+				return ec.fieldContext_Bug_actors(ctx, field)
+			case "participants":
+				return ec.fieldContext_Bug_participants(ctx, field)
+			case "comments":
+				return ec.fieldContext_Bug_comments(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Bug_timeline(ctx, field)
+			case "operations":
+				return ec.fieldContext_Bug_operations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Bug", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetBugStatePayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.SetBugStatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetBugStatePayload_operation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Operation, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*bug.SetStatusOperation)
+	fc.Result = res
+	return ec.marshalNSetStatusOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐSetStatusOperation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SetBugStatePayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetBugStatePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SetStatusOperation_id(ctx, field)
+			case "author":
+				return ec.fieldContext_SetStatusOperation_author(ctx, field)
+			case "date":
+				return ec.fieldContext_SetStatusOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_SetStatusOperation_signed(ctx, field)
+			case "status":
+				return ec.fieldContext_SetStatusOperation_status(ctx, field)
+			case "state":
+				return ec.fieldContext_SetStatusOperation_state(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SetStatusOperation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetFieldPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.SetFieldPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetFieldPayload_clientMutationId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClientMutationID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SetFieldPayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetFieldPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetFieldPayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.SetFieldPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetFieldPayload_bug(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bug, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.BugWrapper)
+	fc.Result = res
+	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SetFieldPayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetFieldPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Bug_id(ctx, field)
+			case "humanId":
+				return ec.fieldContext_Bug_humanId(ctx, field)
+			case "status":
+				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
+			case "title":
+				return ec.fieldContext_Bug_title(ctx, field)
+			case "labels":
+				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
+			case "author":
+				return ec.fieldContext_Bug_author(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Bug_createdAt(ctx, field)
+			case "lastEdit":
+				return ec.fieldContext_Bug_lastEdit(ctx, field)
+			case "actors":
+				return ec.fieldContext_Bug_actors(ctx, field)
+			case "participants":
+				return ec.fieldContext_Bug_participants(ctx, field)
+			case "comments":
+				return ec.fieldContext_Bug_comments(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Bug_timeline(ctx, field)
+			case "operations":
+				return ec.fieldContext_Bug_operations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Bug", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetFieldPayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.SetFieldPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetFieldPayload_operation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Operation, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*bug.SetFieldOperation)
+	fc.Result = res
+	return ec.marshalNSetFieldOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐSetFieldOperation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SetFieldPayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetFieldPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SetFieldOperation_id(ctx, field)
+			case "author":
+				return ec.fieldContext_SetFieldOperation_author(ctx, field)
+			case "date":
+				return ec.fieldContext_SetFieldOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_SetFieldOperation_signed(ctx, field)
+			case "name":
+				return ec.fieldContext_SetFieldOperation_name(ctx, field)
+			case "value":
+				return ec.fieldContext_SetFieldOperation_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SetFieldOperation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetTitlePayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *models.SetTitlePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetTitlePayload_clientMutationId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClientMutationID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SetTitlePayload_clientMutationId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetTitlePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetTitlePayload_bug(ctx context.Context, field graphql.CollectedField, obj *models.SetTitlePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetTitlePayload_bug(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bug, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.BugWrapper)
+	fc.Result = res
+	return ec.marshalNBug2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐBugWrapper(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SetTitlePayload_bug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetTitlePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Bug_id(ctx, field)
+			case "humanId":
+				return ec.fieldContext_Bug_humanId(ctx, field)
+			case "status":
+				return ec.fieldContext_Bug_status(ctx, field)
+			case "state":
+				return ec.fieldContext_Bug_state(ctx, field)
+			case "title":
+				return ec.fieldContext_Bug_title(ctx, field)
+			case "labels":
+				return ec.fieldContext_Bug_labels(ctx, field)
+			case "fields":
+				return ec.fieldContext_Bug_fields(ctx, field)
+			case "relations":
+				return ec.fieldContext_Bug_relations(ctx, field)
+			case "backlinks":
+				return ec.fieldContext_Bug_backlinks(ctx, field)
+			case "mergeConflicts":
+				return ec.fieldContext_Bug_mergeConflicts(ctx, field)
+			case "translationLanguages":
+				return ec.fieldContext_Bug_translationLanguages(ctx, field)
+			case "translations":
+				return ec.fieldContext_Bug_translations(ctx, field)
+			case "attachments":
+				return ec.fieldContext_Bug_attachments(ctx, field)
+			case "assignees":
+				return ec.fieldContext_Bug_assignees(ctx, field)
+			case "author":
+				return ec.fieldContext_Bug_author(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Bug_createdAt(ctx, field)
+			case "lastEdit":
+				return ec.fieldContext_Bug_lastEdit(ctx, field)
+			case "actors":
+				return ec.fieldContext_Bug_actors(ctx, field)
+			case "participants":
+				return ec.fieldContext_Bug_participants(ctx, field)
+			case "comments":
+				return ec.fieldContext_Bug_comments(ctx, field)
+			case "timeline":
+				return ec.fieldContext_Bug_timeline(ctx, field)
+			case "operations":
+				return ec.fieldContext_Bug_operations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Bug", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetTitlePayload_operation(ctx context.Context, field graphql.CollectedField, obj *models.SetTitlePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetTitlePayload_operation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Operation, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*bug.SetTitleOperation)
+	fc.Result = res
+	return ec.marshalNSetTitleOperation2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐSetTitleOperation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SetTitlePayload_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetTitlePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SetTitleOperation_id(ctx, field)
+			case "author":
+				return ec.fieldContext_SetTitleOperation_author(ctx, field)
+			case "date":
+				return ec.fieldContext_SetTitleOperation_date(ctx, field)
+			case "signed":
+				return ec.fieldContext_SetTitleOperation_signed(ctx, field)
+			case "title":
+				return ec.fieldContext_SetTitleOperation_title(ctx, field)
+			case "was":
+				return ec.fieldContext_SetTitleOperation_was(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SetTitleOperation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAckChangeFeedInput(ctx context.Context, obj interface{}) (models.AckChangeFeedInput, error) {
+	var it models.AckChangeFeedInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "consumer", "cursor"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "consumer":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("consumer"))
+			it.Consumer, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "cursor":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+			it.Cursor, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAddAttachmentInput(ctx context.Context, obj interface{}) (models.AddAttachmentInput, error) {
+	var it models.AddAttachmentInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "name", "content"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "prefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "content":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
+			it.Content, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAddCommentAndCloseBugInput(ctx context.Context, obj interface{}) (models.AddCommentAndCloseBugInput, error) {
+	var it models.AddCommentAndCloseBugInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "message", "files", "confirmFreeze"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "prefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "message":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
+			it.Message, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "files":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("files"))
+			it.Files, err = ec.unmarshalOHash2ᚕgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋrepositoryᚐHashᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "confirmFreeze":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("confirmFreeze"))
+			it.ConfirmFreeze, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAddCommentAndReopenBugInput(ctx context.Context, obj interface{}) (models.AddCommentAndReopenBugInput, error) {
+	var it models.AddCommentAndReopenBugInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "message", "files"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "prefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "message":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
+			it.Message, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "files":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("files"))
+			it.Files, err = ec.unmarshalOHash2ᚕgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋrepositoryᚐHashᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAddCommentInput(ctx context.Context, obj interface{}) (models.AddCommentInput, error) {
+	var it models.AddCommentInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "message", "files"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "prefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "message":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
+			it.Message, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "files":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("files"))
+			it.Files, err = ec.unmarshalOHash2ᚕgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋrepositoryᚐHashᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputChangeAssigneeInput(ctx context.Context, obj interface{}) (models.ChangeAssigneeInput, error) {
+	var it models.ChangeAssigneeInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "added", "removed"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "prefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "added":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("added"))
+			it.Added, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "removed":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("removed"))
+			it.Removed, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputChangeLabelInput(ctx context.Context, obj interface{}) (models.ChangeLabelInput, error) {
+	var it models.ChangeLabelInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "added", "Removed"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "prefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "added":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("added"))
+			it.Added, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "Removed":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("Removed"))
+			it.Removed, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCloseBugInput(ctx context.Context, obj interface{}) (models.CloseBugInput, error) {
+	var it models.CloseBugInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "confirmFreeze"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "prefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "confirmFreeze":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("confirmFreeze"))
+			it.ConfirmFreeze, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputEditCommentInput(ctx context.Context, obj interface{}) (models.EditCommentInput, error) {
+	var it models.EditCommentInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "targetPrefix", "message", "files"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "targetPrefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetPrefix"))
+			it.TargetPrefix, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "message":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
+			it.Message, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "files":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("files"))
+			it.Files, err = ec.unmarshalOHash2ᚕgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋrepositoryᚐHashᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputMarkBugAsReadInput(ctx context.Context, obj interface{}) (models.MarkBugAsReadInput, error) {
+	var it models.MarkBugAsReadInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "prefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewBugInput(ctx context.Context, obj interface{}) (models.NewBugInput, error) {
+	var it models.NewBugInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "title", "message", "files"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "title":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			it.Title, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "message":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
+			it.Message, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "files":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("files"))
+			it.Files, err = ec.unmarshalOHash2ᚕgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋrepositoryᚐHashᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOpenBugInput(ctx context.Context, obj interface{}) (models.OpenBugInput, error) {
+	var it models.OpenBugInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repoRef":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "prefix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputReactionInput(ctx context.Context, obj interface{}) (models.ReactionInput, error) {
+	var it models.ReactionInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "targetPrefix", "reaction"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "clientMutationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "prefix":
+		case "repoRef":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
-			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
+			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "message":
+		case "targetPrefix":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
-			it.Message, err = ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetPrefix"))
+			it.TargetPrefix, err = ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "files":
+		case "reaction":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("files"))
-			it.Files, err = ec.unmarshalOHash2ᚕgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋrepositoryᚐHashᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reaction"))
+			it.Reaction, err = ec.unmarshalNReaction2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐReaction(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -1866,14 +4264,14 @@ func (ec *executionContext) unmarshalInputAddCommentAndCloseBugInput(ctx context
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputAddCommentAndReopenBugInput(ctx context.Context, obj interface{}) (models.AddCommentAndReopenBugInput, error) {
-	var it models.AddCommentAndReopenBugInput
+func (ec *executionContext) unmarshalInputRedactCommentInput(ctx context.Context, obj interface{}) (models.RedactCommentInput, error) {
+	var it models.RedactCommentInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "message", "files"}
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "targetPrefix", "delete"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -1896,27 +4294,19 @@ func (ec *executionContext) unmarshalInputAddCommentAndReopenBugInput(ctx contex
 			if err != nil {
 				return it, err
 			}
-		case "prefix":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
-			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "message":
+		case "targetPrefix":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
-			it.Message, err = ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetPrefix"))
+			it.TargetPrefix, err = ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "files":
+		case "delete":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("files"))
-			it.Files, err = ec.unmarshalOHash2ᚕgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋrepositoryᚐHashᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("delete"))
+			it.Delete, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -1926,14 +4316,14 @@ func (ec *executionContext) unmarshalInputAddCommentAndReopenBugInput(ctx contex
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputAddCommentInput(ctx context.Context, obj interface{}) (models.AddCommentInput, error) {
-	var it models.AddCommentInput
+func (ec *executionContext) unmarshalInputRelationInput(ctx context.Context, obj interface{}) (models.RelationInput, error) {
+	var it models.RelationInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "message", "files"}
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "relation", "targetPrefix"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -1964,19 +4354,19 @@ func (ec *executionContext) unmarshalInputAddCommentInput(ctx context.Context, o
 			if err != nil {
 				return it, err
 			}
-		case "message":
+		case "relation":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
-			it.Message, err = ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("relation"))
+			it.Relation, err = ec.unmarshalNRelationType2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋentitiesᚋbugᚐRelationType(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "files":
+		case "targetPrefix":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("files"))
-			it.Files, err = ec.unmarshalOHash2ᚕgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋrepositoryᚐHashᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetPrefix"))
+			it.TargetPrefix, err = ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -1986,14 +4376,14 @@ func (ec *executionContext) unmarshalInputAddCommentInput(ctx context.Context, o
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputChangeLabelInput(ctx context.Context, obj interface{}) (models.ChangeLabelInput, error) {
-	var it models.ChangeLabelInput
+func (ec *executionContext) unmarshalInputSetBugStateInput(ctx context.Context, obj interface{}) (models.SetBugStateInput, error) {
+	var it models.SetBugStateInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "added", "Removed"}
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "state", "confirmFreeze"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -2024,19 +4414,19 @@ func (ec *executionContext) unmarshalInputChangeLabelInput(ctx context.Context,
 			if err != nil {
 				return it, err
 			}
-		case "added":
+		case "state":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("added"))
-			it.Added, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
+			it.State, err = ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "Removed":
+		case "confirmFreeze":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("Removed"))
-			it.Removed, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("confirmFreeze"))
+			it.ConfirmFreeze, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -2046,14 +4436,14 @@ func (ec *executionContext) unmarshalInputChangeLabelInput(ctx context.Context,
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCloseBugInput(ctx context.Context, obj interface{}) (models.CloseBugInput, error) {
-	var it models.CloseBugInput
+func (ec *executionContext) unmarshalInputSetFieldInput(ctx context.Context, obj interface{}) (models.SetFieldInput, error) {
+	var it models.SetFieldInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix"}
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "name", "value"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -2084,20 +4474,36 @@ func (ec *executionContext) unmarshalInputCloseBugInput(ctx context.Context, obj
 			if err != nil {
 				return it, err
 			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "value":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			it.Value, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputEditCommentInput(ctx context.Context, obj interface{}) (models.EditCommentInput, error) {
-	var it models.EditCommentInput
+func (ec *executionContext) unmarshalInputSetTitleInput(ctx context.Context, obj interface{}) (models.SetTitleInput, error) {
+	var it models.SetTitleInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "targetPrefix", "message", "files"}
+	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "title"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -2120,231 +4526,386 @@ func (ec *executionContext) unmarshalInputEditCommentInput(ctx context.Context,
 			if err != nil {
 				return it, err
 			}
-		case "targetPrefix":
+		case "prefix":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetPrefix"))
-			it.TargetPrefix, err = ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "message":
+		case "title":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
-			it.Message, err = ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			it.Title, err = ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-		case "files":
-			var err error
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var ackChangeFeedPayloadImplementors = []string{"AckChangeFeedPayload"}
+
+func (ec *executionContext) _AckChangeFeedPayload(ctx context.Context, sel ast.SelectionSet, obj *models.AckChangeFeedPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, ackChangeFeedPayloadImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AckChangeFeedPayload")
+		case "clientMutationId":
+
+			out.Values[i] = ec._AckChangeFeedPayload_clientMutationId(ctx, field, obj)
+
+		case "cursor":
+
+			out.Values[i] = ec._AckChangeFeedPayload_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var addAttachmentPayloadImplementors = []string{"AddAttachmentPayload"}
+
+func (ec *executionContext) _AddAttachmentPayload(ctx context.Context, sel ast.SelectionSet, obj *models.AddAttachmentPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addAttachmentPayloadImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AddAttachmentPayload")
+		case "clientMutationId":
+
+			out.Values[i] = ec._AddAttachmentPayload_clientMutationId(ctx, field, obj)
+
+		case "bug":
+
+			out.Values[i] = ec._AddAttachmentPayload_bug(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "operation":
+
+			out.Values[i] = ec._AddAttachmentPayload_operation(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var addCommentAndCloseBugPayloadImplementors = []string{"AddCommentAndCloseBugPayload"}
+
+func (ec *executionContext) _AddCommentAndCloseBugPayload(ctx context.Context, sel ast.SelectionSet, obj *models.AddCommentAndCloseBugPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addCommentAndCloseBugPayloadImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AddCommentAndCloseBugPayload")
+		case "clientMutationId":
+
+			out.Values[i] = ec._AddCommentAndCloseBugPayload_clientMutationId(ctx, field, obj)
+
+		case "bug":
+
+			out.Values[i] = ec._AddCommentAndCloseBugPayload_bug(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "commentOperation":
+
+			out.Values[i] = ec._AddCommentAndCloseBugPayload_commentOperation(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "statusOperation":
+
+			out.Values[i] = ec._AddCommentAndCloseBugPayload_statusOperation(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var addCommentAndReopenBugPayloadImplementors = []string{"AddCommentAndReopenBugPayload"}
+
+func (ec *executionContext) _AddCommentAndReopenBugPayload(ctx context.Context, sel ast.SelectionSet, obj *models.AddCommentAndReopenBugPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addCommentAndReopenBugPayloadImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AddCommentAndReopenBugPayload")
+		case "clientMutationId":
+
+			out.Values[i] = ec._AddCommentAndReopenBugPayload_clientMutationId(ctx, field, obj)
+
+		case "bug":
+
+			out.Values[i] = ec._AddCommentAndReopenBugPayload_bug(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "commentOperation":
+
+			out.Values[i] = ec._AddCommentAndReopenBugPayload_commentOperation(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "statusOperation":
+
+			out.Values[i] = ec._AddCommentAndReopenBugPayload_statusOperation(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("files"))
-			it.Files, err = ec.unmarshalOHash2ᚕgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋrepositoryᚐHashᚄ(ctx, v)
-			if err != nil {
-				return it, err
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-func (ec *executionContext) unmarshalInputNewBugInput(ctx context.Context, obj interface{}) (models.NewBugInput, error) {
-	var it models.NewBugInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
+var addCommentPayloadImplementors = []string{"AddCommentPayload"}
 
-	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "title", "message", "files"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
+func (ec *executionContext) _AddCommentPayload(ctx context.Context, sel ast.SelectionSet, obj *models.AddCommentPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addCommentPayloadImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AddCommentPayload")
 		case "clientMutationId":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
-			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "repoRef":
-			var err error
+			out.Values[i] = ec._AddCommentPayload_clientMutationId(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
-			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "title":
-			var err error
+		case "bug":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
-			it.Title, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "message":
-			var err error
+			out.Values[i] = ec._AddCommentPayload_bug(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
-			it.Message, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "files":
-			var err error
+		case "operation":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("files"))
-			it.Files, err = ec.unmarshalOHash2ᚕgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋrepositoryᚐHashᚄ(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._AddCommentPayload_operation(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-func (ec *executionContext) unmarshalInputOpenBugInput(ctx context.Context, obj interface{}) (models.OpenBugInput, error) {
-	var it models.OpenBugInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
+var changeAssigneePayloadImplementors = []string{"ChangeAssigneePayload"}
 
-	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
+func (ec *executionContext) _ChangeAssigneePayload(ctx context.Context, sel ast.SelectionSet, obj *models.ChangeAssigneePayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, changeAssigneePayloadImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChangeAssigneePayload")
 		case "clientMutationId":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
-			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "repoRef":
-			var err error
+			out.Values[i] = ec._ChangeAssigneePayload_clientMutationId(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
-			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "bug":
+
+			out.Values[i] = ec._ChangeAssigneePayload_bug(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "prefix":
-			var err error
+		case "operation":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
-			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ChangeAssigneePayload_operation(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-func (ec *executionContext) unmarshalInputSetTitleInput(ctx context.Context, obj interface{}) (models.SetTitleInput, error) {
-	var it models.SetTitleInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
+var changeLabelPayloadImplementors = []string{"ChangeLabelPayload"}
 
-	fieldsInOrder := [...]string{"clientMutationId", "repoRef", "prefix", "title"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
+func (ec *executionContext) _ChangeLabelPayload(ctx context.Context, sel ast.SelectionSet, obj *models.ChangeLabelPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, changeLabelPayloadImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChangeLabelPayload")
 		case "clientMutationId":
-			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
-			it.ClientMutationID, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "repoRef":
-			var err error
+			out.Values[i] = ec._ChangeLabelPayload_clientMutationId(ctx, field, obj)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repoRef"))
-			it.RepoRef, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "bug":
+
+			out.Values[i] = ec._ChangeLabelPayload_bug(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "prefix":
-			var err error
+		case "operation":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
-			it.Prefix, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ChangeLabelPayload_operation(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "title":
-			var err error
+		case "results":
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
-			it.Title, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ChangeLabelPayload_results(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-// endregion **************************** input.gotpl *****************************
+var closeBugPayloadImplementors = []string{"CloseBugPayload"}
 
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) _CloseBugPayload(ctx context.Context, sel ast.SelectionSet, obj *models.CloseBugPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, closeBugPayloadImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CloseBugPayload")
+		case "clientMutationId":
 
-// endregion ************************** interface.gotpl ***************************
+			out.Values[i] = ec._CloseBugPayload_clientMutationId(ctx, field, obj)
 
-// region    **************************** object.gotpl ****************************
+		case "bug":
 
-var addCommentAndCloseBugPayloadImplementors = []string{"AddCommentAndCloseBugPayload"}
+			out.Values[i] = ec._CloseBugPayload_bug(ctx, field, obj)
 
-func (ec *executionContext) _AddCommentAndCloseBugPayload(ctx context.Context, sel ast.SelectionSet, obj *models.AddCommentAndCloseBugPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addCommentAndCloseBugPayloadImplementors)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "operation":
+
+			out.Values[i] = ec._CloseBugPayload_operation(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var editCommentPayloadImplementors = []string{"EditCommentPayload"}
+
+func (ec *executionContext) _EditCommentPayload(ctx context.Context, sel ast.SelectionSet, obj *models.EditCommentPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, editCommentPayloadImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AddCommentAndCloseBugPayload")
+			out.Values[i] = graphql.MarshalString("EditCommentPayload")
 		case "clientMutationId":
 
-			out.Values[i] = ec._AddCommentAndCloseBugPayload_clientMutationId(ctx, field, obj)
+			out.Values[i] = ec._EditCommentPayload_clientMutationId(ctx, field, obj)
 
 		case "bug":
 
-			out.Values[i] = ec._AddCommentAndCloseBugPayload_bug(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "commentOperation":
-
-			out.Values[i] = ec._AddCommentAndCloseBugPayload_commentOperation(ctx, field, obj)
+			out.Values[i] = ec._EditCommentPayload_bug(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "statusOperation":
+		case "operation":
 
-			out.Values[i] = ec._AddCommentAndCloseBugPayload_statusOperation(ctx, field, obj)
+			out.Values[i] = ec._EditCommentPayload_operation(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -2360,37 +4921,58 @@ func (ec *executionContext) _AddCommentAndCloseBugPayload(ctx context.Context, s
 	return out
 }
 
-var addCommentAndReopenBugPayloadImplementors = []string{"AddCommentAndReopenBugPayload"}
+var labelChangeResultImplementors = []string{"LabelChangeResult"}
 
-func (ec *executionContext) _AddCommentAndReopenBugPayload(ctx context.Context, sel ast.SelectionSet, obj *models.AddCommentAndReopenBugPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addCommentAndReopenBugPayloadImplementors)
+func (ec *executionContext) _LabelChangeResult(ctx context.Context, sel ast.SelectionSet, obj *bug.LabelChangeResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, labelChangeResultImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AddCommentAndReopenBugPayload")
-		case "clientMutationId":
-
-			out.Values[i] = ec._AddCommentAndReopenBugPayload_clientMutationId(ctx, field, obj)
-
-		case "bug":
+			out.Values[i] = graphql.MarshalString("LabelChangeResult")
+		case "label":
 
-			out.Values[i] = ec._AddCommentAndReopenBugPayload_bug(ctx, field, obj)
+			out.Values[i] = ec._LabelChangeResult_label(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "commentOperation":
+		case "status":
 
-			out.Values[i] = ec._AddCommentAndReopenBugPayload_commentOperation(ctx, field, obj)
+			out.Values[i] = ec._LabelChangeResult_status(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "statusOperation":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._AddCommentAndReopenBugPayload_statusOperation(ctx, field, obj)
+var markBugAsReadPayloadImplementors = []string{"MarkBugAsReadPayload"}
+
+func (ec *executionContext) _MarkBugAsReadPayload(ctx context.Context, sel ast.SelectionSet, obj *models.MarkBugAsReadPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, markBugAsReadPayloadImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MarkBugAsReadPayload")
+		case "clientMutationId":
+
+			out.Values[i] = ec._MarkBugAsReadPayload_clientMutationId(ctx, field, obj)
+
+		case "bug":
+
+			out.Values[i] = ec._MarkBugAsReadPayload_bug(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -2406,30 +4988,30 @@ func (ec *executionContext) _AddCommentAndReopenBugPayload(ctx context.Context,
 	return out
 }
 
-var addCommentPayloadImplementors = []string{"AddCommentPayload"}
+var newBugPayloadImplementors = []string{"NewBugPayload"}
 
-func (ec *executionContext) _AddCommentPayload(ctx context.Context, sel ast.SelectionSet, obj *models.AddCommentPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addCommentPayloadImplementors)
+func (ec *executionContext) _NewBugPayload(ctx context.Context, sel ast.SelectionSet, obj *models.NewBugPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, newBugPayloadImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AddCommentPayload")
+			out.Values[i] = graphql.MarshalString("NewBugPayload")
 		case "clientMutationId":
 
-			out.Values[i] = ec._AddCommentPayload_clientMutationId(ctx, field, obj)
+			out.Values[i] = ec._NewBugPayload_clientMutationId(ctx, field, obj)
 
 		case "bug":
 
-			out.Values[i] = ec._AddCommentPayload_bug(ctx, field, obj)
+			out.Values[i] = ec._NewBugPayload_bug(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
 		case "operation":
 
-			out.Values[i] = ec._AddCommentPayload_operation(ctx, field, obj)
+			out.Values[i] = ec._NewBugPayload_operation(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -2445,37 +5027,30 @@ func (ec *executionContext) _AddCommentPayload(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var changeLabelPayloadImplementors = []string{"ChangeLabelPayload"}
+var openBugPayloadImplementors = []string{"OpenBugPayload"}
 
-func (ec *executionContext) _ChangeLabelPayload(ctx context.Context, sel ast.SelectionSet, obj *models.ChangeLabelPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, changeLabelPayloadImplementors)
+func (ec *executionContext) _OpenBugPayload(ctx context.Context, sel ast.SelectionSet, obj *models.OpenBugPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, openBugPayloadImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ChangeLabelPayload")
+			out.Values[i] = graphql.MarshalString("OpenBugPayload")
 		case "clientMutationId":
 
-			out.Values[i] = ec._ChangeLabelPayload_clientMutationId(ctx, field, obj)
+			out.Values[i] = ec._OpenBugPayload_clientMutationId(ctx, field, obj)
 
 		case "bug":
 
-			out.Values[i] = ec._ChangeLabelPayload_bug(ctx, field, obj)
+			out.Values[i] = ec._OpenBugPayload_bug(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
 		case "operation":
 
-			out.Values[i] = ec._ChangeLabelPayload_operation(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "results":
-
-			out.Values[i] = ec._ChangeLabelPayload_results(ctx, field, obj)
+			out.Values[i] = ec._OpenBugPayload_operation(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -2491,30 +5066,30 @@ func (ec *executionContext) _ChangeLabelPayload(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var closeBugPayloadImplementors = []string{"CloseBugPayload"}
+var reactionPayloadImplementors = []string{"ReactionPayload"}
 
-func (ec *executionContext) _CloseBugPayload(ctx context.Context, sel ast.SelectionSet, obj *models.CloseBugPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, closeBugPayloadImplementors)
+func (ec *executionContext) _ReactionPayload(ctx context.Context, sel ast.SelectionSet, obj *models.ReactionPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reactionPayloadImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CloseBugPayload")
+			out.Values[i] = graphql.MarshalString("ReactionPayload")
 		case "clientMutationId":
 
-			out.Values[i] = ec._CloseBugPayload_clientMutationId(ctx, field, obj)
+			out.Values[i] = ec._ReactionPayload_clientMutationId(ctx, field, obj)
 
 		case "bug":
 
-			out.Values[i] = ec._CloseBugPayload_bug(ctx, field, obj)
+			out.Values[i] = ec._ReactionPayload_bug(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
 		case "operation":
 
-			out.Values[i] = ec._CloseBugPayload_operation(ctx, field, obj)
+			out.Values[i] = ec._ReactionPayload_operation(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -2530,30 +5105,30 @@ func (ec *executionContext) _CloseBugPayload(ctx context.Context, sel ast.Select
 	return out
 }
 
-var editCommentPayloadImplementors = []string{"EditCommentPayload"}
+var redactCommentPayloadImplementors = []string{"RedactCommentPayload"}
 
-func (ec *executionContext) _EditCommentPayload(ctx context.Context, sel ast.SelectionSet, obj *models.EditCommentPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, editCommentPayloadImplementors)
+func (ec *executionContext) _RedactCommentPayload(ctx context.Context, sel ast.SelectionSet, obj *models.RedactCommentPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, redactCommentPayloadImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("EditCommentPayload")
+			out.Values[i] = graphql.MarshalString("RedactCommentPayload")
 		case "clientMutationId":
 
-			out.Values[i] = ec._EditCommentPayload_clientMutationId(ctx, field, obj)
+			out.Values[i] = ec._RedactCommentPayload_clientMutationId(ctx, field, obj)
 
 		case "bug":
 
-			out.Values[i] = ec._EditCommentPayload_bug(ctx, field, obj)
+			out.Values[i] = ec._RedactCommentPayload_bug(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
 		case "operation":
 
-			out.Values[i] = ec._EditCommentPayload_operation(ctx, field, obj)
+			out.Values[i] = ec._RedactCommentPayload_operation(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -2569,26 +5144,30 @@ func (ec *executionContext) _EditCommentPayload(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var labelChangeResultImplementors = []string{"LabelChangeResult"}
+var relationPayloadImplementors = []string{"RelationPayload"}
 
-func (ec *executionContext) _LabelChangeResult(ctx context.Context, sel ast.SelectionSet, obj *bug.LabelChangeResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, labelChangeResultImplementors)
+func (ec *executionContext) _RelationPayload(ctx context.Context, sel ast.SelectionSet, obj *models.RelationPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relationPayloadImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LabelChangeResult")
-		case "label":
+			out.Values[i] = graphql.MarshalString("RelationPayload")
+		case "clientMutationId":
 
-			out.Values[i] = ec._LabelChangeResult_label(ctx, field, obj)
+			out.Values[i] = ec._RelationPayload_clientMutationId(ctx, field, obj)
+
+		case "bug":
+
+			out.Values[i] = ec._RelationPayload_bug(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "status":
+		case "operation":
 
-			out.Values[i] = ec._LabelChangeResult_status(ctx, field, obj)
+			out.Values[i] = ec._RelationPayload_operation(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -2604,30 +5183,30 @@ func (ec *executionContext) _LabelChangeResult(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var newBugPayloadImplementors = []string{"NewBugPayload"}
+var setBugStatePayloadImplementors = []string{"SetBugStatePayload"}
 
-func (ec *executionContext) _NewBugPayload(ctx context.Context, sel ast.SelectionSet, obj *models.NewBugPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, newBugPayloadImplementors)
+func (ec *executionContext) _SetBugStatePayload(ctx context.Context, sel ast.SelectionSet, obj *models.SetBugStatePayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, setBugStatePayloadImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("NewBugPayload")
+			out.Values[i] = graphql.MarshalString("SetBugStatePayload")
 		case "clientMutationId":
 
-			out.Values[i] = ec._NewBugPayload_clientMutationId(ctx, field, obj)
+			out.Values[i] = ec._SetBugStatePayload_clientMutationId(ctx, field, obj)
 
 		case "bug":
 
-			out.Values[i] = ec._NewBugPayload_bug(ctx, field, obj)
+			out.Values[i] = ec._SetBugStatePayload_bug(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
 		case "operation":
 
-			out.Values[i] = ec._NewBugPayload_operation(ctx, field, obj)
+			out.Values[i] = ec._SetBugStatePayload_operation(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -2643,30 +5222,30 @@ func (ec *executionContext) _NewBugPayload(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var openBugPayloadImplementors = []string{"OpenBugPayload"}
+var setFieldPayloadImplementors = []string{"SetFieldPayload"}
 
-func (ec *executionContext) _OpenBugPayload(ctx context.Context, sel ast.SelectionSet, obj *models.OpenBugPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, openBugPayloadImplementors)
+func (ec *executionContext) _SetFieldPayload(ctx context.Context, sel ast.SelectionSet, obj *models.SetFieldPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, setFieldPayloadImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OpenBugPayload")
+			out.Values[i] = graphql.MarshalString("SetFieldPayload")
 		case "clientMutationId":
 
-			out.Values[i] = ec._OpenBugPayload_clientMutationId(ctx, field, obj)
+			out.Values[i] = ec._SetFieldPayload_clientMutationId(ctx, field, obj)
 
 		case "bug":
 
-			out.Values[i] = ec._OpenBugPayload_bug(ctx, field, obj)
+			out.Values[i] = ec._SetFieldPayload_bug(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
 		case "operation":
 
-			out.Values[i] = ec._OpenBugPayload_operation(ctx, field, obj)
+			out.Values[i] = ec._SetFieldPayload_operation(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -2725,6 +5304,44 @@ func (ec *executionContext) _SetTitlePayload(ctx context.Context, sel ast.Select
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) unmarshalNAckChangeFeedInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐAckChangeFeedInput(ctx context.Context, v interface{}) (models.AckChangeFeedInput, error) {
+	res, err := ec.unmarshalInputAckChangeFeedInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAckChangeFeedPayload2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐAckChangeFeedPayload(ctx context.Context, sel ast.SelectionSet, v models.AckChangeFeedPayload) graphql.Marshaler {
+	return ec._AckChangeFeedPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAckChangeFeedPayload2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐAckChangeFeedPayload(ctx context.Context, sel ast.SelectionSet, v *models.AckChangeFeedPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AckChangeFeedPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNAddAttachmentInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐAddAttachmentInput(ctx context.Context, v interface{}) (models.AddAttachmentInput, error) {
+	res, err := ec.unmarshalInputAddAttachmentInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAddAttachmentPayload2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐAddAttachmentPayload(ctx context.Context, sel ast.SelectionSet, v models.AddAttachmentPayload) graphql.Marshaler {
+	return ec._AddAttachmentPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAddAttachmentPayload2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐAddAttachmentPayload(ctx context.Context, sel ast.SelectionSet, v *models.AddAttachmentPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AddAttachmentPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNAddCommentAndCloseBugInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐAddCommentAndCloseBugInput(ctx context.Context, v interface{}) (models.AddCommentAndCloseBugInput, error) {
 	res, err := ec.unmarshalInputAddCommentAndCloseBugInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -2782,6 +5399,25 @@ func (ec *executionContext) marshalNAddCommentPayload2ᚖgithubᚗcomᚋMichaelM
 	return ec._AddCommentPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNChangeAssigneeInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐChangeAssigneeInput(ctx context.Context, v interface{}) (models.ChangeAssigneeInput, error) {
+	res, err := ec.unmarshalInputChangeAssigneeInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNChangeAssigneePayload2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐChangeAssigneePayload(ctx context.Context, sel ast.SelectionSet, v models.ChangeAssigneePayload) graphql.Marshaler {
+	return ec._ChangeAssigneePayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNChangeAssigneePayload2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐChangeAssigneePayload(ctx context.Context, sel ast.SelectionSet, v *models.ChangeAssigneePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ChangeAssigneePayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNChangeLabelPayload2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐChangeLabelPayload(ctx context.Context, sel ast.SelectionSet, v models.ChangeLabelPayload) graphql.Marshaler {
 	return ec._ChangeLabelPayload(ctx, sel, &v)
 }
@@ -2882,6 +5518,25 @@ func (ec *executionContext) marshalNLabelChangeStatus2githubᚗcomᚋMichaelMure
 	return v
 }
 
+func (ec *executionContext) unmarshalNMarkBugAsReadInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐMarkBugAsReadInput(ctx context.Context, v interface{}) (models.MarkBugAsReadInput, error) {
+	res, err := ec.unmarshalInputMarkBugAsReadInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMarkBugAsReadPayload2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐMarkBugAsReadPayload(ctx context.Context, sel ast.SelectionSet, v models.MarkBugAsReadPayload) graphql.Marshaler {
+	return ec._MarkBugAsReadPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNMarkBugAsReadPayload2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐMarkBugAsReadPayload(ctx context.Context, sel ast.SelectionSet, v *models.MarkBugAsReadPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._MarkBugAsReadPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNNewBugInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐNewBugInput(ctx context.Context, v interface{}) (models.NewBugInput, error) {
 	res, err := ec.unmarshalInputNewBugInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -2920,6 +5575,101 @@ func (ec *executionContext) marshalNOpenBugPayload2ᚖgithubᚗcomᚋMichaelMure
 	return ec._OpenBugPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNReactionInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐReactionInput(ctx context.Context, v interface{}) (models.ReactionInput, error) {
+	res, err := ec.unmarshalInputReactionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNReactionPayload2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐReactionPayload(ctx context.Context, sel ast.SelectionSet, v models.ReactionPayload) graphql.Marshaler {
+	return ec._ReactionPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNReactionPayload2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐReactionPayload(ctx context.Context, sel ast.SelectionSet, v *models.ReactionPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ReactionPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRedactCommentInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐRedactCommentInput(ctx context.Context, v interface{}) (models.RedactCommentInput, error) {
+	res, err := ec.unmarshalInputRedactCommentInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRedactCommentPayload2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐRedactCommentPayload(ctx context.Context, sel ast.SelectionSet, v models.RedactCommentPayload) graphql.Marshaler {
+	return ec._RedactCommentPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRedactCommentPayload2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐRedactCommentPayload(ctx context.Context, sel ast.SelectionSet, v *models.RedactCommentPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RedactCommentPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRelationInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐRelationInput(ctx context.Context, v interface{}) (models.RelationInput, error) {
+	res, err := ec.unmarshalInputRelationInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRelationPayload2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐRelationPayload(ctx context.Context, sel ast.SelectionSet, v models.RelationPayload) graphql.Marshaler {
+	return ec._RelationPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRelationPayload2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐRelationPayload(ctx context.Context, sel ast.SelectionSet, v *models.RelationPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RelationPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSetBugStateInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐSetBugStateInput(ctx context.Context, v interface{}) (models.SetBugStateInput, error) {
+	res, err := ec.unmarshalInputSetBugStateInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSetBugStatePayload2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐSetBugStatePayload(ctx context.Context, sel ast.SelectionSet, v models.SetBugStatePayload) graphql.Marshaler {
+	return ec._SetBugStatePayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSetBugStatePayload2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐSetBugStatePayload(ctx context.Context, sel ast.SelectionSet, v *models.SetBugStatePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SetBugStatePayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSetFieldInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐSetFieldInput(ctx context.Context, v interface{}) (models.SetFieldInput, error) {
+	res, err := ec.unmarshalInputSetFieldInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSetFieldPayload2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐSetFieldPayload(ctx context.Context, sel ast.SelectionSet, v models.SetFieldPayload) graphql.Marshaler {
+	return ec._SetFieldPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSetFieldPayload2ᚖgithubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐSetFieldPayload(ctx context.Context, sel ast.SelectionSet, v *models.SetFieldPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SetFieldPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNSetTitleInput2githubᚗcomᚋMichaelMureᚋgitᚑbugᚋapiᚋgraphqlᚋmodelsᚐSetTitleInput(ctx context.Context, v interface{}) (models.SetTitleInput, error) {
 	res, err := ec.unmarshalInputSetTitleInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -30,8 +30,10 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	AddAttachmentOperation() AddAttachmentOperationResolver
 	AddCommentOperation() AddCommentOperationResolver
 	AddCommentTimelineItem() AddCommentTimelineItemResolver
+	Attachment() AttachmentResolver
 	Bug() BugResolver
 	Color() ColorResolver
 	Comment() CommentResolver
@@ -43,19 +45,59 @@ type ResolverRoot interface {
 	Label() LabelResolver
 	LabelChangeOperation() LabelChangeOperationResolver
 	LabelChangeTimelineItem() LabelChangeTimelineItemResolver
+	MergeConflict() MergeConflictResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
+	ReactionGroup() ReactionGroupResolver
+	ReactionOperation() ReactionOperationResolver
+	RedactCommentOperation() RedactCommentOperationResolver
+	RelationAddOperation() RelationAddOperationResolver
+	RelationRemoveOperation() RelationRemoveOperationResolver
 	Repository() RepositoryResolver
+	RequestInfoOperation() RequestInfoOperationResolver
+	SetAssigneeOperation() SetAssigneeOperationResolver
+	SetFieldOperation() SetFieldOperationResolver
 	SetStatusOperation() SetStatusOperationResolver
 	SetStatusTimelineItem() SetStatusTimelineItemResolver
 	SetTitleOperation() SetTitleOperationResolver
 	SetTitleTimelineItem() SetTitleTimelineItemResolver
+	Subscription() SubscriptionResolver
+	SyncConflictOperation() SyncConflictOperationResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AckChangeFeedPayload struct {
+		ClientMutationID func(childComplexity int) int
+		Cursor           func(childComplexity int) int
+	}
+
+	ActivityCount struct {
+		Authored  func(childComplexity int) int
+		Closed    func(childComplexity int) int
+		Commented func(childComplexity int) int
+		Month     func(childComplexity int) int
+	}
+
+	AddAttachmentOperation struct {
+		Author      func(childComplexity int) int
+		ContentType func(childComplexity int) int
+		Date        func(childComplexity int) int
+		Hash        func(childComplexity int) int
+		Id          func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Signed      func(childComplexity int) int
+		Size        func(childComplexity int) int
+	}
+
+	AddAttachmentPayload struct {
+		Bug              func(childComplexity int) int
+		ClientMutationID func(childComplexity int) int
+		Operation        func(childComplexity int) int
+	}
+
 	AddCommentAndCloseBugPayload struct {
 		Bug              func(childComplexity int) int
 		ClientMutationID func(childComplexity int) int
@@ -76,6 +118,7 @@ type ComplexityRoot struct {
 		Files   func(childComplexity int) int
 		Id      func(childComplexity int) int
 		Message func(childComplexity int) int
+		Signed  func(childComplexity int) int
 	}
 
 	AddCommentPayload struct {
@@ -87,6 +130,7 @@ type ComplexityRoot struct {
 	AddCommentTimelineItem struct {
 		Author         func(childComplexity int) int
 		CreatedAt      func(childComplexity int) int
+		Deleted        func(childComplexity int) int
 		Edited         func(childComplexity int) int
 		Files          func(childComplexity int) int
 		History        func(childComplexity int) int
@@ -94,22 +138,43 @@ type ComplexityRoot struct {
 		LastEdit       func(childComplexity int) int
 		Message        func(childComplexity int) int
 		MessageIsEmpty func(childComplexity int) int
+		Reactions      func(childComplexity int) int
+		Redacted       func(childComplexity int) int
+		Structure      func(childComplexity int) int
+	}
+
+	Attachment struct {
+		Author      func(childComplexity int) int
+		ContentType func(childComplexity int) int
+		Date        func(childComplexity int) int
+		Hash        func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Size        func(childComplexity int) int
 	}
 
 	Bug struct {
-		Actors       func(childComplexity int, after *string, before *string, first *int, last *int) int
-		Author       func(childComplexity int) int
-		Comments     func(childComplexity int, after *string, before *string, first *int, last *int) int
-		CreatedAt    func(childComplexity int) int
-		HumanID      func(childComplexity int) int
-		Id           func(childComplexity int) int
-		Labels       func(childComplexity int) int
-		LastEdit     func(childComplexity int) int
-		Operations   func(childComplexity int, after *string, before *string, first *int, last *int) int
-		Participants func(childComplexity int, after *string, before *string, first *int, last *int) int
-		Status       func(childComplexity int) int
-		Timeline     func(childComplexity int, after *string, before *string, first *int, last *int) int
-		Title        func(childComplexity int) int
+		Actors               func(childComplexity int, after *string, before *string, first *int, last *int) int
+		Assignees            func(childComplexity int) int
+		Attachments          func(childComplexity int) int
+		Author               func(childComplexity int) int
+		Backlinks            func(childComplexity int) int
+		Comments             func(childComplexity int, after *string, before *string, first *int, last *int) int
+		CreatedAt            func(childComplexity int) int
+		Fields               func(childComplexity int) int
+		HumanID              func(childComplexity int) int
+		Id                   func(childComplexity int) int
+		Labels               func(childComplexity int) int
+		LastEdit             func(childComplexity int) int
+		MergeConflicts       func(childComplexity int) int
+		Operations           func(childComplexity int, after *string, before *string, first *int, last *int) int
+		Participants         func(childComplexity int, after *string, before *string, first *int, last *int) int
+		Relations            func(childComplexity int) int
+		State                func(childComplexity int) int
+		Status               func(childComplexity int) int
+		Timeline             func(childComplexity int, after *string, before *string, first *int, last *int) int
+		Title                func(childComplexity int) int
+		TranslationLanguages func(childComplexity int) int
+		Translations         func(childComplexity int, lang string) int
 	}
 
 	BugConnection struct {
@@ -124,6 +189,35 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	BugField struct {
+		Name  func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
+
+	BugTranslations struct {
+		Comments func(childComplexity int) int
+		Lang     func(childComplexity int) int
+		Title    func(childComplexity int) int
+	}
+
+	ChangeAssigneePayload struct {
+		Bug              func(childComplexity int) int
+		ClientMutationID func(childComplexity int) int
+		Operation        func(childComplexity int) int
+	}
+
+	ChangeFeedEntry struct {
+		BugID     func(childComplexity int) int
+		Operation func(childComplexity int) int
+		Removed   func(childComplexity int) int
+		Seq       func(childComplexity int) int
+	}
+
+	ChangeFeedPage struct {
+		Cursor  func(childComplexity int) int
+		Entries func(childComplexity int) int
+	}
+
 	ChangeLabelPayload struct {
 		Bug              func(childComplexity int) int
 		ClientMutationID func(childComplexity int) int
@@ -137,6 +231,12 @@ type ComplexityRoot struct {
 		Operation        func(childComplexity int) int
 	}
 
+	CodeBlock struct {
+		Code            func(childComplexity int) int
+		Language        func(childComplexity int) int
+		LanguageGuessed func(childComplexity int) int
+	}
+
 	Color struct {
 		B func(childComplexity int) int
 		G func(childComplexity int) int
@@ -144,10 +244,12 @@ type ComplexityRoot struct {
 	}
 
 	Comment struct {
-		Author  func(childComplexity int) int
-		Files   func(childComplexity int) int
-		ID      func(childComplexity int) int
-		Message func(childComplexity int) int
+		Author    func(childComplexity int) int
+		Files     func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Message   func(childComplexity int) int
+		Reactions func(childComplexity int) int
+		Structure func(childComplexity int) int
 	}
 
 	CommentConnection struct {
@@ -167,18 +269,25 @@ type ComplexityRoot struct {
 		Message func(childComplexity int) int
 	}
 
+	CommentTranslation struct {
+		ID      func(childComplexity int) int
+		Message func(childComplexity int) int
+	}
+
 	CreateOperation struct {
 		Author  func(childComplexity int) int
 		Date    func(childComplexity int) int
 		Files   func(childComplexity int) int
 		Id      func(childComplexity int) int
 		Message func(childComplexity int) int
+		Signed  func(childComplexity int) int
 		Title   func(childComplexity int) int
 	}
 
 	CreateTimelineItem struct {
 		Author         func(childComplexity int) int
 		CreatedAt      func(childComplexity int) int
+		Deleted        func(childComplexity int) int
 		Edited         func(childComplexity int) int
 		Files          func(childComplexity int) int
 		History        func(childComplexity int) int
@@ -186,6 +295,9 @@ type ComplexityRoot struct {
 		LastEdit       func(childComplexity int) int
 		Message        func(childComplexity int) int
 		MessageIsEmpty func(childComplexity int) int
+		Reactions      func(childComplexity int) int
+		Redacted       func(childComplexity int) int
+		Structure      func(childComplexity int) int
 	}
 
 	EditCommentOperation struct {
@@ -194,6 +306,7 @@ type ComplexityRoot struct {
 		Files   func(childComplexity int) int
 		Id      func(childComplexity int) int
 		Message func(childComplexity int) int
+		Signed  func(childComplexity int) int
 		Target  func(childComplexity int) int
 	}
 
@@ -209,11 +322,19 @@ type ComplexityRoot struct {
 		Email       func(childComplexity int) int
 		HumanID     func(childComplexity int) int
 		Id          func(childComplexity int) int
+		IsBot       func(childComplexity int) int
 		IsProtected func(childComplexity int) int
 		Login       func(childComplexity int) int
 		Name        func(childComplexity int) int
 	}
 
+	IdentityActivity struct {
+		Authored  func(childComplexity int) int
+		Closed    func(childComplexity int) int
+		Commented func(childComplexity int) int
+		Timeline  func(childComplexity int) int
+	}
+
 	IdentityConnection struct {
 		Edges      func(childComplexity int) int
 		Nodes      func(childComplexity int) int
@@ -227,8 +348,9 @@ type ComplexityRoot struct {
 	}
 
 	Label struct {
-		Color func(childComplexity int) int
-		Name  func(childComplexity int) int
+		Color       func(childComplexity int) int
+		Description func(childComplexity int) int
+		Name        func(childComplexity int) int
 	}
 
 	LabelChangeOperation struct {
@@ -237,6 +359,7 @@ type ComplexityRoot struct {
 		Date    func(childComplexity int) int
 		Id      func(childComplexity int) int
 		Removed func(childComplexity int) int
+		Signed  func(childComplexity int) int
 	}
 
 	LabelChangeResult struct {
@@ -259,20 +382,55 @@ type ComplexityRoot struct {
 		TotalCount func(childComplexity int) int
 	}
 
+	LabelCount struct {
+		Count func(childComplexity int) int
+		Label func(childComplexity int) int
+	}
+
 	LabelEdge struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
 	}
 
+	MarkBugAsReadPayload struct {
+		Bug              func(childComplexity int) int
+		ClientMutationID func(childComplexity int) int
+	}
+
+	MergeConflict struct {
+		Field           func(childComplexity int) int
+		Kept            func(childComplexity int) int
+		KeptValue       func(childComplexity int) int
+		Overridden      func(childComplexity int) int
+		OverriddenValue func(childComplexity int) int
+	}
+
+	MessageStructure struct {
+		CodeBlocks func(childComplexity int) int
+		Links      func(childComplexity int) int
+		Mentions   func(childComplexity int) int
+	}
+
 	Mutation struct {
+		AckChangeFeed       func(childComplexity int, input models.AckChangeFeedInput) int
+		AddAttachment       func(childComplexity int, input models.AddAttachmentInput) int
 		AddComment          func(childComplexity int, input models.AddCommentInput) int
 		AddCommentAndClose  func(childComplexity int, input models.AddCommentAndCloseBugInput) int
 		AddCommentAndReopen func(childComplexity int, input models.AddCommentAndReopenBugInput) int
+		AddReaction         func(childComplexity int, input models.ReactionInput) int
+		AddRelation         func(childComplexity int, input models.RelationInput) int
+		ChangeAssignees     func(childComplexity int, input models.ChangeAssigneeInput) int
 		ChangeLabels        func(childComplexity int, input *models.ChangeLabelInput) int
 		CloseBug            func(childComplexity int, input models.CloseBugInput) int
 		EditComment         func(childComplexity int, input models.EditCommentInput) int
+		MarkBugAsRead       func(childComplexity int, input models.MarkBugAsReadInput) int
 		NewBug              func(childComplexity int, input models.NewBugInput) int
 		OpenBug             func(childComplexity int, input models.OpenBugInput) int
+		RedactComment       func(childComplexity int, input models.RedactCommentInput) int
+		RemoveReaction      func(childComplexity int, input models.ReactionInput) int
+		RemoveRelation      func(childComplexity int, input models.RelationInput) int
+		SetBugState         func(childComplexity int, input models.SetBugStateInput) int
+		SetField            func(childComplexity int, input models.SetFieldInput) int
 		SetTitle            func(childComplexity int, input models.SetTitleInput) int
 	}
 
@@ -311,20 +469,145 @@ type ComplexityRoot struct {
 		Repository func(childComplexity int, ref *string) int
 	}
 
+	ReactionGroup struct {
+		Authors  func(childComplexity int) int
+		Emoji    func(childComplexity int) int
+		Reaction func(childComplexity int) int
+	}
+
+	ReactionOperation struct {
+		Author   func(childComplexity int) int
+		Date     func(childComplexity int) int
+		Id       func(childComplexity int) int
+		Reaction func(childComplexity int) int
+		Remove   func(childComplexity int) int
+		Signed   func(childComplexity int) int
+		Target   func(childComplexity int) int
+	}
+
+	ReactionPayload struct {
+		Bug              func(childComplexity int) int
+		ClientMutationID func(childComplexity int) int
+		Operation        func(childComplexity int) int
+	}
+
+	RedactCommentOperation struct {
+		Author func(childComplexity int) int
+		Date   func(childComplexity int) int
+		Delete func(childComplexity int) int
+		Id     func(childComplexity int) int
+		Signed func(childComplexity int) int
+		Target func(childComplexity int) int
+	}
+
+	RedactCommentPayload struct {
+		Bug              func(childComplexity int) int
+		ClientMutationID func(childComplexity int) int
+		Operation        func(childComplexity int) int
+	}
+
+	Relation struct {
+		Target func(childComplexity int) int
+		Type   func(childComplexity int) int
+	}
+
+	RelationAddOperation struct {
+		Author   func(childComplexity int) int
+		Date     func(childComplexity int) int
+		Id       func(childComplexity int) int
+		Relation func(childComplexity int) int
+		Signed   func(childComplexity int) int
+		Target   func(childComplexity int) int
+	}
+
+	RelationPayload struct {
+		Bug              func(childComplexity int) int
+		ClientMutationID func(childComplexity int) int
+		Operation        func(childComplexity int) int
+	}
+
+	RelationRemoveOperation struct {
+		Author   func(childComplexity int) int
+		Date     func(childComplexity int) int
+		Id       func(childComplexity int) int
+		Relation func(childComplexity int) int
+		Signed   func(childComplexity int) int
+		Target   func(childComplexity int) int
+	}
+
 	Repository struct {
-		AllBugs       func(childComplexity int, after *string, before *string, first *int, last *int, query *string) int
-		AllIdentities func(childComplexity int, after *string, before *string, first *int, last *int) int
-		Bug           func(childComplexity int, prefix string) int
-		Identity      func(childComplexity int, prefix string) int
-		Name          func(childComplexity int) int
-		UserIdentity  func(childComplexity int) int
-		ValidLabels   func(childComplexity int, after *string, before *string, first *int, last *int) int
+		AllBugs          func(childComplexity int, after *string, before *string, first *int, last *int, query *string) int
+		AllIdentities    func(childComplexity int, after *string, before *string, first *int, last *int) int
+		Bug              func(childComplexity int, prefix string) int
+		ChangeFeed       func(childComplexity int, consumer *string, after *int, first *int) int
+		Identity         func(childComplexity int, prefix string) int
+		IdentityActivity func(childComplexity int, prefix string) int
+		Name             func(childComplexity int) int
+		Statistics       func(childComplexity int) int
+		UserIdentity     func(childComplexity int) int
+		ValidLabels      func(childComplexity int, after *string, before *string, first *int, last *int) int
+		Workflow         func(childComplexity int) int
+	}
+
+	RepositoryEvent struct {
+		BugID             func(childComplexity int) int
+		IdentityID        func(childComplexity int) int
+		Kind              func(childComplexity int) int
+		MergedBugIds      func(childComplexity int) int
+		MergedIdentityIds func(childComplexity int) int
+		Remote            func(childComplexity int) int
+	}
+
+	RepositoryStatistics struct {
+		Activity    func(childComplexity int) int
+		ClosedCount func(childComplexity int) int
+		Labels      func(childComplexity int) int
+		OpenCount   func(childComplexity int) int
+	}
+
+	RequestInfoOperation struct {
+		Author func(childComplexity int) int
+		Date   func(childComplexity int) int
+		Id     func(childComplexity int) int
+		Signed func(childComplexity int) int
+	}
+
+	SetAssigneeOperation struct {
+		Added   func(childComplexity int) int
+		Author  func(childComplexity int) int
+		Date    func(childComplexity int) int
+		Id      func(childComplexity int) int
+		Removed func(childComplexity int) int
+		Signed  func(childComplexity int) int
+	}
+
+	SetBugStatePayload struct {
+		Bug              func(childComplexity int) int
+		ClientMutationID func(childComplexity int) int
+		Operation        func(childComplexity int) int
+	}
+
+	SetFieldOperation struct {
+		Author func(childComplexity int) int
+		Date   func(childComplexity int) int
+		Id     func(childComplexity int) int
+		Name   func(childComplexity int) int
+		Signed func(childComplexity int) int
+		Value  func(childComplexity int) int
+	}
+
+	SetFieldPayload struct {
+		Bug              func(childComplexity int) int
+		ClientMutationID func(childComplexity int) int
+		Operation        func(childComplexity int) int
 	}
 
 	SetStatusOperation struct {
 		Author func(childComplexity int) int
 		Date   func(childComplexity int) int
 		Id     func(childComplexity int) int
+		Signed func(childComplexity int) int
+		State  func(childComplexity int) int
 		Status func(childComplexity int) int
 	}
 
@@ -332,6 +615,7 @@ type ComplexityRoot struct {
 		Author func(childComplexity int) int
 		Date   func(childComplexity int) int
 		ID     func(childComplexity int) int
+		State  func(childComplexity int) int
 		Status func(childComplexity int) int
 	}
 
@@ -339,6 +623,7 @@ type ComplexityRoot struct {
 		Author func(childComplexity int) int
 		Date   func(childComplexity int) int
 		Id     func(childComplexity int) int
+		Signed func(childComplexity int) int
 		Title  func(childComplexity int) int
 		Was    func(childComplexity int) int
 	}
@@ -357,6 +642,22 @@ type ComplexityRoot struct {
 		Was    func(childComplexity int) int
 	}
 
+	Subscription struct {
+		RepositoryEvents func(childComplexity int, ref *string, kinds []models.RepositoryEventKind) int
+	}
+
+	SyncConflictOperation struct {
+		Author func(childComplexity int) int
+		Bridge func(childComplexity int) int
+		Date   func(childComplexity int) int
+		Field  func(childComplexity int) int
+		Id     func(childComplexity int) int
+		Local  func(childComplexity int) int
+		Remote func(childComplexity int) int
+		Signed func(childComplexity int) int
+		Winner func(childComplexity int) int
+	}
+
 	TimelineItemConnection struct {
 		Edges      func(childComplexity int) int
 		Nodes      func(childComplexity int) int
@@ -368,6 +669,12 @@ type ComplexityRoot struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
 	}
+
+	WorkflowState struct {
+		Name   func(childComplexity int) int
+		Next   func(childComplexity int) int
+		Status func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -385,6 +692,125 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AckChangeFeedPayload.clientMutationId":
+		if e.complexity.AckChangeFeedPayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.AckChangeFeedPayload.ClientMutationID(childComplexity), true
+
+	case "AckChangeFeedPayload.cursor":
+		if e.complexity.AckChangeFeedPayload.Cursor == nil {
+			break
+		}
+
+		return e.complexity.AckChangeFeedPayload.Cursor(childComplexity), true
+
+	case "ActivityCount.authored":
+		if e.complexity.ActivityCount.Authored == nil {
+			break
+		}
+
+		return e.complexity.ActivityCount.Authored(childComplexity), true
+
+	case "ActivityCount.closed":
+		if e.complexity.ActivityCount.Closed == nil {
+			break
+		}
+
+		return e.complexity.ActivityCount.Closed(childComplexity), true
+
+	case "ActivityCount.commented":
+		if e.complexity.ActivityCount.Commented == nil {
+			break
+		}
+
+		return e.complexity.ActivityCount.Commented(childComplexity), true
+
+	case "ActivityCount.month":
+		if e.complexity.ActivityCount.Month == nil {
+			break
+		}
+
+		return e.complexity.ActivityCount.Month(childComplexity), true
+
+	case "AddAttachmentOperation.author":
+		if e.complexity.AddAttachmentOperation.Author == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentOperation.Author(childComplexity), true
+
+	case "AddAttachmentOperation.contentType":
+		if e.complexity.AddAttachmentOperation.ContentType == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentOperation.ContentType(childComplexity), true
+
+	case "AddAttachmentOperation.date":
+		if e.complexity.AddAttachmentOperation.Date == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentOperation.Date(childComplexity), true
+
+	case "AddAttachmentOperation.hash":
+		if e.complexity.AddAttachmentOperation.Hash == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentOperation.Hash(childComplexity), true
+
+	case "AddAttachmentOperation.id":
+		if e.complexity.AddAttachmentOperation.Id == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentOperation.Id(childComplexity), true
+
+	case "AddAttachmentOperation.name":
+		if e.complexity.AddAttachmentOperation.Name == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentOperation.Name(childComplexity), true
+
+	case "AddAttachmentOperation.signed":
+		if e.complexity.AddAttachmentOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentOperation.Signed(childComplexity), true
+
+	case "AddAttachmentOperation.size":
+		if e.complexity.AddAttachmentOperation.Size == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentOperation.Size(childComplexity), true
+
+	case "AddAttachmentPayload.bug":
+		if e.complexity.AddAttachmentPayload.Bug == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentPayload.Bug(childComplexity), true
+
+	case "AddAttachmentPayload.clientMutationId":
+		if e.complexity.AddAttachmentPayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentPayload.ClientMutationID(childComplexity), true
+
+	case "AddAttachmentPayload.operation":
+		if e.complexity.AddAttachmentPayload.Operation == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentPayload.Operation(childComplexity), true
+
 	case "AddCommentAndCloseBugPayload.bug":
 		if e.complexity.AddCommentAndCloseBugPayload.Bug == nil {
 			break
@@ -476,6 +902,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AddCommentOperation.Message(childComplexity), true
 
+	case "AddCommentOperation.signed":
+		if e.complexity.AddCommentOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.AddCommentOperation.Signed(childComplexity), true
+
 	case "AddCommentPayload.bug":
 		if e.complexity.AddCommentPayload.Bug == nil {
 			break
@@ -511,6 +944,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AddCommentTimelineItem.CreatedAt(childComplexity), true
 
+	case "AddCommentTimelineItem.deleted":
+		if e.complexity.AddCommentTimelineItem.Deleted == nil {
+			break
+		}
+
+		return e.complexity.AddCommentTimelineItem.Deleted(childComplexity), true
+
 	case "AddCommentTimelineItem.edited":
 		if e.complexity.AddCommentTimelineItem.Edited == nil {
 			break
@@ -560,6 +1000,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AddCommentTimelineItem.MessageIsEmpty(childComplexity), true
 
+	case "AddCommentTimelineItem.reactions":
+		if e.complexity.AddCommentTimelineItem.Reactions == nil {
+			break
+		}
+
+		return e.complexity.AddCommentTimelineItem.Reactions(childComplexity), true
+
+	case "AddCommentTimelineItem.redacted":
+		if e.complexity.AddCommentTimelineItem.Redacted == nil {
+			break
+		}
+
+		return e.complexity.AddCommentTimelineItem.Redacted(childComplexity), true
+
+	case "AddCommentTimelineItem.structure":
+		if e.complexity.AddCommentTimelineItem.Structure == nil {
+			break
+		}
+
+		return e.complexity.AddCommentTimelineItem.Structure(childComplexity), true
+
+	case "Attachment.author":
+		if e.complexity.Attachment.Author == nil {
+			break
+		}
+
+		return e.complexity.Attachment.Author(childComplexity), true
+
+	case "Attachment.contentType":
+		if e.complexity.Attachment.ContentType == nil {
+			break
+		}
+
+		return e.complexity.Attachment.ContentType(childComplexity), true
+
+	case "Attachment.date":
+		if e.complexity.Attachment.Date == nil {
+			break
+		}
+
+		return e.complexity.Attachment.Date(childComplexity), true
+
+	case "Attachment.hash":
+		if e.complexity.Attachment.Hash == nil {
+			break
+		}
+
+		return e.complexity.Attachment.Hash(childComplexity), true
+
+	case "Attachment.name":
+		if e.complexity.Attachment.Name == nil {
+			break
+		}
+
+		return e.complexity.Attachment.Name(childComplexity), true
+
+	case "Attachment.size":
+		if e.complexity.Attachment.Size == nil {
+			break
+		}
+
+		return e.complexity.Attachment.Size(childComplexity), true
+
 	case "Bug.actors":
 		if e.complexity.Bug.Actors == nil {
 			break
@@ -572,6 +1075,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Bug.Actors(childComplexity, args["after"].(*string), args["before"].(*string), args["first"].(*int), args["last"].(*int)), true
 
+	case "Bug.assignees":
+		if e.complexity.Bug.Assignees == nil {
+			break
+		}
+
+		return e.complexity.Bug.Assignees(childComplexity), true
+
+	case "Bug.attachments":
+		if e.complexity.Bug.Attachments == nil {
+			break
+		}
+
+		return e.complexity.Bug.Attachments(childComplexity), true
+
 	case "Bug.author":
 		if e.complexity.Bug.Author == nil {
 			break
@@ -579,6 +1096,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Bug.Author(childComplexity), true
 
+	case "Bug.backlinks":
+		if e.complexity.Bug.Backlinks == nil {
+			break
+		}
+
+		return e.complexity.Bug.Backlinks(childComplexity), true
+
 	case "Bug.comments":
 		if e.complexity.Bug.Comments == nil {
 			break
@@ -598,6 +1122,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Bug.CreatedAt(childComplexity), true
 
+	case "Bug.fields":
+		if e.complexity.Bug.Fields == nil {
+			break
+		}
+
+		return e.complexity.Bug.Fields(childComplexity), true
+
 	case "Bug.humanId":
 		if e.complexity.Bug.HumanID == nil {
 			break
@@ -626,8 +1157,15 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Bug.LastEdit(childComplexity), true
 
-	case "Bug.operations":
-		if e.complexity.Bug.Operations == nil {
+	case "Bug.mergeConflicts":
+		if e.complexity.Bug.MergeConflicts == nil {
+			break
+		}
+
+		return e.complexity.Bug.MergeConflicts(childComplexity), true
+
+	case "Bug.operations":
+		if e.complexity.Bug.Operations == nil {
 			break
 		}
 
@@ -650,6 +1188,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Bug.Participants(childComplexity, args["after"].(*string), args["before"].(*string), args["first"].(*int), args["last"].(*int)), true
 
+	case "Bug.relations":
+		if e.complexity.Bug.Relations == nil {
+			break
+		}
+
+		return e.complexity.Bug.Relations(childComplexity), true
+
+	case "Bug.state":
+		if e.complexity.Bug.State == nil {
+			break
+		}
+
+		return e.complexity.Bug.State(childComplexity), true
+
 	case "Bug.status":
 		if e.complexity.Bug.Status == nil {
 			break
@@ -676,6 +1228,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Bug.Title(childComplexity), true
 
+	case "Bug.translationLanguages":
+		if e.complexity.Bug.TranslationLanguages == nil {
+			break
+		}
+
+		return e.complexity.Bug.TranslationLanguages(childComplexity), true
+
+	case "Bug.translations":
+		if e.complexity.Bug.Translations == nil {
+			break
+		}
+
+		args, err := ec.field_Bug_translations_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Bug.Translations(childComplexity, args["lang"].(string)), true
+
 	case "BugConnection.edges":
 		if e.complexity.BugConnection.Edges == nil {
 			break
@@ -718,6 +1289,104 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.BugEdge.Node(childComplexity), true
 
+	case "BugField.name":
+		if e.complexity.BugField.Name == nil {
+			break
+		}
+
+		return e.complexity.BugField.Name(childComplexity), true
+
+	case "BugField.value":
+		if e.complexity.BugField.Value == nil {
+			break
+		}
+
+		return e.complexity.BugField.Value(childComplexity), true
+
+	case "BugTranslations.comments":
+		if e.complexity.BugTranslations.Comments == nil {
+			break
+		}
+
+		return e.complexity.BugTranslations.Comments(childComplexity), true
+
+	case "BugTranslations.lang":
+		if e.complexity.BugTranslations.Lang == nil {
+			break
+		}
+
+		return e.complexity.BugTranslations.Lang(childComplexity), true
+
+	case "BugTranslations.title":
+		if e.complexity.BugTranslations.Title == nil {
+			break
+		}
+
+		return e.complexity.BugTranslations.Title(childComplexity), true
+
+	case "ChangeAssigneePayload.bug":
+		if e.complexity.ChangeAssigneePayload.Bug == nil {
+			break
+		}
+
+		return e.complexity.ChangeAssigneePayload.Bug(childComplexity), true
+
+	case "ChangeAssigneePayload.clientMutationId":
+		if e.complexity.ChangeAssigneePayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.ChangeAssigneePayload.ClientMutationID(childComplexity), true
+
+	case "ChangeAssigneePayload.operation":
+		if e.complexity.ChangeAssigneePayload.Operation == nil {
+			break
+		}
+
+		return e.complexity.ChangeAssigneePayload.Operation(childComplexity), true
+
+	case "ChangeFeedEntry.bugId":
+		if e.complexity.ChangeFeedEntry.BugID == nil {
+			break
+		}
+
+		return e.complexity.ChangeFeedEntry.BugID(childComplexity), true
+
+	case "ChangeFeedEntry.operation":
+		if e.complexity.ChangeFeedEntry.Operation == nil {
+			break
+		}
+
+		return e.complexity.ChangeFeedEntry.Operation(childComplexity), true
+
+	case "ChangeFeedEntry.removed":
+		if e.complexity.ChangeFeedEntry.Removed == nil {
+			break
+		}
+
+		return e.complexity.ChangeFeedEntry.Removed(childComplexity), true
+
+	case "ChangeFeedEntry.seq":
+		if e.complexity.ChangeFeedEntry.Seq == nil {
+			break
+		}
+
+		return e.complexity.ChangeFeedEntry.Seq(childComplexity), true
+
+	case "ChangeFeedPage.cursor":
+		if e.complexity.ChangeFeedPage.Cursor == nil {
+			break
+		}
+
+		return e.complexity.ChangeFeedPage.Cursor(childComplexity), true
+
+	case "ChangeFeedPage.entries":
+		if e.complexity.ChangeFeedPage.Entries == nil {
+			break
+		}
+
+		return e.complexity.ChangeFeedPage.Entries(childComplexity), true
+
 	case "ChangeLabelPayload.bug":
 		if e.complexity.ChangeLabelPayload.Bug == nil {
 			break
@@ -767,6 +1436,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CloseBugPayload.Operation(childComplexity), true
 
+	case "CodeBlock.code":
+		if e.complexity.CodeBlock.Code == nil {
+			break
+		}
+
+		return e.complexity.CodeBlock.Code(childComplexity), true
+
+	case "CodeBlock.language":
+		if e.complexity.CodeBlock.Language == nil {
+			break
+		}
+
+		return e.complexity.CodeBlock.Language(childComplexity), true
+
+	case "CodeBlock.languageGuessed":
+		if e.complexity.CodeBlock.LanguageGuessed == nil {
+			break
+		}
+
+		return e.complexity.CodeBlock.LanguageGuessed(childComplexity), true
+
 	case "Color.B":
 		if e.complexity.Color.B == nil {
 			break
@@ -816,6 +1506,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Comment.Message(childComplexity), true
 
+	case "Comment.reactions":
+		if e.complexity.Comment.Reactions == nil {
+			break
+		}
+
+		return e.complexity.Comment.Reactions(childComplexity), true
+
+	case "Comment.structure":
+		if e.complexity.Comment.Structure == nil {
+			break
+		}
+
+		return e.complexity.Comment.Structure(childComplexity), true
+
 	case "CommentConnection.edges":
 		if e.complexity.CommentConnection.Edges == nil {
 			break
@@ -872,6 +1576,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CommentHistoryStep.Message(childComplexity), true
 
+	case "CommentTranslation.id":
+		if e.complexity.CommentTranslation.ID == nil {
+			break
+		}
+
+		return e.complexity.CommentTranslation.ID(childComplexity), true
+
+	case "CommentTranslation.message":
+		if e.complexity.CommentTranslation.Message == nil {
+			break
+		}
+
+		return e.complexity.CommentTranslation.Message(childComplexity), true
+
 	case "CreateOperation.author":
 		if e.complexity.CreateOperation.Author == nil {
 			break
@@ -907,6 +1625,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CreateOperation.Message(childComplexity), true
 
+	case "CreateOperation.signed":
+		if e.complexity.CreateOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.CreateOperation.Signed(childComplexity), true
+
 	case "CreateOperation.title":
 		if e.complexity.CreateOperation.Title == nil {
 			break
@@ -928,6 +1653,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CreateTimelineItem.CreatedAt(childComplexity), true
 
+	case "CreateTimelineItem.deleted":
+		if e.complexity.CreateTimelineItem.Deleted == nil {
+			break
+		}
+
+		return e.complexity.CreateTimelineItem.Deleted(childComplexity), true
+
 	case "CreateTimelineItem.edited":
 		if e.complexity.CreateTimelineItem.Edited == nil {
 			break
@@ -977,6 +1709,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CreateTimelineItem.MessageIsEmpty(childComplexity), true
 
+	case "CreateTimelineItem.reactions":
+		if e.complexity.CreateTimelineItem.Reactions == nil {
+			break
+		}
+
+		return e.complexity.CreateTimelineItem.Reactions(childComplexity), true
+
+	case "CreateTimelineItem.redacted":
+		if e.complexity.CreateTimelineItem.Redacted == nil {
+			break
+		}
+
+		return e.complexity.CreateTimelineItem.Redacted(childComplexity), true
+
+	case "CreateTimelineItem.structure":
+		if e.complexity.CreateTimelineItem.Structure == nil {
+			break
+		}
+
+		return e.complexity.CreateTimelineItem.Structure(childComplexity), true
+
 	case "EditCommentOperation.author":
 		if e.complexity.EditCommentOperation.Author == nil {
 			break
@@ -1012,6 +1765,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.EditCommentOperation.Message(childComplexity), true
 
+	case "EditCommentOperation.signed":
+		if e.complexity.EditCommentOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.EditCommentOperation.Signed(childComplexity), true
+
 	case "EditCommentOperation.target":
 		if e.complexity.EditCommentOperation.Target == nil {
 			break
@@ -1075,6 +1835,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Identity.Id(childComplexity), true
 
+	case "Identity.isBot":
+		if e.complexity.Identity.IsBot == nil {
+			break
+		}
+
+		return e.complexity.Identity.IsBot(childComplexity), true
+
 	case "Identity.isProtected":
 		if e.complexity.Identity.IsProtected == nil {
 			break
@@ -1096,6 +1863,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Identity.Name(childComplexity), true
 
+	case "IdentityActivity.authored":
+		if e.complexity.IdentityActivity.Authored == nil {
+			break
+		}
+
+		return e.complexity.IdentityActivity.Authored(childComplexity), true
+
+	case "IdentityActivity.closed":
+		if e.complexity.IdentityActivity.Closed == nil {
+			break
+		}
+
+		return e.complexity.IdentityActivity.Closed(childComplexity), true
+
+	case "IdentityActivity.commented":
+		if e.complexity.IdentityActivity.Commented == nil {
+			break
+		}
+
+		return e.complexity.IdentityActivity.Commented(childComplexity), true
+
+	case "IdentityActivity.timeline":
+		if e.complexity.IdentityActivity.Timeline == nil {
+			break
+		}
+
+		return e.complexity.IdentityActivity.Timeline(childComplexity), true
+
 	case "IdentityConnection.edges":
 		if e.complexity.IdentityConnection.Edges == nil {
 			break
@@ -1145,6 +1940,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Label.Color(childComplexity), true
 
+	case "Label.description":
+		if e.complexity.Label.Description == nil {
+			break
+		}
+
+		return e.complexity.Label.Description(childComplexity), true
+
 	case "Label.name":
 		if e.complexity.Label.Name == nil {
 			break
@@ -1187,6 +1989,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LabelChangeOperation.Removed(childComplexity), true
 
+	case "LabelChangeOperation.signed":
+		if e.complexity.LabelChangeOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.LabelChangeOperation.Signed(childComplexity), true
+
 	case "LabelChangeResult.label":
 		if e.complexity.LabelChangeResult.Label == nil {
 			break
@@ -1264,6 +2073,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LabelConnection.TotalCount(childComplexity), true
 
+	case "LabelCount.count":
+		if e.complexity.LabelCount.Count == nil {
+			break
+		}
+
+		return e.complexity.LabelCount.Count(childComplexity), true
+
+	case "LabelCount.label":
+		if e.complexity.LabelCount.Label == nil {
+			break
+		}
+
+		return e.complexity.LabelCount.Label(childComplexity), true
+
 	case "LabelEdge.cursor":
 		if e.complexity.LabelEdge.Cursor == nil {
 			break
@@ -1278,6 +2101,100 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LabelEdge.Node(childComplexity), true
 
+	case "MarkBugAsReadPayload.bug":
+		if e.complexity.MarkBugAsReadPayload.Bug == nil {
+			break
+		}
+
+		return e.complexity.MarkBugAsReadPayload.Bug(childComplexity), true
+
+	case "MarkBugAsReadPayload.clientMutationId":
+		if e.complexity.MarkBugAsReadPayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.MarkBugAsReadPayload.ClientMutationID(childComplexity), true
+
+	case "MergeConflict.field":
+		if e.complexity.MergeConflict.Field == nil {
+			break
+		}
+
+		return e.complexity.MergeConflict.Field(childComplexity), true
+
+	case "MergeConflict.kept":
+		if e.complexity.MergeConflict.Kept == nil {
+			break
+		}
+
+		return e.complexity.MergeConflict.Kept(childComplexity), true
+
+	case "MergeConflict.keptValue":
+		if e.complexity.MergeConflict.KeptValue == nil {
+			break
+		}
+
+		return e.complexity.MergeConflict.KeptValue(childComplexity), true
+
+	case "MergeConflict.overridden":
+		if e.complexity.MergeConflict.Overridden == nil {
+			break
+		}
+
+		return e.complexity.MergeConflict.Overridden(childComplexity), true
+
+	case "MergeConflict.overriddenValue":
+		if e.complexity.MergeConflict.OverriddenValue == nil {
+			break
+		}
+
+		return e.complexity.MergeConflict.OverriddenValue(childComplexity), true
+
+	case "MessageStructure.codeBlocks":
+		if e.complexity.MessageStructure.CodeBlocks == nil {
+			break
+		}
+
+		return e.complexity.MessageStructure.CodeBlocks(childComplexity), true
+
+	case "MessageStructure.links":
+		if e.complexity.MessageStructure.Links == nil {
+			break
+		}
+
+		return e.complexity.MessageStructure.Links(childComplexity), true
+
+	case "MessageStructure.mentions":
+		if e.complexity.MessageStructure.Mentions == nil {
+			break
+		}
+
+		return e.complexity.MessageStructure.Mentions(childComplexity), true
+
+	case "Mutation.ackChangeFeed":
+		if e.complexity.Mutation.AckChangeFeed == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ackChangeFeed_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AckChangeFeed(childComplexity, args["input"].(models.AckChangeFeedInput)), true
+
+	case "Mutation.addAttachment":
+		if e.complexity.Mutation.AddAttachment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addAttachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddAttachment(childComplexity, args["input"].(models.AddAttachmentInput)), true
+
 	case "Mutation.addComment":
 		if e.complexity.Mutation.AddComment == nil {
 			break
@@ -1314,6 +2231,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddCommentAndReopen(childComplexity, args["input"].(models.AddCommentAndReopenBugInput)), true
 
+	case "Mutation.addReaction":
+		if e.complexity.Mutation.AddReaction == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addReaction_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddReaction(childComplexity, args["input"].(models.ReactionInput)), true
+
+	case "Mutation.addRelation":
+		if e.complexity.Mutation.AddRelation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addRelation_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddRelation(childComplexity, args["input"].(models.RelationInput)), true
+
+	case "Mutation.changeAssignees":
+		if e.complexity.Mutation.ChangeAssignees == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_changeAssignees_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ChangeAssignees(childComplexity, args["input"].(models.ChangeAssigneeInput)), true
+
 	case "Mutation.changeLabels":
 		if e.complexity.Mutation.ChangeLabels == nil {
 			break
@@ -1350,20 +2303,32 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.EditComment(childComplexity, args["input"].(models.EditCommentInput)), true
 
-	case "Mutation.newBug":
-		if e.complexity.Mutation.NewBug == nil {
+	case "Mutation.markBugAsRead":
+		if e.complexity.Mutation.MarkBugAsRead == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_newBug_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_markBugAsRead_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.NewBug(childComplexity, args["input"].(models.NewBugInput)), true
+		return e.complexity.Mutation.MarkBugAsRead(childComplexity, args["input"].(models.MarkBugAsReadInput)), true
 
-	case "Mutation.openBug":
-		if e.complexity.Mutation.OpenBug == nil {
+	case "Mutation.newBug":
+		if e.complexity.Mutation.NewBug == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_newBug_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.NewBug(childComplexity, args["input"].(models.NewBugInput)), true
+
+	case "Mutation.openBug":
+		if e.complexity.Mutation.OpenBug == nil {
 			break
 		}
 
@@ -1374,6 +2339,66 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.OpenBug(childComplexity, args["input"].(models.OpenBugInput)), true
 
+	case "Mutation.redactComment":
+		if e.complexity.Mutation.RedactComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_redactComment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RedactComment(childComplexity, args["input"].(models.RedactCommentInput)), true
+
+	case "Mutation.removeReaction":
+		if e.complexity.Mutation.RemoveReaction == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeReaction_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveReaction(childComplexity, args["input"].(models.ReactionInput)), true
+
+	case "Mutation.removeRelation":
+		if e.complexity.Mutation.RemoveRelation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeRelation_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveRelation(childComplexity, args["input"].(models.RelationInput)), true
+
+	case "Mutation.setBugState":
+		if e.complexity.Mutation.SetBugState == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setBugState_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetBugState(childComplexity, args["input"].(models.SetBugStateInput)), true
+
+	case "Mutation.setField":
+		if e.complexity.Mutation.SetField == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setField_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetField(childComplexity, args["input"].(models.SetFieldInput)), true
+
 	case "Mutation.setTitle":
 		if e.complexity.Mutation.SetTitle == nil {
 			break
@@ -1510,6 +2535,279 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Repository(childComplexity, args["ref"].(*string)), true
 
+	case "ReactionGroup.authors":
+		if e.complexity.ReactionGroup.Authors == nil {
+			break
+		}
+
+		return e.complexity.ReactionGroup.Authors(childComplexity), true
+
+	case "ReactionGroup.emoji":
+		if e.complexity.ReactionGroup.Emoji == nil {
+			break
+		}
+
+		return e.complexity.ReactionGroup.Emoji(childComplexity), true
+
+	case "ReactionGroup.reaction":
+		if e.complexity.ReactionGroup.Reaction == nil {
+			break
+		}
+
+		return e.complexity.ReactionGroup.Reaction(childComplexity), true
+
+	case "ReactionOperation.author":
+		if e.complexity.ReactionOperation.Author == nil {
+			break
+		}
+
+		return e.complexity.ReactionOperation.Author(childComplexity), true
+
+	case "ReactionOperation.date":
+		if e.complexity.ReactionOperation.Date == nil {
+			break
+		}
+
+		return e.complexity.ReactionOperation.Date(childComplexity), true
+
+	case "ReactionOperation.id":
+		if e.complexity.ReactionOperation.Id == nil {
+			break
+		}
+
+		return e.complexity.ReactionOperation.Id(childComplexity), true
+
+	case "ReactionOperation.reaction":
+		if e.complexity.ReactionOperation.Reaction == nil {
+			break
+		}
+
+		return e.complexity.ReactionOperation.Reaction(childComplexity), true
+
+	case "ReactionOperation.remove":
+		if e.complexity.ReactionOperation.Remove == nil {
+			break
+		}
+
+		return e.complexity.ReactionOperation.Remove(childComplexity), true
+
+	case "ReactionOperation.signed":
+		if e.complexity.ReactionOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.ReactionOperation.Signed(childComplexity), true
+
+	case "ReactionOperation.target":
+		if e.complexity.ReactionOperation.Target == nil {
+			break
+		}
+
+		return e.complexity.ReactionOperation.Target(childComplexity), true
+
+	case "ReactionPayload.bug":
+		if e.complexity.ReactionPayload.Bug == nil {
+			break
+		}
+
+		return e.complexity.ReactionPayload.Bug(childComplexity), true
+
+	case "ReactionPayload.clientMutationId":
+		if e.complexity.ReactionPayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.ReactionPayload.ClientMutationID(childComplexity), true
+
+	case "ReactionPayload.operation":
+		if e.complexity.ReactionPayload.Operation == nil {
+			break
+		}
+
+		return e.complexity.ReactionPayload.Operation(childComplexity), true
+
+	case "RedactCommentOperation.author":
+		if e.complexity.RedactCommentOperation.Author == nil {
+			break
+		}
+
+		return e.complexity.RedactCommentOperation.Author(childComplexity), true
+
+	case "RedactCommentOperation.date":
+		if e.complexity.RedactCommentOperation.Date == nil {
+			break
+		}
+
+		return e.complexity.RedactCommentOperation.Date(childComplexity), true
+
+	case "RedactCommentOperation.delete":
+		if e.complexity.RedactCommentOperation.Delete == nil {
+			break
+		}
+
+		return e.complexity.RedactCommentOperation.Delete(childComplexity), true
+
+	case "RedactCommentOperation.id":
+		if e.complexity.RedactCommentOperation.Id == nil {
+			break
+		}
+
+		return e.complexity.RedactCommentOperation.Id(childComplexity), true
+
+	case "RedactCommentOperation.signed":
+		if e.complexity.RedactCommentOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.RedactCommentOperation.Signed(childComplexity), true
+
+	case "RedactCommentOperation.target":
+		if e.complexity.RedactCommentOperation.Target == nil {
+			break
+		}
+
+		return e.complexity.RedactCommentOperation.Target(childComplexity), true
+
+	case "RedactCommentPayload.bug":
+		if e.complexity.RedactCommentPayload.Bug == nil {
+			break
+		}
+
+		return e.complexity.RedactCommentPayload.Bug(childComplexity), true
+
+	case "RedactCommentPayload.clientMutationId":
+		if e.complexity.RedactCommentPayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.RedactCommentPayload.ClientMutationID(childComplexity), true
+
+	case "RedactCommentPayload.operation":
+		if e.complexity.RedactCommentPayload.Operation == nil {
+			break
+		}
+
+		return e.complexity.RedactCommentPayload.Operation(childComplexity), true
+
+	case "Relation.target":
+		if e.complexity.Relation.Target == nil {
+			break
+		}
+
+		return e.complexity.Relation.Target(childComplexity), true
+
+	case "Relation.type":
+		if e.complexity.Relation.Type == nil {
+			break
+		}
+
+		return e.complexity.Relation.Type(childComplexity), true
+
+	case "RelationAddOperation.author":
+		if e.complexity.RelationAddOperation.Author == nil {
+			break
+		}
+
+		return e.complexity.RelationAddOperation.Author(childComplexity), true
+
+	case "RelationAddOperation.date":
+		if e.complexity.RelationAddOperation.Date == nil {
+			break
+		}
+
+		return e.complexity.RelationAddOperation.Date(childComplexity), true
+
+	case "RelationAddOperation.id":
+		if e.complexity.RelationAddOperation.Id == nil {
+			break
+		}
+
+		return e.complexity.RelationAddOperation.Id(childComplexity), true
+
+	case "RelationAddOperation.relation":
+		if e.complexity.RelationAddOperation.Relation == nil {
+			break
+		}
+
+		return e.complexity.RelationAddOperation.Relation(childComplexity), true
+
+	case "RelationAddOperation.signed":
+		if e.complexity.RelationAddOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.RelationAddOperation.Signed(childComplexity), true
+
+	case "RelationAddOperation.target":
+		if e.complexity.RelationAddOperation.Target == nil {
+			break
+		}
+
+		return e.complexity.RelationAddOperation.Target(childComplexity), true
+
+	case "RelationPayload.bug":
+		if e.complexity.RelationPayload.Bug == nil {
+			break
+		}
+
+		return e.complexity.RelationPayload.Bug(childComplexity), true
+
+	case "RelationPayload.clientMutationId":
+		if e.complexity.RelationPayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.RelationPayload.ClientMutationID(childComplexity), true
+
+	case "RelationPayload.operation":
+		if e.complexity.RelationPayload.Operation == nil {
+			break
+		}
+
+		return e.complexity.RelationPayload.Operation(childComplexity), true
+
+	case "RelationRemoveOperation.author":
+		if e.complexity.RelationRemoveOperation.Author == nil {
+			break
+		}
+
+		return e.complexity.RelationRemoveOperation.Author(childComplexity), true
+
+	case "RelationRemoveOperation.date":
+		if e.complexity.RelationRemoveOperation.Date == nil {
+			break
+		}
+
+		return e.complexity.RelationRemoveOperation.Date(childComplexity), true
+
+	case "RelationRemoveOperation.id":
+		if e.complexity.RelationRemoveOperation.Id == nil {
+			break
+		}
+
+		return e.complexity.RelationRemoveOperation.Id(childComplexity), true
+
+	case "RelationRemoveOperation.relation":
+		if e.complexity.RelationRemoveOperation.Relation == nil {
+			break
+		}
+
+		return e.complexity.RelationRemoveOperation.Relation(childComplexity), true
+
+	case "RelationRemoveOperation.signed":
+		if e.complexity.RelationRemoveOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.RelationRemoveOperation.Signed(childComplexity), true
+
+	case "RelationRemoveOperation.target":
+		if e.complexity.RelationRemoveOperation.Target == nil {
+			break
+		}
+
+		return e.complexity.RelationRemoveOperation.Target(childComplexity), true
+
 	case "Repository.allBugs":
 		if e.complexity.Repository.AllBugs == nil {
 			break
@@ -1546,6 +2844,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Repository.Bug(childComplexity, args["prefix"].(string)), true
 
+	case "Repository.changeFeed":
+		if e.complexity.Repository.ChangeFeed == nil {
+			break
+		}
+
+		args, err := ec.field_Repository_changeFeed_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Repository.ChangeFeed(childComplexity, args["consumer"].(*string), args["after"].(*int), args["first"].(*int)), true
+
 	case "Repository.identity":
 		if e.complexity.Repository.Identity == nil {
 			break
@@ -1558,6 +2868,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Repository.Identity(childComplexity, args["prefix"].(string)), true
 
+	case "Repository.identityActivity":
+		if e.complexity.Repository.IdentityActivity == nil {
+			break
+		}
+
+		args, err := ec.field_Repository_identityActivity_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Repository.IdentityActivity(childComplexity, args["prefix"].(string)), true
+
 	case "Repository.name":
 		if e.complexity.Repository.Name == nil {
 			break
@@ -1565,6 +2887,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Repository.Name(childComplexity), true
 
+	case "Repository.statistics":
+		if e.complexity.Repository.Statistics == nil {
+			break
+		}
+
+		return e.complexity.Repository.Statistics(childComplexity), true
+
 	case "Repository.userIdentity":
 		if e.complexity.Repository.UserIdentity == nil {
 			break
@@ -1584,6 +2913,237 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Repository.ValidLabels(childComplexity, args["after"].(*string), args["before"].(*string), args["first"].(*int), args["last"].(*int)), true
 
+	case "Repository.workflow":
+		if e.complexity.Repository.Workflow == nil {
+			break
+		}
+
+		return e.complexity.Repository.Workflow(childComplexity), true
+
+	case "RepositoryEvent.bugId":
+		if e.complexity.RepositoryEvent.BugID == nil {
+			break
+		}
+
+		return e.complexity.RepositoryEvent.BugID(childComplexity), true
+
+	case "RepositoryEvent.identityId":
+		if e.complexity.RepositoryEvent.IdentityID == nil {
+			break
+		}
+
+		return e.complexity.RepositoryEvent.IdentityID(childComplexity), true
+
+	case "RepositoryEvent.kind":
+		if e.complexity.RepositoryEvent.Kind == nil {
+			break
+		}
+
+		return e.complexity.RepositoryEvent.Kind(childComplexity), true
+
+	case "RepositoryEvent.mergedBugIds":
+		if e.complexity.RepositoryEvent.MergedBugIds == nil {
+			break
+		}
+
+		return e.complexity.RepositoryEvent.MergedBugIds(childComplexity), true
+
+	case "RepositoryEvent.mergedIdentityIds":
+		if e.complexity.RepositoryEvent.MergedIdentityIds == nil {
+			break
+		}
+
+		return e.complexity.RepositoryEvent.MergedIdentityIds(childComplexity), true
+
+	case "RepositoryEvent.remote":
+		if e.complexity.RepositoryEvent.Remote == nil {
+			break
+		}
+
+		return e.complexity.RepositoryEvent.Remote(childComplexity), true
+
+	case "RepositoryStatistics.activity":
+		if e.complexity.RepositoryStatistics.Activity == nil {
+			break
+		}
+
+		return e.complexity.RepositoryStatistics.Activity(childComplexity), true
+
+	case "RepositoryStatistics.closedCount":
+		if e.complexity.RepositoryStatistics.ClosedCount == nil {
+			break
+		}
+
+		return e.complexity.RepositoryStatistics.ClosedCount(childComplexity), true
+
+	case "RepositoryStatistics.labels":
+		if e.complexity.RepositoryStatistics.Labels == nil {
+			break
+		}
+
+		return e.complexity.RepositoryStatistics.Labels(childComplexity), true
+
+	case "RepositoryStatistics.openCount":
+		if e.complexity.RepositoryStatistics.OpenCount == nil {
+			break
+		}
+
+		return e.complexity.RepositoryStatistics.OpenCount(childComplexity), true
+
+	case "RequestInfoOperation.author":
+		if e.complexity.RequestInfoOperation.Author == nil {
+			break
+		}
+
+		return e.complexity.RequestInfoOperation.Author(childComplexity), true
+
+	case "RequestInfoOperation.date":
+		if e.complexity.RequestInfoOperation.Date == nil {
+			break
+		}
+
+		return e.complexity.RequestInfoOperation.Date(childComplexity), true
+
+	case "RequestInfoOperation.id":
+		if e.complexity.RequestInfoOperation.Id == nil {
+			break
+		}
+
+		return e.complexity.RequestInfoOperation.Id(childComplexity), true
+
+	case "RequestInfoOperation.signed":
+		if e.complexity.RequestInfoOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.RequestInfoOperation.Signed(childComplexity), true
+
+	case "SetAssigneeOperation.added":
+		if e.complexity.SetAssigneeOperation.Added == nil {
+			break
+		}
+
+		return e.complexity.SetAssigneeOperation.Added(childComplexity), true
+
+	case "SetAssigneeOperation.author":
+		if e.complexity.SetAssigneeOperation.Author == nil {
+			break
+		}
+
+		return e.complexity.SetAssigneeOperation.Author(childComplexity), true
+
+	case "SetAssigneeOperation.date":
+		if e.complexity.SetAssigneeOperation.Date == nil {
+			break
+		}
+
+		return e.complexity.SetAssigneeOperation.Date(childComplexity), true
+
+	case "SetAssigneeOperation.id":
+		if e.complexity.SetAssigneeOperation.Id == nil {
+			break
+		}
+
+		return e.complexity.SetAssigneeOperation.Id(childComplexity), true
+
+	case "SetAssigneeOperation.removed":
+		if e.complexity.SetAssigneeOperation.Removed == nil {
+			break
+		}
+
+		return e.complexity.SetAssigneeOperation.Removed(childComplexity), true
+
+	case "SetAssigneeOperation.signed":
+		if e.complexity.SetAssigneeOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.SetAssigneeOperation.Signed(childComplexity), true
+
+	case "SetBugStatePayload.bug":
+		if e.complexity.SetBugStatePayload.Bug == nil {
+			break
+		}
+
+		return e.complexity.SetBugStatePayload.Bug(childComplexity), true
+
+	case "SetBugStatePayload.clientMutationId":
+		if e.complexity.SetBugStatePayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.SetBugStatePayload.ClientMutationID(childComplexity), true
+
+	case "SetBugStatePayload.operation":
+		if e.complexity.SetBugStatePayload.Operation == nil {
+			break
+		}
+
+		return e.complexity.SetBugStatePayload.Operation(childComplexity), true
+
+	case "SetFieldOperation.author":
+		if e.complexity.SetFieldOperation.Author == nil {
+			break
+		}
+
+		return e.complexity.SetFieldOperation.Author(childComplexity), true
+
+	case "SetFieldOperation.date":
+		if e.complexity.SetFieldOperation.Date == nil {
+			break
+		}
+
+		return e.complexity.SetFieldOperation.Date(childComplexity), true
+
+	case "SetFieldOperation.id":
+		if e.complexity.SetFieldOperation.Id == nil {
+			break
+		}
+
+		return e.complexity.SetFieldOperation.Id(childComplexity), true
+
+	case "SetFieldOperation.name":
+		if e.complexity.SetFieldOperation.Name == nil {
+			break
+		}
+
+		return e.complexity.SetFieldOperation.Name(childComplexity), true
+
+	case "SetFieldOperation.signed":
+		if e.complexity.SetFieldOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.SetFieldOperation.Signed(childComplexity), true
+
+	case "SetFieldOperation.value":
+		if e.complexity.SetFieldOperation.Value == nil {
+			break
+		}
+
+		return e.complexity.SetFieldOperation.Value(childComplexity), true
+
+	case "SetFieldPayload.bug":
+		if e.complexity.SetFieldPayload.Bug == nil {
+			break
+		}
+
+		return e.complexity.SetFieldPayload.Bug(childComplexity), true
+
+	case "SetFieldPayload.clientMutationId":
+		if e.complexity.SetFieldPayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.SetFieldPayload.ClientMutationID(childComplexity), true
+
+	case "SetFieldPayload.operation":
+		if e.complexity.SetFieldPayload.Operation == nil {
+			break
+		}
+
+		return e.complexity.SetFieldPayload.Operation(childComplexity), true
+
 	case "SetStatusOperation.author":
 		if e.complexity.SetStatusOperation.Author == nil {
 			break
@@ -1605,6 +3165,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SetStatusOperation.Id(childComplexity), true
 
+	case "SetStatusOperation.signed":
+		if e.complexity.SetStatusOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.SetStatusOperation.Signed(childComplexity), true
+
+	case "SetStatusOperation.state":
+		if e.complexity.SetStatusOperation.State == nil {
+			break
+		}
+
+		return e.complexity.SetStatusOperation.State(childComplexity), true
+
 	case "SetStatusOperation.status":
 		if e.complexity.SetStatusOperation.Status == nil {
 			break
@@ -1633,6 +3207,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SetStatusTimelineItem.ID(childComplexity), true
 
+	case "SetStatusTimelineItem.state":
+		if e.complexity.SetStatusTimelineItem.State == nil {
+			break
+		}
+
+		return e.complexity.SetStatusTimelineItem.State(childComplexity), true
+
 	case "SetStatusTimelineItem.status":
 		if e.complexity.SetStatusTimelineItem.Status == nil {
 			break
@@ -1661,6 +3242,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SetTitleOperation.Id(childComplexity), true
 
+	case "SetTitleOperation.signed":
+		if e.complexity.SetTitleOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.SetTitleOperation.Signed(childComplexity), true
+
 	case "SetTitleOperation.title":
 		if e.complexity.SetTitleOperation.Title == nil {
 			break
@@ -1731,6 +3319,81 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SetTitleTimelineItem.Was(childComplexity), true
 
+	case "Subscription.repositoryEvents":
+		if e.complexity.Subscription.RepositoryEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_repositoryEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.RepositoryEvents(childComplexity, args["ref"].(*string), args["kinds"].([]models.RepositoryEventKind)), true
+
+	case "SyncConflictOperation.author":
+		if e.complexity.SyncConflictOperation.Author == nil {
+			break
+		}
+
+		return e.complexity.SyncConflictOperation.Author(childComplexity), true
+
+	case "SyncConflictOperation.bridge":
+		if e.complexity.SyncConflictOperation.Bridge == nil {
+			break
+		}
+
+		return e.complexity.SyncConflictOperation.Bridge(childComplexity), true
+
+	case "SyncConflictOperation.date":
+		if e.complexity.SyncConflictOperation.Date == nil {
+			break
+		}
+
+		return e.complexity.SyncConflictOperation.Date(childComplexity), true
+
+	case "SyncConflictOperation.field":
+		if e.complexity.SyncConflictOperation.Field == nil {
+			break
+		}
+
+		return e.complexity.SyncConflictOperation.Field(childComplexity), true
+
+	case "SyncConflictOperation.id":
+		if e.complexity.SyncConflictOperation.Id == nil {
+			break
+		}
+
+		return e.complexity.SyncConflictOperation.Id(childComplexity), true
+
+	case "SyncConflictOperation.local":
+		if e.complexity.SyncConflictOperation.Local == nil {
+			break
+		}
+
+		return e.complexity.SyncConflictOperation.Local(childComplexity), true
+
+	case "SyncConflictOperation.remote":
+		if e.complexity.SyncConflictOperation.Remote == nil {
+			break
+		}
+
+		return e.complexity.SyncConflictOperation.Remote(childComplexity), true
+
+	case "SyncConflictOperation.signed":
+		if e.complexity.SyncConflictOperation.Signed == nil {
+			break
+		}
+
+		return e.complexity.SyncConflictOperation.Signed(childComplexity), true
+
+	case "SyncConflictOperation.winner":
+		if e.complexity.SyncConflictOperation.Winner == nil {
+			break
+		}
+
+		return e.complexity.SyncConflictOperation.Winner(childComplexity), true
+
 	case "TimelineItemConnection.edges":
 		if e.complexity.TimelineItemConnection.Edges == nil {
 			break
@@ -1773,6 +3436,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TimelineItemEdge.Node(childComplexity), true
 
+	case "WorkflowState.name":
+		if e.complexity.WorkflowState.Name == nil {
+			break
+		}
+
+		return e.complexity.WorkflowState.Name(childComplexity), true
+
+	case "WorkflowState.next":
+		if e.complexity.WorkflowState.Next == nil {
+			break
+		}
+
+		return e.complexity.WorkflowState.Next(childComplexity), true
+
+	case "WorkflowState.status":
+		if e.complexity.WorkflowState.Status == nil {
+			break
+		}
+
+		return e.complexity.WorkflowState.Status(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -1781,14 +3465,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAckChangeFeedInput,
+		ec.unmarshalInputAddAttachmentInput,
 		ec.unmarshalInputAddCommentAndCloseBugInput,
 		ec.unmarshalInputAddCommentAndReopenBugInput,
 		ec.unmarshalInputAddCommentInput,
+		ec.unmarshalInputChangeAssigneeInput,
 		ec.unmarshalInputChangeLabelInput,
 		ec.unmarshalInputCloseBugInput,
 		ec.unmarshalInputEditCommentInput,
+		ec.unmarshalInputMarkBugAsReadInput,
 		ec.unmarshalInputNewBugInput,
 		ec.unmarshalInputOpenBugInput,
+		ec.unmarshalInputReactionInput,
+		ec.unmarshalInputRedactCommentInput,
+		ec.unmarshalInputRelationInput,
+		ec.unmarshalInputSetBugStateInput,
+		ec.unmarshalInputSetFieldInput,
 		ec.unmarshalInputSetTitleInput,
 	)
 	first := true
@@ -1820,6 +3513,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -1860,25 +3570,142 @@ type Comment implements Authored {
   """The message of this comment."""
   message: String!
 
-  """All media's hash referenced in this comment"""
-  files: [Hash!]!
+  """All media's hash referenced in this comment"""
+  files: [Hash!]!
+
+  """The code blocks, links and mentions of the message."""
+  structure: MessageStructure!
+
+  """The emoji reactions to this comment."""
+  reactions: [ReactionGroup!]!
+}
+
+"""An emoji reaction to a comment."""
+enum Reaction {
+  THUMBS_UP
+  THUMBS_DOWN
+  LAUGH
+  HOORAY
+  CONFUSED
+  HEART
+  ROCKET
+  EYES
+}
+
+"""The authors of the same reaction to a comment."""
+type ReactionGroup {
+  reaction: Reaction!
+  """The unicode emoji of the reaction."""
+  emoji: String!
+  authors: [Identity!]!
+}
+
+"""A fenced block of code in a message"""
+type CodeBlock {
+  """The language of the code, as given after the opening fence or guessed from the code. Empty if unknown."""
+  language: String!
+  """True when the language has been guessed from the code."""
+  languageGuessed: Boolean!
+  code: String!
+}
+
+"""The code blocks, links and mentions of a markdown message"""
+type MessageStructure {
+  codeBlocks: [CodeBlock!]!
+  """The URLs linked or written in the text, in order."""
+  links: [String!]!
+  """The logins mentioned with @login, in order."""
+  mentions: [String!]!
+}
+
+type CommentConnection {
+  edges: [CommentEdge!]!
+  nodes: [Comment!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+type CommentEdge {
+  cursor: String!
+  node: Comment!
+}
+
+"""The value of a custom field of a bug, as defined in the repository configuration."""
+type BugField {
+  name: String!
+  value: String!
+}
+
+enum Status {
+  OPEN
+  CLOSED
+}
+
+"""A state of the workflow of the bugs, as defined in the repository configuration."""
+type WorkflowState {
+  name: String!
+  """Whether a bug in this state is open or closed."""
+  status: Status!
+  """The states a bug can move to from this state."""
+  next: [String!]!
+}
+
+"""The kind of link from a bug to another one."""
+enum RelationType {
+  """The other bug can't be resolved before this one."""
+  BLOCKS
+  """This bug is a duplicate of the other one."""
+  DUPLICATE_OF
+  """A loose link between the two bugs."""
+  RELATED_TO
+}
+
+"""A link from a bug to another one."""
+type Relation {
+  type: RelationType!
+  """The id of the other bug."""
+  target: ID!
 }
 
-type CommentConnection {
-  edges: [CommentEdge!]!
-  nodes: [Comment!]!
-  pageInfo: PageInfo!
-  totalCount: Int!
+"""A file attached to a bug, stored in git. Its content is served by the web UI at /attachment/{repo}/{bug id}/{hash}."""
+type Attachment {
+  """The name of the file, without directory."""
+  name: String!
+  """The hash of the git blob holding the file."""
+  hash: Hash!
+  """The size of the file in bytes."""
+  size: Int!
+  """The MIME type of the file, as detected when attached."""
+  contentType: String!
+  author: Identity!
+  date: Time!
 }
 
-type CommentEdge {
-  cursor: String!
-  node: Comment!
+"""The machine translations of the current title and messages of a bug in a language. The original texts prevail."""
+type BugTranslations {
+  lang: String!
+  """The translated title, null if not translated."""
+  title: String
+  """The translated messages, in the order of the comments. The comments not translated are skipped."""
+  comments: [CommentTranslation!]!
 }
 
-enum Status {
-  OPEN
-  CLOSED
+"""The machine translation of the message of a comment."""
+type CommentTranslation {
+  id: CombinedId!
+  message: String!
+}
+
+"""A change of a bug overridden by a concurrent one, made on another clone before both were merged."""
+type MergeConflict {
+  """What both operations changed, like "title", "status" or "label bug"."""
+  field: String!
+  """The operation whose change is visible."""
+  kept: Operation!
+  keptValue: String!
+  """The operation whose change has been replaced."""
+  overridden: Operation!
+  overriddenValue: String!
 }
 
 type Bug implements Authored {
@@ -1887,8 +3714,26 @@ type Bug implements Authored {
   """The human version (truncated) identifier for this bug"""
   humanId: String!
   status: Status!
+  """The state of the bug in the workflow of the repository, open or closed depending on the status."""
+  state: String!
   title: String!
   labels: [Label!]!
+  """The custom fields set on the bug, sorted by name."""
+  fields: [BugField!]!
+  """The links from this bug to other bugs, sorted by type then target."""
+  relations: [Relation!]!
+  """The bugs referencing this bug with #prefix in their description or comments, sorted by id."""
+  backlinks: [Bug!]!
+  """The changes overridden by concurrent ones when merging the editions of different clones, in the order the operations are applied."""
+  mergeConflicts: [MergeConflict!]!
+  """The languages with a stored machine translation of the title or of a message, sorted."""
+  translationLanguages: [String!]!
+  """The stored machine translations of the title and the messages in a language, like "fr" or "pt-BR"."""
+  translations(lang: String!): BugTranslations!
+  """The files attached to the bug after its creation, in the order they were attached."""
+  attachments: [Attachment!]!
+  """The ids of the identities the bug is assigned to, sorted. They might not be known locally."""
+  assignees: [ID!]!
   author: Identity!
   createdAt: Time!
   lastEdit: Time!
@@ -1970,6 +3815,55 @@ type BugEdge {
   """The item at the end of the edge."""
   node: Bug!
 }
+`, BuiltIn: false},
+	{Name: "../schema/change_feed.graphql", Input: `"""A page of the change feed of a repository"""
+type ChangeFeedPage {
+    """The entries of the page, in order"""
+    entries: [ChangeFeedEntry!]!
+    """The sequence number of the last entry of the page, to read the next page after it, and to acknowledge once the entries are processed. When the page is empty, this is the sequence number the page was requested after."""
+    cursor: Int!
+}
+
+"""A change of a repository in the change feed: either a new operation of a bug, or the removal of a bug"""
+type ChangeFeedEntry {
+    """The position of the entry in the feed, starting at 1. It never changes once assigned."""
+    seq: Int!
+    """The identifier of the bug"""
+    bugId: ID!
+    """True when the bug has been removed, in which case there is no operation"""
+    removed: Boolean!
+    """The new operation of the bug, unless the bug has been removed since"""
+    operation: Operation
+}
+`, BuiltIn: false},
+	{Name: "../schema/events.graphql", Input: `"""The kind of a change of a repository"""
+enum RepositoryEventKind {
+    """A bug has been created locally or received from a remote"""
+    BUG_CREATED
+    """A bug has been modified"""
+    BUG_UPDATED
+    """A bug has been removed"""
+    BUG_REMOVED
+    """An identity has been created or modified"""
+    IDENTITY_UPDATED
+    """A merge from a remote has completed"""
+    MERGE_COMPLETED
+}
+
+"""A change of a repository"""
+type RepositoryEvent {
+    kind: RepositoryEventKind!
+    """The id of the bug created, updated or removed"""
+    bugId: String
+    """The id of the identity created or updated"""
+    identityId: String
+    """The remote merged"""
+    remote: String
+    """The ids of the bugs created or updated by a merge"""
+    mergedBugIds: [String!]
+    """The ids of the identities created or updated by a merge"""
+    mergedIdentityIds: [String!]
+}
 `, BuiltIn: false},
 	{Name: "../schema/identity.graphql", Input: `"""Represents an identity"""
 type Identity {
@@ -1990,6 +3884,30 @@ type Identity {
     """isProtected is true if the chain of git commits started to be signed.
     If that's the case, only signed commit with a valid key for this identity can be added."""
     isProtected: Boolean!
+    """isBot is true for the identities used by automation, authoring operations
+    through an API token rather than as a person."""
+    isBot: Boolean!
+}
+
+"""The activity of an identity across all the bugs"""
+type IdentityActivity {
+    """The bugs created by the identity"""
+    authored: [Bug!]!
+    """The bugs the identity commented on"""
+    commented: [Bug!]!
+    """The bugs closed by the identity"""
+    closed: [Bug!]!
+    """The number of actions per month, in chronological order"""
+    timeline: [ActivityCount!]!
+}
+
+"""The number of actions during a month"""
+type ActivityCount {
+    """The first day of the month"""
+    month: Time!
+    authored: Int!
+    commented: Int!
+    closed: Int!
 }
 
 type IdentityConnection {
@@ -2009,6 +3927,8 @@ type Label {
     name: String!
     """Color of the label."""
     color: Color!
+    """Description of the label, as imported from a remote bug tracker."""
+    description: String
 }
 
 type LabelConnection {
@@ -2077,6 +3997,8 @@ input AddCommentAndCloseBugInput {
     message: String!
     """The collection of file's hash required for the first message."""
     files: [Hash!]
+    """Confirm the closing of a bug protected by the freeze mode of the repository."""
+    confirmFreeze: Boolean
 }
 
 type AddCommentAndCloseBugPayload {
@@ -2136,6 +4058,112 @@ type EditCommentPayload {
     operation: EditCommentOperation!
 }
 
+input RedactCommentInput {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The name of the repository. If not set, the default repository is used."""
+    repoRef: String
+    """A prefix of the CombinedId of the comment to be redacted."""
+    targetPrefix: String!
+    """Remove the comment from the bug instead of only hiding its content. The description of a bug can't be deleted."""
+    delete: Boolean
+}
+
+type RedactCommentPayload {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The affected bug."""
+    bug: Bug!
+    """The resulting operation."""
+    operation: RedactCommentOperation!
+}
+
+input ReactionInput {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The name of the repository. If not set, the default repository is used."""
+    repoRef: String
+    """A prefix of the CombinedId of the comment to react to."""
+    targetPrefix: String!
+    """The reaction to add or remove."""
+    reaction: Reaction!
+}
+
+type ReactionPayload {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The affected bug."""
+    bug: Bug!
+    """The resulting operation."""
+    operation: ReactionOperation!
+}
+
+input RelationInput {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The name of the repository. If not set, the default repository is used."""
+    repoRef: String
+    """The bug ID's prefix."""
+    prefix: String!
+    """The type of the relation."""
+    relation: RelationType!
+    """The prefix of the ID of the other bug."""
+    targetPrefix: String!
+}
+
+type RelationPayload {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The affected bug."""
+    bug: Bug!
+    """The resulting operation, a RelationAddOperation or a RelationRemoveOperation."""
+    operation: Operation!
+}
+
+input AddAttachmentInput {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The name of the repository. If not set, the default repository is used."""
+    repoRef: String
+    """The bug ID's prefix."""
+    prefix: String!
+    """The name of the file, without directory."""
+    name: String!
+    """The content of the file, encoded in base64."""
+    content: String!
+}
+
+type AddAttachmentPayload {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The affected bug."""
+    bug: Bug!
+    """The resulting operation."""
+    operation: AddAttachmentOperation!
+}
+
+input ChangeAssigneeInput {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The name of the repository. If not set, the default repository is used."""
+    repoRef: String
+    """The bug ID's prefix."""
+    prefix: String!
+    """The prefixes of the ID of the identities to assign."""
+    added: [String!]
+    """The prefixes of the ID of the identities to unassign."""
+    removed: [String!]
+}
+
+type ChangeAssigneePayload {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The affected bug."""
+    bug: Bug!
+    """The resulting operation"""
+    operation: SetAssigneeOperation!
+}
+
 input ChangeLabelInput {
     """A unique identifier for the client performing the mutation."""
     clientMutationId: String
@@ -2200,6 +4228,8 @@ input CloseBugInput {
     repoRef: String
     """The bug ID's prefix."""
     prefix: String!
+    """Confirm the closing of a bug protected by the freeze mode of the repository."""
+    confirmFreeze: Boolean
 }
 
 type CloseBugPayload {
@@ -2211,6 +4241,28 @@ type CloseBugPayload {
     operation: SetStatusOperation!
 }
 
+input SetBugStateInput {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The name of the repository. If not set, the default repository is used."""
+    repoRef: String
+    """The bug ID's prefix."""
+    prefix: String!
+    """The state of the workflow to move the bug to."""
+    state: String!
+    """Confirm the closing of a bug protected by the freeze mode of the repository."""
+    confirmFreeze: Boolean
+}
+
+type SetBugStatePayload {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The affected bug."""
+    bug: Bug!
+    """The resulting operation."""
+    operation: SetStatusOperation!
+}
+
 input SetTitleInput {
     """A unique identifier for the client performing the mutation."""
     clientMutationId: String
@@ -2222,6 +4274,28 @@ input SetTitleInput {
     title: String!
 }
 
+input SetFieldInput {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The name of the repository. If not set, the default repository is used."""
+    repoRef: String
+    """The bug ID's prefix."""
+    prefix: String!
+    """The name of the custom field."""
+    name: String!
+    """The new value of the field. An empty value unset the field."""
+    value: String!
+}
+
+type SetFieldPayload {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The affected bug."""
+    bug: Bug!
+    """The resulting operation"""
+    operation: SetFieldOperation!
+}
+
 type SetTitlePayload {
     """A unique identifier for the client performing the mutation."""
     clientMutationId: String
@@ -2230,6 +4304,40 @@ type SetTitlePayload {
     """The resulting operation"""
     operation: SetTitleOperation!
 }
+
+input MarkBugAsReadInput {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The name of the repository. If not set, the default repository is used."""
+    repoRef: String
+    """The bug ID's prefix."""
+    prefix: String!
+}
+
+type MarkBugAsReadPayload {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The affected bug."""
+    bug: Bug!
+}
+
+input AckChangeFeedInput {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The name of the repository. If not set, the default repository is used."""
+    repoRef: String
+    """The name of the consumer of the change feed."""
+    consumer: String!
+    """The sequence number of the last entry processed by the consumer."""
+    cursor: Int!
+}
+
+type AckChangeFeedPayload {
+    """A unique identifier for the client performing the mutation."""
+    clientMutationId: String
+    """The stored cursor of the consumer."""
+    cursor: Int!
+}
 `, BuiltIn: false},
 	{Name: "../schema/operations.graphql", Input: `"""An operation applied to a bug."""
 interface Operation {
@@ -2239,6 +4347,8 @@ interface Operation {
     author: Identity!
     """The datetime when this operation was issued."""
     date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
 }
 
 # Connection
@@ -2266,6 +4376,8 @@ type CreateOperation implements Operation & Authored {
     author: Identity!
     """The datetime when this operation was issued."""
     date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
 
     title: String!
     message: String!
@@ -2279,6 +4391,8 @@ type SetTitleOperation implements Operation & Authored {
     author: Identity!
     """The datetime when this operation was issued."""
     date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
 
     title: String!
     was: String!
@@ -2291,6 +4405,8 @@ type AddCommentOperation implements Operation & Authored {
     author: Identity!
     """The datetime when this operation was issued."""
     date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
 
     message: String!
     files: [Hash!]!
@@ -2303,12 +4419,93 @@ type EditCommentOperation implements Operation & Authored {
     author: Identity!
     """The datetime when this operation was issued."""
     date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
 
     target: String!
     message: String!
     files: [Hash!]!
 }
 
+type RedactCommentOperation implements Operation & Authored {
+    """The identifier of the operation"""
+    id: ID!
+    """The author of this object."""
+    author: Identity!
+    """The datetime when this operation was issued."""
+    date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
+
+    """The id of the operation that created the comment."""
+    target: String!
+    """True if the comment is removed, false if only its content is hidden."""
+    delete: Boolean!
+}
+
+type ReactionOperation implements Operation & Authored {
+    """The identifier of the operation"""
+    id: ID!
+    """The author of this object."""
+    author: Identity!
+    """The datetime when this operation was issued."""
+    date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
+
+    """The id of the operation that created the comment."""
+    target: String!
+    reaction: Reaction!
+    """True if the reaction is removed, false if added."""
+    remove: Boolean!
+}
+
+type AddAttachmentOperation implements Operation & Authored {
+    """The identifier of the operation"""
+    id: ID!
+    """The author of this object."""
+    author: Identity!
+    """The datetime when this operation was issued."""
+    date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
+
+    name: String!
+    hash: Hash!
+    size: Int!
+    contentType: String!
+}
+
+type RelationAddOperation implements Operation & Authored {
+    """The identifier of the operation"""
+    id: ID!
+    """The author of this object."""
+    author: Identity!
+    """The datetime when this operation was issued."""
+    date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
+
+    relation: RelationType!
+    """The id of the other bug."""
+    target: ID!
+}
+
+type RelationRemoveOperation implements Operation & Authored {
+    """The identifier of the operation"""
+    id: ID!
+    """The author of this object."""
+    author: Identity!
+    """The datetime when this operation was issued."""
+    date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
+
+    relation: RelationType!
+    """The id of the other bug."""
+    target: ID!
+}
+
 type SetStatusOperation implements Operation & Authored {
     """The identifier of the operation"""
     id: ID!
@@ -2316,8 +4513,12 @@ type SetStatusOperation implements Operation & Authored {
     author: Identity!
     """The datetime when this operation was issued."""
     date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
 
     status: Status!
+    """The state of the workflow the bug moved to, if recorded. Otherwise, the bug moved to the first state with the status."""
+    state: String
 }
 
 type LabelChangeOperation implements Operation & Authored {
@@ -2327,10 +4528,77 @@ type LabelChangeOperation implements Operation & Authored {
     author: Identity!
     """The datetime when this operation was issued."""
     date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
 
     added: [Label!]!
     removed: [Label!]!
 }
+
+type RequestInfoOperation implements Operation & Authored {
+    """The identifier of the operation"""
+    id: ID!
+    """The author of this object."""
+    author: Identity!
+    """The datetime when this operation was issued."""
+    date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
+}
+
+type SetAssigneeOperation implements Operation & Authored {
+    """The identifier of the operation"""
+    id: ID!
+    """The author of this object."""
+    author: Identity!
+    """The datetime when this operation was issued."""
+    date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
+
+    """The ids of the identities assigned."""
+    added: [ID!]!
+    """The ids of the identities unassigned."""
+    removed: [ID!]!
+}
+
+type SetFieldOperation implements Operation & Authored {
+    """The identifier of the operation"""
+    id: ID!
+    """The author of this object."""
+    author: Identity!
+    """The datetime when this operation was issued."""
+    date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
+
+    """The name of the custom field."""
+    name: String!
+    """The new value of the field, empty if the field is unset."""
+    value: String!
+}
+
+type SyncConflictOperation implements Operation & Authored {
+    """The identifier of the operation"""
+    id: ID!
+    """The author of this object."""
+    author: Identity!
+    """The datetime when this operation was issued."""
+    date: Time!
+    """True if the operation is stored in a commit signed by its author, and the signature has been verified."""
+    signed: Boolean!
+
+    """The bridge that detected the conflict."""
+    bridge: String!
+    """The part of the bug that differed, for example "title"."""
+    field: String!
+    """The local value."""
+    local: String!
+    """The remote value."""
+    remote: String!
+    """The side whose value has been kept, "local" or "remote"."""
+    winner: String!
+}
 `, BuiltIn: false},
 	{Name: "../schema/repository.graphql", Input: `
 type Repository {
@@ -2367,9 +4635,28 @@ type Repository {
 
     identity(prefix: String!): Identity
 
+    """The activity of an identity across all the bugs"""
+    identityActivity(prefix: String!): IdentityActivity
+
+    """The states a bug can be in, in order, as defined in the repository configuration"""
+    workflow: [WorkflowState!]!
+
+    """Aggregates over all the bugs, maintained by the cache as the bugs change"""
+    statistics: RepositoryStatistics!
+
     """The identity created or selected by the user as its own"""
     userIdentity: Identity
 
+    """The changes of the repository in order, for an external system to consume them exactly once and resume after a downtime"""
+    changeFeed(
+        """Returns the entries after the cursor of the given consumer, as acknowledged with ackChangeFeed, unless after is given."""
+        consumer: String
+        """Returns the entries after the given sequence number."""
+        after: Int
+        """Returns the first _n_ entries."""
+        first: Int
+    ): ChangeFeedPage!
+
     """List of valid labels."""
     validLabels(
         """Returns the elements in the list that come after the specified cursor."""
@@ -2382,6 +4669,24 @@ type Repository {
         last: Int
     ): LabelConnection!
 }
+
+"""Aggregates over all the bugs of a repository"""
+type RepositoryStatistics {
+    """The number of open bugs"""
+    openCount: Int!
+    """The number of closed bugs"""
+    closedCount: Int!
+    """The number of bugs per label, sorted by label"""
+    labels: [LabelCount!]!
+    """The number of actions per month on all bugs, in chronological order"""
+    activity: [ActivityCount!]!
+}
+
+"""The number of bugs having a label"""
+type LabelCount {
+    label: Label!
+    count: Int!
+}
 `, BuiltIn: false},
 	{Name: "../schema/root.graphql", Input: `type Query {
     """Access a repository by reference/name. If no ref is given, the default repository is returned if any."""
@@ -2399,14 +4704,41 @@ type Mutation {
     addCommentAndReopen(input: AddCommentAndReopenBugInput!): AddCommentAndReopenBugPayload!
     """Change a comment of a bug"""
     editComment(input: EditCommentInput!): EditCommentPayload!
+    """Hide the content of a comment of a bug, or delete it"""
+    redactComment(input: RedactCommentInput!): RedactCommentPayload!
+    """Add the reaction of the user to a comment of a bug"""
+    addReaction(input: ReactionInput!): ReactionPayload!
+    """Remove the reaction of the user to a comment of a bug"""
+    removeReaction(input: ReactionInput!): ReactionPayload!
+    """Link a bug to another one"""
+    addRelation(input: RelationInput!): RelationPayload!
+    """Remove a link from a bug to another one"""
+    removeRelation(input: RelationInput!): RelationPayload!
+    """Attach a file to a bug"""
+    addAttachment(input: AddAttachmentInput!): AddAttachmentPayload!
+    """Assign or unassign a set of identities on a bug"""
+    changeAssignees(input: ChangeAssigneeInput!): ChangeAssigneePayload!
     """Add or remove a set of label on a bug"""
     changeLabels(input: ChangeLabelInput): ChangeLabelPayload!
     """Change a bug's status to open"""
     openBug(input: OpenBugInput!): OpenBugPayload!
     """Change a bug's status to closed"""
     closeBug(input: CloseBugInput!): CloseBugPayload!
+    """Move a bug to a state of the workflow"""
+    setBugState(input: SetBugStateInput!): SetBugStatePayload!
     """Change a bug's title"""
     setTitle(input: SetTitleInput!): SetTitlePayload!
+    """Set or unset a custom field of a bug"""
+    setField(input: SetFieldInput!): SetFieldPayload!
+    """Mark a bug as read by the user, until its next edition"""
+    markBugAsRead(input: MarkBugAsReadInput!): MarkBugAsReadPayload!
+    """Store the cursor of a consumer of the change feed, once it processed the entries up to it"""
+    ackChangeFeed(input: AckChangeFeedInput!): AckChangeFeedPayload!
+}
+
+type Subscription {
+    """Receive the changes of a repository as they happen, for example to refresh a view. If no ref is given, the default repository is used. All the kinds of events are received if none is given."""
+    repositoryEvents(ref: String, kinds: [RepositoryEventKind!]): RepositoryEvent!
 }
 `, BuiltIn: false},
 	{Name: "../schema/timeline.graphql", Input: `"""An item in the timeline of events"""
@@ -2446,10 +4778,18 @@ type CreateTimelineItem implements TimelineItem & Authored {
     author: Identity!
     message: String!
     messageIsEmpty: Boolean!
+    """The code blocks, links and mentions of the message."""
+    structure: MessageStructure!
     files: [Hash!]!
     createdAt: Time!
     lastEdit: Time!
     edited: Boolean!
+    """True if the content of the comment, and its previous versions, have been hidden."""
+    redacted: Boolean!
+    """True if the comment has been deleted, only its tombstone being left."""
+    deleted: Boolean!
+    """The emoji reactions to the comment."""
+    reactions: [ReactionGroup!]!
     history: [CommentHistoryStep!]!
 }
 
@@ -2460,10 +4800,18 @@ type AddCommentTimelineItem implements TimelineItem & Authored {
     author: Identity!
     message: String!
     messageIsEmpty: Boolean!
+    """The code blocks, links and mentions of the message."""
+    structure: MessageStructure!
     files: [Hash!]!
     createdAt: Time!
     lastEdit: Time!
     edited: Boolean!
+    """True if the content of the comment, and its previous versions, have been hidden."""
+    redacted: Boolean!
+    """True if the comment has been deleted, only its tombstone being left."""
+    deleted: Boolean!
+    """The emoji reactions to the comment."""
+    reactions: [ReactionGroup!]!
     history: [CommentHistoryStep!]!
 }
 
@@ -2484,6 +4832,8 @@ type SetStatusTimelineItem implements TimelineItem & Authored {
     author: Identity!
     date: Time!
     status: Status!
+    """The state of the workflow the bug moved to, if recorded. Otherwise, the bug moved to the first state with the status."""
+    state: String
 }
 
 """LabelChangeTimelineItem is a TimelineItem that represent a change in the title of a bug"""
@@ -391,6 +391,31 @@ func (ec *executionContext) _Authored(ctx context.Context, sel ast.SelectionSet,
 			return graphql.Null
 		}
 		return ec._EditCommentOperation(ctx, sel, obj)
+	case *bug.RedactCommentOperation:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RedactCommentOperation(ctx, sel, obj)
+	case *bug.ReactionOperation:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ReactionOperation(ctx, sel, obj)
+	case *bug.AddAttachmentOperation:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AddAttachmentOperation(ctx, sel, obj)
+	case *bug.RelationAddOperation:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RelationAddOperation(ctx, sel, obj)
+	case *bug.RelationRemoveOperation:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RelationRemoveOperation(ctx, sel, obj)
 	case *bug.SetStatusOperation:
 		if obj == nil {
 			return graphql.Null
@@ -401,6 +426,26 @@ func (ec *executionContext) _Authored(ctx context.Context, sel ast.SelectionSet,
 			return graphql.Null
 		}
 		return ec._LabelChangeOperation(ctx, sel, obj)
+	case *bug.RequestInfoOperation:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RequestInfoOperation(ctx, sel, obj)
+	case *bug.SetAssigneeOperation:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SetAssigneeOperation(ctx, sel, obj)
+	case *bug.SetFieldOperation:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SetFieldOperation(ctx, sel, obj)
+	case *bug.SyncConflictOperation:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SyncConflictOperation(ctx, sel, obj)
 	case *bug.CreateTimelineItem:
 		if obj == nil {
 			return graphql.Null
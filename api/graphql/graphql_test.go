@@ -2,13 +2,16 @@ package graphql
 
 import (
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/MichaelMure/git-bug/api/auth"
 	"github.com/MichaelMure/git-bug/api/graphql/models"
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/misc/random_bugs"
 	"github.com/MichaelMure/git-bug/repository"
 )
@@ -22,7 +25,7 @@ func TestQueries(t *testing.T) {
 	_, err := mrc.RegisterDefaultRepository(repo)
 	require.NoError(t, err)
 
-	handler := NewHandler(mrc, nil)
+	handler := NewHandler(mrc, nil, DefaultLimits)
 
 	c := client.New(handler)
 
@@ -217,3 +220,424 @@ func TestQueries(t *testing.T) {
 	err = c.Post(query, &resp)
 	assert.NoError(t, err)
 }
+
+func TestLimits(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	random_bugs.FillRepoWithSeed(repo, 10, 42)
+
+	mrc := cache.NewMultiRepoCache()
+	_, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+
+	handler := NewHandler(mrc, nil, DefaultLimits)
+
+	c := client.New(handler)
+
+	var resp interface{}
+
+	// the timeline query of the web UI must be allowed
+	timeline := `
+     query {
+       repository {
+         allBugs(first: 1) {
+           nodes {
+             timeline(first: 100) {
+               nodes {
+                 ... on CreateTimelineItem { id createdAt edited message history { message date } ...authored }
+                 ... on AddCommentTimelineItem { id createdAt edited message history { message date } ...authored }
+                 ... on LabelChangeTimelineItem { date added { ...label } removed { ...label } ...authored }
+                 ... on SetTitleTimelineItem { date title was ...authored }
+                 ... on SetStatusTimelineItem { date status ...authored }
+               }
+               pageInfo { hasNextPage endCursor }
+             }
+           }
+         }
+       }
+     }
+
+     fragment label on Label { name color { R G B } }
+     fragment authored on Authored { author { name email displayName avatarUrl humanId id } }
+`
+	require.NoError(t, c.Post(timeline, &resp))
+
+	// too many elements
+	complex := `
+     query {
+       repository {
+         allBugs(first: 1000) {
+           nodes {
+             comments(first: 1000) { nodes { message } }
+           }
+         }
+       }
+     }
+`
+	err = c.Post(complex, &resp)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "complexity")
+
+	// too deep
+	deep := `
+     query {
+       repository {
+         allBugs(first: 1) { nodes { comments(first: 1) { nodes { author { id } } } } }
+       }
+     }
+`
+	handler = NewHandler(mrc, nil, Limits{MaxDepth: 6})
+	c = client.New(handler)
+	err = c.Post(deep, &resp)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "depth")
+
+	handler = NewHandler(mrc, nil, Limits{MaxDepth: 7})
+	c = client.New(handler)
+	require.NoError(t, c.Post(deep, &resp))
+}
+
+func TestErrorCodes(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	mrc := cache.NewMultiRepoCache()
+	_, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+
+	handler := NewHandler(mrc, nil, DefaultLimits)
+
+	c := client.New(handler)
+
+	resp, err := c.RawPost(`query { repository { bug(prefix: "ffffff") { id } } }`)
+	require.NoError(t, err)
+	require.Contains(t, string(resp.Errors), `"code":"NOT_FOUND"`)
+
+	resp, err = c.RawPost(`query { repository(ref: "unknown") { name } }`)
+	require.NoError(t, err)
+	require.Contains(t, string(resp.Errors), `"code":"NOT_FOUND"`)
+}
+
+func TestStatistics(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	random_bugs.FillRepoWithSeed(repo, 10, 42)
+
+	mrc := cache.NewMultiRepoCache()
+	_, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+
+	handler := NewHandler(mrc, nil, DefaultLimits)
+
+	c := client.New(handler)
+
+	var resp struct {
+		Repository struct {
+			Statistics struct {
+				OpenCount   int
+				ClosedCount int
+				Labels      []struct {
+					Label struct{ Name string }
+					Count int
+				}
+				Activity []struct {
+					Month    string
+					Authored int
+				}
+			}
+		}
+	}
+
+	err = c.Post(`query { repository { statistics {
+		openCount closedCount
+		labels { label { name } count }
+		activity { month authored }
+	} } }`, &resp)
+	require.NoError(t, err)
+
+	stats := resp.Repository.Statistics
+	require.Equal(t, 10, stats.OpenCount+stats.ClosedCount)
+
+	authored := 0
+	for _, bucket := range stats.Activity {
+		authored += bucket.Authored
+	}
+	require.Equal(t, 10, authored)
+}
+
+func TestChangeFeed(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	mrc := cache.NewMultiRepoCache()
+	rc, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+
+	rene, err := rc.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	b, _, err := rc.NewBugRaw(rene, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b.AddCommentRaw(rene, time.Now().Unix()+1, "comment", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	handler := auth.Middleware(rene.Id())(NewHandler(mrc, nil, DefaultLimits))
+	c := client.New(handler)
+
+	type page struct {
+		Entries []struct {
+			Seq       int
+			BugId     string
+			Operation struct {
+				Typename string `json:"__typename"`
+			}
+		}
+		Cursor int
+	}
+
+	var resp struct {
+		Repository struct {
+			ChangeFeed page
+		}
+	}
+
+	err = c.Post(`query { repository { changeFeed(consumer: "warehouse", first: 1) {
+		entries { seq bugId operation { __typename } }
+		cursor
+	} } }`, &resp)
+	require.NoError(t, err)
+	require.Len(t, resp.Repository.ChangeFeed.Entries, 1)
+	require.Equal(t, b.Id().String(), resp.Repository.ChangeFeed.Entries[0].BugId)
+	require.Equal(t, "CreateOperation", resp.Repository.ChangeFeed.Entries[0].Operation.Typename)
+	require.Equal(t, 1, resp.Repository.ChangeFeed.Cursor)
+
+	var ack struct {
+		AckChangeFeed struct{ Cursor int }
+	}
+	err = c.Post(`mutation { ackChangeFeed(input: {consumer: "warehouse", cursor: 1}) { cursor } }`, &ack)
+	require.NoError(t, err)
+
+	// the consumer resume after its cursor
+	err = c.Post(`query { repository { changeFeed(consumer: "warehouse") {
+		entries { seq bugId operation { __typename } }
+		cursor
+	} } }`, &resp)
+	require.NoError(t, err)
+	require.Len(t, resp.Repository.ChangeFeed.Entries, 1)
+	require.Equal(t, 2, resp.Repository.ChangeFeed.Entries[0].Seq)
+	require.Equal(t, "AddCommentOperation", resp.Repository.ChangeFeed.Entries[0].Operation.Typename)
+	require.Equal(t, 2, resp.Repository.ChangeFeed.Cursor)
+}
+
+func TestBacklinks(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	mrc := cache.NewMultiRepoCache()
+	rc, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+
+	rene, err := rc.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	target, _, err := rc.NewBugRaw(rene, time.Now().Unix(), "target", "message", nil, nil)
+	require.NoError(t, err)
+	source, _, err := rc.NewBugRaw(rene, time.Now().Unix(), "source", "see #"+target.Id().Human(), nil, nil)
+	require.NoError(t, err)
+
+	c := client.New(NewHandler(mrc, nil, DefaultLimits))
+
+	var resp struct {
+		Repository struct {
+			Bug struct {
+				Backlinks []struct {
+					Id    string
+					Title string
+				}
+			}
+		}
+	}
+
+	err = c.Post(`query($prefix: String!) { repository { bug(prefix: $prefix) { backlinks { id title } } } }`,
+		&resp, client.Var("prefix", target.Id().Human()))
+	require.NoError(t, err)
+	require.Len(t, resp.Repository.Bug.Backlinks, 1)
+	require.Equal(t, source.Id().String(), resp.Repository.Bug.Backlinks[0].Id)
+	require.Equal(t, "source", resp.Repository.Bug.Backlinks[0].Title)
+}
+
+func TestSubscription(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	mrc := cache.NewMultiRepoCache()
+	rc, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+	defer mrc.Close()
+
+	rene, err := rc.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	// the websocket connections are not subject to the timeout
+	c := client.New(NewHandler(mrc, nil, DefaultLimits))
+
+	sub := c.Websocket(`subscription { repositoryEvents(kinds: [BUG_CREATED]) { kind bugId } }`)
+	defer sub.Close()
+
+	// the subscription is registered asynchronously, create bugs until one is
+	// received
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	defer func() {
+		close(done)
+		<-finished
+	}()
+	go func() {
+		defer close(finished)
+		for {
+			_, _, err := rc.NewBugRaw(rene, time.Now().Unix(), "title", "message", nil, nil)
+			if err != nil {
+				return
+			}
+			select {
+			case <-done:
+				return
+			case <-time.After(50 * time.Millisecond):
+			}
+		}
+	}()
+
+	var resp struct {
+		RepositoryEvents struct {
+			Kind  string
+			BugId string
+		}
+	}
+	require.NoError(t, sub.Next(&resp))
+	require.Equal(t, "BUG_CREATED", resp.RepositoryEvents.Kind)
+	_, err = rc.ResolveBug(entity.Id(resp.RepositoryEvents.BugId))
+	require.NoError(t, err)
+}
+
+func TestMergeConflicts(t *testing.T) {
+	repoA, repoB, _ := repository.SetupGoGitReposAndRemote(t)
+
+	cacheA, err := cache.NewRepoCache(repoA)
+	require.NoError(t, err)
+	mrc := cache.NewMultiRepoCache()
+	cacheB, err := mrc.RegisterDefaultRepository(repoB)
+	require.NoError(t, err)
+
+	rene, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cacheA.SetUserIdentity(rene))
+	isaac, err := cacheB.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.SetUserIdentity(isaac))
+
+	b, _, err := cacheA.NewBugRaw(rene, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Pull("origin"))
+
+	// both clones change the title before syncing
+	_, err = b.SetTitleRaw(rene, time.Now().Unix(), "title A", nil)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+	bB, err := cacheB.ResolveBug(b.Id())
+	require.NoError(t, err)
+	_, err = bB.SetTitleRaw(isaac, time.Now().Unix()+1, "title B", nil)
+	require.NoError(t, err)
+	require.NoError(t, bB.Commit())
+
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Pull("origin"))
+
+	c := client.New(NewHandler(mrc, nil, DefaultLimits))
+
+	var resp struct {
+		Repository struct {
+			Bug struct {
+				Title          string
+				MergeConflicts []struct {
+					Field           string
+					KeptValue       string
+					OverriddenValue string
+					Kept            struct {
+						Typename string `json:"__typename"`
+					}
+				}
+			}
+		}
+	}
+
+	err = c.Post(`query($prefix: String!) { repository { bug(prefix: $prefix) {
+		title
+		mergeConflicts { field keptValue overriddenValue kept { __typename } }
+	} } }`, &resp, client.Var("prefix", b.Id().Human()))
+	require.NoError(t, err)
+	require.Len(t, resp.Repository.Bug.MergeConflicts, 1)
+	conflict := resp.Repository.Bug.MergeConflicts[0]
+	require.Equal(t, "title", conflict.Field)
+	require.Equal(t, resp.Repository.Bug.Title, conflict.KeptValue)
+	require.ElementsMatch(t, []string{"title A", "title B"}, []string{conflict.KeptValue, conflict.OverriddenValue})
+	require.Equal(t, "SetTitleOperation", conflict.Kept.Typename)
+}
+
+// prefixTranslator is a cache.Translator prefixing the texts with the language
+type prefixTranslator struct{}
+
+func (prefixTranslator) Translate(text string, lang string) (string, error) {
+	return "[" + lang + "] " + text, nil
+}
+
+func TestTranslations(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	mrc := cache.NewMultiRepoCache()
+	rc, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+
+	rene, err := rc.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	b, _, err := rc.NewBugRaw(rene, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = b.TranslateRaw(rene, time.Now().Unix(), prefixTranslator{}, "fr")
+	require.NoError(t, err)
+
+	c := client.New(NewHandler(mrc, nil, DefaultLimits))
+
+	var resp struct {
+		Repository struct {
+			Bug struct {
+				TranslationLanguages []string
+				Translations         struct {
+					Lang     string
+					Title    *string
+					Comments []struct {
+						Id      string
+						Message string
+					}
+				}
+			}
+		}
+	}
+
+	err = c.Post(`query($prefix: String!) { repository { bug(prefix: $prefix) {
+		translationLanguages
+		translations(lang: "fr") { lang title comments { id message } }
+	} } }`, &resp, client.Var("prefix", b.Id().Human()))
+	require.NoError(t, err)
+	require.Equal(t, []string{"fr"}, resp.Repository.Bug.TranslationLanguages)
+	translations := resp.Repository.Bug.Translations
+	require.Equal(t, "fr", translations.Lang)
+	require.NotNil(t, translations.Title)
+	require.Equal(t, "[fr] title", *translations.Title)
+	require.Len(t, translations.Comments, 1)
+	require.Equal(t, b.Snapshot().Comments[0].CombinedId().String(), translations.Comments[0].Id)
+	require.Equal(t, "[fr] message", translations.Comments[0].Message)
+
+	err = c.Post(`query($prefix: String!) { repository { bug(prefix: $prefix) {
+		translations(lang: "de") { lang title comments { id message } }
+	} } }`, &resp, client.Var("prefix", b.Id().Human()))
+	require.NoError(t, err)
+	require.Nil(t, resp.Repository.Bug.Translations.Title)
+	require.Empty(t, resp.Repository.Bug.Translations.Comments)
+}
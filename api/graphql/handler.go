@@ -20,17 +20,19 @@ type Handler struct {
 	io.Closer
 }
 
-func NewHandler(mrc *cache.MultiRepoCache, errorOut io.Writer) Handler {
+func NewHandler(mrc *cache.MultiRepoCache, errorOut io.Writer, limits Limits) Handler {
 	rootResolver := resolvers.NewRootResolver(mrc)
 	config := graph.Config{Resolvers: rootResolver}
+	setComplexity(&config.Complexity)
 	h := handler.NewDefaultServer(graph.NewExecutableSchema(config))
+	h.SetErrorPresenter(errorPresenter)
 
 	if errorOut != nil {
 		h.Use(&Tracer{Out: errorOut})
 	}
 
 	return Handler{
-		Handler: h,
+		Handler: applyLimits(h, limits),
 		Closer:  rootResolver,
 	}
 }
@@ -0,0 +1,161 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/MichaelMure/git-bug/api/graphql/graph"
+)
+
+// Limits protect the server against expensive queries, whether malicious or
+// accidental. A zero value disable the corresponding limit.
+type Limits struct {
+	// MaxComplexity is the maximum computed complexity of a query. Each field
+	// count for one, and the fields of a connection are multiplied by the
+	// number of requested elements.
+	MaxComplexity int
+	// MaxDepth is the maximum nesting of fields in a query.
+	MaxDepth int
+	// Timeout is the maximum duration of a request.
+	Timeout time.Duration
+}
+
+// DefaultLimits are limits suitable for a public instance
+var DefaultLimits = Limits{
+	MaxComplexity: 10000,
+	MaxDepth:      15,
+	Timeout:       30 * time.Second,
+}
+
+// The number of elements a connection is assumed to return when neither first
+// or last are given, to compute the complexity.
+const defaultConnectionSize = 10
+
+func connectionComplexity(childComplexity int, first *int, last *int) int {
+	size := defaultConnectionSize
+	switch {
+	case first != nil:
+		size = *first
+	case last != nil:
+		size = *last
+	}
+	if size < 1 {
+		size = 1
+	}
+	return 1 + size*childComplexity
+}
+
+// setComplexity configure the complexity of the connection fields
+func setComplexity(c *graph.ComplexityRoot) {
+	conn := func(childComplexity int, after *string, before *string, first *int, last *int) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	c.Bug.Actors = conn
+	c.Bug.Comments = conn
+	c.Bug.Operations = conn
+	c.Bug.Participants = conn
+	c.Bug.Timeline = conn
+	c.Repository.AllIdentities = conn
+	c.Repository.ValidLabels = conn
+	c.Repository.AllBugs = func(childComplexity int, after *string, before *string, first *int, last *int, query *string) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+}
+
+// applyLimits install the limits on the GraphQL server and return the final http handler
+func applyLimits(srv *handler.Server, limits Limits) http.Handler {
+	if limits.MaxComplexity > 0 {
+		srv.Use(extension.FixedComplexityLimit(limits.MaxComplexity))
+	}
+	if limits.MaxDepth > 0 {
+		srv.Use(&DepthLimit{MaxDepth: limits.MaxDepth})
+	}
+	if limits.Timeout > 0 {
+		timeout := http.TimeoutHandler(srv, limits.Timeout, "request timeout")
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// the subscriptions are served on long-lived websocket connections,
+			// which can't be upgraded behind the timeout handler
+			if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				srv.ServeHTTP(w, r)
+				return
+			}
+			timeout.ServeHTTP(w, r)
+		})
+	}
+	return srv
+}
+
+const errDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+// DepthLimit reject the queries with too deeply nested fields
+type DepthLimit struct {
+	MaxDepth int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &DepthLimit{}
+
+func (d DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (d *DepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d DepthLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	depth := selectionDepth(op.SelectionSet, map[string]bool{})
+	if depth > d.MaxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.MaxDepth)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+
+	return nil
+}
+
+// selectionDepth return the maximum nesting of fields in a selection set.
+// Fragments don't count as a level of nesting.
+func selectionDepth(set ast.SelectionSet, visiting map[string]bool) int {
+	max := 0
+	for _, selection := range set {
+		var depth int
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			depth = 1 + selectionDepth(selection.SelectionSet, visiting)
+		case *ast.InlineFragment:
+			depth = selectionDepth(selection.SelectionSet, visiting)
+		case *ast.FragmentSpread:
+			// cycles are rejected during validation, but better safe than sorry
+			if selection.Definition == nil || visiting[selection.Name] {
+				continue
+			}
+			visiting[selection.Name] = true
+			depth = selectionDepth(selection.Definition.SelectionSet, visiting)
+			delete(visiting, selection.Name)
+		}
+
+		if depth > max {
+			max = depth
+		}
+	}
+	return max
+}
@@ -3,7 +3,13 @@
 package models
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
 	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/entity/dag"
 	"github.com/MichaelMure/git-bug/repository"
 )
@@ -13,6 +19,55 @@ type Authored interface {
 	IsAuthored()
 }
 
+type AckChangeFeedInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The name of the consumer of the change feed.
+	Consumer string `json:"consumer"`
+	// The sequence number of the last entry processed by the consumer.
+	Cursor int `json:"cursor"`
+}
+
+type AckChangeFeedPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The stored cursor of the consumer.
+	Cursor int `json:"cursor"`
+}
+
+// The number of actions during a month
+type ActivityCount struct {
+	// The first day of the month
+	Month     time.Time `json:"month"`
+	Authored  int       `json:"authored"`
+	Commented int       `json:"commented"`
+	Closed    int       `json:"closed"`
+}
+
+type AddAttachmentInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The name of the file, without directory.
+	Name string `json:"name"`
+	// The content of the file, encoded in base64.
+	Content string `json:"content"`
+}
+
+type AddAttachmentPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation.
+	Operation *bug.AddAttachmentOperation `json:"operation"`
+}
+
 type AddCommentAndCloseBugInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -24,6 +79,8 @@ type AddCommentAndCloseBugInput struct {
 	Message string `json:"message"`
 	// The collection of file's hash required for the first message.
 	Files []repository.Hash `json:"files"`
+	// Confirm the closing of a bug protected by the freeze mode of the repository.
+	ConfirmFreeze *bool `json:"confirmFreeze"`
 }
 
 type AddCommentAndCloseBugPayload struct {
@@ -102,6 +159,63 @@ type BugEdge struct {
 	Node BugWrapper `json:"node"`
 }
 
+// The value of a custom field of a bug, as defined in the repository configuration.
+type BugField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// The machine translations of the current title and messages of a bug in a language. The original texts prevail.
+type BugTranslations struct {
+	Lang string `json:"lang"`
+	// The translated title, null if not translated.
+	Title *string `json:"title"`
+	// The translated messages, in the order of the comments. The comments not translated are skipped.
+	Comments []*CommentTranslation `json:"comments"`
+}
+
+type ChangeAssigneeInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The prefixes of the ID of the identities to assign.
+	Added []string `json:"added"`
+	// The prefixes of the ID of the identities to unassign.
+	Removed []string `json:"removed"`
+}
+
+type ChangeAssigneePayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation
+	Operation *bug.SetAssigneeOperation `json:"operation"`
+}
+
+// A change of a repository in the change feed: either a new operation of a bug, or the removal of a bug
+type ChangeFeedEntry struct {
+	// The position of the entry in the feed, starting at 1. It never changes once assigned.
+	Seq int `json:"seq"`
+	// The identifier of the bug
+	BugID entity.Id `json:"bugId"`
+	// True when the bug has been removed, in which case there is no operation
+	Removed bool `json:"removed"`
+	// The new operation of the bug, unless the bug has been removed since
+	Operation dag.Operation `json:"operation"`
+}
+
+// A page of the change feed of a repository
+type ChangeFeedPage struct {
+	// The entries of the page, in order
+	Entries []*ChangeFeedEntry `json:"entries"`
+	// The sequence number of the last entry of the page, to read the next page after it, and to acknowledge once the entries are processed. When the page is empty, this is the sequence number the page was requested after.
+	Cursor int `json:"cursor"`
+}
+
 type ChangeLabelInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -133,6 +247,8 @@ type CloseBugInput struct {
 	RepoRef *string `json:"repoRef"`
 	// The bug ID's prefix.
 	Prefix string `json:"prefix"`
+	// Confirm the closing of a bug protected by the freeze mode of the repository.
+	ConfirmFreeze *bool `json:"confirmFreeze"`
 }
 
 type CloseBugPayload struct {
@@ -156,6 +272,12 @@ type CommentEdge struct {
 	Node   *bug.Comment `json:"node"`
 }
 
+// The machine translation of the message of a comment.
+type CommentTranslation struct {
+	ID      entity.CombinedId `json:"id"`
+	Message string            `json:"message"`
+}
+
 type EditCommentInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -178,6 +300,18 @@ type EditCommentPayload struct {
 	Operation *bug.EditCommentOperation `json:"operation"`
 }
 
+// The activity of an identity across all the bugs
+type IdentityActivity struct {
+	// The bugs created by the identity
+	Authored []BugWrapper `json:"authored"`
+	// The bugs the identity commented on
+	Commented []BugWrapper `json:"commented"`
+	// The bugs closed by the identity
+	Closed []BugWrapper `json:"closed"`
+	// The number of actions per month, in chronological order
+	Timeline []*ActivityCount `json:"timeline"`
+}
+
 type IdentityConnection struct {
 	Edges      []*IdentityEdge   `json:"edges"`
 	Nodes      []IdentityWrapper `json:"nodes"`
@@ -197,11 +331,33 @@ type LabelConnection struct {
 	TotalCount int          `json:"totalCount"`
 }
 
+// The number of bugs having a label
+type LabelCount struct {
+	Label bug.Label `json:"label"`
+	Count int       `json:"count"`
+}
+
 type LabelEdge struct {
 	Cursor string    `json:"cursor"`
 	Node   bug.Label `json:"node"`
 }
 
+type MarkBugAsReadInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+}
+
+type MarkBugAsReadPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+}
+
 type NewBugInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -268,6 +424,139 @@ type PageInfo struct {
 	EndCursor string `json:"endCursor"`
 }
 
+type ReactionInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// A prefix of the CombinedId of the comment to react to.
+	TargetPrefix string `json:"targetPrefix"`
+	// The reaction to add or remove.
+	Reaction bug.Reaction `json:"reaction"`
+}
+
+type ReactionPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation.
+	Operation *bug.ReactionOperation `json:"operation"`
+}
+
+type RedactCommentInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// A prefix of the CombinedId of the comment to be redacted.
+	TargetPrefix string `json:"targetPrefix"`
+	// Remove the comment from the bug instead of only hiding its content. The description of a bug can't be deleted.
+	Delete *bool `json:"delete"`
+}
+
+type RedactCommentPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation.
+	Operation *bug.RedactCommentOperation `json:"operation"`
+}
+
+type RelationInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The type of the relation.
+	Relation bug.RelationType `json:"relation"`
+	// The prefix of the ID of the other bug.
+	TargetPrefix string `json:"targetPrefix"`
+}
+
+type RelationPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation, a RelationAddOperation or a RelationRemoveOperation.
+	Operation dag.Operation `json:"operation"`
+}
+
+// A change of a repository
+type RepositoryEvent struct {
+	Kind RepositoryEventKind `json:"kind"`
+	// The id of the bug created, updated or removed
+	BugID *string `json:"bugId"`
+	// The id of the identity created or updated
+	IdentityID *string `json:"identityId"`
+	// The remote merged
+	Remote *string `json:"remote"`
+	// The ids of the bugs created or updated by a merge
+	MergedBugIds []string `json:"mergedBugIds"`
+	// The ids of the identities created or updated by a merge
+	MergedIdentityIds []string `json:"mergedIdentityIds"`
+}
+
+// Aggregates over all the bugs of a repository
+type RepositoryStatistics struct {
+	// The number of open bugs
+	OpenCount int `json:"openCount"`
+	// The number of closed bugs
+	ClosedCount int `json:"closedCount"`
+	// The number of bugs per label, sorted by label
+	Labels []*LabelCount `json:"labels"`
+	// The number of actions per month on all bugs, in chronological order
+	Activity []*ActivityCount `json:"activity"`
+}
+
+type SetBugStateInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The state of the workflow to move the bug to.
+	State string `json:"state"`
+	// Confirm the closing of a bug protected by the freeze mode of the repository.
+	ConfirmFreeze *bool `json:"confirmFreeze"`
+}
+
+type SetBugStatePayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation.
+	Operation *bug.SetStatusOperation `json:"operation"`
+}
+
+type SetFieldInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The name of the custom field.
+	Name string `json:"name"`
+	// The new value of the field. An empty value unset the field.
+	Value string `json:"value"`
+}
+
+type SetFieldPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation
+	Operation *bug.SetFieldOperation `json:"operation"`
+}
+
 type SetTitleInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -301,3 +590,56 @@ type TimelineItemEdge struct {
 	Cursor string           `json:"cursor"`
 	Node   bug.TimelineItem `json:"node"`
 }
+
+// The kind of a change of a repository
+type RepositoryEventKind string
+
+const (
+	// A bug has been created locally or received from a remote
+	RepositoryEventKindBugCreated RepositoryEventKind = "BUG_CREATED"
+	// A bug has been modified
+	RepositoryEventKindBugUpdated RepositoryEventKind = "BUG_UPDATED"
+	// A bug has been removed
+	RepositoryEventKindBugRemoved RepositoryEventKind = "BUG_REMOVED"
+	// An identity has been created or modified
+	RepositoryEventKindIDEntityUpdated RepositoryEventKind = "IDENTITY_UPDATED"
+	// A merge from a remote has completed
+	RepositoryEventKindMergeCompleted RepositoryEventKind = "MERGE_COMPLETED"
+)
+
+var AllRepositoryEventKind = []RepositoryEventKind{
+	RepositoryEventKindBugCreated,
+	RepositoryEventKindBugUpdated,
+	RepositoryEventKindBugRemoved,
+	RepositoryEventKindIDEntityUpdated,
+	RepositoryEventKindMergeCompleted,
+}
+
+func (e RepositoryEventKind) IsValid() bool {
+	switch e {
+	case RepositoryEventKindBugCreated, RepositoryEventKindBugUpdated, RepositoryEventKindBugRemoved, RepositoryEventKindIDEntityUpdated, RepositoryEventKindMergeCompleted:
+		return true
+	}
+	return false
+}
+
+func (e RepositoryEventKind) String() string {
+	return string(e)
+}
+
+func (e *RepositoryEventKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RepositoryEventKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RepositoryEventKind", str)
+	}
+	return nil
+}
+
+func (e RepositoryEventKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -18,9 +18,27 @@ type BugWrapper interface {
 	Id() entity.Id
 	LastEdit() time.Time
 	Status() common.Status
+	// WorkflowState return the state of the bug in the workflow of the repository
+	WorkflowState() (string, error)
 	Title() string
 	Comments() ([]bug.Comment, error)
 	Labels() []bug.Label
+	// CustomFields return the values of the custom fields, by name
+	CustomFields() map[string]string
+	// Relations return the links from the bug to other bugs
+	Relations() []bug.Relation
+	// Backlinks return the bugs referencing the bug in their messages
+	Backlinks() ([]BugWrapper, error)
+	// MergeConflicts return the changes overridden by concurrent ones when merging
+	MergeConflicts() ([]bug.MergeConflict, error)
+	// Translations return the stored machine translations in a language
+	Translations(lang string) (bug.Translations, error)
+	// TranslationLanguages return the languages with stored translations
+	TranslationLanguages() ([]string, error)
+	// Attachments return the files attached to the bug
+	Attachments() ([]bug.Attachment, error)
+	// Assignees return the ids of the identities the bug is assigned to
+	Assignees() []entity.Id
 	Author() (IdentityWrapper, error)
 	Actors() ([]IdentityWrapper, error)
 	Participants() ([]IdentityWrapper, error)
@@ -90,6 +108,14 @@ func (lb *lazyBug) Status() common.Status {
 	return lb.excerpt.Status
 }
 
+func (lb *lazyBug) WorkflowState() (string, error) {
+	workflow, err := lb.cache.Workflow()
+	if err != nil {
+		return "", err
+	}
+	return workflow.StateOf(lb.excerpt.Status, lb.excerpt.State), nil
+}
+
 func (lb *lazyBug) Title() string {
 	return lb.excerpt.Title
 }
@@ -106,6 +132,54 @@ func (lb *lazyBug) Labels() []bug.Label {
 	return lb.excerpt.Labels
 }
 
+func (lb *lazyBug) CustomFields() map[string]string {
+	return lb.excerpt.Fields
+}
+
+func (lb *lazyBug) Relations() []bug.Relation {
+	return lb.excerpt.Relations
+}
+
+func (lb *lazyBug) Backlinks() ([]BugWrapper, error) {
+	return backlinks(lb.cache, lb.excerpt.Id)
+}
+
+func (lb *lazyBug) MergeConflicts() ([]bug.MergeConflict, error) {
+	err := lb.load()
+	if err != nil {
+		return nil, err
+	}
+	return lb.snap.MergeConflicts, nil
+}
+
+func (lb *lazyBug) Translations(lang string) (bug.Translations, error) {
+	err := lb.load()
+	if err != nil {
+		return bug.Translations{}, err
+	}
+	return lb.snap.Translations(lang), nil
+}
+
+func (lb *lazyBug) TranslationLanguages() ([]string, error) {
+	err := lb.load()
+	if err != nil {
+		return nil, err
+	}
+	return lb.snap.TranslationLanguages(), nil
+}
+
+func (lb *lazyBug) Attachments() ([]bug.Attachment, error) {
+	err := lb.load()
+	if err != nil {
+		return nil, err
+	}
+	return lb.snap.Attachments, nil
+}
+
+func (lb *lazyBug) Assignees() []entity.Id {
+	return lb.excerpt.Assignees
+}
+
 func (lb *lazyBug) Author() (IdentityWrapper, error) {
 	return lb.identity(lb.excerpt.AuthorId)
 }
@@ -158,10 +232,11 @@ var _ BugWrapper = &loadedBug{}
 
 type loadedBug struct {
 	*bug.Snapshot
+	cache *cache.RepoCache
 }
 
-func NewLoadedBug(snap *bug.Snapshot) *loadedBug {
-	return &loadedBug{Snapshot: snap}
+func NewLoadedBug(cache *cache.RepoCache, snap *bug.Snapshot) *loadedBug {
+	return &loadedBug{Snapshot: snap, cache: cache}
 }
 
 func (l *loadedBug) LastEdit() time.Time {
@@ -172,6 +247,14 @@ func (l *loadedBug) Status() common.Status {
 	return l.Snapshot.Status
 }
 
+func (l *loadedBug) WorkflowState() (string, error) {
+	workflow, err := l.cache.Workflow()
+	if err != nil {
+		return "", err
+	}
+	return workflow.StateOf(l.Snapshot.Status, l.Snapshot.State), nil
+}
+
 func (l *loadedBug) Title() string {
 	return l.Snapshot.Title
 }
@@ -184,6 +267,52 @@ func (l *loadedBug) Labels() []bug.Label {
 	return l.Snapshot.Labels
 }
 
+func (l *loadedBug) CustomFields() map[string]string {
+	return l.Snapshot.Fields
+}
+
+func (l *loadedBug) Relations() []bug.Relation {
+	return l.Snapshot.Relations
+}
+
+func (l *loadedBug) Backlinks() ([]BugWrapper, error) {
+	return backlinks(l.cache, l.Snapshot.Id())
+}
+
+// backlinks return the bugs referencing a bug, lazily loaded
+func backlinks(c *cache.RepoCache, id entity.Id) ([]BugWrapper, error) {
+	ids := c.Backlinks(id)
+	result := make([]BugWrapper, 0, len(ids))
+	for _, source := range ids {
+		excerpt, err := c.ResolveBugExcerpt(source)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, NewLazyBug(c, excerpt))
+	}
+	return result, nil
+}
+
+func (l *loadedBug) MergeConflicts() ([]bug.MergeConflict, error) {
+	return l.Snapshot.MergeConflicts, nil
+}
+
+func (l *loadedBug) Translations(lang string) (bug.Translations, error) {
+	return l.Snapshot.Translations(lang), nil
+}
+
+func (l *loadedBug) TranslationLanguages() ([]string, error) {
+	return l.Snapshot.TranslationLanguages(), nil
+}
+
+func (l *loadedBug) Attachments() ([]bug.Attachment, error) {
+	return l.Snapshot.Attachments, nil
+}
+
+func (l *loadedBug) Assignees() []entity.Id {
+	return l.Snapshot.Assignees
+}
+
 func (l *loadedBug) Author() (IdentityWrapper, error) {
 	return NewLoadedIdentity(l.Snapshot.Author), nil
 }
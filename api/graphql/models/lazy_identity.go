@@ -21,6 +21,7 @@ type IdentityWrapper interface {
 	Keys() ([]*identity.Key, error)
 	DisplayName() string
 	IsProtected() (bool, error)
+	IsBot() bool
 }
 
 var _ IdentityWrapper = &lazyIdentity{}
@@ -108,6 +109,10 @@ func (li *lazyIdentity) IsProtected() (bool, error) {
 	return id.IsProtected(), nil
 }
 
+func (li *lazyIdentity) IsBot() bool {
+	return li.excerpt.IsBot()
+}
+
 var _ IdentityWrapper = &loadedIdentity{}
 
 type loadedIdentity struct {
@@ -137,3 +142,7 @@ func (l loadedIdentity) Keys() ([]*identity.Key, error) {
 func (l loadedIdentity) IsProtected() (bool, error) {
 	return l.Interface.IsProtected(), nil
 }
+
+func (l loadedIdentity) IsBot() bool {
+	return identity.IsBotIdentity(l.Interface)
+}
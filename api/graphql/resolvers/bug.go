@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"sort"
 
 	"github.com/MichaelMure/git-bug/api/graphql/connections"
 	"github.com/MichaelMure/git-bug/api/graphql/graph"
@@ -18,6 +19,54 @@ func (bugResolver) HumanID(_ context.Context, obj models.BugWrapper) (string, er
 	return obj.Id().Human(), nil
 }
 
+func (bugResolver) State(_ context.Context, obj models.BugWrapper) (string, error) {
+	return obj.WorkflowState()
+}
+
+func (bugResolver) Fields(_ context.Context, obj models.BugWrapper) ([]*models.BugField, error) {
+	values := obj.CustomFields()
+	result := make([]*models.BugField, 0, len(values))
+	for name, value := range values {
+		result = append(result, &models.BugField{Name: name, Value: value})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+func (bugResolver) Translations(_ context.Context, obj models.BugWrapper, lang string) (*models.BugTranslations, error) {
+	if err := bug.ValidateLanguage(lang); err != nil {
+		return nil, err
+	}
+
+	translations, err := obj.Translations(lang)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := obj.Comments()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.BugTranslations{
+		Lang:     lang,
+		Comments: []*models.CommentTranslation{},
+	}
+	if translations.Title != "" {
+		result.Title = &translations.Title
+	}
+	for _, comment := range comments {
+		if message, ok := translations.Messages[comment.CombinedId()]; ok {
+			result.Comments = append(result.Comments, &models.CommentTranslation{
+				ID:      comment.CombinedId(),
+				Message: message,
+			})
+		}
+	}
+	return result, nil
+}
+
 func (bugResolver) Comments(_ context.Context, obj models.BugWrapper, after *string, before *string, first *int, last *int) (*models.CommentConnection, error) {
 	input := models.ConnectionInput{
 		Before: before,
@@ -181,3 +230,15 @@ func (bugResolver) Participants(_ context.Context, obj models.BugWrapper, after
 
 	return connections.IdentityCon(participants, edger, conMaker, input)
 }
+
+var _ graph.MergeConflictResolver = &mergeConflictResolver{}
+
+type mergeConflictResolver struct{}
+
+func (mergeConflictResolver) Kept(_ context.Context, obj *bug.MergeConflict) (dag.Operation, error) {
+	return obj.Kept, nil
+}
+
+func (mergeConflictResolver) Overridden(_ context.Context, obj *bug.MergeConflict) (dag.Operation, error) {
+	return obj.Overridden, nil
+}
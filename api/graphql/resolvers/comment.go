@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"time"
 
 	"github.com/MichaelMure/git-bug/api/graphql/graph"
 	"github.com/MichaelMure/git-bug/api/graphql/models"
@@ -20,3 +21,28 @@ func (c commentResolver) ID(ctx context.Context, obj *bug.Comment) (entity.Combi
 func (c commentResolver) Author(_ context.Context, obj *bug.Comment) (models.IdentityWrapper, error) {
 	return models.NewLoadedIdentity(obj.Author), nil
 }
+
+var _ graph.ReactionGroupResolver = &reactionGroupResolver{}
+
+type reactionGroupResolver struct{}
+
+func (reactionGroupResolver) Authors(_ context.Context, obj *bug.ReactionGroup) ([]models.IdentityWrapper, error) {
+	result := make([]models.IdentityWrapper, len(obj.Authors))
+	for i, author := range obj.Authors {
+		result[i] = models.NewLoadedIdentity(author)
+	}
+	return result, nil
+}
+
+var _ graph.AttachmentResolver = &attachmentResolver{}
+
+type attachmentResolver struct{}
+
+func (attachmentResolver) Author(_ context.Context, obj *bug.Attachment) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (attachmentResolver) Date(_ context.Context, obj *bug.Attachment) (*time.Time, error) {
+	t := obj.UnixTime.Time()
+	return &t, nil
+}
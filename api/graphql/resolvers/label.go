@@ -5,18 +5,41 @@ import (
 	"image/color"
 
 	"github.com/MichaelMure/git-bug/api/graphql/graph"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entities/bug"
 )
 
 var _ graph.LabelResolver = &labelResolver{}
 
-type labelResolver struct{}
+type labelResolver struct {
+	cache *cache.MultiRepoCache
+}
 
 func (labelResolver) Name(ctx context.Context, obj *bug.Label) (string, error) {
 	return obj.String(), nil
 }
 
-func (labelResolver) Color(ctx context.Context, obj *bug.Label) (*color.RGBA, error) {
+// Color use the label registry of the default repository when there is one,
+// as a label doesn't know its repository
+func (r labelResolver) Color(ctx context.Context, obj *bug.Label) (*color.RGBA, error) {
 	rgba := obj.Color().RGBA()
+	if repo, err := r.cache.DefaultRepo(); err == nil {
+		rgba = repo.LabelColor(*obj).RGBA()
+	}
 	return &rgba, nil
 }
+
+func (r labelResolver) Description(ctx context.Context, obj *bug.Label) (*string, error) {
+	repo, err := r.cache.DefaultRepo()
+	if err != nil {
+		return nil, nil
+	}
+	info, err := repo.LabelInfo(*obj)
+	if err != nil {
+		return nil, err
+	}
+	if info.Description == "" {
+		return nil, nil
+	}
+	return &info.Description, nil
+}
@@ -2,6 +2,8 @@ package resolvers
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 	"time"
 
 	"github.com/MichaelMure/git-bug/api/auth"
@@ -9,6 +11,8 @@ import (
 	"github.com/MichaelMure/git-bug/api/graphql/models"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/text"
 )
 
@@ -62,7 +66,7 @@ func (r mutationResolver) NewBug(ctx context.Context, input models.NewBugInput)
 
 	return &models.NewBugPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
@@ -94,7 +98,7 @@ func (r mutationResolver) AddComment(ctx context.Context, input models.AddCommen
 
 	return &models.AddCommentPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
@@ -110,6 +114,16 @@ func (r mutationResolver) AddCommentAndClose(ctx context.Context, input models.A
 		return nil, err
 	}
 
+	err = b.ValidateStatusChange(common.ClosedStatus, "")
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := b.FreezeMetadata(author, input.ConfirmFreeze != nil && *input.ConfirmFreeze)
+	if err != nil {
+		return nil, err
+	}
+
 	_, opAddComment, err := b.AddCommentRaw(author,
 		time.Now().Unix(),
 		text.Cleanup(input.Message),
@@ -119,7 +133,7 @@ func (r mutationResolver) AddCommentAndClose(ctx context.Context, input models.A
 		return nil, err
 	}
 
-	opClose, err := b.CloseRaw(author, time.Now().Unix(), nil)
+	opClose, err := b.CloseRaw(author, time.Now().Unix(), metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +145,7 @@ func (r mutationResolver) AddCommentAndClose(ctx context.Context, input models.A
 
 	return &models.AddCommentAndCloseBugPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		CommentOperation: opAddComment,
 		StatusOperation:  opClose,
 	}, nil
@@ -148,6 +162,11 @@ func (r mutationResolver) AddCommentAndReopen(ctx context.Context, input models.
 		return nil, err
 	}
 
+	err = b.ValidateStatusChange(common.OpenStatus, "")
+	if err != nil {
+		return nil, err
+	}
+
 	_, opAddComment, err := b.AddCommentRaw(author,
 		time.Now().Unix(),
 		text.Cleanup(input.Message),
@@ -169,7 +188,7 @@ func (r mutationResolver) AddCommentAndReopen(ctx context.Context, input models.
 
 	return &models.AddCommentAndReopenBugPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		CommentOperation: opAddComment,
 		StatusOperation:  opReopen,
 	}, nil
@@ -209,11 +228,228 @@ func (r mutationResolver) EditComment(ctx context.Context, input models.EditComm
 
 	return &models.EditCommentPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) RedactComment(ctx context.Context, input models.RedactCommentInput) (*models.RedactCommentPayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	b, target, err := repo.ResolveComment(input.TargetPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var op *bug.RedactCommentOperation
+	if input.Delete != nil && *input.Delete {
+		op, err = b.DeleteCommentRaw(author, time.Now().Unix(), target, nil)
+	} else {
+		op, err = b.RedactCommentRaw(author, time.Now().Unix(), target, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.RedactCommentPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) AddReaction(ctx context.Context, input models.ReactionInput) (*models.ReactionPayload, error) {
+	return r.react(ctx, input, false)
+}
+
+func (r mutationResolver) RemoveReaction(ctx context.Context, input models.ReactionInput) (*models.ReactionPayload, error) {
+	return r.react(ctx, input, true)
+}
+
+func (r mutationResolver) react(ctx context.Context, input models.ReactionInput, remove bool) (*models.ReactionPayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	b, target, err := repo.ResolveComment(input.TargetPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var op *bug.ReactionOperation
+	if remove {
+		op, err = b.RemoveReactionRaw(author, time.Now().Unix(), target, input.Reaction, nil)
+	} else {
+		op, err = b.AddReactionRaw(author, time.Now().Unix(), target, input.Reaction, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ReactionPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
 
+func (r mutationResolver) AddRelation(ctx context.Context, input models.RelationInput) (*models.RelationPayload, error) {
+	return r.relate(ctx, input, false)
+}
+
+func (r mutationResolver) RemoveRelation(ctx context.Context, input models.RelationInput) (*models.RelationPayload, error) {
+	return r.relate(ctx, input, true)
+}
+
+func (r mutationResolver) relate(ctx context.Context, input models.RelationInput, remove bool) (*models.RelationPayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload models.RelationPayload
+	if remove {
+		// the other bug might not be known locally anymore
+		relation, err := b.ResolveRelation(input.Relation, input.TargetPrefix)
+		if err != nil {
+			return nil, err
+		}
+		payload.Operation, err = b.RemoveRelationRaw(author, time.Now().Unix(), relation.Type, relation.Target, nil)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		target, err := repo.ResolveBugPrefix(input.TargetPrefix)
+		if err != nil {
+			return nil, err
+		}
+		payload.Operation, err = b.AddRelationRaw(author, time.Now().Unix(), input.Relation, target.Id(), nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	payload.ClientMutationID = input.ClientMutationID
+	payload.Bug = models.NewLoadedBug(repo, b.Snapshot())
+	return &payload, nil
+}
+
+func (r mutationResolver) AddAttachment(ctx context.Context, input models.AddAttachmentInput) (*models.AddAttachmentPayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := base64.StdEncoding.DecodeString(input.Content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content: %w", err)
+	}
+
+	op, err := b.AttachRaw(author, time.Now().Unix(), input.Name, content, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AddAttachmentPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) ChangeAssignees(ctx context.Context, input models.ChangeAssigneeInput) (*models.ChangeAssigneePayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := resolveIdentityPrefixes(repo, input.Added)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := resolveIdentityPrefixes(repo, input.Removed)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := b.SetAssigneeRaw(author, time.Now().Unix(), added, removed, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ChangeAssigneePayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func resolveIdentityPrefixes(repo *cache.RepoCache, prefixes []string) ([]entity.Id, error) {
+	ids := make([]entity.Id, len(prefixes))
+	for i, prefix := range prefixes {
+		id, err := repo.ResolveIdentityPrefix(prefix)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id.Id()
+	}
+	return ids, nil
+}
+
 func (r mutationResolver) ChangeLabels(ctx context.Context, input *models.ChangeLabelInput) (*models.ChangeLabelPayload, error) {
 	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
 	if err != nil {
@@ -248,7 +484,7 @@ func (r mutationResolver) ChangeLabels(ctx context.Context, input *models.Change
 
 	return &models.ChangeLabelPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 		Results:          resultsPtr,
 	}, nil
@@ -265,6 +501,11 @@ func (r mutationResolver) OpenBug(ctx context.Context, input models.OpenBugInput
 		return nil, err
 	}
 
+	err = b.ValidateStatusChange(common.OpenStatus, "")
+	if err != nil {
+		return nil, err
+	}
+
 	op, err := b.OpenRaw(author, time.Now().Unix(), nil)
 	if err != nil {
 		return nil, err
@@ -277,7 +518,7 @@ func (r mutationResolver) OpenBug(ctx context.Context, input models.OpenBugInput
 
 	return &models.OpenBugPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
@@ -293,7 +534,17 @@ func (r mutationResolver) CloseBug(ctx context.Context, input models.CloseBugInp
 		return nil, err
 	}
 
-	op, err := b.CloseRaw(author, time.Now().Unix(), nil)
+	err = b.ValidateStatusChange(common.ClosedStatus, "")
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := b.FreezeMetadata(author, input.ConfirmFreeze != nil && *input.ConfirmFreeze)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := b.CloseRaw(author, time.Now().Unix(), metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -305,7 +556,91 @@ func (r mutationResolver) CloseBug(ctx context.Context, input models.CloseBugInp
 
 	return &models.CloseBugPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) SetField(ctx context.Context, input models.SetFieldInput) (*models.SetFieldPayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := b.SetFieldRaw(
+		author,
+		time.Now().Unix(),
+		input.Name,
+		text.CleanupOneLine(input.Value),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SetFieldPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) SetBugState(ctx context.Context, input models.SetBugStateInput) (*models.SetBugStatePayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, err := repo.Workflow()
+	if err != nil {
+		return nil, err
+	}
+	state, ok := workflow.State(input.State)
+	if !ok {
+		return nil, fmt.Errorf("unknown state \"%s\"", input.State)
+	}
+
+	err = b.ValidateStatusChange(state.Status, state.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata map[string]string
+	if state.Status == common.ClosedStatus {
+		metadata, err = b.FreezeMetadata(author, input.ConfirmFreeze != nil && *input.ConfirmFreeze)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	op, err := b.SetStateRaw(author, time.Now().Unix(), state.Name, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SetBugStatePayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
@@ -338,7 +673,51 @@ func (r mutationResolver) SetTitle(ctx context.Context, input models.SetTitleInp
 
 	return &models.SetTitlePayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
+
+func (r mutationResolver) MarkBugAsRead(_ context.Context, input models.MarkBugAsReadInput) (*models.MarkBugAsReadPayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repo.MarkAsRead(b.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MarkBugAsReadPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+	}, nil
+}
+
+func (r mutationResolver) AckChangeFeed(ctx context.Context, input models.AckChangeFeedInput) (*models.AckChangeFeedPayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// only an authenticated client can move the cursor of a consumer
+	_, err = auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Cursor < 0 {
+		return nil, fmt.Errorf("invalid sequence number %d", input.Cursor)
+	}
+
+	err = repo.SetChangeFeedCursor(input.Consumer, uint64(input.Cursor))
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AckChangeFeedPayload{
+		ClientMutationID: input.ClientMutationID,
+		Cursor:           input.Cursor,
+	}, nil
+}
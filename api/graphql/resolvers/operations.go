@@ -52,6 +52,79 @@ func (editCommentOperationResolver) Date(_ context.Context, obj *bug.EditComment
 	return &t, nil
 }
 
+var _ graph.RedactCommentOperationResolver = redactCommentOperationResolver{}
+
+type redactCommentOperationResolver struct{}
+
+func (redactCommentOperationResolver) Target(_ context.Context, obj *bug.RedactCommentOperation) (string, error) {
+	return obj.Target.String(), nil
+}
+
+func (redactCommentOperationResolver) Author(_ context.Context, obj *bug.RedactCommentOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author()), nil
+}
+
+func (redactCommentOperationResolver) Date(_ context.Context, obj *bug.RedactCommentOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+var _ graph.ReactionOperationResolver = reactionOperationResolver{}
+
+type reactionOperationResolver struct{}
+
+func (reactionOperationResolver) Target(_ context.Context, obj *bug.ReactionOperation) (string, error) {
+	return obj.Target.String(), nil
+}
+
+func (reactionOperationResolver) Author(_ context.Context, obj *bug.ReactionOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author()), nil
+}
+
+func (reactionOperationResolver) Date(_ context.Context, obj *bug.ReactionOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+var _ graph.AddAttachmentOperationResolver = addAttachmentOperationResolver{}
+
+type addAttachmentOperationResolver struct{}
+
+func (addAttachmentOperationResolver) Author(_ context.Context, obj *bug.AddAttachmentOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author()), nil
+}
+
+func (addAttachmentOperationResolver) Date(_ context.Context, obj *bug.AddAttachmentOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+var _ graph.RelationAddOperationResolver = relationAddOperationResolver{}
+
+type relationAddOperationResolver struct{}
+
+func (relationAddOperationResolver) Author(_ context.Context, obj *bug.RelationAddOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author()), nil
+}
+
+func (relationAddOperationResolver) Date(_ context.Context, obj *bug.RelationAddOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+var _ graph.RelationRemoveOperationResolver = relationRemoveOperationResolver{}
+
+type relationRemoveOperationResolver struct{}
+
+func (relationRemoveOperationResolver) Author(_ context.Context, obj *bug.RelationRemoveOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author()), nil
+}
+
+func (relationRemoveOperationResolver) Date(_ context.Context, obj *bug.RelationRemoveOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
 var _ graph.LabelChangeOperationResolver = labelChangeOperationResolver{}
 
 type labelChangeOperationResolver struct{}
@@ -78,6 +151,13 @@ func (setStatusOperationResolver) Date(_ context.Context, obj *bug.SetStatusOper
 	return &t, nil
 }
 
+func (setStatusOperationResolver) State(_ context.Context, obj *bug.SetStatusOperation) (*string, error) {
+	if obj.State == "" {
+		return nil, nil
+	}
+	return &obj.State, nil
+}
+
 var _ graph.SetTitleOperationResolver = setTitleOperationResolver{}
 
 type setTitleOperationResolver struct{}
@@ -90,3 +170,59 @@ func (setTitleOperationResolver) Date(_ context.Context, obj *bug.SetTitleOperat
 	t := obj.Time()
 	return &t, nil
 }
+
+var _ graph.RequestInfoOperationResolver = requestInfoOperationResolver{}
+
+type requestInfoOperationResolver struct{}
+
+func (requestInfoOperationResolver) Author(_ context.Context, obj *bug.RequestInfoOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author()), nil
+}
+
+func (requestInfoOperationResolver) Date(_ context.Context, obj *bug.RequestInfoOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+var _ graph.SetAssigneeOperationResolver = setAssigneeOperationResolver{}
+
+type setAssigneeOperationResolver struct{}
+
+func (setAssigneeOperationResolver) Author(_ context.Context, obj *bug.SetAssigneeOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author()), nil
+}
+
+func (setAssigneeOperationResolver) Date(_ context.Context, obj *bug.SetAssigneeOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+var _ graph.SetFieldOperationResolver = setFieldOperationResolver{}
+
+type setFieldOperationResolver struct{}
+
+func (setFieldOperationResolver) Author(_ context.Context, obj *bug.SetFieldOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author()), nil
+}
+
+func (setFieldOperationResolver) Date(_ context.Context, obj *bug.SetFieldOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+var _ graph.SyncConflictOperationResolver = syncConflictOperationResolver{}
+
+type syncConflictOperationResolver struct{}
+
+func (syncConflictOperationResolver) Author(_ context.Context, obj *bug.SyncConflictOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author()), nil
+}
+
+func (syncConflictOperationResolver) Date(_ context.Context, obj *bug.SyncConflictOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+func (syncConflictOperationResolver) Winner(_ context.Context, obj *bug.SyncConflictOperation) (string, error) {
+	return string(obj.Winner), nil
+}
@@ -2,11 +2,13 @@ package resolvers
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/MichaelMure/git-bug/api/auth"
 	"github.com/MichaelMure/git-bug/api/graphql/connections"
 	"github.com/MichaelMure/git-bug/api/graphql/graph"
 	"github.com/MichaelMure/git-bug/api/graphql/models"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entities/bug"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/query"
@@ -40,12 +42,6 @@ func (repoResolver) AllBugs(_ context.Context, obj *models.Repository, after *st
 		q = query.NewQuery()
 	}
 
-	// Simply pass a []string with the ids to the pagination algorithm
-	source, err := obj.Repo.QueryBugs(q)
-	if err != nil {
-		return nil, err
-	}
-
 	// The edger create a custom edge holding just the id
 	edger := func(id entity.Id, offset int) connections.Edge {
 		return connections.LazyBugEdge{
@@ -82,9 +78,74 @@ func (repoResolver) AllBugs(_ context.Context, obj *models.Repository, after *st
 		}, nil
 	}
 
+	// Paginating forward is done by the cache, which keep the sorted result of
+	// the query to serve the next pages
+	if before == nil && last == nil {
+		return allBugsPage(obj, q, input, edger, conMaker)
+	}
+
+	// Simply pass a []string with the ids to the pagination algorithm
+	source, err := obj.Repo.QueryBugs(q)
+	if err != nil {
+		return nil, err
+	}
+
 	return connections.LazyBugCon(source, edger, conMaker, input)
 }
 
+func allBugsPage(obj *models.Repository, q *query.Query, input models.ConnectionInput, edger connections.LazyBugEdgeMaker, conMaker connections.LazyBugConMaker) (*models.BugConnection, error) {
+	var req cache.BugPageRequest
+
+	if input.After != nil {
+		offset, err := connections.CursorToOffset(*input.After)
+		if err != nil {
+			return nil, err
+		}
+		req.Offset = offset + 1
+	}
+
+	if input.First != nil {
+		if *input.First < 0 {
+			return nil, fmt.Errorf("first less than zero")
+		}
+		if *input.First == 0 {
+			// only the total count is requested
+			page, err := obj.Repo.QueryBugsPage(q, cache.BugPageRequest{Limit: 1, Offset: req.Offset})
+			if err != nil {
+				return nil, err
+			}
+			info := &models.PageInfo{
+				HasPreviousPage: input.After != nil,
+				HasNextPage:     len(page.Ids) > 0,
+			}
+			return conMaker(nil, nil, info, page.TotalCount)
+		}
+		req.Limit = *input.First
+	}
+
+	page, err := obj.Repo.QueryBugsPage(q, req)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*connections.LazyBugEdge, len(page.Ids))
+	for i, id := range page.Ids {
+		e := edger(id, page.Offset+i).(connections.LazyBugEdge)
+		edges[i] = &e
+	}
+
+	info := &models.PageInfo{
+		HasPreviousPage: input.After != nil,
+		HasNextPage:     page.Offset+len(page.Ids) < page.TotalCount,
+	}
+	if len(edges) > 0 {
+		info.StartCursor = edges[0].Cursor
+		info.EndCursor = edges[len(edges)-1].Cursor
+	}
+
+	return conMaker(edges, page.Ids, info, page.TotalCount)
+}
+
 func (repoResolver) Bug(_ context.Context, obj *models.Repository, prefix string) (models.BugWrapper, error) {
 	excerpt, err := obj.Repo.ResolveBugExcerptPrefix(prefix)
 	if err != nil {
@@ -153,6 +214,100 @@ func (repoResolver) Identity(_ context.Context, obj *models.Repository, prefix s
 	return models.NewLazyIdentity(obj.Repo, excerpt), nil
 }
 
+func (repoResolver) IdentityActivity(_ context.Context, obj *models.Repository, prefix string) (*models.IdentityActivity, error) {
+	excerpt, err := obj.Repo.ResolveIdentityExcerptPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := obj.Repo.IdentityActivity(excerpt.Id)
+
+	toBugs := func(ids []entity.Id) ([]models.BugWrapper, error) {
+		result := make([]models.BugWrapper, len(ids))
+		for i, id := range ids {
+			excerpt, err := obj.Repo.ResolveBugExcerpt(id)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = models.NewLazyBug(obj.Repo, excerpt)
+		}
+		return result, nil
+	}
+
+	result := &models.IdentityActivity{
+		Timeline: make([]*models.ActivityCount, len(activity.Timeline)),
+	}
+
+	if result.Authored, err = toBugs(activity.Authored); err != nil {
+		return nil, err
+	}
+	if result.Commented, err = toBugs(activity.Commented); err != nil {
+		return nil, err
+	}
+	if result.Closed, err = toBugs(activity.Closed); err != nil {
+		return nil, err
+	}
+
+	for i, count := range activity.Timeline {
+		result.Timeline[i] = &models.ActivityCount{
+			Month:     count.Month,
+			Authored:  count.Authored,
+			Commented: count.Commented,
+			Closed:    count.Closed,
+		}
+	}
+
+	return result, nil
+}
+
+func (repoResolver) Workflow(_ context.Context, obj *models.Repository) ([]*bug.WorkflowState, error) {
+	workflow, err := obj.Repo.Workflow()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*bug.WorkflowState, len(workflow.States))
+	for i, state := range workflow.States {
+		result[i] = &bug.WorkflowState{
+			Name:   state.Name,
+			Status: state.Status,
+			// a state without restriction can move to any other state
+			Next: workflow.NextStates(state.Name),
+		}
+	}
+
+	return result, nil
+}
+
+func (repoResolver) Statistics(_ context.Context, obj *models.Repository) (*models.RepositoryStatistics, error) {
+	stats := obj.Repo.Statistics()
+
+	result := &models.RepositoryStatistics{
+		OpenCount:   stats.Open,
+		ClosedCount: stats.Closed,
+		Labels:      make([]*models.LabelCount, len(stats.Labels)),
+		Activity:    make([]*models.ActivityCount, len(stats.Timeline)),
+	}
+
+	for i, count := range stats.Labels {
+		result.Labels[i] = &models.LabelCount{
+			Label: count.Label,
+			Count: count.Count,
+		}
+	}
+
+	for i, count := range stats.Timeline {
+		result.Activity[i] = &models.ActivityCount{
+			Month:     count.Month,
+			Authored:  count.Authored,
+			Commented: count.Commented,
+			Closed:    count.Closed,
+		}
+	}
+
+	return result, nil
+}
+
 func (repoResolver) UserIdentity(ctx context.Context, obj *models.Repository) (models.IdentityWrapper, error) {
 	id, err := auth.UserFromCtx(ctx, obj.Repo)
 	if err == auth.ErrNotAuthenticated {
@@ -163,6 +318,68 @@ func (repoResolver) UserIdentity(ctx context.Context, obj *models.Repository) (m
 	return models.NewLoadedIdentity(id.Identity), nil
 }
 
+func (repoResolver) ChangeFeed(_ context.Context, obj *models.Repository, consumer *string, after *int, first *int) (*models.ChangeFeedPage, error) {
+	var start uint64
+	switch {
+	case after != nil:
+		if *after < 0 {
+			return nil, fmt.Errorf("invalid sequence number %d", *after)
+		}
+		start = uint64(*after)
+	case consumer != nil:
+		var err error
+		start, err = obj.Repo.ChangeFeedCursor(*consumer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	limit := 0
+	if first != nil {
+		limit = *first
+	}
+
+	entries, err := obj.Repo.ChangeFeed(start, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ChangeFeedPage{
+		Entries: make([]*models.ChangeFeedEntry, len(entries)),
+		Cursor:  int(start),
+	}
+
+	for i, entry := range entries {
+		result.Entries[i] = &models.ChangeFeedEntry{
+			Seq:     int(entry.Seq),
+			BugID:   entry.BugId,
+			Removed: entry.Removed,
+		}
+		result.Cursor = int(entry.Seq)
+
+		if entry.Removed {
+			continue
+		}
+
+		b, err := obj.Repo.ResolveBug(entry.BugId)
+		if entity.IsErrNotFound(err) {
+			// removed since, the removal is a later entry
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, op := range b.Snapshot().Operations {
+			if op.Id() == entry.OpId {
+				result.Entries[i].Operation = op
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
 func (repoResolver) ValidLabels(_ context.Context, obj *models.Repository, after *string, before *string, first *int, last *int) (*models.LabelConnection, error) {
 	input := models.ConnectionInput{
 		Before: before,
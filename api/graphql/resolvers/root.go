@@ -30,6 +30,12 @@ func (r RootResolver) Mutation() graph.MutationResolver {
 	}
 }
 
+func (r RootResolver) Subscription() graph.SubscriptionResolver {
+	return &subscriptionResolver{
+		cache: r.MultiRepoCache,
+	}
+}
+
 func (RootResolver) Repository() graph.RepositoryResolver {
 	return &repoResolver{}
 }
@@ -46,8 +52,20 @@ func (r RootResolver) Comment() graph.CommentResolver {
 	return &commentResolver{}
 }
 
-func (RootResolver) Label() graph.LabelResolver {
-	return &labelResolver{}
+func (RootResolver) Attachment() graph.AttachmentResolver {
+	return &attachmentResolver{}
+}
+
+func (RootResolver) MergeConflict() graph.MergeConflictResolver {
+	return &mergeConflictResolver{}
+}
+
+func (RootResolver) ReactionGroup() graph.ReactionGroupResolver {
+	return &reactionGroupResolver{}
+}
+
+func (r RootResolver) Label() graph.LabelResolver {
+	return &labelResolver{cache: r.MultiRepoCache}
 }
 
 func (r RootResolver) Identity() graph.IdentityResolver {
@@ -90,6 +108,26 @@ func (r RootResolver) EditCommentOperation() graph.EditCommentOperationResolver
 	return &editCommentOperationResolver{}
 }
 
+func (RootResolver) ReactionOperation() graph.ReactionOperationResolver {
+	return &reactionOperationResolver{}
+}
+
+func (RootResolver) RedactCommentOperation() graph.RedactCommentOperationResolver {
+	return &redactCommentOperationResolver{}
+}
+
+func (RootResolver) AddAttachmentOperation() graph.AddAttachmentOperationResolver {
+	return &addAttachmentOperationResolver{}
+}
+
+func (RootResolver) RelationAddOperation() graph.RelationAddOperationResolver {
+	return &relationAddOperationResolver{}
+}
+
+func (RootResolver) RelationRemoveOperation() graph.RelationRemoveOperationResolver {
+	return &relationRemoveOperationResolver{}
+}
+
 func (RootResolver) LabelChangeOperation() graph.LabelChangeOperationResolver {
 	return &labelChangeOperationResolver{}
 }
@@ -101,3 +139,19 @@ func (RootResolver) SetStatusOperation() graph.SetStatusOperationResolver {
 func (RootResolver) SetTitleOperation() graph.SetTitleOperationResolver {
 	return &setTitleOperationResolver{}
 }
+
+func (RootResolver) RequestInfoOperation() graph.RequestInfoOperationResolver {
+	return &requestInfoOperationResolver{}
+}
+
+func (RootResolver) SetAssigneeOperation() graph.SetAssigneeOperationResolver {
+	return &setAssigneeOperationResolver{}
+}
+
+func (RootResolver) SetFieldOperation() graph.SetFieldOperationResolver {
+	return &setFieldOperationResolver{}
+}
+
+func (RootResolver) SyncConflictOperation() graph.SyncConflictOperationResolver {
+	return &syncConflictOperationResolver{}
+}
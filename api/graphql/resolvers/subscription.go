@@ -0,0 +1,108 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/MichaelMure/git-bug/api/graphql/graph"
+	"github.com/MichaelMure/git-bug/api/graphql/models"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+var _ graph.SubscriptionResolver = &subscriptionResolver{}
+
+type subscriptionResolver struct {
+	cache *cache.MultiRepoCache
+}
+
+var eventKinds = map[models.RepositoryEventKind]cache.EventKind{
+	models.RepositoryEventKindBugCreated:      cache.EventBugCreated,
+	models.RepositoryEventKindBugUpdated:      cache.EventBugUpdated,
+	models.RepositoryEventKindBugRemoved:      cache.EventBugRemoved,
+	models.RepositoryEventKindIDEntityUpdated: cache.EventIdentityUpdated,
+	models.RepositoryEventKindMergeCompleted:  cache.EventMergeCompleted,
+}
+
+func (r subscriptionResolver) RepositoryEvents(ctx context.Context, ref *string, kinds []models.RepositoryEventKind) (<-chan *models.RepositoryEvent, error) {
+	var repo *cache.RepoCache
+	var err error
+
+	if ref == nil {
+		repo, err = r.cache.DefaultRepo()
+	} else {
+		repo, err = r.cache.ResolveRepo(*ref)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	filter := cache.EventAll
+	if len(kinds) > 0 {
+		filter = 0
+		for _, kind := range kinds {
+			filter |= eventKinds[kind]
+		}
+	}
+
+	events, unsubscribe := repo.SubscribeKinds(filter)
+	result := make(chan *models.RepositoryEvent)
+
+	go func() {
+		defer close(result)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					// the cache has been closed
+					return
+				}
+				select {
+				case result <- toRepositoryEvent(event):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return result, nil
+}
+
+func toRepositoryEvent(event cache.Event) *models.RepositoryEvent {
+	id := func(id entity.Id) *string {
+		s := id.String()
+		return &s
+	}
+	ids := func(ids []entity.Id) []string {
+		result := make([]string, len(ids))
+		for i, id := range ids {
+			result[i] = id.String()
+		}
+		return result
+	}
+
+	switch event := event.(type) {
+	case cache.BugCreated:
+		return &models.RepositoryEvent{Kind: models.RepositoryEventKindBugCreated, BugID: id(event.BugId)}
+	case cache.BugUpdated:
+		return &models.RepositoryEvent{Kind: models.RepositoryEventKindBugUpdated, BugID: id(event.BugId)}
+	case cache.BugRemoved:
+		return &models.RepositoryEvent{Kind: models.RepositoryEventKindBugRemoved, BugID: id(event.BugId)}
+	case cache.IdentityUpdated:
+		return &models.RepositoryEvent{Kind: models.RepositoryEventKindIDEntityUpdated, IdentityID: id(event.IdentityId)}
+	case cache.MergeCompleted:
+		remote := event.Remote
+		return &models.RepositoryEvent{
+			Kind:              models.RepositoryEventKindMergeCompleted,
+			Remote:            &remote,
+			MergedBugIds:      ids(event.Bugs),
+			MergedIdentityIds: ids(event.Identities),
+		}
+	default:
+		panic("unknown event type")
+	}
+}
@@ -97,6 +97,13 @@ func (setStatusTimelineItem) Date(_ context.Context, obj *bug.SetStatusTimelineI
 	return &t, nil
 }
 
+func (setStatusTimelineItem) State(_ context.Context, obj *bug.SetStatusTimelineItem) (*string, error) {
+	if obj.State == "" {
+		return nil, nil
+	}
+	return &obj.State, nil
+}
+
 var _ graph.SetTitleTimelineItemResolver = setTitleTimelineItem{}
 
 type setTitleTimelineItem struct{}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"bytes"
+	"mime"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// implement a http.Handler that will serve the files attached to a bug, with
+// their name and content type.
+//
+// Expected gorilla/mux parameters:
+//   - "repo" : the ref of the repo or "" for the default one
+//   - "bug" : the prefix of the id of the bug
+//   - "file" : the name of the file, or a prefix of its hash
+type attachmentHandler struct {
+	mrc *cache.MultiRepoCache
+}
+
+func NewAttachmentHandler(mrc *cache.MultiRepoCache) http.Handler {
+	return &attachmentHandler{mrc: mrc}
+}
+
+func (ah *attachmentHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	var repo *cache.RepoCache
+	var err error
+
+	repoVar := mux.Vars(r)["repo"]
+	switch repoVar {
+	case "":
+		repo, err = ah.mrc.DefaultRepo()
+	default:
+		repo, err = ah.mrc.ResolveRepo(repoVar)
+	}
+
+	if err != nil {
+		http.Error(rw, "invalid repo reference", http.StatusBadRequest)
+		return
+	}
+
+	b, err := repo.ResolveBugPrefix(mux.Vars(r)["bug"])
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// only the files attached to the bug are served, not any blob of the repository
+	attachment, data, err := b.ReadAttachment(mux.Vars(r)["file"])
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	rw.Header().Set("Content-Type", attachment.ContentType)
+	rw.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	rw.Header().Set("X-Content-Type-Options", "nosniff")
+
+	http.ServeContent(rw, r, attachment.Name, attachment.UnixTime.Time(), bytes.NewReader(data))
+}
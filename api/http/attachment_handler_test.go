@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestAttachmentHandler(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	mrc := cache.NewMultiRepoCache()
+	repoCache, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+	defer mrc.Close()
+
+	author, err := repoCache.NewIdentity("test identity", "test@test.org")
+	require.NoError(t, err)
+	require.NoError(t, repoCache.SetUserIdentity(author))
+
+	b, _, err := repoCache.NewBug("title", "message")
+	require.NoError(t, err)
+	op, err := b.Attach("report.json", []byte(`{"ok": true}`))
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	// a blob that is not attached to the bug
+	other, err := repoCache.StoreData([]byte("secret"))
+	require.NoError(t, err)
+
+	get := func(bugPrefix, file string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/attachment/default/"+bugPrefix+"/"+file, nil)
+		r = mux.SetURLVars(r, map[string]string{"repo": "", "bug": bugPrefix, "file": file})
+		NewAttachmentHandler(mrc).ServeHTTP(w, r)
+		return w
+	}
+
+	w := get(b.Id().Human(), "report.json")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"ok": true}`, w.Body.String())
+	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	require.Equal(t, `attachment; filename=report.json`, w.Header().Get("Content-Disposition"))
+
+	w = get(b.Id().Human(), string(op.Hash)[:10])
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"ok": true}`, w.Body.String())
+
+	w = get(b.Id().Human(), string(other))
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	w = get("ffffff", "report.json")
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,233 @@
+package http
+
+import (
+	"compress/gzip"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/pktline"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/gorilla/mux"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+const (
+	uploadPackService  = "git-upload-pack"
+	receivePackService = "git-receive-pack"
+)
+
+// implement a http.Handler serving the refs of the bugs and identities over
+// the git smart HTTP protocol, so that git-bug can pull from and push to the
+// web server as any other git remote. Pushing require the HTTP basic
+// authentication, with the push token as password.
+//
+// The default repository is served under the prefix, the others under
+// prefix/<repo>.
+type gitSmartHandler struct {
+	mrc       *cache.MultiRepoCache
+	pushToken string
+	router    *mux.Router
+}
+
+// NewGitSmartHandler return a http.Handler serving the refs of the bugs and
+// identities over the git smart HTTP protocol. With an empty pushToken, the
+// pushes are refused.
+func NewGitSmartHandler(mrc *cache.MultiRepoCache, prefix string, pushToken string) http.Handler {
+	prefix = strings.TrimSuffix(prefix, "/")
+
+	gsh := &gitSmartHandler{
+		mrc:       mrc,
+		pushToken: pushToken,
+		router:    mux.NewRouter(),
+	}
+
+	for _, repoPrefix := range []string{prefix, prefix + "/{repo}"} {
+		gsh.router.Path(repoPrefix + "/info/refs").Methods("GET").HandlerFunc(gsh.serveAdvertisement)
+		gsh.router.Path(repoPrefix + "/" + uploadPackService).Methods("POST").HandlerFunc(gsh.serveUploadPack)
+		gsh.router.Path(repoPrefix + "/" + receivePackService).Methods("POST").HandlerFunc(gsh.serveReceivePack)
+	}
+
+	return gsh
+}
+
+func (gsh *gitSmartHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	gsh.router.ServeHTTP(rw, r)
+}
+
+// gitServer return the git server of the requested repository, or write the
+// error and return nil
+func (gsh *gitSmartHandler) gitServer(rw http.ResponseWriter, r *http.Request, push bool) transport.Transport {
+	var repo *cache.RepoCache
+	var err error
+
+	repoVar := mux.Vars(r)["repo"]
+	switch repoVar {
+	case "":
+		repo, err = gsh.mrc.DefaultRepo()
+	default:
+		repo, err = gsh.mrc.ResolveRepo(repoVar)
+	}
+	if err != nil {
+		http.Error(rw, "invalid repo reference", http.StatusNotFound)
+		return nil
+	}
+
+	if push && !gsh.authorizePush(rw, r) {
+		return nil
+	}
+
+	srv, err := repo.GitServer(push)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusNotImplemented)
+		return nil
+	}
+	return srv
+}
+
+// authorizePush check the credentials of a push, or write the error
+func (gsh *gitSmartHandler) authorizePush(rw http.ResponseWriter, r *http.Request) bool {
+	if gsh.pushToken == "" {
+		http.Error(rw, "push is disabled", http.StatusForbidden)
+		return false
+	}
+
+	_, password, ok := r.BasicAuth()
+	if !ok {
+		rw.Header().Set("WWW-Authenticate", `Basic realm="git-bug"`)
+		http.Error(rw, "authentication required", http.StatusUnauthorized)
+		return false
+	}
+	if subtle.ConstantTimeCompare([]byte(password), []byte(gsh.pushToken)) != 1 {
+		http.Error(rw, "invalid credentials", http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+func (gsh *gitSmartHandler) serveAdvertisement(rw http.ResponseWriter, r *http.Request) {
+	service := r.URL.Query().Get("service")
+	if service != uploadPackService && service != receivePackService {
+		http.Error(rw, "only the smart HTTP protocol is supported", http.StatusForbidden)
+		return
+	}
+
+	srv := gsh.gitServer(rw, r, service == receivePackService)
+	if srv == nil {
+		return
+	}
+
+	var session transport.Session
+	var err error
+	if service == uploadPackService {
+		session, err = srv.NewUploadPackSession(nil, nil)
+	} else {
+		session, err = srv.NewReceivePackSession(nil, nil)
+	}
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+	defer session.Close()
+
+	adv, err := session.AdvertisedReferencesContext(r.Context())
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	adv.Prefix = [][]byte{[]byte("# service=" + service), pktline.Flush}
+
+	rw.Header().Set("Content-Type", fmt.Sprintf("application/x-%s-advertisement", service))
+	rw.Header().Set("Cache-Control", "no-cache")
+	_ = adv.Encode(rw)
+}
+
+func (gsh *gitSmartHandler) serveUploadPack(rw http.ResponseWriter, r *http.Request) {
+	srv := gsh.gitServer(rw, r, false)
+	if srv == nil {
+		return
+	}
+
+	body, err := requestBody(r)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer body.Close()
+
+	req := packp.NewUploadPackRequest()
+	if err := req.Decode(body); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	session, err := srv.NewUploadPackSession(nil, nil)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer session.Close()
+
+	resp, err := session.UploadPack(r.Context(), req)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer resp.Close()
+
+	rw.Header().Set("Content-Type", fmt.Sprintf("application/x-%s-result", uploadPackService))
+	rw.Header().Set("Cache-Control", "no-cache")
+	_ = resp.Encode(rw)
+}
+
+func (gsh *gitSmartHandler) serveReceivePack(rw http.ResponseWriter, r *http.Request) {
+	srv := gsh.gitServer(rw, r, true)
+	if srv == nil {
+		return
+	}
+
+	body, err := requestBody(r)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer body.Close()
+
+	req := packp.NewReferenceUpdateRequest()
+	if err := req.Decode(body); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	session, err := srv.NewReceivePackSession(nil, nil)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+	defer session.Close()
+
+	// the refused updates are reported in the status, to the git client
+	status, err := session.ReceivePack(r.Context(), req)
+	if status == nil {
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	rw.Header().Set("Content-Type", fmt.Sprintf("application/x-%s-result", receivePackService))
+	rw.Header().Set("Cache-Control", "no-cache")
+	_ = status.Encode(rw)
+}
+
+// requestBody return the body of a request, uncompressed if needed
+func requestBody(r *http.Request) (io.ReadCloser, error) {
+	if r.Header.Get("Content-Encoding") != "gzip" {
+		return r.Body, nil
+	}
+	return gzip.NewReader(r.Body)
+}
@@ -0,0 +1,83 @@
+package http
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestGitSmartHandler(t *testing.T) {
+	served := repository.CreateGoGitTestRepo(t, false)
+
+	mrc := cache.NewMultiRepoCache()
+	servedCache, err := mrc.RegisterDefaultRepository(served)
+	require.NoError(t, err)
+
+	rene, err := servedCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, servedCache.SetUserIdentity(rene))
+
+	served1, _, err := servedCache.NewBug("served", "message")
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(NewGitSmartHandler(mrc, "/git", "secret"))
+	defer srv.Close()
+
+	client := repository.CreateGoGitTestRepo(t, false)
+	clientCache, err := cache.NewRepoCache(client)
+	require.NoError(t, err)
+
+	require.NoError(t, client.AddRemote("origin", srv.URL+"/git"))
+	_, err = clientCache.Fetch("origin")
+	require.NoError(t, err)
+	for result := range clientCache.MergeIdentities("origin") {
+		require.NoError(t, result.Err)
+	}
+
+	clientUser, err := clientCache.ResolveIdentity(rene.Id())
+	require.NoError(t, err)
+	require.NoError(t, clientCache.SetUserIdentity(clientUser))
+	require.NoError(t, clientCache.Pull("origin"))
+
+	pulled, err := clientCache.ResolveBug(served1.Id())
+	require.NoError(t, err)
+	require.Equal(t, "served", pulled.Snapshot().Title)
+
+	pushed, _, err := clientCache.NewBug("pushed", "message")
+	require.NoError(t, err)
+
+	// without credentials, the push is refused
+	_, err = clientCache.Push("origin")
+	require.Error(t, err)
+
+	withToken, err := url.Parse(srv.URL + "/git")
+	require.NoError(t, err)
+	withToken.User = url.UserPassword("git-bug", "secret")
+	require.NoError(t, client.AddRemote("authenticated", withToken.String()))
+
+	_, err = clientCache.Push("authenticated")
+	require.NoError(t, err)
+
+	// the cache of the served repository is up to date
+	excerpt, err := servedCache.ResolveBugExcerpt(pushed.Id())
+	require.NoError(t, err)
+	require.Equal(t, "pushed", excerpt.Title)
+
+	// a diverging history is refused
+	_, err = served1.SetTitle("changed on the server")
+	require.NoError(t, err)
+	require.NoError(t, served1.Commit())
+	_, err = pulled.SetTitle("changed on the client")
+	require.NoError(t, err)
+	require.NoError(t, pulled.Commit())
+	_, err = clientCache.Push("authenticated")
+	require.Error(t, err)
+
+	require.NoError(t, clientCache.Close())
+	require.NoError(t, mrc.Close())
+}
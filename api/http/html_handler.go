@@ -0,0 +1,410 @@
+package http
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MichaelMure/git-bug/api/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
+	"github.com/MichaelMure/git-bug/util/render"
+)
+
+//go:embed templates/*.html
+var htmlTemplates embed.FS
+
+// the number of bugs on a page of the list
+const htmlPageSize = 50
+
+// the query of the list when none is given
+const htmlDefaultQuery = "status:open"
+
+// implement a http.Handler serving a minimal server-side rendered web UI for
+// the default repo, without any JavaScript: a bug list with a query, the bug
+// pages, and forms to comment and to open or close a bug. It keeps the tracker
+// usable from text browsers, curl or constrained environments.
+//
+// The forms are only available when a user is authenticated, as for the
+// GraphQL API.
+type htmlHandler struct {
+	mrc       *cache.MultiRepoCache
+	prefix    string
+	router    *mux.Router
+	templates *template.Template
+}
+
+// NewHTMLHandler return a handler serving the minimal web UI under the given
+// path prefix (ex: "/html").
+func NewHTMLHandler(mrc *cache.MultiRepoCache, prefix string) http.Handler {
+	prefix = strings.TrimSuffix(prefix, "/")
+
+	hh := &htmlHandler{
+		mrc:       mrc,
+		prefix:    prefix,
+		router:    mux.NewRouter(),
+		templates: template.Must(template.ParseFS(htmlTemplates, "templates/*.html")),
+	}
+
+	hh.router.Path(prefix + "/").Methods("GET").HandlerFunc(hh.serveList)
+	hh.router.Path(prefix + "/bug/{id}").Methods("GET").HandlerFunc(hh.serveBug)
+	hh.router.Path(prefix + "/bug/{id}/comment").Methods("POST").HandlerFunc(hh.serveComment)
+	hh.router.Path(prefix + "/bug/{id}/status").Methods("POST").HandlerFunc(hh.serveStatus)
+	hh.router.Path(prefix).Handler(http.RedirectHandler(prefix+"/", http.StatusMovedPermanently))
+
+	return hh
+}
+
+func (hh *htmlHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	hh.router.ServeHTTP(rw, r)
+}
+
+type htmlBugRow struct {
+	Id          entity.Id
+	Status      common.Status
+	State       string
+	Title       string
+	Labels      []string
+	Author      string
+	LenComments int
+}
+
+func (hh *htmlHandler) serveList(rw http.ResponseWriter, r *http.Request) {
+	repo, err := hh.mrc.DefaultRepo()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	qStr := r.URL.Query().Get("q")
+	if _, ok := r.URL.Query()["q"]; !ok {
+		qStr = htmlDefaultQuery
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	data := struct {
+		Title    string
+		Prefix   string
+		Query    string
+		Error    string
+		Total    int
+		Bugs     []htmlBugRow
+		PrevPage int
+		NextPage int
+	}{
+		Title:  "Bugs",
+		Prefix: hh.prefix,
+		Query:  qStr,
+	}
+
+	q, err := query.Parse(qStr)
+	if err != nil {
+		data.Error = err.Error()
+		hh.render(rw, "list.html", data)
+		return
+	}
+
+	ids, err := repo.QueryBugs(q)
+	if err != nil {
+		data.Error = err.Error()
+		hh.render(rw, "list.html", data)
+		return
+	}
+
+	data.Total = len(ids)
+
+	workflow, err := repo.Workflow()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	start := (page - 1) * htmlPageSize
+	if start > len(ids) {
+		start = len(ids)
+	}
+	end := start + htmlPageSize
+	if end > len(ids) {
+		end = len(ids)
+	}
+	if page > 1 {
+		data.PrevPage = page - 1
+	}
+	if end < len(ids) {
+		data.NextPage = page + 1
+	}
+
+	for _, id := range ids[start:end] {
+		excerpt, err := repo.ResolveBugExcerpt(id)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		row := htmlBugRow{
+			Id:          excerpt.Id,
+			Status:      excerpt.Status,
+			State:       workflow.StateOf(excerpt.Status, excerpt.State),
+			Title:       excerpt.Title,
+			LenComments: excerpt.LenComments,
+		}
+		for _, l := range excerpt.Labels {
+			row.Labels = append(row.Labels, l.String())
+		}
+		if author, err := repo.ResolveIdentityExcerpt(excerpt.AuthorId); err == nil {
+			row.Author = author.DisplayName()
+		}
+
+		data.Bugs = append(data.Bugs, row)
+	}
+
+	hh.render(rw, "list.html", data)
+}
+
+func (hh *htmlHandler) serveBug(rw http.ResponseWriter, r *http.Request) {
+	repo, b, ok := hh.resolveBug(rw, r)
+	if !ok {
+		return
+	}
+
+	_, err := auth.UserFromCtx(r.Context(), repo)
+	canEdit := err == nil
+
+	snap := b.Snapshot()
+
+	workflow, err := repo.Workflow()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	state := workflow.StateOf(snap.Status, snap.State)
+
+	data := struct {
+		Title    string
+		Prefix   string
+		Snapshot *bug.Snapshot
+		State    string
+		// NextStates are the states the bug can move to, for a workflow
+		// beyond opening and closing
+		NextStates []string
+		// Messages are the rendered messages of the comments
+		Messages map[entity.CombinedId]template.HTML
+		// Backlinks are the bugs referencing this one in their messages
+		Backlinks []htmlBugRow
+		// Lang is the language of the translations shown instead of the
+		// original texts, if any
+		Lang string
+		// Languages are the languages with stored translations
+		Languages []string
+		CanEdit   bool
+	}{
+		Title:     snap.Title,
+		Prefix:    hh.prefix,
+		Snapshot:  snap,
+		State:     state,
+		Messages:  make(map[entity.CombinedId]template.HTML, len(snap.Comments)),
+		Languages: snap.TranslationLanguages(),
+		CanEdit:   canEdit,
+	}
+	if !workflow.IsDefault() {
+		data.NextStates = workflow.NextStates(state)
+	}
+
+	links := render.Links{
+		Bug: func(id entity.Id) string {
+			return hh.prefix + "/bug/" + id.String()
+		},
+		Commit: repo.CommitURL,
+	}
+	// the machine translations are shown on demand, with ?translate=<lang>
+	var translations bug.Translations
+	if lang := r.URL.Query().Get("translate"); lang != "" {
+		if err := bug.ValidateLanguage(lang); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data.Lang = lang
+		translations = snap.Translations(lang)
+		if translations.Title != "" {
+			data.Title = translations.Title
+		}
+	}
+
+	for _, comment := range snap.Comments {
+		message := comment.Message
+		if translation, ok := translations.Messages[comment.CombinedId()]; ok {
+			message = translation
+		}
+		data.Messages[comment.CombinedId()] = render.HTML(message, repo.RefResolver(), links)
+	}
+
+	for _, id := range repo.Backlinks(snap.Id()) {
+		excerpt, err := repo.ResolveBugExcerpt(id)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		data.Backlinks = append(data.Backlinks, htmlBugRow{
+			Id:     excerpt.Id,
+			Status: excerpt.Status,
+			Title:  excerpt.Title,
+		})
+	}
+
+	hh.render(rw, "bug.html", data)
+}
+
+func (hh *htmlHandler) serveComment(rw http.ResponseWriter, r *http.Request) {
+	_, b, author, ok := hh.resolveEdit(rw, r)
+	if !ok {
+		return
+	}
+
+	message := strings.TrimSpace(r.PostFormValue("message"))
+	if message == "" {
+		http.Error(rw, "empty message", http.StatusBadRequest)
+		return
+	}
+
+	_, _, err := b.AddCommentRaw(author, time.Now().Unix(), message, nil, nil)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	hh.commitAndRedirect(rw, r, b)
+}
+
+func (hh *htmlHandler) serveStatus(rw http.ResponseWriter, r *http.Request) {
+	repo, b, author, ok := hh.resolveEdit(rw, r)
+	if !ok {
+		return
+	}
+
+	// the form give either a status, to open or close the bug, or a state
+	// of the workflow
+	var status common.Status
+	state := r.PostFormValue("state")
+	if state != "" {
+		workflow, err := repo.Workflow()
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		// an unknown state is reported by ValidateStatusChange
+		s, _ := workflow.State(state)
+		status = s.Status
+	} else {
+		var err error
+		status, err = common.StatusFromString(r.PostFormValue("status"))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	err := b.ValidateStatusChange(status, state)
+
+	var metadata map[string]string
+	if err == nil && status == common.ClosedStatus {
+		metadata, err = b.FreezeMetadata(author, r.PostFormValue("confirm-freeze") == "true")
+	}
+
+	if err == nil {
+		switch {
+		case state != "":
+			_, err = b.SetStateRaw(author, time.Now().Unix(), state, metadata)
+		case status == common.OpenStatus:
+			_, err = b.OpenRaw(author, time.Now().Unix(), nil)
+		default:
+			_, err = b.CloseRaw(author, time.Now().Unix(), metadata)
+		}
+	}
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	hh.commitAndRedirect(rw, r, b)
+}
+
+func (hh *htmlHandler) resolveBug(rw http.ResponseWriter, r *http.Request) (*cache.RepoCache, *cache.BugCache, bool) {
+	repo, err := hh.mrc.DefaultRepo()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return nil, nil, false
+	}
+
+	b, err := repo.ResolveBugPrefix(mux.Vars(r)["id"])
+	if entity.IsErrNotFound(err) {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return nil, nil, false
+	}
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	return repo, b, true
+}
+
+// resolveEdit resolve the bug and the author of a form submission
+func (hh *htmlHandler) resolveEdit(rw http.ResponseWriter, r *http.Request) (*cache.RepoCache, *cache.BugCache, *cache.IdentityCache, bool) {
+	// as the forms are submitted without any token, refuse the ones coming
+	// from another site
+	if origin := r.Header.Get("Origin"); origin != "" {
+		u, err := url.Parse(origin)
+		if err != nil || u.Host != r.Host {
+			http.Error(rw, "cross-origin request", http.StatusForbidden)
+			return nil, nil, nil, false
+		}
+	}
+
+	repo, b, ok := hh.resolveBug(rw, r)
+	if !ok {
+		return nil, nil, nil, false
+	}
+
+	author, err := auth.UserFromCtx(r.Context(), repo)
+	if err == auth.ErrNotAuthenticated {
+		http.Error(rw, "read-only mode or not logged in", http.StatusForbidden)
+		return nil, nil, nil, false
+	} else if err != nil {
+		http.Error(rw, fmt.Sprintf("loading identity: %v", err), http.StatusInternalServerError)
+		return nil, nil, nil, false
+	}
+
+	return repo, b, author, true
+}
+
+func (hh *htmlHandler) commitAndRedirect(rw http.ResponseWriter, r *http.Request, b *cache.BugCache) {
+	err := b.Commit()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(rw, r, fmt.Sprintf("%s/bug/%s", hh.prefix, b.Id()), http.StatusSeeOther)
+}
+
+func (hh *htmlHandler) render(rw http.ResponseWriter, name string, data interface{}) {
+	rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err := hh.templates.ExecuteTemplate(rw, name, data)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,139 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/api/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestHTMLHandler(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	mrc := cache.NewMultiRepoCache()
+	repoCache, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+	defer mrc.Close()
+
+	author, err := repoCache.NewIdentity("test identity", "test@test.org")
+	require.NoError(t, err)
+	require.NoError(t, repoCache.SetUserIdentity(author))
+
+	b, _, err := repoCache.NewBug("a <b>bold</b> title", "first message")
+	require.NoError(t, err)
+
+	handler := NewHTMLHandler(mrc, "/html")
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", path, nil)
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	post := func(path string, form url.Values, authenticated bool) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", path, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if authenticated {
+			r = r.WithContext(auth.CtxWithUser(r.Context(), author.Id()))
+		}
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	// list
+	w := get("/html/")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), b.Id().Human())
+	require.Contains(t, w.Body.String(), "a &lt;b&gt;bold&lt;/b&gt; title")
+
+	w = get("/html/?q=status:closed")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotContains(t, w.Body.String(), b.Id().Human())
+
+	w = get("/html/?q=status:")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `class="error"`)
+
+	// bug page, read-only without authentication
+	w = get("/html/bug/" + b.Id().Human())
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), "first message")
+	require.Contains(t, w.Body.String(), "Read-only mode")
+
+	w = get("/html/bug/unknown")
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	// forms
+	w = post("/html/bug/"+b.Id().Human()+"/comment", url.Values{"message": {"hello"}}, false)
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	w = post("/html/bug/"+b.Id().Human()+"/comment", url.Values{"message": {"hello"}}, true)
+	require.Equal(t, http.StatusSeeOther, w.Code)
+	require.Equal(t, "/html/bug/"+b.Id().String(), w.Header().Get("Location"))
+
+	w = post("/html/bug/"+b.Id().Human()+"/status", url.Values{"status": {"closed"}}, true)
+	require.Equal(t, http.StatusSeeOther, w.Code)
+
+	snap := b.Snapshot()
+	require.Len(t, snap.Comments, 2)
+	require.Equal(t, "hello", snap.Comments[1].Message)
+	require.Equal(t, common.ClosedStatus, snap.Status)
+
+	// cross-site submissions are refused
+	r := httptest.NewRequest("POST", "/html/bug/"+b.Id().Human()+"/status", strings.NewReader("status=open"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("Origin", "http://evil.example.com")
+	r = r.WithContext(auth.CtxWithUser(r.Context(), author.Id()))
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	// rendered messages, with the references linked
+	other, _, err := repoCache.NewBug("other", "**same** as #"+b.Id().Human()+" <script>x</script>")
+	require.NoError(t, err)
+
+	w = get("/html/bug/" + other.Id().Human())
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `<strong>same</strong> as <a class="bug-ref" href="/html/bug/`+b.Id().String()+`">#`+b.Id().Human()+`</a>`)
+	require.NotContains(t, w.Body.String(), "<script>")
+
+	w = get("/html/bug/" + b.Id().Human())
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), "Referenced by:")
+	require.Contains(t, w.Body.String(), `<a href="/html/bug/`+other.Id().String()+`">`+other.Id().Human()+`</a> other`)
+
+	// machine translations, shown on demand
+	_, err = other.Translate(upperTranslator{}, "fr")
+	require.NoError(t, err)
+
+	w = get("/html/bug/" + other.Id().Human())
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `<a href="/html/bug/`+other.Id().String()+`?translate=fr">fr</a>`)
+	require.Contains(t, w.Body.String(), "<h1>other ")
+
+	w = get("/html/bug/" + other.Id().Human() + "?translate=fr")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), "Machine translation to fr")
+	require.Contains(t, w.Body.String(), "<h1>OTHER ")
+	require.Contains(t, w.Body.String(), "<strong>SAME</strong>")
+
+	w = get("/html/bug/" + other.Id().Human() + "?translate=<b>")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// upperTranslator is a cache.Translator upper-casing the texts
+type upperTranslator struct{}
+
+func (upperTranslator) Translate(text string, _ string) (string, error) {
+	return strings.ToUpper(text), nil
+}
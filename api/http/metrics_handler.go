@@ -0,0 +1,142 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// implement a http.Handler serving the metrics of the repositories in the
+// Prometheus text format, for the monitoring systems. For now, it's the health
+// of the bridges, to detect a long-running synchronization failing.
+type metricsHandler struct {
+	mrc *cache.MultiRepoCache
+}
+
+func NewMetricsHandler(mrc *cache.MultiRepoCache) http.Handler {
+	return &metricsHandler{mrc: mrc}
+}
+
+// a metric, with its samples
+type metric struct {
+	name    string
+	help    string
+	samples []metricSample
+}
+
+type metricSample struct {
+	labels [][2]string
+	value  float64
+}
+
+func (mh *metricsHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	lastSuccess := &metric{
+		name: "git_bug_bridge_last_success_timestamp_seconds",
+		help: "Time of the last successful synchronization of the bridge, 0 if none.",
+	}
+	failures := &metric{
+		name: "git_bug_bridge_consecutive_failures",
+		help: "Number of synchronizations of the bridge that failed since the last successful one.",
+	}
+	failingSince := &metric{
+		name: "git_bug_bridge_failing_since_timestamp_seconds",
+		help: "Time of the first of the consecutive failures of the bridge, 0 if not failing.",
+	}
+	rateLimited := &metric{
+		name: "git_bug_bridge_rate_limited",
+		help: "Whether the remote API rate limited the last synchronization of the bridge.",
+	}
+
+	for _, repoName := range mh.mrc.RepoNames() {
+		var repo *cache.RepoCache
+		var err error
+		if repoName == "" {
+			repo, err = mh.mrc.DefaultRepo()
+		} else {
+			repo, err = mh.mrc.ResolveRepo(repoName)
+		}
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		names, err := core.ConfiguredBridges(repo)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			health, err := core.ReadHealth(repo, name)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			for _, direction := range []struct {
+				name string
+				sh   core.SyncHealth
+			}{
+				{"pull", health.Import},
+				{"push", health.Export},
+			} {
+				labels := [][2]string{{"repo", repoName}, {"bridge", name}, {"direction", direction.name}}
+
+				lastSuccess.add(labels, timestampValue(direction.sh.LastSuccess))
+				failures.add(labels, float64(direction.sh.ConsecutiveFailures))
+				failingSince.add(labels, timestampValue(direction.sh.FailingSince))
+				rate := 0.0
+				if direction.sh.RateLimited {
+					rate = 1
+				}
+				rateLimited.add(labels, rate)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	for _, m := range []*metric{lastSuccess, failures, failingSince, rateLimited} {
+		m.write(&buf)
+	}
+
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = rw.Write(buf.Bytes())
+}
+
+// timestampValue return the value of a time as a metric, 0 for a zero time
+func timestampValue(t time.Time) float64 {
+	if t.IsZero() {
+		return 0
+	}
+	return float64(t.Unix())
+}
+
+func (m *metric) add(labels [][2]string, value float64) {
+	m.samples = append(m.samples, metricSample{labels: labels, value: value})
+}
+
+func (m *metric) write(buf *bytes.Buffer) {
+	_, _ = fmt.Fprintf(buf, "# HELP %s %s\n", m.name, m.help)
+	_, _ = fmt.Fprintf(buf, "# TYPE %s gauge\n", m.name)
+
+	for _, sample := range m.samples {
+		buf.WriteString(m.name)
+		buf.WriteString("{")
+		for i, label := range sample.labels {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			_, _ = fmt.Fprintf(buf, "%s=%s", label[0], strconv.Quote(label[1]))
+		}
+		buf.WriteString("} ")
+		buf.WriteString(strconv.FormatFloat(sample.value, 'f', -1, 64))
+		buf.WriteString("\n")
+	}
+}
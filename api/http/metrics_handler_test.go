@@ -0,0 +1,40 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	mrc := cache.NewMultiRepoCache()
+	repoCache, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+	defer mrc.Close()
+
+	config := repoCache.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.bridge.github.target", "github"))
+	require.NoError(t, config.StoreString("git-bug.bridge.github.health-import-last-success", "1600000000"))
+	require.NoError(t, config.StoreString("git-bug.bridge.github.health-export-failures", "3"))
+	require.NoError(t, config.StoreString("git-bug.bridge.github.health-export-failing-since", "1600000100"))
+	require.NoError(t, config.StoreString("git-bug.bridge.github.health-export-rate-limited", "true"))
+
+	w := httptest.NewRecorder()
+	NewMetricsHandler(mrc).ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	body := w.Body.String()
+	require.Contains(t, body, "# TYPE git_bug_bridge_consecutive_failures gauge\n")
+	require.Contains(t, body, `git_bug_bridge_last_success_timestamp_seconds{repo="",bridge="github",direction="pull"} 1600000000`+"\n")
+	require.Contains(t, body, `git_bug_bridge_last_success_timestamp_seconds{repo="",bridge="github",direction="push"} 0`+"\n")
+	require.Contains(t, body, `git_bug_bridge_consecutive_failures{repo="",bridge="github",direction="push"} 3`+"\n")
+	require.Contains(t, body, `git_bug_bridge_failing_since_timestamp_seconds{repo="",bridge="github",direction="push"} 1600000100`+"\n")
+	require.Contains(t, body, `git_bug_bridge_rate_limited{repo="",bridge="github",direction="push"} 1`+"\n")
+}
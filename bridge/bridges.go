@@ -2,11 +2,15 @@
 package bridge
 
 import (
+	"context"
+	"strings"
+
 	"github.com/MichaelMure/git-bug/bridge/core"
 	"github.com/MichaelMure/git-bug/bridge/github"
 	"github.com/MichaelMure/git-bug/bridge/gitlab"
 	"github.com/MichaelMure/git-bug/bridge/jira"
 	"github.com/MichaelMure/git-bug/bridge/launchpad"
+	"github.com/MichaelMure/git-bug/bridge/phabricator"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/repository"
 )
@@ -16,6 +20,7 @@ func init() {
 	core.Register(&gitlab.Gitlab{})
 	core.Register(&launchpad.Launchpad{})
 	core.Register(&jira.Jira{})
+	core.Register(&phabricator.Phabricator{})
 }
 
 // Targets return all known bridge implementation target
@@ -52,7 +57,41 @@ func ConfiguredBridges(repo repository.RepoConfig) ([]string, error) {
 	return core.ConfiguredBridges(repo)
 }
 
+// ReadHealth read the health of a bridge, as recorded by its imports and
+// exports
+func ReadHealth(repo repository.RepoConfig, name string) (core.Health, error) {
+	return core.ReadHealth(repo, name)
+}
+
 // Remove a configured bridge
 func RemoveBridge(repo repository.RepoConfig, name string) error {
 	return core.RemoveBridge(repo, name)
 }
+
+// ResolveIssueRef resolve a reference to an issue of a remote bug-tracker, in
+// the form "<bridge>#<ref>" where <bridge> is either the name of a configured
+// bridge or a target with a single configured bridge (ex: "github#1234").
+// The issue is imported on demand if it has not been imported yet.
+// If the given string is not such a reference, ok is false.
+func ResolveIssueRef(ctx context.Context, repo *cache.RepoCache, ref string) (b *cache.BugCache, ok bool, err error) {
+	name, issueRef, found := strings.Cut(ref, "#")
+	if !found || name == "" || issueRef == "" {
+		return nil, false, nil
+	}
+
+	var br *core.Bridge
+	switch {
+	case core.BridgeExist(repo, name):
+		br, err = core.LoadBridge(repo, name)
+	case core.TargetExist(name):
+		br, err = core.LoadBridgeForTarget(repo, name)
+	default:
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, true, err
+	}
+
+	b, err = br.ResolveIssue(ctx, issueRef)
+	return b, true, err
+}
@@ -14,20 +14,38 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/repository"
 )
 
 var ErrImportNotSupported = errors.New("import is not supported")
 var ErrExportNotSupported = errors.New("export is not supported")
+var ErrIssueImportNotSupported = errors.New("importing a single issue is not supported")
 
 const (
 	ConfigKeyTarget = "target"
 
+	// ConfigKeyAllowConfidential, when set to "true", allow the export of
+	// confidential operations through the bridge.
+	ConfigKeyAllowConfidential = "allow-confidential"
+
+	// ConfigKeyLazyClosed, when set to "true", restrict the initial import to
+	// the open issues. The closed ones are imported on demand.
+	ConfigKeyLazyClosed = "lazy-closed"
+
 	MetaKeyOrigin = "origin"
 
 	bridgeConfigKeyPrefix = "git-bug.bridge"
 )
 
+const (
+	// in streaming mode, the number of imported entities between two writes
+	// of the cache files
+	streamingFlushEvery = 500
+	// in streaming mode, the maximum number of bugs kept loaded in memory
+	streamingMaxLoadedBugs = 20
+)
+
 var bridgeImpl map[string]reflect.Type
 var bridgeLoginMetaKey map[string]string
 
@@ -42,6 +60,7 @@ type Bridge struct {
 	conf           Configuration
 	initImportDone bool
 	initExportDone bool
+	streaming      bool
 }
 
 // Register will register a new BridgeImpl
@@ -128,6 +147,36 @@ func LoadBridge(repo *cache.RepoCache, name string) (*Bridge, error) {
 	return bridge, nil
 }
 
+// LoadBridgeForTarget instantiate the only bridge configured for the given
+// target. If zero or multiple bridge exist for that target, it fails.
+func LoadBridgeForTarget(repo *cache.RepoCache, target string) (*Bridge, error) {
+	bridges, err := ConfiguredBridges(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []string
+	for _, name := range bridges {
+		conf, err := loadConfig(repo, name)
+		if err != nil {
+			return nil, err
+		}
+		if conf[ConfigKeyTarget] == target {
+			matching = append(matching, name)
+		}
+	}
+
+	if len(matching) == 0 {
+		return nil, fmt.Errorf("no configured bridge for target %v", target)
+	}
+
+	if len(matching) > 1 {
+		return nil, fmt.Errorf("multiple bridge are configured for target %v, you need to select one explicitely", target)
+	}
+
+	return LoadBridge(repo, matching[0])
+}
+
 // Attempt to retrieve a default bridge for the given repo. If zero or multiple
 // bridge exist, it fails.
 func DefaultBridge(repo *cache.RepoCache) (*Bridge, error) {
@@ -248,6 +297,26 @@ func (b *Bridge) storeConfig(conf Configuration) error {
 	return nil
 }
 
+// AllowConfidential allow the export of confidential operations for the
+// lifetime of this Bridge, without changing the stored configuration.
+func (b *Bridge) AllowConfidential() error {
+	err := b.ensureConfig()
+	if err != nil {
+		return err
+	}
+
+	b.conf[ConfigKeyAllowConfidential] = "true"
+	return nil
+}
+
+// EnableStreaming make the imports of this Bridge run with a bounded memory,
+// whatever the size of the bug tracker: the issues are processed one at a time,
+// only a few bugs are kept loaded in memory and the cache files are written
+// incrementally. This is meant for the initial import of very large trackers.
+func (b *Bridge) EnableStreaming() {
+	b.streaming = true
+}
+
 func (b *Bridge) ensureConfig() error {
 	if b.conf == nil {
 		conf, err := loadConfig(b.repo, b.Name)
@@ -343,32 +412,54 @@ func (b *Bridge) ImportAllSince(ctx context.Context, since time.Time) (<-chan Im
 
 	err = b.ensureImportInit(ctx)
 	if err != nil {
-		return nil, err
+		return nil, b.failedSync(false, err)
 	}
 
 	events, err := importer.ImportAll(ctx, b.repo, since)
 	if err != nil {
-		return nil, err
+		return nil, b.failedSync(false, err)
+	}
+
+	endBatch := func() error { return nil }
+	if b.streaming {
+		endBatch = b.repo.StartBatch(streamingFlushEvery, streamingMaxLoadedBugs)
 	}
 
 	out := make(chan ImportResult)
 	go func() {
 		defer close(out)
-		noError := true
+		var importErr error
+		rateLimited := false
 
 		// relay all events while checking that everything went well
 		for event := range events {
-			if event.Event == ImportEventError {
-				noError = false
+			switch event.Event {
+			case ImportEventError:
+				if importErr == nil {
+					importErr = event.Err
+				}
+			case ImportEventRateLimiting:
+				rateLimited = true
 			}
 			out <- event
 		}
 
+		if err := endBatch(); err != nil {
+			if importErr == nil {
+				importErr = err
+			}
+			out <- NewImportError(err, "")
+		}
+
 		// store the last import time ONLY if no error happened
-		if noError {
+		if importErr == nil {
 			key := fmt.Sprintf("git-bug.bridge.%s.lastImportTime", b.Name)
 			err = b.repo.LocalConfig().StoreTimestamp(key, importStartTime)
 		}
+
+		if err := b.recordHealth(false, importErr, rateLimited); err != nil {
+			out <- NewImportWarning(err, "")
+		}
 	}()
 
 	return out, nil
@@ -384,6 +475,49 @@ func (b *Bridge) ImportAll(ctx context.Context) (<-chan ImportResult, error) {
 	return b.ImportAllSince(ctx, time.Time{})
 }
 
+// ResolveIssue return the bug corresponding to the given reference of the
+// remote bug-tracker (ex: an issue number). If that issue has not been imported
+// yet, a targeted import is done first.
+func (b *Bridge) ResolveIssue(ctx context.Context, ref string) (*cache.BugCache, error) {
+	importer, ok := b.getImporter().(IssueImporter)
+	if !ok {
+		return nil, ErrIssueImportNotSupported
+	}
+
+	err := b.ensureConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.ensureImportInit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := importer.ResolveIssue(b.repo, ref)
+	if !entity.IsErrNotFound(err) {
+		return resolved, err
+	}
+
+	events, err := importer.ImportIssue(ctx, b.repo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	// drain all the events so that the import can complete
+	var importErr error
+	for event := range events {
+		if event.Event == ImportEventError && importErr == nil {
+			importErr = event.Err
+		}
+	}
+	if importErr != nil {
+		return nil, importErr
+	}
+
+	return importer.ResolveIssue(b.repo, ref)
+}
+
 func (b *Bridge) ExportAll(ctx context.Context, since time.Time) (<-chan ExportResult, error) {
 	exporter := b.getExporter()
 	if exporter == nil {
@@ -397,8 +531,46 @@ func (b *Bridge) ExportAll(ctx context.Context, since time.Time) (<-chan ExportR
 
 	err = b.ensureExportInit(ctx)
 	if err != nil {
-		return nil, err
+		return nil, b.failedSync(true, err)
+	}
+
+	events, err := exporter.ExportAll(ctx, b.repo, since)
+	if err != nil {
+		return nil, b.failedSync(true, err)
 	}
 
-	return exporter.ExportAll(ctx, b.repo, since)
+	out := make(chan ExportResult)
+	go func() {
+		defer close(out)
+		var exportErr error
+		rateLimited := false
+
+		// relay all events while checking that everything went well
+		for event := range events {
+			switch event.Event {
+			case ExportEventError:
+				if exportErr == nil {
+					exportErr = event.Err
+				}
+			case ExportEventRateLimiting:
+				rateLimited = true
+			}
+			out <- event
+		}
+
+		if err := b.recordHealth(true, exportErr, rateLimited); err != nil {
+			out <- NewExportWarning(err, "")
+		}
+	}()
+
+	return out, nil
+}
+
+// failedSync record the failure of an import or an export before it could
+// start, and return its error
+func (b *Bridge) failedSync(export bool, err error) error {
+	if healthErr := b.recordHealth(export, err, false); healthErr != nil {
+		return fmt.Errorf("%w (%v)", err, healthErr)
+	}
+	return err
 }
@@ -3,7 +3,9 @@ package core
 import (
 	"fmt"
 
+	"github.com/MichaelMure/git-bug/entities/bug"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
 )
 
 type ExportEvent int
@@ -23,6 +25,10 @@ const (
 	ExportEventTitleEdition
 	// Bug's labels have been changed on the remote tracker
 	ExportEventLabelChange
+	// Bug's assignees have been changed on the remote tracker
+	ExportEventAssigneeChange
+	// A reaction to a comment has been added or removed on the remote tracker
+	ExportEventReaction
 
 	// Nothing changed on the bug
 	ExportEventNothing
@@ -62,6 +68,10 @@ func (er ExportResult) String() string {
 		return fmt.Sprintf("[%s] changed title", er.EntityId.Human())
 	case ExportEventLabelChange:
 		return fmt.Sprintf("[%s] changed label", er.EntityId.Human())
+	case ExportEventAssigneeChange:
+		return fmt.Sprintf("[%s] changed assignees", er.EntityId.Human())
+	case ExportEventReaction:
+		return fmt.Sprintf("[%s] changed reaction", er.EntityId.Human())
 	case ExportEventNothing:
 		if er.EntityId != "" {
 			return fmt.Sprintf("no actions taken on entity %s: %s", er.EntityId, er.Reason)
@@ -144,6 +154,20 @@ func NewExportLabelChange(entityId entity.Id) ExportResult {
 	}
 }
 
+func NewExportAssigneeChange(entityId entity.Id) ExportResult {
+	return ExportResult{
+		EntityId: entityId,
+		Event:    ExportEventAssigneeChange,
+	}
+}
+
+func NewExportReaction(entityId entity.Id) ExportResult {
+	return ExportResult{
+		EntityId: entityId,
+		Event:    ExportEventReaction,
+	}
+}
+
 func NewExportTitleEdition(entityId entity.Id) ExportResult {
 	return ExportResult{
 		EntityId: entityId,
@@ -157,3 +181,12 @@ func NewExportRateLimiting(msg string) ExportResult {
 		Event:  ExportEventRateLimiting,
 	}
 }
+
+// ExportAllowed return false if the operation is confidential and the bridge
+// configuration doesn't explicitly allow the export of confidential operations.
+func ExportAllowed(conf Configuration, op dag.Operation) bool {
+	if !bug.IsConfidential(op) {
+		return true
+	}
+	return conf[ConfigKeyAllowConfidential] == "true"
+}
@@ -0,0 +1,328 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// the keys of the health of a bridge, in its configuration. The import or the
+// export direction is prefixed.
+const (
+	healthKeyPrefix       = "health-"
+	healthKeyLastSuccess  = "last-success"
+	healthKeyFailures     = "failures"
+	healthKeyFailingSince = "failing-since"
+	healthKeyLastError    = "last-error"
+	healthKeyRateLimited  = "rate-limited"
+	healthKeyAlerted      = healthKeyPrefix + "alerted"
+)
+
+// git config key of the URL of a webhook, receiving a JSON alert when a bridge
+// has been failing for too long, and when it recovers
+const healthWebhookConfigKey = "git-bug.bridge-health.webhook"
+
+// git config key of how long a bridge must have been failing before an alert
+// is sent, as a duration like "30m" or "2h"
+const healthAlertAfterConfigKey = "git-bug.bridge-health.alert-after"
+
+const defaultHealthAlertAfter = time.Hour
+
+// the maximum time to deliver an alert to the webhook
+const healthWebhookTimeout = 10 * time.Second
+
+// SyncHealth is the health of a bridge in one direction, import or export
+type SyncHealth struct {
+	// LastSuccess is the end of the last successful synchronization, zero if
+	// none
+	LastSuccess time.Time
+	// ConsecutiveFailures is the number of synchronizations that failed since
+	// the last successful one
+	ConsecutiveFailures int
+	// FailingSince is the time of the first of the consecutive failures
+	FailingSince time.Time
+	// LastError is the error of the last failure
+	LastError string
+	// RateLimited is true when the remote API has rate limited the last
+	// synchronization
+	RateLimited bool
+}
+
+// Failing tell if the last synchronization has failed
+func (sh SyncHealth) Failing() bool {
+	return sh.ConsecutiveFailures > 0
+}
+
+// Health is the state of the synchronization of a bridge with its remote bug
+// tracker, as recorded by its imports and exports, to monitor a long-running
+// synchronization.
+type Health struct {
+	Name   string
+	Import SyncHealth
+	Export SyncHealth
+	// Alerted is true when an alert has been sent for the current failures
+	Alerted bool
+}
+
+// Failing tell if the last import or the last export has failed
+func (h Health) Failing() bool {
+	return h.Import.Failing() || h.Export.Failing()
+}
+
+// FailingSince return since when the bridge is failing, in either direction,
+// or a zero time if it isn't
+func (h Health) FailingSince() time.Time {
+	switch {
+	case h.Import.Failing() && h.Export.Failing():
+		if h.Export.FailingSince.Before(h.Import.FailingSince) {
+			return h.Export.FailingSince
+		}
+		return h.Import.FailingSince
+	case h.Import.Failing():
+		return h.Import.FailingSince
+	case h.Export.Failing():
+		return h.Export.FailingSince
+	}
+	return time.Time{}
+}
+
+// ReadHealth read the health of a bridge, as recorded by its imports and
+// exports
+func ReadHealth(repo repository.RepoConfig, name string) (Health, error) {
+	// the config can only be read by whole section
+	keyPrefix := fmt.Sprintf("git-bug.bridge.%s.", name)
+
+	pairs, err := repo.LocalConfig().ReadAll(keyPrefix)
+	if err != nil {
+		return Health{}, fmt.Errorf("can't read the health of the bridge: %w", err)
+	}
+
+	health := Health{Name: name}
+
+	for key, value := range pairs {
+		if !strings.HasPrefix(key, keyPrefix+healthKeyPrefix) {
+			continue
+		}
+		key = strings.TrimPrefix(key, keyPrefix)
+
+		if key == healthKeyAlerted {
+			health.Alerted = value == "true"
+			continue
+		}
+
+		direction, option, _ := strings.Cut(strings.TrimPrefix(key, healthKeyPrefix), "-")
+		var sh *SyncHealth
+		switch direction {
+		case "import":
+			sh = &health.Import
+		case "export":
+			sh = &health.Export
+		default:
+			continue
+		}
+
+		switch option {
+		case healthKeyLastSuccess:
+			sh.LastSuccess, err = parseHealthTime(value)
+		case healthKeyFailures:
+			sh.ConsecutiveFailures, err = strconv.Atoi(value)
+		case healthKeyFailingSince:
+			sh.FailingSince, err = parseHealthTime(value)
+		case healthKeyLastError:
+			sh.LastError = value
+		case healthKeyRateLimited:
+			sh.RateLimited = value == "true"
+		}
+		if err != nil {
+			return Health{}, fmt.Errorf("invalid health value %s of the bridge %s: %w", key, name, err)
+		}
+	}
+
+	return health, nil
+}
+
+func writeHealth(repo repository.RepoConfig, health Health) error {
+	config := repo.LocalConfig()
+	key := func(direction string, option string) string {
+		return fmt.Sprintf("git-bug.bridge.%s.%s%s-%s", health.Name, healthKeyPrefix, direction, option)
+	}
+
+	for _, direction := range []struct {
+		name string
+		sh   SyncHealth
+	}{
+		{"import", health.Import},
+		{"export", health.Export},
+	} {
+		err := config.StoreString(key(direction.name, healthKeyLastSuccess), formatHealthTime(direction.sh.LastSuccess))
+		if err == nil {
+			err = config.StoreString(key(direction.name, healthKeyFailures), strconv.Itoa(direction.sh.ConsecutiveFailures))
+		}
+		if err == nil {
+			err = config.StoreString(key(direction.name, healthKeyFailingSince), formatHealthTime(direction.sh.FailingSince))
+		}
+		if err == nil {
+			err = config.StoreString(key(direction.name, healthKeyLastError), direction.sh.LastError)
+		}
+		if err == nil {
+			err = config.StoreBool(key(direction.name, healthKeyRateLimited), direction.sh.RateLimited)
+		}
+		if err != nil {
+			return fmt.Errorf("can't store the health of the bridge: %w", err)
+		}
+	}
+
+	err := config.StoreBool(fmt.Sprintf("git-bug.bridge.%s.%s", health.Name, healthKeyAlerted), health.Alerted)
+	if err != nil {
+		return fmt.Errorf("can't store the health of the bridge: %w", err)
+	}
+	return nil
+}
+
+// formatHealthTime format a time of the health as a timestamp, or an empty
+// string for a zero time, as a config key can only be removed with its whole
+// section
+func formatHealthTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func parseHealthTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return repository.ParseTimestamp(s)
+}
+
+// healthAlert is sent as JSON to the webhook when a bridge has been failing
+// for too long, then when it recovers
+type healthAlert struct {
+	Bridge string `json:"bridge"`
+	// Status is either "failing" or "recovered"
+	Status         string     `json:"status"`
+	FailingSince   *time.Time `json:"failing_since,omitempty"`
+	ImportFailures int        `json:"import_failures"`
+	ExportFailures int        `json:"export_failures"`
+	ImportError    string     `json:"import_error,omitempty"`
+	ExportError    string     `json:"export_error,omitempty"`
+}
+
+// recordHealth update the health of the bridge after an import or an export,
+// failed if syncErr is not nil, and send an alert to the webhook if the
+// bridge has been failing for too long or has recovered.
+func (b *Bridge) recordHealth(export bool, syncErr error, rateLimited bool) error {
+	health, err := ReadHealth(b.repo, b.Name)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	sh := &health.Import
+	if export {
+		sh = &health.Export
+	}
+
+	if syncErr == nil {
+		sh.LastSuccess = now
+		sh.ConsecutiveFailures = 0
+		sh.FailingSince = time.Time{}
+		sh.LastError = ""
+	} else {
+		if sh.ConsecutiveFailures == 0 {
+			sh.FailingSince = now
+		}
+		sh.ConsecutiveFailures++
+		sh.LastError = syncErr.Error()
+	}
+	sh.RateLimited = rateLimited
+
+	var alert *healthAlert
+	switch {
+	case !health.Failing() && health.Alerted:
+		alert = &healthAlert{Status: "recovered"}
+		health.Alerted = false
+
+	case health.Failing() && !health.Alerted:
+		alertAfter, err := healthAlertAfter(b.repo)
+		if err != nil {
+			return err
+		}
+		if now.Sub(health.FailingSince()) >= alertAfter {
+			since := health.FailingSince()
+			alert = &healthAlert{Status: "failing", FailingSince: &since}
+			health.Alerted = true
+		}
+	}
+
+	webhook, err := b.repo.AnyConfig().ReadString(healthWebhookConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		// without a webhook, the bridge is never considered alerted, so that
+		// an alert is sent once one is configured
+		alert = nil
+		health.Alerted = false
+	} else if err != nil {
+		return err
+	}
+
+	if err := writeHealth(b.repo, health); err != nil {
+		return err
+	}
+
+	if alert == nil {
+		return nil
+	}
+
+	alert.Bridge = b.Name
+	alert.ImportFailures = health.Import.ConsecutiveFailures
+	alert.ExportFailures = health.Export.ConsecutiveFailures
+	alert.ImportError = health.Import.LastError
+	alert.ExportError = health.Export.LastError
+
+	return sendHealthAlert(webhook, *alert)
+}
+
+// healthAlertAfter return how long a bridge must have been failing before an
+// alert is sent
+func healthAlertAfter(repo repository.RepoConfig) (time.Duration, error) {
+	val, err := repo.AnyConfig().ReadString(healthAlertAfterConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return defaultHealthAlertAfter, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %v", healthAlertAfterConfigKey, err)
+	}
+	return d, nil
+}
+
+func sendHealthAlert(webhook string, alert healthAlert) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{Timeout: healthWebhookTimeout}
+	resp, err := client.Post(webhook, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("can't send the health alert of the bridge: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("can't send the health alert of the bridge: the webhook answered %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestHealth(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	b := &Bridge{Name: "test", repo: backend}
+
+	health, err := ReadHealth(backend, "test")
+	require.NoError(t, err)
+	require.Equal(t, Health{Name: "test"}, health)
+
+	var alerts []healthAlert
+	webhook := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		var alert healthAlert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts = append(alerts, alert)
+	}))
+	defer webhook.Close()
+
+	require.NoError(t, backend.LocalConfig().StoreString(healthWebhookConfigKey, webhook.URL))
+	require.NoError(t, backend.LocalConfig().StoreString(healthAlertAfterConfigKey, "1h"))
+
+	require.NoError(t, b.recordHealth(false, nil, true))
+	require.NoError(t, b.recordHealth(true, errors.New("boom"), false))
+	require.NoError(t, b.recordHealth(true, errors.New("bang"), false))
+
+	health, err = ReadHealth(backend, "test")
+	require.NoError(t, err)
+	require.True(t, health.Failing())
+	require.False(t, health.Import.Failing())
+	require.WithinDuration(t, time.Now(), health.Import.LastSuccess, time.Minute)
+	require.True(t, health.Import.RateLimited)
+	require.Equal(t, 2, health.Export.ConsecutiveFailures)
+	require.Equal(t, "bang", health.Export.LastError)
+	require.True(t, health.Export.LastSuccess.IsZero())
+	require.Equal(t, health.Export.FailingSince, health.FailingSince())
+
+	// not failing for long enough to alert
+	require.Empty(t, alerts)
+	require.False(t, health.Alerted)
+
+	require.NoError(t, backend.LocalConfig().StoreString(healthAlertAfterConfigKey, "0s"))
+	require.NoError(t, b.recordHealth(true, errors.New("again"), false))
+	require.NoError(t, b.recordHealth(true, errors.New("and again"), false))
+	require.Len(t, alerts, 1, "a single alert is sent for the consecutive failures")
+	require.Equal(t, "failing", alerts[0].Status)
+	require.Equal(t, 3, alerts[0].ExportFailures)
+	require.Equal(t, "again", alerts[0].ExportError)
+
+	require.NoError(t, b.recordHealth(true, nil, false))
+	require.Len(t, alerts, 2)
+	require.Equal(t, "recovered", alerts[1].Status)
+
+	health, err = ReadHealth(backend, "test")
+	require.NoError(t, err)
+	require.False(t, health.Failing())
+	require.False(t, health.Alerted)
+	require.True(t, health.FailingSince().IsZero())
+
+	failing := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	require.ErrorContains(t, sendHealthAlert(failing.URL, healthAlert{}), "500")
+}
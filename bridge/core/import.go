@@ -24,6 +24,10 @@ const (
 	ImportEventTitleEdition
 	// Bug's labels changed
 	ImportEventLabelChange
+	// Bug's assignees changed
+	ImportEventAssigneeChange
+	// A conflict between the local and remote data has been recorded on a Bug
+	ImportEventSyncConflict
 	// Nothing happened on a Bug
 	ImportEventNothing
 
@@ -67,6 +71,10 @@ func (er ImportResult) String() string {
 		return fmt.Sprintf("[%s] changed title with op: %s", er.EntityId.Human(), er.OperationId)
 	case ImportEventLabelChange:
 		return fmt.Sprintf("[%s] changed label with op: %s", er.EntityId.Human(), er.OperationId)
+	case ImportEventAssigneeChange:
+		return fmt.Sprintf("[%s] changed assignees with op: %s", er.EntityId.Human(), er.OperationId)
+	case ImportEventSyncConflict:
+		return fmt.Sprintf("[%s] sync conflict recorded with op %s: %s", er.EntityId.Human(), er.OperationId, er.Reason)
 	case ImportEventIdentity:
 		return fmt.Sprintf("[%s] new identity: %s", er.EntityId.Human(), er.EntityId)
 	case ImportEventNothing:
@@ -163,6 +171,14 @@ func NewImportLabelChange(entityId entity.Id, opId entity.Id) ImportResult {
 	}
 }
 
+func NewImportAssigneeChange(entityId entity.Id, opId entity.Id) ImportResult {
+	return ImportResult{
+		EntityId:    entityId,
+		OperationId: opId,
+		Event:       ImportEventAssigneeChange,
+	}
+}
+
 func NewImportTitleEdition(entityId entity.Id, opId entity.Id) ImportResult {
 	return ImportResult{
 		EntityId:    entityId,
@@ -171,6 +187,15 @@ func NewImportTitleEdition(entityId entity.Id, opId entity.Id) ImportResult {
 	}
 }
 
+func NewImportSyncConflict(entityId entity.Id, opId entity.Id, reason string) ImportResult {
+	return ImportResult{
+		EntityId:    entityId,
+		OperationId: opId,
+		Reason:      reason,
+		Event:       ImportEventSyncConflict,
+	}
+}
+
 func NewImportIdentity(entityId entity.Id) ImportResult {
 	return ImportResult{
 		EntityId: entityId,
@@ -40,6 +40,15 @@ type Importer interface {
 	ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan ImportResult, error)
 }
 
+// IssueImporter is implemented by the importers able to import a single issue on
+// demand, given the reference the remote bug-tracker use for it.
+type IssueImporter interface {
+	// ResolveIssue return the bug previously imported for that issue, or an
+	// entity.ErrNotFound.
+	ResolveIssue(repo *cache.RepoCache, ref string) (*cache.BugCache, error)
+	ImportIssue(ctx context.Context, repo *cache.RepoCache, ref string) (<-chan ImportResult, error)
+}
+
 type Exporter interface {
 	Init(ctx context.Context, repo *cache.RepoCache, conf Configuration) error
 	ExportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan ExportResult, error)
@@ -5,13 +5,15 @@ import "fmt"
 // BridgeParams holds parameters to simplify the bridge configuration without
 // having to make terminal prompts.
 type BridgeParams struct {
-	URL        string // complete URL of a repo               (Github, Gitlab,     , Launchpad)
-	BaseURL    string // base URL for self-hosted instance    (        Gitlab, Jira,          )
-	Login      string // username for the passed credential   (Github, Gitlab, Jira,          )
-	CredPrefix string // ID prefix of the credential to use   (Github, Gitlab, Jira,          )
-	TokenRaw   string // pre-existing token to use            (Github, Gitlab,     ,          )
-	Owner      string // owner of the repo                    (Github,       ,     ,          )
-	Project    string // name of the repo or project key      (Github,       , Jira, Launchpad)
+	URL         string // complete URL of a repo               (Github, Gitlab,     , Launchpad, Phabricator)
+	BaseURL     string // base URL for self-hosted instance    (        Gitlab, Jira,          , Phabricator)
+	Login       string // username for the passed credential   (Github, Gitlab, Jira,          ,            )
+	CredPrefix  string // ID prefix of the credential to use   (Github, Gitlab, Jira,          , Phabricator)
+	TokenRaw    string // pre-existing token to use            (Github, Gitlab,     ,          , Phabricator)
+	Owner       string // owner of the repo                    (Github,       ,     ,          ,            )
+	Project     string // name of the repo or project key      (Github,       , Jira, Launchpad, Phabricator)
+	LazyClosed  string // import closed issues on demand only  (Github,       ,     ,          ,            )
+	Discussions string // import the discussions as bugs       (Github,       ,     ,          ,            )
 }
 
 func (BridgeParams) fieldWarning(field string, target string) string {
@@ -30,6 +32,10 @@ func (BridgeParams) fieldWarning(field string, target string) string {
 		return fmt.Sprintf("warning: --owner is ineffective for a %s bridge", target)
 	case "Project":
 		return fmt.Sprintf("warning: --project is ineffective for a %s bridge", target)
+	case "LazyClosed":
+		return fmt.Sprintf("warning: --lazy-closed is ineffective for a %s bridge", target)
+	case "Discussions":
+		return fmt.Sprintf("warning: --discussions is ineffective for a %s bridge", target)
 	default:
 		panic("unknown field")
 	}
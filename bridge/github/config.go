@@ -31,12 +31,14 @@ var (
 
 func (g *Github) ValidParams() map[string]interface{} {
 	return map[string]interface{}{
-		"URL":        nil,
-		"Login":      nil,
-		"CredPrefix": nil,
-		"TokenRaw":   nil,
-		"Owner":      nil,
-		"Project":    nil,
+		"URL":         nil,
+		"Login":       nil,
+		"CredPrefix":  nil,
+		"TokenRaw":    nil,
+		"Owner":       nil,
+		"Project":     nil,
+		"LazyClosed":  nil,
+		"Discussions": nil,
 	}
 }
 
@@ -145,6 +147,12 @@ func (g *Github) Configure(repo *cache.RepoCache, params core.BridgeParams, inte
 	conf[confKeyOwner] = owner
 	conf[confKeyProject] = project
 	conf[confKeyDefaultLogin] = login
+	if params.LazyClosed != "" {
+		conf[core.ConfigKeyLazyClosed] = "true"
+	}
+	if params.Discussions != "" {
+		conf[confKeyDiscussions] = "true"
+	}
 
 	err = g.ValidateConfig(conf)
 	if err != nil {
@@ -56,6 +56,9 @@ type githubExporter struct {
 	// cache labels used to speed up exporting labels events
 	cachedLabels map[string]string
 
+	// cache the node id of the users by login, to speed up exporting assignees
+	cachedUserIDs map[string]string
+
 	// channel to send export results
 	out chan<- core.ExportResult
 }
@@ -66,6 +69,7 @@ func (ge *githubExporter) Init(_ context.Context, repo *cache.RepoCache, conf co
 	ge.identityClient = make(map[entity.Id]*rateLimitHandlerClient)
 	ge.cachedOperationIDs = make(map[entity.Id]string)
 	ge.cachedLabels = make(map[string]string)
+	ge.cachedUserIDs = make(map[string]string)
 
 	// preload all clients
 	err := ge.cacheAllClient(repo)
@@ -187,7 +191,7 @@ func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 
 				if snapshot.HasAnyActor(allIdentitiesIds...) {
 					// try to export the bug and it associated events
-					ge.exportBug(ctx, b, out)
+					ge.exportBug(ctx, repo, b, out)
 				}
 			}
 		}
@@ -197,7 +201,7 @@ func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 }
 
 // exportBug publish bugs and related events
-func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out chan<- core.ExportResult) {
+func (ge *githubExporter) exportBug(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, out chan<- core.ExportResult) {
 	snapshot := b.Snapshot()
 	var bugUpdated bool
 
@@ -212,6 +216,12 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 	createOp := snapshot.Operations[0].(*bug.CreateOperation)
 	author := snapshot.Author
 
+	// skip confidential bug
+	if !core.ExportAllowed(ge.conf, createOp) {
+		out <- core.NewExportNothing(b.Id(), "confidential issue")
+		return
+	}
+
 	// skip bug if origin is not allowed
 	origin, ok := snapshot.GetCreateMetadata(core.MetaKeyOrigin)
 	if ok && origin != target {
@@ -243,6 +253,12 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			return
 		}
 
+		// discussions are imported only, there is no issue to export to
+		if strings.Contains(githubURL, "/discussions/") {
+			out <- core.NewExportNothing(b.Id(), "imported from a discussion")
+			return
+		}
+
 		// will be used to mark operation related to a bug as exported
 		bugGithubID = githubID
 		bugGithubURL = githubURL
@@ -294,6 +310,37 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			continue
 		}
 
+		// sync conflicts are local annotations for review, not meant to be exported
+		if _, ok := op.(*bug.SyncConflictOperation); ok {
+			continue
+		}
+
+		// custom fields are defined locally, there is no remote equivalent
+		if _, ok := op.(*bug.SetFieldOperation); ok {
+			continue
+		}
+
+		// redactions are local, the remote comments are left as is
+		if _, ok := op.(*bug.RedactCommentOperation); ok {
+			continue
+		}
+
+		// relations link local bugs, which might not exist on the remote
+		switch op.(type) {
+		case *bug.RelationAddOperation, *bug.RelationRemoveOperation:
+			continue
+		}
+
+		// attachments are stored in git, there is no upload to the remote
+		if _, ok := op.(*bug.AddAttachmentOperation); ok {
+			continue
+		}
+
+		// never leak confidential operations
+		if !core.ExportAllowed(ge.conf, op) {
+			continue
+		}
+
 		// ignore operations already existing in github (due to import or export)
 		// cache the ID of already exported or imported issues and events from Github
 		if id, ok := op.GetMetadata(metaKeyGithubId); ok {
@@ -361,6 +408,27 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 				url = eurl
 			}
 
+		case *bug.ReactionOperation:
+			// github consider the issue body as the issue itself
+			subjectID := bugGithubID
+			if op.Target != createOp.Id() {
+				commentID, ok := ge.cachedOperationIDs[op.Target]
+				if !ok {
+					panic("unexpected error: comment id not found")
+				}
+				subjectID = commentID
+			}
+
+			// the reaction id is used, not to be confused with the comment
+			id, err = ge.reactGithubSubject(ctx, client, subjectID, op.Reaction, op.Remove)
+			if err != nil {
+				err := errors.Wrap(err, "reacting")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportReaction(b.Id())
+
 		case *bug.SetStatusOperation:
 			if err := ge.updateGithubIssueStatus(ctx, client, bugGithubID, op.Status); err != nil {
 				err := errors.Wrap(err, "editing status")
@@ -397,6 +465,18 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			id = bugGithubID
 			url = bugGithubURL
 
+		case *bug.SetAssigneeOperation:
+			if err := ge.updateGithubIssueAssignees(ctx, client, repo, bugGithubID, op.Added, op.Removed); err != nil {
+				err := errors.Wrap(err, "updating assignees")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportAssigneeChange(b.Id())
+
+			id = bugGithubID
+			url = bugGithubURL
+
 		default:
 			panic("unhandled operation type case")
 		}
@@ -689,6 +769,49 @@ func (ge *githubExporter) editCommentGithubIssue(ctx context.Context, gc *rateLi
 	return commentID, m.UpdateIssueComment.IssueComment.URL, nil
 }
 
+// reactionContents map the reactions to their github equivalent
+var reactionContents = map[bug.Reaction]githubv4.ReactionContent{
+	bug.ReactionThumbsUp:   githubv4.ReactionContentThumbsUp,
+	bug.ReactionThumbsDown: githubv4.ReactionContentThumbsDown,
+	bug.ReactionLaugh:      githubv4.ReactionContentLaugh,
+	bug.ReactionHooray:     githubv4.ReactionContentHooray,
+	bug.ReactionConfused:   githubv4.ReactionContentConfused,
+	bug.ReactionHeart:      githubv4.ReactionContentHeart,
+	bug.ReactionRocket:     githubv4.ReactionContentRocket,
+	bug.ReactionEyes:       githubv4.ReactionContentEyes,
+}
+
+// add or remove the reaction of the user to an issue or a comment, and return
+// the id of the reaction
+func (ge *githubExporter) reactGithubSubject(ctx context.Context, gc *rateLimitHandlerClient, subjectID string, reaction bug.Reaction, remove bool) (string, error) {
+	content, ok := reactionContents[reaction]
+	if !ok {
+		return "", fmt.Errorf("unsupported reaction %s", reaction)
+	}
+
+	if remove {
+		m := &removeReactionMutation{}
+		input := githubv4.RemoveReactionInput{
+			SubjectID: subjectID,
+			Content:   content,
+		}
+		if err := gc.mutate(ctx, m, input, nil, ge.out); err != nil {
+			return "", err
+		}
+		return m.RemoveReaction.Reaction.ID, nil
+	}
+
+	m := &addReactionMutation{}
+	input := githubv4.AddReactionInput{
+		SubjectID: subjectID,
+		Content:   content,
+	}
+	if err := gc.mutate(ctx, m, input, nil, ge.out); err != nil {
+		return "", err
+	}
+	return m.AddReaction.Reaction.ID, nil
+}
+
 func (ge *githubExporter) updateGithubIssueStatus(ctx context.Context, gc *rateLimitHandlerClient, id string, status common.Status) error {
 	m := &updateIssueMutation{}
 
@@ -792,3 +915,73 @@ func (ge *githubExporter) updateGithubIssueLabels(ctx context.Context, gc *rateL
 
 	return wg.Wait()
 }
+
+// update github issue assignees. The identities without a Github login,
+// never imported from Github, are not mapped to a remote user and are skipped.
+func (ge *githubExporter) updateGithubIssueAssignees(ctx context.Context, gc *rateLimitHandlerClient, repo *cache.RepoCache, assignableID string, added, removed []entity.Id) error {
+	addedIDs, err := ge.getUsersIDs(ctx, gc, repo, added)
+	if err != nil {
+		return err
+	}
+	removedIDs, err := ge.getUsersIDs(ctx, gc, repo, removed)
+	if err != nil {
+		return err
+	}
+
+	if len(addedIDs) > 0 {
+		m := &addAssigneesToAssignableMutation{}
+		input := githubv4.AddAssigneesToAssignableInput{
+			AssignableID: assignableID,
+			AssigneeIDs:  addedIDs,
+		}
+		if err := gc.mutate(ctx, m, input, nil, ge.out); err != nil {
+			return err
+		}
+	}
+
+	if len(removedIDs) > 0 {
+		m := &removeAssigneesFromAssignableMutation{}
+		input := githubv4.RemoveAssigneesFromAssignableInput{
+			AssignableID: assignableID,
+			AssigneeIDs:  removedIDs,
+		}
+		if err := gc.mutate(ctx, m, input, nil, ge.out); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getUsersIDs return the node ids of the Github users matching the given
+// identities, through their Github login
+func (ge *githubExporter) getUsersIDs(ctx context.Context, gc *rateLimitHandlerClient, repo *cache.RepoCache, identities []entity.Id) ([]githubv4.ID, error) {
+	ids := make([]githubv4.ID, 0, len(identities))
+	for _, identityId := range identities {
+		i, err := repo.ResolveIdentity(identityId)
+		if err != nil {
+			// not known locally, can't be mapped
+			continue
+		}
+		login, ok := i.ImmutableMetadata()[metaKeyGithubLogin]
+		if !ok {
+			continue
+		}
+
+		id, ok := ge.cachedUserIDs[login]
+		if !ok {
+			q := userIdQuery{}
+			variables := map[string]interface{}{
+				"login": githubv4.String(login),
+			}
+			if err := gc.queryExport(ctx, &q, variables, ge.out); err != nil {
+				return nil, err
+			}
+			id = q.User.ID
+			ge.cachedUserIDs[login] = id
+		}
+
+		ids = append(ids, githubv4.ID(id))
+	}
+	return ids, nil
+}
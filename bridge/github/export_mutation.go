@@ -38,6 +38,22 @@ type updateIssueCommentMutation struct {
 	} `graphql:"updateIssueComment(input:$input)"`
 }
 
+type addReactionMutation struct {
+	AddReaction struct {
+		Reaction struct {
+			ID string `graphql:"id"`
+		}
+	} `graphql:"addReaction(input:$input)"`
+}
+
+type removeReactionMutation struct {
+	RemoveReaction struct {
+		Reaction struct {
+			ID string `graphql:"id"`
+		}
+	} `graphql:"removeReaction(input:$input)"`
+}
+
 type removeLabelsFromLabelableMutation struct {
 	AddLabels struct {
 		Labelable struct {
@@ -54,6 +70,22 @@ type addLabelsToLabelableMutation struct {
 	} `graphql:"addLabelsToLabelable(input:$input)"`
 }
 
+type addAssigneesToAssignableMutation struct {
+	AddAssignees struct {
+		Assignable struct {
+			Typename string `graphql:"__typename"`
+		}
+	} `graphql:"addAssigneesToAssignable(input:$input)"`
+}
+
+type removeAssigneesFromAssignableMutation struct {
+	RemoveAssignees struct {
+		Assignable struct {
+			Typename string `graphql:"__typename"`
+		}
+	} `graphql:"removeAssigneesFromAssignable(input:$input)"`
+}
+
 /**
 type createLabelMutation struct {
 	CreateLabel struct {
@@ -17,10 +17,18 @@ const (
 	metaKeyGithubId    = "github-id"
 	metaKeyGithubUrl   = "github-url"
 	metaKeyGithubLogin = "github-login"
+	// metaKeyGithubAnswer flag the comment marked as the answer of a discussion
+	metaKeyGithubAnswer = "github-answer"
+
+	// discussionLabel is the label of the bugs imported from a discussion
+	discussionLabel = "discussion"
 
 	confKeyOwner        = "owner"
 	confKeyProject      = "project"
 	confKeyDefaultLogin = "default-login"
+	// confKeyDiscussions, when set to "true", import the discussions of the
+	// repository as well, as bugs labeled "discussion".
+	confKeyDiscussions = "discussions"
 
 	githubV3Url    = "https://api.github.com"
 	defaultTimeout = 60 * time.Second
@@ -3,6 +3,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/shurcooL/githubv4"
@@ -11,6 +13,7 @@ import (
 	"github.com/MichaelMure/git-bug/bridge/core/auth"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/text"
 )
@@ -24,13 +27,22 @@ type githubImporter struct {
 	// default client
 	client *rateLimitHandlerClient
 
-	// mediator to access the Github API
-	mediator *importMediator
+	// mediator to access the Github API, or the content of an archive
+	mediator importSource
 
 	// send only channel
 	out chan<- core.ImportResult
 }
 
+// importSource provide the events to import, as an importMediator does from
+// the Github API
+type importSource interface {
+	// NextImportEvent returns the next ImportEvent, or nil if done.
+	NextImportEvent() ImportEvent
+	Error() error
+	User(ctx context.Context, loginName string) (*user, error)
+}
+
 func (gi *githubImporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration) error {
 	gi.conf = conf
 	creds, err := auth.List(repo,
@@ -52,7 +64,53 @@ func (gi *githubImporter) Init(_ context.Context, repo *cache.RepoCache, conf co
 // ImportAll iterate over all the configured repository issues and ensure the creation of the
 // missing issues / timeline items / edits / label events ...
 func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ImportResult, error) {
-	gi.mediator = NewImportMediator(ctx, gi.client, gi.conf[confKeyOwner], gi.conf[confKeyProject], since)
+	var states []githubv4.IssueState
+	if since.IsZero() && gi.conf[core.ConfigKeyLazyClosed] == "true" {
+		// On the initial import, only fetch the open issues. The closed ones are
+		// imported on demand with ImportIssue. Later imports fetch everything
+		// updated since, which include the issues closed in the meantime.
+		states = []githubv4.IssueState{githubv4.IssueStateOpen}
+	}
+
+	discussions := gi.conf[confKeyDiscussions] == "true"
+
+	gi.mediator = NewImportMediator(ctx, gi.client, gi.conf[confKeyOwner], gi.conf[confKeyProject], since, states, discussions)
+	return gi.importEvents(ctx, repo), nil
+}
+
+// ResolveIssue return the bug previously imported for the given issue number
+func (gi *githubImporter) ResolveIssue(repo *cache.RepoCache, ref string) (*cache.BugCache, error) {
+	number, err := parseIssueNumber(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("https://github.com/%s/%s/issues/%d", gi.conf[confKeyOwner], gi.conf[confKeyProject], number)
+	return repo.ResolveBugCreateMetadata(metaKeyGithubUrl, url)
+}
+
+// ImportIssue import the single issue with the given number, whatever its state
+func (gi *githubImporter) ImportIssue(ctx context.Context, repo *cache.RepoCache, ref string) (<-chan core.ImportResult, error) {
+	number, err := parseIssueNumber(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	gi.mediator = NewIssueImportMediator(ctx, gi.client, gi.conf[confKeyOwner], gi.conf[confKeyProject], number)
+	return gi.importEvents(ctx, repo), nil
+}
+
+func parseIssueNumber(ref string) (int, error) {
+	number, err := strconv.Atoi(strings.TrimPrefix(ref, "#"))
+	if err != nil || number <= 0 {
+		return 0, fmt.Errorf("invalid issue number: %s", ref)
+	}
+	return number, nil
+}
+
+// importEvents consume the events of the mediator and create the corresponding
+// bugs and operations
+func (gi *githubImporter) importEvents(ctx context.Context, repo *cache.RepoCache) <-chan core.ImportResult {
 	out := make(chan core.ImportResult)
 	gi.out = out
 
@@ -133,6 +191,25 @@ func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 					out <- core.NewImportError(err, "")
 					return
 				}
+			case DiscussionEvent:
+				// first: commit what is being held in currBug
+				if err = gi.commit(currBug, out); err != nil {
+					out <- core.NewImportError(err, "")
+					return
+				}
+				currBug, err = gi.ensureDiscussion(ctx, repo, &event.discussion)
+				if err != nil {
+					err = fmt.Errorf("discussion creation: %v", err)
+					out <- core.NewImportError(err, "")
+					return
+				}
+			case DiscussionCommentEvent:
+				err = gi.ensureDiscussionComment(ctx, repo, currBug, &event.discussionComment)
+				if err != nil {
+					err = fmt.Errorf("discussion comment creation: %v", err)
+					out <- core.NewImportError(err, "")
+					return
+				}
 			default:
 				panic("Unknown event type")
 			}
@@ -146,7 +223,7 @@ func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 		}
 	}()
 
-	return out, nil
+	return out
 }
 
 func (gi *githubImporter) getEventHandleMsgs() ImportEvent {
@@ -248,8 +325,13 @@ func (gi *githubImporter) ensureTimelineItem(ctx context.Context, repo *cache.Re
 		return nil
 
 	case "LabeledEvent":
+		err := gi.ensureLabelInfo(repo, item.LabeledEvent.Label)
+		if err != nil {
+			return err
+		}
+
 		id := parseId(item.LabeledEvent.Id)
-		_, err := b.ResolveOperationWithMetadata(metaKeyGithubId, id)
+		_, err = b.ResolveOperationWithMetadata(metaKeyGithubId, id)
 		if err == nil {
 			return nil
 		}
@@ -278,8 +360,13 @@ func (gi *githubImporter) ensureTimelineItem(ctx context.Context, repo *cache.Re
 		return nil
 
 	case "UnlabeledEvent":
+		err := gi.ensureLabelInfo(repo, item.UnlabeledEvent.Label)
+		if err != nil {
+			return err
+		}
+
 		id := parseId(item.UnlabeledEvent.Id)
-		_, err := b.ResolveOperationWithMetadata(metaKeyGithubId, id)
+		_, err = b.ResolveOperationWithMetadata(metaKeyGithubId, id)
 		if err == nil {
 			return nil
 		}
@@ -307,6 +394,12 @@ func (gi *githubImporter) ensureTimelineItem(ctx context.Context, repo *cache.Re
 		gi.out <- core.NewImportLabelChange(b.Id(), op.Id())
 		return nil
 
+	case "AssignedEvent":
+		return gi.ensureAssigneeChange(ctx, repo, b, item.AssignedEvent.actorEvent, item.AssignedEvent.Assignee, true)
+
+	case "UnassignedEvent":
+		return gi.ensureAssigneeChange(ctx, repo, b, item.UnassignedEvent.actorEvent, item.UnassignedEvent.Assignee, false)
+
 	case "ClosedEvent":
 		id := parseId(item.ClosedEvent.Id)
 		_, err := b.ResolveOperationWithMetadata(metaKeyGithubId, id)
@@ -382,6 +475,13 @@ func (gi *githubImporter) ensureTimelineItem(ctx context.Context, repo *cache.Re
 			title = EmptyTitlePlaceholder
 		}
 
+		// If the title has also been edited locally and not exported yet, both
+		// sides changed it concurrently. The Github title is kept, but the
+		// conflict is recorded for a human to review.
+		local := b.Snapshot().Title
+		previous := text.CleanupOneLine(string(item.RenamedTitleEvent.PreviousTitle))
+		conflict := local != title && local != previous && hasPendingTitleEdit(b)
+
 		op, err := b.SetTitleRaw(
 			author,
 			item.RenamedTitleEvent.CreatedAt.Unix(),
@@ -393,12 +493,46 @@ func (gi *githubImporter) ensureTimelineItem(ctx context.Context, repo *cache.Re
 		}
 
 		gi.out <- core.NewImportTitleEdition(b.Id(), op.Id())
+
+		if conflict {
+			conflictOp, err := b.RecordSyncConflictRaw(
+				author,
+				item.RenamedTitleEvent.CreatedAt.Unix(),
+				target,
+				"title",
+				local,
+				title,
+				bug.SyncSideRemote,
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+			gi.out <- core.NewImportSyncConflict(b.Id(), conflictOp.Id(), "title edited on both sides")
+		}
+
 		return nil
 	}
 
 	return nil
 }
 
+// hasPendingTitleEdit return true if the current title of the bug comes from
+// a local edition that has not been exported to Github yet
+func hasPendingTitleEdit(b *cache.BugCache) bool {
+	ops := b.Snapshot().Operations
+	for i := len(ops) - 1; i >= 0; i-- {
+		switch ops[i].(type) {
+		case *bug.SetTitleOperation:
+			_, ok := ops[i].GetMetadata(metaKeyGithubId)
+			return !ok
+		case *bug.CreateOperation:
+			return false
+		}
+	}
+	return false
+}
+
 func (gi *githubImporter) ensureCommentEdit(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, ghTargetId githubv4.ID, edit *userContentEdit) error {
 	// find comment
 	target, err := b.ResolveOperationWithMetadata(metaKeyGithubId, parseId(ghTargetId))
@@ -489,6 +623,205 @@ func (gi *githubImporter) ensureComment(ctx context.Context, repo *cache.RepoCac
 	return nil
 }
 
+// ensureDiscussion create the bug of a discussion, labeled as such, and keep
+// its status in sync with the discussion being closed or not.
+//
+// Unlike issues, the edition history of a discussion is not imported: the bug
+// is created with the current title and body.
+func (gi *githubImporter) ensureDiscussion(ctx context.Context, repo *cache.RepoCache, discussion *discussion) (*cache.BugCache, error) {
+	author, err := gi.ensurePerson(ctx, repo, discussion.Author)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
+		return excerpt.CreateMetadata[metaKeyGithubUrl] == discussion.Url.String() &&
+			excerpt.CreateMetadata[metaKeyGithubId] == parseId(discussion.Id)
+	})
+	if err != nil && err != bug.ErrBugNotExist {
+		return nil, err
+	}
+
+	if err == bug.ErrBugNotExist {
+		title := text.CleanupOneLine(string(discussion.Title))
+		if text.Empty(title) {
+			title = EmptyTitlePlaceholder
+		}
+
+		b, _, err = repo.NewBugRaw(
+			author,
+			discussion.CreatedAt.Unix(),
+			title,
+			text.Cleanup(string(discussion.Body)),
+			nil,
+			map[string]string{
+				core.MetaKeyOrigin: target,
+				metaKeyGithubId:    parseId(discussion.Id),
+				metaKeyGithubUrl:   discussion.Url.String(),
+			})
+		if err != nil {
+			return nil, err
+		}
+		gi.out <- core.NewImportBug(b.Id())
+
+		op, err := b.ForceChangeLabelsRaw(author, discussion.CreatedAt.Unix(), []string{discussionLabel}, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		gi.out <- core.NewImportLabelChange(b.Id(), op.Id())
+	}
+
+	// Github doesn't expose the closing and reopening of a discussion as
+	// events, so only the current state is replicated.
+	status := b.Snapshot().Status
+	switch {
+	case bool(discussion.Closed) && status == common.OpenStatus:
+		unixTime := discussion.UpdatedAt.Unix()
+		if discussion.ClosedAt != nil {
+			unixTime = discussion.ClosedAt.Unix()
+		}
+		op, err := b.CloseRaw(author, unixTime, nil)
+		if err != nil {
+			return nil, err
+		}
+		gi.out <- core.NewImportStatusChange(b.Id(), op.Id())
+
+	case !bool(discussion.Closed) && status == common.ClosedStatus:
+		op, err := b.OpenRaw(author, discussion.UpdatedAt.Unix(), nil)
+		if err != nil {
+			return nil, err
+		}
+		gi.out <- core.NewImportStatusChange(b.Id(), op.Id())
+	}
+
+	return b, nil
+}
+
+// ensureDiscussionComment add a comment or a reply of a discussion to its bug.
+// The comment marked as the answer carry the metaKeyGithubAnswer metadata,
+// including when it has been marked after being imported.
+func (gi *githubImporter) ensureDiscussionComment(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, comment *discussionComment) error {
+	op, err := b.ResolveOperationWithMetadata(metaKeyGithubId, parseId(comment.Id))
+	if err == nil {
+		if !bool(comment.IsAnswer) || isDiscussionAnswer(b, op) {
+			return nil
+		}
+		author, err := gi.ensurePerson(ctx, repo, comment.Author)
+		if err != nil {
+			return err
+		}
+		_, err = b.SetMetadataRaw(author, time.Now().Unix(), op, map[string]string{
+			metaKeyGithubAnswer: "true",
+		})
+		return err
+	}
+	if err != cache.ErrNoMatchingOp {
+		// real error
+		return err
+	}
+
+	author, err := gi.ensurePerson(ctx, repo, comment.Author)
+	if err != nil {
+		return err
+	}
+
+	metadata := map[string]string{
+		metaKeyGithubId:  parseId(comment.Id),
+		metaKeyGithubUrl: comment.Url.String(),
+	}
+	if bool(comment.IsAnswer) {
+		metadata[metaKeyGithubAnswer] = "true"
+	}
+
+	commentId, _, err := b.AddCommentRaw(
+		author,
+		comment.CreatedAt.Unix(),
+		text.Cleanup(string(comment.Body)),
+		nil,
+		metadata,
+	)
+	if err != nil {
+		return err
+	}
+
+	gi.out <- core.NewImportComment(b.Id(), commentId)
+	return nil
+}
+
+// isDiscussionAnswer return true if the operation with the given id is
+// already flagged as the answer of the discussion
+func isDiscussionAnswer(b *cache.BugCache, opId entity.Id) bool {
+	for _, op := range b.Snapshot().Operations {
+		if op.Id() == opId {
+			_, ok := op.GetMetadata(metaKeyGithubAnswer)
+			return ok
+		}
+	}
+	return false
+}
+
+// ensureLabelInfo record the color and description of a Github label in the
+// label registry, to render it as on Github. This is done on each import to
+// follow the changes.
+func (gi *githubImporter) ensureLabelInfo(repo *cache.RepoCache, l label) error {
+	if _, err := bug.LabelColorFromHex(string(l.Color)); err != nil {
+		// no color, or one git-bug can't render
+		return nil
+	}
+
+	return repo.SetLabelInfo(bug.Label(text.CleanupOneLine(string(l.Name))), cache.LabelInfo{
+		Color:       string(l.Color),
+		Description: text.CleanupOneLine(string(l.Description)),
+	})
+}
+
+// ensureAssigneeChange import the assignment or unassignment of an issue
+func (gi *githubImporter) ensureAssigneeChange(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, event actorEvent, assignee *assignee, assigned bool) error {
+	id := parseId(event.Id)
+	_, err := b.ResolveOperationWithMetadata(metaKeyGithubId, id)
+	if err == nil {
+		return nil
+	}
+	if err != cache.ErrNoMatchingOp {
+		return err
+	}
+
+	author, err := gi.ensurePerson(ctx, repo, event.Actor)
+	if err != nil {
+		return err
+	}
+
+	var assigneeActor *actor
+	if assignee != nil {
+		assigneeActor = &assignee.Actor
+	}
+	target, err := gi.ensurePerson(ctx, repo, assigneeActor)
+	if err != nil {
+		return err
+	}
+
+	var added, removed []entity.Id
+	if assigned {
+		added = []entity.Id{target.Id()}
+	} else {
+		removed = []entity.Id{target.Id()}
+	}
+
+	op, err := b.SetAssigneeRaw(
+		author,
+		event.CreatedAt.Unix(),
+		added,
+		removed,
+		map[string]string{metaKeyGithubId: id},
+	)
+	if err != nil {
+		return err
+	}
+
+	gi.out <- core.NewImportAssigneeChange(b.Id(), op.Id())
+	return nil
+}
+
 // ensurePerson create a bug.Person from the Github data
 func (gi *githubImporter) ensurePerson(ctx context.Context, repo *cache.RepoCache, actor *actor) (*cache.IdentityCache, error) {
 	// When a user has been deleted, Github return a null actor, while displaying a profile named "ghost"
@@ -0,0 +1,825 @@
+package github
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// ImportArchive import the issues of Github archives, without using the API.
+// Two kinds of archives are supported, and can be mixed:
+//   - the data export of a repository or an organization (also known as a
+//     migration archive), as a directory or a .tar.gz file holding the
+//     issues_*.json, issue_comments_*.json, issue_events_*.json, users_*.json
+//     and labels_*.json files
+//   - the event files of GH Archive (https://www.gharchive.org), compressed or
+//     not, from which the IssuesEvent and IssueCommentEvent are used
+//
+// If repository is not empty, like "MichaelMure/git-bug", only the issues of
+// that repository are imported.
+//
+// The bugs, comments, label changes, status changes and title changes are
+// created as the live importer does, with the same metadata, so that the
+// bridge can take over later. The edition history of the issues and comments
+// is not part of the archives: only their last known version is imported.
+func ImportArchive(ctx context.Context, repo *cache.RepoCache, paths []string, repository string) (<-chan core.ImportResult, error) {
+	ga := newGithubArchive(repository)
+
+	for _, p := range paths {
+		if err := ga.readPath(p); err != nil {
+			return nil, fmt.Errorf("%s: %v", p, err)
+		}
+	}
+
+	events, err := ga.importEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	gi := &githubImporter{
+		mediator: &archiveSource{ctx: ctx, events: events},
+	}
+	return gi.importEvents(ctx, repo), nil
+}
+
+// archiveSource provide the import events read from archives
+type archiveSource struct {
+	ctx    context.Context
+	events []ImportEvent
+	err    error
+}
+
+func (as *archiveSource) NextImportEvent() ImportEvent {
+	if len(as.events) == 0 {
+		return nil
+	}
+	if err := as.ctx.Err(); err != nil {
+		as.err = err
+		as.events = nil
+		return nil
+	}
+	event := as.events[0]
+	as.events = as.events[1:]
+	return event
+}
+
+func (as *archiveSource) Error() error {
+	return as.err
+}
+
+// User is only used for the "ghost" user, replacing the deleted users. Only
+// its login is known offline.
+func (as *archiveSource) User(_ context.Context, loginName string) (*user, error) {
+	return &user{Login: githubv4.String(loginName)}, nil
+}
+
+// githubArchive gather the content of the archives, by issue
+type githubArchive struct {
+	// repository, lowercase, restrict the issues to import if not empty
+	repository string
+
+	// the files of a data export, by kind, to be decoded once they have all
+	// been read, as they refer to each other
+	exportFiles map[string][][]byte
+
+	// actors are shared between the events, by login
+	actors map[string]*actor
+	// labels of a data export, by url
+	labels map[string]label
+	// issues by url
+	issues map[string]*archivedIssue
+}
+
+type archivedIssue struct {
+	issue
+	// updatedAt is the time of the event the issue has been read from, to keep
+	// the most recent title and body
+	updatedAt time.Time
+	items     []timelineItem
+	itemIds   map[string]bool
+}
+
+func newGithubArchive(repository string) *githubArchive {
+	return &githubArchive{
+		repository:  strings.ToLower(strings.Trim(repository, "/")),
+		exportFiles: make(map[string][][]byte),
+		actors:      make(map[string]*actor),
+		labels:      make(map[string]label),
+		issues:      make(map[string]*archivedIssue),
+	}
+}
+
+// the kinds of files of a data export, in the order they are decoded
+var exportKinds = []string{"users", "labels", "issues", "issue_comments", "issue_events"}
+
+func (ga *githubArchive) readPath(p string) error {
+	info, err := os.Stat(p)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() {
+		return filepath.Walk(p, func(name string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			return ga.readFile(name)
+		})
+	}
+
+	return ga.readFile(p)
+}
+
+func (ga *githubArchive) readFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		return ga.readTar(gz)
+	case strings.HasSuffix(name, ".tar"):
+		return ga.readTar(f)
+	default:
+		return ga.readEntry(name, f)
+	}
+}
+
+func (ga *githubArchive) readTar(r io.Reader) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := ga.readEntry(header.Name, tr); err != nil {
+			return fmt.Errorf("%s: %v", header.Name, err)
+		}
+	}
+}
+
+func (ga *githubArchive) readEntry(name string, r io.Reader) error {
+	base := path.Base(filepath.ToSlash(name))
+
+	if strings.HasSuffix(base, ".gz") {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+		base = strings.TrimSuffix(base, ".gz")
+	}
+
+	if !strings.HasSuffix(base, ".json") {
+		// attachments, repositories ...
+		return nil
+	}
+
+	for _, kind := range exportKinds {
+		if strings.HasPrefix(base, kind+"_") {
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			ga.exportFiles[kind] = append(ga.exportFiles[kind], data)
+			return nil
+		}
+	}
+
+	return ga.readGHArchive(r)
+}
+
+// readGHArchive read a GH Archive file, made of one JSON event per line
+func (ga *githubArchive) readGHArchive(r io.Reader) error {
+	br := bufio.NewReader(r)
+
+	// the other files of a data export hold arrays
+	for {
+		c, err := br.ReadByte()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if c == '[' {
+			return nil
+		}
+		if c != ' ' && c != '\t' && c != '\r' && c != '\n' {
+			_ = br.UnreadByte()
+			break
+		}
+	}
+
+	dec := json.NewDecoder(br)
+	for {
+		var event ghArchiveEvent
+		err := dec.Decode(&event)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := ga.addGHArchiveEvent(&event); err != nil {
+			return err
+		}
+	}
+}
+
+// match return true if the issue with the given url is to be imported
+func (ga *githubArchive) match(issueUrl string) bool {
+	if ga.repository == "" {
+		return true
+	}
+	prefix := "https://github.com/" + ga.repository + "/issues/"
+	return strings.HasPrefix(strings.ToLower(issueUrl), prefix)
+}
+
+// actor return the shared actor of the given login, or nil for the deleted
+// users, as the API does
+func (ga *githubArchive) actor(login string) *actor {
+	if login == "" || login == "ghost" {
+		return nil
+	}
+	if a, ok := ga.actors[login]; ok {
+		return a
+	}
+	a := &actor{Typename: "User", Login: githubv4.String(login)}
+	if strings.HasSuffix(login, "[bot]") {
+		a.Typename = "Bot"
+	}
+	ga.actors[login] = a
+	return a
+}
+
+// ensureIssue return the issue with the given url, created if needed. The
+// title and body are updated if the issue is more recent than the one known.
+func (ga *githubArchive) ensureIssue(issueUrl string, id string, updatedAt time.Time, fill func(i *archivedIssue) error) (*archivedIssue, error) {
+	i, ok := ga.issues[issueUrl]
+	if !ok {
+		u, err := url.Parse(issueUrl)
+		if err != nil {
+			return nil, err
+		}
+		number, err := strconv.Atoi(path.Base(u.Path))
+		if err != nil {
+			return nil, fmt.Errorf("invalid issue url %s", issueUrl)
+		}
+		i = &archivedIssue{itemIds: make(map[string]bool)}
+		i.Id = githubv4.ID(id)
+		i.Url = githubv4.URI{URL: u}
+		i.Number = githubv4.Int(number)
+	}
+
+	if !ok || !updatedAt.Before(i.updatedAt) {
+		if err := fill(i); err != nil {
+			return nil, err
+		}
+		i.updatedAt = updatedAt
+	}
+
+	ga.issues[issueUrl] = i
+	return i, nil
+}
+
+// addItem add a timeline item to the issue, unless it has been added already
+func (i *archivedIssue) addItem(id string, item timelineItem) {
+	if i.itemIds[id] {
+		return
+	}
+	i.itemIds[id] = true
+	i.items = append(i.items, item)
+}
+
+// importEvents decode the data exports, and return the import events of all
+// the issues, ordered by creation. The timeline items of each issue are ordered
+// by time.
+func (ga *githubArchive) importEvents() ([]ImportEvent, error) {
+	if err := ga.decodeExport(); err != nil {
+		return nil, err
+	}
+
+	issues := make([]*archivedIssue, 0, len(ga.issues))
+	for _, i := range ga.issues {
+		issues = append(issues, i)
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		if !issues[i].CreatedAt.Equal(issues[j].CreatedAt.Time) {
+			return issues[i].CreatedAt.Before(issues[j].CreatedAt.Time)
+		}
+		return issues[i].Url.String() < issues[j].Url.String()
+	})
+
+	var events []ImportEvent
+	for _, i := range issues {
+		events = append(events, IssueEvent{issue: i.issue})
+
+		sort.SliceStable(i.items, func(a, b int) bool {
+			return timelineItemTime(&i.items[a]).Before(timelineItemTime(&i.items[b]))
+		})
+		for _, item := range i.items {
+			events = append(events, TimelineEvent{issueId: i.Id, timelineItem: item})
+		}
+	}
+
+	return events, nil
+}
+
+func timelineItemTime(item *timelineItem) time.Time {
+	switch item.Typename {
+	case "IssueComment":
+		return item.IssueComment.CreatedAt.Time
+	case "LabeledEvent":
+		return item.LabeledEvent.CreatedAt.Time
+	case "UnlabeledEvent":
+		return item.UnlabeledEvent.CreatedAt.Time
+	case "AssignedEvent":
+		return item.AssignedEvent.CreatedAt.Time
+	case "UnassignedEvent":
+		return item.UnassignedEvent.CreatedAt.Time
+	case "ClosedEvent":
+		return item.ClosedEvent.CreatedAt.Time
+	case "ReopenedEvent":
+		return item.ReopenedEvent.CreatedAt.Time
+	case "RenamedTitleEvent":
+		return item.RenamedTitleEvent.CreatedAt.Time
+	}
+	return time.Time{}
+}
+
+// newStatusItem return the timeline item of a closing or reopening
+func newStatusItem(closed bool, event actorEvent) timelineItem {
+	var item timelineItem
+	if closed {
+		item.Typename = "ClosedEvent"
+		item.ClosedEvent.actorEvent = event
+	} else {
+		item.Typename = "ReopenedEvent"
+		item.ReopenedEvent.actorEvent = event
+	}
+	return item
+}
+
+// newLabelItem return the timeline item of a label being added or removed
+func newLabelItem(added bool, event actorEvent, l label) timelineItem {
+	var item timelineItem
+	if added {
+		item.Typename = "LabeledEvent"
+		item.LabeledEvent = labeledEvent{actorEvent: event, Label: l}
+	} else {
+		item.Typename = "UnlabeledEvent"
+		item.UnlabeledEvent = unlabeledEvent{actorEvent: event, Label: l}
+	}
+	return item
+}
+
+// legacyNodeId return the global id used by the Github GraphQL API for an
+// object of the REST API, in the legacy format still accepted by the API.
+func legacyNodeId(typename string, databaseId int64) string {
+	raw := fmt.Sprintf("0%d:%s%d", len(typename), typename, databaseId)
+	return base64.StdEncoding.EncodeToString([]byte(raw))
+}
+
+// fragmentId return the database id in the fragment of an url, like
+// https://github.com/octo/repo/issues/1#issuecomment-42
+func fragmentId(rawUrl string, prefix string) (int64, bool) {
+	_, fragment, ok := strings.Cut(rawUrl, "#"+prefix)
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(fragment, 10, 64)
+	return id, err == nil
+}
+
+// GH Archive
+
+type ghArchiveEvent struct {
+	Id        string
+	Type      string
+	Actor     restUser
+	Payload   json.RawMessage
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ghArchivePayload struct {
+	Action  string
+	Issue   *restIssue
+	Comment *restComment
+	Label   *restLabel
+	Changes struct {
+		Title *struct {
+			From string
+		}
+	}
+}
+
+type restUser struct {
+	Login string
+}
+
+type restLabel struct {
+	Name        string
+	Color       string
+	Description string
+}
+
+type restIssue struct {
+	Id          int64
+	NodeId      string `json:"node_id"`
+	HtmlUrl     string `json:"html_url"`
+	Title       string
+	Body        string
+	User        *restUser
+	CreatedAt   time.Time       `json:"created_at"`
+	PullRequest json.RawMessage `json:"pull_request"`
+}
+
+type restComment struct {
+	Id        int64
+	NodeId    string `json:"node_id"`
+	HtmlUrl   string `json:"html_url"`
+	Body      string
+	User      *restUser
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (u *restUser) login() string {
+	if u == nil {
+		return ""
+	}
+	return u.Login
+}
+
+func (ga *githubArchive) addGHArchiveEvent(event *ghArchiveEvent) error {
+	if event.Type != "IssuesEvent" && event.Type != "IssueCommentEvent" {
+		return nil
+	}
+
+	var payload ghArchivePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("event %s: %v", event.Id, err)
+	}
+
+	if payload.Issue == nil || len(payload.Issue.PullRequest) > 0 && string(payload.Issue.PullRequest) != "null" {
+		// comments of the pull requests use the same events
+		return nil
+	}
+	if !ga.match(payload.Issue.HtmlUrl) {
+		return nil
+	}
+
+	issueId := payload.Issue.NodeId
+	if issueId == "" {
+		issueId = legacyNodeId("Issue", payload.Issue.Id)
+	}
+
+	i, err := ga.ensureIssue(payload.Issue.HtmlUrl, issueId, event.CreatedAt, func(i *archivedIssue) error {
+		i.Title = githubv4.String(payload.Issue.Title)
+		i.Body = githubv4.String(payload.Issue.Body)
+		i.CreatedAt = githubv4.DateTime{Time: payload.Issue.CreatedAt}
+		i.Author = ga.actor(payload.Issue.User.login())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The events of GH Archive are not the events of the issue timelines,
+	// which ids are unknown. The id of the event is used instead.
+	actorEvent := actorEvent{
+		Id:        githubv4.ID("gharchive-" + event.Id),
+		CreatedAt: githubv4.DateTime{Time: event.CreatedAt},
+		Actor:     ga.actor(event.Actor.Login),
+	}
+
+	switch {
+	case event.Type == "IssueCommentEvent" && payload.Action == "created" && payload.Comment != nil:
+		c := payload.Comment
+		id := c.NodeId
+		if id == "" {
+			id = legacyNodeId("IssueComment", c.Id)
+		}
+		u, err := url.Parse(c.HtmlUrl)
+		if err != nil {
+			return err
+		}
+		var item timelineItem
+		item.Typename = "IssueComment"
+		item.IssueComment.Id = githubv4.ID(id)
+		item.IssueComment.CreatedAt = githubv4.DateTime{Time: c.CreatedAt}
+		item.IssueComment.Author = ga.actor(c.User.login())
+		item.IssueComment.Body = githubv4.String(c.Body)
+		item.IssueComment.Url = githubv4.URI{URL: u}
+		i.addItem(id, item)
+
+	case payload.Action == "closed", payload.Action == "reopened":
+		i.addItem(event.Id, newStatusItem(payload.Action == "closed", actorEvent))
+
+	case payload.Action == "labeled" && payload.Label != nil, payload.Action == "unlabeled" && payload.Label != nil:
+		l := label{
+			Name:        githubv4.String(payload.Label.Name),
+			Color:       githubv4.String(payload.Label.Color),
+			Description: githubv4.String(payload.Label.Description),
+		}
+		i.addItem(event.Id, newLabelItem(payload.Action == "labeled", actorEvent, l))
+
+	case payload.Action == "edited" && payload.Changes.Title != nil:
+		var item timelineItem
+		item.Typename = "RenamedTitleEvent"
+		item.RenamedTitleEvent = renamedTitleEvent{
+			actorEvent:    actorEvent,
+			PreviousTitle: githubv4.String(payload.Changes.Title.From),
+			CurrentTitle:  githubv4.String(payload.Issue.Title),
+		}
+		i.addItem(event.Id, item)
+	}
+
+	return nil
+}
+
+// data export
+
+type exportUser struct {
+	Login     string
+	Name      string
+	AvatarUrl string `json:"avatar_url"`
+	Emails    []struct {
+		Address string
+		Primary bool
+	}
+}
+
+type exportLabel struct {
+	Url         string
+	Name        string
+	Color       string
+	Description string
+}
+
+type exportIssue struct {
+	Url       string
+	Id        int64
+	NodeId    string `json:"node_id"`
+	User      string
+	Title     string
+	Body      string
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type exportComment struct {
+	Url       string
+	Issue     string
+	User      string
+	Body      string
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type exportIssueEvent struct {
+	Url        string
+	Issue      string
+	Actor      string
+	Event      string
+	Label      string
+	LabelName  string    `json:"label_name"`
+	LabelColor string    `json:"label_color"`
+	Assignee   string    `json:"assignee"`
+	TitleWas   string    `json:"title_was"`
+	TitleIs    string    `json:"title_is"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// userLogin return the login of a user referred by its url, like
+// https://github.com/octocat
+func userLogin(userUrl string) string {
+	if userUrl == "" {
+		return ""
+	}
+	return path.Base(strings.TrimSuffix(userUrl, "/"))
+}
+
+func (ga *githubArchive) decodeExport() error {
+	for _, kind := range exportKinds {
+		for _, data := range ga.exportFiles[kind] {
+			if err := ga.decodeExportFile(kind, data); err != nil {
+				return fmt.Errorf("%s: %v", kind, err)
+			}
+		}
+	}
+	ga.exportFiles = make(map[string][][]byte)
+	return nil
+}
+
+func (ga *githubArchive) decodeExportFile(kind string, data []byte) error {
+	switch kind {
+	case "users":
+		var users []exportUser
+		if err := json.Unmarshal(data, &users); err != nil {
+			return err
+		}
+		for _, u := range users {
+			a := ga.actor(u.Login)
+			if a == nil {
+				continue
+			}
+			if u.Name != "" {
+				name := githubv4.String(u.Name)
+				a.User.Name = &name
+			}
+			a.AvatarUrl = githubv4.String(u.AvatarUrl)
+			for _, email := range u.Emails {
+				if email.Primary || a.User.Email == "" {
+					a.User.Email = githubv4.String(email.Address)
+				}
+			}
+		}
+
+	case "labels":
+		var labels []exportLabel
+		if err := json.Unmarshal(data, &labels); err != nil {
+			return err
+		}
+		for _, l := range labels {
+			ga.labels[l.Url] = label{
+				Name:        githubv4.String(l.Name),
+				Color:       githubv4.String(l.Color),
+				Description: githubv4.String(l.Description),
+			}
+		}
+
+	case "issues":
+		var issues []exportIssue
+		if err := json.Unmarshal(data, &issues); err != nil {
+			return err
+		}
+		for _, exported := range issues {
+			if !ga.match(exported.Url) {
+				continue
+			}
+			// The export doesn't have the id of the issues. If missing, the url is
+			// used instead.
+			id := exported.NodeId
+			switch {
+			case id == "" && exported.Id != 0:
+				id = legacyNodeId("Issue", exported.Id)
+			case id == "":
+				id = exported.Url
+			}
+			_, err := ga.ensureIssue(exported.Url, id, time.Time{}, func(i *archivedIssue) error {
+				i.Title = githubv4.String(exported.Title)
+				i.Body = githubv4.String(exported.Body)
+				i.CreatedAt = githubv4.DateTime{Time: exported.CreatedAt}
+				i.Author = ga.actor(userLogin(exported.User))
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+	case "issue_comments":
+		var comments []exportComment
+		if err := json.Unmarshal(data, &comments); err != nil {
+			return err
+		}
+		for _, c := range comments {
+			i, ok := ga.issues[c.Issue]
+			if !ok {
+				continue
+			}
+			id := c.Url
+			if databaseId, ok := fragmentId(c.Url, "issuecomment-"); ok {
+				id = legacyNodeId("IssueComment", databaseId)
+			}
+			u, err := url.Parse(c.Url)
+			if err != nil {
+				return err
+			}
+			var item timelineItem
+			item.Typename = "IssueComment"
+			item.IssueComment.Id = githubv4.ID(id)
+			item.IssueComment.CreatedAt = githubv4.DateTime{Time: c.CreatedAt}
+			item.IssueComment.Author = ga.actor(userLogin(c.User))
+			item.IssueComment.Body = githubv4.String(c.Body)
+			item.IssueComment.Url = githubv4.URI{URL: u}
+			i.addItem(id, item)
+		}
+
+	case "issue_events":
+		var events []exportIssueEvent
+		if err := json.Unmarshal(data, &events); err != nil {
+			return err
+		}
+		for _, e := range events {
+			if i, ok := ga.issues[e.Issue]; ok {
+				ga.addExportIssueEvent(i, &e)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ga *githubArchive) addExportIssueEvent(i *archivedIssue, e *exportIssueEvent) {
+	typenames := map[string]string{
+		"closed":     "ClosedEvent",
+		"reopened":   "ReopenedEvent",
+		"labeled":    "LabeledEvent",
+		"unlabeled":  "UnlabeledEvent",
+		"assigned":   "AssignedEvent",
+		"unassigned": "UnassignedEvent",
+		"renamed":    "RenamedTitleEvent",
+	}
+	typename, ok := typenames[e.Event]
+	if !ok {
+		// references, locks ... are not imported
+		return
+	}
+
+	id := e.Url
+	if databaseId, ok := fragmentId(e.Url, "event-"); ok {
+		id = legacyNodeId(typename, databaseId)
+	}
+
+	event := actorEvent{
+		Id:        githubv4.ID(id),
+		CreatedAt: githubv4.DateTime{Time: e.CreatedAt},
+		Actor:     ga.actor(userLogin(e.Actor)),
+	}
+
+	switch e.Event {
+	case "closed", "reopened":
+		i.addItem(id, newStatusItem(e.Event == "closed", event))
+
+	case "labeled", "unlabeled":
+		l, ok := ga.labels[e.Label]
+		if !ok {
+			l = label{
+				Name:  githubv4.String(e.LabelName),
+				Color: githubv4.String(e.LabelColor),
+			}
+		}
+		if l.Name == "" {
+			return
+		}
+		i.addItem(id, newLabelItem(e.Event == "labeled", event, l))
+
+	case "assigned", "unassigned":
+		a := ga.actor(userLogin(e.Assignee))
+		if a == nil {
+			// the assignee is unknown
+			return
+		}
+		target := &assignee{Actor: *a}
+		var item timelineItem
+		item.Typename = githubv4.String(typename)
+		if e.Event == "assigned" {
+			item.AssignedEvent = assignedEvent{actorEvent: event, Assignee: target}
+		} else {
+			item.UnassignedEvent = unassignedEvent{actorEvent: event, Assignee: target}
+		}
+		i.addItem(id, item)
+
+	case "renamed":
+		var item timelineItem
+		item.Typename = "RenamedTitleEvent"
+		item.RenamedTitleEvent = renamedTitleEvent{
+			actorEvent:    event,
+			PreviousTitle: githubv4.String(e.TitleWas),
+			CurrentTitle:  githubv4.String(e.TitleIs),
+		}
+		i.addItem(id, item)
+	}
+}
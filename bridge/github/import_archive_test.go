@@ -0,0 +1,158 @@
+package github
+
+import (
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const archiveUsers = `[
+  {"type": "user", "url": "https://github.com/octocat", "login": "octocat", "name": "Mona Octocat",
+   "avatar_url": "https://avatars.githubusercontent.com/u/583231",
+   "emails": [{"address": "octocat@example.com", "primary": true}]}
+]`
+
+const archiveLabels = `[
+  {"type": "label", "url": "https://github.com/octo/repo/labels/bug", "name": "bug", "color": "d73a4a",
+   "description": "Something isn't working"}
+]`
+
+const archiveIssues = `[
+  {"type": "issue", "url": "https://github.com/octo/repo/issues/1", "user": "https://github.com/octocat",
+   "title": "crash on start", "body": "it crashes", "created_at": "2020-01-01T10:00:00.000Z"},
+  {"type": "issue", "url": "https://github.com/other/repo/issues/1", "user": "https://github.com/octocat",
+   "title": "elsewhere", "body": "not imported", "created_at": "2020-01-01T10:00:00.000Z"}
+]`
+
+const archiveComments = `[
+  {"type": "issue_comment", "url": "https://github.com/octo/repo/issues/1#issuecomment-42",
+   "issue": "https://github.com/octo/repo/issues/1", "user": "https://github.com/hubot",
+   "body": "same here", "created_at": "2020-01-02T10:00:00.000Z"}
+]`
+
+const archiveIssueEvents = `[
+  {"type": "issue_event", "url": "https://github.com/octo/repo/issues/1#event-7",
+   "issue": "https://github.com/octo/repo/issues/1", "actor": "https://github.com/octocat",
+   "event": "closed", "created_at": "2020-01-04T10:00:00.000Z"},
+  {"type": "issue_event", "url": "https://github.com/octo/repo/issues/1#event-5",
+   "issue": "https://github.com/octo/repo/issues/1", "actor": "https://github.com/octocat",
+   "event": "labeled", "label": "https://github.com/octo/repo/labels/bug", "created_at": "2020-01-03T10:00:00.000Z"},
+  {"type": "issue_event", "url": "https://github.com/octo/repo/issues/1#event-6",
+   "issue": "https://github.com/octo/repo/issues/1", "actor": "https://github.com/octocat",
+   "event": "renamed", "title_was": "crash", "title_is": "crash on start", "created_at": "2020-01-03T11:00:00.000Z"},
+  {"type": "issue_event", "url": "https://github.com/octo/repo/issues/1#event-8",
+   "issue": "https://github.com/octo/repo/issues/1", "actor": "https://github.com/octocat",
+   "event": "assigned", "assignee": "https://github.com/hubot", "created_at": "2020-01-04T11:00:00.000Z"}
+]`
+
+const ghArchiveEvents = `{"id": "100", "type": "IssuesEvent", "actor": {"login": "hubot"}, "created_at": "2021-05-01T10:00:00Z", "payload": {"action": "opened", "issue": {"id": 9, "node_id": "I_node9", "html_url": "https://github.com/octo/repo/issues/2", "title": "slow", "body": "very slow", "user": {"login": "hubot"}, "created_at": "2021-05-01T10:00:00Z"}}}
+{"id": "101", "type": "IssueCommentEvent", "actor": {"login": "octocat"}, "created_at": "2021-05-01T11:00:00Z", "payload": {"action": "created", "issue": {"id": 9, "node_id": "I_node9", "html_url": "https://github.com/octo/repo/issues/2", "title": "slow", "body": "very slow", "user": {"login": "hubot"}, "created_at": "2021-05-01T10:00:00Z"}, "comment": {"id": 50, "node_id": "IC_node50", "html_url": "https://github.com/octo/repo/issues/2#issuecomment-50", "body": "confirmed", "user": {"login": "octocat"}, "created_at": "2021-05-01T11:00:00Z"}}}
+{"id": "102", "type": "IssueCommentEvent", "actor": {"login": "octocat"}, "created_at": "2021-05-01T11:30:00Z", "payload": {"action": "created", "issue": {"id": 10, "node_id": "PR_node10", "html_url": "https://github.com/octo/repo/pull/3", "title": "fix", "user": {"login": "octocat"}, "pull_request": {"url": "https://api.github.com/repos/octo/repo/pulls/3"}, "created_at": "2021-05-01T11:00:00Z"}, "comment": {"id": 51, "node_id": "IC_node51", "html_url": "https://github.com/octo/repo/pull/3#issuecomment-51", "body": "lgtm", "user": {"login": "octocat"}, "created_at": "2021-05-01T11:30:00Z"}}}
+{"id": "103", "type": "IssuesEvent", "actor": {"login": "octocat"}, "created_at": "2021-05-01T12:00:00Z", "payload": {"action": "labeled", "label": {"name": "perf", "color": "0e8a16"}, "issue": {"id": 9, "node_id": "I_node9", "html_url": "https://github.com/octo/repo/issues/2", "title": "slow", "body": "very slow", "user": {"login": "hubot"}, "created_at": "2021-05-01T10:00:00Z"}}}
+{"id": "104", "type": "WatchEvent", "actor": {"login": "octocat"}, "created_at": "2021-05-01T12:30:00Z", "payload": {"action": "started"}}
+{"id": "105", "type": "IssuesEvent", "actor": {"login": "hubot"}, "created_at": "2021-05-01T13:00:00Z", "payload": {"action": "edited", "changes": {"title": {"from": "slow"}}, "issue": {"id": 9, "node_id": "I_node9", "html_url": "https://github.com/octo/repo/issues/2", "title": "slow rendering", "body": "very slow", "user": {"login": "hubot"}, "created_at": "2021-05-01T10:00:00Z"}}}
+`
+
+func TestImportArchive(t *testing.T) {
+	dir := t.TempDir()
+
+	export := filepath.Join(dir, "export")
+	require.NoError(t, os.Mkdir(export, 0755))
+	for name, content := range map[string]string{
+		"users_000001.json":          archiveUsers,
+		"labels_000001.json":         archiveLabels,
+		"issues_000001.json":         archiveIssues,
+		"issue_comments_000001.json": archiveComments,
+		"issue_events_000001.json":   archiveIssueEvents,
+		"repositories_000001.json":   `[{"type": "repository"}]`,
+		"schema.json":                `{"version": "1.0.1"}`,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(export, name), []byte(content), 0644))
+	}
+
+	events := filepath.Join(dir, "2021-05-01-10.json.gz")
+	f, err := os.Create(events)
+	require.NoError(t, err)
+	gz := gzip.NewWriter(f)
+	_, err = gz.Write([]byte(ghArchiveEvents))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	require.NoError(t, f.Close())
+
+	repo := repository.CreateGoGitTestRepo(t, false)
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	importArchive := func() map[core.ImportEvent]int {
+		results, err := ImportArchive(context.Background(), backend, []string{export, events}, "octo/repo")
+		require.NoError(t, err)
+		count := make(map[core.ImportEvent]int)
+		for result := range results {
+			require.NoError(t, result.Err)
+			count[result.Event]++
+		}
+		return count
+	}
+
+	count := importArchive()
+	require.Equal(t, 2, count[core.ImportEventBug])
+	require.Equal(t, 2, count[core.ImportEventIdentity])
+	require.Len(t, backend.AllBugsIds(), 2)
+
+	// data export
+	b1, err := backend.ResolveBugCreateMetadata(metaKeyGithubUrl, "https://github.com/octo/repo/issues/1")
+	require.NoError(t, err)
+	snap1 := b1.Snapshot()
+	require.Equal(t, "crash on start", snap1.Title)
+	require.Equal(t, common.ClosedStatus, snap1.Status)
+	require.Equal(t, []bug.Label{"bug"}, snap1.Labels)
+	require.Equal(t, "Mona Octocat", snap1.Author.Name())
+	require.Equal(t, "octocat@example.com", snap1.Author.Email())
+
+	ops1 := snap1.Operations
+	require.Len(t, ops1, 6)
+	require.Equal(t, "it crashes", ops1[0].(*bug.CreateOperation).Message)
+	require.Equal(t, "same here", ops1[1].(*bug.AddCommentOperation).Message)
+	require.Equal(t, "hubot", ops1[1].Author().Login())
+	require.Equal(t, []bug.Label{"bug"}, ops1[2].(*bug.LabelChangeOperation).Added)
+	require.Equal(t, "crash on start", ops1[3].(*bug.SetTitleOperation).Title)
+	require.IsType(t, &bug.SetStatusOperation{}, ops1[4])
+	require.Equal(t, []entity.Id{ops1[1].Author().Id()}, ops1[5].(*bug.SetAssigneeOperation).Added)
+
+	// the ids are the ones of the API
+	id, ok := ops1[1].GetMetadata(metaKeyGithubId)
+	require.True(t, ok)
+	require.Equal(t, "MDEyOklzc3VlQ29tbWVudDQy", id) // 012:IssueComment42
+
+	labelInfo, err := backend.LabelInfo("bug")
+	require.NoError(t, err)
+	require.Equal(t, cache.LabelInfo{Color: "#d73a4a", Description: "Something isn't working"}, labelInfo)
+
+	// GH Archive
+	b2, err := backend.ResolveBugCreateMetadata(metaKeyGithubId, "I_node9")
+	require.NoError(t, err)
+	snap2 := b2.Snapshot()
+	require.Equal(t, "slow rendering", snap2.Title)
+	require.Equal(t, common.OpenStatus, snap2.Status)
+	require.Equal(t, []bug.Label{"perf"}, snap2.Labels)
+	require.Len(t, snap2.Comments, 2)
+	require.Equal(t, "confirmed", snap2.Comments[1].Message)
+
+	// importing again doesn't create anything
+	count = importArchive()
+	require.Zero(t, count[core.ImportEventBug])
+	require.Zero(t, count[core.ImportEventComment])
+	require.Equal(t, 2, count[core.ImportEventNothing])
+}
@@ -32,6 +32,21 @@ type TimelineEvent struct {
 
 func (TimelineEvent) isImportEvent() {}
 
+type DiscussionEvent struct {
+	discussion
+}
+
+func (DiscussionEvent) isImportEvent() {}
+
+// DiscussionCommentEvent is a comment of a discussion, or a reply to such a
+// comment. Replies immediately follow the comment they answer.
+type DiscussionCommentEvent struct {
+	discussionId githubv4.ID
+	discussionComment
+}
+
+func (DiscussionCommentEvent) isImportEvent() {}
+
 type CommentEditEvent struct {
 	commentId githubv4.ID
 	userContentEdit
@@ -11,9 +11,13 @@ import (
 	m "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/MichaelMure/git-bug/bridge/core"
 	"github.com/MichaelMure/git-bug/bridge/github/mocks"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
 	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
@@ -64,6 +68,9 @@ func TestGithubImporterIntegration(t *testing.T) {
 	require.Equal(t, "issue 3 comment 1", ops3[1].(*bug.AddCommentOperation).Message)
 	require.Equal(t, "issue 3 comment 2", ops3[2].(*bug.AddCommentOperation).Message)
 	require.Equal(t, []bug.Label{"bug"}, ops3[3].(*bug.LabelChangeOperation).Added)
+	labelInfo, err := backend.LabelInfo("bug")
+	require.NoError(t, err)
+	require.Equal(t, cache.LabelInfo{Color: "#d73a4a", Description: "Something isn't working"}, labelInfo)
 	require.Equal(t, "title 3, edit 1", ops3[4].(*bug.SetTitleOperation).Title)
 
 	b4, err := backend.ResolveBugCreateMetadata(metaKeyGithubUrl, "https://github.com/marcus/to-himself/issues/4")
@@ -250,7 +257,9 @@ func expectIssueQuery2(mock *mocks.Client) {
 										},
 									},
 									Label: label{
-										Name: "bug",
+										Name:        "bug",
+										Color:       "d73a4a",
+										Description: "Something isn't working",
 									},
 								},
 							},
@@ -382,3 +391,259 @@ func expectUserQuery(t *testing.T, mock *mocks.Client) {
 		},
 	).Once()
 }
+
+func TestGithubImporterLazyClosed(t *testing.T) {
+	// mock
+	clientMock := &mocks.Client{}
+	clientMock.On("Query", m.Anything, m.AnythingOfType("*github.issueQuery"), m.Anything).Return(nil).Run(
+		func(args m.Arguments) {
+			// only the open issues are fetched on the initial import
+			vars := args.Get(2).(map[string]interface{})
+			require.Equal(t, []githubv4.IssueState{githubv4.IssueStateOpen}, vars["issueStates"])
+		},
+	).Once()
+	clientMock.On("Query", m.Anything, m.AnythingOfType("*github.singleIssueQuery"), m.Anything).Return(nil).Run(
+		func(args m.Arguments) {
+			vars := args.Get(2).(map[string]interface{})
+			require.Equal(t, githubv4.Int(7), vars["issueNumber"])
+
+			retVal := args.Get(1).(*singleIssueQuery)
+			retVal.Repository.Issue = issueNode{
+				issue: issue{
+					authorEvent: authorEvent{
+						Id: 7,
+						Author: &actor{
+							Typename: "User",
+							User: userActor{
+								Name:  &userName,
+								Email: userEmail,
+							},
+						},
+					},
+					Title:  "title 7",
+					Number: 7,
+					Body:   "body text 7",
+					Url: githubv4.URI{
+						URL: &url.URL{
+							Scheme: "https",
+							Host:   "github.com",
+							Path:   "marcus/to-himself/issues/7",
+						},
+					},
+				},
+			}
+		},
+	).Once()
+
+	importer := githubImporter{
+		conf: map[string]string{
+			confKeyOwner:             "marcus",
+			confKeyProject:           "to-himself",
+			core.ConfigKeyLazyClosed: "true",
+		},
+	}
+	importer.client = &rateLimitHandlerClient{sc: clientMock}
+
+	// arrange
+	repo := repository.CreateGoGitTestRepo(t, false)
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	// act
+	events, err := importer.ImportAll(context.Background(), backend, time.Time{})
+	require.NoError(t, err)
+	for e := range events {
+		require.NoError(t, e.Err)
+	}
+	require.Len(t, backend.AllBugsIds(), 0)
+
+	_, err = importer.ResolveIssue(backend, "7")
+	require.ErrorIs(t, err, bug.ErrBugNotExist)
+
+	events, err = importer.ImportIssue(context.Background(), backend, "7")
+	require.NoError(t, err)
+	for e := range events {
+		require.NoError(t, e.Err)
+	}
+
+	// assert
+	b, err := importer.ResolveIssue(backend, "#7")
+	require.NoError(t, err)
+	require.Equal(t, "title 7", b.Snapshot().Title)
+
+	_, err = importer.ResolveIssue(backend, "foo")
+	require.Error(t, err)
+
+	clientMock.AssertExpectations(t)
+}
+
+func TestGithubImporterTitleConflict(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, _, err := backend.NewBugRaw(rene, time.Now().Unix(), "title", "message", nil, map[string]string{
+		metaKeyGithubId: "1",
+	})
+	require.NoError(t, err)
+	// local edition, not exported yet
+	_, err = b.SetTitleRaw(rene, time.Now().Unix(), "local title", nil)
+	require.NoError(t, err)
+
+	out := make(chan core.ImportResult, 10)
+	importer := githubImporter{out: out}
+
+	item := &timelineItem{
+		Typename: "RenamedTitleEvent",
+		RenamedTitleEvent: renamedTitleEvent{
+			actorEvent: actorEvent{
+				Id:        2,
+				CreatedAt: githubv4.DateTime{Time: time.Now()},
+				Actor: &actor{
+					Typename: "User",
+					Login:    "marcus",
+					User: userActor{
+						Name:  &userName,
+						Email: userEmail,
+					},
+				},
+			},
+			CurrentTitle:  "remote title",
+			PreviousTitle: "title",
+		},
+	}
+
+	require.NoError(t, importer.ensureTimelineItem(context.Background(), backend, b, item))
+	close(out)
+
+	var events []core.ImportEvent
+	for e := range out {
+		events = append(events, e.Event)
+	}
+	require.Equal(t, []core.ImportEvent{core.ImportEventIdentity, core.ImportEventTitleEdition, core.ImportEventSyncConflict}, events)
+
+	snap := b.Snapshot()
+	require.Equal(t, "remote title", snap.Title)
+	require.Len(t, snap.SyncConflicts, 1)
+	require.Equal(t, "local title", snap.SyncConflicts[0].Local)
+	require.Equal(t, "remote title", snap.SyncConflicts[0].Remote)
+	require.Equal(t, bug.SyncSideRemote, snap.SyncConflicts[0].Winner)
+
+	q, err := query.Parse("has:sync-conflict")
+	require.NoError(t, err)
+	matching, err := backend.QueryBugs(q)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{b.Id()}, matching)
+}
+
+func TestGithubImporterDiscussions(t *testing.T) {
+	clientMock := &mocks.Client{}
+	clientMock.On("Query", m.Anything, m.AnythingOfType("*github.issueQuery"), m.Anything).Return(nil).Once()
+	expectDiscussionQuery(clientMock)
+
+	importer := githubImporter{conf: core.Configuration{confKeyDiscussions: "true"}}
+	importer.client = &rateLimitHandlerClient{sc: clientMock}
+
+	repo := repository.CreateGoGitTestRepo(t, false)
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	events, err := importer.ImportAll(context.Background(), backend, time.Time{})
+	require.NoError(t, err)
+	for e := range events {
+		require.NoError(t, e.Err)
+	}
+	require.Len(t, backend.AllBugsIds(), 1)
+
+	b, err := backend.ResolveBugCreateMetadata(metaKeyGithubUrl, "https://github.com/marcus/to-himself/discussions/7")
+	require.NoError(t, err)
+	snap := b.Snapshot()
+	require.Equal(t, "how to do this?", snap.Title)
+	require.Equal(t, []bug.Label{discussionLabel}, snap.Labels)
+	require.Equal(t, common.ClosedStatus, snap.Status)
+
+	require.Len(t, snap.Comments, 4)
+	require.Equal(t, "I'd like to know", snap.Comments[0].Message)
+	require.Equal(t, "like that", snap.Comments[1].Message)
+	require.Equal(t, "thanks!", snap.Comments[2].Message)
+	require.Equal(t, "or like that", snap.Comments[3].Message)
+
+	var answers []string
+	for _, op := range snap.Operations {
+		if _, ok := op.GetMetadata(metaKeyGithubAnswer); ok {
+			answers = append(answers, op.(*bug.AddCommentOperation).Message)
+		}
+	}
+	require.Equal(t, []string{"like that"}, answers)
+}
+
+func expectDiscussionQuery(mock *mocks.Client) {
+	author := &actor{
+		Typename: "User",
+		Login:    "marcus",
+		User: userActor{
+			Name:  &userName,
+			Email: userEmail,
+		},
+	}
+	discussionUrl := func(path string) githubv4.URI {
+		return githubv4.URI{URL: &url.URL{Scheme: "https", Host: "github.com", Path: path}}
+	}
+	createdAt := githubv4.DateTime{Time: time.Unix(1600000000, 0)}
+
+	mock.On("Query", m.Anything, m.AnythingOfType("*github.discussionQuery"), m.Anything).Return(nil).Run(
+		func(args m.Arguments) {
+			retVal := args.Get(1).(*discussionQuery)
+			retVal.Repository.Discussions.Nodes = []discussionNode{
+				{
+					discussion: discussion{
+						authorEvent: authorEvent{Id: 70, CreatedAt: createdAt, Author: author},
+						Title:       "how to do this?",
+						Number:      7,
+						Body:        "I'd like to know",
+						Url:         discussionUrl("/marcus/to-himself/discussions/7"),
+						UpdatedAt:   createdAt,
+						Closed:      true,
+					},
+					Comments: discussionCommentConnection{
+						Nodes: []discussionCommentNode{
+							{
+								discussionComment: discussionComment{
+									authorEvent: authorEvent{Id: 71, CreatedAt: createdAt, Author: author},
+									Body:        "like that",
+									Url:         discussionUrl("/marcus/to-himself/discussions/7#discussioncomment-71"),
+									IsAnswer:    true,
+								},
+								Replies: discussionReplyConnection{
+									Nodes: []discussionComment{
+										{
+											authorEvent: authorEvent{Id: 72, CreatedAt: createdAt, Author: author},
+											Body:        "thanks!",
+											Url:         discussionUrl("/marcus/to-himself/discussions/7#discussioncomment-72"),
+										},
+									},
+								},
+							},
+							{
+								discussionComment: discussionComment{
+									authorEvent: authorEvent{Id: 73, CreatedAt: createdAt, Author: author},
+									Body:        "or like that",
+									Url:         discussionUrl("/marcus/to-himself/discussions/7#discussioncomment-73"),
+								},
+							},
+						},
+					},
+				},
+			}
+		},
+	).Once()
+}
@@ -15,6 +15,10 @@ const (
 	NumTimelineItems = 100
 	NumCommentEdits  = 100
 
+	NumDiscussions        = 20
+	NumDiscussionComments = 50
+	NumDiscussionReplies  = 50
+
 	ChanCapacity = 128
 )
 
@@ -33,6 +37,16 @@ type importMediator struct {
 	// given date should be imported.
 	since time.Time
 
+	// states restricts the imported issues to the given states
+	states []githubv4.IssueState
+
+	// number, if not zero, is the number of the single issue to import
+	number int
+
+	// discussions, if true, also retrieve the discussions updated since the
+	// given date, after the issues
+	discussions bool
+
 	// importEvents holds events representing issues, comments, edits, ...
 	// In this channel issues are immediately followed by their issue edits and comments are
 	// immediately followed by their comment edits.
@@ -42,12 +56,37 @@ type importMediator struct {
 	err error
 }
 
-func NewImportMediator(ctx context.Context, client *rateLimitHandlerClient, owner, project string, since time.Time) *importMediator {
+// NewImportMediator start retrieving the issues updated since the given date.
+// If states is empty, issues are imported whatever their state. If discussions
+// is true, the discussions updated since the given date are retrieved as well.
+func NewImportMediator(ctx context.Context, client *rateLimitHandlerClient, owner, project string, since time.Time, states []githubv4.IssueState, discussions bool) *importMediator {
+	if len(states) == 0 {
+		states = []githubv4.IssueState{githubv4.IssueStateOpen, githubv4.IssueStateClosed}
+	}
+
 	mm := importMediator{
 		gh:           client,
 		owner:        owner,
 		project:      project,
 		since:        since,
+		states:       states,
+		discussions:  discussions,
+		importEvents: make(chan ImportEvent, ChanCapacity),
+		err:          nil,
+	}
+
+	go mm.start(ctx)
+
+	return &mm
+}
+
+// NewIssueImportMediator start retrieving the single issue with the given number.
+func NewIssueImportMediator(ctx context.Context, client *rateLimitHandlerClient, owner, project string, number int) *importMediator {
+	mm := importMediator{
+		gh:           client,
+		owner:        owner,
+		project:      project,
+		number:       number,
 		importEvents: make(chan ImportEvent, ChanCapacity),
 		err:          nil,
 	}
@@ -59,7 +98,11 @@ func NewImportMediator(ctx context.Context, client *rateLimitHandlerClient, owne
 
 func (mm *importMediator) start(ctx context.Context) {
 	ctx, cancel := context.WithCancel(ctx)
-	mm.fillImportEvents(ctx)
+	if mm.number != 0 {
+		mm.fillSingleIssueEvents(ctx)
+	} else {
+		mm.fillImportEvents(ctx)
+	}
 	// Make sure we cancel everything when we are done, instead of relying on the parent context
 	// This should unblock pending send to the channel if the capacity was reached and avoid a panic/race when closing.
 	cancel()
@@ -89,22 +132,166 @@ func (mm *importMediator) fillImportEvents(ctx context.Context) {
 	issues, hasIssues := mm.queryIssue(ctx, initialCursor)
 	for hasIssues {
 		for _, node := range issues.Nodes {
+			if !mm.fillIssueEvents(ctx, &node) {
+				return
+			}
+		}
+		if !issues.PageInfo.HasNextPage {
+			break
+		}
+		issues, hasIssues = mm.queryIssue(ctx, issues.PageInfo.EndCursor)
+	}
+
+	if mm.discussions && mm.err == nil {
+		mm.fillDiscussionEvents(ctx)
+	}
+}
+
+// fillDiscussionEvents send the discussions updated since the given date,
+// each one followed by its comments.
+func (mm *importMediator) fillDiscussionEvents(ctx context.Context) {
+	discussions, hasDiscussions := mm.queryDiscussion(ctx, "")
+	for hasDiscussions {
+		for _, node := range discussions.Nodes {
+			// discussions can't be filtered by date, but are ordered by
+			// decreasing update time: the first older one ends the import
+			if node.UpdatedAt.Before(mm.since) {
+				return
+			}
 			select {
 			case <-ctx.Done():
 				return
-			case mm.importEvents <- IssueEvent{node.issue}:
+			case mm.importEvents <- DiscussionEvent{node.discussion}:
+			}
+			if !mm.fillDiscussionCommentEvents(ctx, &node) {
+				return
+			}
+		}
+		if !discussions.PageInfo.HasNextPage {
+			break
+		}
+		discussions, hasDiscussions = mm.queryDiscussion(ctx, discussions.PageInfo.EndCursor)
+	}
+}
+
+// fillDiscussionCommentEvents send the comments of a discussion, each one
+// followed by its replies. It returns false if the context has been canceled.
+func (mm *importMediator) fillDiscussionCommentEvents(ctx context.Context, node *discussionNode) bool {
+	comments := &node.Comments
+	hasComments := true
+	for hasComments {
+		for _, comment := range comments.Nodes {
+			select {
+			case <-ctx.Done():
+				return false
+			case mm.importEvents <- DiscussionCommentEvent{discussionId: node.Id, discussionComment: comment.discussionComment}:
+			}
+			if !mm.fillDiscussionReplyEvents(ctx, node.Id, &comment) {
+				return false
 			}
+		}
+		if !comments.PageInfo.HasNextPage {
+			break
+		}
+		comments, hasComments = mm.queryDiscussionComments(ctx, node.Id, comments.PageInfo.EndCursor)
+	}
+	return true
+}
 
-			// issue edit events follow the issue event
-			mm.fillIssueEditEvents(ctx, &node)
-			// last come the timeline events
-			mm.fillTimelineEvents(ctx, &node)
+func (mm *importMediator) fillDiscussionReplyEvents(ctx context.Context, discussionId githubv4.ID, comment *discussionCommentNode) bool {
+	replies := &comment.Replies
+	hasReplies := true
+	for hasReplies {
+		for _, reply := range replies.Nodes {
+			select {
+			case <-ctx.Done():
+				return false
+			case mm.importEvents <- DiscussionCommentEvent{discussionId: discussionId, discussionComment: reply}:
+			}
 		}
-		if !issues.PageInfo.HasNextPage {
+		if !replies.PageInfo.HasNextPage {
 			break
 		}
-		issues, hasIssues = mm.queryIssue(ctx, issues.PageInfo.EndCursor)
+		replies, hasReplies = mm.queryDiscussionReplies(ctx, comment.Id, replies.PageInfo.EndCursor)
+	}
+	return true
+}
+
+func (mm *importMediator) queryDiscussion(ctx context.Context, cursor githubv4.String) (*discussionConnection, bool) {
+	vars := newDiscussionVars(mm.owner, mm.project)
+	if cursor != "" {
+		vars["discussionAfter"] = cursor
 	}
+	query := discussionQuery{}
+	if err := mm.gh.queryImport(ctx, &query, vars, mm.importEvents); err != nil {
+		mm.err = err
+		return nil, false
+	}
+	connection := &query.Repository.Discussions
+	if len(connection.Nodes) <= 0 {
+		return nil, false
+	}
+	return connection, true
+}
+
+func (mm *importMediator) queryDiscussionComments(ctx context.Context, nid githubv4.ID, cursor githubv4.String) (*discussionCommentConnection, bool) {
+	vars := newDiscussionCommentVars()
+	vars["gqlNodeId"] = nid
+	vars["discussionCommentAfter"] = cursor
+	query := discussionCommentQuery{}
+	if err := mm.gh.queryImport(ctx, &query, vars, mm.importEvents); err != nil {
+		mm.err = err
+		return nil, false
+	}
+	connection := &query.Node.Discussion.Comments
+	if len(connection.Nodes) <= 0 {
+		return nil, false
+	}
+	return connection, true
+}
+
+func (mm *importMediator) queryDiscussionReplies(ctx context.Context, nid githubv4.ID, cursor githubv4.String) (*discussionReplyConnection, bool) {
+	vars := varmap{
+		"gqlNodeId":            nid,
+		"discussionReplyFirst": githubv4.Int(NumDiscussionReplies),
+		"discussionReplyAfter": cursor,
+	}
+	query := discussionReplyQuery{}
+	if err := mm.gh.queryImport(ctx, &query, vars, mm.importEvents); err != nil {
+		mm.err = err
+		return nil, false
+	}
+	connection := &query.Node.DiscussionComment.Replies
+	if len(connection.Nodes) <= 0 {
+		return nil, false
+	}
+	return connection, true
+}
+
+func (mm *importMediator) fillSingleIssueEvents(ctx context.Context) {
+	query := singleIssueQuery{}
+	vars := newSingleIssueVars(mm.owner, mm.project, mm.number)
+	if err := mm.gh.queryImport(ctx, &query, vars, mm.importEvents); err != nil {
+		mm.err = err
+		return
+	}
+	mm.fillIssueEvents(ctx, &query.Repository.Issue)
+}
+
+// fillIssueEvents send the events of a single issue. It returns false if the
+// context has been canceled.
+func (mm *importMediator) fillIssueEvents(ctx context.Context, node *issueNode) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case mm.importEvents <- IssueEvent{node.issue}:
+	}
+
+	// issue edit events follow the issue event
+	mm.fillIssueEditEvents(ctx, node)
+	// last come the timeline events
+	mm.fillTimelineEvents(ctx, node)
+	return true
 }
 
 func (mm *importMediator) fillIssueEditEvents(ctx context.Context, issueNode *issueNode) {
@@ -246,7 +433,7 @@ func (mm *importMediator) queryCommentEdits(ctx context.Context, nid githubv4.ID
 }
 
 func (mm *importMediator) queryIssue(ctx context.Context, cursor githubv4.String) (*issueConnection, bool) {
-	vars := newIssueVars(mm.owner, mm.project, mm.since)
+	vars := newIssueVars(mm.owner, mm.project, mm.since, mm.states)
 	if cursor == "" {
 		vars["issueAfter"] = (*githubv4.String)(nil)
 	} else {
@@ -278,11 +465,12 @@ func reverse(eds []userContentEdit) chan userContentEdit {
 // varmap is a container for Github API's pagination variables
 type varmap map[string]interface{}
 
-func newIssueVars(owner, project string, since time.Time) varmap {
+func newIssueVars(owner, project string, since time.Time, states []githubv4.IssueState) varmap {
 	return varmap{
 		"owner":             githubv4.String(owner),
 		"name":              githubv4.String(project),
 		"issueSince":        githubv4.DateTime{Time: since},
+		"issueStates":       states,
 		"issueFirst":        githubv4.Int(NumIssues),
 		"issueEditLast":     githubv4.Int(NumIssueEdits),
 		"issueEditBefore":   (*githubv4.String)(nil),
@@ -293,6 +481,20 @@ func newIssueVars(owner, project string, since time.Time) varmap {
 	}
 }
 
+func newSingleIssueVars(owner, project string, number int) varmap {
+	return varmap{
+		"owner":             githubv4.String(owner),
+		"name":              githubv4.String(project),
+		"issueNumber":       githubv4.Int(number),
+		"issueEditLast":     githubv4.Int(NumIssueEdits),
+		"issueEditBefore":   (*githubv4.String)(nil),
+		"timelineFirst":     githubv4.Int(NumTimelineItems),
+		"timelineAfter":     (*githubv4.String)(nil),
+		"commentEditLast":   githubv4.Int(NumCommentEdits),
+		"commentEditBefore": (*githubv4.String)(nil),
+	}
+}
+
 func newIssueEditVars() varmap {
 	return varmap{
 		"issueEditLast": githubv4.Int(NumIssueEdits),
@@ -312,3 +514,24 @@ func newCommentEditVars() varmap {
 		"commentEditLast": githubv4.Int(NumCommentEdits),
 	}
 }
+
+func newDiscussionVars(owner, project string) varmap {
+	return varmap{
+		"owner":                  githubv4.String(owner),
+		"name":                   githubv4.String(project),
+		"discussionFirst":        githubv4.Int(NumDiscussions),
+		"discussionAfter":        (*githubv4.String)(nil),
+		"discussionCommentFirst": githubv4.Int(NumDiscussionComments),
+		"discussionCommentAfter": (*githubv4.String)(nil),
+		"discussionReplyFirst":   githubv4.Int(NumDiscussionReplies),
+		"discussionReplyAfter":   (*githubv4.String)(nil),
+	}
+}
+
+func newDiscussionCommentVars() varmap {
+	return varmap{
+		"discussionCommentFirst": githubv4.Int(NumDiscussionComments),
+		"discussionReplyFirst":   githubv4.Int(NumDiscussionReplies),
+		"discussionReplyAfter":   (*githubv4.String)(nil),
+	}
+}
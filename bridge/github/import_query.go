@@ -14,6 +14,12 @@ type userQuery struct {
 	User user `graphql:"user(login: $login)"`
 }
 
+type userIdQuery struct {
+	User struct {
+		ID string `graphql:"id"`
+	} `graphql:"user(login: $login)"`
+}
+
 type labelsQuery struct {
 	Repository struct {
 		Labels struct {
@@ -36,7 +42,13 @@ type loginQuery struct {
 
 type issueQuery struct {
 	Repository struct {
-		Issues issueConnection `graphql:"issues(first: $issueFirst, after: $issueAfter, orderBy: {field: CREATED_AT, direction: ASC}, filterBy: {since: $issueSince})"`
+		Issues issueConnection `graphql:"issues(first: $issueFirst, after: $issueAfter, orderBy: {field: CREATED_AT, direction: ASC}, filterBy: {since: $issueSince, states: $issueStates})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type singleIssueQuery struct {
+	Repository struct {
+		Issue issueNode `graphql:"issue(number: $issueNumber)"`
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
@@ -67,6 +79,30 @@ type commentEditQuery struct {
 	} `graphql:"node(id: $gqlNodeId)"`
 }
 
+type discussionQuery struct {
+	Repository struct {
+		Discussions discussionConnection `graphql:"discussions(first: $discussionFirst, after: $discussionAfter, orderBy: {field: UPDATED_AT, direction: DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type discussionCommentQuery struct {
+	Node struct {
+		Typename   githubv4.String `graphql:"__typename"`
+		Discussion struct {
+			Comments discussionCommentConnection `graphql:"comments(first: $discussionCommentFirst, after: $discussionCommentAfter)"`
+		} `graphql:"... on Discussion"`
+	} `graphql:"node(id: $gqlNodeId)"`
+}
+
+type discussionReplyQuery struct {
+	Node struct {
+		Typename          githubv4.String `graphql:"__typename"`
+		DiscussionComment struct {
+			Replies discussionReplyConnection `graphql:"replies(first: $discussionReplyFirst, after: $discussionReplyAfter)"`
+		} `graphql:"... on DiscussionComment"`
+	} `graphql:"node(id: $gqlNodeId)"`
+}
+
 type user struct {
 	Login     githubv4.String
 	AvatarUrl githubv4.String
@@ -114,7 +150,9 @@ type userContentEdit struct {
 }
 
 type label struct {
-	Name githubv4.String
+	Name        githubv4.String
+	Color       githubv4.String
+	Description githubv4.String
 }
 
 type labeledEvent struct {
@@ -127,6 +165,22 @@ type unlabeledEvent struct {
 	Label label
 }
 
+type assignedEvent struct {
+	actorEvent
+	Assignee *assignee
+}
+
+type unassignedEvent struct {
+	actorEvent
+	Assignee *assignee
+}
+
+// assignee is the user, bot or organization an issue is assigned to. It's null
+// when the user has been deleted.
+type assignee struct {
+	Actor actor `graphql:"... on Actor"`
+}
+
 type renamedTitleEvent struct {
 	actorEvent
 	CurrentTitle  githubv4.String
@@ -143,6 +197,10 @@ type timelineItem struct {
 	LabeledEvent   labeledEvent   `graphql:"... on LabeledEvent"`
 	UnlabeledEvent unlabeledEvent `graphql:"... on UnlabeledEvent"`
 
+	// Assignee
+	AssignedEvent   assignedEvent   `graphql:"... on AssignedEvent"`
+	UnassignedEvent unassignedEvent `graphql:"... on UnassignedEvent"`
+
 	// Status
 	ClosedEvent struct {
 		actorEvent
@@ -164,6 +222,49 @@ type issueComment struct {
 	UserContentEdits userContentEditConnection `graphql:"userContentEdits(last: $commentEditLast, before: $commentEditBefore)"`
 }
 
+type discussionConnection struct {
+	Nodes    []discussionNode
+	PageInfo pageInfo
+}
+
+type discussionNode struct {
+	discussion
+	Comments discussionCommentConnection `graphql:"comments(first: $discussionCommentFirst, after: $discussionCommentAfter)"`
+}
+
+type discussion struct {
+	authorEvent
+	Title     githubv4.String
+	Number    githubv4.Int
+	Body      githubv4.String
+	Url       githubv4.URI
+	UpdatedAt githubv4.DateTime
+	Closed    githubv4.Boolean
+	ClosedAt  *githubv4.DateTime
+}
+
+type discussionCommentConnection struct {
+	Nodes    []discussionCommentNode
+	PageInfo pageInfo
+}
+
+type discussionCommentNode struct {
+	discussionComment
+	Replies discussionReplyConnection `graphql:"replies(first: $discussionReplyFirst, after: $discussionReplyAfter)"`
+}
+
+type discussionReplyConnection struct {
+	Nodes    []discussionComment
+	PageInfo pageInfo
+}
+
+type discussionComment struct {
+	authorEvent // NOTE: contains Id
+	Body        githubv4.String
+	Url         githubv4.URI
+	IsAnswer    githubv4.Boolean
+}
+
 type userActor struct {
 	Name  *githubv4.String
 	Email githubv4.String
@@ -2,6 +2,7 @@ package gitlab
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -109,6 +110,33 @@ func (n NoteEvent) Title() string {
 	return text.CleanupOneLine(n.Body)
 }
 
+// Assignees return the usernames of the assigned and unassigned users of an
+// assignment note, like "assigned to @alice, @bob and @carol",
+// "assigned to @bob and unassigned @alice" or "unassigned @alice and @bob".
+func (n NoteEvent) Assignees() (assigned []string, unassigned []string) {
+	body := n.Body
+	if strings.HasPrefix(body, "assigned to ") {
+		parts := strings.SplitN(body, " and unassigned ", 2)
+		assigned = mentionedUsernames(parts[0])
+		if len(parts) == 2 {
+			unassigned = mentionedUsernames(parts[1])
+		}
+		return assigned, unassigned
+	}
+	return nil, mentionedUsernames(body)
+}
+
+var mentionRegexp = regexp.MustCompile(`@([\w.-]+)`)
+
+// mentionedUsernames return the usernames mentioned with a @ in a text
+func mentionedUsernames(text string) []string {
+	var usernames []string
+	for _, match := range mentionRegexp.FindAllStringSubmatch(text, -1) {
+		usernames = append(usernames, match[1])
+	}
+	return usernames
+}
+
 var _ Event = &LabelEvent{}
 
 type LabelEvent struct{ gitlab.LabelEvent }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
 )
 
 func TestGetNewTitle(t *testing.T) {
@@ -93,3 +94,24 @@ func TestSortedEvents(t *testing.T) {
 		previous = event
 	}
 }
+
+func TestNoteAssignees(t *testing.T) {
+	tests := []struct {
+		body       string
+		assigned   []string
+		unassigned []string
+	}{
+		{"assigned to @alice", []string{"alice"}, nil},
+		{"assigned to @alice, @bob.b and @carol-c", []string{"alice", "bob.b", "carol-c"}, nil},
+		{"assigned to @bob and unassigned @alice", []string{"bob"}, []string{"alice"}},
+		{"unassigned @alice and @bob", nil, []string{"alice", "bob"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.body, func(t *testing.T) {
+			assigned, unassigned := NoteEvent{gitlab.Note{Body: tt.body}}.Assignees()
+			assert.Equal(t, tt.assigned, assigned)
+			assert.Equal(t, tt.unassigned, unassigned)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"time"
 
@@ -30,6 +31,8 @@ type gitlabExporter struct {
 
 	// cache identities clients
 	identityClient map[entity.Id]*gitlab.Client
+	// the gitlab login of the identities having a client
+	identityLogin map[entity.Id]string
 
 	// gitlab repository ID
 	repositoryID string
@@ -43,6 +46,7 @@ type gitlabExporter struct {
 func (ge *gitlabExporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration) error {
 	ge.conf = conf
 	ge.identityClient = make(map[entity.Id]*gitlab.Client)
+	ge.identityLogin = make(map[entity.Id]string)
 	ge.cachedOperationIDs = make(map[string]string)
 
 	// get repository node id
@@ -88,6 +92,7 @@ func (ge *gitlabExporter) cacheAllClient(repo *cache.RepoCache, baseURL string)
 				return err
 			}
 			ge.identityClient[user.Id()] = client
+			ge.identityLogin[user.Id()] = login
 		}
 	}
 
@@ -140,7 +145,7 @@ func (ge *gitlabExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 
 				if snapshot.HasAnyActor(allIdentitiesIds...) {
 					// try to export the bug and it associated events
-					ge.exportBug(ctx, b, out)
+					ge.exportBug(ctx, repo, b, out)
 				}
 			}
 		}
@@ -150,7 +155,7 @@ func (ge *gitlabExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 }
 
 // exportBug publish bugs and related events
-func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out chan<- core.ExportResult) {
+func (ge *gitlabExporter) exportBug(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, out chan<- core.ExportResult) {
 	snapshot := b.Snapshot()
 
 	var bugUpdated bool
@@ -175,6 +180,12 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 	createOp := snapshot.Operations[0].(*bug.CreateOperation)
 	author := snapshot.Author
 
+	// skip confidential bug
+	if !core.ExportAllowed(ge.conf, createOp) {
+		out <- core.NewExportNothing(b.Id(), "confidential issue")
+		return
+	}
+
 	// get gitlab bug ID
 	gitlabID, ok := snapshot.GetCreateMetadata(metaKeyGitlabId)
 	if ok {
@@ -256,12 +267,54 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 	ge.cachedOperationIDs[bugCreationId] = bugGitlabIDString
 
 	labelSet := make(map[string]struct{})
+	assigneeSet := make(map[entity.Id]struct{})
 	for _, op := range snapshot.Operations[1:] {
 		// ignore SetMetadata operations
 		if _, ok := op.(dag.OperationDoesntChangeSnapshot); ok {
 			continue
 		}
 
+		// sync conflicts are local annotations for review, not meant to be exported
+		if _, ok := op.(*bug.SyncConflictOperation); ok {
+			continue
+		}
+
+		// custom fields are defined locally, there is no remote equivalent
+		if _, ok := op.(*bug.SetFieldOperation); ok {
+			continue
+		}
+
+		// redactions are local, the remote comments are left as is
+		if _, ok := op.(*bug.RedactCommentOperation); ok {
+			continue
+		}
+
+		// relations link local bugs, which might not exist on the remote
+		switch op.(type) {
+		case *bug.RelationAddOperation, *bug.RelationRemoveOperation:
+			continue
+		}
+
+		// attachments are stored in git, there is no upload to the remote
+		if _, ok := op.(*bug.AddAttachmentOperation); ok {
+			continue
+		}
+
+		// never leak confidential operations
+		if !core.ExportAllowed(ge.conf, op) {
+			continue
+		}
+
+		// follow the assignees, as gitlab update issue requests need the whole list
+		if op, ok := op.(*bug.SetAssigneeOperation); ok {
+			for _, assignee := range op.Added {
+				assigneeSet[assignee] = struct{}{}
+			}
+			for _, assignee := range op.Removed {
+				delete(assigneeSet, assignee)
+			}
+		}
+
 		// ignore operations already existing in gitlab (due to import or export)
 		// cache the ID of already exported or imported issues and events from Gitlab
 		if id, ok := op.GetMetadata(metaKeyGitlabId); ok {
@@ -335,6 +388,33 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 				id = commentIDint
 			}
 
+		case *bug.ReactionOperation:
+			// gitlab consider the issue body as the issue itself
+			noteID := 0
+			if op.Target.String() != bugCreationId {
+				commentID, ok := ge.cachedOperationIDs[op.Target.String()]
+				if !ok {
+					out <- core.NewExportError(fmt.Errorf("unexpected error: comment id not found"), b.Id())
+					return
+				}
+
+				noteID, err = strconv.Atoi(commentID)
+				if err != nil {
+					out <- core.NewExportError(fmt.Errorf("unexpected comment id format"), b.Id())
+					return
+				}
+			}
+
+			login := ge.identityLogin[opAuthor.Id()]
+			id, err = reactGitlabIssue(ctx, client, ge.repositoryID, bugGitlabID, noteID, login, op.Reaction, op.Remove)
+			if err != nil {
+				err := errors.Wrap(err, "reacting")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportReaction(b.Id())
+
 		case *bug.SetStatusOperation:
 			if err := updateGitlabIssueStatus(ctx, client, ge.repositoryID, bugGitlabID, op.Status); err != nil {
 				err := errors.Wrap(err, "editing status")
@@ -380,6 +460,18 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 
 			out <- core.NewExportLabelChange(b.Id())
 			id = bugGitlabID
+
+		case *bug.SetAssigneeOperation:
+			assignees := gitlabUserIDs(repo, assigneeSet)
+
+			if err := updateGitlabIssueAssignees(ctx, client, ge.repositoryID, bugGitlabID, assignees); err != nil {
+				err := errors.Wrap(err, "updating assignees")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportAssigneeChange(b.Id())
+			id = bugGitlabID
 		default:
 			panic("unhandled operation type case")
 		}
@@ -470,6 +562,87 @@ func editCommentGitlabIssue(ctx context.Context, gc *gitlab.Client, repositoryID
 	return err
 }
 
+// awardNames map the reactions to the names of the gitlab award emojis
+var awardNames = map[bug.Reaction]string{
+	bug.ReactionThumbsUp:   "thumbsup",
+	bug.ReactionThumbsDown: "thumbsdown",
+	bug.ReactionLaugh:      "laughing",
+	bug.ReactionHooray:     "tada",
+	bug.ReactionConfused:   "confused",
+	bug.ReactionHeart:      "heart",
+	bug.ReactionRocket:     "rocket",
+	bug.ReactionEyes:       "eyes",
+}
+
+// add or remove the award emoji of the user to an issue, or to one of its
+// notes if noteID is not zero, and return the id of the award. As an award is
+// removed by id, the one to remove is found by the login of the user.
+func reactGitlabIssue(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID, noteID int, login string, reaction bug.Reaction, remove bool) (int, error) {
+	name, ok := awardNames[reaction]
+	if !ok {
+		return 0, fmt.Errorf("unsupported reaction %s", reaction)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	if !remove {
+		opt := &gitlab.CreateAwardEmojiOptions{Name: name}
+		var award *gitlab.AwardEmoji
+		var err error
+		if noteID == 0 {
+			award, _, err = gc.AwardEmoji.CreateIssueAwardEmoji(repositoryID, issueID, opt, gitlab.WithContext(ctx))
+		} else {
+			award, _, err = gc.AwardEmoji.CreateIssuesAwardEmojiOnNote(repositoryID, issueID, noteID, opt, gitlab.WithContext(ctx))
+		}
+		if err != nil {
+			return 0, err
+		}
+		return award.ID, nil
+	}
+
+	opt := &gitlab.ListAwardEmojiOptions{PerPage: 100, Page: 1}
+	for {
+		var awards []*gitlab.AwardEmoji
+		var resp *gitlab.Response
+		var err error
+		if noteID == 0 {
+			awards, resp, err = gc.AwardEmoji.ListIssueAwardEmoji(repositoryID, issueID, opt, gitlab.WithContext(ctx))
+		} else {
+			awards, resp, err = gc.AwardEmoji.ListIssuesAwardEmojiOnNote(repositoryID, issueID, noteID, opt, gitlab.WithContext(ctx))
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		for _, award := range awards {
+			if award.Name != name || award.User.Username != login {
+				continue
+			}
+			if noteID == 0 {
+				_, err = gc.AwardEmoji.DeleteIssueAwardEmoji(repositoryID, issueID, award.ID, gitlab.WithContext(ctx))
+			} else {
+				_, err = gc.AwardEmoji.DeleteIssuesAwardEmojiOnNote(repositoryID, issueID, noteID, award.ID, gitlab.WithContext(ctx))
+			}
+			if err != nil {
+				return 0, err
+			}
+			return award.ID, nil
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	// the award is already gone
+	if noteID == 0 {
+		return issueID, nil
+	}
+	return noteID, nil
+}
+
 func updateGitlabIssueStatus(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, status common.Status) error {
 	var state string
 
@@ -538,3 +711,38 @@ func updateGitlabIssueLabels(ctx context.Context, gc *gitlab.Client, repositoryI
 
 	return err
 }
+
+func updateGitlabIssueAssignees(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, assignees []int) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	_, _, err := gc.Issues.UpdateIssue(
+		repositoryID, issueID,
+		&gitlab.UpdateIssueOptions{
+			AssigneeIDs: &assignees,
+		},
+		gitlab.WithContext(ctx),
+	)
+
+	return err
+}
+
+// gitlabUserIDs return the sorted ids of the Gitlab users matching the given
+// identities. The identities never imported from Gitlab are not mapped to a
+// remote user and are skipped.
+func gitlabUserIDs(repo *cache.RepoCache, identities map[entity.Id]struct{}) []int {
+	ids := make([]int, 0, len(identities))
+	for identityId := range identities {
+		i, err := repo.ResolveIdentity(identityId)
+		if err != nil {
+			// not known locally, can't be mapped
+			continue
+		}
+		id, err := strconv.Atoi(i.ImmutableMetadata()[metaKeyGitlabId])
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -293,6 +293,11 @@ func (gi *gitlabImporter) ensureIssueEvent(repo *cache.RepoCache, b *cache.BugCa
 		gi.out <- core.NewImportTitleEdition(b.Id(), op.Id())
 
 	case EventAddLabel:
+		err = gi.ensureLabelInfo(repo, event.(LabelEvent))
+		if err != nil {
+			return err
+		}
+
 		_, err = b.ForceChangeLabelsRaw(
 			author,
 			event.CreatedAt().Unix(),
@@ -305,6 +310,11 @@ func (gi *gitlabImporter) ensureIssueEvent(repo *cache.RepoCache, b *cache.BugCa
 		return err
 
 	case EventRemoveLabel:
+		err = gi.ensureLabelInfo(repo, event.(LabelEvent))
+		if err != nil {
+			return err
+		}
+
 		_, err = b.ForceChangeLabelsRaw(
 			author,
 			event.CreatedAt().Unix(),
@@ -316,9 +326,40 @@ func (gi *gitlabImporter) ensureIssueEvent(repo *cache.RepoCache, b *cache.BugCa
 		)
 		return err
 
-	case EventAssigned,
-		EventUnassigned,
-		EventChangedMilestone,
+	case EventAssigned, EventUnassigned:
+		if errResolve == nil {
+			return nil
+		}
+
+		assigned, unassigned := event.(NoteEvent).Assignees()
+		added, err := gi.ensurePersons(repo, assigned)
+		if err != nil {
+			return err
+		}
+		removed, err := gi.ensurePersons(repo, unassigned)
+		if err != nil {
+			return err
+		}
+		if len(added)+len(removed) == 0 {
+			return nil
+		}
+
+		op, err := b.SetAssigneeRaw(
+			author,
+			event.CreatedAt().Unix(),
+			added,
+			removed,
+			map[string]string{
+				metaKeyGitlabId: event.ID(),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportAssigneeChange(b.Id(), op.Id())
+
+	case EventChangedMilestone,
 		EventRemovedMilestone,
 		EventChangedDuedate,
 		EventRemovedDuedate,
@@ -336,6 +377,50 @@ func (gi *gitlabImporter) ensureIssueEvent(repo *cache.RepoCache, b *cache.BugCa
 	return nil
 }
 
+// ensureLabelInfo record the color and description of a Gitlab label in the
+// label registry, to render it as on Gitlab. This is done on each import to
+// follow the changes.
+func (gi *gitlabImporter) ensureLabelInfo(repo *cache.RepoCache, event LabelEvent) error {
+	if _, err := bug.LabelColorFromHex(event.Label.Color); err != nil {
+		// no color, or one git-bug can't render
+		return nil
+	}
+
+	return repo.SetLabelInfo(bug.Label(event.Label.Name), cache.LabelInfo{
+		Color:       event.Label.Color,
+		Description: text.CleanupOneLine(event.Label.Description),
+	})
+}
+
+// ensurePersons return the ids of the identities of the given Gitlab usernames,
+// imported if needed. The unknown usernames are skipped.
+func (gi *gitlabImporter) ensurePersons(repo *cache.RepoCache, usernames []string) ([]entity.Id, error) {
+	ids := make([]entity.Id, 0, len(usernames))
+	for _, username := range usernames {
+		// Look first in the cache
+		i, err := repo.ResolveIdentityImmutableMetadata(metaKeyGitlabLogin, username)
+		if entity.IsErrMultipleMatch(err) {
+			return nil, err
+		}
+		if err != nil {
+			username := username
+			users, _, err := gi.client.Users.ListUsers(&gitlab.ListUsersOptions{Username: &username})
+			if err != nil {
+				return nil, err
+			}
+			if len(users) == 0 {
+				continue
+			}
+			i, err = gi.ensurePerson(repo, users[0].ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		ids = append(ids, i.Id())
+	}
+	return ids, nil
+}
+
 func (gi *gitlabImporter) ensurePerson(repo *cache.RepoCache, id int) (*cache.IdentityCache, error) {
 	// Look first in the cache
 	i, err := repo.ResolveIdentityImmutableMetadata(metaKeyGitlabId, strconv.Itoa(id))
@@ -203,6 +203,12 @@ func (je *jiraExporter) exportBug(ctx context.Context, b *cache.BugCache, out ch
 	createOp := snapshot.Operations[0].(*bug.CreateOperation)
 	author := snapshot.Author
 
+	// skip confidential bug
+	if !core.ExportAllowed(je.conf, createOp) {
+		out <- core.NewExportNothing(b.Id(), "confidential issue")
+		return nil
+	}
+
 	// skip bug if it was imported from some other bug system
 	origin, ok := snapshot.GetCreateMetadata(core.MetaKeyOrigin)
 	if ok && origin != target {
@@ -302,6 +308,47 @@ func (je *jiraExporter) exportBug(ctx context.Context, b *cache.BugCache, out ch
 			continue
 		}
 
+		// sync conflicts are local annotations for review, not meant to be exported
+		if _, ok := op.(*bug.SyncConflictOperation); ok {
+			continue
+		}
+
+		// assignees are local identities, they are not mapped to remote users
+		if _, ok := op.(*bug.SetAssigneeOperation); ok {
+			continue
+		}
+
+		// custom fields are defined locally, there is no remote equivalent
+		if _, ok := op.(*bug.SetFieldOperation); ok {
+			continue
+		}
+
+		// redactions are local, the remote comments are left as is
+		if _, ok := op.(*bug.RedactCommentOperation); ok {
+			continue
+		}
+
+		// relations link local bugs, which might not exist on the remote
+		switch op.(type) {
+		case *bug.RelationAddOperation, *bug.RelationRemoveOperation:
+			continue
+		}
+
+		// attachments are stored in git, there is no upload to the remote
+		if _, ok := op.(*bug.AddAttachmentOperation); ok {
+			continue
+		}
+
+		// jira has no emoji reactions
+		if _, ok := op.(*bug.ReactionOperation); ok {
+			continue
+		}
+
+		// never leak confidential operations
+		if !core.ExportAllowed(je.conf, op) {
+			continue
+		}
+
 		// ignore operations already existing in jira (due to import or export)
 		// cache the ID of already exported or imported issues and events from
 		// Jira
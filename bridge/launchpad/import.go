@@ -52,13 +52,21 @@ func (li *launchpadImporter) ImportAll(ctx context.Context, repo *cache.RepoCach
 		return nil, err
 	}
 
-	lpBugs, err := lpAPI.SearchTasks(ctx, li.conf["project"])
-	if err != nil {
-		return nil, err
-	}
-
 	go func() {
-		for _, lpBug := range lpBugs {
+		defer close(out)
+
+		// stop the search when returning early
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// the bugs are imported as they are found, one at a time
+		for streamed := range lpAPI.SearchTasks(ctx, li.conf["project"]) {
+			if streamed.Err != nil {
+				out <- core.NewImportError(streamed.Err, "")
+				return
+			}
+			lpBug := streamed.Bug
+
 			select {
 			case <-ctx.Done():
 				return
@@ -103,7 +111,7 @@ func (li *launchpadImporter) ImportAll(ctx context.Context, repo *cache.RepoCach
 
 				/* Handle messages */
 				if len(lpBug.Messages) == 0 {
-					return
+					continue
 				}
 
 				// The Launchpad API returns the bug description as the first
@@ -7,7 +7,6 @@ package launchpad
  * TODO:
  * - Retrieve bug status
  * - Retrieve activity log
- * - SearchTasks should yield bugs one by one
  *
  * TODO (maybe):
  * - Authentication (this might help retrieving email adresses)
@@ -79,61 +78,90 @@ func (lapi *launchpadAPI) Init() error {
 	return nil
 }
 
-func (lapi *launchpadAPI) SearchTasks(ctx context.Context, project string) ([]LPBug, error) {
-	var bugs []LPBug
-
-	// First, let us build the URL. Not all statuses are included by
-	// default, so we have to explicitely enumerate them.
-	validStatuses := [13]string{
-		"New", "Incomplete", "Opinion", "Invalid",
-		"Won't Fix", "Expired", "Confirmed", "Triaged",
-		"In Progress", "Fix Committed", "Fix Released",
-		"Incomplete (with response)", "Incomplete (without response)",
-	}
-	queryParams := url.Values{}
-	queryParams.Add("ws.op", "searchTasks")
-	queryParams.Add("order_by", "-date_last_updated")
-	for _, validStatus := range validStatuses {
-		queryParams.Add("status", validStatus)
-	}
-	lpURL := fmt.Sprintf("%s/%s?%s", apiRoot, project, queryParams.Encode())
+// StreamedLPBug is a bug found by SearchTasks, or the error that stopped the
+// search
+type StreamedLPBug struct {
+	Bug LPBug
+	Err error
+}
 
-	for {
-		req, err := http.NewRequest("GET", lpURL, nil)
-		if err != nil {
-			return nil, err
+// SearchTasks yield the bugs of the project one by one, from the most
+// recently updated, so that they don't need to be all held in memory.
+func (lapi *launchpadAPI) SearchTasks(ctx context.Context, project string) <-chan StreamedLPBug {
+	out := make(chan StreamedLPBug)
+
+	go func() {
+		defer close(out)
+
+		// First, let us build the URL. Not all statuses are included by
+		// default, so we have to explicitely enumerate them.
+		validStatuses := [13]string{
+			"New", "Incomplete", "Opinion", "Invalid",
+			"Won't Fix", "Expired", "Confirmed", "Triaged",
+			"In Progress", "Fix Committed", "Fix Released",
+			"Incomplete (with response)", "Incomplete (without response)",
 		}
-
-		resp, err := lapi.client.Do(req)
-		if err != nil {
-			return nil, err
+		queryParams := url.Values{}
+		queryParams.Add("ws.op", "searchTasks")
+		queryParams.Add("order_by", "-date_last_updated")
+		for _, validStatus := range validStatuses {
+			queryParams.Add("status", validStatus)
+		}
+		lpURL := fmt.Sprintf("%s/%s?%s", apiRoot, project, queryParams.Encode())
+
+		send := func(streamed StreamedLPBug) bool {
+			select {
+			case out <- streamed:
+				return true
+			case <-ctx.Done():
+				return false
+			}
 		}
 
-		var result launchpadAnswer
+		for {
+			req, err := http.NewRequest("GET", lpURL, nil)
+			if err != nil {
+				send(StreamedLPBug{Err: err})
+				return
+			}
+			req = req.WithContext(ctx)
 
-		err = json.NewDecoder(resp.Body).Decode(&result)
-		_ = resp.Body.Close()
+			resp, err := lapi.client.Do(req)
+			if err != nil {
+				send(StreamedLPBug{Err: err})
+				return
+			}
 
-		if err != nil {
-			return nil, err
-		}
+			var result launchpadAnswer
+
+			err = json.NewDecoder(resp.Body).Decode(&result)
+			_ = resp.Body.Close()
 
-		for _, bugEntry := range result.Entries {
-			bug, err := lapi.queryBug(ctx, bugEntry.BugLink)
-			if err == nil {
-				bugs = append(bugs, bug)
+			if err != nil {
+				send(StreamedLPBug{Err: err})
+				return
 			}
-		}
 
-		// Launchpad only returns 75 results at a time. We get the next
-		// page and run another query, unless there is no other page.
-		lpURL = result.NextLink
-		if lpURL == "" {
-			break
+			for _, bugEntry := range result.Entries {
+				bug, err := lapi.queryBug(ctx, bugEntry.BugLink)
+				if err != nil {
+					continue
+				}
+				if !send(StreamedLPBug{Bug: bug}) {
+					return
+				}
+			}
+
+			// Launchpad only returns 75 results at a time. We get the next
+			// page and run another query, unless there is no other page.
+			lpURL = result.NextLink
+			if lpURL == "" {
+				return
+			}
 		}
-	}
+	}()
 
-	return bugs, nil
+	return out
 }
 
 func (lapi *launchpadAPI) queryBug(ctx context.Context, url string) (LPBug, error) {
@@ -0,0 +1,288 @@
+package phabricator
+
+/*
+ * A minimal client for the Conduit API of Phabricator. The documentation can
+ * be found on any instance at /conduit/, or at:
+ * https://secure.phabricator.com/conduit/
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// the maximum page size accepted by the *.search methods
+const searchLimit = 100
+
+// conduitError is an error reported by the Conduit API
+type conduitError struct {
+	Code string
+	Info string
+}
+
+func (e *conduitError) Error() string {
+	return fmt.Sprintf("conduit: %s: %s", e.Code, e.Info)
+}
+
+type conduit struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func newConduit(baseURL string, token string) *conduit {
+	return &conduit{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		client: &http.Client{
+			Timeout: defaultTimeout,
+		},
+	}
+}
+
+// call a Conduit method, and decode its result in result
+func (c *conduit) call(ctx context.Context, method string, params url.Values, result interface{}) error {
+	form := url.Values{}
+	for key, values := range params {
+		form[key] = values
+	}
+	form.Set("api.token", c.token)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/api/%s", c.baseURL, method), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("conduit: %s: unexpected status %s", method, resp.Status)
+	}
+
+	var response struct {
+		Result    json.RawMessage `json:"result"`
+		ErrorCode *string         `json:"error_code"`
+		ErrorInfo *string         `json:"error_info"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("conduit: %s: %v", method, err)
+	}
+
+	if response.ErrorCode != nil {
+		info := ""
+		if response.ErrorInfo != nil {
+			info = *response.ErrorInfo
+		}
+		return &conduitError{Code: *response.ErrorCode, Info: info}
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(response.Result, result)
+}
+
+// search call one of the *.search methods, and follow the cursor to return
+// all the results.
+func search[T any](ctx context.Context, c *conduit, method string, params url.Values) ([]T, error) {
+	var result []T
+	after := ""
+
+	for {
+		pageParams := url.Values{}
+		for key, values := range params {
+			pageParams[key] = values
+		}
+		pageParams.Set("limit", strconv.Itoa(searchLimit))
+		if after != "" {
+			pageParams.Set("after", after)
+		}
+
+		var page struct {
+			Data   []T `json:"data"`
+			Cursor struct {
+				After *string `json:"after"`
+			} `json:"cursor"`
+		}
+		if err := c.call(ctx, method, pageParams, &page); err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.Data...)
+
+		if page.Cursor.After == nil || *page.Cursor.After == "" {
+			return result, nil
+		}
+		after = *page.Cursor.After
+	}
+}
+
+// phids encode a list of PHID as a constraint of a *.search method
+func phids(params url.Values, constraint string, values []string) {
+	for i, phid := range values {
+		params.Set(fmt.Sprintf("constraints[%s][%d]", constraint, i), phid)
+	}
+}
+
+type phabUser struct {
+	PHID   string `json:"phid"`
+	Fields struct {
+		Username string `json:"username"`
+		RealName string `json:"realName"`
+	} `json:"fields"`
+}
+
+type phabProject struct {
+	PHID   string `json:"phid"`
+	Fields struct {
+		Name string `json:"name"`
+		Slug string `json:"slug"`
+	} `json:"fields"`
+}
+
+type phabTask struct {
+	ID     int    `json:"id"`
+	PHID   string `json:"phid"`
+	Fields struct {
+		Name        string `json:"name"`
+		Description struct {
+			Raw string `json:"raw"`
+		} `json:"description"`
+		AuthorPHID string `json:"authorPHID"`
+		Status     struct {
+			Value string `json:"value"`
+		} `json:"status"`
+		DateCreated  int64 `json:"dateCreated"`
+		DateModified int64 `json:"dateModified"`
+	} `json:"fields"`
+}
+
+type phabStatus struct {
+	Value  string `json:"value"`
+	Name   string `json:"name"`
+	Closed bool   `json:"closed"`
+}
+
+type phabComment struct {
+	PHID    string `json:"phid"`
+	Version int    `json:"version"`
+	Removed bool   `json:"removed"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	DateModified int64 `json:"dateModified"`
+}
+
+// phabTransaction is an entry of the timeline of a task. Fields depends on
+// the type of the transaction.
+type phabTransaction struct {
+	ID          int             `json:"id"`
+	PHID        string          `json:"phid"`
+	Type        *string         `json:"type"`
+	AuthorPHID  string          `json:"authorPHID"`
+	DateCreated int64           `json:"dateCreated"`
+	Comments    []phabComment   `json:"comments"`
+	Fields      json.RawMessage `json:"fields"`
+}
+
+// the fields of the "title", "description" and "status" transactions
+type phabChangeFields struct {
+	Old *string `json:"old"`
+	New string  `json:"new"`
+}
+
+// the fields of the "projects" transactions
+type phabProjectsFields struct {
+	Operations []struct {
+		Operation string `json:"operation"`
+		PHID      string `json:"phid"`
+	} `json:"operations"`
+}
+
+func (c *conduit) whoami(ctx context.Context) (string, error) {
+	var user struct {
+		UserName string `json:"userName"`
+	}
+	if err := c.call(ctx, "user.whoami", nil, &user); err != nil {
+		return "", err
+	}
+	if user.UserName == "" {
+		return "", fmt.Errorf("phabricator say username is empty")
+	}
+	return user.UserName, nil
+}
+
+func (c *conduit) users(ctx context.Context, userPHIDs []string) ([]phabUser, error) {
+	params := url.Values{}
+	phids(params, "phids", userPHIDs)
+	return search[phabUser](ctx, c, "user.search", params)
+}
+
+func (c *conduit) projectBySlug(ctx context.Context, slug string) (*phabProject, error) {
+	params := url.Values{}
+	params.Set("constraints[slugs][0]", slug)
+	projects, err := search[phabProject](ctx, c, "project.search", params)
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) == 0 {
+		return nil, fmt.Errorf("project %s doesn't exist", slug)
+	}
+	return &projects[0], nil
+}
+
+func (c *conduit) projects(ctx context.Context, projectPHIDs []string) ([]phabProject, error) {
+	params := url.Values{}
+	phids(params, "phids", projectPHIDs)
+	return search[phabProject](ctx, c, "project.search", params)
+}
+
+func (c *conduit) statuses(ctx context.Context) ([]phabStatus, error) {
+	var result struct {
+		Data []phabStatus `json:"data"`
+	}
+	if err := c.call(ctx, "maniphest.status.search", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+// tasks return the tasks tagged with the given project, modified since the
+// given unix time, oldest first.
+func (c *conduit) tasks(ctx context.Context, projectPHID string, since int64) ([]phabTask, error) {
+	params := url.Values{}
+	params.Set("constraints[projects][0]", projectPHID)
+	if since > 0 {
+		params.Set("constraints[modifiedStart]", strconv.FormatInt(since, 10))
+	}
+	params.Set("order", "oldest")
+	return search[phabTask](ctx, c, "maniphest.search", params)
+}
+
+// transactions return the timeline of a task, oldest first
+func (c *conduit) transactions(ctx context.Context, taskPHID string) ([]phabTransaction, error) {
+	params := url.Values{}
+	params.Set("objectIdentifier", taskPHID)
+	transactions, err := search[phabTransaction](ctx, c, "transaction.search", params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Conduit return the newest transactions first
+	for i, j := 0, len(transactions)-1; i < j; i, j = i+1, j-1 {
+		transactions[i], transactions[j] = transactions[j], transactions[i]
+	}
+
+	return transactions, nil
+}
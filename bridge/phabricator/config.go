@@ -0,0 +1,233 @@
+package phabricator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/input"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+var (
+	ErrBadProjectURL        = errors.New("bad Phabricator project URL")
+	ErrMissingIdentityToken = errors.New("missing identity token")
+)
+
+func (*Phabricator) ValidParams() map[string]interface{} {
+	return map[string]interface{}{
+		"URL":        nil,
+		"BaseURL":    nil,
+		"Project":    nil,
+		"CredPrefix": nil,
+		"TokenRaw":   nil,
+	}
+}
+
+func (p *Phabricator) Configure(repo *cache.RepoCache, params core.BridgeParams, interactive bool) (core.Configuration, error) {
+	var err error
+	var baseUrl, project string
+
+	switch {
+	case params.URL != "":
+		baseUrl, project, err = splitURL(params.URL)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		baseUrl = params.BaseURL
+		project = params.Project
+	}
+
+	if baseUrl == "" {
+		if !interactive {
+			return nil, fmt.Errorf("Non-interactive-mode is active. Please specify the Phabricator instance URL via the --base-url option.")
+		}
+		baseUrl, err = input.Prompt("Phabricator server URL", "URL", input.Required, input.IsURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+	baseUrl = strings.TrimSuffix(baseUrl, "/")
+
+	if project == "" {
+		if !interactive {
+			return nil, fmt.Errorf("Non-interactive-mode is active. Please specify the project slug via the --project option.")
+		}
+		project, err = input.Prompt("Phabricator project slug", "project", input.Required)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var cred auth.Credential
+
+	switch {
+	case params.CredPrefix != "":
+		cred, err = auth.LoadWithPrefix(repo, params.CredPrefix)
+		if err != nil {
+			return nil, err
+		}
+	case params.TokenRaw != "":
+		cred, err = newToken(baseUrl, params.TokenRaw)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		if !interactive {
+			return nil, fmt.Errorf("Non-interactive-mode is active. Please specify the API token via the --token option.")
+		}
+		cred, err = promptTokenOptions(repo, baseUrl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	token, ok := cred.(*auth.Token)
+	if !ok {
+		return nil, fmt.Errorf("the Phabricator bridge only handle token credentials")
+	}
+	login, ok := token.GetMetadata(auth.MetaKeyLogin)
+	if !ok {
+		return nil, fmt.Errorf("credential doesn't have a login")
+	}
+
+	// verify project and get its PHID
+	proj, err := newConduit(baseUrl, token.Value).projectBySlug(context.Background(), project)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := make(core.Configuration)
+	conf[core.ConfigKeyTarget] = target
+	conf[confKeyBaseUrl] = baseUrl
+	conf[confKeyProject] = project
+	conf[confKeyProjectPHID] = proj.PHID
+	conf[confKeyDefaultLogin] = login
+
+	err = p.ValidateConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// don't forget to store the now known valid token
+	if !auth.IdExist(repo, cred.ID()) {
+		err = auth.Store(repo, cred)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return conf, core.FinishConfig(repo, metaKeyPhabricatorLogin, login)
+}
+
+func (*Phabricator) ValidateConfig(conf core.Configuration) error {
+	if v, ok := conf[core.ConfigKeyTarget]; !ok {
+		return fmt.Errorf("missing %s key", core.ConfigKeyTarget)
+	} else if v != target {
+		return fmt.Errorf("unexpected target name: %v", v)
+	}
+	if _, ok := conf[confKeyBaseUrl]; !ok {
+		return fmt.Errorf("missing %s key", confKeyBaseUrl)
+	}
+	if _, ok := conf[confKeyProject]; !ok {
+		return fmt.Errorf("missing %s key", confKeyProject)
+	}
+	if _, ok := conf[confKeyProjectPHID]; !ok {
+		return fmt.Errorf("missing %s key", confKeyProjectPHID)
+	}
+	if _, ok := conf[confKeyDefaultLogin]; !ok {
+		return fmt.Errorf("missing %s key", confKeyDefaultLogin)
+	}
+
+	return nil
+}
+
+func promptTokenOptions(repo repository.RepoKeyring, baseUrl string) (auth.Credential, error) {
+	creds, err := auth.List(repo,
+		auth.WithTarget(target),
+		auth.WithKind(auth.KindToken),
+		auth.WithMeta(auth.MetaKeyBaseURL, baseUrl),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, index, err := input.PromptCredential(target, "token", creds, []string{
+		"enter my token",
+	})
+	switch {
+	case err != nil:
+		return nil, err
+	case cred != nil:
+		return cred, nil
+	case index == 0:
+		return promptToken(baseUrl)
+	default:
+		panic("missed case")
+	}
+}
+
+var tokenRegexp = regexp.MustCompile(`^api-[a-z0-9]{28}$`)
+
+func promptToken(baseUrl string) (*auth.Token, error) {
+	fmt.Printf("You can generate a new token by visiting %s.\n", baseUrl+"/settings/panel/apitokens/")
+	fmt.Println("Choose 'Generate Token'. Conduit tokens have the permissions of your account.")
+	fmt.Println()
+
+	var token *auth.Token
+
+	validator := func(name string, value string) (complaint string, err error) {
+		if !tokenRegexp.MatchString(value) {
+			return "token has incorrect format", nil
+		}
+		token, err = newToken(baseUrl, value)
+		if err != nil {
+			return fmt.Sprintf("token is invalid: %v", err), nil
+		}
+		return "", nil
+	}
+
+	_, err := input.Prompt("Enter token", "token", input.Required, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// newToken check a raw API token against the Phabricator instance, and
+// return the corresponding credential.
+func newToken(baseUrl string, value string) (*auth.Token, error) {
+	login, err := newConduit(baseUrl, value).whoami(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	token := auth.NewToken(target, value)
+	token.SetMetadata(auth.MetaKeyLogin, login)
+	token.SetMetadata(auth.MetaKeyBaseURL, baseUrl)
+	return token, nil
+}
+
+// splitURL extract the base URL of the instance and the project slug from
+// a project URL, like https://phabricator.example.com/tag/my-project/
+func splitURL(projectUrl string) (baseUrl string, project string, err error) {
+	u, err := url.Parse(projectUrl)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", "", ErrBadProjectURL
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) != 2 || parts[0] != "tag" || parts[1] == "" {
+		return "", "", ErrBadProjectURL
+	}
+
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host), parts[1], nil
+}
@@ -0,0 +1,49 @@
+package phabricator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		baseUrl string
+		project string
+		err     error
+	}{
+		{
+			name:    "project url",
+			url:     "https://phabricator.example.com/tag/my-project/",
+			baseUrl: "https://phabricator.example.com",
+			project: "my-project",
+		},
+		{
+			name:    "without trailing slash",
+			url:     "http://phab.example.com:8080/tag/backend",
+			baseUrl: "http://phab.example.com:8080",
+			project: "backend",
+		},
+		{
+			name: "task url",
+			url:  "https://phabricator.example.com/T123",
+			err:  ErrBadProjectURL,
+		},
+		{
+			name: "not an url",
+			url:  "my-project",
+			err:  ErrBadProjectURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseUrl, project, err := splitURL(tt.url)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.baseUrl, baseUrl)
+			require.Equal(t, tt.project, project)
+		})
+	}
+}
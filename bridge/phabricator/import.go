@@ -0,0 +1,494 @@
+package phabricator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// phabricatorImporter implement the Importer interface
+type phabricatorImporter struct {
+	conf   core.Configuration
+	client *conduit
+
+	// whether a task status is considered closed, by status value
+	closed map[string]bool
+	// project names, by PHID
+	projects map[string]string
+
+	// send only channel
+	out chan<- core.ImportResult
+}
+
+func (pi *phabricatorImporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration) error {
+	pi.conf = conf
+	pi.projects = make(map[string]string)
+
+	creds, err := auth.List(repo,
+		auth.WithTarget(target),
+		auth.WithKind(auth.KindToken),
+		auth.WithMeta(auth.MetaKeyBaseURL, conf[confKeyBaseUrl]),
+		auth.WithMeta(auth.MetaKeyLogin, conf[confKeyDefaultLogin]),
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(creds) == 0 {
+		return ErrMissingIdentityToken
+	}
+
+	pi.client = newConduit(conf[confKeyBaseUrl], creds[0].(*auth.Token).Value)
+
+	return nil
+}
+
+// ImportAll iterate over all the tasks of the configured project and ensure
+// the creation of the missing bugs / comments / label changes / title changes ...
+func (pi *phabricatorImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ImportResult, error) {
+	statuses, err := pi.client.statuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pi.closed = make(map[string]bool, len(statuses))
+	for _, status := range statuses {
+		pi.closed[status.Value] = status.Closed
+	}
+
+	var sinceUnix int64
+	if !since.IsZero() {
+		sinceUnix = since.Unix()
+	}
+
+	tasks, err := pi.client.tasks(ctx, pi.conf[confKeyProjectPHID], sinceUnix)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan core.ImportResult)
+	pi.out = out
+
+	go func() {
+		defer close(out)
+
+		for _, task := range tasks {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			transactions, err := pi.client.transactions(ctx, task.PHID)
+			if err != nil {
+				out <- core.NewImportError(err, entity.Id(task.PHID))
+				continue
+			}
+
+			b, err := pi.ensureTask(ctx, repo, task, transactions)
+			if err != nil {
+				err := fmt.Errorf("task creation: %v", err)
+				out <- core.NewImportError(err, "")
+				return
+			}
+
+			for _, transaction := range transactions {
+				if err := pi.ensureTransaction(ctx, repo, b, transaction); err != nil {
+					err := fmt.Errorf("transaction creation: %v", err)
+					out <- core.NewImportError(err, entity.Id(transaction.PHID))
+				}
+			}
+
+			if !b.NeedCommit() {
+				out <- core.NewImportNothing(b.Id(), "no imported operation")
+			} else if err := b.Commit(); err != nil {
+				// commit bug state
+				err := fmt.Errorf("bug commit: %v", err)
+				out <- core.NewImportError(err, "")
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (pi *phabricatorImporter) ensureTask(ctx context.Context, repo *cache.RepoCache, task phabTask, transactions []phabTransaction) (*cache.BugCache, error) {
+	// resolve bug
+	b, err := repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
+		return excerpt.CreateMetadata[core.MetaKeyOrigin] == target &&
+			excerpt.CreateMetadata[metaKeyPhabricatorId] == task.PHID &&
+			excerpt.CreateMetadata[metaKeyPhabricatorBaseUrl] == pi.conf[confKeyBaseUrl]
+	})
+	if err == nil {
+		return b, nil
+	}
+	if err != bug.ErrBugNotExist {
+		return nil, err
+	}
+
+	// ensure task author
+	author, err := pi.ensurePerson(ctx, repo, task.Fields.AuthorPHID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The task is created with its original title and description, the
+	// later changes are imported from the timeline.
+	title := initialValue(transactions, "title", task.Fields.Name)
+	description := initialValue(transactions, "description", task.Fields.Description.Raw)
+
+	// if bug was never imported, create bug
+	b, _, err = repo.NewBugRaw(
+		author,
+		task.Fields.DateCreated,
+		text.CleanupOneLine(title),
+		text.Cleanup(description),
+		nil,
+		map[string]string{
+			core.MetaKeyOrigin:        target,
+			metaKeyPhabricatorId:      task.PHID,
+			metaKeyPhabricatorUrl:     fmt.Sprintf("%s/T%d", pi.conf[confKeyBaseUrl], task.ID),
+			metaKeyPhabricatorBaseUrl: pi.conf[confKeyBaseUrl],
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// importing a new bug
+	pi.out <- core.NewImportBug(b.Id())
+
+	return b, nil
+}
+
+// initialValue return the value a field had when the task was created: the
+// first transaction changing it either set it at creation, or hold the
+// original value as its old value.
+func initialValue(transactions []phabTransaction, kind string, current string) string {
+	for _, transaction := range transactions {
+		if transaction.Type == nil || *transaction.Type != kind {
+			continue
+		}
+		var fields phabChangeFields
+		if err := json.Unmarshal(transaction.Fields, &fields); err != nil {
+			continue
+		}
+		if fields.Old == nil {
+			return fields.New
+		}
+		return *fields.Old
+	}
+	return current
+}
+
+func (pi *phabricatorImporter) ensureTransaction(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, transaction phabTransaction) error {
+	// untyped transactions (subscribers, edges ...) can't be described
+	if transaction.Type == nil {
+		return nil
+	}
+
+	id, errResolve := b.ResolveOperationWithMetadata(metaKeyPhabricatorId, transaction.PHID)
+	if errResolve != nil && errResolve != cache.ErrNoMatchingOp {
+		return errResolve
+	}
+
+	switch *transaction.Type {
+	case "comment":
+		return pi.ensureComment(ctx, repo, b, transaction, id, errResolve)
+
+	case "title":
+		var fields phabChangeFields
+		if err := json.Unmarshal(transaction.Fields, &fields); err != nil {
+			return err
+		}
+		// the creation is part of the create operation
+		if errResolve == nil || fields.Old == nil {
+			return nil
+		}
+
+		author, err := pi.ensurePerson(ctx, repo, transaction.AuthorPHID)
+		if err != nil {
+			return err
+		}
+
+		op, err := b.SetTitleRaw(
+			author,
+			transaction.DateCreated,
+			text.CleanupOneLine(fields.New),
+			map[string]string{
+				metaKeyPhabricatorId: transaction.PHID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		pi.out <- core.NewImportTitleEdition(b.Id(), op.Id())
+
+	case "description":
+		var fields phabChangeFields
+		if err := json.Unmarshal(transaction.Fields, &fields); err != nil {
+			return err
+		}
+		// the creation is part of the create operation
+		if errResolve == nil || fields.Old == nil {
+			return nil
+		}
+
+		author, err := pi.ensurePerson(ctx, repo, transaction.AuthorPHID)
+		if err != nil {
+			return err
+		}
+
+		commentId, _, err := b.EditCreateCommentRaw(
+			author,
+			transaction.DateCreated,
+			text.Cleanup(fields.New),
+			map[string]string{
+				metaKeyPhabricatorId: transaction.PHID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		pi.out <- core.NewImportCommentEdition(b.Id(), commentId)
+
+	case "status":
+		var fields phabChangeFields
+		if err := json.Unmarshal(transaction.Fields, &fields); err != nil {
+			return err
+		}
+		if errResolve == nil {
+			return nil
+		}
+
+		// Phabricator has many statuses, only the transitions between an
+		// open and a closed one are relevant.
+		closed := pi.closed[fields.New]
+		status := b.Snapshot().Status
+		if closed == (status == common.ClosedStatus) {
+			return nil
+		}
+
+		author, err := pi.ensurePerson(ctx, repo, transaction.AuthorPHID)
+		if err != nil {
+			return err
+		}
+
+		metadata := map[string]string{
+			metaKeyPhabricatorId: transaction.PHID,
+		}
+
+		var op *bug.SetStatusOperation
+		if closed {
+			op, err = b.CloseRaw(author, transaction.DateCreated, metadata)
+		} else {
+			op, err = b.OpenRaw(author, transaction.DateCreated, metadata)
+		}
+		if err != nil {
+			return err
+		}
+
+		pi.out <- core.NewImportStatusChange(b.Id(), op.Id())
+
+	case "projects":
+		var fields phabProjectsFields
+		if err := json.Unmarshal(transaction.Fields, &fields); err != nil {
+			return err
+		}
+		if errResolve == nil {
+			return nil
+		}
+
+		var added, removed []string
+		for _, operation := range fields.Operations {
+			name, err := pi.projectName(ctx, operation.PHID)
+			if err != nil {
+				return err
+			}
+			switch operation.Operation {
+			case "add":
+				added = append(added, name)
+			case "remove":
+				removed = append(removed, name)
+			}
+		}
+		if len(added) == 0 && len(removed) == 0 {
+			return nil
+		}
+
+		author, err := pi.ensurePerson(ctx, repo, transaction.AuthorPHID)
+		if err != nil {
+			return err
+		}
+
+		op, err := b.ForceChangeLabelsRaw(
+			author,
+			transaction.DateCreated,
+			added,
+			removed,
+			map[string]string{
+				metaKeyPhabricatorId: transaction.PHID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		pi.out <- core.NewImportLabelChange(b.Id(), op.Id())
+	}
+
+	// other transactions (priority, owner, subtasks, columns ...) have no
+	// equivalent and are ignored
+	return nil
+}
+
+func (pi *phabricatorImporter) ensureComment(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, transaction phabTransaction, id entity.Id, errResolve error) error {
+	// Conduit return every version of an edited comment, use the latest
+	var latest *phabComment
+	for i, comment := range transaction.Comments {
+		if latest == nil || comment.Version > latest.Version {
+			latest = &transaction.Comments[i]
+		}
+	}
+	if latest == nil || latest.Removed {
+		return nil
+	}
+
+	cleanText := text.Cleanup(latest.Content.Raw)
+
+	author, err := pi.ensurePerson(ctx, repo, transaction.AuthorPHID)
+	if err != nil {
+		return err
+	}
+
+	// if we didn't import the comment
+	if errResolve == cache.ErrNoMatchingOp {
+		commentId, _, err := b.AddCommentRaw(
+			author,
+			transaction.DateCreated,
+			cleanText,
+			nil,
+			map[string]string{
+				metaKeyPhabricatorId: transaction.PHID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		pi.out <- core.NewImportComment(b.Id(), commentId)
+		return nil
+	}
+
+	// if comment was already imported, check for an edition
+	comment, err := b.Snapshot().SearchCommentByOpId(id)
+	if err != nil {
+		return err
+	}
+
+	if comment.Message == cleanText {
+		return nil
+	}
+
+	_, err = b.EditCommentRaw(
+		author,
+		latest.DateModified,
+		comment.CombinedId(),
+		cleanText,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	pi.out <- core.NewImportCommentEdition(b.Id(), comment.CombinedId())
+	return nil
+}
+
+func (pi *phabricatorImporter) projectName(ctx context.Context, phid string) (string, error) {
+	if name, ok := pi.projects[phid]; ok {
+		return name, nil
+	}
+
+	projects, err := pi.client.projects(ctx, []string{phid})
+	if err != nil {
+		return "", err
+	}
+	if len(projects) == 0 {
+		return "", fmt.Errorf("unknown project %s", phid)
+	}
+
+	name := text.CleanupOneLine(projects[0].Fields.Name)
+	pi.projects[phid] = name
+	return name, nil
+}
+
+func (pi *phabricatorImporter) ensurePerson(ctx context.Context, repo *cache.RepoCache, phid string) (*cache.IdentityCache, error) {
+	// Look first in the cache
+	i, err := repo.ResolveIdentityImmutableMetadata(metaKeyPhabricatorPHID, phid)
+	if err == nil {
+		return i, nil
+	}
+	if entity.IsErrMultipleMatch(err) {
+		return nil, err
+	}
+
+	// Applications (Herald rules, bots ...) can author transactions but are
+	// not users. Those are imported with their PHID as name.
+	name, login := phid, ""
+	if strings.HasPrefix(phid, "PHID-USER-") {
+		users, err := pi.client.users(ctx, []string{phid})
+		if err != nil {
+			return nil, err
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("unknown user %s", phid)
+		}
+		name, login = users[0].Fields.RealName, users[0].Fields.Username
+		if name == "" {
+			name = login
+		}
+
+		// the user configuring the bridge is only known by its login
+		i, err := repo.ResolveIdentityImmutableMetadata(metaKeyPhabricatorLogin, login)
+		if err == nil {
+			return i, nil
+		}
+		if entity.IsErrMultipleMatch(err) {
+			return nil, err
+		}
+	}
+
+	metadata := map[string]string{
+		metaKeyPhabricatorPHID: phid,
+	}
+	if login != "" {
+		metadata[metaKeyPhabricatorLogin] = login
+	}
+
+	i, err = repo.NewIdentityRaw(
+		name,
+		"",
+		login,
+		"",
+		nil,
+		metadata,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pi.out <- core.NewImportIdentity(i.Id())
+	return i, nil
+}
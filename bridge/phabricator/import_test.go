@@ -0,0 +1,184 @@
+package phabricator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+const testToken = "api-abcdefghijklmnopqrstuvwxyz01"
+
+// conduit responses, by method
+var testResponses = map[string]string{
+	"maniphest.status.search": `{"data": [
+		{"value": "open", "name": "Open", "closed": false},
+		{"value": "resolved", "name": "Resolved", "closed": true}
+	]}`,
+	"maniphest.search": `{"data": [
+		{"id": 12, "phid": "PHID-TASK-1", "fields": {
+			"name": "better title",
+			"description": {"raw": "original description"},
+			"authorPHID": "PHID-USER-alice",
+			"status": {"value": "resolved"},
+			"dateCreated": 1600000000,
+			"dateModified": 1600000500
+		}}
+	], "cursor": {"after": null}}`,
+	"transaction.search": `{"data": [
+		{"phid": "PHID-XACT-9", "type": "projects", "authorPHID": "PHID-APPS-PhabricatorHeraldApplication", "dateCreated": 1600000600,
+			"comments": [], "fields": {"operations": [{"operation": "add", "phid": "PHID-PROJ-triage"}]}},
+		{"phid": "PHID-XACT-8", "type": null, "authorPHID": "PHID-USER-bob", "dateCreated": 1600000500,
+			"comments": [], "fields": {}},
+		{"phid": "PHID-XACT-7", "type": "projects", "authorPHID": "PHID-USER-alice", "dateCreated": 1600000500,
+			"comments": [], "fields": {"operations": [{"operation": "remove", "phid": "PHID-PROJ-backend"}]}},
+		{"phid": "PHID-XACT-6", "type": "status", "authorPHID": "PHID-USER-alice", "dateCreated": 1600000400,
+			"comments": [], "fields": {"old": "open", "new": "resolved"}},
+		{"phid": "PHID-XACT-5", "type": "title", "authorPHID": "PHID-USER-bob", "dateCreated": 1600000300,
+			"comments": [], "fields": {"old": "original title", "new": "better title"}},
+		{"phid": "PHID-XACT-4", "type": "comment", "authorPHID": "PHID-USER-bob", "dateCreated": 1600000200,
+			"comments": [
+				{"phid": "PHID-XCMT-2", "version": 2, "removed": false, "content": {"raw": "edited comment"}, "dateModified": 1600000250},
+				{"phid": "PHID-XCMT-1", "version": 1, "removed": false, "content": {"raw": "first comment"}, "dateModified": 1600000200}
+			], "fields": {}},
+		{"phid": "PHID-XACT-3", "type": "projects", "authorPHID": "PHID-USER-alice", "dateCreated": 1600000000,
+			"comments": [], "fields": {"operations": [{"operation": "add", "phid": "PHID-PROJ-backend"}]}},
+		{"phid": "PHID-XACT-2", "type": "description", "authorPHID": "PHID-USER-alice", "dateCreated": 1600000000,
+			"comments": [], "fields": {"old": null, "new": "original description"}},
+		{"phid": "PHID-XACT-1", "type": "title", "authorPHID": "PHID-USER-alice", "dateCreated": 1600000000,
+			"comments": [], "fields": {"old": null, "new": "original title"}}
+	], "cursor": {"after": null}}`,
+}
+
+var testUsers = map[string]string{
+	"PHID-USER-alice": `{"phid": "PHID-USER-alice", "fields": {"username": "alice", "realName": "Alice Liddell"}}`,
+	"PHID-USER-bob":   `{"phid": "PHID-USER-bob", "fields": {"username": "bob", "realName": ""}}`,
+}
+
+var testProjects = map[string]string{
+	"PHID-PROJ-backend": `{"phid": "PHID-PROJ-backend", "fields": {"name": "Backend", "slug": "backend"}}`,
+	"PHID-PROJ-triage":  `{"phid": "PHID-PROJ-triage", "fields": {"name": "Needs Triage", "slug": "needs_triage"}}`,
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, testToken, r.Form.Get("api.token"))
+
+		method := r.URL.Path[len("/api/"):]
+
+		var result string
+		switch method {
+		case "user.search":
+			result = `{"data": [` + testUsers[r.Form.Get("constraints[phids][0]")] + `], "cursor": {"after": null}}`
+		case "project.search":
+			result = `{"data": [` + testProjects[r.Form.Get("constraints[phids][0]")] + `], "cursor": {"after": null}}`
+		default:
+			var ok bool
+			result, ok = testResponses[method]
+			require.True(t, ok, "unexpected method %s", method)
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"result":     json.RawMessage(result),
+			"error_code": nil,
+			"error_info": nil,
+		})
+	}))
+}
+
+func TestPhabricatorImport(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	token := auth.NewToken(target, testToken)
+	token.SetMetadata(auth.MetaKeyLogin, "alice")
+	token.SetMetadata(auth.MetaKeyBaseURL, server.URL)
+	require.NoError(t, auth.Store(repo, token))
+
+	conf := core.Configuration{
+		confKeyBaseUrl:      server.URL,
+		confKeyProject:      "backend",
+		confKeyProjectPHID:  "PHID-PROJ-backend",
+		confKeyDefaultLogin: "alice",
+	}
+
+	importAll := func() []core.ImportResult {
+		ctx := context.Background()
+
+		importer := &phabricatorImporter{}
+		require.NoError(t, importer.Init(ctx, backend, conf))
+
+		events, err := importer.ImportAll(ctx, backend, time.Time{})
+		require.NoError(t, err)
+
+		var results []core.ImportResult
+		for result := range events {
+			require.NoError(t, result.Err)
+			results = append(results, result)
+		}
+		return results
+	}
+
+	importAll()
+
+	require.Len(t, backend.AllBugsIds(), 1)
+
+	b, err := backend.ResolveBugCreateMetadata(metaKeyPhabricatorUrl, server.URL+"/T12")
+	require.NoError(t, err)
+
+	snap := b.Snapshot()
+	require.Equal(t, "better title", snap.Title)
+	require.Equal(t, common.ClosedStatus, snap.Status)
+	require.Equal(t, []bug.Label{"Needs Triage"}, snap.Labels)
+	require.Equal(t, "Alice Liddell", snap.Author.Name())
+
+	require.Len(t, snap.Comments, 2)
+	require.Equal(t, "original description", snap.Comments[0].Message)
+	require.Equal(t, "edited comment", snap.Comments[1].Message)
+	require.Equal(t, "bob", snap.Comments[1].Author.Name())
+
+	create := snap.Operations[0].(*bug.CreateOperation)
+	require.Equal(t, "original title", create.Title)
+
+	// the timeline is imported in order
+	ops := snap.Operations
+	require.Len(t, ops, 7)
+	require.IsType(t, &bug.LabelChangeOperation{}, ops[1])
+	require.IsType(t, &bug.AddCommentOperation{}, ops[2])
+	require.IsType(t, &bug.SetTitleOperation{}, ops[3])
+	require.IsType(t, &bug.SetStatusOperation{}, ops[4])
+	require.IsType(t, &bug.LabelChangeOperation{}, ops[5])
+	require.IsType(t, &bug.LabelChangeOperation{}, ops[6])
+
+	// the Herald rule is imported as an identity
+	herald, err := backend.ResolveIdentityImmutableMetadata(metaKeyPhabricatorPHID, "PHID-APPS-PhabricatorHeraldApplication")
+	require.NoError(t, err)
+	require.Equal(t, "PHID-APPS-PhabricatorHeraldApplication", herald.Name())
+
+	// importing again doesn't duplicate anything
+	results := importAll()
+	require.Len(t, results, 1)
+	require.Equal(t, core.ImportEventNothing, results[0].Event)
+	require.Len(t, b.Snapshot().Operations, 7)
+}
@@ -0,0 +1,48 @@
+// Package phabricator contains the Phabricator bridge implementation. It
+// import the Maniphest tasks of a project, with their timeline.
+package phabricator
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+const (
+	target = "phabricator"
+
+	metaKeyPhabricatorId      = "phabricator-id"
+	metaKeyPhabricatorUrl     = "phabricator-url"
+	metaKeyPhabricatorPHID    = "phabricator-phid"
+	metaKeyPhabricatorLogin   = "phabricator-login"
+	metaKeyPhabricatorBaseUrl = "phabricator-base-url"
+
+	confKeyBaseUrl      = "base-url"
+	confKeyProject      = "project"
+	confKeyProjectPHID  = "project-phid"
+	confKeyDefaultLogin = "default-login"
+
+	defaultTimeout = 60 * time.Second
+)
+
+var _ core.BridgeImpl = &Phabricator{}
+
+type Phabricator struct{}
+
+func (*Phabricator) Target() string {
+	return target
+}
+
+func (*Phabricator) LoginMetaKey() string {
+	return metaKeyPhabricatorLogin
+}
+
+func (*Phabricator) NewImporter() core.Importer {
+	return &phabricatorImporter{}
+}
+
+// NewExporter return nil, as Phabricator is end-of-life and the bridge is
+// meant as an exit path.
+func (*Phabricator) NewExporter() core.Exporter {
+	return nil
+}
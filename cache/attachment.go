@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"mime"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+// Attach store a file in git and attach it to the bug, under the given file
+// name. Its content type is detected from the name, or from the content
+// itself.
+func (c *BugCache) Attach(name string, data []byte) (*bug.AddAttachmentOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.AttachRaw(author, time.Now().Unix(), name, data, nil)
+}
+
+func (c *BugCache) AttachRaw(author *IdentityCache, unixTime int64, name string, data []byte, metadata map[string]string) (*bug.AddAttachmentOperation, error) {
+	if err := c.repoCache.checkAttachmentSize(data); err != nil {
+		return nil, err
+	}
+
+	hash, err := c.repoCache.repo.StoreData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	op, err := bug.AddAttachment(c.bug, author.Identity, unixTime, name, hash, int64(len(data)), detectContentType(name, data), metadata)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return op, c.notifyUpdated()
+}
+
+// ReadAttachment return the content of the last file attached with the given
+// name, or whose hash starts with the given prefix
+func (c *BugCache) ReadAttachment(nameOrHash string) (*bug.Attachment, []byte, error) {
+	attachment, err := c.Snapshot().SearchAttachment(nameOrHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := c.repoCache.repo.ReadData(attachment.Hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, data, nil
+}
+
+// detectContentType return the MIME type of a file, from the extension of its
+// name if known, or else from its first bytes
+func detectContentType(name string, data []byte) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(name)); contentType != "" {
+		return contentType
+	}
+	return http.DetectContentType(data)
+}
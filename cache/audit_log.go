@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"bufio"
+	"container/heap"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+)
+
+// AuditEntry is a single line of the audit log, describing one operation.
+// Each entry is linked to the previous one with a hash, forming a chain that
+// can't be altered without breaking all the following hashes.
+type AuditEntry struct {
+	Seq       int             `json:"seq"`
+	BugId     entity.Id       `json:"bug_id"`
+	OpId      entity.Id       `json:"op_id"`
+	AuthorId  entity.Id       `json:"author_id"`
+	Timestamp int64           `json:"timestamp"`
+	Signed    bool            `json:"signed"`
+	Operation json.RawMessage `json:"operation"`
+	Prev      string          `json:"prev"`
+	Hash      string          `json:"hash,omitempty"`
+}
+
+// computeHash return the hash of the entry, excluding the Hash field itself
+func (e AuditEntry) computeHash() (string, error) {
+	e.Hash = ""
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// AuditLog generate the audit log of all the operations of all the bugs.
+//
+// The log is deterministic: the same repository always produce the same log.
+// Operations are ordered by timestamp, while always keeping the order of the
+// operations within a bug. When new operations are added with a later
+// timestamp, the new log is an extension of the previous one.
+func (c *RepoCache) AuditLog() ([]AuditEntry, error) {
+	ids := c.AllBugsIds()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	h := &auditHeap{}
+
+	for _, id := range ids {
+		b, err := c.ResolveBug(id)
+		if err != nil {
+			return nil, err
+		}
+		ops := b.Snapshot().Operations
+		if len(ops) > 0 {
+			heap.Push(h, &auditCursor{bugId: id, ops: ops})
+		}
+	}
+
+	var result []AuditEntry
+	prev := ""
+
+	for h.Len() > 0 {
+		cursor := (*h)[0]
+		op := cursor.ops[cursor.index]
+
+		data, err := json.Marshal(op)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := AuditEntry{
+			Seq:       len(result),
+			BugId:     cursor.bugId,
+			OpId:      op.Id(),
+			AuthorId:  op.Author().Id(),
+			Timestamp: op.Time().Unix(),
+			Signed:    isSigned(op),
+			Operation: data,
+			Prev:      prev,
+		}
+
+		entry.Hash, err = entry.computeHash()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, entry)
+		prev = entry.Hash
+
+		cursor.index++
+		if cursor.index < len(cursor.ops) {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+
+	return result, nil
+}
+
+func isSigned(op dag.Operation) bool {
+	signed, ok := op.(interface{ Signed() bool })
+	return ok && signed.Signed()
+}
+
+// WriteAuditLog write the audit log as JSON lines.
+func WriteAuditLog(w io.Writer, entries []AuditEntry) error {
+	encoder := json.NewEncoder(w)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyAuditLog read an audit log in JSON lines and check that:
+// - the hash chain is intact
+// - each entry match the current state of the repository, given as the
+// entries generated with AuditLog. As the log is append-only, a previously
+// generated log must be a prefix of the current one.
+// The number of verified entries is returned.
+func VerifyAuditLog(r io.Reader, current []AuditEntry) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64*1024*1024)
+
+	prev := ""
+	count := 0
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return count, fmt.Errorf("entry %d: %v", count, err)
+		}
+
+		if entry.Seq != count {
+			return count, fmt.Errorf("entry %d: unexpected sequence number %d", count, entry.Seq)
+		}
+		if entry.Prev != prev {
+			return count, fmt.Errorf("entry %d: broken link to the previous entry", count)
+		}
+
+		hash, err := entry.computeHash()
+		if err != nil {
+			return count, err
+		}
+		if hash != entry.Hash {
+			return count, fmt.Errorf("entry %d: hash mismatch", count)
+		}
+
+		if count >= len(current) || current[count].Hash != entry.Hash {
+			return count, fmt.Errorf("entry %d: doesn't match the repository", count)
+		}
+
+		prev = entry.Hash
+		count++
+	}
+
+	return count, scanner.Err()
+}
+
+// auditCursor is the position of the next operation to log in a bug
+type auditCursor struct {
+	bugId entity.Id
+	ops   []dag.Operation
+	index int
+}
+
+// auditHeap order the bugs by the timestamp of their next operation to log
+type auditHeap []*auditCursor
+
+func (h auditHeap) Len() int { return len(h) }
+
+func (h auditHeap) Less(i, j int) bool {
+	ti := h[i].ops[h[i].index].Time()
+	tj := h[j].ops[h[j].index].Time()
+	if !ti.Equal(tj) {
+		return ti.Before(tj)
+	}
+	return h[i].bugId < h[j].bugId
+}
+
+func (h auditHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *auditHeap) Push(x interface{}) { *h = append(*h, x.(*auditCursor)) }
+
+func (h *auditHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/policy"
+)
+
+// autoAssignable is a bug on which the auto-assign policies can be evaluated,
+// like a bug.Bug or a bug.WithSnapshot
+type autoAssignable interface {
+	bug.Interface
+	Replay(fn func(before *bug.Snapshot, op bug.Operation)) *bug.Snapshot
+}
+
+// autoAssign append to b an operation assigning the identities required by
+// the auto-assign policies, if any. It is evaluated when label changes are
+// committed or merged.
+//
+// A label applied by an operation without the policy.MetaKeyNoAutoAssign
+// metadata requires the assignee of the policy, unless the assignee has
+// been assigned or unassigned since. Policies whose assignee can't be
+// resolved to a single identity are ignored.
+func (c *RepoCache) autoAssign(b autoAssignable, author identity.Interface, unixTime int64) (*bug.SetAssigneeOperation, error) {
+	policies, err := policy.Load(c.repo.AnyConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(map[string]*policy.AutoAssignRule)
+	for _, p := range policies {
+		if rule, ok := p.Rule.(*policy.AutoAssignRule); ok {
+			rules[p.Name] = rule
+		}
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	// the identities required, with the name of the policies requiring them
+	pending := make(map[entity.Id][]string)
+
+	snap := b.Replay(func(before *bug.Snapshot, op bug.Operation) {
+		switch op := op.(type) {
+		case *bug.LabelChangeOperation:
+			for name, rule := range rules {
+				if !rule.Triggered(before, op) {
+					continue
+				}
+				id, err := c.identities.resolveMatcher(func(excerpt *IdentityExcerpt) bool {
+					return excerpt.Login == rule.Assignee || excerpt.Id.HasPrefix(rule.Assignee)
+				})
+				if err != nil {
+					continue
+				}
+				pending[id] = append(pending[id], name)
+			}
+		case *bug.SetAssigneeOperation:
+			for _, id := range op.Added {
+				delete(pending, id)
+			}
+			for _, id := range op.Removed {
+				delete(pending, id)
+			}
+		}
+	})
+
+	for _, id := range snap.Assignees {
+		delete(pending, id)
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	added := make([]entity.Id, 0, len(pending))
+	var names []string
+	for id, policies := range pending {
+		added = append(added, id)
+		names = append(names, policies...)
+	}
+	sort.Slice(added, func(i, j int) bool { return added[i] < added[j] })
+	sort.Strings(names)
+
+	return bug.SetAssignee(b, author, unixTime, added, nil, map[string]string{
+		policy.MetaKeyAutoAssignPolicy: strings.Join(names, ","),
+	})
+}
+
+// hasStagedLabelChange return true if a label change is waiting to be committed
+func hasStagedLabelChange(b *bug.Bug) bool {
+	for _, op := range b.StagedOperations() {
+		if _, ok := op.(*bug.LabelChangeOperation); ok {
+			return true
+		}
+	}
+	return false
+}
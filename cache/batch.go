@@ -0,0 +1,75 @@
+package cache
+
+import "sync"
+
+// batch is the state of the batch mode, see StartBatch
+type batch struct {
+	mu     sync.Mutex
+	active bool
+	// the number of updates between two writes of the cache files
+	flushEvery int
+	// the number of updates not written yet
+	pending int
+	// the maximum number of loaded bugs to restore at the end of the batch
+	savedMaxLoadedBugs int
+	// the journal entries of the mutations done but not written yet
+	journaled []journalEntry
+}
+
+// StartBatch enter the batch mode, meant for long running writers touching a
+// large number of bugs, like the import of a bug tracker. The memory used
+// stays bounded whatever the number of bugs:
+//   - the cache files are written every flushEvery updates instead of after
+//     each of them, which otherwise cost a full rewrite each time
+//   - at most maxLoadedBugs bugs are kept loaded in memory
+//
+// The returned function end the batch and write the cache files. It must be
+// called even if the batch failed, to not lose the updates not written yet.
+func (c *RepoCache) StartBatch(flushEvery int, maxLoadedBugs int) func() error {
+	if flushEvery < 1 {
+		flushEvery = 1
+	}
+
+	c.batch.mu.Lock()
+	c.batch.active = true
+	c.batch.flushEvery = flushEvery
+	c.batch.pending = 0
+	c.batch.savedMaxLoadedBugs = c.maxLoadedBugs
+	c.batch.mu.Unlock()
+
+	if maxLoadedBugs > 0 && maxLoadedBugs < c.maxLoadedBugs {
+		c.setCacheSize(maxLoadedBugs)
+	}
+
+	return func() error {
+		c.batch.mu.Lock()
+		c.batch.active = false
+		c.batch.pending = 0
+		c.batch.mu.Unlock()
+
+		c.setCacheSize(c.batch.savedMaxLoadedBugs)
+
+		return c.write()
+	}
+}
+
+// writeAfterUpdate write the cache files after an update of a bug or an
+// identity, using write. In batch mode, all the cache files are only written
+// every few updates instead.
+func (c *RepoCache) writeAfterUpdate(write func() error) error {
+	c.batch.mu.Lock()
+	if !c.batch.active {
+		c.batch.mu.Unlock()
+		return write()
+	}
+
+	c.batch.pending++
+	if c.batch.pending < c.batch.flushEvery {
+		c.batch.mu.Unlock()
+		return nil
+	}
+	c.batch.pending = 0
+	c.batch.mu.Unlock()
+
+	return c.write()
+}
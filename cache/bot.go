@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// git config prefix of the hashes of the API tokens of the bots, as in
+// "git-bug.bot-token.<bot id>.hash"
+const botTokenConfigPrefix = "git-bug.bot-token."
+
+func botTokenConfigKey(id entity.Id) string {
+	return botTokenConfigPrefix + id.String() + ".hash"
+}
+
+// ErrInvalidBotToken is returned when an API token doesn't match any bot
+var ErrInvalidBotToken = errors.New("invalid bot token")
+
+// NewBotIdentity create and commit a new bot identity. A bot can't be the
+// user identity: it authors operations only through an API token, as given
+// by NewBotToken.
+func (c *RepoCache) NewBotIdentity(name string) (*IdentityCache, error) {
+	i, err := identity.NewBotIdentity(c.repo, name)
+	if err != nil {
+		return nil, err
+	}
+	return c.finishIdentity(i, nil)
+}
+
+// NewBotToken generate a new API token for a bot, replacing the previous one
+// if any. Only a hash of the token is stored, in the local git config: the
+// token can't be read again.
+func (c *RepoCache) NewBotToken(id entity.Id) (string, error) {
+	if err := c.checkWritable(); err != nil {
+		return "", err
+	}
+
+	bot, err := c.ResolveIdentityExcerpt(id)
+	if err != nil {
+		return "", err
+	}
+	if !bot.IsBot() {
+		return "", fmt.Errorf("%s is not a bot", bot.DisplayName())
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(raw)
+
+	err = c.repo.LocalConfig().StoreString(botTokenConfigKey(id), hashBotToken(token))
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// RevokeBotToken remove the API token of a bot, if any
+func (c *RepoCache) RevokeBotToken(id entity.Id) error {
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+
+	_, err := c.repo.LocalConfig().ReadString(botTokenConfigKey(id))
+	if err == repository.ErrNoConfigEntry {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.repo.LocalConfig().RemoveAll(botTokenConfigPrefix + id.String())
+}
+
+// ResolveBotToken return the bot identity an API token belongs to, or
+// ErrInvalidBotToken.
+func (c *RepoCache) ResolveBotToken(token string) (*IdentityCache, error) {
+	hashes, err := c.repo.LocalConfig().ReadAll(botTokenConfigPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := hashBotToken(token)
+	for key, stored := range hashes {
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(stored)) != 1 {
+			continue
+		}
+
+		id := strings.TrimSuffix(strings.TrimPrefix(key, botTokenConfigPrefix), ".hash")
+		i, err := c.ResolveIdentity(entity.Id(id))
+		if err != nil {
+			return nil, err
+		}
+		if !i.IsBot() {
+			return nil, ErrInvalidBotToken
+		}
+		return i, nil
+	}
+
+	return nil, ErrInvalidBotToken
+}
+
+func hashBotToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ResolveBotToken return the bot identity an API token belongs to, looking in
+// every repository, or ErrInvalidBotToken.
+func (c *MultiRepoCache) ResolveBotToken(token string) (*IdentityCache, error) {
+	for _, r := range c.repos {
+		i, err := r.ResolveBotToken(token)
+		if err == ErrInvalidBotToken {
+			continue
+		}
+		return i, err
+	}
+	return nil, ErrInvalidBotToken
+}
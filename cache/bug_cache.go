@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/entity/dag"
 	"github.com/MichaelMure/git-bug/repository"
@@ -13,6 +14,14 @@ import (
 
 var ErrNoMatchingOp = fmt.Errorf("no matching operation found")
 
+// ErrBugRemoved is returned when committing a bug removed in the meantime,
+// which would otherwise create it again
+var ErrBugRemoved = fmt.Errorf("the bug has been removed")
+
+// ErrBugNotCreatedYet is returned when asking for the state of a bug before
+// its creation
+var ErrBugNotCreatedYet = fmt.Errorf("the bug didn't exist yet")
+
 // BugCache is a wrapper around a Bug. It provides multiple functions:
 //
 // 1. Provide a higher level API to use than the raw API from Bug.
@@ -22,19 +31,91 @@ type BugCache struct {
 	repoCache *RepoCache
 	mu        sync.RWMutex
 	bug       *bug.WithSnapshot
+	// number of operations already notified in a BugUpdated event
+	notifiedOps int
+	// estimated memory used, as accounted in the cache
+	size int64
+	// number of pins preventing the eviction, guarded by the cache's muBug
+	pins int
+	// the bug has been removed, it can't be committed anymore
+	removed bool
 }
 
 func NewBugCache(repoCache *RepoCache, b *bug.Bug) *BugCache {
 	return &BugCache{
-		repoCache: repoCache,
-		bug:       &bug.WithSnapshot{Bug: b},
+		repoCache:   repoCache,
+		bug:         &bug.WithSnapshot{Bug: b},
+		notifiedOps: len(b.Operations()),
 	}
 }
 
 func (c *BugCache) Snapshot() *bug.Snapshot {
+	c.mu.RLock()
+	snap, ok := c.bug.SharedSnapshot()
+	c.mu.RUnlock()
+	if ok {
+		return snap
+	}
+
+	// compiling modify the bug, it needs the exclusive lock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.compile()
+}
+
+// compile return the snapshot of the bug, reusing the one compiled
+// previously for the same operations if any.
+// c.mu must be held for writing.
+func (c *BugCache) compile() *bug.Snapshot {
+	if snap, ok := c.bug.SharedSnapshot(); ok {
+		return snap
+	}
+	if snap, ok := c.repoCache.snapshots.get(c.bug); ok {
+		c.bug.UseSnapshot(snap)
+		return snap
+	}
+	snap := c.bug.Compile()
+	c.repoCache.snapshots.add(c.bug, snap)
+	return snap
+}
+
+// Replay compile the bug operation by operation, calling fn with the state of
+// the bug before each operation is applied. Nothing is written.
+func (c *BugCache) Replay(fn func(before *bug.Snapshot, op bug.Operation)) *bug.Snapshot {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.bug.Replay(fn)
+}
+
+// SnapshotAt return the state of the bug at the given time, compiled with its
+// operations up to the first one made after that time. Nothing is written.
+func (c *BugCache) SnapshotAt(t time.Time) (*bug.Snapshot, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	return c.bug.Compile()
+
+	ops := c.bug.Operations()
+	n := 0
+	for n < len(ops) && !ops[n].Time().After(t) {
+		n++
+	}
+	if n == 0 {
+		return nil, ErrBugNotCreatedYet
+	}
+	return c.bug.CompilePrefix(n), nil
+}
+
+// SnapshotAtOperation return the state of the bug right after the given
+// operation has been applied. Nothing is written.
+func (c *BugCache) SnapshotAtOperation(opId entity.Id) (*bug.Snapshot, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for i, op := range c.bug.Operations() {
+		if op.Id() == opId {
+			return c.bug.CompilePrefix(i + 1), nil
+		}
+	}
+	return nil, ErrNoMatchingOp
 }
 
 func (c *BugCache) Id() entity.Id {
@@ -84,6 +165,10 @@ func (c *BugCache) AddCommentWithFiles(message string, files []repository.Hash)
 }
 
 func (c *BugCache) AddCommentRaw(author *IdentityCache, unixTime int64, message string, files []repository.Hash, metadata map[string]string) (entity.CombinedId, *bug.AddCommentOperation, error) {
+	if err := c.repoCache.checkMessageSize(message); err != nil {
+		return entity.UnsetCombinedId, nil, err
+	}
+
 	c.mu.Lock()
 	commentId, op, err := bug.AddComment(c.bug, author, unixTime, message, files, metadata)
 	c.mu.Unlock()
@@ -131,12 +216,63 @@ func (c *BugCache) ForceChangeLabelsRaw(author *IdentityCache, unixTime int64, a
 	return op, c.notifyUpdated()
 }
 
+func (c *BugCache) SetAssignee(added []entity.Id, removed []entity.Id) (*bug.SetAssigneeOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetAssigneeRaw(author, time.Now().Unix(), added, removed, nil)
+}
+
+func (c *BugCache) SetAssigneeRaw(author *IdentityCache, unixTime int64, added []entity.Id, removed []entity.Id, metadata map[string]string) (*bug.SetAssigneeOperation, error) {
+	c.mu.Lock()
+	op, err := bug.SetAssignee(c.bug, author.Identity, unixTime, added, removed, metadata)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return op, c.notifyUpdated()
+}
+
+// SetField set the value of a custom field, or unset it with an empty value
+func (c *BugCache) SetField(name string, value string) (*bug.SetFieldOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetFieldRaw(author, time.Now().Unix(), name, value, nil)
+}
+
+func (c *BugCache) SetFieldRaw(author *IdentityCache, unixTime int64, name string, value string, metadata map[string]string) (*bug.SetFieldOperation, error) {
+	defs, err := c.repoCache.FieldDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.repoCache.validateField(defs, name, value); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	op, err := bug.SetField(c.bug, author.Identity, unixTime, name, value, metadata)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return op, c.notifyUpdated()
+}
+
 func (c *BugCache) Open() (*bug.SetStatusOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
 		return nil, err
 	}
 
+	if err := c.ValidateStatusChange(common.OpenStatus, ""); err != nil {
+		return nil, err
+	}
+
 	return c.OpenRaw(author, time.Now().Unix(), nil)
 }
 
@@ -150,13 +286,34 @@ func (c *BugCache) OpenRaw(author *IdentityCache, unixTime int64, metadata map[s
 	return op, c.notifyUpdated()
 }
 
+// Close close the bug. If the bug is protected by the freeze mode, an
+// ErrFrozen is returned; CloseConfirmed must be used instead.
 func (c *BugCache) Close() (*bug.SetStatusOperation, error) {
+	return c.close(false)
+}
+
+// CloseConfirmed close the bug, confirming the closing if the bug is protected
+// by the freeze mode
+func (c *BugCache) CloseConfirmed() (*bug.SetStatusOperation, error) {
+	return c.close(true)
+}
+
+func (c *BugCache) close(confirmed bool) (*bug.SetStatusOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
 		return nil, err
 	}
 
-	return c.CloseRaw(author, time.Now().Unix(), nil)
+	if err := c.ValidateStatusChange(common.ClosedStatus, ""); err != nil {
+		return nil, err
+	}
+
+	metadata, err := c.FreezeMetadata(author, confirmed)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CloseRaw(author, time.Now().Unix(), metadata)
 }
 
 func (c *BugCache) CloseRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.SetStatusOperation, error) {
@@ -169,6 +326,37 @@ func (c *BugCache) CloseRaw(author *IdentityCache, unixTime int64, metadata map[
 	return op, c.notifyUpdated()
 }
 
+func (c *BugCache) RequestInfo() (*bug.RequestInfoOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.RequestInfoRaw(author, time.Now().Unix(), nil)
+}
+
+func (c *BugCache) RequestInfoRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.RequestInfoOperation, error) {
+	c.mu.Lock()
+	op, err := bug.RequestInfo(c.bug, author.Identity, unixTime, metadata)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return op, c.notifyUpdated()
+}
+
+// RecordSyncConflictRaw annotate the bug with a synchronisation conflict that
+// a bridge couldn't resolve automatically
+func (c *BugCache) RecordSyncConflictRaw(author *IdentityCache, unixTime int64, bridge, field, local, remote string, winner bug.SyncSide, metadata map[string]string) (*bug.SyncConflictOperation, error) {
+	c.mu.Lock()
+	op, err := bug.RecordSyncConflict(c.bug, author.Identity, unixTime, bridge, field, local, remote, winner, metadata)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return op, c.notifyUpdated()
+}
+
 func (c *BugCache) SetTitle(title string) (*bug.SetTitleOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
@@ -200,6 +388,10 @@ func (c *BugCache) EditCreateComment(body string) (entity.CombinedId, *bug.EditC
 
 // EditCreateCommentRaw is a convenience function to edit the body of a bug (the first comment)
 func (c *BugCache) EditCreateCommentRaw(author *IdentityCache, unixTime int64, body string, metadata map[string]string) (entity.CombinedId, *bug.EditCommentOperation, error) {
+	if err := c.repoCache.checkMessageSize(body); err != nil {
+		return entity.UnsetCombinedId, nil, err
+	}
+
 	c.mu.Lock()
 	commentId, op, err := bug.EditCreateComment(c.bug, author.Identity, unixTime, body, nil, metadata)
 	c.mu.Unlock()
@@ -219,6 +411,10 @@ func (c *BugCache) EditComment(target entity.CombinedId, message string) (*bug.E
 }
 
 func (c *BugCache) EditCommentRaw(author *IdentityCache, unixTime int64, target entity.CombinedId, message string, metadata map[string]string) (*bug.EditCommentOperation, error) {
+	if err := c.repoCache.checkMessageSize(message); err != nil {
+		return nil, err
+	}
+
 	comment, err := c.Snapshot().SearchComment(target)
 	if err != nil {
 		return nil, err
@@ -236,6 +432,150 @@ func (c *BugCache) EditCommentRaw(author *IdentityCache, unixTime int64, target
 	return op, c.notifyUpdated()
 }
 
+// RedactComment hide the content of a comment, and its previous versions
+func (c *BugCache) RedactComment(target entity.CombinedId) (*bug.RedactCommentOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.RedactCommentRaw(author, time.Now().Unix(), target, nil)
+}
+
+func (c *BugCache) RedactCommentRaw(author *IdentityCache, unixTime int64, target entity.CombinedId, metadata map[string]string) (*bug.RedactCommentOperation, error) {
+	return c.redactCommentRaw(author, unixTime, target, false, metadata)
+}
+
+// DeleteComment remove a comment from the bug, only its tombstone being left
+// in the timeline
+func (c *BugCache) DeleteComment(target entity.CombinedId) (*bug.RedactCommentOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.DeleteCommentRaw(author, time.Now().Unix(), target, nil)
+}
+
+func (c *BugCache) DeleteCommentRaw(author *IdentityCache, unixTime int64, target entity.CombinedId, metadata map[string]string) (*bug.RedactCommentOperation, error) {
+	return c.redactCommentRaw(author, unixTime, target, true, metadata)
+}
+
+func (c *BugCache) redactCommentRaw(author *IdentityCache, unixTime int64, target entity.CombinedId, delete bool, metadata map[string]string) (*bug.RedactCommentOperation, error) {
+	comment, err := c.Snapshot().SearchComment(target)
+	if err != nil {
+		return nil, err
+	}
+
+	redact := bug.RedactComment
+	if delete {
+		redact = bug.DeleteComment
+	}
+
+	c.mu.Lock()
+	commentId, op, err := redact(c.bug, author.Identity, unixTime, comment.TargetId(), metadata)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if commentId != target {
+		panic("RedactComment returned unexpected comment id")
+	}
+	return op, c.notifyUpdated()
+}
+
+// AddReaction add the reaction of the user to a comment
+func (c *BugCache) AddReaction(target entity.CombinedId, reaction bug.Reaction) (*bug.ReactionOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.AddReactionRaw(author, time.Now().Unix(), target, reaction, nil)
+}
+
+func (c *BugCache) AddReactionRaw(author *IdentityCache, unixTime int64, target entity.CombinedId, reaction bug.Reaction, metadata map[string]string) (*bug.ReactionOperation, error) {
+	return c.reactRaw(author, unixTime, target, reaction, false, metadata)
+}
+
+// RemoveReaction remove the reaction of the user to a comment
+func (c *BugCache) RemoveReaction(target entity.CombinedId, reaction bug.Reaction) (*bug.ReactionOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.RemoveReactionRaw(author, time.Now().Unix(), target, reaction, nil)
+}
+
+func (c *BugCache) RemoveReactionRaw(author *IdentityCache, unixTime int64, target entity.CombinedId, reaction bug.Reaction, metadata map[string]string) (*bug.ReactionOperation, error) {
+	return c.reactRaw(author, unixTime, target, reaction, true, metadata)
+}
+
+func (c *BugCache) reactRaw(author *IdentityCache, unixTime int64, target entity.CombinedId, reaction bug.Reaction, remove bool, metadata map[string]string) (*bug.ReactionOperation, error) {
+	comment, err := c.Snapshot().SearchComment(target)
+	if err != nil {
+		return nil, err
+	}
+
+	react := bug.AddReaction
+	if remove {
+		react = bug.RemoveReaction
+	}
+
+	c.mu.Lock()
+	op, err := react(c.bug, author.Identity, unixTime, comment.TargetId(), reaction, metadata)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return op, c.notifyUpdated()
+}
+
+// AddRelation link the bug to another one
+func (c *BugCache) AddRelation(relType bug.RelationType, target entity.Id) (*bug.RelationAddOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.AddRelationRaw(author, time.Now().Unix(), relType, target, nil)
+}
+
+func (c *BugCache) AddRelationRaw(author *IdentityCache, unixTime int64, relType bug.RelationType, target entity.Id, metadata map[string]string) (*bug.RelationAddOperation, error) {
+	if target == c.Id() {
+		return nil, fmt.Errorf("a bug can't be related to itself")
+	}
+
+	c.mu.Lock()
+	op, err := bug.AddRelation(c.bug, author.Identity, unixTime, relType, target, metadata)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return op, c.notifyUpdated()
+}
+
+// RemoveRelation remove a link from the bug to another one
+func (c *BugCache) RemoveRelation(relType bug.RelationType, target entity.Id) (*bug.RelationRemoveOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.RemoveRelationRaw(author, time.Now().Unix(), relType, target, nil)
+}
+
+func (c *BugCache) RemoveRelationRaw(author *IdentityCache, unixTime int64, relType bug.RelationType, target entity.Id, metadata map[string]string) (*bug.RelationRemoveOperation, error) {
+	c.mu.Lock()
+	op, err := bug.RemoveRelation(c.bug, author.Identity, unixTime, relType, target, metadata)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return op, c.notifyUpdated()
+}
+
 func (c *BugCache) SetMetadata(target entity.Id, newMetadata map[string]string) (*dag.SetMetadataOperation[*bug.Snapshot], error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
@@ -256,25 +596,92 @@ func (c *BugCache) SetMetadataRaw(author *IdentityCache, unixTime int64, target
 }
 
 func (c *BugCache) Commit() error {
+	if err := c.repoCache.checkWritable(); err != nil {
+		return err
+	}
+
 	c.mu.Lock()
-	err := c.bug.Commit(c.repoCache.repo)
+	err := c.commitStaged()
+	c.mu.Unlock()
 	if err != nil {
-		c.mu.Unlock()
 		return err
 	}
-	c.mu.Unlock()
-	return c.notifyUpdated()
+	err = c.notifyUpdated()
+	if err != nil {
+		return err
+	}
+	return c.repoCache.journalEnd(bugJournalKind, c.Id())
+}
+
+// commitStaged validate and write the staged operations. The journal entry
+// has to be ended once the cache is updated.
+// c.mu must be locked.
+func (c *BugCache) commitStaged() error {
+	if c.removed {
+		return ErrBugRemoved
+	}
+	err := c.repoCache.validateStagedFields(c.bug.Bug)
+	if err != nil {
+		return err
+	}
+	err = c.stageAutoAssign()
+	if err != nil {
+		return err
+	}
+	err = c.repoCache.journalBegin(bugJournalKind, c.bug.Id())
+	if err != nil {
+		return err
+	}
+	return c.bug.Commit(c.repoCache.repo)
 }
 
 func (c *BugCache) CommitAsNeeded() error {
+	if err := c.repoCache.checkWritable(); err != nil {
+		return err
+	}
+
 	c.mu.Lock()
-	err := c.bug.CommitAsNeeded(c.repoCache.repo)
+	if c.removed {
+		c.mu.Unlock()
+		return ErrBugRemoved
+	}
+	err := c.repoCache.validateStagedFields(c.bug.Bug)
+	if err != nil {
+		c.mu.Unlock()
+		return err
+	}
+	err = c.stageAutoAssign()
+	if err != nil {
+		c.mu.Unlock()
+		return err
+	}
+	err = c.repoCache.journalBegin(bugJournalKind, c.bug.Id())
+	if err != nil {
+		c.mu.Unlock()
+		return err
+	}
+	err = c.bug.CommitAsNeeded(c.repoCache.repo)
 	if err != nil {
 		c.mu.Unlock()
 		return err
 	}
 	c.mu.Unlock()
-	return c.notifyUpdated()
+	err = c.notifyUpdated()
+	if err != nil {
+		return err
+	}
+	return c.repoCache.journalEnd(bugJournalKind, c.Id())
+}
+
+// stageAutoAssign append the assignments required by the auto-assign
+// policies if label changes are about to be committed. They are authored by
+// the author of the last operation. c.mu must be locked.
+func (c *BugCache) stageAutoAssign() error {
+	if !hasStagedLabelChange(c.bug.Bug) {
+		return nil
+	}
+	_, err := c.repoCache.autoAssign(c.bug, c.bug.LastOp().Author(), time.Now().Unix())
+	return err
 }
 
 func (c *BugCache) NeedCommit() bool {
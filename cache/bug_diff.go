@@ -0,0 +1,226 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// SnapshotDiff is the set of changes between two states of a bug, each one
+// taken right after an operation
+type SnapshotDiff struct {
+	From entity.Id
+	To   entity.Id
+
+	// Title is the change of title, if any
+	Title *TitleChange
+	// Status is the change of status or of workflow state, if any
+	Status *StatusChange
+
+	LabelsAdded   []bug.Label
+	LabelsRemoved []bug.Label
+
+	AssigneesAdded   []entity.Id
+	AssigneesRemoved []entity.Id
+
+	// Fields are the custom fields changed, sorted by name
+	Fields []FieldChange
+
+	RelationsAdded   []bug.Relation
+	RelationsRemoved []bug.Relation
+
+	// CommentsAdded are the new comments, in order
+	CommentsAdded []bug.Comment
+	// CommentsEdited are the comments whose message changed, redacted
+	// included, in order
+	CommentsEdited []CommentChange
+	// CommentsRemoved are the deleted comments, in order
+	CommentsRemoved []bug.Comment
+
+	AttachmentsAdded []bug.Attachment
+}
+
+type TitleChange struct {
+	From string
+	To   string
+}
+
+type StatusChange struct {
+	From      common.Status
+	FromState string
+	To        common.Status
+	ToState   string
+}
+
+// FieldChange is the change of a custom field, with an empty value when the
+// field is not set
+type FieldChange struct {
+	Name string
+	From string
+	To   string
+}
+
+type CommentChange struct {
+	// Comment is the comment after the change
+	Comment bug.Comment
+	// From is the message before the change
+	From string
+}
+
+// IsEmpty return true if nothing changed
+func (d *SnapshotDiff) IsEmpty() bool {
+	return d.Title == nil && d.Status == nil &&
+		len(d.LabelsAdded) == 0 && len(d.LabelsRemoved) == 0 &&
+		len(d.AssigneesAdded) == 0 && len(d.AssigneesRemoved) == 0 &&
+		len(d.Fields) == 0 &&
+		len(d.RelationsAdded) == 0 && len(d.RelationsRemoved) == 0 &&
+		len(d.CommentsAdded) == 0 && len(d.CommentsEdited) == 0 && len(d.CommentsRemoved) == 0 &&
+		len(d.AttachmentsAdded) == 0
+}
+
+// ResolveOperationPrefix find the operation of the bug matching an id prefix
+func (c *BugCache) ResolveOperationPrefix(prefix string) (entity.Id, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var matching []entity.Id
+	for _, op := range c.bug.Operations() {
+		if op.Id().HasPrefix(prefix) {
+			matching = append(matching, op.Id())
+		}
+	}
+
+	switch len(matching) {
+	case 0:
+		return "", ErrNoMatchingOp
+	case 1:
+		return matching[0], nil
+	default:
+		return "", bug.NewErrMultipleMatchOp(matching)
+	}
+}
+
+// DiffSnapshots compute the changes between the state of the bug right after
+// the operation from, and the one right after the operation to, which can't
+// be before.
+func (c *BugCache) DiffSnapshots(from, to entity.Id) (*SnapshotDiff, error) {
+	c.mu.RLock()
+	fromIndex, toIndex := -1, -1
+	for i, op := range c.bug.Operations() {
+		if op.Id() == from {
+			fromIndex = i
+		}
+		if op.Id() == to {
+			toIndex = i
+		}
+	}
+	c.mu.RUnlock()
+
+	if fromIndex < 0 || toIndex < 0 {
+		return nil, ErrNoMatchingOp
+	}
+	if fromIndex > toIndex {
+		return nil, fmt.Errorf("operation %s is after operation %s", from.Human(), to.Human())
+	}
+
+	fromSnap, err := c.SnapshotAtOperation(from)
+	if err != nil {
+		return nil, err
+	}
+	toSnap, err := c.SnapshotAtOperation(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := diffSnapshots(fromSnap, toSnap)
+	diff.From = from
+	diff.To = to
+	return diff, nil
+}
+
+func diffSnapshots(from, to *bug.Snapshot) *SnapshotDiff {
+	diff := &SnapshotDiff{}
+
+	if from.Title != to.Title {
+		diff.Title = &TitleChange{From: from.Title, To: to.Title}
+	}
+	if from.Status != to.Status || from.State != to.State {
+		diff.Status = &StatusChange{
+			From:      from.Status,
+			FromState: from.State,
+			To:        to.Status,
+			ToState:   to.State,
+		}
+	}
+
+	diff.LabelsAdded, diff.LabelsRemoved = diffSets(from.Labels, to.Labels)
+	diff.AssigneesAdded, diff.AssigneesRemoved = diffSets(from.Assignees, to.Assignees)
+	diff.RelationsAdded, diff.RelationsRemoved = diffSets(from.Relations, to.Relations)
+
+	for name, value := range to.Fields {
+		if from.Fields[name] != value {
+			diff.Fields = append(diff.Fields, FieldChange{Name: name, From: from.Fields[name], To: value})
+		}
+	}
+	for name, value := range from.Fields {
+		if _, ok := to.Fields[name]; !ok {
+			diff.Fields = append(diff.Fields, FieldChange{Name: name, From: value})
+		}
+	}
+	sort.Slice(diff.Fields, func(i, j int) bool {
+		return diff.Fields[i].Name < diff.Fields[j].Name
+	})
+
+	fromComments := make(map[entity.CombinedId]bug.Comment, len(from.Comments))
+	for _, comment := range from.Comments {
+		fromComments[comment.CombinedId()] = comment
+	}
+	toComments := make(map[entity.CombinedId]struct{}, len(to.Comments))
+	for _, comment := range to.Comments {
+		toComments[comment.CombinedId()] = struct{}{}
+		previous, ok := fromComments[comment.CombinedId()]
+		switch {
+		case !ok:
+			diff.CommentsAdded = append(diff.CommentsAdded, comment)
+		case previous.Message != comment.Message:
+			diff.CommentsEdited = append(diff.CommentsEdited, CommentChange{Comment: comment, From: previous.Message})
+		}
+	}
+	for _, comment := range from.Comments {
+		if _, ok := toComments[comment.CombinedId()]; !ok {
+			diff.CommentsRemoved = append(diff.CommentsRemoved, comment)
+		}
+	}
+
+	// attachments are only added
+	if len(to.Attachments) > len(from.Attachments) {
+		diff.AttachmentsAdded = to.Attachments[len(from.Attachments):]
+	}
+
+	return diff
+}
+
+// diffSets return the values added and removed between two sets, in the
+// order of the sets
+func diffSets[T comparable](from, to []T) (added, removed []T) {
+	fromSet := make(map[T]struct{}, len(from))
+	for _, v := range from {
+		fromSet[v] = struct{}{}
+	}
+	toSet := make(map[T]struct{}, len(to))
+	for _, v := range to {
+		toSet[v] = struct{}{}
+		if _, ok := fromSet[v]; !ok {
+			added = append(added, v)
+		}
+	}
+	for _, v := range from {
+		if _, ok := toSet[v]; !ok {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/MichaelMure/git-bug/entities/identity"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/lamport"
+	"github.com/MichaelMure/git-bug/util/render"
 )
 
 // Package initialisation used to register the type for (de)serialization
@@ -19,6 +20,11 @@ func init() {
 
 // BugExcerpt hold a subset of the bug values to be able to sort and filter bugs
 // efficiently without having to read and compile each raw bugs.
+//
+// A typed value of the bugs (priority, milestone, due date, assignee ...) must
+// be added here before being used to filter or sort, along with a bump of
+// formatVersion and a cacheMigration deriving it, so that listing the bugs
+// never has to load them. TestQueryBugsExcerptOnly check that.
 type BugExcerpt struct {
 	Id entity.Id
 
@@ -26,6 +32,9 @@ type BugExcerpt struct {
 	EditLamportTime   lamport.Time
 	CreateUnixTime    int64
 	EditUnixTime      int64
+	// LastCommentUnixTime is the creation time of the last comment, or of the
+	// description if there is no comment
+	LastCommentUnixTime int64
 
 	AuthorId     entity.Id
 	Status       common.Status
@@ -35,6 +44,39 @@ type BugExcerpt struct {
 	Actors       []entity.Id
 	Participants []entity.Id
 
+	// AwaitingReporter is true when more information has been requested from the reporter
+	AwaitingReporter bool
+
+	// Fields are the values of the custom fields, by name
+	Fields map[string]string
+
+	// SyncConflict is true when a bridge recorded a conflict it couldn't resolve
+	SyncConflict bool
+
+	// Confidential is true when at least one operation is flagged as confidential
+	Confidential bool
+
+	// Activity record who authored, commented on and closed the bug
+	Activity []ActivityEvent
+
+	// DuplicateOf is the id of the canonical bug, when the bug has been merged
+	// into it as a duplicate
+	DuplicateOf entity.Id
+
+	// Relations are the links from the bug to other bugs
+	Relations []bug.Relation
+
+	// Assignees are the ids of the identities the bug is assigned to, sorted
+	Assignees []entity.Id
+
+	// State is the state of the workflow the bug was last moved to, if
+	// recorded. See bug.Workflow.StateOf.
+	State string
+
+	// References are the bug id prefixes referenced with #prefix in the
+	// description and the comments, to find the backlinks of the bugs
+	References []string
+
 	CreateMetadata map[string]string
 }
 
@@ -69,18 +111,29 @@ func NewBugExcerpt(b bug.Interface, snap *bug.Snapshot) *BugExcerpt {
 	}
 
 	e := &BugExcerpt{
-		Id:                b.Id(),
-		CreateLamportTime: b.CreateLamportTime(),
-		EditLamportTime:   b.EditLamportTime(),
-		CreateUnixTime:    b.FirstOp().Time().Unix(),
-		EditUnixTime:      snap.EditTime().Unix(),
-		Status:            snap.Status,
-		Labels:            snap.Labels,
-		Actors:            actorsIds,
-		Participants:      participantsIds,
-		Title:             snap.Title,
-		LenComments:       len(snap.Comments),
-		CreateMetadata:    b.FirstOp().AllMetadata(),
+		Id:                  b.Id(),
+		CreateLamportTime:   b.CreateLamportTime(),
+		EditLamportTime:     b.EditLamportTime(),
+		CreateUnixTime:      b.FirstOp().Time().Unix(),
+		EditUnixTime:        snap.EditTime().Unix(),
+		LastCommentUnixTime: lastCommentUnixTime(snap),
+		Status:              snap.Status,
+		State:               snap.State,
+		Labels:              snap.Labels,
+		Actors:              actorsIds,
+		Participants:        participantsIds,
+		Title:               snap.Title,
+		LenComments:         len(snap.Comments),
+		AwaitingReporter:    snap.AwaitingReporter,
+		Fields:              snap.Fields,
+		SyncConflict:        len(snap.SyncConflicts) > 0,
+		Confidential:        snap.HasConfidential(),
+		Activity:            activityEvents(snap),
+		DuplicateOf:         DuplicateOf(snap),
+		Relations:           snap.Relations,
+		Assignees:           snap.Assignees,
+		References:          bugReferences(snap),
+		CreateMetadata:      b.FirstOp().AllMetadata(),
 	}
 
 	switch snap.Author.(type) {
@@ -93,6 +146,30 @@ func NewBugExcerpt(b bug.Interface, snap *bug.Snapshot) *BugExcerpt {
 	return e
 }
 
+// lastCommentUnixTime return the creation time of the last comment of a bug
+func lastCommentUnixTime(snap *bug.Snapshot) int64 {
+	if len(snap.Comments) == 0 {
+		return 0
+	}
+	return int64(snap.Comments[len(snap.Comments)-1].UnixTime())
+}
+
+// bugReferences return the bug id prefixes referenced in the description and
+// the comments of a bug, without duplicates
+func bugReferences(snap *bug.Snapshot) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, comment := range snap.Comments {
+		for _, prefix := range render.BugRefs(comment.Message) {
+			if !seen[prefix] {
+				seen[prefix] = true
+				result = append(result, prefix)
+			}
+		}
+	}
+	return result
+}
+
 func (b *BugExcerpt) CreateTime() time.Time {
 	return time.Unix(b.CreateUnixTime, 0)
 }
@@ -174,3 +251,71 @@ func (b BugsByEditTime) Less(i, j int) bool {
 func (b BugsByEditTime) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
+
+type BugsByComments []*BugExcerpt
+
+func (b BugsByComments) Len() int {
+	return len(b)
+}
+
+func (b BugsByComments) Less(i, j int) bool {
+	if b[i].LenComments != b[j].LenComments {
+		return b[i].LenComments < b[j].LenComments
+	}
+
+	// the same number of comments: the most recently edited come last
+	return BugsByEditTime(b).Less(i, j)
+}
+
+func (b BugsByComments) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+type BugsByLastCommentTime []*BugExcerpt
+
+func (b BugsByLastCommentTime) Len() int {
+	return len(b)
+}
+
+func (b BugsByLastCommentTime) Less(i, j int) bool {
+	// there is no logical clock for the comments, only the timestamp
+	if b[i].LastCommentUnixTime != b[j].LastCommentUnixTime {
+		return b[i].LastCommentUnixTime < b[j].LastCommentUnixTime
+	}
+
+	return b[i].Id < b[j].Id
+}
+
+func (b BugsByLastCommentTime) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+// BugSortKey is a key of a BugsByKeys sorting
+type BugSortKey func(b []*BugExcerpt, i, j int) bool
+
+// BugsByKeys sort the bugs by multiple keys, each key ordering the bugs that
+// the previous keys consider equal
+type BugsByKeys struct {
+	Excerpts []*BugExcerpt
+	Keys     []BugSortKey
+}
+
+func (b BugsByKeys) Len() int {
+	return len(b.Excerpts)
+}
+
+func (b BugsByKeys) Less(i, j int) bool {
+	for _, less := range b.Keys {
+		if less(b.Excerpts, i, j) {
+			return true
+		}
+		if less(b.Excerpts, j, i) {
+			return false
+		}
+	}
+	return false
+}
+
+func (b BugsByKeys) Swap(i, j int) {
+	b.Excerpts[i], b.Excerpts[j] = b.Excerpts[j], b.Excerpts[i]
+}
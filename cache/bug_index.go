@@ -0,0 +1,188 @@
+package cache
+
+import (
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
+)
+
+type idSet map[entity.Id]struct{}
+
+// bugIndex hold secondary indexes of the bug excerpts, so that the common
+// filters of a query are resolved with set operations instead of scanning all
+// the excerpts.
+type bugIndex struct {
+	byStatus map[common.Status]idSet
+	byLabel  map[bug.Label]idSet
+	byAuthor map[entity.Id]idSet
+	// byRelation index the bugs by their relations to other bugs
+	byRelation map[bug.Relation]idSet
+	// byReference index the bugs by the bug id prefixes referenced in their
+	// messages
+	byReference map[string]idSet
+}
+
+func newBugIndex() *bugIndex {
+	return &bugIndex{
+		byStatus:    make(map[common.Status]idSet),
+		byLabel:     make(map[bug.Label]idSet),
+		byAuthor:    make(map[entity.Id]idSet),
+		byRelation:  make(map[bug.Relation]idSet),
+		byReference: make(map[string]idSet),
+	}
+}
+
+// updateBugIndex replace a bug in the secondary indexes. Either excerpt can be
+// nil for a created or removed bug.
+// c.muBug must be locked for writing.
+func (c *RepoCache) updateBugIndex(old *BugExcerpt, new *BugExcerpt) {
+	if old != nil {
+		c.index.remove(old)
+	}
+	if new != nil {
+		c.index.add(new)
+	}
+}
+
+// rebuildBugIndex build the secondary indexes from the bug excerpts.
+// c.muBug must be locked for writing.
+func (c *RepoCache) rebuildBugIndex() {
+	c.index = newBugIndex()
+	for _, excerpt := range c.bugExcerpts {
+		c.index.add(excerpt)
+	}
+}
+
+func (idx *bugIndex) add(excerpt *BugExcerpt) {
+	addToSet(idx.byStatus, excerpt.Status, excerpt.Id)
+	for _, label := range excerpt.Labels {
+		addToSet(idx.byLabel, label, excerpt.Id)
+	}
+	addToSet(idx.byAuthor, excerpt.AuthorId, excerpt.Id)
+	for _, relation := range excerpt.Relations {
+		addToSet(idx.byRelation, relation, excerpt.Id)
+	}
+	for _, prefix := range excerpt.References {
+		addToSet(idx.byReference, prefix, excerpt.Id)
+	}
+}
+
+func (idx *bugIndex) remove(excerpt *BugExcerpt) {
+	removeFromSet(idx.byStatus, excerpt.Status, excerpt.Id)
+	for _, label := range excerpt.Labels {
+		removeFromSet(idx.byLabel, label, excerpt.Id)
+	}
+	removeFromSet(idx.byAuthor, excerpt.AuthorId, excerpt.Id)
+	for _, relation := range excerpt.Relations {
+		removeFromSet(idx.byRelation, relation, excerpt.Id)
+	}
+	for _, prefix := range excerpt.References {
+		removeFromSet(idx.byReference, prefix, excerpt.Id)
+	}
+}
+
+func addToSet[K comparable](index map[K]idSet, key K, id entity.Id) {
+	set, ok := index[key]
+	if !ok {
+		set = make(idSet)
+		index[key] = set
+	}
+	set[id] = struct{}{}
+}
+
+func removeFromSet[K comparable](index map[K]idSet, key K, id entity.Id) {
+	set := index[key]
+	delete(set, id)
+	if len(set) == 0 {
+		delete(index, key)
+	}
+}
+
+// candidates return the bugs that can match the status, label and author
+// filters, which still need to be checked with the complete matcher. It
+// returns false if none of those filters is used, as all the bugs are then
+// candidates.
+// c.muBug must be locked for reading.
+func (c *RepoCache) candidates(filters query.Filters) (idSet, bool) {
+	var sets []idSet
+
+	// the status and author filters match any of their values
+	if len(filters.Status) > 0 {
+		union := make(idSet)
+		for _, status := range filters.Status {
+			unionInto(union, c.index.byStatus[status])
+		}
+		sets = append(sets, union)
+	}
+
+	if len(filters.Author) > 0 {
+		union := make(idSet)
+		for _, q := range filters.Author {
+			for _, authorId := range c.matchingAuthors(strings.ToLower(q)) {
+				unionInto(union, c.index.byAuthor[authorId])
+			}
+		}
+		sets = append(sets, union)
+	}
+
+	// the label filters must all match
+	for _, label := range filters.Label {
+		sets = append(sets, c.index.byLabel[bug.Label(label)])
+	}
+
+	if len(sets) == 0 {
+		return nil, false
+	}
+
+	return intersect(sets), true
+}
+
+// matchingAuthors return the ids of the bug authors matching an identity query
+func (c *RepoCache) matchingAuthors(q string) []entity.Id {
+	var result []entity.Id
+	for authorId := range c.index.byAuthor {
+		if matchIdentity(c, authorId, q) {
+			result = append(result, authorId)
+		}
+	}
+	return result
+}
+
+func unionInto(dst idSet, src idSet) {
+	for id := range src {
+		dst[id] = struct{}{}
+	}
+}
+
+// intersect return the ids present in all the sets, starting from the
+// smallest one
+func intersect(sets []idSet) idSet {
+	smallest := 0
+	for i, set := range sets {
+		if len(set) < len(sets[smallest]) {
+			smallest = i
+		}
+	}
+
+	result := make(idSet, len(sets[smallest]))
+	for id := range sets[smallest] {
+		inAll := true
+		for i, set := range sets {
+			if i == smallest {
+				continue
+			}
+			if _, ok := set[id]; !ok {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			result[id] = struct{}{}
+		}
+	}
+
+	return result
+}
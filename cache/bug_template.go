@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+// templateConfigKeyPrefix is the git config prefix under which the bug
+// templates are defined, as in "git-bug.template.<name>.<key> = <value>"
+const templateConfigKeyPrefix = "git-bug.template."
+
+const (
+	templateConfigKeyMessage = "message"
+	templateConfigKeyLabels  = "labels"
+)
+
+// BugTemplate is a predefined starting point for a new bug, like a bug report
+// or a feature request form.
+type BugTemplate struct {
+	Name string
+	// Message is the initial description of the bug, to be completed
+	Message string
+	// Labels are the labels of the new bug
+	Labels []bug.Label
+}
+
+// BugTemplates return the bug templates defined in the git config, sorted by
+// name.
+func (c *RepoCache) BugTemplates() ([]BugTemplate, error) {
+	raw, err := c.repo.AnyConfig().ReadAll(templateConfigKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[string]*BugTemplate)
+	for key, value := range raw {
+		name, field, ok := cutLast(strings.TrimPrefix(key, templateConfigKeyPrefix), ".")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid bug template config key %s", key)
+		}
+
+		template, ok := templates[name]
+		if !ok {
+			template = &BugTemplate{Name: name}
+			templates[name] = template
+		}
+
+		switch field {
+		case templateConfigKeyMessage:
+			template.Message = strings.ReplaceAll(value, `\n`, "\n")
+		case templateConfigKeyLabels:
+			for _, raw := range splitFreezeList(value) {
+				label := bug.Label(raw)
+				if err := label.Validate(); err != nil {
+					return nil, fmt.Errorf("invalid label of the bug template %s: %w", name, err)
+				}
+				template.Labels = append(template.Labels, label)
+			}
+		default:
+			return nil, fmt.Errorf("unknown bug template config key %s", key)
+		}
+	}
+
+	result := make([]BugTemplate, 0, len(templates))
+	for _, template := range templates {
+		result = append(result, *template)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
@@ -0,0 +1,175 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// BugTx stage the changes of a bug in a transaction, see BugCache.Transaction.
+// All the changes are authored by the user identity, at the time the
+// transaction started.
+type BugTx struct {
+	cache    *BugCache
+	author   *IdentityCache
+	unixTime int64
+}
+
+// Transaction apply several changes to the bug with all-or-nothing semantics:
+// the changes staged by fn are committed together if fn succeed, and
+// discarded if fn or the commit fail. The cache is updated once, when
+// committing.
+//
+// The bug is locked during the transaction: fn must only change the bug
+// through tx, and must not use the BugCache itself.
+func (c *BugCache) Transaction(fn func(tx *BugTx) error) error {
+	if err := c.repoCache.checkWritable(); err != nil {
+		return err
+	}
+
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	tx := &BugTx{
+		cache:    c,
+		author:   author,
+		unixTime: time.Now().Unix(),
+	}
+
+	c.mu.Lock()
+
+	// the operations staged before the transaction are kept on failure, and
+	// committed along with the transaction otherwise
+	staged := len(c.bug.StagedOperations())
+
+	err = fn(tx)
+	if err == nil && len(c.bug.StagedOperations()) == staged {
+		// nothing to commit
+		c.mu.Unlock()
+		return nil
+	}
+	if err == nil {
+		err = c.commitStaged()
+	}
+	if err != nil {
+		c.bug.DiscardStaged(staged)
+		c.mu.Unlock()
+		return err
+	}
+	c.mu.Unlock()
+
+	err = c.notifyUpdated()
+	if err != nil {
+		return err
+	}
+	return c.repoCache.journalEnd(bugJournalKind, c.Id())
+}
+
+// Snapshot return the state of the bug, including the changes staged so far
+func (tx *BugTx) Snapshot() *bug.Snapshot {
+	return tx.cache.bug.Compile()
+}
+
+func (tx *BugTx) AddComment(message string) (entity.CombinedId, *bug.AddCommentOperation, error) {
+	return tx.AddCommentWithFiles(message, nil)
+}
+
+func (tx *BugTx) AddCommentWithFiles(message string, files []repository.Hash) (entity.CombinedId, *bug.AddCommentOperation, error) {
+	if err := tx.cache.repoCache.checkMessageSize(message); err != nil {
+		return entity.UnsetCombinedId, nil, err
+	}
+	return bug.AddComment(tx.cache.bug, tx.author.Identity, tx.unixTime, message, files, nil)
+}
+
+func (tx *BugTx) ChangeLabels(added []string, removed []string) ([]bug.LabelChangeResult, *bug.LabelChangeOperation, error) {
+	return bug.ChangeLabels(tx.cache.bug, tx.author.Identity, tx.unixTime, added, removed, nil)
+}
+
+func (tx *BugTx) SetAssignee(added []entity.Id, removed []entity.Id) (*bug.SetAssigneeOperation, error) {
+	return bug.SetAssignee(tx.cache.bug, tx.author.Identity, tx.unixTime, added, removed, nil)
+}
+
+// SetField set the value of a custom field, or unset it with an empty value
+func (tx *BugTx) SetField(name string, value string) (*bug.SetFieldOperation, error) {
+	defs, err := tx.cache.repoCache.FieldDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.cache.repoCache.validateField(defs, name, value); err != nil {
+		return nil, err
+	}
+	return bug.SetField(tx.cache.bug, tx.author.Identity, tx.unixTime, name, value, nil)
+}
+
+func (tx *BugTx) SetTitle(title string) (*bug.SetTitleOperation, error) {
+	return bug.SetTitle(tx.cache.bug, tx.author.Identity, tx.unixTime, title, nil)
+}
+
+func (tx *BugTx) Open() (*bug.SetStatusOperation, error) {
+	err := tx.cache.repoCache.validateStatusChange(tx.Snapshot(), common.OpenStatus, "")
+	if err != nil {
+		return nil, err
+	}
+	return bug.Open(tx.cache.bug, tx.author.Identity, tx.unixTime, nil)
+}
+
+// Close close the bug. If the bug is protected by the freeze mode, an
+// ErrFrozen is returned; CloseConfirmed must be used instead.
+func (tx *BugTx) Close() (*bug.SetStatusOperation, error) {
+	return tx.close(false)
+}
+
+// CloseConfirmed close the bug, confirming the closing if the bug is protected
+// by the freeze mode
+func (tx *BugTx) CloseConfirmed() (*bug.SetStatusOperation, error) {
+	return tx.close(true)
+}
+
+func (tx *BugTx) close(confirmed bool) (*bug.SetStatusOperation, error) {
+	err := tx.cache.repoCache.validateStatusChange(tx.Snapshot(), common.ClosedStatus, "")
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := tx.cache.repoCache.freezeMetadata(tx.Snapshot(), tx.author, confirmed)
+	if err != nil {
+		return nil, err
+	}
+	return bug.Close(tx.cache.bug, tx.author.Identity, tx.unixTime, metadata)
+}
+
+// SetState move the bug to a state of the workflow, checking the transition.
+// If the bug is closed and protected by the freeze mode, an ErrFrozen is
+// returned; SetStateConfirmed must be used instead.
+func (tx *BugTx) SetState(state string) (*bug.SetStatusOperation, error) {
+	return tx.setState(state, false)
+}
+
+// SetStateConfirmed move the bug to a state of the workflow, confirming the
+// closing if the bug is protected by the freeze mode
+func (tx *BugTx) SetStateConfirmed(state string) (*bug.SetStatusOperation, error) {
+	return tx.setState(state, true)
+}
+
+func (tx *BugTx) setState(state string, confirmed bool) (*bug.SetStatusOperation, error) {
+	status, recorded, err := tx.cache.repoCache.resolveState(state)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.cache.repoCache.validateStatusChange(tx.Snapshot(), status, state)
+	if err != nil {
+		return nil, err
+	}
+	var metadata map[string]string
+	if status == common.ClosedStatus {
+		metadata, err = tx.cache.repoCache.freezeMetadata(tx.Snapshot(), tx.author, confirmed)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bug.SetState(tx.cache.bug, tx.author.Identity, tx.unixTime, status, recorded, metadata)
+}
@@ -0,0 +1,181 @@
+package cache
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// BuildEventType is the stage of a cache build reported by a BuildEvent
+type BuildEventType int
+
+const (
+	_ BuildEventType = iota
+	// BuildEventStarted is sent when the cache of an entity type starts to be built
+	BuildEventStarted
+	// BuildEventProgress is sent each time an entity has been added to the cache
+	BuildEventProgress
+	// BuildEventFinished is sent when the cache of an entity type has been built,
+	// or when the build failed
+	BuildEventFinished
+)
+
+// BuildEvent report the progress of the build of the cache, for one entity
+// type at a time.
+type BuildEvent struct {
+	// Typename is the entity type being built, "identity" or "bug"
+	Typename string
+	Event    BuildEventType
+	// Done is the number of entities already added to the cache
+	Done int
+	// Total is the number of entities to add to the cache
+	Total int
+	// Err is set with BuildEventFinished if the build failed
+	Err error
+}
+
+// The number of build events a listener can lag behind before progress
+// events are dropped
+const buildEventBufferSize = 64
+
+// buildProgress dispatch the BuildEvent of the next build of the cache to
+// the registered listeners.
+type buildProgress struct {
+	mu        sync.Mutex
+	listeners []chan BuildEvent
+}
+
+// BuildProgress return a channel receiving the progress of the next build of
+// the cache, or of the build in progress if any, for example when calling
+// Rebuild. The channel is closed once the build is over, or when the cache is
+// closed.
+//
+// Sending the events never blocks the build: the BuildEventProgress events
+// that don't fit in the channel buffer are dropped, so a slow listener only
+// sees some of them. The BuildEventStarted and BuildEventFinished events are
+// delivered in priority, by dropping the oldest pending event if needed.
+func (c *RepoCache) BuildProgress() <-chan BuildEvent {
+	ch := make(chan BuildEvent, buildEventBufferSize)
+
+	c.buildProgress.mu.Lock()
+	c.buildProgress.listeners = append(c.buildProgress.listeners, ch)
+	c.buildProgress.mu.Unlock()
+
+	return ch
+}
+
+func (bp *buildProgress) send(event BuildEvent) {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
+	for _, ch := range bp.listeners {
+		if event.Event == BuildEventProgress {
+			select {
+			case ch <- event:
+			default:
+				// listener is lagging, drop the event
+			}
+			continue
+		}
+
+		// make room for the event if needed, by dropping the oldest one
+		select {
+		case ch <- event:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- event
+		}
+	}
+}
+
+// close terminate the current build, closing the channel of all the listeners
+func (bp *buildProgress) close() {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
+	for _, ch := range bp.listeners {
+		close(ch)
+	}
+	bp.listeners = nil
+}
+
+// buildReporter send the BuildEvent of an entity type being built, and write a
+// human readable version of them.
+type buildReporter struct {
+	progress *buildProgress
+	out      io.Writer
+	typename string
+	total    int
+
+	mu   sync.Mutex
+	done int
+	// the last percentage written
+	percent int
+}
+
+// under this number of entities, the percentage is not written
+const buildReportMinTotal = 1000
+
+func newBuildReporter(progress *buildProgress, out io.Writer, typename string, total int) *buildReporter {
+	_, _ = fmt.Fprintf(out, "Building %s cache... ", typename)
+
+	progress.send(BuildEvent{
+		Typename: typename,
+		Event:    BuildEventStarted,
+		Total:    total,
+	})
+
+	return &buildReporter{
+		progress: progress,
+		out:      out,
+		typename: typename,
+		total:    total,
+	}
+}
+
+// step record that one more entity has been added to the cache. It can be
+// called concurrently.
+func (r *buildReporter) step() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.done++
+
+	r.progress.send(BuildEvent{
+		Typename: r.typename,
+		Event:    BuildEventProgress,
+		Done:     r.done,
+		Total:    r.total,
+	})
+
+	if r.total < buildReportMinTotal {
+		return
+	}
+	percent := r.done * 100 / r.total / 10 * 10
+	if percent > r.percent && percent < 100 {
+		r.percent = percent
+		_, _ = fmt.Fprintf(r.out, "%d%%... ", percent)
+	}
+}
+
+func (r *buildReporter) finish(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err == nil {
+		_, _ = fmt.Fprintln(r.out, "Done.")
+	} else {
+		_, _ = fmt.Fprintln(r.out, "Failed.")
+	}
+
+	r.progress.send(BuildEvent{
+		Typename: r.typename,
+		Event:    BuildEventFinished,
+		Done:     r.done,
+		Total:    r.total,
+		Err:      err,
+	})
+}
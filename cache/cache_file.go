@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/go-git/go-billy/v5"
+)
+
+// cacheFileChecksumPrefix start the footer of the cache files, followed by
+// the hex encoded sha256 of the rest of the file
+const cacheFileChecksumPrefix = "\nsha256 "
+
+// the length of the checksum footer of the cache files
+const cacheFileChecksumLen = len(cacheFileChecksumPrefix) + 2*sha256.Size
+
+// errCacheChecksum is returned when a cache file doesn't match its checksum,
+// typically after a crash or a disk failure. The cache is then rebuilt.
+var errCacheChecksum = errors.New("the cache file is corrupted: checksum mismatch")
+
+// writeCacheFile replace a cache file at once, with its checksum footer
+func writeCacheFile(storage billy.Filesystem, name string, content []byte) error {
+	sum := sha256.Sum256(content)
+
+	data := make([]byte, 0, len(content)+cacheFileChecksumLen)
+	data = append(data, content...)
+	data = append(data, cacheFileChecksumPrefix+hex.EncodeToString(sum[:])...)
+
+	return replaceFile(storage, name, data)
+}
+
+// replaceFile replace a file of the local storage at once: the content is
+// written in a temporary file synced to the disk, then renamed over the
+// previous file. A crash leaves either the previous or the new file, never a
+// truncated one.
+func replaceFile(storage billy.Filesystem, name string, content []byte) error {
+	tmp := name + ".tmp"
+	f, err := storage.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(content)
+	if err == nil {
+		err = syncFile(f)
+	}
+	if err != nil {
+		_ = f.Close()
+		_ = storage.Remove(tmp)
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	return storage.Rename(tmp, name)
+}
+
+// readCacheFile read a cache file and verify its checksum footer, returning
+// the content without it. The files in the legacy gob encoding have no
+// checksum and are returned as is, to be migrated.
+func readCacheFile(storage billy.Filesystem, name string) ([]byte, error) {
+	f, err := storage.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(content, []byte(cacheFileMagic)) {
+		return content, nil
+	}
+
+	if len(content) < len(cacheFileMagic)+cacheFileChecksumLen {
+		return nil, errCacheChecksum
+	}
+
+	split := len(content) - cacheFileChecksumLen
+	content, footer := content[:split], content[split:]
+
+	if !bytes.HasPrefix(footer, []byte(cacheFileChecksumPrefix)) {
+		return nil, errCacheChecksum
+	}
+	expected, err := hex.DecodeString(string(footer[len(cacheFileChecksumPrefix):]))
+	if err != nil {
+		return nil, errCacheChecksum
+	}
+
+	sum := sha256.Sum256(content)
+	if !bytes.Equal(sum[:], expected) {
+		return nil, errCacheChecksum
+	}
+
+	return content, nil
+}
@@ -0,0 +1,400 @@
+package cache
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// changeFeedFile is where the entries of the change feed are appended, as
+// JSON lines. It's local to the repository and never pushed.
+const changeFeedFile = "change-feed"
+
+// changeFeedStateFile is where the state of the change feed is kept: what has
+// been recorded in the feed already, and the cursors of the consumers.
+const changeFeedStateFile = "change-feed-state"
+
+// ChangeFeedEntry is a change of the repository in the change feed: either a
+// new operation of a bug, or the removal of a bug.
+type ChangeFeedEntry struct {
+	// Seq is the position of the entry in the feed, starting at 1. It never
+	// changes once assigned.
+	Seq   uint64    `json:"seq"`
+	BugId entity.Id `json:"bug_id"`
+	// Removed is true when the bug has been removed, in which case there is
+	// no operation
+	Removed   bool            `json:"removed,omitempty"`
+	OpId      entity.Id       `json:"op_id,omitempty"`
+	AuthorId  entity.Id       `json:"author_id,omitempty"`
+	Timestamp int64           `json:"timestamp,omitempty"`
+	Operation json.RawMessage `json:"operation,omitempty"`
+}
+
+// changeFeedStateData is the content of the state file of the change feed
+type changeFeedStateData struct {
+	// the edit time of each bug when its operations were last recorded
+	EditTimes map[entity.Id]lamport.Time `json:"edit_times"`
+	// the sequence number of the last entry processed by each consumer
+	Cursors map[string]uint64 `json:"cursors"`
+}
+
+// changeFeed is the ordered sequence of the changes of the repository, for
+// the external systems to consume them exactly once, and resume where they
+// stopped after a downtime.
+type changeFeed struct {
+	mu     sync.Mutex
+	loaded bool
+	// the operations already in the feed
+	ops     map[entity.Id]struct{}
+	lastSeq uint64
+	state   changeFeedStateData
+}
+
+// ChangeFeed return the entries of the change feed after the sequence number
+// after, in order, at most limit of them if limit is positive.
+//
+// The changes since the last call are recorded first, with a new sequence
+// number: the operations of the bugs in the order of their timestamp, and the
+// removed bugs. A read-only cache only serves the entries recorded already.
+func (c *RepoCache) ChangeFeed(after uint64, limit int) ([]ChangeFeedEntry, error) {
+	c.changeFeed.mu.Lock()
+	defer c.changeFeed.mu.Unlock()
+
+	// a read-only cache follow the feed recorded by the writer
+	if c.readOnly {
+		c.changeFeed.loaded = false
+	}
+	if err := c.loadChangeFeed(); err != nil {
+		return nil, err
+	}
+	if !c.readOnly {
+		if err := c.recordChangeFeed(); err != nil {
+			return nil, err
+		}
+	}
+
+	if after >= c.changeFeed.lastSeq {
+		return nil, nil
+	}
+
+	f, err := c.repo.LocalStorage().Open(changeFeedFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var result []ChangeFeedEntry
+	err = scanChangeFeed(f, func(entry ChangeFeedEntry) bool {
+		if entry.Seq <= after {
+			return true
+		}
+		result = append(result, entry)
+		return limit <= 0 || len(result) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ChangeFeedCursor return the sequence number of the last entry of the change
+// feed processed by a consumer, as stored with SetChangeFeedCursor, or 0 for
+// a new consumer.
+func (c *RepoCache) ChangeFeedCursor(consumer string) (uint64, error) {
+	c.changeFeed.mu.Lock()
+	defer c.changeFeed.mu.Unlock()
+
+	if err := c.loadChangeFeed(); err != nil {
+		return 0, err
+	}
+
+	return c.changeFeed.state.Cursors[consumer], nil
+}
+
+// SetChangeFeedCursor store the sequence number of the last entry of the
+// change feed processed by a consumer, to resume after it later. A consumer
+// storing its cursor only once an entry is processed receives each entry
+// exactly once.
+func (c *RepoCache) SetChangeFeedCursor(consumer string, seq uint64) error {
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+	if consumer == "" {
+		return fmt.Errorf("empty consumer name")
+	}
+
+	c.changeFeed.mu.Lock()
+	defer c.changeFeed.mu.Unlock()
+
+	if err := c.loadChangeFeed(); err != nil {
+		return err
+	}
+
+	if seq > c.changeFeed.lastSeq {
+		return fmt.Errorf("cursor %d is after the end of the change feed (%d)", seq, c.changeFeed.lastSeq)
+	}
+
+	c.changeFeed.state.Cursors[consumer] = seq
+	return c.writeChangeFeedState()
+}
+
+// recordChangeFeed append to the change feed the operations of the bugs
+// changed since they were last recorded, and the removed bugs.
+// c.changeFeed.mu must be locked.
+func (c *RepoCache) recordChangeFeed() error {
+	c.muBug.RLock()
+	var changed []*BugExcerpt
+	for id, excerpt := range c.bugExcerpts {
+		if editTime, ok := c.changeFeed.state.EditTimes[id]; !ok || editTime != excerpt.EditLamportTime {
+			changed = append(changed, excerpt)
+		}
+	}
+	var removed []entity.Id
+	for id := range c.changeFeed.state.EditTimes {
+		if _, ok := c.bugExcerpts[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	c.muBug.RUnlock()
+
+	if len(changed) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	// the new operations of all the changed bugs, merged in the order of
+	// their timestamp as in the audit log
+	sort.Slice(changed, func(i, j int) bool { return changed[i].Id < changed[j].Id })
+	h := &auditHeap{}
+	for _, excerpt := range changed {
+		b, err := c.ResolveBug(excerpt.Id)
+		if err != nil {
+			return err
+		}
+		var ops []dag.Operation
+		for _, op := range b.Snapshot().Operations {
+			if _, ok := c.changeFeed.ops[op.Id()]; !ok {
+				ops = append(ops, op)
+			}
+		}
+		if len(ops) > 0 {
+			heap.Push(h, &auditCursor{bugId: excerpt.Id, ops: ops})
+		}
+	}
+
+	var entries []ChangeFeedEntry
+	seq := c.changeFeed.lastSeq
+
+	for h.Len() > 0 {
+		cursor := (*h)[0]
+		op := cursor.ops[cursor.index]
+
+		data, err := json.Marshal(op)
+		if err != nil {
+			return err
+		}
+
+		seq++
+		entries = append(entries, ChangeFeedEntry{
+			Seq:       seq,
+			BugId:     cursor.bugId,
+			OpId:      op.Id(),
+			AuthorId:  op.Author().Id(),
+			Timestamp: op.Time().Unix(),
+			Operation: data,
+		})
+
+		cursor.index++
+		if cursor.index < len(cursor.ops) {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+
+	sort.Slice(removed, func(i, j int) bool { return removed[i] < removed[j] })
+	for _, id := range removed {
+		seq++
+		entries = append(entries, ChangeFeedEntry{Seq: seq, BugId: id, Removed: true})
+	}
+
+	// the entries are written before the state: if interrupted in between,
+	// the bugs are scanned again but their operations are already known
+	if err := c.appendChangeFeed(entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.Removed {
+			c.changeFeed.ops[entry.OpId] = struct{}{}
+		}
+	}
+	c.changeFeed.lastSeq = seq
+
+	for _, excerpt := range changed {
+		c.changeFeed.state.EditTimes[excerpt.Id] = excerpt.EditLamportTime
+	}
+	for _, id := range removed {
+		delete(c.changeFeed.state.EditTimes, id)
+	}
+
+	return c.writeChangeFeedState()
+}
+
+// loadChangeFeed read the change feed and its state, if not done already.
+// c.changeFeed.mu must be locked.
+func (c *RepoCache) loadChangeFeed() error {
+	if c.changeFeed.loaded {
+		return nil
+	}
+
+	feed := &c.changeFeed
+	feed.ops = make(map[entity.Id]struct{})
+	feed.lastSeq = 0
+	feed.state = changeFeedStateData{
+		EditTimes: make(map[entity.Id]lamport.Time),
+		Cursors:   make(map[string]uint64),
+	}
+
+	storage := c.repo.LocalStorage()
+
+	f, err := storage.Open(changeFeedFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return err
+	default:
+		var content bytes.Buffer
+		_, err := content.ReadFrom(f)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+
+		// a truncated last line is an entry whose writing has been
+		// interrupted, dropped so that the next entries are written after
+		// the complete ones
+		data := content.Bytes()
+		if len(data) > 0 && data[len(data)-1] != '\n' {
+			data = data[:bytes.LastIndexByte(data, '\n')+1]
+			if !c.readOnly {
+				if err := replaceFile(storage, changeFeedFile, data); err != nil {
+					return err
+				}
+			}
+		}
+
+		err = scanChangeFeed(bytes.NewReader(data), func(entry ChangeFeedEntry) bool {
+			if !entry.Removed {
+				feed.ops[entry.OpId] = struct{}{}
+			}
+			feed.lastSeq = entry.Seq
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	f, err = storage.Open(changeFeedStateFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return err
+	default:
+		data, err := io.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &feed.state); err != nil {
+			return fmt.Errorf("reading the state of the change feed: %w", err)
+		}
+		if feed.state.EditTimes == nil {
+			feed.state.EditTimes = make(map[entity.Id]lamport.Time)
+		}
+		if feed.state.Cursors == nil {
+			feed.state.Cursors = make(map[string]uint64)
+		}
+	}
+
+	feed.loaded = true
+	return nil
+}
+
+// appendChangeFeed append entries at the end of the change feed file, synced
+// to the disk. c.changeFeed.mu must be locked.
+func (c *RepoCache) appendChangeFeed(entries []ChangeFeedEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+
+	f, err := c.repo.LocalStorage().OpenFile(changeFeedFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(buf.Bytes())
+	if err == nil {
+		err = syncFile(f)
+	}
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// writeChangeFeedState replace the state file of the change feed at once.
+// c.changeFeed.mu must be locked.
+func (c *RepoCache) writeChangeFeedState() error {
+	data, err := json.Marshal(c.changeFeed.state)
+	if err != nil {
+		return err
+	}
+
+	return replaceFile(c.repo.LocalStorage(), changeFeedStateFile, data)
+}
+
+// scanChangeFeed call fn with each entry of a change feed, until fn return
+// false
+func scanChangeFeed(r io.Reader, fn func(entry ChangeFeedEntry) bool) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64*1024*1024)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var entry ChangeFeedEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return fmt.Errorf("reading the change feed: %w", err)
+		}
+		if !fn(entry) {
+			return nil
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// savedQueryConfigKeyPrefix is the git config prefix under which queries are
+// saved, as in "git-bug.query.<name> = <query>"
+const savedQueryConfigKeyPrefix = "git-bug.query."
+
+// CompletionData is the data useful to complete a command line, as read
+// from the on-disk cache.
+type CompletionData struct {
+	// Bugs are sorted from the most to the least recently edited
+	Bugs       []*BugExcerpt
+	Labels     []bug.Label
+	Identities []*IdentityExcerpt
+	// SavedQueries map the name of a saved query to the query itself
+	SavedQueries map[string]string
+}
+
+// ReadCompletionData read from the on-disk cache the data useful to complete
+// a command line. Contrary to NewRepoCache, this doesn't take the repository
+// lock, doesn't write anything and never rebuild the cache, which make it safe
+// and fast to call while another git-bug process is running. If the cache
+// is missing or outdated, an error is returned.
+func ReadCompletionData(repo repository.RepoStorage, config repository.ConfigRead) (*CompletionData, error) {
+	bugExcerpts, err := readBugCache(repo.LocalStorage())
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("the cache has not been built yet")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	identityExcerpts, err := readIdentityCache(repo.LocalStorage())
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("the cache has not been built yet")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data := &CompletionData{
+		Bugs:         make([]*BugExcerpt, 0, len(bugExcerpts)),
+		Identities:   make([]*IdentityExcerpt, 0, len(identityExcerpts)),
+		SavedQueries: make(map[string]string),
+	}
+
+	labels := make(map[bug.Label]struct{})
+	for _, excerpt := range bugExcerpts {
+		data.Bugs = append(data.Bugs, excerpt)
+		for _, l := range excerpt.Labels {
+			labels[l] = struct{}{}
+		}
+	}
+	sort.Slice(data.Bugs, func(i, j int) bool {
+		if data.Bugs[i].EditUnixTime != data.Bugs[j].EditUnixTime {
+			return data.Bugs[i].EditUnixTime > data.Bugs[j].EditUnixTime
+		}
+		return data.Bugs[i].Id < data.Bugs[j].Id
+	})
+
+	data.Labels = make([]bug.Label, 0, len(labels))
+	for l := range labels {
+		data.Labels = append(data.Labels, l)
+	}
+	sort.Slice(data.Labels, func(i, j int) bool {
+		return data.Labels[i] < data.Labels[j]
+	})
+
+	for _, excerpt := range identityExcerpts {
+		data.Identities = append(data.Identities, excerpt)
+	}
+	sort.Slice(data.Identities, func(i, j int) bool {
+		return data.Identities[i].Id < data.Identities[j].Id
+	})
+
+	data.SavedQueries, err = savedQueries(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// savedQueries return the queries saved in the git config, by name
+func savedQueries(config repository.ConfigRead) (map[string]string, error) {
+	raw, err := config.ReadAll(savedQueryConfigKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(raw))
+	for key, value := range raw {
+		if !strings.HasPrefix(key, savedQueryConfigKeyPrefix) {
+			continue
+		}
+		result[strings.TrimPrefix(key, savedQueryConfigKeyPrefix)] = value
+	}
+
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// git config key template to flag a git remote as public. Confidential data
+// is not pushed to public remotes.
+const publicRemoteConfigKeyTemplate = "git-bug.remote.%s.public"
+
+// IsPublicRemote return true if the given git remote has been configured as public.
+func (c *RepoCache) IsPublicRemote(remote string) (bool, error) {
+	public, err := c.repo.AnyConfig().ReadBool(fmt.Sprintf(publicRemoteConfigKeyTemplate, remote))
+	if err == repository.ErrNoConfigEntry {
+		return false, nil
+	}
+	return public, err
+}
+
+// ConfidentialBugs return the id of all the bugs holding confidential operations.
+func (c *RepoCache) ConfidentialBugs() []entity.Id {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	var result []entity.Id
+	for id, excerpt := range c.bugExcerpts {
+		if excerpt.Confidential {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"sort"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/common"
+)
+
+// HotBug is a bug with a lot of discussion during the period of a digest
+type HotBug struct {
+	Excerpt *BugExcerpt
+	// number of comments during the period
+	Comments int
+}
+
+// Digest summarize the activity on the bugs since a point in time
+type Digest struct {
+	Since time.Time
+	// bugs created during the period, oldest first
+	Created []*BugExcerpt
+	// bugs closed during the period and still closed, oldest first
+	Closed []*BugExcerpt
+	// the most discussed bugs during the period, most comments first
+	Hot []HotBug
+}
+
+// IsEmpty return true if nothing happened during the period
+func (d *Digest) IsEmpty() bool {
+	return len(d.Created) == 0 && len(d.Closed) == 0 && len(d.Hot) == 0
+}
+
+// Digest compute a summary of the activity on the bugs since the given time,
+// with at most maxHot of the most discussed bugs. It only use the bug
+// excerpts, so it's cheap to compute even on a large repository.
+func (c *RepoCache) Digest(since time.Time, maxHot int) *Digest {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	result := &Digest{Since: since}
+	sinceUnix := since.Unix()
+
+	for _, excerpt := range c.bugExcerpts {
+		if excerpt.CreateUnixTime >= sinceUnix {
+			result.Created = append(result.Created, excerpt)
+		}
+
+		comments := 0
+		closed := false
+		for _, event := range excerpt.Activity {
+			if event.UnixTime < sinceUnix {
+				continue
+			}
+			switch event.Kind {
+			case ActivityCommented:
+				comments++
+			case ActivityClosed:
+				closed = true
+			}
+		}
+
+		if closed && excerpt.Status == common.ClosedStatus {
+			result.Closed = append(result.Closed, excerpt)
+		}
+		if comments > 0 {
+			result.Hot = append(result.Hot, HotBug{Excerpt: excerpt, Comments: comments})
+		}
+	}
+
+	sort.Slice(result.Created, func(i, j int) bool {
+		return result.Created[i].CreateUnixTime < result.Created[j].CreateUnixTime
+	})
+	sort.Slice(result.Closed, func(i, j int) bool {
+		return result.Closed[i].EditUnixTime < result.Closed[j].EditUnixTime
+	})
+	sort.Slice(result.Hot, func(i, j int) bool {
+		if result.Hot[i].Comments != result.Hot[j].Comments {
+			return result.Hot[i].Comments > result.Hot[j].Comments
+		}
+		return result.Hot[i].Excerpt.Id < result.Hot[j].Excerpt.Id
+	})
+	if maxHot >= 0 && len(result.Hot) > maxHot {
+		result.Hot = result.Hot[:maxHot]
+	}
+
+	return result
+}
@@ -0,0 +1,142 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+const (
+	// MetaKeyDuplicateOf is the metadata recorded on the comment of a
+	// duplicate bug pointing to its canonical bug, holding its id
+	MetaKeyDuplicateOf = "duplicate-of"
+	// MetaKeyMergedFrom is the metadata recorded on the comments copied from a
+	// duplicate bug, holding the combined id of the original comment
+	MetaKeyMergedFrom = "merged-from"
+)
+
+// duplicateLabel is the label added to a duplicate bug
+const duplicateLabel bug.Label = "duplicate"
+
+// MergeBugInto consolidate a duplicate bug into its canonical bug, in one step:
+//   - the comments of the duplicate are copied in the canonical bug, quoted
+//     and attributed to their original author
+//   - the duplicate is labeled "duplicate", gets a comment pointing to the
+//     canonical bug, holding the MetaKeyDuplicateOf metadata, and is closed
+//
+// Both bugs are committed. confirmFreeze confirm the closing of the duplicate
+// if it is protected by the freeze mode, as for BugCache.CloseConfirmed.
+func (c *RepoCache) MergeBugInto(duplicate, canonical *BugCache, confirmFreeze bool) error {
+	if duplicate.Id() == canonical.Id() {
+		return fmt.Errorf("can't merge a bug into itself")
+	}
+
+	if id := DuplicateOf(duplicate.Snapshot()); id != "" {
+		return fmt.Errorf("bug %s is already a duplicate of %s", duplicate.Id().Human(), id.Human())
+	}
+	if id := DuplicateOf(canonical.Snapshot()); id != "" {
+		return fmt.Errorf("bug %s is itself a duplicate of %s", canonical.Id().Human(), id.Human())
+	}
+
+	author, err := c.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	closeMetadata, err := duplicate.FreezeMetadata(author, confirmFreeze)
+	if err != nil {
+		return err
+	}
+
+	unixTime := time.Now().Unix()
+	snap := duplicate.Snapshot()
+
+	for _, comment := range snap.Comments {
+		_, _, err = canonical.AddCommentRaw(author, unixTime,
+			quoteMergedComment(snap, comment),
+			comment.Files,
+			map[string]string{MetaKeyMergedFrom: comment.CombinedId().String()})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !hasLabel(snap, duplicateLabel) {
+		_, _, err = duplicate.ChangeLabelsRaw(author, unixTime, []string{duplicateLabel.String()}, nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, _, err = duplicate.AddCommentRaw(author, unixTime,
+		fmt.Sprintf("Duplicate of %s: %s", canonical.Id().Human(), canonical.Snapshot().Title),
+		nil,
+		map[string]string{MetaKeyDuplicateOf: canonical.Id().String()})
+	if err != nil {
+		return err
+	}
+
+	if snap.Status != common.ClosedStatus {
+		_, err = duplicate.CloseRaw(author, unixTime, closeMetadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = canonical.Commit()
+	if err != nil {
+		return err
+	}
+
+	return duplicate.Commit()
+}
+
+// quoteMergedComment format a comment of a duplicate bug to be copied in the
+// canonical bug
+func quoteMergedComment(snap *bug.Snapshot, comment bug.Comment) string {
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "%s wrote in %s (%s), on %s:\n\n",
+		comment.Author.DisplayName(), snap.Id().Human(), snap.Title, comment.FormatTime())
+
+	for _, line := range strings.Split(comment.Message, "\n") {
+		if line == "" {
+			sb.WriteString(">\n")
+		} else {
+			sb.WriteString("> " + line + "\n")
+		}
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// DuplicateOf return the id of the canonical bug if the bug has been merged
+// into it as a duplicate, or an empty id otherwise. Reopening the bug clear it.
+func DuplicateOf(snap *bug.Snapshot) entity.Id {
+	var result entity.Id
+	for _, op := range snap.Operations {
+		switch op := op.(type) {
+		case *bug.AddCommentOperation:
+			if id, ok := op.GetMetadata(MetaKeyDuplicateOf); ok {
+				result = entity.Id(id)
+			}
+		case *bug.SetStatusOperation:
+			if op.Status == common.OpenStatus {
+				result = ""
+			}
+		}
+	}
+	return result
+}
+
+func hasLabel(snap *bug.Snapshot, label bug.Label) bool {
+	for _, l := range snap.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
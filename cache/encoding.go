@@ -0,0 +1,474 @@
+package cache
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/gob"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// The cache files are encoded with the protobuf wire format, as described in
+// cache.proto. Unlike gob, this can be read by tools not written in Go, and
+// fields can be added without breaking the existing readers.
+//
+// The files written before the format version 9 are gob encoded, and are
+// still read to be migrated.
+
+//go:embed cache.proto
+var cacheProtoSchema string
+
+// cacheFileMagic is the start of every cache file encoded with protobuf
+const cacheFileMagic = "git-bug cache\n"
+
+// protobuf field numbers, see cache.proto
+const (
+	fieldCacheVersion  protowire.Number = 1
+	fieldCacheExcerpts protowire.Number = 2
+	fieldCacheTips     protowire.Number = 3
+
+	fieldMapKey   protowire.Number = 1
+	fieldMapValue protowire.Number = 2
+
+	fieldBugId                  protowire.Number = 1
+	fieldBugCreateLamportTime   protowire.Number = 2
+	fieldBugEditLamportTime     protowire.Number = 3
+	fieldBugCreateUnixTime      protowire.Number = 4
+	fieldBugEditUnixTime        protowire.Number = 5
+	fieldBugAuthorId            protowire.Number = 6
+	fieldBugStatus              protowire.Number = 7
+	fieldBugLabels              protowire.Number = 8
+	fieldBugTitle               protowire.Number = 9
+	fieldBugLenComments         protowire.Number = 10
+	fieldBugActors              protowire.Number = 11
+	fieldBugParticipants        protowire.Number = 12
+	fieldBugAwaitingReporter    protowire.Number = 13
+	fieldBugFields              protowire.Number = 14
+	fieldBugSyncConflict        protowire.Number = 15
+	fieldBugConfidential        protowire.Number = 16
+	fieldBugActivity            protowire.Number = 17
+	fieldBugCreateMetadata      protowire.Number = 18
+	fieldBugDuplicateOf         protowire.Number = 19
+	fieldBugLastCommentUnixTime protowire.Number = 20
+	fieldBugRelations           protowire.Number = 21
+	fieldBugAssignees           protowire.Number = 22
+	fieldBugState               protowire.Number = 23
+	fieldBugReferences          protowire.Number = 24
+
+	fieldActivityKind     protowire.Number = 1
+	fieldActivityAuthorId protowire.Number = 2
+	fieldActivityUnixTime protowire.Number = 3
+
+	fieldRelationType   protowire.Number = 1
+	fieldRelationTarget protowire.Number = 2
+
+	fieldIdentityId                protowire.Number = 1
+	fieldIdentityName              protowire.Number = 2
+	fieldIdentityLogin             protowire.Number = 3
+	fieldIdentityImmutableMetadata protowire.Number = 4
+	fieldIdentityTrusted           protowire.Number = 5
+	fieldIdentityEmail             protowire.Number = 6
+)
+
+// protoBuffer accumulate an encoded protobuf message. As in proto3, the
+// fields with a zero value are omitted.
+type protoBuffer []byte
+
+func (b *protoBuffer) varint(num protowire.Number, v uint64) {
+	if v == 0 {
+		return
+	}
+	*b = protowire.AppendTag(*b, num, protowire.VarintType)
+	*b = protowire.AppendVarint(*b, v)
+}
+
+func (b *protoBuffer) bool(num protowire.Number, v bool) {
+	if v {
+		b.varint(num, 1)
+	}
+}
+
+func (b *protoBuffer) string(num protowire.Number, s string) {
+	if s == "" {
+		return
+	}
+	*b = protowire.AppendTag(*b, num, protowire.BytesType)
+	*b = protowire.AppendString(*b, s)
+}
+
+// message append an embedded message, even empty as it's always an element
+// of a repeated field
+func (b *protoBuffer) message(num protowire.Number, msg protoBuffer) {
+	*b = protowire.AppendTag(*b, num, protowire.BytesType)
+	*b = protowire.AppendBytes(*b, msg)
+}
+
+// repeatedString append an element of a repeated string field, even empty
+func (b *protoBuffer) repeatedString(num protowire.Number, s string) {
+	*b = protowire.AppendTag(*b, num, protowire.BytesType)
+	*b = protowire.AppendString(*b, s)
+}
+
+func (b *protoBuffer) ids(num protowire.Number, ids []entity.Id) {
+	for _, id := range ids {
+		b.repeatedString(num, id.String())
+	}
+}
+
+// stringMap append a map, sorted by key to have a deterministic output
+func (b *protoBuffer) stringMap(num protowire.Number, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		var entry protoBuffer
+		entry.string(fieldMapKey, k)
+		entry.string(fieldMapValue, m[k])
+		b.message(num, entry)
+	}
+}
+
+// walkProto call f for each field of an encoded protobuf message, with the
+// value of the varint fields or the content of the length-delimited ones.
+// The fields of other wire types are skipped.
+func walkProto(b []byte, f func(num protowire.Number, v uint64, raw []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		var v uint64
+		var raw []byte
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			raw, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			b = b[n:]
+			continue
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if err := f(num, v, raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeMapEntry decode an entry of a map<string, string>
+func decodeMapEntry(raw []byte, m map[string]string) error {
+	var key, value string
+	err := walkProto(raw, func(num protowire.Number, v uint64, raw []byte) error {
+		switch num {
+		case fieldMapKey:
+			key = string(raw)
+		case fieldMapValue:
+			value = string(raw)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	m[key] = value
+	return nil
+}
+
+func encodeBugExcerpt(e *BugExcerpt) protoBuffer {
+	var b protoBuffer
+	b.string(fieldBugId, e.Id.String())
+	b.varint(fieldBugCreateLamportTime, uint64(e.CreateLamportTime))
+	b.varint(fieldBugEditLamportTime, uint64(e.EditLamportTime))
+	b.varint(fieldBugCreateUnixTime, uint64(e.CreateUnixTime))
+	b.varint(fieldBugEditUnixTime, uint64(e.EditUnixTime))
+	b.string(fieldBugAuthorId, e.AuthorId.String())
+	b.varint(fieldBugStatus, uint64(e.Status))
+	for _, label := range e.Labels {
+		b.repeatedString(fieldBugLabels, label.String())
+	}
+	b.string(fieldBugTitle, e.Title)
+	b.varint(fieldBugLenComments, uint64(e.LenComments))
+	b.ids(fieldBugActors, e.Actors)
+	b.ids(fieldBugParticipants, e.Participants)
+	b.bool(fieldBugAwaitingReporter, e.AwaitingReporter)
+	b.stringMap(fieldBugFields, e.Fields)
+	b.bool(fieldBugSyncConflict, e.SyncConflict)
+	b.bool(fieldBugConfidential, e.Confidential)
+	for _, event := range e.Activity {
+		var eb protoBuffer
+		eb.varint(fieldActivityKind, uint64(event.Kind))
+		eb.string(fieldActivityAuthorId, event.AuthorId.String())
+		eb.varint(fieldActivityUnixTime, uint64(event.UnixTime))
+		b.message(fieldBugActivity, eb)
+	}
+	b.stringMap(fieldBugCreateMetadata, e.CreateMetadata)
+	b.string(fieldBugDuplicateOf, e.DuplicateOf.String())
+	b.varint(fieldBugLastCommentUnixTime, uint64(e.LastCommentUnixTime))
+	for _, relation := range e.Relations {
+		var rb protoBuffer
+		rb.string(fieldRelationType, relation.Type.String())
+		rb.string(fieldRelationTarget, relation.Target.String())
+		b.message(fieldBugRelations, rb)
+	}
+	b.ids(fieldBugAssignees, e.Assignees)
+	b.string(fieldBugState, e.State)
+	for _, prefix := range e.References {
+		b.repeatedString(fieldBugReferences, prefix)
+	}
+	return b
+}
+
+func decodeBugExcerpt(raw []byte) (*BugExcerpt, error) {
+	e := &BugExcerpt{}
+	err := walkProto(raw, func(num protowire.Number, v uint64, raw []byte) error {
+		switch num {
+		case fieldBugId:
+			e.Id = entity.Id(raw)
+		case fieldBugCreateLamportTime:
+			e.CreateLamportTime = lamport.Time(v)
+		case fieldBugEditLamportTime:
+			e.EditLamportTime = lamport.Time(v)
+		case fieldBugCreateUnixTime:
+			e.CreateUnixTime = int64(v)
+		case fieldBugEditUnixTime:
+			e.EditUnixTime = int64(v)
+		case fieldBugAuthorId:
+			e.AuthorId = entity.Id(raw)
+		case fieldBugStatus:
+			e.Status = common.Status(v)
+		case fieldBugLabels:
+			e.Labels = append(e.Labels, bug.Label(raw))
+		case fieldBugTitle:
+			e.Title = string(raw)
+		case fieldBugLenComments:
+			e.LenComments = int(v)
+		case fieldBugActors:
+			e.Actors = append(e.Actors, entity.Id(raw))
+		case fieldBugParticipants:
+			e.Participants = append(e.Participants, entity.Id(raw))
+		case fieldBugAwaitingReporter:
+			e.AwaitingReporter = v != 0
+		case fieldBugFields:
+			if e.Fields == nil {
+				e.Fields = make(map[string]string)
+			}
+			return decodeMapEntry(raw, e.Fields)
+		case fieldBugSyncConflict:
+			e.SyncConflict = v != 0
+		case fieldBugConfidential:
+			e.Confidential = v != 0
+		case fieldBugActivity:
+			var event ActivityEvent
+			err := walkProto(raw, func(num protowire.Number, v uint64, raw []byte) error {
+				switch num {
+				case fieldActivityKind:
+					event.Kind = ActivityKind(v)
+				case fieldActivityAuthorId:
+					event.AuthorId = entity.Id(raw)
+				case fieldActivityUnixTime:
+					event.UnixTime = int64(v)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			e.Activity = append(e.Activity, event)
+		case fieldBugCreateMetadata:
+			if e.CreateMetadata == nil {
+				e.CreateMetadata = make(map[string]string)
+			}
+			return decodeMapEntry(raw, e.CreateMetadata)
+		case fieldBugDuplicateOf:
+			e.DuplicateOf = entity.Id(raw)
+		case fieldBugLastCommentUnixTime:
+			e.LastCommentUnixTime = int64(v)
+		case fieldBugRelations:
+			var relation bug.Relation
+			err := walkProto(raw, func(num protowire.Number, v uint64, raw []byte) error {
+				switch num {
+				case fieldRelationType:
+					relation.Type = bug.RelationType(raw)
+				case fieldRelationTarget:
+					relation.Target = entity.Id(raw)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			e.Relations = append(e.Relations, relation)
+		case fieldBugAssignees:
+			e.Assignees = append(e.Assignees, entity.Id(raw))
+		case fieldBugState:
+			e.State = string(raw)
+		case fieldBugReferences:
+			e.References = append(e.References, string(raw))
+		}
+		return nil
+	})
+	return e, err
+}
+
+func encodeIdentityExcerpt(e *IdentityExcerpt) protoBuffer {
+	var b protoBuffer
+	b.string(fieldIdentityId, e.Id.String())
+	b.string(fieldIdentityName, e.Name)
+	b.string(fieldIdentityLogin, e.Login)
+	b.stringMap(fieldIdentityImmutableMetadata, e.ImmutableMetadata)
+	b.ids(fieldIdentityTrusted, e.Trusted)
+	b.string(fieldIdentityEmail, e.Email)
+	return b
+}
+
+func decodeIdentityExcerpt(raw []byte) (*IdentityExcerpt, error) {
+	e := &IdentityExcerpt{}
+	err := walkProto(raw, func(num protowire.Number, v uint64, raw []byte) error {
+		switch num {
+		case fieldIdentityId:
+			e.Id = entity.Id(raw)
+		case fieldIdentityName:
+			e.Name = string(raw)
+		case fieldIdentityLogin:
+			e.Login = string(raw)
+		case fieldIdentityImmutableMetadata:
+			if e.ImmutableMetadata == nil {
+				e.ImmutableMetadata = make(map[string]string)
+			}
+			return decodeMapEntry(raw, e.ImmutableMetadata)
+		case fieldIdentityTrusted:
+			e.Trusted = append(e.Trusted, entity.Id(raw))
+		case fieldIdentityEmail:
+			e.Email = string(raw)
+		}
+		return nil
+	})
+	return e, err
+}
+
+// sortedExcerptIds return the ids of a map of excerpts, sorted to have a
+// deterministic output
+func sortedExcerptIds[E any](excerpts map[entity.Id]E) []entity.Id {
+	ids := make([]entity.Id, 0, len(excerpts))
+	for id := range excerpts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// encodeBugCache encode the content of the bug cache file
+func encodeBugCache(data *bugCacheData) []byte {
+	b := protoBuffer(cacheFileMagic)
+	b.varint(fieldCacheVersion, uint64(data.Version))
+	for _, id := range sortedExcerptIds(data.Excerpts) {
+		b.message(fieldCacheExcerpts, encodeBugExcerpt(data.Excerpts[id]))
+	}
+	tips := make(map[string]string, len(data.Tips))
+	for id, tip := range data.Tips {
+		tips[id.String()] = tip.String()
+	}
+	b.stringMap(fieldCacheTips, tips)
+	return b
+}
+
+// decodeBugCache decode the content of the bug cache file, in the current or
+// the legacy gob encoding
+func decodeBugCache(content []byte) (*bugCacheData, error) {
+	if !bytes.HasPrefix(content, []byte(cacheFileMagic)) {
+		var data bugCacheData
+		err := gob.NewDecoder(bytes.NewReader(content)).Decode(&data)
+		if err != nil {
+			return nil, err
+		}
+		return &data, nil
+	}
+
+	data := &bugCacheData{
+		Excerpts: make(map[entity.Id]*BugExcerpt),
+		Tips:     make(map[entity.Id]repository.Hash),
+	}
+	tips := make(map[string]string)
+
+	err := walkProto(content[len(cacheFileMagic):], func(num protowire.Number, v uint64, raw []byte) error {
+		switch num {
+		case fieldCacheVersion:
+			data.Version = uint(v)
+		case fieldCacheExcerpts:
+			excerpt, err := decodeBugExcerpt(raw)
+			if err != nil {
+				return err
+			}
+			data.Excerpts[excerpt.Id] = excerpt
+		case fieldCacheTips:
+			return decodeMapEntry(raw, tips)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("decoding the bug cache: %w", err)
+	}
+
+	for id, tip := range tips {
+		data.Tips[entity.Id(id)] = repository.Hash(tip)
+	}
+
+	return data, nil
+}
+
+// decodeLegacyIdentityCache decode an identity cache file in the legacy gob
+// encoding
+func decodeLegacyIdentityCache(content []byte) (uint, map[entity.Id]*IdentityExcerpt, error) {
+	aux := struct {
+		Version  uint
+		Excerpts map[entity.Id]*IdentityExcerpt
+	}{}
+	err := gob.NewDecoder(bytes.NewReader(content)).Decode(&aux)
+	if err != nil {
+		return 0, nil, err
+	}
+	return aux.Version, aux.Excerpts, nil
+}
+
+// decodeCacheVersion only decode the format version of a cache file, whatever
+// its encoding and the format of the rest
+func decodeCacheVersion(content []byte) (uint, error) {
+	if !bytes.HasPrefix(content, []byte(cacheFileMagic)) {
+		aux := struct {
+			Version uint
+		}{}
+		err := gob.NewDecoder(bytes.NewReader(content)).Decode(&aux)
+		return aux.Version, err
+	}
+
+	var version uint
+	err := walkProto(content[len(cacheFileMagic):], func(num protowire.Number, v uint64, raw []byte) error {
+		if num == fieldCacheVersion {
+			version = uint(v)
+		}
+		return nil
+	})
+	return version, err
+}
@@ -0,0 +1,161 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// Event is emitted by the cache each time something changes. The concrete
+// types are BugCreated, BugUpdated, BugRemoved, IdentityUpdated and
+// MergeCompleted.
+type Event interface {
+	// Kind return the kind of the event, to filter them when subscribing
+	Kind() EventKind
+}
+
+// EventKind is a set of kinds of events, combined with a bitwise or, as in
+// EventBugCreated|EventBugUpdated
+type EventKind uint
+
+const (
+	EventBugCreated EventKind = 1 << iota
+	EventBugUpdated
+	EventBugRemoved
+	EventIdentityUpdated
+	EventMergeCompleted
+
+	// EventAll is the set of all the kinds of events
+	EventAll = EventBugCreated | EventBugUpdated | EventBugRemoved | EventIdentityUpdated | EventMergeCompleted
+)
+
+// BugCreated is emitted when a new bug has been created locally or
+// received from a remote.
+type BugCreated struct {
+	BugId entity.Id
+}
+
+// BugUpdated is emitted when a bug has been modified.
+type BugUpdated struct {
+	BugId entity.Id
+	// Operations are the new operations, if known. This is nil when the bug
+	// has been updated by a merge.
+	Operations []bug.Operation
+}
+
+// BugRemoved is emitted when a bug has been removed from the repository.
+type BugRemoved struct {
+	BugId entity.Id
+}
+
+// IdentityUpdated is emitted when an identity has been created or modified.
+type IdentityUpdated struct {
+	IdentityId entity.Id
+}
+
+// MergeCompleted is emitted at the end of a merge from a remote.
+type MergeCompleted struct {
+	Remote string
+	// Bugs are the bugs created or updated by the merge
+	Bugs []entity.Id
+	// Identities are the identities created or updated by the merge
+	Identities []entity.Id
+}
+
+func (BugCreated) Kind() EventKind      { return EventBugCreated }
+func (BugUpdated) Kind() EventKind      { return EventBugUpdated }
+func (BugRemoved) Kind() EventKind      { return EventBugRemoved }
+func (IdentityUpdated) Kind() EventKind { return EventIdentityUpdated }
+func (MergeCompleted) Kind() EventKind  { return EventMergeCompleted }
+
+// The number of events a subscriber can lag behind before events are dropped
+const eventBufferSize = 256
+
+// EventBus dispatch the cache events to any number of subscribers.
+//
+// Publishing never blocks: if a subscriber doesn't consume its events fast
+// enough, the events that don't fit in its buffer are dropped.
+type EventBus struct {
+	mu          sync.Mutex
+	// the subscribers, with the kinds of events they receive
+	subscribers map[chan Event]EventKind
+	// generation is incremented each time an event is published
+	generation uint64
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: make(map[chan Event]EventKind),
+	}
+}
+
+// Subscribe register a new subscriber. The returned function must be called
+// to unsubscribe, which close the events channel.
+func (eb *EventBus) Subscribe() (<-chan Event, func()) {
+	return eb.SubscribeKinds(EventAll)
+}
+
+// SubscribeKinds register a new subscriber, receiving only the given kinds of
+// events. The returned function must be called to unsubscribe, which close the
+// events channel.
+func (eb *EventBus) SubscribeKinds(kinds EventKind) (<-chan Event, func()) {
+	ch := make(chan Event, eventBufferSize)
+
+	eb.mu.Lock()
+	eb.subscribers[ch] = kinds
+	eb.mu.Unlock()
+
+	unsubscribe := func() {
+		eb.mu.Lock()
+		defer eb.mu.Unlock()
+
+		// the bus might have been closed already
+		if _, ok := eb.subscribers[ch]; ok {
+			delete(eb.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// Publish send an event to all the subscribers.
+func (eb *EventBus) Publish(event Event) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	eb.generation++
+
+	for ch, kinds := range eb.subscribers {
+		if kinds&event.Kind() == 0 {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			// subscriber is lagging, drop the event
+		}
+	}
+}
+
+// Generation return the number of events published so far. As every change
+// of the cache publishes an event, an unchanged generation means an unchanged
+// cache.
+func (eb *EventBus) Generation() uint64 {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	return eb.generation
+}
+
+// Close unsubscribe all the subscribers.
+func (eb *EventBus) Close() {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	for ch := range eb.subscribers {
+		delete(eb.subscribers, ch)
+		close(ch)
+	}
+}
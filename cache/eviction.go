@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// git config keys to set the maximum number of bugs, and the maximum estimated
+// memory in bytes, the cache keeps fully loaded before evicting the least
+// recently used ones.
+const maxLoadedBugsConfigKey = "git-bug.cache.max-loaded-bugs"
+const maxLoadedBytesConfigKey = "git-bug.cache.max-loaded-bytes"
+
+// the estimated memory used by an operation, besides its texts
+const opSizeOverhead = 512
+
+// estimateBugSize return a rough estimate of the memory used by a loaded bug.
+// The texts are counted twice, as they are held both by the operations and
+// by the compiled snapshot.
+func estimateBugSize(b *bug.Bug) int64 {
+	var size int64
+	for _, op := range b.Operations() {
+		size += opSizeOverhead
+		switch op := op.(type) {
+		case *bug.CreateOperation:
+			size += 2 * int64(len(op.Title)+len(op.Message))
+		case *bug.AddCommentOperation:
+			size += 2 * int64(len(op.Message))
+		case *bug.EditCommentOperation:
+			size += 2 * int64(len(op.Message))
+		case *bug.SetTitleOperation:
+			size += 2 * int64(len(op.Title))
+		}
+	}
+	return size
+}
+
+// readCacheLimits set the limits of the LRU of loaded bugs from the options,
+// falling back to the git config and then to the defaults.
+func (c *RepoCache) readCacheLimits(opts RepoCacheOptions) error {
+	c.maxLoadedBugs = opts.MaxLoadedBugs
+	if c.maxLoadedBugs <= 0 {
+		n, err := c.readLimitConfig(maxLoadedBugsConfigKey)
+		if err != nil {
+			return err
+		}
+		c.maxLoadedBugs = int(n)
+	}
+	if c.maxLoadedBugs <= 0 {
+		c.maxLoadedBugs = defaultMaxLoadedBugs
+	}
+
+	c.maxLoadedBytes = opts.MaxLoadedBytes
+	if c.maxLoadedBytes <= 0 {
+		n, err := c.readLimitConfig(maxLoadedBytesConfigKey)
+		if err != nil {
+			return err
+		}
+		c.maxLoadedBytes = n
+	}
+
+	return nil
+}
+
+func (c *RepoCache) readLimitConfig(key string) (int64, error) {
+	val, err := c.repo.AnyConfig().ReadString(key)
+	if err == repository.ErrNoConfigEntry {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %v", key, err)
+	}
+	return n, nil
+}
+
+// setCacheSize change the maximum number of loaded bugs
+func (c *RepoCache) setCacheSize(size int) {
+	c.maxLoadedBugs = size
+	c.evictIfNeeded()
+}
+
+// addLoadedBug record a newly loaded bug in the LRU.
+// muBug must be held for writing.
+func (c *RepoCache) addLoadedBug(b *BugCache) {
+	b.size = estimateBugSize(b.bug.Bug)
+	c.bugs[b.Id()] = b
+	c.loadedBugs.Add(b.Id())
+	c.loadedBytes += b.size
+}
+
+// resizeLoadedBug update the estimated size of a loaded bug after a change.
+// muBug must be held for writing.
+func (c *RepoCache) resizeLoadedBug(b *BugCache, size int64) {
+	c.loadedBytes += size - b.size
+	b.size = size
+}
+
+// dropLoadedBug forget a loaded bug, only its excerpt is kept.
+// muBug must be held for writing.
+func (c *RepoCache) dropLoadedBug(id entity.Id) {
+	if b, ok := c.bugs[id]; ok {
+		c.loadedBytes -= b.size
+		delete(c.bugs, id)
+	}
+	c.loadedBugs.Remove(id)
+}
+
+// overLimits tell if the loaded bugs exceed the count or memory limits.
+// muBug must be held.
+func (c *RepoCache) overLimits() bool {
+	if c.loadedBugs.Len() > c.maxLoadedBugs {
+		return true
+	}
+	return c.maxLoadedBytes > 0 && c.loadedBytes > c.maxLoadedBytes
+}
+
+// evictIfNeeded will evict the least recently used bugs from the cache if the
+// limits are exceeded. Bugs with uncommitted operations or pinned are kept, as
+// well as the most recently used one, so that a bug just loaded is returned
+// to the caller even if it exceeds the memory limit alone.
+func (c *RepoCache) evictIfNeeded() {
+	c.muBug.Lock()
+	defer c.muBug.Unlock()
+	if !c.overLimits() {
+		return
+	}
+
+	ids := c.loadedBugs.GetOldestToNewest()
+	if len(ids) == 0 {
+		return
+	}
+	for _, id := range ids[:len(ids)-1] {
+		b := c.bugs[id]
+		if b.NeedCommit() || b.pins > 0 {
+			continue
+		}
+
+		b.mu.Lock()
+		c.dropLoadedBug(id)
+
+		if !c.overLimits() {
+			return
+		}
+	}
+}
+
+// LoadedBytes return the estimated memory used by the bugs fully loaded in
+// memory.
+func (c *RepoCache) LoadedBytes() int64 {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+	return c.loadedBytes
+}
+
+// Pin prevent the bug from being evicted from the cache, typically while it is
+// being edited interactively. An evicted BugCache can't be used anymore, as
+// another copy could be loaded and edited concurrently.
+// The returned function must be called to release the pin.
+func (c *BugCache) Pin() func() {
+	c.repoCache.muBug.Lock()
+	c.pins++
+	c.repoCache.muBug.Unlock()
+
+	var released bool
+	return func() {
+		c.repoCache.muBug.Lock()
+		defer c.repoCache.muBug.Unlock()
+		if !released {
+			released = true
+			c.pins--
+		}
+	}
+}
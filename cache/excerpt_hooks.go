@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// ExcerptHook is notified each time a bug excerpt is created, updated or
+// removed. It allows an external index (search service, analytics ...) to be
+// kept in sync with the cache without walking all the bugs.
+//
+// Unlike the events of Subscribe, no notification is ever dropped: the hooks
+// are called synchronously, in order, after the cache has been updated and
+// without holding its locks. A hook should return quickly, as it delays the
+// operation that triggered it.
+type ExcerptHook interface {
+	// ExcerptCreated is called when a new bug has been created or merged
+	ExcerptCreated(excerpt *BugExcerpt)
+	// ExcerptUpdated is called when a bug has been modified
+	ExcerptUpdated(excerpt *BugExcerpt)
+	// ExcerptRemoved is called when a bug has been removed
+	ExcerptRemoved(id entity.Id)
+}
+
+// excerptHooks is the set of registered ExcerptHook
+type excerptHooks struct {
+	// mu is held for writing while a hook is registered, to make sure that
+	// the replayed excerpts are delivered before the live notifications
+	mu    sync.RWMutex
+	hooks map[*ExcerptHook]struct{}
+}
+
+// RegisterExcerptHook register a hook notified of the changes of the bug
+// excerpts. The returned function unregister the hook.
+//
+// Before returning, the excerpts edited after since are replayed to the hook
+// as updates, from the oldest to the most recent edition. since is a logical
+// time of the bug editions, as in BugExcerpt.EditLamportTime. An indexer
+// persisting the highest EditLamportTime it received can resync incrementally
+// by registering with it, while a zero since replay every excerpt.
+//
+// Bugs removed while no hook was registered can't be replayed: they are the
+// ones of the index missing from AllBugsIds.
+func (c *RepoCache) RegisterExcerptHook(hook ExcerptHook, since lamport.Time) func() {
+	key := &hook
+
+	c.excerptHooks.mu.Lock()
+	if c.excerptHooks.hooks == nil {
+		c.excerptHooks.hooks = make(map[*ExcerptHook]struct{})
+	}
+	c.excerptHooks.hooks[key] = struct{}{}
+
+	c.muBug.RLock()
+	var replay []*BugExcerpt
+	for _, excerpt := range c.bugExcerpts {
+		if since == 0 || excerpt.EditLamportTime > since {
+			replay = append(replay, excerpt)
+		}
+	}
+	c.muBug.RUnlock()
+
+	sort.Sort(BugsByEditTime(replay))
+	for _, excerpt := range replay {
+		hook.ExcerptUpdated(excerpt)
+	}
+	c.excerptHooks.mu.Unlock()
+
+	return func() {
+		c.excerptHooks.mu.Lock()
+		delete(c.excerptHooks.hooks, key)
+		c.excerptHooks.mu.Unlock()
+	}
+}
+
+// notifyExcerptChanged call the hooks for a created or updated excerpt.
+// The bug lock must not be held.
+func (c *RepoCache) notifyExcerptChanged(excerpt *BugExcerpt, created bool) {
+	c.excerptHooks.mu.RLock()
+	defer c.excerptHooks.mu.RUnlock()
+
+	for hook := range c.excerptHooks.hooks {
+		if created {
+			(*hook).ExcerptCreated(excerpt)
+		} else {
+			(*hook).ExcerptUpdated(excerpt)
+		}
+	}
+}
+
+// notifyExcerptRemoved call the hooks for a removed excerpt.
+// The bug lock must not be held.
+func (c *RepoCache) notifyExcerptRemoved(id entity.Id) {
+	c.excerptHooks.mu.RLock()
+	defer c.excerptHooks.mu.RUnlock()
+
+	for hook := range c.excerptHooks.hooks {
+		(*hook).ExcerptRemoved(id)
+	}
+}
@@ -0,0 +1,358 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blevesearch/bleve"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
+)
+
+// FilterExplanation tell if a single filter of a query match a bug, and why
+type FilterExplanation struct {
+	// Filter is the filter, as written in the query language
+	Filter  string
+	Matched bool
+	// Reason describe the value of the bug the filter has been compared to
+	Reason string
+}
+
+// FilterGroupExplanation gather the filters of a query sharing the same qualifier
+type FilterGroupExplanation struct {
+	Qualifier string
+	// AnyOf is true when matching one of the filters is enough, otherwise all
+	// of them need to match
+	AnyOf   bool
+	Matched bool
+	Filters []FilterExplanation
+}
+
+// QueryExplanation detail how each filter of a query applies to a bug
+type QueryExplanation struct {
+	Excerpt *BugExcerpt
+	Groups  []FilterGroupExplanation
+	// Matched is true if the bug is part of the query results
+	Matched bool
+}
+
+// ExplainQuery detail, for a single bug, which filters of a query match or
+// fail and why, to help understand why a bug is or isn't part of the results.
+// The sorting of the query is ignored.
+func (c *RepoCache) ExplainQuery(q *query.Query, id entity.Id) (*QueryExplanation, error) {
+	excerpt, err := c.ResolveBugExcerpt(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &QueryExplanation{Excerpt: excerpt, Matched: true}
+
+	addGroup := func(qualifier string, anyOf bool, filters []FilterExplanation) {
+		if len(filters) == 0 {
+			return
+		}
+		group := FilterGroupExplanation{Qualifier: qualifier, AnyOf: anyOf, Filters: filters, Matched: !anyOf}
+		for _, f := range filters {
+			if anyOf && f.Matched {
+				group.Matched = true
+			}
+			if !anyOf && !f.Matched {
+				group.Matched = false
+			}
+		}
+		result.Groups = append(result.Groups, group)
+		result.Matched = result.Matched && group.Matched
+	}
+
+	if len(q.Search) > 0 {
+		matched, err := c.matchFullText(q.Search, id)
+		if err != nil {
+			return nil, err
+		}
+		reason := "the text of the bug contains the terms"
+		if !matched {
+			reason = "the text of the bug doesn't contain the terms"
+		}
+		addGroup("search", false, []FilterExplanation{{
+			Filter:  fullTextQuery(q.Search),
+			Matched: matched,
+			Reason:  reason,
+		}})
+	}
+
+	var filters []FilterExplanation
+
+	for _, status := range q.Status {
+		filters = append(filters, FilterExplanation{
+			Filter:  "status:" + status.String(),
+			Matched: StatusFilter(status)(excerpt, c),
+			Reason:  "status is " + excerpt.Status.String(),
+		})
+	}
+	if len(q.State) > 0 {
+		workflow, err := c.Workflow()
+		if err != nil {
+			return nil, err
+		}
+		state := workflow.StateOf(excerpt.Status, excerpt.State)
+		for _, value := range q.State {
+			filters = append(filters, FilterExplanation{
+				Filter:  "status:" + value,
+				Matched: StateFilter(workflow, value)(excerpt, c),
+				Reason:  "state is " + state,
+			})
+		}
+	}
+	addGroup("status", true, filters)
+
+	filters = nil
+	for _, value := range q.Author {
+		filters = append(filters, FilterExplanation{
+			Filter:  "author:" + value,
+			Matched: AuthorFilter(value)(excerpt, c),
+			Reason:  "author is " + c.describeIdentities([]entity.Id{excerpt.AuthorId}),
+		})
+	}
+	addGroup("author", true, filters)
+
+	if len(q.AuthorType) > 0 || len(q.NotAuthorType) > 0 {
+		author, err := c.ResolveIdentityExcerpt(excerpt.AuthorId)
+		if err != nil {
+			return nil, err
+		}
+		reason := "author is a " + query.AuthorTypeHuman
+		if author.IsBot() {
+			reason = "author is a " + query.AuthorTypeBot
+		}
+
+		filters = nil
+		for _, value := range q.AuthorType {
+			filters = append(filters, FilterExplanation{
+				Filter:  "author:type:" + value,
+				Matched: AuthorTypeFilter(value)(excerpt, c),
+				Reason:  reason,
+			})
+		}
+		addGroup("author type", true, filters)
+
+		filters = nil
+		for _, value := range q.NotAuthorType {
+			filters = append(filters, FilterExplanation{
+				Filter:  "-author:type:" + value,
+				Matched: NotFilter(AuthorTypeFilter(value))(excerpt, c),
+				Reason:  reason,
+			})
+		}
+		addGroup("-author type", false, filters)
+	}
+
+	filters = nil
+	for _, pair := range q.Metadata {
+		reason := fmt.Sprintf("no metadata %s at creation", pair.Key)
+		if value, ok := excerpt.CreateMetadata[pair.Key]; ok {
+			reason = fmt.Sprintf("metadata %s is %q", pair.Key, value)
+		}
+		filters = append(filters, FilterExplanation{
+			Filter:  fmt.Sprintf("metadata:%s:%s", pair.Key, pair.Value),
+			Matched: MetadataFilter(pair)(excerpt, c),
+			Reason:  reason,
+		})
+	}
+	addGroup("metadata", true, filters)
+
+	filters = nil
+	for _, pair := range q.Field {
+		reason := fmt.Sprintf("field %s is not set", pair.Key)
+		if value, ok := excerpt.Fields[pair.Key]; ok {
+			reason = fmt.Sprintf("field %s is %q", pair.Key, value)
+		}
+		filters = append(filters, FilterExplanation{
+			Filter:  fmt.Sprintf("field:%s:%s", pair.Key, pair.Value),
+			Matched: FieldFilter(pair)(excerpt, c),
+			Reason:  reason,
+		})
+	}
+	addGroup("field", false, filters)
+
+	filters = nil
+	for _, value := range q.Participant {
+		filters = append(filters, FilterExplanation{
+			Filter:  "participant:" + value,
+			Matched: ParticipantFilter(value)(excerpt, c),
+			Reason:  "participants are " + c.describeIdentities(excerpt.Participants),
+		})
+	}
+	addGroup("participant", true, filters)
+
+	filters = nil
+	for _, value := range q.Actor {
+		filters = append(filters, FilterExplanation{
+			Filter:  "actor:" + value,
+			Matched: ActorFilter(value)(excerpt, c),
+			Reason:  "actors are " + c.describeIdentities(excerpt.Actors),
+		})
+	}
+	addGroup("actor", true, filters)
+
+	filters = nil
+	for _, value := range q.Assignee {
+		filters = append(filters, FilterExplanation{
+			Filter:  "assignee:" + value,
+			Matched: AssigneeFilter(value)(excerpt, c),
+			Reason:  "assignees are " + c.describeIdentities(excerpt.Assignees),
+		})
+	}
+	addGroup("assignee", true, filters)
+
+	filters = nil
+	for _, value := range q.Label {
+		filters = append(filters, FilterExplanation{
+			Filter:  "label:" + value,
+			Matched: LabelFilter(value)(excerpt, c),
+			Reason:  describeLabels(excerpt.Labels),
+		})
+	}
+	addGroup("label", false, filters)
+
+	filters = nil
+	for _, pair := range q.Relation {
+		filters = append(filters, FilterExplanation{
+			Filter:  fmt.Sprintf("%s:%s", pair.Key, pair.Value),
+			Matched: RelationFilter(pair)(excerpt, c),
+			Reason:  describeRelations(excerpt.Relations),
+		})
+	}
+	addGroup("relation", false, filters)
+
+	filters = nil
+	if q.NoLabel {
+		filters = append(filters, FilterExplanation{
+			Filter:  "no:label",
+			Matched: NoLabelFilter()(excerpt, c),
+			Reason:  describeLabels(excerpt.Labels),
+		})
+	}
+	if q.NoAssignee {
+		filters = append(filters, FilterExplanation{
+			Filter:  "no:assignee",
+			Matched: NoAssigneeFilter()(excerpt, c),
+			Reason:  "assignees are " + c.describeIdentities(excerpt.Assignees),
+		})
+	}
+	if q.AwaitingReporter {
+		reason := "not awaiting the reporter"
+		if excerpt.AwaitingReporter {
+			reason = "awaiting the reporter"
+		}
+		filters = append(filters, FilterExplanation{
+			Filter:  "awaiting:reporter",
+			Matched: AwaitingReporterFilter()(excerpt, c),
+			Reason:  reason,
+		})
+	}
+	if q.SyncConflict {
+		reason := "no sync conflict recorded"
+		if excerpt.SyncConflict {
+			reason = "a sync conflict is recorded"
+		}
+		filters = append(filters, FilterExplanation{
+			Filter:  "has:sync-conflict",
+			Matched: SyncConflictFilter()(excerpt, c),
+			Reason:  reason,
+		})
+	}
+	if q.Unread {
+		unread, err := c.isUnread(excerpt)
+		if err != nil {
+			return nil, err
+		}
+		reason := "read since its last edition"
+		if unread {
+			reason = "edited since last read"
+		}
+		filters = append(filters, FilterExplanation{
+			Filter:  "is:unread",
+			Matched: unread,
+			Reason:  reason,
+		})
+	}
+	addGroup("flags", false, filters)
+
+	filters = nil
+	for _, value := range q.Title {
+		filters = append(filters, FilterExplanation{
+			Filter:  "title:" + value,
+			Matched: TitleFilter(value)(excerpt, c),
+			Reason:  fmt.Sprintf("title is %q", excerpt.Title),
+		})
+	}
+	addGroup("title", false, filters)
+
+	return result, nil
+}
+
+// matchFullText tell if a bug match the full text search terms
+func (c *RepoCache) matchFullText(search query.Search, id entity.Id) (bool, error) {
+	index, err := c.repo.GetBleveIndex("bug")
+	if err != nil {
+		return false, err
+	}
+
+	bleveQuery := bleve.NewConjunctionQuery(
+		bleve.NewQueryStringQuery(fullTextQuery(search)),
+		bleve.NewDocIDQuery([]string{id.String()}),
+	)
+
+	searchResults, err := index.Search(bleve.NewSearchRequest(bleveQuery))
+	if err != nil {
+		return false, err
+	}
+
+	return searchResults.Total > 0, nil
+}
+
+// describeIdentities return a human readable list of identities
+func (c *RepoCache) describeIdentities(ids []entity.Id) string {
+	if len(ids) == 0 {
+		return "nobody"
+	}
+
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		excerpt, err := c.ResolveIdentityExcerpt(id)
+		if err != nil {
+			names[i] = id.Human()
+			continue
+		}
+		names[i] = fmt.Sprintf("%s [%s]", excerpt.DisplayName(), id.Human())
+	}
+	return strings.Join(names, ", ")
+}
+
+func describeRelations(relations []bug.Relation) string {
+	if len(relations) == 0 {
+		return "no relations"
+	}
+
+	names := make([]string, len(relations))
+	for i, relation := range relations {
+		names[i] = relation.String()
+	}
+	return "relations are " + strings.Join(names, ", ")
+}
+
+func describeLabels(labels []bug.Label) string {
+	if len(labels) == 0 {
+		return "no labels"
+	}
+
+	names := make([]string, len(labels))
+	for i, label := range labels {
+		names[i] = label.String()
+	}
+	sort.Strings(names)
+	return "labels are " + strings.Join(names, ", ")
+}
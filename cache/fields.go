@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+// FieldDefinitions return the custom fields defined in the repository
+// configuration, sorted by name
+func (c *RepoCache) FieldDefinitions() ([]bug.FieldDefinition, error) {
+	return bug.LoadFieldDefinitions(c.repo.AnyConfig())
+}
+
+// validateField check that a field is defined, and that the value is valid
+// for its type. A field can always be unset, even if not defined anymore.
+func (c *RepoCache) validateField(defs []bug.FieldDefinition, name string, value string) error {
+	if value == "" {
+		return nil
+	}
+	if def, ok := findFieldDefinition(defs, name); ok {
+		return def.ValidateValue(value)
+	}
+	return fmt.Errorf("unknown field \"%s\"", name)
+}
+
+func findFieldDefinition(defs []bug.FieldDefinition, name string) (bug.FieldDefinition, bool) {
+	for _, def := range defs {
+		if def.Name == name {
+			return def, true
+		}
+	}
+	return bug.FieldDefinition{}, false
+}
+
+// validateStagedFields check the custom field values waiting to be committed
+func (c *RepoCache) validateStagedFields(b *bug.Bug) error {
+	var defs []bug.FieldDefinition
+	for _, op := range b.StagedOperations() {
+		op, ok := op.(*bug.SetFieldOperation)
+		if !ok {
+			continue
+		}
+		if defs == nil {
+			var err error
+			defs, err = c.FieldDefinitions()
+			if err != nil {
+				return err
+			}
+		}
+		if err := c.validateField(defs, op.Name, op.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldSortKey sort the bugs by the value of a custom field: in the order of
+// the values of an enum, numerically for a number and lexically otherwise.
+// The bugs without value come last, whatever the direction.
+func fieldSortKey(def bug.FieldDefinition, descending bool) BugSortKey {
+	return func(b []*BugExcerpt, i, j int) bool {
+		vi, vj := b[i].Fields[def.Name], b[j].Fields[def.Name]
+		if vi == "" || vj == "" {
+			return vi != "" && vj == ""
+		}
+		cmp := compareFieldValues(def, vi, vj)
+		if descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	}
+}
+
+func compareFieldValues(def bug.FieldDefinition, a, b string) int {
+	switch def.Type {
+	case bug.FieldTypeEnum:
+		return fieldValueIndex(def, a) - fieldValueIndex(def, b)
+	case bug.FieldTypeNumber:
+		fa, errA := strconv.ParseFloat(a, 64)
+		fb, errB := strconv.ParseFloat(b, 64)
+		if errA == nil && errB == nil {
+			switch {
+			case fa < fb:
+				return -1
+			case fa > fb:
+				return 1
+			}
+			return 0
+		}
+	}
+	// the dates sort lexically as well
+	return strings.Compare(a, b)
+}
+
+// fieldValueIndex return the position of a value of an enum, the values no
+// longer allowed coming after the others
+func fieldValueIndex(def bug.FieldDefinition, value string) int {
+	for i, v := range def.Values {
+		if v == value {
+			return i
+		}
+	}
+	return len(def.Values)
+}
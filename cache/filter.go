@@ -3,6 +3,7 @@ package cache
 import (
 	"strings"
 
+	"github.com/MichaelMure/git-bug/entities/bug"
 	"github.com/MichaelMure/git-bug/entities/common"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/query"
@@ -12,6 +13,31 @@ import (
 // This exist mainly to go through the functions of the cache with proper locking.
 type resolver interface {
 	ResolveIdentityExcerpt(id entity.Id) (*IdentityExcerpt, error)
+	GetUserIdentityExcerpt() (*IdentityExcerpt, error)
+	isUnread(excerpt *BugExcerpt) (bool, error)
+}
+
+// meQuery is the identity query matching the user identity
+const meQuery = "me"
+
+// matchIdentity return true if the identity match the query.
+// The special query "me" match the user identity.
+func matchIdentity(resolver resolver, id entity.Id, query string) bool {
+	if query == meQuery {
+		user, err := resolver.GetUserIdentityExcerpt()
+		if err != nil {
+			// no user identity set
+			return false
+		}
+		return user.Id == id
+	}
+
+	identityExcerpt, err := resolver.ResolveIdentityExcerpt(id)
+	if err != nil {
+		panic(err)
+	}
+
+	return identityExcerpt.Match(query)
 }
 
 // Filter is a predicate that match a subset of bugs
@@ -24,17 +50,19 @@ func StatusFilter(status common.Status) Filter {
 	}
 }
 
+// StateFilter return a Filter that match a state of the workflow
+func StateFilter(workflow *bug.Workflow, state string) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return workflow.StateOf(excerpt.Status, excerpt.State) == state
+	}
+}
+
 // AuthorFilter return a Filter that match a bug author
 func AuthorFilter(query string) Filter {
 	return func(excerpt *BugExcerpt, resolver resolver) bool {
 		query = strings.ToLower(query)
 
-		author, err := resolver.ResolveIdentityExcerpt(excerpt.AuthorId)
-		if err != nil {
-			panic(err)
-		}
-
-		return author.Match(query)
+		return matchIdentity(resolver, excerpt.AuthorId, query)
 	}
 }
 
@@ -48,6 +76,13 @@ func MetadataFilter(pair query.StringPair) Filter {
 	}
 }
 
+// FieldFilter return a Filter that match the value of a custom field
+func FieldFilter(pair query.StringPair) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return excerpt.Fields[pair.Key] == pair.Value
+	}
+}
+
 // LabelFilter return a Filter that match a label
 func LabelFilter(label string) Filter {
 	return func(excerpt *BugExcerpt, resolver resolver) bool {
@@ -66,12 +101,7 @@ func ActorFilter(query string) Filter {
 		query = strings.ToLower(query)
 
 		for _, id := range excerpt.Actors {
-			identityExcerpt, err := resolver.ResolveIdentityExcerpt(id)
-			if err != nil {
-				panic(err)
-			}
-
-			if identityExcerpt.Match(query) {
+			if matchIdentity(resolver, id, query) {
 				return true
 			}
 		}
@@ -85,12 +115,29 @@ func ParticipantFilter(query string) Filter {
 		query = strings.ToLower(query)
 
 		for _, id := range excerpt.Participants {
-			identityExcerpt, err := resolver.ResolveIdentityExcerpt(id)
-			if err != nil {
-				panic(err)
+			if matchIdentity(resolver, id, query) {
+				return true
 			}
+		}
+		return false
+	}
+}
+
+// AssigneeFilter return a Filter that match a bug assignee. Unlike the
+// actors, an assignee might not be known locally, it's then only matched by
+// id prefix.
+func AssigneeFilter(query string) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		query = strings.ToLower(query)
 
-			if identityExcerpt.Match(query) {
+		for _, id := range excerpt.Assignees {
+			if _, err := resolver.ResolveIdentityExcerpt(id); err != nil {
+				if query != meQuery && id.HasPrefix(query) {
+					return true
+				}
+				continue
+			}
+			if matchIdentity(resolver, id, query) {
 				return true
 			}
 		}
@@ -108,6 +155,19 @@ func TitleFilter(query string) Filter {
 	}
 }
 
+// RelationFilter return a Filter that match a relation to another bug, given
+// the type of relation and a prefix of the id of the other bug
+func RelationFilter(pair query.StringPair) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		for _, relation := range excerpt.Relations {
+			if string(relation.Type) == pair.Key && relation.Target.HasPrefix(pair.Value) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 // NoLabelFilter return a Filter that match the absence of labels
 func NoLabelFilter() Filter {
 	return func(excerpt *BugExcerpt, resolver resolver) bool {
@@ -115,47 +175,136 @@ func NoLabelFilter() Filter {
 	}
 }
 
+// NoAssigneeFilter return a Filter that match the bugs assigned to nobody
+func NoAssigneeFilter() Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return len(excerpt.Assignees) == 0
+	}
+}
+
+// AwaitingReporterFilter return a Filter that match the bugs waiting for more
+// information from their reporter
+func AwaitingReporterFilter() Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return excerpt.AwaitingReporter
+	}
+}
+
+// SyncConflictFilter return a Filter that match the bugs with a synchronisation
+// conflict recorded by a bridge
+func SyncConflictFilter() Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return excerpt.SyncConflict
+	}
+}
+
+// UnreadFilter return a Filter that match the bugs edited since the user
+// identity last read them
+func UnreadFilter() Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		unread, err := resolver.isUnread(excerpt)
+		if err != nil {
+			panic(err)
+		}
+		return unread
+	}
+}
+
+// AuthorTypeFilter return a Filter that match the bugs opened by a bot or a
+// human, as in query.AuthorTypeBot
+func AuthorTypeFilter(authorType string) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		author, err := resolver.ResolveIdentityExcerpt(excerpt.AuthorId)
+		if err != nil {
+			panic(err)
+		}
+		return author.IsBot() == (authorType == query.AuthorTypeBot)
+	}
+}
+
+// NotFilter return a Filter that match the bugs not matched by filter
+func NotFilter(filter Filter) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return !filter(excerpt, resolver)
+	}
+}
+
 // Matcher is a collection of Filter that implement a complex filter
 type Matcher struct {
 	Status      []Filter
 	Author      []Filter
+	AuthorType  []Filter
 	Metadata    []Filter
+	Field       []Filter
 	Actor       []Filter
 	Participant []Filter
+	Assignee    []Filter
 	Label       []Filter
 	Title       []Filter
+	Relation    []Filter
 	NoFilters   []Filter
 }
 
 // compileMatcher transform a query.Filters into a specialized matcher
-// for the cache.
-func compileMatcher(filters query.Filters) *Matcher {
+// for the cache. The workflow is only needed to match a state.
+func compileMatcher(filters query.Filters, workflow *bug.Workflow) *Matcher {
 	result := &Matcher{}
 
+	// a bug can match either a status or a state, as in "status:open status:review"
 	for _, value := range filters.Status {
 		result.Status = append(result.Status, StatusFilter(value))
 	}
+	for _, value := range filters.State {
+		result.Status = append(result.Status, StateFilter(workflow, value))
+	}
 	for _, value := range filters.Author {
 		result.Author = append(result.Author, AuthorFilter(value))
 	}
+	for _, value := range filters.AuthorType {
+		result.AuthorType = append(result.AuthorType, AuthorTypeFilter(value))
+	}
+	for _, value := range filters.NotAuthorType {
+		result.NoFilters = append(result.NoFilters, NotFilter(AuthorTypeFilter(value)))
+	}
 	for _, value := range filters.Metadata {
 		result.Metadata = append(result.Metadata, MetadataFilter(value))
 	}
+	for _, value := range filters.Field {
+		result.Field = append(result.Field, FieldFilter(value))
+	}
 	for _, value := range filters.Actor {
 		result.Actor = append(result.Actor, ActorFilter(value))
 	}
 	for _, value := range filters.Participant {
 		result.Participant = append(result.Participant, ParticipantFilter(value))
 	}
+	for _, value := range filters.Assignee {
+		result.Assignee = append(result.Assignee, AssigneeFilter(value))
+	}
 	for _, value := range filters.Label {
 		result.Label = append(result.Label, LabelFilter(value))
 	}
 	for _, value := range filters.Title {
 		result.Title = append(result.Title, TitleFilter(value))
 	}
+	for _, value := range filters.Relation {
+		result.Relation = append(result.Relation, RelationFilter(value))
+	}
 	if filters.NoLabel {
 		result.NoFilters = append(result.NoFilters, NoLabelFilter())
 	}
+	if filters.NoAssignee {
+		result.NoFilters = append(result.NoFilters, NoAssigneeFilter())
+	}
+	if filters.AwaitingReporter {
+		result.NoFilters = append(result.NoFilters, AwaitingReporterFilter())
+	}
+	if filters.SyncConflict {
+		result.NoFilters = append(result.NoFilters, SyncConflictFilter())
+	}
+	if filters.Unread {
+		result.NoFilters = append(result.NoFilters, UnreadFilter())
+	}
 
 	return result
 }
@@ -170,10 +319,18 @@ func (f *Matcher) Match(excerpt *BugExcerpt, resolver resolver) bool {
 		return false
 	}
 
+	if match := f.orMatch(f.AuthorType, excerpt, resolver); !match {
+		return false
+	}
+
 	if match := f.orMatch(f.Metadata, excerpt, resolver); !match {
 		return false
 	}
 
+	if match := f.andMatch(f.Field, excerpt, resolver); !match {
+		return false
+	}
+
 	if match := f.orMatch(f.Participant, excerpt, resolver); !match {
 		return false
 	}
@@ -182,10 +339,18 @@ func (f *Matcher) Match(excerpt *BugExcerpt, resolver resolver) bool {
 		return false
 	}
 
+	if match := f.orMatch(f.Assignee, excerpt, resolver); !match {
+		return false
+	}
+
 	if match := f.andMatch(f.Label, excerpt, resolver); !match {
 		return false
 	}
 
+	if match := f.andMatch(f.Relation, excerpt, resolver); !match {
+		return false
+	}
+
 	if match := f.andMatch(f.NoFilters, excerpt, resolver); !match {
 		return false
 	}
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
 )
 
 func TestTitleFilter(t *testing.T) {
@@ -32,3 +35,51 @@ func TestTitleFilter(t *testing.T) {
 		})
 	}
 }
+
+type fakeResolver struct {
+	identities map[entity.Id]*IdentityExcerpt
+	user       entity.Id
+}
+
+func (f fakeResolver) ResolveIdentityExcerpt(id entity.Id) (*IdentityExcerpt, error) {
+	return f.identities[id], nil
+}
+
+func (f fakeResolver) GetUserIdentityExcerpt() (*IdentityExcerpt, error) {
+	if f.user == "" {
+		return nil, identity.ErrNoIdentitySet
+	}
+	return f.identities[f.user], nil
+}
+
+func (f fakeResolver) isUnread(excerpt *BugExcerpt) (bool, error) {
+	return true, nil
+}
+
+func TestMeFilter(t *testing.T) {
+	rene := &IdentityExcerpt{Id: "rene", Name: "René Descartes"}
+	isaac := &IdentityExcerpt{Id: "isaac", Name: "Isaac Newton"}
+
+	resolver := fakeResolver{
+		identities: map[entity.Id]*IdentityExcerpt{rene.Id: rene, isaac.Id: isaac},
+		user:       rene.Id,
+	}
+
+	excerpt := &BugExcerpt{
+		AuthorId:     isaac.Id,
+		Actors:       []entity.Id{isaac.Id, rene.Id},
+		Participants: []entity.Id{isaac.Id},
+	}
+
+	assert.False(t, AuthorFilter("me")(excerpt, resolver))
+	assert.True(t, AuthorFilter("isaac")(excerpt, resolver))
+	assert.True(t, ActorFilter("me")(excerpt, resolver))
+	assert.False(t, ParticipantFilter("me")(excerpt, resolver))
+
+	resolver.user = isaac.Id
+	assert.True(t, AuthorFilter("me")(excerpt, resolver))
+
+	// without a user identity, "me" match nothing
+	resolver.user = ""
+	assert.False(t, ActorFilter("me")(excerpt, resolver))
+}
@@ -0,0 +1,201 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// freezeConfigKeyPrefix is the git config prefix under which the freeze mode
+// is configured, as in "git-bug.freeze.<key> = <value>"
+const freezeConfigKeyPrefix = "git-bug.freeze."
+
+const (
+	freezeConfigKeyActive    = freezeConfigKeyPrefix + "active"
+	freezeConfigKeyLabels    = freezeConfigKeyPrefix + "labels"
+	freezeConfigKeyApprovers = freezeConfigKeyPrefix + "approvers"
+)
+
+// defaultFreezeLabel is the label of the bugs protected by the freeze mode
+// when none is configured
+const defaultFreezeLabel bug.Label = "release-blocker"
+
+// MetaKeyFreezeConfirmed is the metadata recorded on an operation closing a
+// protected bug while the repository is frozen, holding the protected label
+const MetaKeyFreezeConfirmed = "freeze-confirmed"
+
+// Freeze is the freeze mode of a repository, typically activated during the
+// stabilization of a release. While active, closing a bug carrying one of the
+// labels requires an explicit confirmation, from one of the approvers if any.
+type Freeze struct {
+	Active bool
+	// Labels are the labels of the protected bugs
+	Labels []bug.Label
+	// Approvers are the logins, emails or id prefixes of the identities
+	// allowed to confirm. When empty, anyone can confirm.
+	Approvers []string
+}
+
+// ErrFrozen is returned when closing a protected bug while the repository is
+// frozen, without the required confirmation
+type ErrFrozen struct {
+	Label     bug.Label
+	Approvers []string
+	// Confirmed is true when the confirmation was given by an identity that is
+	// not an approver
+	Confirmed bool
+}
+
+func (e *ErrFrozen) Error() string {
+	if e.Confirmed {
+		return fmt.Sprintf("the repository is frozen: closing a bug labeled %q requires the confirmation of one of %s",
+			e.Label, strings.Join(e.Approvers, ", "))
+	}
+	return fmt.Sprintf("the repository is frozen: closing a bug labeled %q requires a confirmation", e.Label)
+}
+
+// IsErrFrozen tell if the error is an ErrFrozen
+func IsErrFrozen(err error) bool {
+	_, ok := err.(*ErrFrozen)
+	return ok
+}
+
+// Freeze return the freeze mode of the repository, as configured in the git
+// config. A freeze without labels protects the "release-blocker" label.
+func (c *RepoCache) Freeze() (Freeze, error) {
+	return readFreeze(c.repo.AnyConfig())
+}
+
+func readFreeze(config repository.ConfigRead) (Freeze, error) {
+	var result Freeze
+
+	active, err := config.ReadBool(freezeConfigKeyActive)
+	switch err {
+	case nil:
+		result.Active = active
+	case repository.ErrNoConfigEntry:
+	default:
+		return Freeze{}, err
+	}
+
+	labels, err := config.ReadString(freezeConfigKeyLabels)
+	if err != nil && err != repository.ErrNoConfigEntry {
+		return Freeze{}, err
+	}
+	for _, raw := range splitFreezeList(labels) {
+		label := bug.Label(raw)
+		if err := label.Validate(); err != nil {
+			return Freeze{}, fmt.Errorf("invalid freeze label: %w", err)
+		}
+		result.Labels = append(result.Labels, label)
+	}
+	if len(result.Labels) == 0 {
+		result.Labels = []bug.Label{defaultFreezeLabel}
+	}
+
+	approvers, err := config.ReadString(freezeConfigKeyApprovers)
+	if err != nil && err != repository.ErrNoConfigEntry {
+		return Freeze{}, err
+	}
+	result.Approvers = splitFreezeList(approvers)
+
+	return result, nil
+}
+
+// SetFreeze write the freeze mode in the local git config of the repository
+func (c *RepoCache) SetFreeze(freeze Freeze) error {
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+
+	for _, label := range freeze.Labels {
+		if err := label.Validate(); err != nil {
+			return err
+		}
+	}
+
+	config := c.repo.LocalConfig()
+
+	err := config.StoreBool(freezeConfigKeyActive, freeze.Active)
+	if err != nil {
+		return err
+	}
+
+	labels := make([]string, len(freeze.Labels))
+	for i, label := range freeze.Labels {
+		labels[i] = label.String()
+	}
+
+	// an empty list is stored as well, to override a global config
+	err = config.StoreString(freezeConfigKeyLabels, strings.Join(labels, ","))
+	if err != nil {
+		return err
+	}
+
+	return config.StoreString(freezeConfigKeyApprovers, strings.Join(freeze.Approvers, ","))
+}
+
+// FreezeMetadata check if the author can close the bug while the repository
+// is frozen, and return the metadata to record on the closing operation.
+// confirmed is the explicit confirmation given by the author. An ErrFrozen is
+// returned if the bug is protected and the closing is not confirmed, or not by
+// one of the approvers.
+//
+// The bridges importing a closing done remotely don't go through this check.
+func (c *BugCache) FreezeMetadata(author *IdentityCache, confirmed bool) (map[string]string, error) {
+	return c.repoCache.freezeMetadata(c.Snapshot(), author, confirmed)
+}
+
+func (c *RepoCache) freezeMetadata(snap *bug.Snapshot, author *IdentityCache, confirmed bool) (map[string]string, error) {
+	freeze, err := c.Freeze()
+	if err != nil {
+		return nil, err
+	}
+	if !freeze.Active {
+		return nil, nil
+	}
+
+	if snap.Status == common.ClosedStatus {
+		return nil, nil
+	}
+
+	var protected bug.Label
+	for _, label := range freeze.Labels {
+		if hasLabel(snap, label) {
+			protected = label
+			break
+		}
+	}
+	if protected == "" {
+		return nil, nil
+	}
+
+	if !confirmed || (len(freeze.Approvers) > 0 && !isFreezeApprover(freeze.Approvers, author)) {
+		return nil, &ErrFrozen{Label: protected, Approvers: freeze.Approvers, Confirmed: confirmed}
+	}
+
+	return map[string]string{MetaKeyFreezeConfirmed: protected.String()}, nil
+}
+
+func isFreezeApprover(approvers []string, author *IdentityCache) bool {
+	for _, approver := range approvers {
+		if approver == author.Login() || approver == author.Email() || author.Id().HasPrefix(approver) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitFreezeList(raw string) []string {
+	var result []string
+	for _, s := range strings.Split(raw, ",") {
+		s = strings.TrimSpace(s)
+		if s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
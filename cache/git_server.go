@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// the prefix of the git refs of the identities
+const identityRefPrefix = "refs/identities/"
+
+// ErrGitServerUnsupported is returned when the repository can't serve its
+// refs to the git clients
+var ErrGitServerUnsupported = errors.New("the repository can't serve its refs to git clients")
+
+// ErrGitPushRefused is returned when a git client push to a git server that
+// doesn't accept it
+var ErrGitPushRefused = errors.New("push refused")
+
+// GitServer return a git server exposing the refs of the bugs and identities
+// to the git clients, whatever the transport. If push is true and the cache
+// is writable, the clients can also push the fast-forward updates of those
+// refs, and the cache is refreshed accordingly.
+func (c *RepoCache) GitServer(push bool) (transport.Transport, error) {
+	gs, ok := c.repo.(repository.RepoGitServer)
+	if !ok {
+		return nil, ErrGitServerUnsupported
+	}
+
+	return &gitServer{
+		Transport: gs.GitServer([]string{bugRefPrefix, identityRefPrefix}),
+		cache:     c,
+		push:      push && !c.readOnly,
+	}, nil
+}
+
+// gitServer wrap the git server of the repository, to refresh the cache
+// after a push
+type gitServer struct {
+	transport.Transport
+	cache *RepoCache
+	push  bool
+}
+
+func (gs *gitServer) NewReceivePackSession(ep *transport.Endpoint, auth transport.AuthMethod) (transport.ReceivePackSession, error) {
+	if !gs.push {
+		return nil, ErrGitPushRefused
+	}
+	session, err := gs.Transport.NewReceivePackSession(ep, auth)
+	if err != nil {
+		return nil, err
+	}
+	return &receivePackSession{ReceivePackSession: session, cache: gs.cache}, nil
+}
+
+type receivePackSession struct {
+	transport.ReceivePackSession
+	cache *RepoCache
+}
+
+func (s *receivePackSession) ReceivePack(ctx context.Context, req *packp.ReferenceUpdateRequest) (*packp.ReportStatus, error) {
+	status, err := s.ReceivePackSession.ReceivePack(ctx, req)
+
+	// some refs might have been updated even if others failed
+	refs := make([]string, 0, len(req.Commands))
+	for _, cmd := range req.Commands {
+		refs = append(refs, cmd.Name.String())
+	}
+	if refreshErr := s.cache.refreshRefs(refs); refreshErr != nil && err == nil {
+		err = refreshErr
+	}
+
+	return status, err
+}
+
+// refreshRefs read again the bugs and identities of the given refs, updated
+// outside of the cache, and write the cache files.
+func (c *RepoCache) refreshRefs(refs []string) error {
+	var bugs, identities []entity.Id
+	for _, ref := range refs {
+		switch {
+		case strings.HasPrefix(ref, bugRefPrefix):
+			bugs = append(bugs, entity.Id(strings.TrimPrefix(ref, bugRefPrefix)))
+		case strings.HasPrefix(ref, identityRefPrefix):
+			identities = append(identities, entity.Id(strings.TrimPrefix(ref, identityRefPrefix)))
+		}
+	}
+
+	// the bugs resolve the identities, so they are refreshed last
+	for _, id := range identities {
+		if err := c.identities.refresh(id); err != nil {
+			return err
+		}
+	}
+	for _, id := range bugs {
+		if err := c.refreshBug(id); err != nil {
+			return err
+		}
+	}
+
+	return c.write()
+}
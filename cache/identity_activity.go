@@ -0,0 +1,225 @@
+package cache
+
+import (
+	"sort"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+type ActivityKind int
+
+const (
+	_ ActivityKind = iota
+	// The identity created the bug
+	ActivityAuthored
+	// The identity commented on the bug
+	ActivityCommented
+	// The identity closed the bug
+	ActivityClosed
+)
+
+// ActivityEvent is an action of an identity on a bug, as recorded in a BugExcerpt
+type ActivityEvent struct {
+	Kind     ActivityKind
+	AuthorId entity.Id
+	UnixTime int64
+}
+
+// activityEvents extract the ActivityEvent of a bug
+func activityEvents(snap *bug.Snapshot) []ActivityEvent {
+	var result []ActivityEvent
+
+	for _, op := range snap.Operations {
+		var kind ActivityKind
+
+		switch op := op.(type) {
+		case *bug.CreateOperation:
+			kind = ActivityAuthored
+		case *bug.AddCommentOperation:
+			kind = ActivityCommented
+		case *bug.SetStatusOperation:
+			if op.Status != common.ClosedStatus {
+				continue
+			}
+			kind = ActivityClosed
+		default:
+			continue
+		}
+
+		result = append(result, ActivityEvent{
+			Kind:     kind,
+			AuthorId: op.Author().Id(),
+			UnixTime: op.Time().Unix(),
+		})
+	}
+
+	return result
+}
+
+// ActivityCount hold the number of actions of an identity during a month
+type ActivityCount struct {
+	// The first day of the month, in UTC
+	Month     time.Time
+	Authored  int
+	Commented int
+	Closed    int
+}
+
+// IdentityActivity summarize the activity of an identity across all bugs
+type IdentityActivity struct {
+	// bugs created by the identity
+	Authored []entity.Id
+	// bugs the identity commented on
+	Commented []entity.Id
+	// bugs closed by the identity
+	Closed []entity.Id
+	// number of actions per month, in chronological order
+	Timeline []ActivityCount
+}
+
+// IdentityActivity return the activity of the given identity across all bugs
+func (c *RepoCache) IdentityActivity(id entity.Id) *IdentityActivity {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	result := &IdentityActivity{}
+	months := make(map[time.Time]*ActivityCount)
+
+	// iterate in a stable order, to get a stable result
+	bugIds := make([]entity.Id, 0, len(c.activity[id]))
+	for bugId := range c.activity[id] {
+		bugIds = append(bugIds, bugId)
+	}
+	sort.Slice(bugIds, func(i, j int) bool { return bugIds[i] < bugIds[j] })
+
+	for _, bugId := range bugIds {
+		excerpt, ok := c.bugExcerpts[bugId]
+		if !ok {
+			continue
+		}
+
+		var authored, commented, closed bool
+
+		for _, event := range excerpt.Activity {
+			if event.AuthorId != id {
+				continue
+			}
+
+			t := time.Unix(event.UnixTime, 0).UTC()
+			month := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+			count, ok := months[month]
+			if !ok {
+				count = &ActivityCount{Month: month}
+				months[month] = count
+			}
+
+			switch event.Kind {
+			case ActivityAuthored:
+				authored = true
+				count.Authored++
+			case ActivityCommented:
+				commented = true
+				count.Commented++
+			case ActivityClosed:
+				closed = true
+				count.Closed++
+			}
+		}
+
+		if authored {
+			result.Authored = append(result.Authored, bugId)
+		}
+		if commented {
+			result.Commented = append(result.Commented, bugId)
+		}
+		if closed {
+			result.Closed = append(result.Closed, bugId)
+		}
+	}
+
+	for _, count := range months {
+		result.Timeline = append(result.Timeline, *count)
+	}
+	sort.Slice(result.Timeline, func(i, j int) bool {
+		return result.Timeline[i].Month.Before(result.Timeline[j].Month)
+	})
+
+	return result
+}
+
+// IdentityLastActivity return the time of the last action of the given
+// identity on a bug, or the zero time if there is none
+func (c *RepoCache) IdentityLastActivity(id entity.Id) time.Time {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	var last int64
+	for bugId := range c.activity[id] {
+		excerpt, ok := c.bugExcerpts[bugId]
+		if !ok {
+			continue
+		}
+		for _, event := range excerpt.Activity {
+			if event.AuthorId == id && event.UnixTime > last {
+				last = event.UnixTime
+			}
+		}
+	}
+
+	if last == 0 {
+		return time.Time{}
+	}
+	return time.Unix(last, 0)
+}
+
+// identitiesLastActivity return the unix time of the last action on a bug of
+// each identity with some activity
+func (c *RepoCache) identitiesLastActivity() map[entity.Id]int64 {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	result := make(map[entity.Id]int64, len(c.activity))
+	for _, excerpt := range c.bugExcerpts {
+		for _, event := range excerpt.Activity {
+			if event.UnixTime > result[event.AuthorId] {
+				result[event.AuthorId] = event.UnixTime
+			}
+		}
+	}
+	return result
+}
+
+// indexActivity add the activity of a bug to the per-identity activity index.
+// As operations are only ever added to a bug, the index never has to forget
+// anything on update.
+// c.muBug must be locked for writing.
+func (c *RepoCache) indexActivity(excerpt *BugExcerpt) {
+	for _, event := range excerpt.Activity {
+		bugs, ok := c.activity[event.AuthorId]
+		if !ok {
+			bugs = make(map[entity.Id]struct{})
+			c.activity[event.AuthorId] = bugs
+		}
+		bugs[excerpt.Id] = struct{}{}
+	}
+}
+
+// unindexActivity remove a bug from the per-identity activity index.
+// c.muBug must be locked for writing.
+func (c *RepoCache) unindexActivity(excerpt *BugExcerpt) {
+	for _, event := range excerpt.Activity {
+		delete(c.activity[event.AuthorId], excerpt.Id)
+	}
+}
+
+// rebuildActivityIndex build the per-identity activity index from the bug excerpts.
+// c.muBug must be locked for writing.
+func (c *RepoCache) rebuildActivityIndex() {
+	c.activity = make(map[entity.Id]map[entity.Id]struct{})
+	for _, excerpt := range c.bugExcerpts {
+		c.indexActivity(excerpt)
+	}
+}
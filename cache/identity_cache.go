@@ -32,18 +32,56 @@ func (i *IdentityCache) Mutate(repo repository.RepoClock, f func(*identity.Mutat
 	return i.notifyUpdated()
 }
 
+// AddSigningKey generate a new keypair to sign the operations of the identity from now
+// on, as identity.AddSigningKey does. The identity still needs to be committed.
+func (i *IdentityCache) AddSigningKey() (*identity.Key, error) {
+	key, err := i.Identity.AddSigningKey(i.repoCache.repo)
+	if err != nil {
+		return nil, err
+	}
+	return key, i.notifyUpdated()
+}
+
 func (i *IdentityCache) Commit() error {
-	err := i.Identity.Commit(i.repoCache.repo)
+	if err := i.repoCache.checkWritable(); err != nil {
+		return err
+	}
+
+	kind := i.repoCache.identities.kind()
+	err := i.repoCache.journalBegin(kind, i.Id())
 	if err != nil {
 		return err
 	}
-	return i.notifyUpdated()
+
+	err = i.Identity.Commit(i.repoCache.repo)
+	if err != nil {
+		return err
+	}
+	err = i.notifyUpdated()
+	if err != nil {
+		return err
+	}
+	return i.repoCache.journalEnd(kind, i.Id())
 }
 
 func (i *IdentityCache) CommitAsNeeded() error {
-	err := i.Identity.CommitAsNeeded(i.repoCache.repo)
+	if err := i.repoCache.checkWritable(); err != nil {
+		return err
+	}
+
+	kind := i.repoCache.identities.kind()
+	err := i.repoCache.journalBegin(kind, i.Id())
 	if err != nil {
 		return err
 	}
-	return i.notifyUpdated()
+
+	err = i.Identity.CommitAsNeeded(i.repoCache.repo)
+	if err != nil {
+		return err
+	}
+	err = i.notifyUpdated()
+	if err != nil {
+		return err
+	}
+	return i.repoCache.journalEnd(kind, i.Id())
 }
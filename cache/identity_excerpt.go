@@ -21,16 +21,22 @@ type IdentityExcerpt struct {
 	Id entity.Id
 
 	Name              string
+	Email             string
 	Login             string
 	ImmutableMetadata map[string]string
+
+	// Trusted are the identities this identity vouches for
+	Trusted []entity.Id
 }
 
 func NewIdentityExcerpt(i *identity.Identity) *IdentityExcerpt {
 	return &IdentityExcerpt{
 		Id:                i.Id(),
 		Name:              i.Name(),
+		Email:             i.Email(),
 		Login:             i.Login(),
 		ImmutableMetadata: i.ImmutableMetadata(),
+		Trusted:           i.Trusted(),
 	}
 }
 
@@ -49,6 +55,11 @@ func (i *IdentityExcerpt) DisplayName() string {
 	panic("invalid person data")
 }
 
+// IsBot return true if the identity is a bot, used by automation
+func (i *IdentityExcerpt) IsBot() bool {
+	return identity.IsBotMetadata(i.ImmutableMetadata)
+}
+
 // Match matches a query with the identity name, login and ID prefixes
 func (i *IdentityExcerpt) Match(query string) bool {
 	return i.Id.HasPrefix(query) ||
@@ -73,3 +84,43 @@ func (b IdentityById) Less(i, j int) bool {
 func (b IdentityById) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
+
+type IdentityByName []*IdentityExcerpt
+
+func (b IdentityByName) Len() int {
+	return len(b)
+}
+
+func (b IdentityByName) Less(i, j int) bool {
+	ni, nj := strings.ToLower(b[i].DisplayName()), strings.ToLower(b[j].DisplayName())
+	if ni != nj {
+		return ni < nj
+	}
+	return b[i].Id < b[j].Id
+}
+
+func (b IdentityByName) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+// identityByActivity sort the identities by the time of their last activity
+type identityByActivity struct {
+	excerpts     []*IdentityExcerpt
+	lastActivity map[entity.Id]int64
+}
+
+func (b identityByActivity) Len() int {
+	return len(b.excerpts)
+}
+
+func (b identityByActivity) Less(i, j int) bool {
+	ti, tj := b.lastActivity[b.excerpts[i].Id], b.lastActivity[b.excerpts[j].Id]
+	if ti != tj {
+		return ti < tj
+	}
+	return b.excerpts[i].Id < b.excerpts[j].Id
+}
+
+func (b identityByActivity) Swap(i, j int) {
+	b.excerpts[i], b.excerpts[j] = b.excerpts[j], b.excerpts[i]
+}
@@ -0,0 +1,202 @@
+package cache
+
+import (
+	"os"
+	"reflect"
+	"sort"
+
+	"github.com/go-git/go-billy/v5"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// CacheFileInfo describe a file of the on-disk cache
+type CacheFileInfo struct {
+	Name string `json:"name"`
+	// Exist is false if the file has not been written yet
+	Exist bool  `json:"exist"`
+	Size  int64 `json:"size"`
+	// Version is the format version the file has been written with
+	Version uint `json:"version"`
+	// Entries is the number of excerpts in the file, if it could be read
+	Entries int `json:"entries"`
+	// Err is the reason the file can't be used, if any
+	Err string `json:"error,omitempty"`
+}
+
+// CacheInspection is a description of the on-disk cache, compared with the
+// entities stored in git.
+type CacheInspection struct {
+	// FormatVersion is the format version this git-bug expects
+	FormatVersion uint
+	Files         []CacheFileInfo
+
+	// Bugs are the bug excerpts, sorted by id
+	Bugs       []*BugExcerpt
+	Identities []*IdentityExcerpt
+
+	// MissingBugs are the bugs stored in git, but absent from the cache
+	MissingBugs []entity.Id
+	// MissingIdentities are the identities stored in git, but absent from the cache
+	MissingIdentities []entity.Id
+	// StaleBugs are in the cache, but no longer stored in git
+	StaleBugs []entity.Id
+}
+
+// InspectCache describe the on-disk cache and compare it with the entities
+// stored in git. Like ReadCompletionData, this doesn't take the repository
+// lock and never write or rebuild anything.
+func InspectCache(repo repository.ClockedRepo) (*CacheInspection, error) {
+	storage := repo.LocalStorage()
+
+	result := &CacheInspection{
+		FormatVersion: formatVersion,
+	}
+
+	bugInfo := inspectCacheFile(storage, bugCacheFile)
+	bugExcerpts, err := readBugCache(storage)
+	if err == nil {
+		bugInfo.Entries = len(bugExcerpts)
+	} else if bugInfo.Exist {
+		bugInfo.Err = err.Error()
+	}
+
+	identityInfo := inspectCacheFile(storage, identityCacheFile)
+	identityExcerpts, err := readIdentityCache(storage)
+	if err == nil {
+		identityInfo.Entries = len(identityExcerpts)
+	} else if identityInfo.Exist {
+		identityInfo.Err = err.Error()
+	}
+
+	result.Files = []CacheFileInfo{bugInfo, identityInfo}
+
+	for _, excerpt := range bugExcerpts {
+		result.Bugs = append(result.Bugs, excerpt)
+	}
+	sort.Slice(result.Bugs, func(i, j int) bool {
+		return result.Bugs[i].Id < result.Bugs[j].Id
+	})
+
+	for _, excerpt := range identityExcerpts {
+		result.Identities = append(result.Identities, excerpt)
+	}
+	sort.Slice(result.Identities, func(i, j int) bool {
+		return result.Identities[i].Id < result.Identities[j].Id
+	})
+
+	bugIds, err := bug.ListLocalIds(repo)
+	if err != nil {
+		return nil, err
+	}
+	gitBugs := make(map[entity.Id]struct{}, len(bugIds))
+	for _, id := range bugIds {
+		gitBugs[id] = struct{}{}
+		if _, ok := bugExcerpts[id]; !ok {
+			result.MissingBugs = append(result.MissingBugs, id)
+		}
+	}
+	for _, excerpt := range result.Bugs {
+		if _, ok := gitBugs[excerpt.Id]; !ok {
+			result.StaleBugs = append(result.StaleBugs, excerpt.Id)
+		}
+	}
+
+	identityIds, err := identity.ListLocalIds(repo)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range identityIds {
+		if _, ok := identityExcerpts[id]; !ok {
+			result.MissingIdentities = append(result.MissingIdentities, id)
+		}
+	}
+
+	sort.Slice(result.MissingBugs, func(i, j int) bool { return result.MissingBugs[i] < result.MissingBugs[j] })
+	sort.Slice(result.MissingIdentities, func(i, j int) bool { return result.MissingIdentities[i] < result.MissingIdentities[j] })
+
+	return result, nil
+}
+
+// inspectCacheFile read the size and format version of a cache file
+func inspectCacheFile(storage billy.Filesystem, name string) CacheFileInfo {
+	info := CacheFileInfo{Name: name}
+
+	stat, err := storage.Stat(name)
+	if os.IsNotExist(err) {
+		return info
+	}
+	if err != nil {
+		info.Err = err.Error()
+		return info
+	}
+	info.Exist = true
+	info.Size = stat.Size()
+
+	content, err := readCacheFile(storage, name)
+	if err != nil {
+		info.Err = err.Error()
+		return info
+	}
+
+	// only decode the version, whatever the format of the rest
+	info.Version, err = decodeCacheVersion(content)
+	if err != nil {
+		info.Err = err.Error()
+		return info
+	}
+
+	return info
+}
+
+// SchemaField describe a field of a cached excerpt
+type SchemaField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// SchemaType describe an excerpt stored in a cache file
+type SchemaType struct {
+	Name   string        `json:"name"`
+	File   string        `json:"file"`
+	Fields []SchemaField `json:"fields"`
+}
+
+// CacheSchema is a machine-readable description of the on-disk cache format
+type CacheSchema struct {
+	FormatVersion uint         `json:"format_version"`
+	Types         []SchemaType `json:"types"`
+	// Proto is the protobuf schema of the cache files
+	Proto string `json:"proto"`
+}
+
+// DescribeCacheSchema return the description of the on-disk cache format of
+// this version of git-bug.
+func DescribeCacheSchema() CacheSchema {
+	return CacheSchema{
+		FormatVersion: formatVersion,
+		Types: []SchemaType{
+			describeType(reflect.TypeOf(BugExcerpt{}), bugCacheFile),
+			describeType(reflect.TypeOf(IdentityExcerpt{}), identityCacheFile),
+		},
+		Proto: cacheProtoSchema,
+	}
+}
+
+func describeType(t reflect.Type, file string) SchemaType {
+	result := SchemaType{Name: t.Name(), File: file}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		result.Fields = append(result.Fields, SchemaField{
+			Name: field.Name,
+			Type: field.Type.String(),
+		})
+	}
+	return result
+}
@@ -0,0 +1,229 @@
+package cache
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-git/go-billy/v5"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+const journalFile = "journal"
+
+// the kind of the journal entries of the bugs. The entities of a SubCache use
+// the typename of their definition.
+const bugJournalKind = "bug"
+
+// journalEntry is the mutation of an entity, recorded in the journal
+type journalEntry struct {
+	kind string
+	id   entity.Id
+}
+
+// journal is a small write-ahead log of the mutations touching several pieces
+// of state: the entity in git, its excerpt and indexes in memory, the search
+// index and the cache files.
+//
+// An entry is written before such a mutation starts, and removed once the
+// cache files reflect it. If git-bug is interrupted in between, the entries
+// left are replayed the next time the cache is opened: those entities are
+// read again from git, which makes the cache converge instead of requiring a
+// full rebuild.
+type journal struct {
+	mu sync.Mutex
+	// the mutations not reflected in the cache files yet, with the number of
+	// them for each entity
+	pending map[journalEntry]int
+}
+
+// journalBegin record in the journal that a mutation of an entity starts
+func (c *RepoCache) journalBegin(kind string, id entity.Id) error {
+	c.journal.mu.Lock()
+	defer c.journal.mu.Unlock()
+
+	if c.journal.pending == nil {
+		c.journal.pending = make(map[journalEntry]int)
+	}
+	c.journal.pending[journalEntry{kind: kind, id: id}]++
+
+	return c.writeJournal()
+}
+
+// journalEnd record in the journal that a mutation of an entity is done. In
+// batch mode, the cache files might not have been written yet, in which case
+// the entry is kept until the next write of all the cache files.
+// On error, journalEnd is not called: the entry stays in the journal and the
+// entity is read again the next time the cache is opened.
+func (c *RepoCache) journalEnd(kind string, id entity.Id) error {
+	entry := journalEntry{kind: kind, id: id}
+
+	c.batch.mu.Lock()
+	if c.batch.active && c.batch.pending > 0 {
+		c.batch.journaled = append(c.batch.journaled, entry)
+		c.batch.mu.Unlock()
+		return nil
+	}
+	c.batch.mu.Unlock()
+
+	return c.endJournalEntries([]journalEntry{entry})
+}
+
+// endJournalEntries remove entries from the journal, once the cache files
+// reflect their mutation
+func (c *RepoCache) endJournalEntries(entries []journalEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	c.journal.mu.Lock()
+	defer c.journal.mu.Unlock()
+
+	for _, entry := range entries {
+		c.journal.pending[entry]--
+		if c.journal.pending[entry] <= 0 {
+			delete(c.journal.pending, entry)
+		}
+	}
+
+	return c.writeJournal()
+}
+
+// writeJournal write the pending entries in the journal file, or remove it if
+// there is none. The file is replaced at once and synced to the disk, so that
+// it's either the previous or the new version after a crash.
+// c.journal.mu must be locked.
+func (c *RepoCache) writeJournal() error {
+	storage := c.repo.LocalStorage()
+
+	if len(c.journal.pending) == 0 {
+		err := storage.Remove(journalFile)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	lines := make([]string, 0, len(c.journal.pending))
+	for entry := range c.journal.pending {
+		lines = append(lines, fmt.Sprintf("%s %s\n", entry.kind, entry.id))
+	}
+	sort.Strings(lines)
+
+	tmp := journalFile + ".tmp"
+	f, err := storage.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write([]byte(strings.Join(lines, "")))
+	if err == nil {
+		err = syncFile(f)
+	}
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	return storage.Rename(tmp, journalFile)
+}
+
+// syncFile flush a file to the disk, if the filesystem support it
+func syncFile(f billy.File) error {
+	if s, ok := f.(interface{ Sync() error }); ok {
+		return s.Sync()
+	}
+	return nil
+}
+
+// readJournal return the entries left in the journal file by an interrupted
+// mutation. A truncated last line is ignored, as its mutation never started.
+func readJournal(storage billy.Filesystem) ([]journalEntry, error) {
+	f, err := storage.Open(journalFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var content bytes.Buffer
+	if _, err := content.ReadFrom(f); err != nil {
+		return nil, err
+	}
+
+	var entries []journalEntry
+	scanner := bufio.NewScanner(&content)
+	for scanner.Scan() {
+		kind, id, found := strings.Cut(scanner.Text(), " ")
+		if !found || entity.Id(id).Validate() != nil {
+			continue
+		}
+		entries = append(entries, journalEntry{kind: kind, id: entity.Id(id)})
+	}
+
+	return entries, scanner.Err()
+}
+
+// replayJournal read again from git the entities whose mutation has been
+// interrupted, to bring their excerpts and indexes up to date, then write the
+// cache files and clear the journal.
+func (c *RepoCache) replayJournal() error {
+	entries, err := readJournal(c.repo.LocalStorage())
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	for _, entry := range entries {
+		err := c.replayJournalEntry(entry)
+		if err != nil {
+			return fmt.Errorf("replaying the journal for %s %s: %w", entry.kind, entry.id.Human(), err)
+		}
+	}
+
+	err = c.write()
+	if err != nil {
+		return err
+	}
+
+	return c.clearJournal()
+}
+
+func (c *RepoCache) replayJournalEntry(entry journalEntry) error {
+	if entry.kind == bugJournalKind {
+		return c.refreshBug(entry.id)
+	}
+
+	for _, sc := range c.subCaches {
+		if sc.kind() == entry.kind {
+			return sc.refresh(entry.id)
+		}
+	}
+
+	return fmt.Errorf("unknown kind of entity")
+}
+
+// clearJournal forget all the journal entries, once the cache files are known
+// to be up to date with git, for example after a rebuild
+func (c *RepoCache) clearJournal() error {
+	c.journal.mu.Lock()
+	defer c.journal.mu.Unlock()
+
+	c.journal.pending = nil
+	return c.writeJournal()
+}
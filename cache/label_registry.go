@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+// labelRegistryFile is where the label registry is kept. It's local to the
+// repository and never pushed.
+const labelRegistryFile = "labels"
+
+// LabelInfo is what the label registry knows about a label beside its name,
+// typically imported from a remote bug tracker by a bridge
+type LabelInfo struct {
+	// Color is the color of the label as "#rrggbb". When empty, the color is
+	// derived from the name of the label.
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// labelRegistry hold the LabelInfo of the labels
+type labelRegistry struct {
+	mu     sync.Mutex
+	loaded bool
+	labels map[bug.Label]LabelInfo
+}
+
+// LabelInfo return what the label registry knows about a label
+func (c *RepoCache) LabelInfo(label bug.Label) (LabelInfo, error) {
+	c.labelRegistry.mu.Lock()
+	defer c.labelRegistry.mu.Unlock()
+
+	if err := c.loadLabelRegistry(); err != nil {
+		return LabelInfo{}, err
+	}
+
+	return c.labelRegistry.labels[label], nil
+}
+
+// SetLabelInfo record in the label registry the color and description of a
+// label, replacing what was known
+func (c *RepoCache) SetLabelInfo(label bug.Label, info LabelInfo) error {
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+
+	if err := label.Validate(); err != nil {
+		return err
+	}
+
+	if info.Color != "" {
+		color, err := bug.LabelColorFromHex(info.Color)
+		if err != nil {
+			return err
+		}
+		info.Color = color.Hex()
+	}
+
+	c.labelRegistry.mu.Lock()
+	defer c.labelRegistry.mu.Unlock()
+
+	if err := c.loadLabelRegistry(); err != nil {
+		return err
+	}
+
+	// the bridges set the labels again on each import
+	if c.labelRegistry.labels[label] == info {
+		return nil
+	}
+
+	if info == (LabelInfo{}) {
+		delete(c.labelRegistry.labels, label)
+	} else {
+		c.labelRegistry.labels[label] = info
+	}
+
+	data, err := json.Marshal(c.labelRegistry.labels)
+	if err != nil {
+		return err
+	}
+
+	return replaceFile(c.repo.LocalStorage(), labelRegistryFile, data)
+}
+
+// LabelColor return the color of a label: the one of the label registry if
+// any, or the one derived from its name otherwise
+func (c *RepoCache) LabelColor(label bug.Label) bug.LabelColor {
+	info, err := c.LabelInfo(label)
+	if err != nil || info.Color == "" {
+		return label.Color()
+	}
+
+	color, err := bug.LabelColorFromHex(info.Color)
+	if err != nil {
+		return label.Color()
+	}
+	return color
+}
+
+// loadLabelRegistry read the label registry file, if not done already.
+// c.labelRegistry.mu must be locked.
+func (c *RepoCache) loadLabelRegistry() error {
+	if c.labelRegistry.loaded {
+		return nil
+	}
+
+	c.labelRegistry.labels = make(map[bug.Label]LabelInfo)
+
+	f, err := c.repo.LocalStorage().Open(labelRegistryFile)
+	if errors.Is(err, os.ErrNotExist) {
+		c.labelRegistry.loaded = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(data, &c.labelRegistry.labels)
+	if err != nil {
+		return err
+	}
+
+	c.labelRegistry.loaded = true
+	return nil
+}
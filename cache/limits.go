@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// git config key to change the maximum size in bytes of a bug description or comment
+const maxMessageSizeConfigKey = "git-bug.max-message-size"
+
+// The default maximum size of a bug description or comment, if not configured otherwise.
+const defaultMaxMessageSize = 1024 * 1024
+
+type ErrMessageTooLarge struct {
+	Size  int
+	Limit int
+}
+
+func (e ErrMessageTooLarge) Error() string {
+	return fmt.Sprintf("message is too large (%d bytes, the limit is %d bytes)", e.Size, e.Limit)
+}
+
+func IsErrMessageTooLarge(err error) bool {
+	_, ok := err.(*ErrMessageTooLarge)
+	return ok
+}
+
+// maxMessageSize return the configured maximum size of a message.
+// A value of 0 or less disable the limit.
+func (c *RepoCache) maxMessageSize() (int, error) {
+	val, err := c.repo.AnyConfig().ReadString(maxMessageSizeConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return defaultMaxMessageSize, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	limit, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %v", maxMessageSizeConfigKey, err)
+	}
+	return limit, nil
+}
+
+// checkMessageSize enforce the configured size limit on a bug description or comment.
+func (c *RepoCache) checkMessageSize(message string) error {
+	limit, err := c.maxMessageSize()
+	if err != nil {
+		return err
+	}
+	if limit > 0 && len(message) > limit {
+		return &ErrMessageTooLarge{Size: len(message), Limit: limit}
+	}
+	return nil
+}
+
+// git config key to change the maximum size in bytes of an attached file
+const maxAttachmentSizeConfigKey = "git-bug.max-attachment-size"
+
+// The default maximum size of an attached file, if not configured otherwise.
+// It's the limit of github.
+const defaultMaxAttachmentSize = 100 * 1000 * 1000
+
+type ErrAttachmentTooLarge struct {
+	Size  int
+	Limit int
+}
+
+func (e ErrAttachmentTooLarge) Error() string {
+	return fmt.Sprintf("file is too large (%d bytes, the limit is %d bytes)", e.Size, e.Limit)
+}
+
+func IsErrAttachmentTooLarge(err error) bool {
+	_, ok := err.(*ErrAttachmentTooLarge)
+	return ok
+}
+
+// checkAttachmentSize enforce the configured size limit on an attached file.
+// A value of 0 or less disable the limit.
+func (c *RepoCache) checkAttachmentSize(data []byte) error {
+	limit := defaultMaxAttachmentSize
+
+	val, err := c.repo.AnyConfig().ReadString(maxAttachmentSizeConfigKey)
+	switch {
+	case err == repository.ErrNoConfigEntry:
+	case err != nil:
+		return err
+	default:
+		limit, err = strconv.Atoi(val)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %v", maxAttachmentSizeConfigKey, err)
+		}
+	}
+
+	if limit > 0 && len(data) > limit {
+		return &ErrAttachmentTooLarge{Size: len(data), Limit: limit}
+	}
+	return nil
+}
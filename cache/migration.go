@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// cacheMigration upgrade the content of the cache files from a format version
+// to the next one, in place, which is a lot cheaper than rebuilding the cache
+// from git on a large repository.
+//
+// The files are decoded with the current types: the fields added since that
+// version are left empty, and the migration has to derive them. A change that
+// can't be expressed this way, like the type of a field being changed, must
+// not have a migration, so that the cache is rebuilt.
+//
+// The other kinds of entities, managed by a SubCache, define their own
+// migrations in their subCacheDefinition.
+type cacheMigration struct {
+	bugs func(c *RepoCache, data *bugCacheData) error
+}
+
+// cacheMigrations are the migrations, indexed by the format version they
+// upgrade from.
+var cacheMigrations = map[uint]cacheMigration{
+	7: {bugs: migrateBugTips},
+	// the data is unchanged, only the encoding is
+	8: {},
+	// no bug could be merged as a duplicate before
+	9:  {},
+	10: {bugs: migrateBugLastComment},
+	// only the identities changed, see identityCacheDefinition
+	11: {},
+	// no bug could have a relation before
+	12: {},
+	13: {bugs: migrateBugAssignees},
+	// no bug could be moved to a state of a workflow before
+	14: {},
+	15: {bugs: migrateBugReferences},
+}
+
+// migrateBugTips (7 -> 8) record the tips of the bug refs. The refs are assumed
+// to not have changed since the cache file was written, as there is no way to
+// know. The bugs not in the cache file are left out, so that they are read
+// again when syncing with the refs.
+func migrateBugTips(c *RepoCache, data *bugCacheData) error {
+	tips, err := c.listBugTips()
+	if err != nil {
+		return err
+	}
+
+	for id := range tips {
+		if _, ok := data.Excerpts[id]; !ok {
+			delete(tips, id)
+		}
+	}
+
+	data.Tips = tips
+	return nil
+}
+
+// migrateBugLastComment (10 -> 11) record the time of the last comment. Only
+// the bugs with comments beside the description need to be read again.
+func migrateBugLastComment(c *RepoCache, data *bugCacheData) error {
+	for id, excerpt := range data.Excerpts {
+		if excerpt.LenComments <= 1 {
+			excerpt.LastCommentUnixTime = excerpt.CreateUnixTime
+			continue
+		}
+
+		b, err := bug.Read(c.repo, id)
+		if err != nil {
+			return err
+		}
+		excerpt.LastCommentUnixTime = lastCommentUnixTime(b.Compile())
+	}
+	return nil
+}
+
+// migrateBugAssignees (13 -> 14) record the assignees. Any bug could have
+// been assigned, so they all have to be read again.
+func migrateBugAssignees(c *RepoCache, data *bugCacheData) error {
+	for id, excerpt := range data.Excerpts {
+		b, err := bug.Read(c.repo, id)
+		if err != nil {
+			return err
+		}
+		excerpt.Assignees = b.Compile().Assignees
+	}
+	return nil
+}
+
+// migrateBugReferences (15 -> 16) record the bugs referenced in the
+// messages. Any bug could reference another one, so they all have to be read
+// again.
+func migrateBugReferences(c *RepoCache, data *bugCacheData) error {
+	for id, excerpt := range data.Excerpts {
+		b, err := bug.Read(c.repo, id)
+		if err != nil {
+			return err
+		}
+		excerpt.References = bugReferences(b.Compile())
+	}
+	return nil
+}
+
+// migrateIdentityEmail (11 -> 12) record the email of the identities, which
+// have to be read again.
+func migrateIdentityEmail(c *RepoCache, excerpts map[entity.Id]*IdentityExcerpt) error {
+	for id, excerpt := range excerpts {
+		i, err := identity.ReadLocal(c.repo, id)
+		if err != nil {
+			return err
+		}
+		excerpt.Email = i.Email()
+	}
+	return nil
+}
+
+// migrateBugCache apply in order the migrations of the bug cache, up to the
+// current format version
+func (c *RepoCache) migrateBugCache(data *bugCacheData) error {
+	for data.Version < formatVersion {
+		migration, ok := cacheMigrations[data.Version]
+		if !ok {
+			return fmt.Errorf("no migration from the cache format version %v", data.Version)
+		}
+		if migration.bugs != nil {
+			if err := migration.bugs(c, data); err != nil {
+				return err
+			}
+		}
+		data.Version++
+	}
+
+	if data.Version != formatVersion {
+		return fmt.Errorf("unknown cache format version %v", data.Version)
+	}
+
+	return nil
+}
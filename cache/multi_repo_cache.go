@@ -1,22 +1,39 @@
 package cache
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
 	"github.com/MichaelMure/git-bug/repository"
 )
 
 const lockfile = "lock"
 const defaultRepoName = "__default"
 
+var ErrRepoNotUnique = errors.New("repository is not unique")
+var ErrUnknownRepo = errors.New("unknown repo")
+
 // MultiRepoCache is the root cache, holding multiple RepoCache.
 type MultiRepoCache struct {
 	repos map[string]*RepoCache
+	// the repositories opened by the caller, not closed with the MultiRepoCache
+	borrowed map[string]bool
+	// the names of the repositories registered by path, by absolute path
+	paths map[string]string
 }
 
 func NewMultiRepoCache() *MultiRepoCache {
 	return &MultiRepoCache{
-		repos: make(map[string]*RepoCache),
+		repos:    make(map[string]*RepoCache),
+		borrowed: make(map[string]bool),
+		paths:    make(map[string]string),
 	}
 }
 
@@ -42,10 +59,231 @@ func (c *MultiRepoCache) RegisterDefaultRepository(repo repository.ClockedRepo)
 	return r, nil
 }
 
-// DefaultRepo retrieve the default repository
+// AddDefaultRepoCache register an already opened RepoCache as the unnamed
+// repository. Closing the MultiRepoCache doesn't close it, it stays the
+// responsibility of the caller.
+func (c *MultiRepoCache) AddDefaultRepoCache(r *RepoCache) {
+	c.repos[defaultRepoName] = r
+	c.borrowed[defaultRepoName] = true
+}
+
+// RegisterRelatedTrackers register the related trackers declared in the
+// configuration of repo, each under its name, opening their repository with
+// open. A tracker locked by another process is opened read-only.
+func (c *MultiRepoCache) RegisterRelatedTrackers(repo repository.ClockedRepo, open func(path string) (repository.ClockedRepo, error)) error {
+	trackers, err := RelatedTrackers(repo)
+	if err != nil {
+		return err
+	}
+
+	for _, tracker := range trackers {
+		if _, ok := c.repos[tracker.Name]; ok {
+			return fmt.Errorf("tracker %s: %w", tracker.Name, ErrRepoNotUnique)
+		}
+
+		trackerRepo, err := open(tracker.Path)
+		if err != nil {
+			return fmt.Errorf("tracker %s: %w", tracker.Name, err)
+		}
+
+		r, err := NewNamedRepoCache(trackerRepo, tracker.Name)
+		if IsErrLocked(err) {
+			r, err = NewRepoCacheWithOptions(trackerRepo, RepoCacheOptions{Name: tracker.Name, ReadOnly: true})
+		}
+		if err != nil {
+			_ = trackerRepo.Close()
+			return fmt.Errorf("tracker %s: %w", tracker.Name, err)
+		}
+
+		c.repos[tracker.Name] = r
+	}
+
+	return nil
+}
+
+// RegisterRepositoryPath register the repository at the given path, opening it
+// with open. The repository is named after its directory, with a numeric
+// suffix if that name is already taken, as in "project-2". Registering the
+// same path again return the repository already registered.
+func (c *MultiRepoCache) RegisterRepositoryPath(path string, open func(path string) (repository.ClockedRepo, error)) (*RepoCache, error) {
+	return c.registerRepositoryPath(path, open, false)
+}
+
+// registerRepositoryPath is RegisterRepositoryPath, opening the repository
+// read-only if it's locked by another process and readOnlyIfLocked is true
+func (c *MultiRepoCache) registerRepositoryPath(path string, open func(path string) (repository.ClockedRepo, error), readOnlyIfLocked bool) (*RepoCache, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if name, ok := c.paths[abs]; ok {
+		return c.repos[name], nil
+	}
+
+	repo, err := open(abs)
+	if err != nil {
+		return nil, fmt.Errorf("repository %s: %w", path, err)
+	}
+
+	name := c.uniqueRepoName(filepath.Base(abs))
+	r, err := NewNamedRepoCache(repo, name)
+	if readOnlyIfLocked && IsErrLocked(err) {
+		r, err = NewRepoCacheWithOptions(repo, RepoCacheOptions{Name: name, ReadOnly: true})
+	}
+	if err != nil {
+		_ = repo.Close()
+		return nil, fmt.Errorf("repository %s: %w", path, err)
+	}
+
+	c.repos[name] = r
+	c.paths[abs] = name
+	return r, nil
+}
+
+// uniqueRepoName return a name derived from base, not used by any repository
+func (c *MultiRepoCache) uniqueRepoName(base string) string {
+	// a "/" would make the namespaced ids ambiguous
+	base = strings.ReplaceAll(base, "/", "-")
+	if base == "" || base == "." {
+		base = "repo"
+	}
+	name := base
+	for i := 2; ; i++ {
+		if _, ok := c.repos[name]; !ok && name != defaultRepoName {
+			return name
+		}
+		name = base + "-" + strconv.Itoa(i)
+	}
+}
+
+// ResolveRepoPath retrieve a repository registered with RegisterRepositoryPath
+func (c *MultiRepoCache) ResolveRepoPath(path string) (*RepoCache, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	name, ok := c.paths[abs]
+	if !ok {
+		return nil, ErrUnknownRepo
+	}
+	return c.repos[name], nil
+}
+
+// RepoNames return the names of the repositories, sorted, the unnamed one
+// being designated by an empty name
+func (c *MultiRepoCache) RepoNames() []string {
+	names := make([]string, 0, len(c.repos))
+	for ref := range c.repos {
+		if ref == defaultRepoName {
+			ref = ""
+		}
+		names = append(names, ref)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// repoByName return the repository with the given name, the empty name being
+// the unnamed one
+func (c *MultiRepoCache) repoByName(name string) (*RepoCache, bool) {
+	if name == "" {
+		name = defaultRepoName
+	}
+	r, ok := c.repos[name]
+	return r, ok
+}
+
+// ErrMultipleRepoMatch is returned when a bug id prefix is ambiguous across
+// repositories. It wraps an *entity.ErrMultipleMatch.
+type ErrMultipleRepoMatch struct {
+	// Matching are the namespaced ids of the matching bugs
+	Matching []string
+	ids      []entity.Id
+}
+
+func (e *ErrMultipleRepoMatch) Error() string {
+	return fmt.Sprintf("Multiple matching bug found:\n%s", strings.Join(e.Matching, "\n"))
+}
+
+func (e *ErrMultipleRepoMatch) Unwrap() error {
+	return entity.NewErrMultipleMatch("bug", e.ids)
+}
+
+// ResolveBugExcerptPrefix retrieve the excerpt of a bug matching a namespaced
+// id prefix, as in "<repo>/<prefix>", or a bare prefix. A bare prefix is
+// looked up in every repository, and must match a single bug among all of
+// them.
+func (c *MultiRepoCache) ResolveBugExcerptPrefix(ref string) (RepoBugExcerpt, error) {
+	if name, prefix, ok := strings.Cut(ref, "/"); ok {
+		r, ok := c.repoByName(name)
+		if !ok {
+			return RepoBugExcerpt{}, fmt.Errorf("%s: %w", name, ErrUnknownRepo)
+		}
+		excerpt, err := r.ResolveBugExcerptPrefix(prefix)
+		if err != nil {
+			return RepoBugExcerpt{}, err
+		}
+		return RepoBugExcerpt{BugExcerpt: excerpt, Repo: name}, nil
+	}
+
+	var matching []RepoBugExcerpt
+	for _, name := range c.RepoNames() {
+		r, _ := c.repoByName(name)
+		excerpt, err := r.ResolveBugExcerptPrefix(ref)
+		switch {
+		case err == nil:
+			matching = append(matching, RepoBugExcerpt{BugExcerpt: excerpt, Repo: name})
+		case err == bug.ErrBugNotExist:
+		case entity.IsErrMultipleMatch(err):
+			var multiple *entity.ErrMultipleMatch
+			errors.As(err, &multiple)
+			for _, id := range multiple.Matching {
+				matching = append(matching, RepoBugExcerpt{BugExcerpt: &BugExcerpt{Id: id}, Repo: name})
+			}
+		default:
+			return RepoBugExcerpt{}, err
+		}
+	}
+
+	switch len(matching) {
+	case 0:
+		return RepoBugExcerpt{}, bug.ErrBugNotExist
+	case 1:
+		return matching[0], nil
+	}
+
+	err := &ErrMultipleRepoMatch{}
+	for _, excerpt := range matching {
+		err.Matching = append(err.Matching, excerpt.NamespacedId())
+		err.ids = append(err.ids, excerpt.Id)
+	}
+	return RepoBugExcerpt{}, err
+}
+
+// ResolveBugPrefix retrieve a bug matching a namespaced id prefix, or a bare
+// prefix, as ResolveBugExcerptPrefix does, along with its repository.
+func (c *MultiRepoCache) ResolveBugPrefix(ref string) (*RepoCache, *BugCache, error) {
+	excerpt, err := c.ResolveBugExcerptPrefix(ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, _ := c.repoByName(excerpt.Repo)
+	b, err := r.ResolveBug(excerpt.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, b, nil
+}
+
+// DefaultRepo retrieve the default repository: the unnamed one if any, or
+// the only one registered
 func (c *MultiRepoCache) DefaultRepo() (*RepoCache, error) {
+	if r, ok := c.repos[defaultRepoName]; ok {
+		return r, nil
+	}
+
 	if len(c.repos) != 1 {
-		return nil, fmt.Errorf("repository is not unique")
+		return nil, ErrRepoNotUnique
 	}
 
 	for _, r := range c.repos {
@@ -59,14 +297,17 @@ func (c *MultiRepoCache) DefaultRepo() (*RepoCache, error) {
 func (c *MultiRepoCache) ResolveRepo(ref string) (*RepoCache, error) {
 	r, ok := c.repos[ref]
 	if !ok {
-		return nil, fmt.Errorf("unknown repo")
+		return nil, ErrUnknownRepo
 	}
 	return r, nil
 }
 
 // Close will do anything that is needed to close the cache properly
 func (c *MultiRepoCache) Close() error {
-	for _, cachedRepo := range c.repos {
+	for ref, cachedRepo := range c.repos {
+		if c.borrowed[ref] {
+			continue
+		}
 		err := cachedRepo.Close()
 		if err != nil {
 			return err
@@ -74,3 +315,103 @@ func (c *MultiRepoCache) Close() error {
 	}
 	return nil
 }
+
+// RepoBugExcerpt is a BugExcerpt of one of the repositories of a
+// MultiRepoCache
+type RepoBugExcerpt struct {
+	*BugExcerpt
+	// Repo is the name of the repository holding the bug, empty for the
+	// unnamed repository
+	Repo string
+}
+
+// NamespacedId return the id of the bug, prefixed with the name of its
+// repository as in "<repo>/<id>", unless it's in the unnamed repository
+func (e RepoBugExcerpt) NamespacedId() string {
+	if e.Repo == "" {
+		return e.Id.String()
+	}
+	return e.Repo + "/" + e.Id.String()
+}
+
+// NamespacedHumanId is the same as NamespacedId, with the human id of the bug
+func (e RepoBugExcerpt) NamespacedHumanId() string {
+	if e.Repo == "" {
+		return e.Id.Human()
+	}
+	return e.Repo + "/" + e.Id.Human()
+}
+
+// QueryBugs return the excerpt of all the bugs matching the given Query, in
+// all the repositories. As the logical clocks of different repositories can't
+// be compared, the bugs are ordered by their wall clock time across
+// repositories, and by repository then id when sorting by id.
+func (c *MultiRepoCache) QueryBugs(q *query.Query) ([]RepoBugExcerpt, error) {
+	if q == nil {
+		q = query.NewQuery()
+	}
+	descending := q.OrderDirection == query.OrderDescending
+
+	names := make([]string, 0, len(c.repos))
+	for ref := range c.repos {
+		name := ref
+		if ref == defaultRepoName {
+			name = ""
+		}
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if descending {
+			return names[i] > names[j]
+		}
+		return names[i] < names[j]
+	})
+
+	var result []RepoBugExcerpt
+
+	for _, name := range names {
+		ref := name
+		if name == "" {
+			ref = defaultRepoName
+		}
+		r := c.repos[ref]
+
+		ids, err := r.QueryBugs(q)
+		if err != nil {
+			return nil, fmt.Errorf("repository %s: %w", ref, err)
+		}
+
+		for _, id := range ids {
+			excerpt, err := r.ResolveBugExcerpt(id)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, RepoBugExcerpt{BugExcerpt: excerpt, Repo: name})
+		}
+	}
+
+	var key func(e RepoBugExcerpt) int64
+	switch q.OrderBy {
+	case query.OrderByCreation:
+		key = func(e RepoBugExcerpt) int64 { return e.CreateUnixTime }
+	case query.OrderByEdit:
+		key = func(e RepoBugExcerpt) int64 { return e.EditUnixTime }
+	case query.OrderByComments:
+		key = func(e RepoBugExcerpt) int64 { return int64(e.LenComments) }
+	case query.OrderByLastComment:
+		key = func(e RepoBugExcerpt) int64 { return e.LastCommentUnixTime }
+	default:
+		// already ordered by repository, then by id in each of them
+		return result, nil
+	}
+
+	// the stable sort keep the order of each repository for the same key
+	sort.SliceStable(result, func(i, j int) bool {
+		if descending {
+			return key(result[i]) > key(result[j])
+		}
+		return key(result[i]) < key(result[j])
+	})
+
+	return result, nil
+}
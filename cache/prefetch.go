@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// git config key to set the number of bugs to prefetch when the cache is built
+// for the first time, typically right after a clone.
+const prefetchConfigKey = "git-bug.cache.prefetch"
+
+// Rebuild discard the cache and build it again from the git repository.
+func (c *RepoCache) Rebuild() error {
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+
+	c.muBug.Lock()
+	previous := c.bugExcerpts
+	c.bugs = make(map[entity.Id]*BugCache)
+	c.loadedBugs = NewLRUIdCache()
+	c.loadedBytes = 0
+	c.muBug.Unlock()
+
+	for _, sc := range c.subCaches {
+		sc.dropLoaded()
+	}
+
+	// buildCache resolve identities through the cache, so it must run unlocked
+	err := c.buildCache()
+	if err != nil {
+		return err
+	}
+
+	c.notifyRebuilt(previous)
+
+	return c.write()
+}
+
+// notifyRebuilt call the excerpt hooks after a rebuild of the cache: all the
+// excerpts are reported as updated, or removed if they disappeared.
+func (c *RepoCache) notifyRebuilt(previous map[entity.Id]*BugExcerpt) {
+	c.muBug.RLock()
+	var removed []entity.Id
+	for id := range previous {
+		if _, ok := c.bugExcerpts[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	excerpts := make([]*BugExcerpt, 0, len(c.bugExcerpts))
+	for _, excerpt := range c.bugExcerpts {
+		excerpts = append(excerpts, excerpt)
+	}
+	c.muBug.RUnlock()
+
+	sort.Sort(BugsByEditTime(excerpts))
+
+	for _, id := range removed {
+		c.notifyExcerptRemoved(id)
+	}
+	for _, excerpt := range excerpts {
+		c.notifyExcerptChanged(excerpt, false)
+	}
+}
+
+// Prefetch load in memory the n most recently edited bugs, so that accessing
+// them later is fast. It never loads more bugs than the cache can hold.
+// The number of bugs loaded is returned.
+func (c *RepoCache) Prefetch(n int) (int, error) {
+	c.muBug.RLock()
+	excerpts := make([]*BugExcerpt, 0, len(c.bugExcerpts))
+	for _, excerpt := range c.bugExcerpts {
+		excerpts = append(excerpts, excerpt)
+	}
+	c.muBug.RUnlock()
+
+	sort.Sort(sort.Reverse(BugsByEditTime(excerpts)))
+
+	if n > c.maxLoadedBugs {
+		n = c.maxLoadedBugs
+	}
+	if n > len(excerpts) {
+		n = len(excerpts)
+	}
+
+	// resolve from the oldest to the newest, so that the most recent ones are
+	// the last to be evicted from the LRU
+	for i := n - 1; i >= 0; i-- {
+		_, err := c.ResolveBug(excerpts[i].Id)
+		if err != nil {
+			return n - 1 - i, err
+		}
+	}
+
+	return n, nil
+}
+
+// prefetchSize return the configured number of bugs to prefetch after
+// building the cache for the first time. 0 means no prefetch.
+func (c *RepoCache) prefetchSize() (int, error) {
+	val, err := c.repo.AnyConfig().ReadString(prefetchConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s: %v", prefetchConfigKey, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,272 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// projectionFilePrefix start the name of the file where the views of a
+// projection are kept. It's local to the repository and never pushed.
+const projectionFilePrefix = "projection-"
+
+// Projection is a read-model derived from the operations of the bugs, like
+// SLA timers or custom reports, registered by a plugin with
+// RegisterProjection. The cache build a view of each bug with it, persist the
+// views and build them again when the bugs change.
+type Projection interface {
+	// Name identify the projection in the cache. It must be unique, and
+	// can't contain a '/'.
+	Name() string
+	// Version is the version of the views. When it changes, the views built
+	// by a previous version are dropped and built again.
+	Version() int
+	// Project build the view of a bug, from its compiled snapshot and
+	// operations. The view is opaque to the cache.
+	Project(snap *bug.Snapshot) ([]byte, error)
+}
+
+// projectionView is the view of a bug, with the edit time of the bug when it
+// was built. As the edit time of a bug only increases when the bug changes,
+// it's enough to tell if the view is still up to date.
+type projectionView struct {
+	EditTime lamport.Time `json:"edit_time"`
+	Data     []byte       `json:"data"`
+}
+
+// projectionFile is the content of the file of a projection
+type projectionFile struct {
+	Version int                           `json:"version"`
+	Views   map[entity.Id]*projectionView `json:"views"`
+}
+
+// projectionState is a registered projection and its views
+type projectionState struct {
+	mu         sync.Mutex
+	projection Projection
+	views      map[entity.Id]*projectionView
+}
+
+// projections is the set of registered Projection
+type projections struct {
+	mu     sync.RWMutex
+	states map[string]*projectionState
+}
+
+// ErrProjectionNotRegistered is returned when using a projection that has
+// not been registered.
+var ErrProjectionNotRegistered = errors.New("projection not registered")
+
+// RegisterProjection register a projection, so that its views can be
+// queried with ProjectionView and ProjectionViews. The views persisted by the
+// same version of the projection are reused, others are dropped.
+func (c *RepoCache) RegisterProjection(p Projection) error {
+	name := p.Name()
+	if name == "" || strings.Contains(name, "/") {
+		return fmt.Errorf("invalid projection name %q", name)
+	}
+
+	c.projections.mu.Lock()
+	defer c.projections.mu.Unlock()
+
+	if _, ok := c.projections.states[name]; ok {
+		return fmt.Errorf("projection %s already registered", name)
+	}
+
+	views, err := c.readProjectionFile(p)
+	if err != nil {
+		return err
+	}
+
+	if c.projections.states == nil {
+		c.projections.states = make(map[string]*projectionState)
+	}
+	c.projections.states[name] = &projectionState{projection: p, views: views}
+
+	return nil
+}
+
+// ProjectionView return the view of a bug built by a registered projection.
+// The view is built first if the bug changed since.
+func (c *RepoCache) ProjectionView(name string, id entity.Id) ([]byte, error) {
+	state, err := c.projectionState(name)
+	if err != nil {
+		return nil, err
+	}
+
+	excerpt, err := c.ResolveBugExcerpt(id)
+	if err != nil {
+		return nil, err
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	view, built, err := c.projectBug(state, excerpt)
+	if err != nil {
+		return nil, err
+	}
+	if built {
+		if err := c.writeProjectionFile(state); err != nil {
+			return nil, err
+		}
+	}
+
+	return view.Data, nil
+}
+
+// ProjectionViews return the views of all the bugs built by a registered
+// projection, building first the ones of the bugs that changed since.
+func (c *RepoCache) ProjectionViews(name string) (map[entity.Id][]byte, error) {
+	state, err := c.projectionState(name)
+	if err != nil {
+		return nil, err
+	}
+
+	c.muBug.RLock()
+	excerpts := make([]*BugExcerpt, 0, len(c.bugExcerpts))
+	for _, excerpt := range c.bugExcerpts {
+		excerpts = append(excerpts, excerpt)
+	}
+	c.muBug.RUnlock()
+
+	// build in a stable order
+	sort.Slice(excerpts, func(i, j int) bool { return excerpts[i].Id < excerpts[j].Id })
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	result := make(map[entity.Id][]byte, len(excerpts))
+	changed := false
+	for _, excerpt := range excerpts {
+		view, built, err := c.projectBug(state, excerpt)
+		if err != nil {
+			return nil, err
+		}
+		result[excerpt.Id] = view.Data
+		changed = changed || built
+	}
+
+	// drop the views of the removed bugs
+	for id := range state.views {
+		if _, ok := result[id]; !ok {
+			delete(state.views, id)
+			changed = true
+		}
+	}
+
+	if changed {
+		if err := c.writeProjectionFile(state); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// InvalidateProjection drop all the views of a registered projection, so that
+// they are built again when queried.
+func (c *RepoCache) InvalidateProjection(name string) error {
+	state, err := c.projectionState(name)
+	if err != nil {
+		return err
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.views = make(map[entity.Id]*projectionView)
+	return c.writeProjectionFile(state)
+}
+
+func (c *RepoCache) projectionState(name string) (*projectionState, error) {
+	c.projections.mu.RLock()
+	defer c.projections.mu.RUnlock()
+
+	state, ok := c.projections.states[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProjectionNotRegistered, name)
+	}
+	return state, nil
+}
+
+// projectBug return the view of a bug, building it if it's outdated, and tell
+// if it has been built. state.mu must be locked.
+func (c *RepoCache) projectBug(state *projectionState, excerpt *BugExcerpt) (*projectionView, bool, error) {
+	view, ok := state.views[excerpt.Id]
+	if ok && view.EditTime == excerpt.EditLamportTime {
+		return view, false, nil
+	}
+
+	b, err := c.ResolveBug(excerpt.Id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	data, err := state.projection.Project(b.Snapshot())
+	if err != nil {
+		return nil, false, fmt.Errorf("projection %s of bug %s: %w", state.projection.Name(), excerpt.Id.Human(), err)
+	}
+
+	view = &projectionView{EditTime: excerpt.EditLamportTime, Data: data}
+	state.views[excerpt.Id] = view
+	return view, true, nil
+}
+
+// readProjectionFile read the views persisted for a projection, if they were
+// built by the same version.
+func (c *RepoCache) readProjectionFile(p Projection) (map[entity.Id]*projectionView, error) {
+	views := make(map[entity.Id]*projectionView)
+
+	f, err := c.repo.LocalStorage().Open(projectionFilePrefix + p.Name())
+	if errors.Is(err, os.ErrNotExist) {
+		return views, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var file projectionFile
+	if err := json.Unmarshal(data, &file); err != nil || file.Version != p.Version() {
+		// broken or outdated, built again
+		return views, nil
+	}
+
+	for id, view := range file.Views {
+		views[id] = view
+	}
+	return views, nil
+}
+
+// writeProjectionFile replace the file of a projection at once. A read-only
+// cache keep the views in memory only. state.mu must be locked.
+func (c *RepoCache) writeProjectionFile(state *projectionState) error {
+	if c.readOnly {
+		return nil
+	}
+
+	data, err := json.Marshal(projectionFile{
+		Version: state.projection.Version(),
+		Views:   state.views,
+	})
+	if err != nil {
+		return err
+	}
+
+	return replaceFile(c.repo.LocalStorage(), projectionFilePrefix+state.projection.Name(), data)
+}
@@ -0,0 +1,220 @@
+package cache
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
+)
+
+// maxQuerySnapshots is the number of query results kept to serve the next
+// pages without running the query again
+const maxQuerySnapshots = 32
+
+// ErrInvalidCursor is returned when a page is requested with a cursor that
+// has not been created by this cache
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// BugPageRequest select a page of the result of a query
+type BugPageRequest struct {
+	// Limit is the maximum number of bugs in the page, 0 meaning no limit
+	Limit int
+	// Offset is the number of bugs to skip, from the start of the result or
+	// from the position of the cursor
+	Offset int
+	// Cursor, if set, continue a previous query after its page. The query
+	// given with the cursor must be the same.
+	Cursor string
+}
+
+// BugPage is a page of the result of a query
+type BugPage struct {
+	Ids []entity.Id
+	// Offset is the position of the first bug of the page in the result
+	Offset int
+	// TotalCount is the number of bugs matching the query
+	TotalCount int
+	// Cursor continue the query after this page, empty on the last page
+	Cursor string
+}
+
+// querySnapshot is the sorted result of a query, as it was for a generation
+// of the cache
+type querySnapshot struct {
+	token      string
+	key        string
+	generation uint64
+	ids        []entity.Id
+}
+
+// querySnapshots keep the results of the recent queries, the least recently
+// used first
+type querySnapshots struct {
+	mu        sync.Mutex
+	snapshots []*querySnapshot
+}
+
+// QueryBugsPage return a page of the ids of the bugs matching the given Query.
+//
+// The sorted result of the query is kept while the cache doesn't change, so
+// that requesting the next pages doesn't run the query again. When the cursor
+// of a previous page is given, the page is taken from the result as it was
+// when the cursor was created, if still available.
+func (c *RepoCache) QueryBugsPage(q *query.Query, req BugPageRequest) (*BugPage, error) {
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, fmt.Errorf("invalid page, limit and offset must be positive")
+	}
+
+	key := querySnapshotKey(q)
+	offset := req.Offset
+
+	var snapshot *querySnapshot
+	if req.Cursor != "" {
+		token, cursorOffset, err := decodeQueryCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		offset += cursorOffset
+		snapshot = c.querySnapshots.get(token, key)
+	}
+
+	generation := c.events.Generation()
+
+	if snapshot == nil && key != "" {
+		snapshot = c.querySnapshots.find(key, generation)
+	}
+
+	if snapshot == nil {
+		ids, err := c.QueryBugs(q)
+		if err != nil {
+			return nil, err
+		}
+		snapshot = &querySnapshot{key: key, generation: generation, ids: ids}
+	}
+
+	page := &BugPage{
+		Offset:     offset,
+		TotalCount: len(snapshot.ids),
+	}
+
+	if offset >= len(snapshot.ids) {
+		return page, nil
+	}
+
+	end := len(snapshot.ids)
+	if req.Limit > 0 && offset+req.Limit < end {
+		end = offset + req.Limit
+	}
+	page.Ids = snapshot.ids[offset:end]
+
+	if end < len(snapshot.ids) && key != "" {
+		if snapshot.token == "" {
+			snapshot.token = newQuerySnapshotToken()
+			c.querySnapshots.add(snapshot)
+		}
+		page.Cursor = encodeQueryCursor(snapshot.token, end)
+	}
+
+	return page, nil
+}
+
+// querySnapshotKey identify a query, or return an empty key if its result
+// depend on more than the bugs, like the read state of the user, and can't be
+// kept.
+func querySnapshotKey(q *query.Query) string {
+	if q == nil {
+		q = &query.Query{}
+	}
+	if q.Unread {
+		return ""
+	}
+	return fmt.Sprintf("%#v", *q)
+}
+
+// find return the snapshot of a query for the current generation of the cache
+func (qs *querySnapshots) find(key string, generation uint64) *querySnapshot {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	for i, snapshot := range qs.snapshots {
+		if snapshot.key == key && snapshot.generation == generation {
+			qs.touch(i)
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// get return the snapshot of a cursor, if still kept, whatever the generation
+func (qs *querySnapshots) get(token string, key string) *querySnapshot {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	for i, snapshot := range qs.snapshots {
+		if snapshot.token == token && snapshot.key == key {
+			qs.touch(i)
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// add keep a snapshot, evicting the least recently used one if needed
+func (qs *querySnapshots) add(snapshot *querySnapshot) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	if len(qs.snapshots) >= maxQuerySnapshots {
+		qs.snapshots = qs.snapshots[1:]
+	}
+	qs.snapshots = append(qs.snapshots, snapshot)
+}
+
+// clear drop all the snapshots, for example when the cache is rebuilt
+func (qs *querySnapshots) clear() {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	qs.snapshots = nil
+}
+
+// touch move a snapshot at the end, as the most recently used.
+// qs.mu must be locked.
+func (qs *querySnapshots) touch(i int) {
+	snapshot := qs.snapshots[i]
+	qs.snapshots = append(qs.snapshots[:i], qs.snapshots[i+1:]...)
+	qs.snapshots = append(qs.snapshots, snapshot)
+}
+
+func newQuerySnapshotToken() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+func encodeQueryCursor(token string, offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(token + ":" + strconv.Itoa(offset)))
+}
+
+func decodeQueryCursor(cursor string) (string, int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", 0, ErrInvalidCursor
+	}
+	token, rawOffset, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return "", 0, ErrInvalidCursor
+	}
+	offset, err := strconv.Atoi(rawOffset)
+	if err != nil || offset < 0 {
+		return "", 0, ErrInvalidCursor
+	}
+	return token, offset, nil
+}
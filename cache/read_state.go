@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// readStateFile is where the last read state is kept. It's local to the
+// repository and never pushed.
+const readStateFile = "read-state"
+
+// readState is, for each user identity, the edit time of each bug when the
+// user last read it
+type readState struct {
+	mu     sync.Mutex
+	loaded bool
+	// user id -> bug id -> edit lamport time when last read
+	lastRead map[entity.Id]map[entity.Id]lamport.Time
+}
+
+// MarkAsRead record that the user identity has read the bug as it is now.
+// The bug stays read until it is edited again.
+func (c *RepoCache) MarkAsRead(id entity.Id) error {
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+
+	user, err := c.GetUserIdentityExcerpt()
+	if err != nil {
+		return err
+	}
+
+	excerpt, err := c.ResolveBugExcerpt(id)
+	if err != nil {
+		return err
+	}
+
+	c.readState.mu.Lock()
+	defer c.readState.mu.Unlock()
+
+	if err := c.loadReadState(); err != nil {
+		return err
+	}
+
+	if c.readState.lastRead[user.Id] == nil {
+		c.readState.lastRead[user.Id] = make(map[entity.Id]lamport.Time)
+	}
+	c.readState.lastRead[user.Id][id] = excerpt.EditLamportTime
+
+	return c.writeReadState()
+}
+
+// IsUnread tell if the bug has been edited since the user identity last read
+// it. A bug never read is unread.
+func (c *RepoCache) IsUnread(id entity.Id) (bool, error) {
+	excerpt, err := c.ResolveBugExcerpt(id)
+	if err != nil {
+		return false, err
+	}
+	return c.isUnread(excerpt)
+}
+
+// isUnread is the same as IsUnread, for an already resolved excerpt. Without
+// user identity, nothing can have been read.
+func (c *RepoCache) isUnread(excerpt *BugExcerpt) (bool, error) {
+	user, err := c.GetUserIdentityExcerpt()
+	if err != nil {
+		return true, nil
+	}
+
+	c.readState.mu.Lock()
+	defer c.readState.mu.Unlock()
+
+	if err := c.loadReadState(); err != nil {
+		return false, err
+	}
+
+	lastRead, ok := c.readState.lastRead[user.Id][excerpt.Id]
+	return !ok || excerpt.EditLamportTime > lastRead, nil
+}
+
+// loadReadState read the read state file, if not done already.
+// c.readState.mu must be locked.
+func (c *RepoCache) loadReadState() error {
+	if c.readState.loaded {
+		return nil
+	}
+
+	c.readState.lastRead = make(map[entity.Id]map[entity.Id]lamport.Time)
+
+	f, err := c.repo.LocalStorage().Open(readStateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		c.readState.loaded = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(data, &c.readState.lastRead)
+	if err != nil {
+		return err
+	}
+
+	c.readState.loaded = true
+	return nil
+}
+
+// writeReadState replace the read state file at once.
+// c.readState.mu must be locked.
+func (c *RepoCache) writeReadState() error {
+	data, err := json.Marshal(c.readState.lastRead)
+	if err != nil {
+		return err
+	}
+
+	return replaceFile(c.repo.LocalStorage(), readStateFile, data)
+}
@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// the prefix of the git refs of the bugs
+const bugRefPrefix = "refs/bugs/"
+
+// updateBugTip record the commit the ref of a bug currently point to.
+// The bug lock must be held.
+func (c *RepoCache) updateBugTip(id entity.Id) error {
+	tip, err := c.repo.ResolveRef(bugRefPrefix + id.String())
+	if err != nil {
+		return err
+	}
+	if c.bugTips == nil {
+		c.bugTips = make(map[entity.Id]repository.Hash)
+	}
+	c.bugTips[id] = tip
+	return nil
+}
+
+// listBugTips return the commit each bug ref currently point to
+func (c *RepoCache) listBugTips() (map[entity.Id]repository.Hash, error) {
+	refs, err := c.repo.ListRefs(bugRefPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	tips := make(map[entity.Id]repository.Hash, len(refs))
+	for _, ref := range refs {
+		tip, err := c.repo.ResolveRef(ref)
+		if err != nil {
+			return nil, err
+		}
+		tips[entity.Id(strings.TrimPrefix(ref, bugRefPrefix))] = tip
+	}
+
+	return tips, nil
+}
+
+// syncBugRefs compare the bug refs with their tips recorded in the cache file,
+// and only read again the bugs changed outside of the cache, for example by a
+// plain git fetch or another tool. The excerpts of the bugs whose ref has been
+// removed are dropped.
+func (c *RepoCache) syncBugRefs(recorded map[entity.Id]repository.Hash) error {
+	current, err := c.listBugTips()
+	if err != nil {
+		return err
+	}
+
+	var changed []entity.Id
+	for id, tip := range current {
+		if recorded[id] != tip {
+			changed = append(changed, id)
+		}
+	}
+
+	var removed []entity.Id
+	c.muBug.RLock()
+	for id := range c.bugExcerpts {
+		if _, ok := current[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	c.muBug.RUnlock()
+
+	if len(changed) == 0 && len(removed) == 0 {
+		c.muBug.Lock()
+		c.bugTips = current
+		c.muBug.Unlock()
+		return nil
+	}
+
+	index, err := c.repo.GetBleveIndex("bug")
+	if err != nil {
+		return err
+	}
+
+	// the bugs are read without holding the lock, as resolving them can
+	// require the cache
+	excerpts := make(map[entity.Id]*BugExcerpt, len(changed))
+	for _, id := range changed {
+		b, err := bug.ReadWithResolver(c.repo, c.resolvers, id)
+		if err != nil {
+			return err
+		}
+		snap := b.Compile()
+		c.snapshots.add(b, snap)
+		excerpts[id] = NewBugExcerpt(b, snap)
+
+		if err := c.addBugToSearchIndex(snap); err != nil {
+			return err
+		}
+	}
+	for _, id := range removed {
+		if err := index.Delete(id.String()); err != nil {
+			return err
+		}
+	}
+
+	c.muBug.Lock()
+	for id, excerpt := range excerpts {
+		c.bugExcerpts[id] = excerpt
+		c.dropLoadedBug(id)
+	}
+	for _, id := range removed {
+		delete(c.bugExcerpts, id)
+		c.dropLoadedBug(id)
+	}
+	c.bugTips = current
+	c.rebuildActivityIndex()
+	c.rebuildStatistics()
+	c.rebuildBugIndex()
+	c.muBug.Unlock()
+
+	return c.writeBugCache()
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// IncomingRelation is a relation from another bug, as seen from its target
+type IncomingRelation struct {
+	Type   bug.RelationType
+	Source entity.Id
+}
+
+// IncomingRelations return the relations from the other bugs to the given
+// bug, like the bugs it is blocked by or the duplicates of it. They are
+// sorted by type, then by source.
+func (c *RepoCache) IncomingRelations(id entity.Id) []IncomingRelation {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	var result []IncomingRelation
+	for _, relType := range bug.RelationTypes {
+		set := c.index.byRelation[bug.Relation{Type: relType, Target: id}]
+		sources := make([]entity.Id, 0, len(set))
+		for source := range set {
+			sources = append(sources, source)
+		}
+		sort.Slice(sources, func(i, j int) bool {
+			return sources[i] < sources[j]
+		})
+		for _, source := range sources {
+			result = append(result, IncomingRelation{Type: relType, Source: source})
+		}
+	}
+	return result
+}
+
+// ResolveRelation find the relation of the bug of the given type, to the
+// other bug matching an id prefix. The other bug doesn't have to be known
+// locally, which allows to remove a link to a bug that has been removed.
+func (c *BugCache) ResolveRelation(relType bug.RelationType, prefix string) (bug.Relation, error) {
+	var matching []entity.Id
+	for _, relation := range c.Snapshot().Relations {
+		if relation.Type == relType && relation.Target.HasPrefix(prefix) {
+			matching = append(matching, relation.Target)
+		}
+	}
+
+	switch len(matching) {
+	case 0:
+		return bug.Relation{}, fmt.Errorf("no relation %s %s", relType, prefix)
+	case 1:
+		return bug.Relation{Type: relType, Target: matching[0]}, nil
+	default:
+		return bug.Relation{}, entity.NewErrMultipleMatch("relation", matching)
+	}
+}
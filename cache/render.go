@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/render"
+)
+
+// git config key of the URL of the commits, to link the commit hashes of the
+// messages in the web UI. "%s" is replaced by the hash, as in
+// "https://github.com/MichaelMure/git-bug/commit/%s".
+const commitURLConfigKey = "git-bug.commit-url"
+
+// RefResolver return a render.Resolver resolving the references of the
+// messages to the bugs and the identities of the repository
+func (c *RepoCache) RefResolver() render.Resolver {
+	return refResolver{c: c}
+}
+
+type refResolver struct {
+	c *RepoCache
+}
+
+func (r refResolver) ResolveBugRef(prefix string) (entity.Id, bool) {
+	excerpt, err := r.c.ResolveBugExcerptPrefix(prefix)
+	if err != nil {
+		return "", false
+	}
+	return excerpt.Id, true
+}
+
+func (r refResolver) ResolveUserRef(login string) (entity.Id, bool) {
+	excerpt, err := r.c.ResolveIdentityExcerptMatcher(func(excerpt *IdentityExcerpt) bool {
+		return excerpt.Login != "" && strings.EqualFold(excerpt.Login, login)
+	})
+	if err != nil {
+		return "", false
+	}
+	return excerpt.Id, true
+}
+
+// CommitURL return the URL of a commit, as configured with the
+// git-bug.commit-url git config key, or an empty string if not configured.
+func (c *RepoCache) CommitURL(hash string) string {
+	template, err := c.repo.AnyConfig().ReadString(commitURLConfigKey)
+	if err != nil || !strings.Contains(template, "%s") {
+		return ""
+	}
+	return strings.ReplaceAll(template, "%s", hash)
+}
+
+// Backlinks return the bugs referencing the given bug with #prefix in their
+// description or comments, sorted by id
+func (c *RepoCache) Backlinks(id entity.Id) []entity.Id {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	sources := make(idSet)
+	for prefix, set := range c.index.byReference {
+		if id.HasPrefix(prefix) {
+			unionInto(sources, set)
+		}
+	}
+	delete(sources, id)
+
+	result := make([]entity.Id, 0, len(sources))
+	for source := range sources {
+		result = append(result, source)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
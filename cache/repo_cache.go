@@ -1,10 +1,12 @@
 package cache
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"runtime"
 	"strconv"
 	"sync"
 
@@ -19,7 +21,18 @@ import (
 // 2: added cache for identities with a reference in the bug cache
 // 3: no more legacy identity
 // 4: entities make their IDs from data, not git commit
-const formatVersion = 4
+// 5: added the awaiting reporter, confidential and activity data to the bug excerpt
+// 6: added the sync conflict flag to the bug excerpt
+// 7: added the custom fields to the bug excerpt
+// 8: added the tips of the bug refs to the bug cache
+// 9: switched from gob to a protobuf encoding, see cache.proto
+// 10: added the canonical bug of the duplicates to the bug excerpt
+// 11: added the time of the last comment to the bug excerpt
+// 12: added the email to the identity excerpt
+// 13: added the relations to other bugs to the bug excerpt
+// 14: added the assignees to the bug excerpt
+// 15: added the state of the workflow to the bug excerpt
+const formatVersion = 16
 
 // The maximum number of bugs loaded in memory. After that, eviction will be done.
 const defaultMaxLoadedBugs = 1000
@@ -38,6 +51,18 @@ var _ repository.RepoKeyring = &RepoCache{}
 // 3. The cache guarantee that a single instance of a Bug is loaded at once, avoiding
 // 		loss of data that we could have with multiple copies in the same process.
 // 4. The same way, the cache maintain in memory a single copy of the loaded identities.
+// 5. The cache maintain the views of the bugs built by the registered Projection.
+//
+// The cache is safe for concurrent use: the excerpts are guarded by a RWMutex
+// held only briefly by the writers, as a new excerpt is computed aside and
+// swapped in at once, and each loaded bug has its own RWMutex. A Snapshot of a
+// bug is never modified once returned, so it can be read without locking.
+//
+// The entities other than the bugs are each managed by a SubCache, which provide
+// the same excerpts, resolving and persistence for any kind of entity.
+//
+// The mutations touching both git and the cache files are recorded beforehand
+// in a journal, replayed when the cache is opened after an interruption.
 //
 // The cache also protect the on-disk data by locking the git repository for its
 // own usage, by writing a lock file. Of course, normal git operations are not
@@ -54,23 +79,97 @@ type RepoCache struct {
 
 	// maximum number of loaded bugs
 	maxLoadedBugs int
+	// maximum estimated memory used by the loaded bugs, 0 for no limit
+	maxLoadedBytes int64
 
 	muBug sync.RWMutex
 	// excerpt of bugs data for all bugs
 	bugExcerpts map[entity.Id]*BugExcerpt
+	// the commit each bug ref pointed to when its excerpt was made
+	bugTips map[entity.Id]repository.Hash
 	// bug loaded in memory
 	bugs map[entity.Id]*BugCache
 	// loadedBugs is an LRU cache that records which bugs the cache has loaded in
 	loadedBugs *LRUIdCache
+	// estimated memory used by the loaded bugs
+	loadedBytes int64
+	// per-identity index of the bugs an identity has been active on
+	activity map[entity.Id]map[entity.Id]struct{}
+	// aggregates over all the bugs
+	stats *repoStatistics
+	// secondary indexes of the bug excerpts
+	index *bugIndex
+
+	// the identities, their excerpts and the loaded ones
+	identities *SubCache[*identity.Identity, *IdentityExcerpt, *IdentityCache]
 
-	muIdentity sync.RWMutex
-	// excerpt of identities data for all identities
-	identitiesExcerpts map[entity.Id]*IdentityExcerpt
-	// identities loaded in memory
-	identities map[entity.Id]*IdentityCache
+	// all the SubCache, in the order they need to be built
+	subCaches []entitySubCache
 
 	// the user identity's id, if known
 	userIdentityId entity.Id
+
+	// dispatch the events emitted when something changes
+	events *EventBus
+	// the sorted results of the recent queries, to serve their next pages
+	querySnapshots querySnapshots
+	// the recently compiled snapshots of the bugs
+	snapshots *snapshotCache
+	// the hooks notified of the changes of the bug excerpts
+	excerptHooks excerptHooks
+	// the listeners of the progress of the next build of the cache
+	buildProgress buildProgress
+	// the state of the batch mode, where the cache files are written less often
+	batch batch
+	// the mutations in progress, replayed if interrupted
+	journal journal
+	// the bugs each user identity has read
+	readState readState
+	// the colors and descriptions of the labels
+	labelRegistry labelRegistry
+	// the read-models registered by the plugins
+	projections projections
+	// the ordered changes of the repository, for the external systems
+	changeFeed changeFeed
+
+	// the lock file has not been taken
+	noLock bool
+	// mutating operations are refused
+	readOnly bool
+	// closed to stop watching the cache files of a read-only cache
+	stopWatch chan struct{}
+	// where the cache build progress is written
+	progress io.Writer
+}
+
+// RepoCacheOptions tune how a RepoCache is opened
+type RepoCacheOptions struct {
+	// Name is the name of the repository, as defined in the MultiRepoCache
+	Name string
+
+	// NoLock skip taking the repository lock. The caller is responsible for
+	// making sure that no other git-bug process writes concurrently.
+	NoLock bool
+
+	// ReadOnly open the cache without the lock, and refuse any operation that
+	// would change the repository or the cache. The cache must already be
+	// built and up to date, as it can't be rebuilt. The cache files are then
+	// watched to follow the changes made by the process holding the lock.
+	ReadOnly bool
+
+	// Progress receive the progress messages when the cache is built.
+	// It defaults to os.Stderr.
+	Progress io.Writer
+
+	// MaxLoadedBugs is the maximum number of bugs kept fully loaded in memory,
+	// the least recently used ones being evicted after that. It defaults to the
+	// git-bug.cache.max-loaded-bugs git config, or 1000.
+	MaxLoadedBugs int
+
+	// MaxLoadedBytes is the maximum estimated memory used by the bugs kept
+	// fully loaded. It defaults to the git-bug.cache.max-loaded-bytes git
+	// config, or no limit.
+	MaxLoadedBytes int64
 }
 
 func NewRepoCache(r repository.ClockedRepo) (*RepoCache, error) {
@@ -78,23 +177,62 @@ func NewRepoCache(r repository.ClockedRepo) (*RepoCache, error) {
 }
 
 func NewNamedRepoCache(r repository.ClockedRepo, name string) (*RepoCache, error) {
+	return NewRepoCacheWithOptions(r, RepoCacheOptions{Name: name})
+}
+
+// NewRepoCacheReadOnly open the cache of a repository without taking the lock,
+// so that it can be used while another process, like the web UI, holds it.
+// Mutating operations are refused with ErrReadOnly, and the cache files are
+// watched to follow the changes made by the process holding the lock.
+func NewRepoCacheReadOnly(r repository.ClockedRepo) (*RepoCache, error) {
+	return NewRepoCacheWithOptions(r, RepoCacheOptions{ReadOnly: true})
+}
+
+func NewRepoCacheWithOptions(r repository.ClockedRepo, opts RepoCacheOptions) (*RepoCache, error) {
 	c := &RepoCache{
-		repo:          r,
-		name:          name,
-		maxLoadedBugs: defaultMaxLoadedBugs,
-		bugs:          make(map[entity.Id]*BugCache),
-		loadedBugs:    NewLRUIdCache(),
-		identities:    make(map[entity.Id]*IdentityCache),
+		repo:       r,
+		name:       opts.Name,
+		bugs:       make(map[entity.Id]*BugCache),
+		loadedBugs: NewLRUIdCache(),
+		snapshots:  newSnapshotCache(),
+		events:     NewEventBus(),
+		noLock:     opts.NoLock || opts.ReadOnly,
+		readOnly:   opts.ReadOnly,
+		progress:   opts.Progress,
+	}
+
+	if err := c.readCacheLimits(opts); err != nil {
+		return nil, err
+	}
+
+	if c.progress == nil {
+		c.progress = os.Stderr
 	}
 
+	c.identities = newSubCache(c, identityCacheDefinition)
+	c.subCaches = []entitySubCache{c.identities}
 	c.resolvers = makeResolvers(c)
 
-	err := c.lock()
-	if err != nil {
-		return &RepoCache{}, err
+	if c.readOnly {
+		// stat before reading, to not miss a write in between
+		state := c.statCacheFiles()
+		err := c.loadReadOnly()
+		if err != nil {
+			return nil, fmt.Errorf("the cache can't be opened read-only, it needs to be built first: %w", err)
+		}
+		c.stopWatch = make(chan struct{})
+		go c.watchCacheFiles(c.stopWatch, state)
+		return c, nil
+	}
+
+	if !c.noLock {
+		err := c.lock()
+		if err != nil {
+			return &RepoCache{}, err
+		}
 	}
 
-	err = c.load()
+	err := c.load()
 	if err == nil {
 		return c, nil
 	}
@@ -105,32 +243,144 @@ func NewNamedRepoCache(r repository.ClockedRepo, name string) (*RepoCache, error
 		return nil, err
 	}
 
-	return c, c.write()
+	err = c.write()
+	if err != nil {
+		return nil, err
+	}
+
+	// the rebuilt cache reflects all the interrupted mutations
+	err = c.clearJournal()
+	if err != nil {
+		return nil, err
+	}
+
+	// The cache has just been built, which most likely means that the repository
+	// has just been cloned. Warm up the cache if configured to do so.
+	prefetch, err := c.prefetchSize()
+	if err != nil {
+		return nil, err
+	}
+	if prefetch > 0 {
+		if _, err := c.Prefetch(prefetch); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
 }
 
-// setCacheSize change the maximum number of loaded bugs
-func (c *RepoCache) setCacheSize(size int) {
-	c.maxLoadedBugs = size
-	c.evictIfNeeded()
+// Subscribe register to the events emitted by the cache each time something
+// changes. The returned function must be called to unsubscribe.
+func (c *RepoCache) Subscribe() (<-chan Event, func()) {
+	return c.events.Subscribe()
+}
+
+// SubscribeKinds register to the events of the given kinds emitted by the
+// cache, as in SubscribeKinds(EventBugCreated|EventBugUpdated). The returned
+// function must be called to unsubscribe.
+func (c *RepoCache) SubscribeKinds(kinds EventKind) (<-chan Event, func()) {
+	return c.events.SubscribeKinds(kinds)
+}
+
+// LoadedCount return the number of bugs and identities fully loaded in memory.
+// Entities only available as excerpts are not counted.
+func (c *RepoCache) LoadedCount() (bugs int, identities int) {
+	c.muBug.RLock()
+	bugs = len(c.bugs)
+	c.muBug.RUnlock()
+
+	return bugs, c.identities.LoadedCount()
 }
 
 // load will try to read from the disk all the cache files
 func (c *RepoCache) load() error {
-	err := c.loadBugCache()
+	tips, migrated, err := c.loadBugCache()
+	if err != nil {
+		return err
+	}
+
+	for _, sc := range c.subCaches {
+		subMigrated, err := sc.load()
+		if err != nil {
+			return err
+		}
+		migrated = migrated || subMigrated
+	}
+
+	err = c.syncBugRefs(tips)
 	if err != nil {
 		return err
 	}
 
-	return c.loadIdentityCache()
+	err = c.replayJournal()
+	if err != nil {
+		return err
+	}
+
+	err = c.checkBugSearchIndex()
+	if err != nil {
+		return err
+	}
+
+	if migrated {
+		// store the migrated cache, to not migrate again next time
+		return c.write()
+	}
+
+	return nil
+}
+
+// loadReadOnly read from the disk the cache files, without touching the
+// search index that can be held by another process
+func (c *RepoCache) loadReadOnly() error {
+	bugExcerpts, err := readBugCache(c.repo.LocalStorage())
+	if err != nil {
+		return err
+	}
+
+	identityExcerpts, err := readIdentityCache(c.repo.LocalStorage())
+	if err != nil {
+		return err
+	}
+
+	c.muBug.Lock()
+	c.bugExcerpts = bugExcerpts
+	c.rebuildActivityIndex()
+	c.rebuildStatistics()
+	c.rebuildBugIndex()
+	c.muBug.Unlock()
+
+	c.identities.setExcerpts(identityExcerpts)
+
+	return nil
+}
+
+// checkWritable return ErrReadOnly if the cache has been opened read-only
+func (c *RepoCache) checkWritable() error {
+	if c.readOnly {
+		return ErrReadOnly
+	}
+	return nil
 }
 
 // write will serialize on disk all the cache files
 func (c *RepoCache) write() error {
+	// the mutations done so far are written below
+	c.batch.mu.Lock()
+	journaled := c.batch.journaled
+	c.batch.journaled = nil
+	c.batch.mu.Unlock()
+
 	err := c.writeBugCache()
 	if err != nil {
 		return err
 	}
-	return c.writeIdentityCache()
+	for _, sc := range c.subCaches {
+		if err := sc.write(); err != nil {
+			return err
+		}
+	}
+	return c.endJournalEntries(journaled)
 }
 
 func (c *RepoCache) lock() error {
@@ -154,71 +404,176 @@ func (c *RepoCache) lock() error {
 }
 
 func (c *RepoCache) Close() error {
+	// don't lose the updates of an unfinished batch
+	c.batch.mu.Lock()
+	pending := c.batch.active && c.batch.pending > 0
+	c.batch.mu.Unlock()
+	if pending {
+		if err := c.write(); err != nil {
+			return err
+		}
+	}
+
+	for _, sc := range c.subCaches {
+		sc.close()
+	}
+
 	c.muBug.Lock()
 	defer c.muBug.Unlock()
-	c.muIdentity.Lock()
-	defer c.muIdentity.Unlock()
 
-	c.identities = make(map[entity.Id]*IdentityCache)
-	c.identitiesExcerpts = nil
 	c.bugs = make(map[entity.Id]*BugCache)
 	c.bugExcerpts = nil
+	c.bugTips = nil
+	c.activity = nil
+	c.index = nil
+
+	c.events.Close()
+	c.buildProgress.close()
+	if c.stopWatch != nil {
+		close(c.stopWatch)
+		c.stopWatch = nil
+	}
 
 	err := c.repo.Close()
 	if err != nil {
 		return err
 	}
 
+	if c.noLock {
+		return nil
+	}
+
 	return c.repo.LocalStorage().Remove(lockfile)
 }
 
 func (c *RepoCache) buildCache() error {
-	_, _ = fmt.Fprintf(os.Stderr, "Building identity cache... ")
-
-	c.identitiesExcerpts = make(map[entity.Id]*IdentityExcerpt)
+	// whatever happens, the listeners of this build are done
+	defer c.buildProgress.close()
 
-	allIdentities := identity.ReadAllLocal(c.repo)
-
-	for i := range allIdentities {
-		if i.Err != nil {
-			return i.Err
+	// the bugs resolve the other entities, so they are built last
+	for _, sc := range c.subCaches {
+		if err := sc.build(); err != nil {
+			return err
 		}
-
-		c.identitiesExcerpts[i.Identity.Id()] = NewIdentityExcerpt(i.Identity)
 	}
 
-	_, _ = fmt.Fprintln(os.Stderr, "Done.")
-
-	_, _ = fmt.Fprintf(os.Stderr, "Building bug cache... ")
+	return c.buildBugCache()
+}
 
-	c.bugExcerpts = make(map[entity.Id]*BugExcerpt)
+// buildBugCache read all the bugs concurrently, with a pool of workers, to
+// build their excerpts and index them.
+func (c *RepoCache) buildBugCache() error {
+	ids, err := bug.ListLocalIds(c.repo)
+	if err != nil {
+		return err
+	}
 
-	allBugs := bug.ReadAllWithResolver(c.repo, c.resolvers)
+	reporter := newBuildReporter(&c.buildProgress, c.progress, "bug", len(ids))
 
 	// wipe the index just to be sure
-	err := c.repo.ClearBleveIndex("bug")
+	err = c.repo.ClearBleveIndex("bug")
 	if err != nil {
+		reporter.finish(err)
 		return err
 	}
 
-	for b := range allBugs {
-		if b.Err != nil {
-			return b.Err
-		}
+	var mu sync.Mutex
+	bugExcerpts := make(map[entity.Id]*BugExcerpt, len(ids))
+	bugTips := make(map[entity.Id]repository.Hash, len(ids))
 
-		snap := b.Bug.Compile()
-		c.bugExcerpts[b.Bug.Id()] = NewBugExcerpt(b.Bug, snap)
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(ids) {
+		workers = len(ids)
+	}
 
-		if err := c.addBugToSearchIndex(snap); err != nil {
-			return err
+	todo := make(chan entity.Id)
+	errs := make(chan error, workers)
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range todo {
+				b, err := bug.ReadWithResolver(c.repo, c.resolvers, id)
+				var tip repository.Hash
+				if err == nil {
+					tip, err = c.repo.ResolveRef(bugRefPrefix + id.String())
+				}
+				if err == nil {
+					snap := b.Compile()
+					mu.Lock()
+					bugExcerpts[id] = NewBugExcerpt(b, snap)
+					bugTips[id] = tip
+					mu.Unlock()
+					err = c.addBugToSearchIndex(snap)
+				}
+				if err != nil {
+					errs <- err
+					stopOnce.Do(func() { close(stop) })
+					return
+				}
+				reporter.step()
+			}
+		}()
+	}
+
+feed:
+	for _, id := range ids {
+		select {
+		case todo <- id:
+		case <-stop:
+			break feed
 		}
 	}
+	close(todo)
+	wg.Wait()
+
+	// report the first error, if any
+	select {
+	case err := <-errs:
+		reporter.finish(err)
+		return err
+	default:
+	}
+
+	c.muBug.Lock()
+	c.bugExcerpts = bugExcerpts
+	c.bugTips = bugTips
+	c.rebuildActivityIndex()
+	c.rebuildStatistics()
+	c.rebuildBugIndex()
+	c.muBug.Unlock()
+
+	c.querySnapshots.clear()
+	c.snapshots.clear()
 
-	_, _ = fmt.Fprintln(os.Stderr, "Done.")
+	reporter.finish(nil)
 
 	return nil
 }
 
+// ErrReadOnly is returned when trying to change a cache opened read-only
+var ErrReadOnly = errors.New("the cache has been opened read-only")
+
+// ErrLocked is returned when the repository is already locked by another
+// running process
+type ErrLocked struct {
+	Pid int
+}
+
+func (e ErrLocked) Error() string {
+	return fmt.Sprintf("the repository you want to access is already locked by the process pid %d", e.Pid)
+}
+
+// IsErrLocked return true if the error, or any error it wraps, is an ErrLocked
+func IsErrLocked(err error) bool {
+	var target ErrLocked
+	return errors.As(err, &target)
+}
+
 // repoIsAvailable check is the given repository is locked by a Cache.
 // Note: this is a smart function that will clean the lock file if the
 // corresponding process is not there anymore.
@@ -256,7 +611,7 @@ func repoIsAvailable(repo repository.RepoStorage) error {
 		}
 
 		if process.IsRunning(pid) {
-			return fmt.Errorf("the repository you want to access is already locked by the process pid %d", pid)
+			return ErrLocked{Pid: pid}
 		}
 
 		// The lock file is just laying there after a crash, clean it
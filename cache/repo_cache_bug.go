@@ -1,8 +1,6 @@
 package cache
 
 import (
-	"bytes"
-	"encoding/gob"
 	"errors"
 	"fmt"
 	"sort"
@@ -11,6 +9,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/blevesearch/bleve"
+	"github.com/go-git/go-billy/v5"
 
 	"github.com/MichaelMure/git-bug/entities/bug"
 	"github.com/MichaelMure/git-bug/entity"
@@ -25,10 +24,14 @@ var errBugNotInCache = errors.New("bug missing from cache")
 // bugUpdated is a callback to trigger when the excerpt of a bug changed,
 // that is each time a bug is updated
 func (c *RepoCache) bugUpdated(id entity.Id) error {
-	c.muBug.Lock()
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+
+	c.muBug.RLock()
 	b, ok := c.bugs[id]
 	if !ok {
-		c.muBug.Unlock()
+		c.muBug.RUnlock()
 
 		// if the bug is not loaded at this point, it means it was loaded before
 		// but got evicted. Which means we potentially have multiple copies in
@@ -37,45 +40,89 @@ func (c *RepoCache) bugUpdated(id entity.Id) error {
 		// complicated data loss.
 		return errBugNotInCache
 	}
+
+	// The new excerpt is computed with only the read lock of the cache, so that
+	// the readers are not blocked meanwhile. The bug can't be evicted while the
+	// lock is held.
+	b.mu.Lock()
+	snap := b.compile()
+	excerpt := NewBugExcerpt(b.bug, snap)
+	size := estimateBugSize(b.bug.Bug)
+	ops := b.bug.Operations()
+	newOps := ops[b.notifiedOps:]
+	b.notifiedOps = len(ops)
+	b.mu.Unlock()
+	c.muBug.RUnlock()
+
+	// then swapped in at once
+	c.muBug.Lock()
+	if c.bugs[id] != b {
+		c.muBug.Unlock()
+		// evicted in between, see above
+		return errBugNotInCache
+	}
 	c.loadedBugs.Get(id)
-	c.bugExcerpts[id] = NewBugExcerpt(b.bug, b.Snapshot())
+	c.resizeLoadedBug(b, size)
+	if err := c.updateBugTip(id); err != nil {
+		c.muBug.Unlock()
+		return err
+	}
+	_, existed := c.bugExcerpts[id]
+	c.updateStatistics(c.bugExcerpts[id], excerpt)
+	c.updateBugIndex(c.bugExcerpts[id], excerpt)
+	c.bugExcerpts[id] = excerpt
+	c.indexActivity(excerpt)
 	c.muBug.Unlock()
 
-	if err := c.addBugToSearchIndex(b.Snapshot()); err != nil {
+	c.notifyExcerptChanged(excerpt, !existed)
+
+	if len(newOps) > 0 {
+		c.events.Publish(BugUpdated{BugId: id, Operations: newOps})
+	}
+
+	if err := c.addBugToSearchIndex(snap); err != nil {
 		return err
 	}
 
 	// we only need to write the bug cache
-	return c.writeBugCache()
+	return c.writeAfterUpdate(c.writeBugCache)
 }
 
-// load will try to read from the disk the bug cache file
-func (c *RepoCache) loadBugCache() error {
+// load will try to read from the disk the bug cache file, migrating it from a
+// previous format version if needed
+func (c *RepoCache) loadBugCache() (tips map[entity.Id]repository.Hash, migrated bool, err error) {
 	c.muBug.Lock()
 	defer c.muBug.Unlock()
 
-	f, err := c.repo.LocalStorage().Open(bugCacheFile)
+	data, err := decodeBugCacheFile(c.repo.LocalStorage())
 	if err != nil {
-		return err
+		return nil, false, err
 	}
 
-	decoder := gob.NewDecoder(f)
-
-	aux := struct {
-		Version  uint
-		Excerpts map[entity.Id]*BugExcerpt
-	}{}
-
-	err = decoder.Decode(&aux)
-	if err != nil {
-		return err
+	if data.Version != formatVersion {
+		err = c.migrateBugCache(data)
+		if err != nil {
+			return nil, false, err
+		}
+		migrated = true
 	}
 
-	if aux.Version != formatVersion {
-		return fmt.Errorf("unknown cache format version %v", aux.Version)
+	c.bugExcerpts = data.Excerpts
+	if c.bugExcerpts == nil {
+		// the legacy gob encoding skip the empty maps
+		c.bugExcerpts = make(map[entity.Id]*BugExcerpt)
 	}
+	c.rebuildActivityIndex()
+	c.rebuildStatistics()
+	c.rebuildBugIndex()
 
-	c.bugExcerpts = aux.Excerpts
+	return data.Tips, migrated, nil
+}
+
+// checkBugSearchIndex detect a mismatch between the search index and the bugs
+func (c *RepoCache) checkBugSearchIndex() error {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
 
 	index, err := c.repo.GetBleveIndex("bug")
 	if err != nil {
@@ -94,39 +141,62 @@ func (c *RepoCache) loadBugCache() error {
 	return nil
 }
 
-// write will serialize on disk the bug cache file
-func (c *RepoCache) writeBugCache() error {
-	c.muBug.RLock()
-	defer c.muBug.RUnlock()
-
-	var data bytes.Buffer
+// bugCacheData is the content of the bug cache file
+type bugCacheData struct {
+	Version  uint
+	Excerpts map[entity.Id]*BugExcerpt
+	// Tips are the commits the bug refs pointed to when the excerpts were
+	// made, to detect the changes made outside of the cache
+	Tips map[entity.Id]repository.Hash
+}
 
-	aux := struct {
-		Version  uint
-		Excerpts map[entity.Id]*BugExcerpt
-	}{
-		Version:  formatVersion,
-		Excerpts: c.bugExcerpts,
+// readBugCache decode the bug excerpts of the bug cache file, without
+// modifying anything
+func readBugCache(storage billy.Filesystem) (map[entity.Id]*BugExcerpt, error) {
+	data, err := readBugCacheFile(storage)
+	if err != nil {
+		return nil, err
 	}
+	return data.Excerpts, nil
+}
 
-	encoder := gob.NewEncoder(&data)
-
-	err := encoder.Encode(aux)
+// readBugCacheFile decode the bug cache file, without modifying anything
+func readBugCacheFile(storage billy.Filesystem) (*bugCacheData, error) {
+	data, err := decodeBugCacheFile(storage)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	f, err := c.repo.LocalStorage().Create(bugCacheFile)
-	if err != nil {
-		return err
+	if data.Version != formatVersion {
+		return nil, fmt.Errorf("unknown cache format version %v", data.Version)
 	}
 
-	_, err = f.Write(data.Bytes())
+	return data, nil
+}
+
+// decodeBugCacheFile decode the bug cache file with the current types,
+// whatever its format version
+func decodeBugCacheFile(storage billy.Filesystem) (*bugCacheData, error) {
+	content, err := readCacheFile(storage, bugCacheFile)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return f.Close()
+	return decodeBugCache(content)
+}
+
+// write will serialize on disk the bug cache file
+func (c *RepoCache) writeBugCache() error {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	data := encodeBugCache(&bugCacheData{
+		Version:  formatVersion,
+		Excerpts: c.bugExcerpts,
+		Tips:     c.bugTips,
+	})
+
+	return writeCacheFile(c.repo.LocalStorage(), bugCacheFile, data)
 }
 
 // ResolveBugExcerpt retrieve a BugExcerpt matching the exact given id
@@ -161,8 +231,7 @@ func (c *RepoCache) ResolveBug(id entity.Id) (*BugCache, error) {
 	cached = NewBugCache(c, b)
 
 	c.muBug.Lock()
-	c.bugs[id] = cached
-	c.loadedBugs.Add(id)
+	c.addLoadedBug(cached)
 	c.muBug.Unlock()
 
 	c.evictIfNeeded()
@@ -170,31 +239,6 @@ func (c *RepoCache) ResolveBug(id entity.Id) (*BugCache, error) {
 	return cached, nil
 }
 
-// evictIfNeeded will evict a bug from the cache if needed
-// it also removes references of the bug from the bugs
-func (c *RepoCache) evictIfNeeded() {
-	c.muBug.Lock()
-	defer c.muBug.Unlock()
-	if c.loadedBugs.Len() <= c.maxLoadedBugs {
-		return
-	}
-
-	for _, id := range c.loadedBugs.GetOldestToNewest() {
-		b := c.bugs[id]
-		if b.NeedCommit() {
-			continue
-		}
-
-		b.mu.Lock()
-		c.loadedBugs.Remove(id)
-		delete(c.bugs, id)
-
-		if c.loadedBugs.Len() <= c.maxLoadedBugs {
-			return
-		}
-	}
-}
-
 // ResolveBugExcerptPrefix retrieve a BugExcerpt matching an id prefix. It fails if multiple
 // bugs match.
 func (c *RepoCache) ResolveBugExcerptPrefix(prefix string) (*BugExcerpt, error) {
@@ -220,6 +264,9 @@ func (c *RepoCache) ResolveBugCreateMetadata(key string, value string) (*BugCach
 	})
 }
 
+// ResolveBugExcerptMatcher retrieve the excerpt of the only bug whose excerpt
+// match f, without loading any bug. If multiple bugs match, the returned
+// *entity.ErrMultipleMatch list them.
 func (c *RepoCache) ResolveBugExcerptMatcher(f func(*BugExcerpt) bool) (*BugExcerpt, error) {
 	id, err := c.resolveBugMatcher(f)
 	if err != nil {
@@ -228,6 +275,10 @@ func (c *RepoCache) ResolveBugExcerptMatcher(f func(*BugExcerpt) bool) (*BugExce
 	return c.ResolveBugExcerpt(id)
 }
 
+// ResolveBugMatcher retrieve the only bug whose excerpt match f, for example
+// to find a bug by title or by the metadata of its creation. Only the matching
+// bug is loaded. If multiple bugs match, the returned *entity.ErrMultipleMatch
+// list them.
 func (c *RepoCache) ResolveBugMatcher(f func(*BugExcerpt) bool) (*BugCache, error) {
 	id, err := c.resolveBugMatcher(f)
 	if err != nil {
@@ -250,6 +301,10 @@ func (c *RepoCache) resolveBugMatcher(f func(*BugExcerpt) bool) (entity.Id, erro
 	}
 
 	if len(matching) > 1 {
+		// sorted, for the candidates to be listed the same way each time
+		sort.Slice(matching, func(i, j int) bool {
+			return matching[i] < matching[j]
+		})
 		return entity.UnsetId, bug.NewErrMultipleMatchBug(matching)
 	}
 
@@ -301,7 +356,7 @@ func (c *RepoCache) ResolveComment(prefix string) (*BugCache, entity.CombinedId,
 	if len(matchingBugIds) > 1 {
 		return nil, entity.UnsetCombinedId, entity.NewErrMultipleMatch("bug/comment", matchingBugIds)
 	} else if len(matchingBugIds) == 0 {
-		return nil, entity.UnsetCombinedId, errors.New("comment doesn't exist")
+		return nil, entity.UnsetCombinedId, entity.NewErrNotFound("comment")
 	}
 
 	return matchingBug, matchingCommentId, nil
@@ -313,10 +368,19 @@ func (c *RepoCache) QueryBugs(q *query.Query) ([]entity.Id, error) {
 	defer c.muBug.RUnlock()
 
 	if q == nil {
-		return c.AllBugsIds(), nil
+		return c.allBugsIds(), nil
+	}
+
+	var workflow *bug.Workflow
+	if len(q.State) > 0 {
+		var err error
+		workflow, err = c.Workflow()
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	matcher := compileMatcher(q.Filters)
+	matcher := compileMatcher(q.Filters, workflow)
 
 	var filtered []*BugExcerpt
 	var foundBySearch map[entity.Id]*BugExcerpt
@@ -324,15 +388,7 @@ func (c *RepoCache) QueryBugs(q *query.Query) ([]entity.Id, error) {
 	if q.Search != nil {
 		foundBySearch = map[entity.Id]*BugExcerpt{}
 
-		terms := make([]string, len(q.Search))
-		copy(terms, q.Search)
-		for i, search := range q.Search {
-			if strings.Contains(search, " ") {
-				terms[i] = fmt.Sprintf("\"%s\"", search)
-			}
-		}
-
-		bleveQuery := bleve.NewQueryStringQuery(strings.Join(terms, " "))
+		bleveQuery := bleve.NewQueryStringQuery(fullTextQuery(q.Search))
 		bleveSearch := bleve.NewSearchRequest(bleveQuery)
 
 		index, err := c.repo.GetBleveIndex("bug")
@@ -348,6 +404,12 @@ func (c *RepoCache) QueryBugs(q *query.Query) ([]entity.Id, error) {
 		for _, hit := range searchResults.Hits {
 			foundBySearch[entity.Id(hit.ID)] = c.bugExcerpts[entity.Id(hit.ID)]
 		}
+	} else if candidates, ok := c.candidates(q.Filters); ok {
+		// narrow down the bugs to check with the secondary indexes
+		foundBySearch = make(map[entity.Id]*BugExcerpt, len(candidates))
+		for id := range candidates {
+			foundBySearch[id] = c.bugExcerpts[id]
+		}
 	} else {
 		foundBySearch = c.bugExcerpts
 	}
@@ -358,29 +420,12 @@ func (c *RepoCache) QueryBugs(q *query.Query) ([]entity.Id, error) {
 		}
 	}
 
-	var sorter sort.Interface
-
-	switch q.OrderBy {
-	case query.OrderById:
-		sorter = BugsById(filtered)
-	case query.OrderByCreation:
-		sorter = BugsByCreationTime(filtered)
-	case query.OrderByEdit:
-		sorter = BugsByEditTime(filtered)
-	default:
-		return nil, errors.New("missing sort type")
-	}
-
-	switch q.OrderDirection {
-	case query.OrderAscending:
-		// Nothing to do
-	case query.OrderDescending:
-		sorter = sort.Reverse(sorter)
-	default:
-		return nil, errors.New("missing sort direction")
+	keys, err := c.bugSortKeys(q)
+	if err != nil {
+		return nil, err
 	}
 
-	sort.Sort(sorter)
+	sort.Sort(BugsByKeys{Excerpts: filtered, Keys: keys})
 
 	result := make([]entity.Id, len(filtered))
 
@@ -391,11 +436,88 @@ func (c *RepoCache) QueryBugs(q *query.Query) ([]entity.Id, error) {
 	return result, nil
 }
 
+// bugSortKeys compile the sort keys of a query
+func (c *RepoCache) bugSortKeys(q *query.Query) ([]BugSortKey, error) {
+	var defs []bug.FieldDefinition
+
+	sortKeys := q.SortKeys()
+	result := make([]BugSortKey, len(sortKeys))
+
+	for i, key := range sortKeys {
+		var descending bool
+		switch key.OrderDirection {
+		case query.OrderAscending:
+			descending = false
+		case query.OrderDescending:
+			descending = true
+		default:
+			return nil, errors.New("missing sort direction")
+		}
+
+		var less BugSortKey
+		switch key.OrderBy {
+		case query.OrderById:
+			less = func(b []*BugExcerpt, i, j int) bool { return BugsById(b).Less(i, j) }
+		case query.OrderByCreation:
+			less = func(b []*BugExcerpt, i, j int) bool { return BugsByCreationTime(b).Less(i, j) }
+		case query.OrderByEdit:
+			less = func(b []*BugExcerpt, i, j int) bool { return BugsByEditTime(b).Less(i, j) }
+		case query.OrderByComments:
+			less = func(b []*BugExcerpt, i, j int) bool { return BugsByComments(b).Less(i, j) }
+		case query.OrderByLastComment:
+			less = func(b []*BugExcerpt, i, j int) bool { return BugsByLastCommentTime(b).Less(i, j) }
+		case query.OrderByField:
+			if defs == nil {
+				var err error
+				defs, err = c.FieldDefinitions()
+				if err != nil {
+					return nil, err
+				}
+			}
+			def, ok := findFieldDefinition(defs, key.Field)
+			if !ok {
+				return nil, fmt.Errorf("can't sort by unknown field \"%s\"", key.Field)
+			}
+			// the direction is handled by the key, to keep the bugs without
+			// value last
+			result[i] = fieldSortKey(def, descending)
+			continue
+		default:
+			return nil, errors.New("missing sort type")
+		}
+
+		if descending {
+			asc := less
+			less = func(b []*BugExcerpt, i, j int) bool { return asc(b, j, i) }
+		}
+		result[i] = less
+	}
+
+	return result, nil
+}
+
+// fullTextQuery assemble the full text search terms in the bleve query syntax
+func fullTextQuery(search query.Search) string {
+	terms := make([]string, len(search))
+	copy(terms, search)
+	for i, term := range search {
+		if strings.Contains(term, " ") {
+			terms[i] = fmt.Sprintf("\"%s\"", term)
+		}
+	}
+	return strings.Join(terms, " ")
+}
+
 // AllBugsIds return all known bug ids
 func (c *RepoCache) AllBugsIds() []entity.Id {
 	c.muBug.RLock()
 	defer c.muBug.RUnlock()
 
+	return c.allBugsIds()
+}
+
+// allBugsIds return all known bug ids. muBug must be held.
+func (c *RepoCache) allBugsIds() []entity.Id {
 	result := make([]entity.Id, len(c.bugExcerpts))
 
 	i := 0
@@ -461,11 +583,24 @@ func (c *RepoCache) NewBugWithFiles(title string, message string, files []reposi
 // well as metadata for the Create operation.
 // The new bug is written in the repository (commit)
 func (c *RepoCache) NewBugRaw(author *IdentityCache, unixTime int64, title string, message string, files []repository.Hash, metadata map[string]string) (*BugCache, *bug.CreateOperation, error) {
+	if err := c.checkWritable(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.checkMessageSize(message); err != nil {
+		return nil, nil, err
+	}
+
 	b, op, err := bug.Create(author.Identity, unixTime, title, message, files, metadata)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	err = c.journalBegin(bugJournalKind, b.Id())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = b.Commit(c.repo)
 	if err != nil {
 		return nil, nil, err
@@ -478,8 +613,7 @@ func (c *RepoCache) NewBugRaw(author *IdentityCache, unixTime int64, title strin
 	}
 
 	cached := NewBugCache(c, b)
-	c.bugs[b.Id()] = cached
-	c.loadedBugs.Add(b.Id())
+	c.addLoadedBug(cached)
 	c.muBug.Unlock()
 
 	c.evictIfNeeded()
@@ -490,16 +624,32 @@ func (c *RepoCache) NewBugRaw(author *IdentityCache, unixTime int64, title strin
 		return nil, nil, err
 	}
 
+	err = c.journalEnd(bugJournalKind, b.Id())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.events.Publish(BugCreated{BugId: b.Id()})
+
 	return cached, op, nil
 }
 
 // RemoveBug removes a bug from the cache and repo given a bug id prefix
 func (c *RepoCache) RemoveBug(prefix string) error {
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+
 	b, err := c.ResolveBugPrefix(prefix)
 	if err != nil {
 		return err
 	}
 
+	err = c.journalBegin(bugJournalKind, b.Id())
+	if err != nil {
+		return err
+	}
+
 	c.muBug.Lock()
 
 	err = bug.Remove(c.repo, b.Id())
@@ -509,13 +659,116 @@ func (c *RepoCache) RemoveBug(prefix string) error {
 		return err
 	}
 
-	delete(c.bugs, b.Id())
+	excerpt, existed := c.bugExcerpts[b.Id()]
+	if existed {
+		c.unindexActivity(excerpt)
+		c.updateStatistics(excerpt, nil)
+		c.updateBugIndex(excerpt, nil)
+	}
+	c.dropLoadedBug(b.Id())
 	delete(c.bugExcerpts, b.Id())
-	c.loadedBugs.Remove(b.Id())
+	delete(c.bugTips, b.Id())
+
+	c.muBug.Unlock()
+
+	// the copy held by the callers must not create the bug again
+	b.mu.Lock()
+	b.removed = true
+	b.mu.Unlock()
+
+	if existed {
+		c.notifyExcerptRemoved(b.Id())
+	}
+
+	c.events.Publish(BugRemoved{BugId: b.Id()})
+
+	index, err := c.repo.GetBleveIndex("bug")
+	if err != nil {
+		return err
+	}
+	err = index.Delete(b.Id().String())
+	if err != nil {
+		return err
+	}
 
+	err = c.writeBugCache()
+	if err != nil {
+		return err
+	}
+
+	return c.journalEnd(bugJournalKind, b.Id())
+}
+
+// refreshBug read again a bug from git to update its excerpt, tip and indexes,
+// or drop them if the bug doesn't exist anymore. The loaded bug, if any, is
+// dropped.
+func (c *RepoCache) refreshBug(id entity.Id) error {
+	exist, err := c.repo.RefExist(bugRefPrefix + id.String())
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		index, err := c.repo.GetBleveIndex("bug")
+		if err != nil {
+			return err
+		}
+		if err := index.Delete(id.String()); err != nil {
+			return err
+		}
+
+		c.muBug.Lock()
+		excerpt, existed := c.bugExcerpts[id]
+		if existed {
+			c.unindexActivity(excerpt)
+			c.updateStatistics(excerpt, nil)
+			c.updateBugIndex(excerpt, nil)
+		}
+		c.dropLoadedBug(id)
+		delete(c.bugExcerpts, id)
+		delete(c.bugTips, id)
+		c.muBug.Unlock()
+
+		if existed {
+			c.notifyExcerptRemoved(id)
+			c.events.Publish(BugRemoved{BugId: id})
+		}
+		return nil
+	}
+
+	// the bug is read without holding the lock, as resolving it can require
+	// the cache
+	b, err := bug.ReadWithResolver(c.repo, c.resolvers, id)
+	if err != nil {
+		return err
+	}
+	snap := b.Compile()
+	c.snapshots.add(b, snap)
+	excerpt := NewBugExcerpt(b, snap)
+
+	if err := c.addBugToSearchIndex(snap); err != nil {
+		return err
+	}
+
+	c.muBug.Lock()
+	old, existed := c.bugExcerpts[id]
+	if existed {
+		c.unindexActivity(old)
+	}
+	c.updateStatistics(old, excerpt)
+	c.updateBugIndex(old, excerpt)
+	c.bugExcerpts[id] = excerpt
+	c.indexActivity(excerpt)
+	c.dropLoadedBug(id)
+	err = c.updateBugTip(id)
 	c.muBug.Unlock()
+	if err != nil {
+		return err
+	}
 
-	return c.writeBugCache()
+	c.notifyExcerptChanged(excerpt, !existed)
+
+	return nil
 }
 
 func (c *RepoCache) addBugToSearchIndex(snap *bug.Snapshot) error {
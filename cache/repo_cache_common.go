@@ -2,6 +2,7 @@ package cache
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-git/go-billy/v5"
 	"github.com/pkg/errors"
@@ -74,6 +75,10 @@ func (c *RepoCache) StoreData(data []byte) (repository.Hash, error) {
 // Fetch retrieve updates from a remote
 // This does not change the local bugs or identities state
 func (c *RepoCache) Fetch(remote string) (string, error) {
+	if err := c.checkWritable(); err != nil {
+		return "", err
+	}
+
 	stdout1, err := identity.Fetch(c.repo, remote)
 	if err != nil {
 		return stdout1, err
@@ -95,30 +100,21 @@ func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 	go func() {
 		defer close(out)
 
+		if err := c.checkWritable(); err != nil {
+			out <- entity.NewMergeError(err, "")
+			return
+		}
+
 		author, err := c.GetUserIdentity()
 		if err != nil {
 			out <- entity.NewMergeError(err, "")
 			return
 		}
 
-		results := identity.MergeAll(c.repo, remote)
-		for result := range results {
-			out <- result
-
-			if result.Err != nil {
-				continue
-			}
-
-			switch result.Status {
-			case entity.MergeStatusNew, entity.MergeStatusUpdated:
-				i := result.Entity.(*identity.Identity)
-				c.muIdentity.Lock()
-				c.identitiesExcerpts[result.Id] = NewIdentityExcerpt(i)
-				c.muIdentity.Unlock()
-			}
-		}
+		merged := MergeCompleted{Remote: remote}
+		merged.Identities = c.mergeIdentities(remote, out)
 
-		results = bug.MergeAll(c.repo, c.resolvers, remote, author)
+		results := bug.MergeAll(c.repo, c.resolvers, remote, author)
 		for result := range results {
 			out <- result
 
@@ -129,10 +125,43 @@ func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 			switch result.Status {
 			case entity.MergeStatusNew, entity.MergeStatusUpdated:
 				b := result.Entity.(*bug.Bug)
+
+				// the merged label changes can require assignments
+				_, err := c.autoAssign(b, author.Identity, time.Now().Unix())
+				if err == nil && b.NeedCommit() {
+					err = b.Commit(c.repo)
+				}
+				if err != nil {
+					out <- entity.NewMergeError(err, result.Id)
+				}
+
 				snap := b.Compile()
+				c.snapshots.add(b, snap)
+				excerpt := NewBugExcerpt(b, snap)
 				c.muBug.Lock()
-				c.bugExcerpts[result.Id] = NewBugExcerpt(b, snap)
+				// the loaded bug is outdated, it's read again when needed
+				if loaded, ok := c.bugs[result.Id]; ok && !loaded.NeedCommit() {
+					c.dropLoadedBug(result.Id)
+				}
+				_, existed := c.bugExcerpts[result.Id]
+				c.updateStatistics(c.bugExcerpts[result.Id], excerpt)
+				c.updateBugIndex(c.bugExcerpts[result.Id], excerpt)
+				c.bugExcerpts[result.Id] = excerpt
+				c.indexActivity(excerpt)
+				err = c.updateBugTip(result.Id)
 				c.muBug.Unlock()
+				if err != nil {
+					out <- entity.NewMergeError(err, result.Id)
+				}
+
+				c.notifyExcerptChanged(excerpt, !existed)
+
+				merged.Bugs = append(merged.Bugs, result.Id)
+				if result.Status == entity.MergeStatusNew {
+					c.events.Publish(BugCreated{BugId: result.Id})
+				} else {
+					c.events.Publish(BugUpdated{BugId: result.Id})
+				}
 			}
 		}
 
@@ -141,13 +170,74 @@ func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 			out <- entity.NewMergeError(err, "")
 			return
 		}
+
+		c.events.Publish(merged)
 	}()
 
 	return out
 }
 
+// MergeIdentities merge only the available remote identities. Contrary to
+// MergeAll, it doesn't require the user identity to be set, which allows to
+// adopt one of the merged identities.
+func (c *RepoCache) MergeIdentities(remote string) <-chan entity.MergeResult {
+	out := make(chan entity.MergeResult)
+
+	go func() {
+		defer close(out)
+
+		if err := c.checkWritable(); err != nil {
+			out <- entity.NewMergeError(err, "")
+			return
+		}
+
+		merged := MergeCompleted{Remote: remote}
+		merged.Identities = c.mergeIdentities(remote, out)
+
+		err := c.writeIdentityCache()
+		if err != nil {
+			out <- entity.NewMergeError(err, "")
+			return
+		}
+
+		c.events.Publish(merged)
+	}()
+
+	return out
+}
+
+// mergeIdentities merge the remote identities, send the results to out and
+// return the ids of the identities created or updated
+func (c *RepoCache) mergeIdentities(remote string, out chan<- entity.MergeResult) []entity.Id {
+	var merged []entity.Id
+
+	results := identity.MergeAll(c.repo, remote)
+	for result := range results {
+		out <- result
+
+		if result.Err != nil {
+			continue
+		}
+
+		switch result.Status {
+		case entity.MergeStatusNew, entity.MergeStatusUpdated:
+			i := result.Entity.(*identity.Identity)
+			c.identities.updateExcerpt(result.Id, i)
+
+			merged = append(merged, result.Id)
+			c.events.Publish(IdentityUpdated{IdentityId: result.Id})
+		}
+	}
+
+	return merged
+}
+
 // Push update a remote with the local changes
 func (c *RepoCache) Push(remote string) (string, error) {
+	if err := c.checkWritable(); err != nil {
+		return "", err
+	}
+
 	stdout1, err := identity.Push(c.repo, remote)
 	if err != nil {
 		return stdout1, err
@@ -182,16 +272,17 @@ func (c *RepoCache) Pull(remote string) error {
 }
 
 func (c *RepoCache) SetUserIdentity(i *IdentityCache) error {
+	if err := c.checkWritable(); err != nil {
+		return err
+	}
+
 	err := identity.SetUserIdentity(c.repo, i.Identity)
 	if err != nil {
 		return err
 	}
 
-	c.muIdentity.RLock()
-	defer c.muIdentity.RUnlock()
-
 	// Make sure that everything is fine
-	if _, ok := c.identities[i.Id()]; !ok {
+	if _, ok := c.identities.loaded(i.Id()); !ok {
 		panic("SetUserIdentity while the identity is not from the cache, something is wrong")
 	}
 
@@ -202,22 +293,18 @@ func (c *RepoCache) SetUserIdentity(i *IdentityCache) error {
 
 func (c *RepoCache) GetUserIdentity() (*IdentityCache, error) {
 	if c.userIdentityId != "" {
-		i, ok := c.identities[c.userIdentityId]
+		i, ok := c.identities.loaded(c.userIdentityId)
 		if ok {
 			return i, nil
 		}
 	}
 
-	c.muIdentity.Lock()
-	defer c.muIdentity.Unlock()
-
 	i, err := identity.GetUserIdentity(c.repo)
 	if err != nil {
 		return nil, err
 	}
 
-	cached := NewIdentityCache(c, i)
-	c.identities[i.Id()] = cached
+	cached := c.identities.register(i.Id(), i)
 	c.userIdentityId = i.Id()
 
 	return cached, nil
@@ -232,11 +319,8 @@ func (c *RepoCache) GetUserIdentityExcerpt() (*IdentityExcerpt, error) {
 		c.userIdentityId = id
 	}
 
-	c.muIdentity.RLock()
-	defer c.muIdentity.RUnlock()
-
-	excerpt, ok := c.identitiesExcerpts[c.userIdentityId]
-	if !ok {
+	excerpt, err := c.identities.ResolveExcerpt(c.userIdentityId)
+	if err != nil {
 		return nil, fmt.Errorf("cache: missing identity excerpt %v", c.userIdentityId)
 	}
 	return excerpt, nil
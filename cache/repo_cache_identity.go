@@ -1,215 +1,288 @@
 package cache
 
 import (
-	"bytes"
-	"encoding/gob"
-	"fmt"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
 
 	"github.com/MichaelMure/git-bug/entities/identity"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
+	"github.com/MichaelMure/git-bug/repository"
 )
 
 const identityCacheFile = "identity-cache"
 
+// the definition of the identities for their SubCache
+var identityCacheDefinition = &subCacheDefinition[*identity.Identity, *IdentityExcerpt, *IdentityCache]{
+	typename: "identity",
+	file:     identityCacheFile,
+
+	listIds: func(repo repository.ClockedRepo) ([]entity.Id, error) {
+		return identity.ListLocalIds(repo)
+	},
+	read: func(c *RepoCache, id entity.Id) (*identity.Identity, error) {
+		return identity.ReadLocal(c.repo, id)
+	},
+	remove: identity.RemoveIdentity,
+
+	wrap:   NewIdentityCache,
+	unwrap: func(i *IdentityCache) *identity.Identity { return i.Identity },
+
+	makeExcerpt: NewIdentityExcerpt,
+	excerptId:   func(excerpt *IdentityExcerpt) entity.Id { return excerpt.Id },
+	indexKeys:   identityMetadataIndexKeys,
+
+	encodeExcerpt: encodeIdentityExcerpt,
+	decodeExcerpt: decodeIdentityExcerpt,
+	decodeLegacy:  decodeLegacyIdentityCache,
+
+	migrations: map[uint]func(c *RepoCache, excerpts map[entity.Id]*IdentityExcerpt) error{
+		11: migrateIdentityEmail,
+	},
+
+	errNotExist: identity.ErrIdentityNotExist,
+	errMultipleMatch: func(matching []entity.Id) error {
+		return identity.NewErrMultipleMatch(matching)
+	},
+
+	updatedEvent: func(id entity.Id) Event {
+		return IdentityUpdated{IdentityId: id}
+	},
+}
+
 // identityUpdated is a callback to trigger when the excerpt of an identity
 // changed, that is each time an identity is updated
 func (c *RepoCache) identityUpdated(id entity.Id) error {
-	c.muIdentity.Lock()
-
-	i, ok := c.identities[id]
-	if !ok {
-		c.muIdentity.Unlock()
-		panic("missing identity in the cache")
-	}
-
-	c.identitiesExcerpts[id] = NewIdentityExcerpt(i.Identity)
-	c.muIdentity.Unlock()
-
-	// we only need to write the identity cache
-	return c.writeIdentityCache()
+	return c.identities.entityUpdated(id)
 }
 
-// load will try to read from the disk the identity cache file
-func (c *RepoCache) loadIdentityCache() error {
-	c.muIdentity.Lock()
-	defer c.muIdentity.Unlock()
-
-	f, err := c.repo.LocalStorage().Open(identityCacheFile)
-	if err != nil {
-		return err
-	}
-
-	decoder := gob.NewDecoder(f)
-
-	aux := struct {
-		Version  uint
-		Excerpts map[entity.Id]*IdentityExcerpt
-	}{}
-
-	err = decoder.Decode(&aux)
-	if err != nil {
-		return err
-	}
-
-	if aux.Version != formatVersion {
-		return fmt.Errorf("unknown cache format version %v", aux.Version)
-	}
-
-	c.identitiesExcerpts = aux.Excerpts
-	return nil
+// readIdentityCache decode the identity cache file, without modifying anything
+func readIdentityCache(storage billy.Filesystem) (map[entity.Id]*IdentityExcerpt, error) {
+	return identityCacheDefinition.readFile(storage)
 }
 
 // write will serialize on disk the identity cache file
 func (c *RepoCache) writeIdentityCache() error {
-	c.muIdentity.RLock()
-	defer c.muIdentity.RUnlock()
-
-	var data bytes.Buffer
-
-	aux := struct {
-		Version  uint
-		Excerpts map[entity.Id]*IdentityExcerpt
-	}{
-		Version:  formatVersion,
-		Excerpts: c.identitiesExcerpts,
-	}
-
-	encoder := gob.NewEncoder(&data)
-
-	err := encoder.Encode(aux)
-	if err != nil {
-		return err
-	}
-
-	f, err := c.repo.LocalStorage().Create(identityCacheFile)
-	if err != nil {
-		return err
-	}
-
-	_, err = f.Write(data.Bytes())
-	if err != nil {
-		return err
-	}
-
-	return f.Close()
+	return c.identities.write()
 }
 
 // ResolveIdentityExcerpt retrieve a IdentityExcerpt matching the exact given id
 func (c *RepoCache) ResolveIdentityExcerpt(id entity.Id) (*IdentityExcerpt, error) {
-	c.muIdentity.RLock()
-	defer c.muIdentity.RUnlock()
-
-	e, ok := c.identitiesExcerpts[id]
-	if !ok {
-		return nil, identity.ErrIdentityNotExist
-	}
-
-	return e, nil
+	return c.identities.ResolveExcerpt(id)
 }
 
 // ResolveIdentity retrieve an identity matching the exact given id
 func (c *RepoCache) ResolveIdentity(id entity.Id) (*IdentityCache, error) {
-	c.muIdentity.RLock()
-	cached, ok := c.identities[id]
-	c.muIdentity.RUnlock()
-	if ok {
-		return cached, nil
-	}
-
-	i, err := identity.ReadLocal(c.repo, id)
-	if err != nil {
-		return nil, err
-	}
-
-	cached = NewIdentityCache(c, i)
-
-	c.muIdentity.Lock()
-	c.identities[id] = cached
-	c.muIdentity.Unlock()
-
-	return cached, nil
+	return c.identities.Resolve(id)
 }
 
 // ResolveIdentityExcerptPrefix retrieve a IdentityExcerpt matching an id prefix.
 // It fails if multiple identities match.
 func (c *RepoCache) ResolveIdentityExcerptPrefix(prefix string) (*IdentityExcerpt, error) {
-	return c.ResolveIdentityExcerptMatcher(func(excerpt *IdentityExcerpt) bool {
-		return excerpt.Id.HasPrefix(prefix)
-	})
+	return c.identities.ResolveExcerptPrefix(prefix)
 }
 
 // ResolveIdentityPrefix retrieve an Identity matching an id prefix.
 // It fails if multiple identities match.
 func (c *RepoCache) ResolveIdentityPrefix(prefix string) (*IdentityCache, error) {
-	return c.ResolveIdentityMatcher(func(excerpt *IdentityExcerpt) bool {
-		return excerpt.Id.HasPrefix(prefix)
-	})
+	return c.identities.ResolvePrefix(prefix)
 }
 
 // ResolveIdentityImmutableMetadata retrieve an Identity that has the exact given metadata on
 // one of its version. If multiple version have the same key, the first defined take precedence.
+// The identities are found with an index, without scanning them.
 func (c *RepoCache) ResolveIdentityImmutableMetadata(key string, value string) (*IdentityCache, error) {
-	return c.ResolveIdentityMatcher(func(excerpt *IdentityExcerpt) bool {
-		return excerpt.ImmutableMetadata[key] == value
-	})
-}
-
-func (c *RepoCache) ResolveIdentityExcerptMatcher(f func(*IdentityExcerpt) bool) (*IdentityExcerpt, error) {
-	id, err := c.resolveIdentityMatcher(f)
+	id, err := c.resolveIdentityMetadataId(key, value)
 	if err != nil {
 		return nil, err
 	}
-	return c.ResolveIdentityExcerpt(id)
+	return c.identities.Resolve(id)
 }
 
-func (c *RepoCache) ResolveIdentityMatcher(f func(*IdentityExcerpt) bool) (*IdentityCache, error) {
-	id, err := c.resolveIdentityMatcher(f)
-	if err != nil {
-		return nil, err
+// ResolveIdentitiesImmutableMetadata retrieve at once the identities having
+// the exact given metadata, for example to match all the authors of an import
+// by their login. The result is indexed by value, and the values that no
+// identity has are omitted. It fails if multiple identities match a value.
+func (c *RepoCache) ResolveIdentitiesImmutableMetadata(key string, values []string) (map[string]*IdentityCache, error) {
+	result := make(map[string]*IdentityCache, len(values))
+	for _, value := range values {
+		if _, ok := result[value]; ok {
+			continue
+		}
+		id, err := c.resolveIdentityMetadataId(key, value)
+		if errors.Is(err, identity.ErrIdentityNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		i, err := c.identities.Resolve(id)
+		if err != nil {
+			return nil, err
+		}
+		result[value] = i
+	}
+	return result, nil
+}
+
+func (c *RepoCache) resolveIdentityMetadataId(key string, value string) (entity.Id, error) {
+	matching := c.identities.lookup(identityMetadataIndexKey(key, value))
+	switch len(matching) {
+	case 0:
+		return entity.UnsetId, identity.ErrIdentityNotExist
+	case 1:
+		return matching[0], nil
+	default:
+		return entity.UnsetId, identity.NewErrMultipleMatch(matching)
+	}
+}
+
+// identityMetadataIndexKey is the key of the identity index for one metadata
+func identityMetadataIndexKey(key string, value string) string {
+	// the metadata keys are constants of the bridges, without NUL byte
+	return key + "\x00" + value
+}
+
+func identityMetadataIndexKeys(excerpt *IdentityExcerpt) []string {
+	keys := make([]string, 0, len(excerpt.ImmutableMetadata))
+	for key, value := range excerpt.ImmutableMetadata {
+		keys = append(keys, identityMetadataIndexKey(key, value))
 	}
-	return c.ResolveIdentity(id)
+	return keys
+}
+
+// ResolveIdentityExcerptMatcher retrieve the excerpt of the only identity whose
+// excerpt match f, without loading any identity. If multiple identities match,
+// the returned *entity.ErrMultipleMatch list them.
+func (c *RepoCache) ResolveIdentityExcerptMatcher(f func(*IdentityExcerpt) bool) (*IdentityExcerpt, error) {
+	return c.identities.ResolveExcerptMatcher(f)
 }
 
-func (c *RepoCache) resolveIdentityMatcher(f func(*IdentityExcerpt) bool) (entity.Id, error) {
-	c.muIdentity.RLock()
-	defer c.muIdentity.RUnlock()
+// ResolveIdentityMatcher retrieve the only identity whose excerpt match f, for
+// example to find an identity by login. Only the matching identity is loaded.
+// If multiple identities match, the returned *entity.ErrMultipleMatch list
+// them.
+func (c *RepoCache) ResolveIdentityMatcher(f func(*IdentityExcerpt) bool) (*IdentityCache, error) {
+	return c.identities.ResolveMatcher(f)
+}
+
+// AllIdentityIds return all known identity ids
+func (c *RepoCache) AllIdentityIds() []entity.Id {
+	return c.identities.AllIds()
+}
+
+// QueryIdentities return the id of all the identities matching the given
+// IdentityQuery
+func (c *RepoCache) QueryIdentities(q *query.IdentityQuery) ([]entity.Id, error) {
+	if q == nil {
+		q = query.NewIdentityQuery()
+	}
 
-	// preallocate but empty
-	matching := make([]entity.Id, 0, 5)
+	var filtered []*IdentityExcerpt
+	for _, id := range c.identities.Query(func(excerpt *IdentityExcerpt) bool {
+		return matchIdentityQuery(q, excerpt)
+	}) {
+		excerpt, err := c.identities.ResolveExcerpt(id)
+		if err != nil {
+			return nil, err
+		}
+		filtered = append(filtered, excerpt)
+	}
 
-	for _, excerpt := range c.identitiesExcerpts {
-		if f(excerpt) {
-			matching = append(matching, excerpt.Id)
+	var sorter sort.Interface
+
+	switch q.IdentityOrderBy {
+	case query.IdentityOrderById:
+		sorter = IdentityById(filtered)
+	case query.IdentityOrderByName:
+		sorter = IdentityByName(filtered)
+	case query.IdentityOrderByActivity:
+		sorter = identityByActivity{
+			excerpts:     filtered,
+			lastActivity: c.identitiesLastActivity(),
 		}
+	default:
+		return nil, errors.New("missing sort type")
 	}
 
-	if len(matching) > 1 {
-		return entity.UnsetId, identity.NewErrMultipleMatch(matching)
+	switch q.OrderDirection {
+	case query.OrderAscending:
+		// Nothing to do
+	case query.OrderDescending:
+		sorter = sort.Reverse(sorter)
+	default:
+		return nil, errors.New("missing sort direction")
 	}
 
-	if len(matching) == 0 {
-		return entity.UnsetId, identity.ErrIdentityNotExist
+	sort.Sort(sorter)
+
+	result := make([]entity.Id, len(filtered))
+	for i, excerpt := range filtered {
+		result[i] = excerpt.Id
 	}
 
-	return matching[0], nil
+	return result, nil
 }
 
-// AllIdentityIds return all known identity ids
-func (c *RepoCache) AllIdentityIds() []entity.Id {
-	c.muIdentity.RLock()
-	defer c.muIdentity.RUnlock()
+// matchIdentityQuery tell if an identity match the search terms and the
+// filters of a query, as case-insensitive substrings
+func matchIdentityQuery(q *query.IdentityQuery, excerpt *IdentityExcerpt) bool {
+	name := strings.ToLower(excerpt.Name)
+	email := strings.ToLower(excerpt.Email)
+	login := strings.ToLower(excerpt.Login)
+
+	for _, term := range q.Search {
+		term = strings.ToLower(term)
+		if !strings.Contains(name, term) && !strings.Contains(email, term) && !strings.Contains(login, term) {
+			return false
+		}
+	}
 
-	result := make([]entity.Id, len(c.identitiesExcerpts))
+	return matchAnySubstring(name, q.Name) &&
+		matchAnySubstring(email, q.Email) &&
+		matchAnySubstring(login, q.Login)
+}
 
-	i := 0
-	for _, excerpt := range c.identitiesExcerpts {
-		result[i] = excerpt.Id
-		i++
+// matchAnySubstring tell if the lowercase value contains one of the
+// substrings, or if there is none
+func matchAnySubstring(value string, substrings []string) bool {
+	if len(substrings) == 0 {
+		return true
+	}
+	for _, substring := range substrings {
+		if strings.Contains(value, strings.ToLower(substring)) {
+			return true
+		}
 	}
+	return false
+}
+
+// IdentityTrustedBy return the ids of the identities trusting the given identity, sorted by id
+func (c *RepoCache) IdentityTrustedBy(id entity.Id) []entity.Id {
+	result := c.identities.Query(func(excerpt *IdentityExcerpt) bool {
+		for _, trusted := range excerpt.Trusted {
+			if trusted == id {
+				return true
+			}
+		}
+		return false
+	})
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
 
 	return result
 }
 
+// RemoveIdentity removes an identity from the cache and repo given an identity id prefix
+func (c *RepoCache) RemoveIdentity(prefix string) error {
+	return c.identities.Remove(prefix)
+}
+
 func (c *RepoCache) NewIdentityFromGitUser() (*IdentityCache, error) {
 	return c.NewIdentityFromGitUserRaw(nil)
 }
@@ -243,23 +316,30 @@ func (c *RepoCache) NewIdentityRaw(name string, email string, login string, avat
 }
 
 func (c *RepoCache) finishIdentity(i *identity.Identity, metadata map[string]string) (*IdentityCache, error) {
+	if err := c.checkWritable(); err != nil {
+		return nil, err
+	}
+
 	for key, value := range metadata {
 		i.SetMetadata(key, value)
 	}
 
-	err := i.Commit(c.repo)
+	kind := c.identities.kind()
+	err := c.journalBegin(kind, i.Id())
 	if err != nil {
 		return nil, err
 	}
 
-	c.muIdentity.Lock()
-	if _, has := c.identities[i.Id()]; has {
-		return nil, fmt.Errorf("identity %s already exist in the cache", i.Id())
+	err = i.Commit(c.repo)
+	if err != nil {
+		return nil, err
 	}
 
 	cached := NewIdentityCache(c, i)
-	c.identities[i.Id()] = cached
-	c.muIdentity.Unlock()
+	err = c.identities.add(i.Id(), cached)
+	if err != nil {
+		return nil, err
+	}
 
 	// force the write of the excerpt
 	err = c.identityUpdated(i.Id())
@@ -267,5 +347,10 @@ func (c *RepoCache) finishIdentity(i *identity.Identity, metadata map[string]str
 		return nil, err
 	}
 
+	err = c.journalEnd(kind, i.Id())
+	if err != nil {
+		return nil, err
+	}
+
 	return cached, nil
 }
@@ -1,7 +1,14 @@
 package cache
 
 import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -9,6 +16,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/policy"
 	"github.com/MichaelMure/git-bug/query"
 	"github.com/MichaelMure/git-bug/repository"
 )
@@ -37,8 +48,8 @@ func TestCache(t *testing.T) {
 
 	// There is now two identities in the cache
 	require.Len(t, cache.AllIdentityIds(), 2)
-	require.Len(t, cache.identitiesExcerpts, 2)
-	require.Len(t, cache.identities, 2)
+	require.Len(t, cache.identities.excerpts, 2)
+	require.Len(t, cache.identities.cached, 2)
 
 	// Create a bug
 	bug1, _, err := cache.NewBug("title", "message")
@@ -82,17 +93,16 @@ func TestCache(t *testing.T) {
 	require.NoError(t, cache.Close())
 	require.Empty(t, cache.bugs)
 	require.Empty(t, cache.bugExcerpts)
-	require.Empty(t, cache.identities)
-	require.Empty(t, cache.identitiesExcerpts)
+	require.Empty(t, cache.identities.cached)
+	require.Empty(t, cache.identities.excerpts)
 
-	// Reload, only excerpt are loaded, but as we need to load the identities used in the bugs
-	// to check the signatures, we also load the identity used above
+	// Reload, only excerpt are loaded
 	cache, err = NewRepoCache(repo)
 	require.NoError(t, err)
 	require.Empty(t, cache.bugs)
-	require.Len(t, cache.identities, 1)
+	require.Empty(t, cache.identities.cached)
 	require.Len(t, cache.bugExcerpts, 2)
-	require.Len(t, cache.identitiesExcerpts, 2)
+	require.Len(t, cache.identities.excerpts, 2)
 
 	// Resolving load from the disk
 	_, err = cache.ResolveIdentity(iden1.Id())
@@ -143,11 +153,18 @@ func TestCachePushPull(t *testing.T) {
 	_, err = cacheA.Push("origin")
 	require.NoError(t, err)
 
+	events, unsubscribe := cacheB.Subscribe()
+	defer unsubscribe()
+
 	err = cacheB.Pull("origin")
 	require.NoError(t, err)
 
 	require.Len(t, cacheB.AllBugsIds(), 1)
 
+	bugId := cacheB.AllBugsIds()[0]
+	require.Equal(t, BugCreated{BugId: bugId}, <-events)
+	require.Equal(t, MergeCompleted{Remote: "origin", Bugs: []entity.Id{bugId}}, <-events)
+
 	// retrieve and set identity
 	reneB, err := cacheB.ResolveIdentity(reneA.Id())
 	require.NoError(t, err)
@@ -168,6 +185,136 @@ func TestCachePushPull(t *testing.T) {
 	require.Len(t, cacheA.AllBugsIds(), 2)
 }
 
+func TestCachePullDuplicateImport(t *testing.T) {
+	repoA, repoB, _ := repository.SetupGoGitReposAndRemote(t)
+
+	cacheA, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+	cacheB, err := NewRepoCache(repoB)
+	require.NoError(t, err)
+
+	reneA, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cacheA.SetUserIdentity(reneA))
+	isaacB, err := cacheB.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.SetUserIdentity(isaacB))
+
+	b, _, err := cacheA.NewBug("bug1", "message")
+	require.NoError(t, err)
+
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Pull("origin"))
+
+	reneB, err := cacheB.ResolveIdentity(reneA.Id())
+	require.NoError(t, err)
+
+	// both clones import the same remote comment with a bridge, before syncing
+	metadata := map[string]string{"github-id": "IC_1", "github-url": "https://github.com/a/b/issues/1#issuecomment-1"}
+	bA, err := cacheA.ResolveBug(b.Id())
+	require.NoError(t, err)
+	_, _, err = bA.AddCommentRaw(reneA, 1700000000, "imported", nil, metadata)
+	require.NoError(t, err)
+	require.NoError(t, bA.Commit())
+
+	bB, err := cacheB.ResolveBug(b.Id())
+	require.NoError(t, err)
+	_, _, err = bB.AddCommentRaw(reneB, 1700000000, "imported", nil, metadata)
+	require.NoError(t, err)
+	require.NoError(t, bB.Commit())
+
+	// a comment added locally is not a duplicate
+	_, _, err = bB.AddComment("local")
+	require.NoError(t, err)
+	require.NoError(t, bB.Commit())
+
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Pull("origin"))
+	_, err = cacheB.Push("origin")
+	require.NoError(t, err)
+	require.NoError(t, cacheA.Pull("origin"))
+
+	var snaps []*bug.Snapshot
+	for _, repo := range []repository.ClockedRepo{repoA, repoB} {
+		merged, err := bug.Read(repo, b.Id())
+		require.NoError(t, err)
+		snap := merged.Compile()
+		require.Len(t, snap.Comments, 3)
+		require.Equal(t, "imported", snap.Comments[1].Message)
+		require.Equal(t, "local", snap.Comments[2].Message)
+		snaps = append(snaps, snap)
+	}
+
+	// the same comment is kept on both sides
+	require.Equal(t, snaps[0].Comments[1].CombinedId(), snaps[1].Comments[1].CombinedId())
+
+	excerpt, err := cacheB.ResolveBugExcerpt(b.Id())
+	require.NoError(t, err)
+	require.Equal(t, 3, excerpt.LenComments)
+}
+
+func TestCachePullMergeConflicts(t *testing.T) {
+	repoA, repoB, _ := repository.SetupGoGitReposAndRemote(t)
+
+	cacheA, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+	cacheB, err := NewRepoCache(repoB)
+	require.NoError(t, err)
+
+	reneA, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cacheA.SetUserIdentity(reneA))
+	isaacB, err := cacheB.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.SetUserIdentity(isaacB))
+
+	b, _, err := cacheA.NewBug("bug1", "message")
+	require.NoError(t, err)
+
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Pull("origin"))
+
+	// both clones change the title and the status before syncing
+	bA, err := cacheA.ResolveBug(b.Id())
+	require.NoError(t, err)
+	_, err = bA.SetTitle("title A")
+	require.NoError(t, err)
+	_, err = bA.Close()
+	require.NoError(t, err)
+	require.NoError(t, bA.Commit())
+
+	bB, err := cacheB.ResolveBug(b.Id())
+	require.NoError(t, err)
+	_, err = bB.SetTitle("title B")
+	require.NoError(t, err)
+	// the same label on both sides is not a conflict
+	_, _, err = bB.ChangeLabels([]string{"bug"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, bB.Commit())
+
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Pull("origin"))
+
+	bB, err = cacheB.ResolveBug(b.Id())
+	require.NoError(t, err)
+	snap := bB.Snapshot()
+	require.Len(t, snap.MergeConflicts, 1)
+	conflict := snap.MergeConflicts[0]
+	require.Equal(t, "title", conflict.Field)
+	require.Equal(t, snap.Title, conflict.KeptValue)
+	require.ElementsMatch(t, []string{"title A", "title B"}, []string{conflict.KeptValue, conflict.OverriddenValue})
+
+	// a later change is aware of both, and is not a conflict
+	_, err = bB.SetTitle("title C")
+	require.NoError(t, err)
+	require.NoError(t, bB.Commit())
+	require.Len(t, bB.Snapshot().MergeConflicts, 1)
+}
+
 func TestRemove(t *testing.T) {
 	repo := repository.CreateGoGitTestRepo(t, false)
 	remoteA := repository.CreateGoGitTestRepo(t, true)
@@ -269,6 +416,186 @@ func TestCacheEviction(t *testing.T) {
 	require.Equal(t, 2, len(repoCache.bugs))
 }
 
+func TestSnapshotCache(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+	repoCache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	repoCache.setCacheSize(1)
+
+	rene, err := repoCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, repoCache.SetUserIdentity(rene))
+
+	bug1, _, err := repoCache.NewBug("title", "message")
+	require.NoError(t, err)
+	snap1 := bug1.Snapshot()
+	require.Same(t, snap1, bug1.Snapshot())
+
+	// bug1 is evicted, then read again from git
+	_, _, err = repoCache.NewBug("title", "message")
+	require.NoError(t, err)
+	checkBugPresence(t, repoCache, bug1, false)
+
+	reloaded, err := repoCache.ResolveBug(bug1.Id())
+	require.NoError(t, err)
+	require.NotSame(t, bug1, reloaded)
+	require.Same(t, snap1, reloaded.Snapshot())
+
+	// a new operation invalidates the snapshot
+	_, _, err = reloaded.AddComment("comment")
+	require.NoError(t, err)
+	snap2 := reloaded.Snapshot()
+	require.NotSame(t, snap1, snap2)
+	require.Len(t, snap1.Comments, 1)
+	require.Len(t, snap2.Comments, 2)
+}
+
+func TestSnapshotAt(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+	repoCache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := repoCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, _, err := repoCache.NewBugRaw(rene, 1000, "title", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = b.SetTitleRaw(rene, 2000, "new title", nil)
+	require.NoError(t, err)
+	_, commentOp, err := b.AddCommentRaw(rene, 3000, "comment", nil, nil)
+	require.NoError(t, err)
+	_, err = b.CloseRaw(rene, 4000, nil)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	_, err = b.SnapshotAt(time.Unix(999, 0))
+	require.ErrorIs(t, err, ErrBugNotCreatedYet)
+
+	snap, err := b.SnapshotAt(time.Unix(2500, 0))
+	require.NoError(t, err)
+	require.Equal(t, "new title", snap.Title)
+	require.Len(t, snap.Comments, 1)
+	require.Len(t, snap.Operations, 2)
+
+	snap, err = b.SnapshotAt(time.Unix(4000, 0))
+	require.NoError(t, err)
+	require.Equal(t, common.ClosedStatus, snap.Status)
+
+	snap, err = b.SnapshotAtOperation(commentOp.Id())
+	require.NoError(t, err)
+	require.Equal(t, common.OpenStatus, snap.Status)
+	require.Len(t, snap.Comments, 2)
+
+	_, err = b.SnapshotAtOperation(entity.Id("unknown"))
+	require.ErrorIs(t, err, ErrNoMatchingOp)
+
+	// the current state is unchanged
+	require.Equal(t, common.ClosedStatus, b.Snapshot().Status)
+	require.Len(t, b.Snapshot().Operations, 4)
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+	repoCache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := repoCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, createOp, err := repoCache.NewBugRaw(rene, 1000, "title", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b.ChangeLabelsRaw(rene, 1500, []string{"bug", "ui"}, nil, nil)
+	require.NoError(t, err)
+	_, err = b.SetTitleRaw(rene, 2000, "new title", nil)
+	require.NoError(t, err)
+	commentId, _, err := b.AddCommentRaw(rene, 3000, "comment", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b.ChangeLabelsRaw(rene, 3500, []string{"confirmed"}, []string{"ui"}, nil)
+	require.NoError(t, err)
+	_, _, err = b.EditCreateCommentRaw(rene, 3600, "edited message", nil)
+	require.NoError(t, err)
+	closeOp, err := b.CloseRaw(rene, 4000, nil)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	labelOp := b.Snapshot().Operations[1]
+
+	diff, err := b.DiffSnapshots(labelOp.Id(), closeOp.Id())
+	require.NoError(t, err)
+	require.False(t, diff.IsEmpty())
+	require.Equal(t, &TitleChange{From: "title", To: "new title"}, diff.Title)
+	require.Equal(t, common.OpenStatus, diff.Status.From)
+	require.Equal(t, common.ClosedStatus, diff.Status.To)
+	require.Equal(t, []bug.Label{"confirmed"}, diff.LabelsAdded)
+	require.Equal(t, []bug.Label{"ui"}, diff.LabelsRemoved)
+	require.Len(t, diff.CommentsAdded, 1)
+	require.Equal(t, commentId, diff.CommentsAdded[0].CombinedId())
+	require.Len(t, diff.CommentsEdited, 1)
+	require.Equal(t, "message", diff.CommentsEdited[0].From)
+	require.Equal(t, "edited message", diff.CommentsEdited[0].Comment.Message)
+	require.Empty(t, diff.CommentsRemoved)
+
+	diff, err = b.DiffSnapshots(createOp.Id(), createOp.Id())
+	require.NoError(t, err)
+	require.True(t, diff.IsEmpty())
+
+	_, err = b.DiffSnapshots(closeOp.Id(), createOp.Id())
+	require.Error(t, err)
+	_, err = b.DiffSnapshots(createOp.Id(), entity.Id("unknown"))
+	require.ErrorIs(t, err, ErrNoMatchingOp)
+
+	id, err := b.ResolveOperationPrefix(closeOp.Id().Human())
+	require.NoError(t, err)
+	require.Equal(t, closeOp.Id(), id)
+}
+
+func TestCacheEvictionLimits(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+	require.NoError(t, repo.LocalConfig().StoreString(maxLoadedBugsConfigKey, "3"))
+
+	repoCache, err := NewRepoCacheWithOptions(repo, RepoCacheOptions{MaxLoadedBytes: 10000})
+	require.NoError(t, err)
+	require.Equal(t, 3, repoCache.maxLoadedBugs)
+	require.Equal(t, int64(10000), repoCache.maxLoadedBytes)
+
+	rene, err := repoCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, repoCache.SetUserIdentity(rene))
+
+	bug1, _, err := repoCache.NewBug("title", "message")
+	require.NoError(t, err)
+	unpin := bug1.Pin()
+
+	bug2, _, err := repoCache.NewBug("title", "message")
+	require.NoError(t, err)
+	require.Equal(t, bug1.size+bug2.size, repoCache.LoadedBytes())
+
+	// the memory limit is exceeded, the oldest unpinned bug is evicted
+	bug3, _, err := repoCache.NewBug("title", strings.Repeat("a", 5000))
+	require.NoError(t, err)
+	checkBugPresence(t, repoCache, bug1, true)
+	checkBugPresence(t, repoCache, bug2, false)
+	checkBugPresence(t, repoCache, bug3, true)
+	require.Equal(t, bug1.size+bug3.size, repoCache.LoadedBytes())
+
+	// commenting grows the accounted size
+	_, _, err = bug3.AddComment(strings.Repeat("b", 5000))
+	require.NoError(t, err)
+	require.NoError(t, bug3.Commit())
+	require.Equal(t, bug1.size+bug3.size, repoCache.LoadedBytes())
+
+	// once unpinned, the bug can be evicted, but not the one just loaded
+	unpin()
+	_, err = repoCache.ResolveBug(bug2.Id())
+	require.NoError(t, err)
+	checkBugPresence(t, repoCache, bug1, false)
+	checkBugPresence(t, repoCache, bug3, false)
+	require.Equal(t, 1, repoCache.loadedBugs.Len())
+
+	// the excerpts are kept
+	require.Len(t, repoCache.AllBugsIds(), 3)
+}
+
 func checkBugPresence(t *testing.T, cache *RepoCache, bug *BugCache, presence bool) {
 	id := bug.Id()
 	require.Equal(t, presence, cache.loadedBugs.Contains(id))
@@ -295,3 +622,2888 @@ func TestLongDescription(t *testing.T) {
 	_, _, err = backend.NewBugRaw(i, time.Now().Unix(), text, text, nil, nil)
 	require.NoError(t, err)
 }
+
+func TestExternalizedMessage(t *testing.T) {
+	text := strings.Repeat("x", bug.MessageExternalizeThreshold+1)
+
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	i, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, op, err := backend.NewBugRaw(i, time.Now().Unix(), "title", text, nil, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, op.MessageRef)
+
+	_, addOp, err := b.AddCommentRaw(i, time.Now().Unix(), text+"y", nil, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, addOp.MessageRef)
+	require.NoError(t, b.Commit())
+
+	// the message is not stored inline
+	data, err := op.MarshalJSON()
+	require.NoError(t, err)
+	require.NotContains(t, string(data), text)
+
+	// reading back from git gives the full messages
+	require.NoError(t, backend.Close())
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+
+	b, err = backend.ResolveBug(b.Id())
+	require.NoError(t, err)
+	require.Equal(t, text, b.Snapshot().Comments[0].Message)
+	require.Equal(t, text+"y", b.Snapshot().Comments[1].Message)
+}
+
+func TestMessageSizeLimit(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	i, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	require.NoError(t, repo.LocalConfig().StoreString(maxMessageSizeConfigKey, "10"))
+
+	_, _, err = backend.NewBugRaw(i, time.Now().Unix(), "title", "0123456789ABC", nil, nil)
+	require.True(t, IsErrMessageTooLarge(err))
+
+	b, _, err := backend.NewBugRaw(i, time.Now().Unix(), "title", "0123456789", nil, nil)
+	require.NoError(t, err)
+
+	_, _, err = b.AddCommentRaw(i, time.Now().Unix(), "0123456789ABC", nil, nil)
+	require.True(t, IsErrMessageTooLarge(err))
+
+	// disable the limit
+	require.NoError(t, repo.LocalConfig().StoreString(maxMessageSizeConfigKey, "0"))
+
+	_, _, err = b.AddCommentRaw(i, time.Now().Unix(), "0123456789ABC", nil, nil)
+	require.NoError(t, err)
+}
+
+func TestAttachment(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	i, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, _, err := backend.NewBugRaw(i, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	op, err := b.AttachRaw(i, time.Now().Unix(), "app.json", []byte("{\"a\": 1}"), nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), op.Size)
+	require.Equal(t, "application/json", op.ContentType)
+
+	_, err = b.AttachRaw(i, time.Now().Unix(), "screenshot", []byte("\x89PNG\r\n\x1a\n"), nil)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	attachment, data, err := b.ReadAttachment("app.json")
+	require.NoError(t, err)
+	require.Equal(t, op.Hash, attachment.Hash)
+	require.Equal(t, []byte("{\"a\": 1}"), data)
+
+	attachment, _, err = b.ReadAttachment("screenshot")
+	require.NoError(t, err)
+	require.Equal(t, "image/png", attachment.ContentType)
+
+	require.NoError(t, repo.LocalConfig().StoreString(maxAttachmentSizeConfigKey, "4"))
+	_, err = b.AttachRaw(i, time.Now().Unix(), "app.json", []byte("{\"a\": 1}"), nil)
+	require.True(t, IsErrAttachmentTooLarge(err))
+}
+
+func TestBotIdentity(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	bot, err := backend.NewBotIdentity("CI")
+	require.NoError(t, err)
+	require.ErrorIs(t, backend.SetUserIdentity(bot), identity.ErrBotUserIdentity)
+
+	_, err = backend.NewBotToken(rene.Id())
+	require.Error(t, err)
+
+	token, err := backend.NewBotToken(bot.Id())
+	require.NoError(t, err)
+
+	resolved, err := backend.ResolveBotToken(token)
+	require.NoError(t, err)
+	require.Equal(t, bot.Id(), resolved.Id())
+
+	_, err = backend.ResolveBotToken("wrong")
+	require.ErrorIs(t, err, ErrInvalidBotToken)
+
+	b1, _, err := backend.NewBugRaw(rene, time.Now().Unix(), "crash on startup", "the application crashes", nil, nil)
+	require.NoError(t, err)
+	b2, _, err := backend.NewBugRaw(bot, time.Now().Unix(), "flaky test", "TestFoo failed on main", nil, nil)
+	require.NoError(t, err)
+
+	for queryStr, expected := range map[string][]entity.Id{
+		"author:type:bot":   {b2.Id()},
+		"author:type:human": {b1.Id()},
+		"-author:type:bot":  {b1.Id()},
+	} {
+		q, err := query.Parse(queryStr)
+		require.NoError(t, err)
+		ids, err := backend.QueryBugs(q)
+		require.NoError(t, err)
+		require.Equal(t, expected, ids, queryStr)
+	}
+
+	// a renewed token replaces the previous one
+	renewed, err := backend.NewBotToken(bot.Id())
+	require.NoError(t, err)
+	_, err = backend.ResolveBotToken(token)
+	require.ErrorIs(t, err, ErrInvalidBotToken)
+
+	require.NoError(t, backend.RevokeBotToken(bot.Id()))
+	_, err = backend.ResolveBotToken(renewed)
+	require.ErrorIs(t, err, ErrInvalidBotToken)
+
+	require.NoError(t, backend.Close())
+}
+
+func TestPrefetch(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	i, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	var ids []entity.Id
+	for j := 0; j < 3; j++ {
+		b, _, err := backend.NewBugRaw(i, time.Now().Unix()+int64(j), "title", "message", nil, nil)
+		require.NoError(t, err)
+		ids = append(ids, b.Id())
+	}
+
+	require.NoError(t, backend.Rebuild())
+	loaded, _ := backend.LoadedCount()
+	require.Equal(t, 0, loaded)
+
+	n, err := backend.Prefetch(2)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	// only the two most recently edited bugs are loaded
+	checkPrefetched := func(c *RepoCache) {
+		require.Len(t, c.bugs, 2)
+		require.NotContains(t, c.bugs, ids[0])
+		require.Contains(t, c.bugs, ids[1])
+		require.Contains(t, c.bugs, ids[2])
+	}
+	checkPrefetched(backend)
+
+	n, err = backend.Prefetch(10)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	// a fresh cache build, like after a clone, prefetch as configured
+	require.NoError(t, backend.Close())
+	require.NoError(t, repo.LocalStorage().Remove(bugCacheFile))
+	require.NoError(t, repo.LocalConfig().StoreString(prefetchConfigKey, "2"))
+
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	checkPrefetched(backend)
+}
+
+func TestConfidential(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	i, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b1, _, err := backend.NewBugRaw(i, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+	b2, _, err := backend.NewBugRaw(i, time.Now().Unix(), "title", "message", nil, bug.ConfidentialMetadata())
+	require.NoError(t, err)
+
+	require.Equal(t, []entity.Id{b2.Id()}, backend.ConfidentialBugs())
+
+	_, _, err = b1.AddCommentRaw(i, time.Now().Unix(), "secret", nil, bug.ConfidentialMetadata())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entity.Id{b1.Id(), b2.Id()}, backend.ConfidentialBugs())
+
+	public, err := backend.IsPublicRemote("origin")
+	require.NoError(t, err)
+	require.False(t, public)
+
+	require.NoError(t, repo.LocalConfig().StoreBool("git-bug.remote.origin.public", true))
+
+	public, err = backend.IsPublicRemote("origin")
+	require.NoError(t, err)
+	require.True(t, public)
+}
+
+func TestIdentityActivity(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	isaac, err := backend.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+
+	jan := time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC).Unix()
+	feb := time.Date(2020, time.February, 10, 0, 0, 0, 0, time.UTC).Unix()
+
+	b1, _, err := backend.NewBugRaw(rene, jan, "title", "message", nil, nil)
+	require.NoError(t, err)
+	b2, _, err := backend.NewBugRaw(isaac, jan, "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	_, _, err = b2.AddCommentRaw(rene, feb, "comment", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b2.AddCommentRaw(rene, feb, "comment", nil, nil)
+	require.NoError(t, err)
+	_, err = b2.CloseRaw(rene, feb, nil)
+	require.NoError(t, err)
+	require.NoError(t, b2.Commit())
+
+	check := func(c *RepoCache) {
+		activity := c.IdentityActivity(rene.Id())
+		require.Equal(t, []entity.Id{b1.Id()}, activity.Authored)
+		require.Equal(t, []entity.Id{b2.Id()}, activity.Commented)
+		require.Equal(t, []entity.Id{b2.Id()}, activity.Closed)
+		require.Equal(t, []ActivityCount{
+			{Month: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), Authored: 1},
+			{Month: time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC), Commented: 2, Closed: 1},
+		}, activity.Timeline)
+
+		activity = c.IdentityActivity(isaac.Id())
+		require.Equal(t, []entity.Id{b2.Id()}, activity.Authored)
+		require.Empty(t, activity.Commented)
+		require.Empty(t, activity.Closed)
+	}
+
+	check(backend)
+
+	// the index is rebuilt from the excerpts when reloading the cache
+	require.NoError(t, backend.Close())
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	check(backend)
+
+	// removing a bug removes its activity
+	require.NoError(t, backend.RemoveBug(b2.Id().String()))
+	require.Empty(t, backend.IdentityActivity(rene.Id()).Commented)
+}
+
+func TestAuditLog(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	i, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b1, _, err := backend.NewBugRaw(i, 1000, "title", "message", nil, nil)
+	require.NoError(t, err)
+	b2, _, err := backend.NewBugRaw(i, 1001, "title", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b1.AddCommentRaw(i, 1002, "comment", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, b1.Commit())
+
+	entries, err := backend.AuditLog()
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, b1.Id(), entries[0].BugId)
+	require.Equal(t, b2.Id(), entries[1].BugId)
+	require.Equal(t, b1.Id(), entries[2].BugId)
+	require.Equal(t, "", entries[0].Prev)
+	require.Equal(t, entries[0].Hash, entries[1].Prev)
+
+	// deterministic
+	again, err := backend.AuditLog()
+	require.NoError(t, err)
+	require.Equal(t, entries, again)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteAuditLog(&buf, entries))
+	log := buf.String()
+
+	count, err := VerifyAuditLog(strings.NewReader(log), entries)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	// the log is append-only: the previous log is still valid
+	_, _, err = b2.AddCommentRaw(i, 1003, "comment", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, b2.Commit())
+
+	entries, err = backend.AuditLog()
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	count, err = VerifyAuditLog(strings.NewReader(log), entries)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	// tampering is detected
+	tampered := strings.Replace(log, `"timestamp":1001`, `"timestamp":1005`, 1)
+	_, err = VerifyAuditLog(strings.NewReader(tampered), entries)
+	require.Error(t, err)
+}
+
+func TestEvents(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	events, unsubscribe := backend.Subscribe()
+	created, unsubscribeCreated := backend.SubscribeKinds(EventBugCreated)
+	defer unsubscribeCreated()
+
+	i, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.Equal(t, IdentityUpdated{IdentityId: i.Id()}, <-events)
+
+	b, _, err := backend.NewBugRaw(i, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, BugCreated{BugId: b.Id()}, <-events)
+
+	// a filtered subscriber only receive the events of its kinds
+	require.Equal(t, BugCreated{BugId: b.Id()}, <-created)
+	require.Empty(t, created)
+
+	_, op, err := b.AddCommentRaw(i, time.Now().Unix(), "comment", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, BugUpdated{BugId: b.Id(), Operations: []bug.Operation{op}}, <-events)
+
+	// committing doesn't change the bug
+	require.NoError(t, b.Commit())
+	require.Empty(t, events)
+	require.Empty(t, created)
+
+	unsubscribe()
+	_, ok := <-events
+	require.False(t, ok)
+
+	// unsubscribing twice, or after closing the cache, is harmless
+	unsubscribe()
+	_, unsubscribe = backend.Subscribe()
+	require.NoError(t, backend.Close())
+	unsubscribe()
+}
+
+func TestBugTransaction(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	b, _, err := backend.NewBug("title", "message")
+	require.NoError(t, err)
+
+	before, err := backend.ResolveBugExcerpt(b.Id())
+	require.NoError(t, err)
+
+	events, unsubscribe := backend.Subscribe()
+	defer unsubscribe()
+
+	err = b.Transaction(func(tx *BugTx) error {
+		if _, err := tx.SetTitle("new title"); err != nil {
+			return err
+		}
+		if _, _, err := tx.ChangeLabels([]string{"bug"}, nil); err != nil {
+			return err
+		}
+		require.Equal(t, "new title", tx.Snapshot().Title)
+		_, _, err := tx.AddComment("comment")
+		return err
+	})
+	require.NoError(t, err)
+	require.False(t, b.NeedCommit())
+
+	// a single update of the cache, for a single commit
+	event := (<-events).(BugUpdated)
+	require.Len(t, event.Operations, 3)
+	require.Empty(t, events)
+
+	excerpt, err := backend.ResolveBugExcerpt(b.Id())
+	require.NoError(t, err)
+	require.Equal(t, "new title", excerpt.Title)
+	require.Equal(t, []bug.Label{"bug"}, excerpt.Labels)
+	require.Equal(t, 2, excerpt.LenComments)
+	require.Equal(t, before.EditLamportTime+1, excerpt.EditLamportTime)
+
+	// nothing is kept from a failed transaction
+	err = b.Transaction(func(tx *BugTx) error {
+		if _, err := tx.SetTitle("another title"); err != nil {
+			return err
+		}
+		_, err := tx.SetField("unknown", "value")
+		return err
+	})
+	require.Error(t, err)
+	require.False(t, b.NeedCommit())
+	require.Equal(t, "new title", b.Snapshot().Title)
+	require.Empty(t, events)
+
+	// the freeze mode applies as well
+	require.NoError(t, backend.SetFreeze(Freeze{Active: true, Labels: []bug.Label{"bug"}}))
+	err = b.Transaction(func(tx *BugTx) error {
+		_, err := tx.Close()
+		return err
+	})
+	require.True(t, IsErrFrozen(err))
+	require.Equal(t, common.OpenStatus, b.Snapshot().Status)
+}
+
+func TestSquashIdentities(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	author, err := backend.NewIdentity("Author", "author@example.com")
+	require.NoError(t, err)
+	commenter, err := backend.NewIdentity("Commenter", "commenter@example.com")
+	require.NoError(t, err)
+	tagger, err := backend.NewIdentity("Tagger", "tagger@example.com")
+	require.NoError(t, err)
+	ghost1, err := backend.NewIdentity("Ghost 1", "ghost1@example.com")
+	require.NoError(t, err)
+	ghost2, err := backend.NewIdentity("Ghost 2", "ghost2@example.com")
+	require.NoError(t, err)
+
+	b, op, err := backend.NewBugRaw(author, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b.AddCommentRaw(commenter, time.Now().Unix(), "comment", nil, nil)
+	require.NoError(t, err)
+	// an operation that doesn't make its author an actor of the bug
+	_, err = b.SetMetadataRaw(tagger, time.Now().Unix(), op.Id(), map[string]string{"key": "value"})
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	expected := []entity.Id{ghost1.Id(), ghost2.Id()}
+	sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+
+	unreferenced, err := backend.UnreferencedIdentities()
+	require.NoError(t, err)
+	require.Equal(t, expected, unreferenced)
+	require.Len(t, backend.AllIdentityIds(), 6)
+
+	require.NoError(t, backend.RemoveIdentities(unreferenced))
+	require.Len(t, backend.AllIdentityIds(), 4)
+
+	_, err = backend.ResolveIdentity(ghost1.Id())
+	require.Error(t, err)
+
+	// the excerpts have been written
+	require.NoError(t, backend.Close())
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+	require.Len(t, backend.AllIdentityIds(), 4)
+
+	unreferenced, err = backend.UnreferencedIdentities()
+	require.NoError(t, err)
+	require.Empty(t, unreferenced)
+}
+
+func TestLocked(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	_, err = NewRepoCache(repo)
+	require.True(t, IsErrLocked(err))
+	require.Equal(t, ErrLocked{Pid: os.Getpid()}, err)
+}
+
+func TestReadCompletionData(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	_, err := ReadCompletionData(repo, repo.AnyConfig())
+	require.Error(t, err)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	b1, _, err := backend.NewBugRaw(rene, 1000, "first", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b1.ChangeLabelsRaw(rene, 1001, []string{"bug", "ui"}, nil, nil)
+	require.NoError(t, err)
+	_, _, err = backend.NewBugRaw(rene, 2000, "second", "message", nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.query.mine", "status:open"))
+
+	// read while the cache is still open and holding the lock
+	data, err := ReadCompletionData(repo, repo.AnyConfig())
+	require.NoError(t, err)
+
+	require.Len(t, data.Bugs, 2)
+	require.Equal(t, "second", data.Bugs[0].Title)
+	require.Equal(t, "first", data.Bugs[1].Title)
+	require.Equal(t, []bug.Label{"bug", "ui"}, data.Labels)
+	require.Len(t, data.Identities, 1)
+	require.Equal(t, map[string]string{"mine": "status:open"}, data.SavedQueries)
+
+	require.NoError(t, backend.Close())
+}
+
+func TestStatistics(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	march := time.Date(2022, time.March, 10, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2022, time.April, 10, 0, 0, 0, 0, time.UTC)
+
+	b1, _, err := backend.NewBugRaw(rene, march.Unix(), "first", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b1.ChangeLabelsRaw(rene, march.Unix(), []string{"bug", "ui"}, nil, nil)
+	require.NoError(t, err)
+	b2, _, err := backend.NewBugRaw(rene, april.Unix(), "second", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b2.ChangeLabelsRaw(rene, april.Unix(), []string{"bug"}, nil, nil)
+	require.NoError(t, err)
+	_, err = b2.CloseRaw(rene, april.Unix(), nil)
+	require.NoError(t, err)
+
+	expected := &RepoStatistics{
+		Open:   1,
+		Closed: 1,
+		Labels: []LabelCount{{Label: "bug", Count: 2}, {Label: "ui", Count: 1}},
+		Timeline: []ActivityCount{
+			{Month: time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC), Authored: 1},
+			{Month: time.Date(2022, time.April, 1, 0, 0, 0, 0, time.UTC), Authored: 1, Closed: 1},
+		},
+	}
+	require.Equal(t, expected, backend.Statistics())
+
+	// the incremental statistics match the ones computed from scratch
+	require.NoError(t, backend.Close())
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	require.Equal(t, expected, backend.Statistics())
+
+	require.NoError(t, backend.RemoveBug(b1.Id().String()))
+	stats := backend.Statistics()
+	require.Equal(t, 0, stats.Open)
+	require.Equal(t, []LabelCount{{Label: "bug", Count: 1}}, stats.Labels)
+	require.Len(t, stats.Timeline, 1)
+
+	require.NoError(t, backend.Close())
+}
+
+func TestQueryBugsSortByComments(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+
+	quiet, _, err := backend.NewBugRaw(rene, now, "quiet", "message", nil, nil)
+	require.NoError(t, err)
+	busy, _, err := backend.NewBugRaw(rene, now, "busy", "message", nil, nil)
+	require.NoError(t, err)
+	recent, _, err := backend.NewBugRaw(rene, now, "recent", "message", nil, nil)
+	require.NoError(t, err)
+
+	for i := 1; i <= 2; i++ {
+		_, _, err = busy.AddCommentRaw(rene, now+int64(i), "comment", nil, nil)
+		require.NoError(t, err)
+	}
+	require.NoError(t, busy.Commit())
+	_, _, err = recent.AddCommentRaw(rene, now+10, "comment", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, recent.Commit())
+
+	excerpt, err := backend.ResolveBugExcerpt(busy.Id())
+	require.NoError(t, err)
+	require.Equal(t, 3, excerpt.LenComments)
+	require.Equal(t, now+2, excerpt.LastCommentUnixTime)
+
+	queryIds := func(q string) []entity.Id {
+		parsed, err := query.Parse(q)
+		require.NoError(t, err)
+		ids, err := backend.QueryBugs(parsed)
+		require.NoError(t, err)
+		return ids
+	}
+
+	require.Equal(t, []entity.Id{busy.Id(), recent.Id(), quiet.Id()}, queryIds("sort:comments"))
+	require.Equal(t, []entity.Id{quiet.Id(), recent.Id(), busy.Id()}, queryIds("sort:comments-asc"))
+	require.Equal(t, []entity.Id{recent.Id(), busy.Id(), quiet.Id()}, queryIds("sort:last-comment"))
+}
+
+func TestBugIndex(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	isaac, err := backend.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+
+	b1, _, err := backend.NewBugRaw(rene, time.Now().Unix(), "first", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b1.ChangeLabelsRaw(rene, time.Now().Unix(), []string{"bug", "ui"}, nil, nil)
+	require.NoError(t, err)
+	b2, _, err := backend.NewBugRaw(isaac, time.Now().Unix(), "second", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b2.ChangeLabelsRaw(isaac, time.Now().Unix(), []string{"bug"}, nil, nil)
+	require.NoError(t, err)
+	b3, _, err := backend.NewBugRaw(rene, time.Now().Unix(), "third", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = b3.CloseRaw(rene, time.Now().Unix(), nil)
+	require.NoError(t, err)
+
+	queryIds := func(q string) []entity.Id {
+		parsed, err := query.Parse(q)
+		require.NoError(t, err)
+		ids, err := backend.QueryBugs(parsed)
+		require.NoError(t, err)
+		return ids
+	}
+
+	require.ElementsMatch(t, []entity.Id{b1.Id(), b2.Id()}, queryIds("status:open"))
+	require.ElementsMatch(t, []entity.Id{b1.Id(), b2.Id(), b3.Id()}, queryIds("status:open status:closed"))
+	require.ElementsMatch(t, []entity.Id{b1.Id(), b3.Id()}, queryIds("author:descartes"))
+	require.ElementsMatch(t, []entity.Id{b1.Id(), b2.Id()}, queryIds("label:bug"))
+	require.ElementsMatch(t, []entity.Id{b1.Id()}, queryIds("label:bug label:ui"))
+	require.ElementsMatch(t, []entity.Id{b2.Id()}, queryIds("label:bug author:newton"))
+	require.Empty(t, queryIds("label:missing"))
+	require.Empty(t, queryIds("author:nobody"))
+
+	// the indexes follow the changes
+	_, err = b3.OpenRaw(isaac, time.Now().Unix(), nil)
+	require.NoError(t, err)
+	_, _, err = b1.ChangeLabelsRaw(rene, time.Now().Unix(), nil, []string{"bug"}, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entity.Id{b1.Id(), b2.Id(), b3.Id()}, queryIds("status:open"))
+	require.ElementsMatch(t, []entity.Id{b2.Id()}, queryIds("label:bug"))
+
+	require.NoError(t, backend.RemoveBug(b2.Id().String()))
+	require.Empty(t, queryIds("label:bug"))
+
+	// the incremental indexes match the ones built from scratch
+	incremental := backend.index
+	backend.rebuildBugIndex()
+	require.Equal(t, backend.index, incremental)
+
+	require.NoError(t, backend.Close())
+}
+
+func TestExplainQuery(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b1, _, err := backend.NewBugRaw(rene, time.Now().Unix(), "crash on startup", "the application crashes", nil, nil)
+	require.NoError(t, err)
+	_, _, err = b1.ChangeLabelsRaw(rene, time.Now().Unix(), []string{"bug"}, nil, nil)
+	require.NoError(t, err)
+	_, err = b1.CloseRaw(rene, time.Now().Unix(), nil)
+	require.NoError(t, err)
+
+	q, err := query.Parse("status:open label:bug label:ui author:descartes")
+	require.NoError(t, err)
+
+	explanation, err := backend.ExplainQuery(q, b1.Id())
+	require.NoError(t, err)
+	require.False(t, explanation.Matched)
+	require.Equal(t, []FilterGroupExplanation{
+		{Qualifier: "status", AnyOf: true, Matched: false, Filters: []FilterExplanation{
+			{Filter: "status:open", Matched: false, Reason: "status is closed"},
+		}},
+		{Qualifier: "author", AnyOf: true, Matched: true, Filters: []FilterExplanation{
+			{Filter: "author:descartes", Matched: true, Reason: "author is René Descartes [" + rene.Id().Human() + "]"},
+		}},
+		{Qualifier: "label", AnyOf: false, Matched: false, Filters: []FilterExplanation{
+			{Filter: "label:bug", Matched: true, Reason: "labels are bug"},
+			{Filter: "label:ui", Matched: false, Reason: "labels are bug"},
+		}},
+	}, explanation.Groups)
+
+	// the explanation agree with the query results
+	q, err = query.Parse("status:closed label:bug crashes")
+	require.NoError(t, err)
+	explanation, err = backend.ExplainQuery(q, b1.Id())
+	require.NoError(t, err)
+	require.True(t, explanation.Matched)
+	ids, err := backend.QueryBugs(q)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{b1.Id()}, ids)
+
+	q, err = query.Parse("missing")
+	require.NoError(t, err)
+	explanation, err = backend.ExplainQuery(q, b1.Id())
+	require.NoError(t, err)
+	require.False(t, explanation.Matched)
+
+	require.NoError(t, backend.Close())
+}
+
+func TestChangeFeed(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b1, _, err := backend.NewBugRaw(rene, time.Now().Unix(), "first", "message", nil, nil)
+	require.NoError(t, err)
+	b2, _, err := backend.NewBugRaw(rene, time.Now().Unix()+1, "second", "message", nil, nil)
+	require.NoError(t, err)
+
+	entries, err := backend.ChangeFeed(0, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(1), entries[0].Seq)
+	require.Equal(t, b1.Id(), entries[0].BugId)
+	require.Equal(t, b2.Id(), entries[1].BugId)
+
+	_, comment, err := b1.AddCommentRaw(rene, time.Now().Unix()+2, "comment", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, b1.Commit())
+
+	entries, err = backend.ChangeFeed(2, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(3), entries[0].Seq)
+	require.Equal(t, comment.Id(), entries[0].OpId)
+
+	entries, err = backend.ChangeFeed(0, 1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	require.NoError(t, backend.SetChangeFeedCursor("warehouse", 2))
+	require.Error(t, backend.SetChangeFeedCursor("warehouse", 4))
+	require.NoError(t, backend.Close())
+
+	// the feed and the cursors survive a restart, without recording the
+	// operations again
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+
+	cursor, err := backend.ChangeFeedCursor("warehouse")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), cursor)
+
+	require.NoError(t, backend.RemoveBug(b2.Id().String()))
+
+	entries, err = backend.ChangeFeed(cursor, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, comment.Id(), entries[0].OpId)
+	require.Equal(t, ChangeFeedEntry{Seq: 4, BugId: b2.Id(), Removed: true}, entries[1])
+
+	require.NoError(t, backend.Close())
+}
+
+func TestInspectCache(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	inspection, err := InspectCache(repo)
+	require.NoError(t, err)
+	require.False(t, inspection.Files[0].Exist)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	b, _, err := backend.NewBugRaw(rene, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, backend.Close())
+
+	// a bug created without going through the cache
+	other, _, err := bug.Create(rene.Identity, time.Now().Unix(), "other", "message", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, other.Commit(repo))
+
+	inspection, err = InspectCache(repo)
+	require.NoError(t, err)
+	require.Equal(t, uint(formatVersion), inspection.Files[0].Version)
+	require.Equal(t, 1, inspection.Files[0].Entries)
+	require.Len(t, inspection.Bugs, 1)
+	require.Equal(t, b.Id(), inspection.Bugs[0].Id)
+	require.Equal(t, []entity.Id{other.Id()}, inspection.MissingBugs)
+	require.Empty(t, inspection.StaleBugs)
+
+	schema := DescribeCacheSchema()
+	require.Equal(t, "BugExcerpt", schema.Types[0].Name)
+	require.Equal(t, "Id", schema.Types[0].Fields[0].Name)
+}
+
+func TestAutoAssign(t *testing.T) {
+	repoA, repoB, _ := repository.SetupGoGitReposAndRemote(t)
+
+	cacheA, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+	defer cacheA.Close()
+
+	cacheB, err := NewRepoCache(repoB)
+	require.NoError(t, err)
+	defer cacheB.Close()
+
+	rene, err := cacheA.NewIdentityFull("René Descartes", "rene@descartes.fr", "rene", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, cacheA.SetUserIdentity(rene))
+	alice, err := cacheA.NewIdentityFull("Alice", "alice@example.com", "alice", "", nil)
+	require.NoError(t, err)
+
+	isaac, err := cacheB.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.SetUserIdentity(isaac))
+
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Pull("origin"))
+
+	// only B has the policy
+	config := repoB.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.policy.webui-owner.rule", "auto-assign"))
+	require.NoError(t, config.StoreString("git-bug.policy.webui-owner.label", "webui"))
+	require.NoError(t, config.StoreString("git-bug.policy.webui-owner.assignee", "alice"))
+
+	// committed label change
+	b1, _, err := cacheB.NewBug("bug1", "message")
+	require.NoError(t, err)
+	_, _, err = b1.ChangeLabels([]string{"webui"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, b1.Commit())
+	require.Equal(t, []entity.Id{alice.Id()}, b1.Snapshot().Assignees)
+
+	ops := b1.Snapshot().Operations
+	autoOp := ops[len(ops)-1].(*bug.SetAssigneeOperation)
+	policyName, _ := autoOp.GetMetadata(policy.MetaKeyAutoAssignPolicy)
+	require.Equal(t, "webui-owner", policyName)
+
+	// unassigning is respected on the next label change
+	_, err = b1.SetAssignee(nil, []entity.Id{alice.Id()})
+	require.NoError(t, err)
+	_, _, err = b1.ChangeLabels([]string{"other"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, b1.Commit())
+	require.Empty(t, b1.Snapshot().Assignees)
+
+	// opted out
+	b2, _, err := cacheB.NewBug("bug2", "message")
+	require.NoError(t, err)
+	_, _, err = b2.ChangeLabelsRaw(isaac, time.Now().Unix(), []string{"webui"}, nil, map[string]string{
+		policy.MetaKeyNoAutoAssign: "true",
+	})
+	require.NoError(t, err)
+	require.NoError(t, b2.Commit())
+	require.Empty(t, b2.Snapshot().Assignees)
+
+	// merged label change
+	b3, _, err := cacheA.NewBug("bug3", "message")
+	require.NoError(t, err)
+	_, _, err = b3.ChangeLabels([]string{"webui"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, b3.Commit())
+	require.Empty(t, b3.Snapshot().Assignees)
+
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.Pull("origin"))
+
+	b3B, err := cacheB.ResolveBug(b3.Id())
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{alice.Id()}, b3B.Snapshot().Assignees)
+}
+
+func TestBugFields(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	config := repo.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.field.priority.type", "enum"))
+	require.NoError(t, config.StoreString("git-bug.field.priority.values", "low,high"))
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	_, err = b.SetField("priority", "urgent")
+	require.Error(t, err)
+	_, err = b.SetField("size", "big")
+	require.Error(t, err)
+
+	_, err = b.SetField("priority", "high")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	q, err := query.Parse("field:priority:high")
+	require.NoError(t, err)
+	matching, err := cache.QueryBugs(q)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{b.Id()}, matching)
+
+	// operations staged without the cache are still checked on commit
+	_, err = bug.SetField(b.bug, rene.Identity, time.Now().Unix(), "priority", "urgent", nil)
+	require.NoError(t, err)
+	require.Error(t, b.Commit())
+}
+
+func TestBugWorkflow(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	config := repo.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.workflow.states", "triage,in-progress,done"))
+	require.NoError(t, config.StoreString("git-bug.workflow.triage.next", "in-progress"))
+	require.NoError(t, config.StoreString("git-bug.workflow.done.status", "closed"))
+	require.NoError(t, config.StoreString("git-bug.workflow.done.next", "in-progress"))
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	state, err := b.State()
+	require.NoError(t, err)
+	require.Equal(t, "triage", state)
+
+	_, err = b.SetState("done")
+	require.Error(t, err)
+	_, err = b.Close()
+	require.Error(t, err)
+	_, err = b.SetState("unknown")
+	require.Error(t, err)
+
+	op, err := b.SetState("in-progress")
+	require.NoError(t, err)
+	require.Equal(t, common.OpenStatus, op.Status)
+	require.Equal(t, "in-progress", op.State)
+
+	op, err = b.Close()
+	require.NoError(t, err)
+	require.Empty(t, op.State)
+	require.NoError(t, b.Commit())
+
+	state, err = b.State()
+	require.NoError(t, err)
+	require.Equal(t, "done", state)
+
+	// reopening move the bug to the first open state, which isn't allowed
+	_, err = b.Open()
+	require.Error(t, err)
+
+	_, err = b.SetState("in-progress")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	for q, expected := range map[string][]entity.Id{
+		"status:in-progress":             {b.Id()},
+		"status:open":                    {b.Id()},
+		"status:triage":                  {},
+		"status:done status:in-progress": {b.Id()},
+	} {
+		parsed, err := query.Parse(q)
+		require.NoError(t, err)
+		matching, err := cache.QueryBugs(parsed)
+		require.NoError(t, err)
+		require.Equal(t, expected, matching, q)
+	}
+
+	// the raw changes, as done by the bridges, are not checked
+	_, err = b.SetStateRaw(rene, time.Now().Unix(), "triage", nil)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+	state, err = b.State()
+	require.NoError(t, err)
+	require.Equal(t, "triage", state)
+}
+
+type recordingHook struct {
+	calls []string
+}
+
+func (r *recordingHook) ExcerptCreated(excerpt *BugExcerpt) {
+	r.calls = append(r.calls, "created "+excerpt.Title)
+}
+
+func (r *recordingHook) ExcerptUpdated(excerpt *BugExcerpt) {
+	r.calls = append(r.calls, "updated "+excerpt.Title)
+}
+
+func (r *recordingHook) ExcerptRemoved(id entity.Id) {
+	r.calls = append(r.calls, "removed "+id.Human())
+}
+
+func TestExcerptHooks(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	first, _, err := cache.NewBug("first", "message")
+	require.NoError(t, err)
+
+	hook := &recordingHook{}
+	unregister := cache.RegisterExcerptHook(hook, 0)
+	require.Equal(t, []string{"updated first"}, hook.calls)
+	hook.calls = nil
+
+	second, _, err := cache.NewBug("second", "message")
+	require.NoError(t, err)
+	_, _, err = second.AddComment("comment")
+	require.NoError(t, err)
+	require.NoError(t, second.Commit())
+	require.NoError(t, cache.RemoveBug(first.Id().String()))
+
+	require.Equal(t, []string{
+		"created second",
+		"updated second",
+		"updated second",
+		"removed " + first.Id().Human(),
+	}, hook.calls)
+
+	unregister()
+	_, err = second.SetTitle("second, edited")
+	require.NoError(t, err)
+	require.NoError(t, second.Commit())
+	require.Len(t, hook.calls, 4)
+
+	// resync incrementally, from an edit time already indexed
+	third, _, err := cache.NewBug("third", "message")
+	require.NoError(t, err)
+	excerpt, err := cache.ResolveBugExcerpt(third.Id())
+	require.NoError(t, err)
+
+	resync := &recordingHook{}
+	cache.RegisterExcerptHook(resync, excerpt.EditLamportTime-1)
+	require.Equal(t, []string{"updated third"}, resync.calls)
+}
+
+func TestBuildProgress(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	i, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	for j := 0; j < 5; j++ {
+		_, _, err := backend.NewBugRaw(i, time.Now().Unix(), "title", "message", nil, nil)
+		require.NoError(t, err)
+	}
+
+	progress := backend.BuildProgress()
+	require.NoError(t, backend.Rebuild())
+
+	var events []BuildEvent
+	for event := range progress {
+		events = append(events, event)
+	}
+
+	require.Len(t, events, 10)
+	require.Equal(t, BuildEvent{Typename: "identity", Event: BuildEventStarted, Total: 1}, events[0])
+	require.Equal(t, BuildEvent{Typename: "identity", Event: BuildEventProgress, Done: 1, Total: 1}, events[1])
+	require.Equal(t, BuildEvent{Typename: "identity", Event: BuildEventFinished, Done: 1, Total: 1}, events[2])
+	require.Equal(t, BuildEvent{Typename: "bug", Event: BuildEventStarted, Total: 5}, events[3])
+	for j := 1; j <= 5; j++ {
+		require.Equal(t, BuildEvent{Typename: "bug", Event: BuildEventProgress, Done: j, Total: 5}, events[3+j])
+	}
+	require.Equal(t, BuildEvent{Typename: "bug", Event: BuildEventFinished, Done: 5, Total: 5}, events[9])
+
+	require.Len(t, backend.AllBugsIds(), 5)
+}
+
+func TestBatch(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	i, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	endBatch := backend.StartBatch(10, 5)
+
+	for j := 0; j < 25; j++ {
+		_, _, err := backend.NewBugRaw(i, time.Now().Unix(), "title", "message", nil, nil)
+		require.NoError(t, err)
+
+		loaded, _ := backend.LoadedCount()
+		require.LessOrEqual(t, loaded, 5)
+	}
+
+	// the cache file is written every 10 updates
+	excerpts, err := readBugCache(repo.LocalStorage())
+	require.NoError(t, err)
+	require.Len(t, excerpts, 20)
+
+	require.NoError(t, endBatch())
+	require.Equal(t, defaultMaxLoadedBugs, backend.maxLoadedBugs)
+
+	excerpts, err = readBugCache(repo.LocalStorage())
+	require.NoError(t, err)
+	require.Len(t, excerpts, 25)
+}
+
+func TestReadOnlyFollowWriter(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	writer, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer writer.Close()
+
+	rene, err := writer.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, writer.SetUserIdentity(rene))
+
+	reader, err := NewRepoCacheReadOnly(repo)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	require.Empty(t, reader.AllBugsIds())
+
+	_, _, err = reader.NewBug("title", "message")
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	b, _, err := writer.NewBug("title", "message")
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(reader.AllBugsIds()) == 1
+	}, 5*time.Second, 100*time.Millisecond)
+
+	excerpt, err := reader.ResolveBugExcerpt(b.Id())
+	require.NoError(t, err)
+	require.Equal(t, "title", excerpt.Title)
+}
+
+func TestExternalRefChanges(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	edited, _, err := backend.NewBug("edited", "message")
+	require.NoError(t, err)
+	removed, _, err := backend.NewBug("removed", "message")
+	require.NoError(t, err)
+	untouched, _, err := backend.NewBug("untouched", "message")
+	require.NoError(t, err)
+
+	require.NoError(t, backend.Close())
+
+	// change the bugs behind the back of the cache
+	b, err := bug.Read(repo, edited.Id())
+	require.NoError(t, err)
+	_, err = bug.SetTitle(b, rene.Identity, time.Now().Unix(), "edited outside", nil)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	created, _, err := bug.Create(rene.Identity, time.Now().Unix(), "created outside", "message", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, created.Commit(repo))
+
+	require.NoError(t, bug.Remove(repo, removed.Id()))
+
+	var progress bytes.Buffer
+	backend, err = NewRepoCacheWithOptions(repo, RepoCacheOptions{Progress: &progress})
+	require.NoError(t, err)
+	defer backend.Close()
+
+	// the cache has been updated without a full rebuild
+	require.Empty(t, progress.String())
+
+	require.ElementsMatch(t, []entity.Id{edited.Id(), untouched.Id(), created.Id()}, backend.AllBugsIds())
+
+	excerpt, err := backend.ResolveBugExcerpt(edited.Id())
+	require.NoError(t, err)
+	require.Equal(t, "edited outside", excerpt.Title)
+
+	excerpt, err = backend.ResolveBugExcerpt(created.Id())
+	require.NoError(t, err)
+	require.Equal(t, "created outside", excerpt.Title)
+}
+
+func TestJournalReplay(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	b, _, err := backend.NewBug("title", "message")
+	require.NoError(t, err)
+
+	// the completed mutations are not in the journal anymore
+	_, err = repo.LocalStorage().Stat(journalFile)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	require.NoError(t, backend.Close())
+
+	// interrupt the edition of the identity and the removal of the bug after
+	// their commit in git, before the cache files are written
+	i, err := identity.ReadLocal(repo, rene.Id())
+	require.NoError(t, err)
+	require.NoError(t, i.Mutate(repo, func(orig *identity.Mutator) {
+		orig.Name = "Descartes"
+	}))
+	require.NoError(t, i.Commit(repo))
+	require.NoError(t, bug.Remove(repo, b.Id()))
+
+	f, err := repo.LocalStorage().Create(journalFile)
+	require.NoError(t, err)
+	_, err = fmt.Fprintf(f, "identity %s\nbug %s\nbug trunc", rene.Id(), b.Id())
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	var progress bytes.Buffer
+	backend, err = NewRepoCacheWithOptions(repo, RepoCacheOptions{Progress: &progress})
+	require.NoError(t, err)
+	defer backend.Close()
+
+	// the interrupted mutations converged without a full rebuild
+	require.Empty(t, progress.String())
+
+	excerpt, err := backend.ResolveIdentityExcerpt(rene.Id())
+	require.NoError(t, err)
+	require.Equal(t, "Descartes", excerpt.Name)
+
+	require.Empty(t, backend.AllBugsIds())
+
+	_, err = repo.LocalStorage().Stat(journalFile)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestResolveMatcher(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+	rene2, err := backend.NewIdentity("René", "rene@descartes.fr")
+	require.NoError(t, err)
+	_, err = backend.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+
+	unique, _, err := backend.NewBug("unique", "message")
+	require.NoError(t, err)
+	dup1, _, err := backend.NewBug("duplicate", "message")
+	require.NoError(t, err)
+	dup2, _, err := backend.NewBug("duplicate", "message")
+	require.NoError(t, err)
+
+	titled := func(title string) func(*BugExcerpt) bool {
+		return func(excerpt *BugExcerpt) bool { return excerpt.Title == title }
+	}
+
+	b, err := backend.ResolveBugMatcher(titled("unique"))
+	require.NoError(t, err)
+	require.Equal(t, unique.Id(), b.Id())
+
+	_, err = backend.ResolveBugExcerptMatcher(titled("missing"))
+	require.ErrorIs(t, err, bug.ErrBugNotExist)
+
+	// the candidates are listed, sorted
+	_, err = backend.ResolveBugMatcher(titled("duplicate"))
+	var multiple *entity.ErrMultipleMatch
+	require.ErrorAs(t, err, &multiple)
+	expected := []entity.Id{dup1.Id(), dup2.Id()}
+	sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+	require.Equal(t, expected, multiple.Matching)
+
+	i, err := backend.ResolveIdentityMatcher(func(excerpt *IdentityExcerpt) bool {
+		return excerpt.Name == "Isaac Newton"
+	})
+	require.NoError(t, err)
+	require.Equal(t, "isaac@newton.uk", i.Email())
+
+	_, err = backend.ResolveIdentityExcerptMatcher(func(excerpt *IdentityExcerpt) bool {
+		return strings.HasPrefix(excerpt.Name, "René")
+	})
+	require.ErrorAs(t, err, &multiple)
+	expected = []entity.Id{rene.Id(), rene2.Id()}
+	sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+	require.Equal(t, expected, multiple.Matching)
+}
+
+func TestResolveIdentityImmutableMetadata(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentityRaw("René Descartes", "rene@descartes.fr", "", "", nil, map[string]string{"github-login": "rene"})
+	require.NoError(t, err)
+	isaac, err := backend.NewIdentityRaw("Isaac Newton", "isaac@newton.uk", "", "", nil, map[string]string{"github-login": "isaac"})
+	require.NoError(t, err)
+	_, err = backend.NewIdentityRaw("Isaac", "isaac@newton.uk", "", "", nil, map[string]string{"gitlab-login": "isaac"})
+	require.NoError(t, err)
+
+	i, err := backend.ResolveIdentityImmutableMetadata("github-login", "rene")
+	require.NoError(t, err)
+	require.Equal(t, rene.Id(), i.Id())
+
+	_, err = backend.ResolveIdentityImmutableMetadata("github-login", "missing")
+	require.ErrorIs(t, err, identity.ErrIdentityNotExist)
+
+	resolved, err := backend.ResolveIdentitiesImmutableMetadata("github-login", []string{"rene", "isaac", "missing", "rene"})
+	require.NoError(t, err)
+	require.Len(t, resolved, 2)
+	require.Equal(t, rene.Id(), resolved["rene"].Id())
+	require.Equal(t, isaac.Id(), resolved["isaac"].Id())
+
+	// a second identity with the same login
+	_, err = backend.NewIdentityRaw("René", "rene@descartes.fr", "", "", nil, map[string]string{"github-login": "rene"})
+	require.NoError(t, err)
+
+	_, err = backend.ResolveIdentityImmutableMetadata("github-login", "rene")
+	var multiple *entity.ErrMultipleMatch
+	require.ErrorAs(t, err, &multiple)
+	_, err = backend.ResolveIdentitiesImmutableMetadata("github-login", []string{"isaac", "rene"})
+	require.ErrorAs(t, err, &multiple)
+
+	// the index is built again when reading the cache file
+	require.NoError(t, backend.Close())
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	i, err = backend.ResolveIdentityImmutableMetadata("github-login", "isaac")
+	require.NoError(t, err)
+	require.Equal(t, isaac.Id(), i.Id())
+
+	require.NoError(t, backend.RemoveIdentity(isaac.Id().String()))
+	_, err = backend.ResolveIdentityImmutableMetadata("github-login", "isaac")
+	require.ErrorIs(t, err, identity.ErrIdentityNotExist)
+}
+
+func TestRelatedTrackers(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+	libRepo := repository.CreateGoGitTestRepo(t, false)
+
+	// the working trees of the test repositories
+	worktree := filepath.Dir(filepath.Dir(repo.LocalStorage().Root()))
+	libWorktree := filepath.Dir(filepath.Dir(libRepo.LocalStorage().Root()))
+
+	rel, err := filepath.Rel(worktree, libWorktree)
+	require.NoError(t, err)
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.tracker.lib.path", rel))
+
+	trackers, err := RelatedTrackers(repo)
+	require.NoError(t, err)
+	require.Equal(t, []RelatedTracker{{Name: "lib", Path: libWorktree}}, trackers)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+	local, _, err := backend.NewBug("local", "message")
+	require.NoError(t, err)
+
+	// the lib tracker is kept locked, to be opened read-only
+	libBackend, err := NewRepoCache(libRepo)
+	require.NoError(t, err)
+	defer libBackend.Close()
+	isaac, err := libBackend.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+	require.NoError(t, libBackend.SetUserIdentity(isaac))
+	lib, _, err := libBackend.NewBug("lib", "message")
+	require.NoError(t, err)
+
+	mrc := NewMultiRepoCache()
+	mrc.AddDefaultRepoCache(backend)
+	err = mrc.RegisterRelatedTrackers(repo, func(path string) (repository.ClockedRepo, error) {
+		require.Equal(t, libWorktree, path)
+		return libRepo, nil
+	})
+	require.NoError(t, err)
+
+	defaultRepo, err := mrc.DefaultRepo()
+	require.NoError(t, err)
+	require.Same(t, backend, defaultRepo)
+
+	q := query.NewQuery()
+	q.OrderBy = query.OrderById
+	q.OrderDirection = query.OrderAscending
+	excerpts, err := mrc.QueryBugs(q)
+	require.NoError(t, err)
+	require.Len(t, excerpts, 2)
+	require.Equal(t, local.Id().String(), excerpts[0].NamespacedId())
+	require.Equal(t, "lib/"+lib.Id().String(), excerpts[1].NamespacedId())
+	require.Equal(t, "lib/"+lib.Id().Human(), excerpts[1].NamespacedHumanId())
+
+	libCache, err := mrc.ResolveRepo("lib")
+	require.NoError(t, err)
+	require.Equal(t, "lib", libCache.Name())
+
+	// the default repository is left open for its owner
+	require.NoError(t, mrc.Close())
+	_, err = backend.ResolveBugExcerpt(local.Id())
+	require.NoError(t, err)
+}
+
+func TestMultiRepoCacheByPath(t *testing.T) {
+	repos := map[string]repository.ClockedRepo{
+		filepath.Join(t.TempDir(), "project"): repository.CreateGoGitTestRepo(t, false),
+		filepath.Join(t.TempDir(), "project"): repository.CreateGoGitTestRepo(t, false),
+	}
+	open := func(path string) (repository.ClockedRepo, error) {
+		return repos[path], nil
+	}
+
+	mrc := NewMultiRepoCache()
+	defer mrc.Close()
+
+	var paths []string
+	for path := range repos {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var bugs []*BugCache
+	for _, path := range paths {
+		r, err := mrc.RegisterRepositoryPath(path, open)
+		require.NoError(t, err)
+
+		rene, err := r.NewIdentity("René Descartes", "rene@descartes.fr")
+		require.NoError(t, err)
+		require.NoError(t, r.SetUserIdentity(rene))
+		b, _, err := r.NewBug("title", "message")
+		require.NoError(t, err)
+		bugs = append(bugs, b)
+	}
+
+	// the directories have the same name
+	require.Equal(t, []string{"project", "project-2"}, mrc.RepoNames())
+
+	r, err := mrc.ResolveRepoPath(paths[1])
+	require.NoError(t, err)
+	require.Equal(t, "project-2", r.Name())
+	again, err := mrc.RegisterRepositoryPath(paths[1], open)
+	require.NoError(t, err)
+	require.Same(t, r, again)
+	_, err = mrc.ResolveRepoPath(t.TempDir())
+	require.ErrorIs(t, err, ErrUnknownRepo)
+
+	// a bare prefix is looked up in every repository
+	excerpt, err := mrc.ResolveBugExcerptPrefix(bugs[1].Id().String())
+	require.NoError(t, err)
+	require.Equal(t, "project-2/"+bugs[1].Id().String(), excerpt.NamespacedId())
+
+	_, err = mrc.ResolveBugExcerptPrefix("")
+	var multiple *ErrMultipleRepoMatch
+	require.ErrorAs(t, err, &multiple)
+	require.ElementsMatch(t, []string{
+		"project/" + bugs[0].Id().String(),
+		"project-2/" + bugs[1].Id().String(),
+	}, multiple.Matching)
+	require.True(t, entity.IsErrMultipleMatch(err))
+
+	// a namespaced prefix only in its repository
+	r, b, err := mrc.ResolveBugPrefix("project/")
+	require.NoError(t, err)
+	require.Equal(t, "project", r.Name())
+	require.Equal(t, bugs[0].Id(), b.Id())
+
+	_, err = mrc.ResolveBugExcerptPrefix("project-2/" + bugs[0].Id().String())
+	require.ErrorIs(t, err, bug.ErrBugNotExist)
+	_, err = mrc.ResolveBugExcerptPrefix("unknown/" + bugs[0].Id().String())
+	require.ErrorIs(t, err, ErrUnknownRepo)
+}
+
+func TestWorkspace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "git-bug", "workspace")
+
+	w, err := LoadWorkspace(path)
+	require.NoError(t, err)
+	require.Empty(t, w.Repos)
+
+	project := filepath.Join(t.TempDir(), "project")
+	lib := filepath.Join(t.TempDir(), "lib")
+	for _, p := range []string{project, lib, project} {
+		_, err = w.Add(p)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{project, lib}, w.Repos)
+	require.NoError(t, w.Save())
+
+	w, err = LoadWorkspace(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{project, lib}, w.Repos)
+
+	removed, err := w.Remove(project)
+	require.NoError(t, err)
+	require.True(t, removed)
+	removed, err = w.Remove(project)
+	require.NoError(t, err)
+	require.False(t, removed)
+	require.Equal(t, []string{lib}, w.Repos)
+
+	require.NoError(t, os.WriteFile(path, []byte("# comment\n\nrelative/path\n"), 0644))
+	_, err = LoadWorkspace(path)
+	require.Error(t, err)
+}
+
+func TestMultiRepoCacheWorkspace(t *testing.T) {
+	project := filepath.Join(t.TempDir(), "project")
+	lib := filepath.Join(t.TempDir(), "lib")
+	repos := map[string]repository.ClockedRepo{
+		project: repository.CreateGoGitTestRepo(t, false),
+		lib:     repository.CreateGoGitTestRepo(t, false),
+	}
+	open := func(path string) (repository.ClockedRepo, error) {
+		return repos[path], nil
+	}
+
+	// the lib is used by another process
+	libCache, err := NewRepoCache(repos[lib])
+	require.NoError(t, err)
+	rene, err := libCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, libCache.SetUserIdentity(rene))
+	libBug, _, err := libCache.NewBug("lib", "message")
+	require.NoError(t, err)
+	defer libCache.Close()
+
+	w, err := LoadWorkspace(filepath.Join(t.TempDir(), "workspace"))
+	require.NoError(t, err)
+	_, err = w.Add(project)
+	require.NoError(t, err)
+	_, err = w.Add(lib)
+	require.NoError(t, err)
+
+	mrc := NewMultiRepoCache()
+	defer mrc.Close()
+	require.NoError(t, mrc.RegisterWorkspace(w, open))
+	require.Equal(t, []string{"lib", "project"}, mrc.RepoNames())
+
+	excerpts, err := mrc.QueryBugs(query.NewQuery())
+	require.NoError(t, err)
+	require.Len(t, excerpts, 1)
+	require.Equal(t, "lib/"+libBug.Id().String(), excerpts[0].NamespacedId())
+
+	r, err := mrc.ResolveRepo("lib")
+	require.NoError(t, err)
+	require.True(t, r.readOnly)
+}
+
+func TestReadState(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	read, _, err := backend.NewBug("read", "message")
+	require.NoError(t, err)
+	neverRead, _, err := backend.NewBug("never read", "message")
+	require.NoError(t, err)
+
+	unreadQuery, err := query.Parse("is:unread sort:id")
+	require.NoError(t, err)
+
+	unread, err := backend.IsUnread(read.Id())
+	require.NoError(t, err)
+	require.True(t, unread)
+
+	require.NoError(t, backend.MarkAsRead(read.Id()))
+	unread, err = backend.IsUnread(read.Id())
+	require.NoError(t, err)
+	require.False(t, unread)
+
+	ids, err := backend.QueryBugs(unreadQuery)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{neverRead.Id()}, ids)
+
+	// a new edition make the bug unread again
+	_, _, err = read.AddComment("new activity")
+	require.NoError(t, err)
+	require.NoError(t, read.Commit())
+	unread, err = backend.IsUnread(read.Id())
+	require.NoError(t, err)
+	require.True(t, unread)
+
+	require.NoError(t, backend.MarkAsRead(read.Id()))
+	require.NoError(t, backend.Close())
+
+	// the read state is kept on disk
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	ids, err = backend.QueryBugs(unreadQuery)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{neverRead.Id()}, ids)
+
+	explanation, err := backend.ExplainQuery(unreadQuery, read.Id())
+	require.NoError(t, err)
+	require.False(t, explanation.Matched)
+}
+
+func TestMergeIdentitiesWithoutUser(t *testing.T) {
+	repoA, repoB, _ := repository.SetupGoGitReposAndRemote(t)
+
+	cacheA, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+	defer cacheA.Close()
+
+	cacheB, err := NewRepoCache(repoB)
+	require.NoError(t, err)
+	defer cacheB.Close()
+
+	reneA, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cacheA.SetUserIdentity(reneA))
+	_, _, err = cacheA.NewBug("bug1", "message")
+	require.NoError(t, err)
+
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	_, err = cacheB.Fetch("origin")
+	require.NoError(t, err)
+
+	// without a user identity, the bugs can't be merged
+	for result := range cacheB.MergeAll("origin") {
+		require.Error(t, result.Err)
+	}
+
+	for result := range cacheB.MergeIdentities("origin") {
+		require.NoError(t, result.Err)
+	}
+	require.Equal(t, []entity.Id{reneA.Id()}, cacheB.AllIdentityIds())
+	require.Empty(t, cacheB.AllBugsIds())
+
+	// the merged identity can then be adopted to merge the bugs
+	reneB, err := cacheB.ResolveIdentity(reneA.Id())
+	require.NoError(t, err)
+	require.NoError(t, cacheB.SetUserIdentity(reneB))
+	for result := range cacheB.MergeAll("origin") {
+		require.NoError(t, result.Err)
+	}
+	require.Len(t, cacheB.AllBugsIds(), 1)
+}
+
+func TestCacheMigration(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	bug1, _, err := backend.NewBug("bug1", "message")
+	require.NoError(t, err)
+	_, _, err = bug1.AddCommentRaw(rene, time.Now().Unix()+100, "comment", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit())
+
+	require.NoError(t, backend.Close())
+
+	// rewrite the cache files as they were in the format version 7
+	writeOldCacheFiles := func(version uint) {
+		data, err := readBugCacheFile(repo.LocalStorage())
+		require.NoError(t, err)
+		identities, err := readIdentityCache(repo.LocalStorage())
+		require.NoError(t, err)
+		for _, excerpt := range identities {
+			// not recorded before the version 12
+			excerpt.Email = ""
+		}
+
+		f, err := repo.LocalStorage().Create(bugCacheFile)
+		require.NoError(t, err)
+		require.NoError(t, gob.NewEncoder(f).Encode(bugCacheData{Version: version, Excerpts: data.Excerpts}))
+		require.NoError(t, f.Close())
+
+		f, err = repo.LocalStorage().Create(identityCacheFile)
+		require.NoError(t, err)
+		require.NoError(t, gob.NewEncoder(f).Encode(struct {
+			Version  uint
+			Excerpts map[entity.Id]*IdentityExcerpt
+		}{Version: version, Excerpts: identities}))
+		require.NoError(t, f.Close())
+	}
+
+	writeOldCacheFiles(7)
+
+	var progress bytes.Buffer
+	backend, err = NewRepoCacheWithOptions(repo, RepoCacheOptions{Progress: &progress})
+	require.NoError(t, err)
+
+	// the cache has been migrated without a rebuild
+	require.Empty(t, progress.String())
+	require.Equal(t, []entity.Id{bug1.Id()}, backend.AllBugsIds())
+	require.Equal(t, []entity.Id{rene.Id()}, backend.AllIdentityIds())
+	require.NoError(t, backend.Close())
+
+	// and written in the current format
+	data, err := readBugCacheFile(repo.LocalStorage())
+	require.NoError(t, err)
+	require.Contains(t, data.Tips, bug1.Id())
+	require.Equal(t, data.Excerpts[bug1.Id()].CreateUnixTime+100, data.Excerpts[bug1.Id()].LastCommentUnixTime)
+	identities, err := readIdentityCache(repo.LocalStorage())
+	require.NoError(t, err)
+	require.Equal(t, "rene@descartes.fr", identities[rene.Id()].Email)
+
+	// a version without a migration is rebuilt
+	writeOldCacheFiles(3)
+
+	progress.Reset()
+	backend, err = NewRepoCacheWithOptions(repo, RepoCacheOptions{Progress: &progress})
+	require.NoError(t, err)
+	defer backend.Close()
+
+	require.Contains(t, progress.String(), "Building")
+	require.Equal(t, []entity.Id{bug1.Id()}, backend.AllBugsIds())
+}
+
+func TestCacheFileChecksum(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	bug1, _, err := backend.NewBug("bug1", "message")
+	require.NoError(t, err)
+
+	require.NoError(t, backend.Close())
+
+	// the files are replaced at once, nothing is left behind
+	_, err = repo.LocalStorage().Stat(bugCacheFile + ".tmp")
+	require.True(t, os.IsNotExist(err))
+
+	corrupt := func(name string, change func([]byte) []byte) {
+		f, err := repo.LocalStorage().Open(name)
+		require.NoError(t, err)
+		var content bytes.Buffer
+		_, err = content.ReadFrom(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		f, err = repo.LocalStorage().Create(name)
+		require.NoError(t, err)
+		_, err = f.Write(change(content.Bytes()))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+
+	for _, change := range []func([]byte) []byte{
+		// a flipped bit
+		func(content []byte) []byte {
+			content[len(cacheFileMagic)+1] ^= 1
+			return content
+		},
+		// a truncated file
+		func(content []byte) []byte {
+			return content[:len(content)/2]
+		},
+	} {
+		corrupt(bugCacheFile, change)
+
+		_, err = readBugCache(repo.LocalStorage())
+		require.ErrorIs(t, err, errCacheChecksum)
+
+		// the corrupted cache is rebuilt
+		var progress bytes.Buffer
+		backend, err = NewRepoCacheWithOptions(repo, RepoCacheOptions{Progress: &progress})
+		require.NoError(t, err)
+		require.Contains(t, progress.String(), "Building")
+		require.Equal(t, []entity.Id{bug1.Id()}, backend.AllBugsIds())
+		require.NoError(t, backend.Close())
+	}
+
+	corrupt(identityCacheFile, func(content []byte) []byte {
+		return content[:len(content)-1]
+	})
+	_, err = readIdentityCache(repo.LocalStorage())
+	require.ErrorIs(t, err, errCacheChecksum)
+}
+
+func TestLabelRegistry(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	// without registry entry, the color is derived from the name
+	require.Equal(t, bug.Label("bug").Color(), backend.LabelColor("bug"))
+	info, err := backend.LabelInfo("bug")
+	require.NoError(t, err)
+	require.Equal(t, LabelInfo{}, info)
+
+	require.Error(t, backend.SetLabelInfo("bug", LabelInfo{Color: "red"}))
+
+	err = backend.SetLabelInfo("bug", LabelInfo{Color: "D73A4A", Description: "Something isn't working"})
+	require.NoError(t, err)
+	err = backend.SetLabelInfo("wontfix", LabelInfo{Color: "#ffffff"})
+	require.NoError(t, err)
+	require.Equal(t, bug.LabelColor{R: 215, G: 58, B: 74, A: 255}, backend.LabelColor("bug"))
+
+	// an empty info remove the label from the registry
+	require.NoError(t, backend.SetLabelInfo("wontfix", LabelInfo{}))
+
+	require.NoError(t, backend.Close())
+
+	// the registry is kept on disk
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	info, err = backend.LabelInfo("bug")
+	require.NoError(t, err)
+	require.Equal(t, LabelInfo{Color: "#d73a4a", Description: "Something isn't working"}, info)
+	require.Equal(t, bug.Label("wontfix").Color(), backend.LabelColor("wontfix"))
+}
+
+func TestFreeze(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+	isaac, err := backend.NewIdentityFull("Isaac Newton", "isaac@newton.uk", "isaac", "", nil)
+	require.NoError(t, err)
+
+	freeze, err := backend.Freeze()
+	require.NoError(t, err)
+	require.Equal(t, Freeze{Labels: []bug.Label{"release-blocker"}}, freeze)
+
+	blocker, _, err := backend.NewBug("blocker", "message")
+	require.NoError(t, err)
+	_, _, err = blocker.ChangeLabels([]string{"release-blocker"}, nil)
+	require.NoError(t, err)
+	other, _, err := backend.NewBug("other", "message")
+	require.NoError(t, err)
+
+	// not frozen yet
+	metadata, err := blocker.FreezeMetadata(rene, false)
+	require.NoError(t, err)
+	require.Nil(t, metadata)
+
+	require.NoError(t, backend.SetFreeze(Freeze{Active: true, Labels: []bug.Label{"release-blocker"}}))
+
+	_, err = blocker.Close()
+	require.True(t, IsErrFrozen(err))
+	_, err = other.Close()
+	require.NoError(t, err)
+
+	op, err := blocker.CloseConfirmed()
+	require.NoError(t, err)
+	confirmed, ok := op.GetMetadata(MetaKeyFreezeConfirmed)
+	require.True(t, ok)
+	require.Equal(t, "release-blocker", confirmed)
+	_, err = blocker.Open()
+	require.NoError(t, err)
+
+	// with approvers, only they can confirm
+	require.NoError(t, backend.SetFreeze(Freeze{Active: true, Labels: []bug.Label{"release-blocker"}, Approvers: []string{"isaac"}}))
+
+	_, err = blocker.CloseConfirmed()
+	require.True(t, IsErrFrozen(err))
+	_, err = blocker.FreezeMetadata(isaac, false)
+	require.True(t, IsErrFrozen(err))
+	metadata, err = blocker.FreezeMetadata(isaac, true)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{MetaKeyFreezeConfirmed: "release-blocker"}, metadata)
+
+	freeze, err = backend.Freeze()
+	require.NoError(t, err)
+	require.Equal(t, []string{"isaac"}, freeze.Approvers)
+
+	// unfrozen, without approvers
+	require.NoError(t, backend.SetFreeze(Freeze{}))
+	_, err = blocker.Close()
+	require.NoError(t, err)
+
+	freeze, err = backend.Freeze()
+	require.NoError(t, err)
+	require.Equal(t, Freeze{Labels: []bug.Label{"release-blocker"}}, freeze)
+}
+
+func TestStalePolicy(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	policy, err := backend.StalePolicy()
+	require.NoError(t, err)
+	require.False(t, policy.Enabled())
+
+	config := repo.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.stale.days", "30"))
+	require.NoError(t, config.StoreString("git-bug.stale.close-days", "7"))
+	require.NoError(t, config.StoreString("git-bug.stale.message", "stale"))
+	require.NoError(t, config.StoreString("git-bug.stale.exempt-labels", "pinned, security"))
+
+	policy, err = backend.StalePolicy()
+	require.NoError(t, err)
+	require.Equal(t, StalePolicy{
+		Days:         30,
+		CloseDays:    7,
+		Label:        "stale",
+		Message:      "stale",
+		ExemptLabels: []bug.Label{"pinned", "security"},
+	}, policy)
+
+	now := time.Now()
+	old := now.AddDate(0, 0, -60).Unix()
+
+	inactive, _, err := backend.NewBugRaw(rene, old, "inactive", "message", nil, nil)
+	require.NoError(t, err)
+	revived, _, err := backend.NewBugRaw(rene, old, "revived", "message", nil, nil)
+	require.NoError(t, err)
+	pinned, _, err := backend.NewBugRaw(rene, old, "pinned", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = pinned.ForceChangeLabelsRaw(rene, old, []string{"pinned"}, nil, nil)
+	require.NoError(t, err)
+	closed, _, err := backend.NewBugRaw(rene, old, "closed", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = closed.CloseRaw(rene, old, nil)
+	require.NoError(t, err)
+	_, _, err = backend.NewBug("fresh", "message")
+	require.NoError(t, err)
+	require.NoError(t, pinned.CommitAsNeeded())
+	require.NoError(t, closed.CommitAsNeeded())
+
+	sortResults := func(results []StaleResult) []StaleResult {
+		sort.Slice(results, func(i, j int) bool {
+			return results[i].Id < results[j].Id
+		})
+		return results
+	}
+
+	results, err := backend.ApplyStalePolicy(policy, now)
+	require.NoError(t, err)
+	require.Equal(t, sortResults([]StaleResult{
+		{Id: inactive.Id(), Action: StaleMarked},
+		{Id: revived.Id(), Action: StaleMarked},
+	}), results)
+
+	snap := inactive.Snapshot()
+	require.Equal(t, []bug.Label{"stale"}, snap.Labels)
+	require.Equal(t, "stale", snap.Comments[1].Message)
+	mark, ok := snap.Operations[len(snap.Operations)-1].GetMetadata(MetaKeyStale)
+	require.True(t, ok)
+	require.Equal(t, "mark", mark)
+
+	// the marking is not an activity
+	results, err = backend.ApplyStalePolicy(policy, now)
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	_, _, err = revived.AddComment("still there")
+	require.NoError(t, err)
+
+	results, err = backend.ApplyStalePolicy(policy, now)
+	require.NoError(t, err)
+	require.Equal(t, []StaleResult{{Id: revived.Id(), Action: StaleUnmarked}}, results)
+	require.Empty(t, revived.Snapshot().Labels)
+
+	results, err = backend.ApplyStalePolicy(policy, now.AddDate(0, 0, 8))
+	require.NoError(t, err)
+	require.Equal(t, []StaleResult{{Id: inactive.Id(), Action: StaleClosed}}, results)
+	require.Equal(t, common.ClosedStatus, inactive.Snapshot().Status)
+	require.Equal(t, common.OpenStatus, pinned.Snapshot().Status)
+}
+
+func TestQueryBugsSortByKeys(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	config := repo.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.field.priority.type", "enum"))
+	require.NoError(t, config.StoreString("git-bug.field.priority.values", "low,medium,high"))
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+
+	newBug := func(title string, unixTime int64, priority string) *BugCache {
+		b, _, err := backend.NewBugRaw(rene, unixTime, title, "message", nil, nil)
+		require.NoError(t, err)
+		if priority != "" {
+			_, err = b.SetFieldRaw(rene, unixTime, "priority", priority, nil)
+			require.NoError(t, err)
+			require.NoError(t, b.Commit())
+		}
+		return b
+	}
+
+	high1 := newBug("high 1", now, "high")
+	low := newBug("low", now+1, "low")
+	high2 := newBug("high 2", now+2, "high")
+	none := newBug("none", now+3, "")
+
+	queryIds := func(q string) []entity.Id {
+		parsed, err := query.Parse(q)
+		require.NoError(t, err)
+		ids, err := backend.QueryBugs(parsed)
+		require.NoError(t, err)
+		return ids
+	}
+
+	// the bugs without priority come last in both directions
+	require.Equal(t, []entity.Id{high1.Id(), high2.Id(), low.Id(), none.Id()},
+		queryIds("sort:-field.priority,creation-asc"))
+	require.Equal(t, []entity.Id{low.Id(), high2.Id(), high1.Id(), none.Id()},
+		queryIds("sort:field.priority,-creation"))
+
+	parsed, err := query.Parse("sort:field.size")
+	require.NoError(t, err)
+	_, err = backend.QueryBugs(parsed)
+	require.Error(t, err)
+}
+
+func TestQueryBugsPage(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	for i := 0; i < 5; i++ {
+		_, _, err := backend.NewBugRaw(rene, now+int64(i), fmt.Sprintf("bug %d", i), "message", nil, nil)
+		require.NoError(t, err)
+	}
+
+	q, err := query.Parse("sort:creation-asc")
+	require.NoError(t, err)
+
+	all, err := backend.QueryBugs(q)
+	require.NoError(t, err)
+	require.Len(t, all, 5)
+
+	page, err := backend.QueryBugsPage(q, BugPageRequest{Limit: 2, Offset: 1})
+	require.NoError(t, err)
+	require.Equal(t, all[1:3], page.Ids)
+	require.Equal(t, 1, page.Offset)
+	require.Equal(t, 5, page.TotalCount)
+	require.NotEmpty(t, page.Cursor)
+
+	// a new bug doesn't shift the next pages of the query
+	_, _, err = backend.NewBugRaw(rene, now-10, "older bug", "message", nil, nil)
+	require.NoError(t, err)
+
+	next, err := backend.QueryBugsPage(q, BugPageRequest{Limit: 2, Cursor: page.Cursor})
+	require.NoError(t, err)
+	require.Equal(t, all[3:5], next.Ids)
+	require.Equal(t, 3, next.Offset)
+	require.Equal(t, 5, next.TotalCount)
+	require.Empty(t, next.Cursor)
+
+	// without cursor, the query is run again
+	fresh, err := backend.QueryBugsPage(q, BugPageRequest{})
+	require.NoError(t, err)
+	require.Len(t, fresh.Ids, 6)
+	require.Equal(t, 6, fresh.TotalCount)
+	require.Empty(t, fresh.Cursor)
+
+	beyond, err := backend.QueryBugsPage(q, BugPageRequest{Limit: 2, Offset: 10})
+	require.NoError(t, err)
+	require.Empty(t, beyond.Ids)
+	require.Equal(t, 6, beyond.TotalCount)
+
+	_, err = backend.QueryBugsPage(q, BugPageRequest{Cursor: "not a cursor"})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestQueryIdentities(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	isaac, err := backend.NewIdentityFull("Isaac Newton", "isaac@newton.uk", "inewton", "", nil)
+	require.NoError(t, err)
+	blaise, err := backend.NewIdentity("Blaise Pascal", "blaise@pascal.fr")
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	_, _, err = backend.NewBugRaw(rene, now, "old", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = backend.NewBugRaw(isaac, now+10, "recent", "message", nil, nil)
+	require.NoError(t, err)
+
+	queryIds := func(q string) []entity.Id {
+		parsed, err := query.ParseIdentity(q)
+		require.NoError(t, err)
+		ids, err := backend.QueryIdentities(parsed)
+		require.NoError(t, err)
+		return ids
+	}
+
+	require.Equal(t, []entity.Id{blaise.Id(), isaac.Id(), rene.Id()}, queryIds(""))
+	require.Equal(t, []entity.Id{rene.Id(), isaac.Id(), blaise.Id()}, queryIds("sort:name-desc"))
+	require.Equal(t, []entity.Id{blaise.Id(), rene.Id()}, queryIds("email:.FR"))
+	require.Equal(t, []entity.Id{isaac.Id()}, queryIds("login:newt"))
+	require.Equal(t, []entity.Id{rene.Id()}, queryIds("name:rené name:nobody"))
+	require.Equal(t, []entity.Id{isaac.Id()}, queryIds("newton"))
+	require.Empty(t, queryIds("email:fr name:isaac"))
+
+	// the identities without activity come last
+	require.Equal(t, []entity.Id{isaac.Id(), rene.Id(), blaise.Id()}, queryIds("sort:activity"))
+	require.Equal(t, time.Unix(now+10, 0), backend.IdentityLastActivity(isaac.Id()))
+	require.True(t, backend.IdentityLastActivity(blaise.Id()).IsZero())
+}
+
+func TestMergeBugInto(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	duplicate, _, err := backend.NewBug("crash on start", "it crashes\n\nevery time")
+	require.NoError(t, err)
+	_, _, err = duplicate.AddComment("me too")
+	require.NoError(t, err)
+	require.NoError(t, duplicate.Commit())
+
+	canonical, _, err := backend.NewBug("crash when starting", "message")
+	require.NoError(t, err)
+
+	require.Error(t, backend.MergeBugInto(duplicate, duplicate, false))
+
+	require.NoError(t, backend.MergeBugInto(duplicate, canonical, false))
+
+	snap := canonical.Snapshot()
+	require.Len(t, snap.Comments, 3)
+	require.True(t, strings.HasSuffix(snap.Comments[1].Message, "\n\n> it crashes\n>\n> every time"))
+	require.True(t, strings.HasPrefix(snap.Comments[2].Message, "René Descartes wrote in "+duplicate.Id().Human()))
+	require.True(t, strings.HasSuffix(snap.Comments[2].Message, "\n\n> me too"))
+	mergedFrom, ok := snap.Operations[1].GetMetadata(MetaKeyMergedFrom)
+	require.True(t, ok)
+	require.Equal(t, duplicate.Snapshot().Comments[0].CombinedId().String(), mergedFrom)
+
+	snap = duplicate.Snapshot()
+	require.Equal(t, common.ClosedStatus, snap.Status)
+	require.Equal(t, []bug.Label{"duplicate"}, snap.Labels)
+	require.Equal(t, canonical.Id(), DuplicateOf(snap))
+
+	excerpt, err := backend.ResolveBugExcerpt(duplicate.Id())
+	require.NoError(t, err)
+	require.Equal(t, canonical.Id(), excerpt.DuplicateOf)
+
+	// already a duplicate
+	require.Error(t, backend.MergeBugInto(duplicate, canonical, false))
+	require.Error(t, backend.MergeBugInto(canonical, duplicate, false))
+
+	require.NoError(t, backend.Close())
+
+	// the excerpt is kept in the cache file
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	excerpt, err = backend.ResolveBugExcerpt(duplicate.Id())
+	require.NoError(t, err)
+	require.Equal(t, canonical.Id(), excerpt.DuplicateOf)
+
+	// reopening clear it
+	duplicate, err = backend.ResolveBug(duplicate.Id())
+	require.NoError(t, err)
+	_, err = duplicate.Open()
+	require.NoError(t, err)
+	require.NoError(t, duplicate.Commit())
+
+	excerpt, err = backend.ResolveBugExcerpt(duplicate.Id())
+	require.NoError(t, err)
+	require.Empty(t, excerpt.DuplicateOf)
+}
+
+func TestRelations(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	blocker, _, err := backend.NewBug("blocker", "message")
+	require.NoError(t, err)
+	blocked, _, err := backend.NewBug("blocked", "message")
+	require.NoError(t, err)
+	other, _, err := backend.NewBug("other", "message")
+	require.NoError(t, err)
+
+	_, err = blocker.AddRelation(bug.RelationBlocks, blocker.Id())
+	require.Error(t, err)
+
+	_, err = blocker.AddRelation(bug.RelationBlocks, blocked.Id())
+	require.NoError(t, err)
+	_, err = other.AddRelation(bug.RelationBlocks, blocked.Id())
+	require.NoError(t, err)
+	_, err = other.AddRelation(bug.RelationRelatedTo, blocker.Id())
+	require.NoError(t, err)
+	require.NoError(t, blocker.Commit())
+	require.NoError(t, other.Commit())
+
+	require.Equal(t, []bug.Relation{{Type: bug.RelationBlocks, Target: blocked.Id()}}, blocker.Snapshot().Relations)
+
+	search := func(q string) []entity.Id {
+		parsed, err := query.Parse(q)
+		require.NoError(t, err)
+		ids, err := backend.QueryBugs(parsed)
+		require.NoError(t, err)
+		return ids
+	}
+
+	require.ElementsMatch(t, []entity.Id{blocker.Id(), other.Id()}, search("blocks:"+blocked.Id().Human()))
+	require.Equal(t, []entity.Id{other.Id()}, search("related-to:"+blocker.Id().Human()))
+	require.Empty(t, search("duplicate-of:"+blocked.Id().Human()))
+
+	blockers := []IncomingRelation{
+		{Type: bug.RelationBlocks, Source: blocker.Id()},
+		{Type: bug.RelationBlocks, Source: other.Id()},
+	}
+	sort.Slice(blockers, func(i, j int) bool { return blockers[i].Source < blockers[j].Source })
+	require.Equal(t, blockers, backend.IncomingRelations(blocked.Id()))
+
+	require.NoError(t, backend.Close())
+
+	// the relations are kept in the cache file
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	require.Equal(t, blockers, backend.IncomingRelations(blocked.Id()))
+
+	blocker, err = backend.ResolveBug(blocker.Id())
+	require.NoError(t, err)
+	_, err = blocker.RemoveRelation(bug.RelationBlocks, blocked.Id())
+	require.NoError(t, err)
+	require.NoError(t, blocker.Commit())
+
+	require.Equal(t, []IncomingRelation{{Type: bug.RelationBlocks, Source: other.Id()}}, backend.IncomingRelations(blocked.Id()))
+	require.Equal(t, []IncomingRelation{{Type: bug.RelationRelatedTo, Source: other.Id()}}, backend.IncomingRelations(blocker.Id()))
+}
+
+func TestBacklinks(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	target, _, err := backend.NewBug("target", "message")
+	require.NoError(t, err)
+	source, _, err := backend.NewBug("source", "same as #"+target.Id().Human())
+	require.NoError(t, err)
+	other, _, err := backend.NewBug("other", "message")
+	require.NoError(t, err)
+
+	// a complete id, a reference to itself and a reference in code
+	commentId, _, err := other.AddComment("caused by #" + target.Id().String() + ", see #" + other.Id().Human() +
+		"\n```\n#" + source.Id().Human() + "\n```")
+	require.NoError(t, err)
+	require.NoError(t, other.Commit())
+
+	expected := []entity.Id{source.Id(), other.Id()}
+	sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+	require.Equal(t, expected, backend.Backlinks(target.Id()))
+	require.Empty(t, backend.Backlinks(source.Id()))
+	require.Empty(t, backend.Backlinks(other.Id()))
+
+	id, ok := backend.RefResolver().ResolveBugRef(target.Id().Human())
+	require.True(t, ok)
+	require.Equal(t, target.Id(), id)
+	_, ok = backend.RefResolver().ResolveBugRef("0000000")
+	require.False(t, ok)
+
+	require.NoError(t, backend.Close())
+
+	// the references are kept in the cache file, and follow the edition of
+	// the messages
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	require.Equal(t, expected, backend.Backlinks(target.Id()))
+
+	other, err = backend.ResolveBug(other.Id())
+	require.NoError(t, err)
+	_, err = other.EditComment(commentId, "unrelated")
+	require.NoError(t, err)
+	require.NoError(t, other.Commit())
+
+	require.Equal(t, []entity.Id{source.Id()}, backend.Backlinks(target.Id()))
+}
+
+func TestDigest(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	since := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	before := since.Add(-time.Hour).Unix()
+	after := since.Add(time.Hour).Unix()
+
+	old, _, err := backend.NewBugRaw(rene, before, "old", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = old.CloseRaw(rene, after, nil)
+	require.NoError(t, err)
+
+	reopened, _, err := backend.NewBugRaw(rene, before, "reopened", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = reopened.CloseRaw(rene, after, nil)
+	require.NoError(t, err)
+	_, err = reopened.OpenRaw(rene, after, nil)
+	require.NoError(t, err)
+
+	created, _, err := backend.NewBugRaw(rene, after, "created", "message", nil, nil)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, _, err = created.AddCommentRaw(rene, after, "comment", nil, nil)
+		require.NoError(t, err)
+	}
+	_, _, err = old.AddCommentRaw(rene, after, "comment", nil, nil)
+	require.NoError(t, err)
+	_, _, err = reopened.AddCommentRaw(rene, before, "comment before", nil, nil)
+	require.NoError(t, err)
+
+	digest := backend.Digest(since, 10)
+	require.False(t, digest.IsEmpty())
+	require.Len(t, digest.Created, 1)
+	require.Equal(t, created.Id(), digest.Created[0].Id)
+	require.Len(t, digest.Closed, 1)
+	require.Equal(t, old.Id(), digest.Closed[0].Id)
+	require.Len(t, digest.Hot, 2)
+	require.Equal(t, created.Id(), digest.Hot[0].Excerpt.Id)
+	require.Equal(t, 3, digest.Hot[0].Comments)
+	require.Equal(t, old.Id(), digest.Hot[1].Excerpt.Id)
+
+	require.Len(t, backend.Digest(since, 1).Hot, 1)
+	require.True(t, backend.Digest(since.Add(2*time.Hour), 10).IsEmpty())
+}
+
+func TestCacheEncoding(t *testing.T) {
+	bugs := &bugCacheData{
+		Version: formatVersion,
+		Excerpts: map[entity.Id]*BugExcerpt{
+			"aaaa": {
+				Id:                "aaaa",
+				CreateLamportTime: 1,
+				EditLamportTime:   5,
+				CreateUnixTime:    1234567890,
+				EditUnixTime:      1234567899,
+				AuthorId:          "bbbb",
+				Status:            common.ClosedStatus,
+				State:             "wontfix",
+				Labels:            []bug.Label{"bug", ""},
+				Title:             "title",
+				LenComments:       3,
+				Actors:            []entity.Id{"bbbb", "cccc"},
+				Participants:      []entity.Id{"bbbb"},
+				AwaitingReporter:  true,
+				Fields:            map[string]string{"priority": "high", "empty": ""},
+				SyncConflict:      true,
+				Confidential:      true,
+				Activity: []ActivityEvent{
+					{Kind: ActivityAuthored, AuthorId: "bbbb", UnixTime: 1234567890},
+					{Kind: ActivityClosed, AuthorId: "cccc", UnixTime: -1},
+				},
+				CreateMetadata: map[string]string{"origin": "github"},
+				Relations: []bug.Relation{
+					{Type: bug.RelationBlocks, Target: "dddd"},
+					{Type: bug.RelationRelatedTo, Target: "eeee"},
+				},
+				References: []string{"dddd123", "eeee456"},
+			},
+			"dddd": {Id: "dddd"},
+		},
+		Tips: map[entity.Id]repository.Hash{"aaaa": "0123456789abcdef", "dddd": "fedcba9876543210"},
+	}
+
+	encoded := encodeBugCache(bugs)
+	require.True(t, strings.HasPrefix(string(encoded), cacheFileMagic))
+	require.Equal(t, encoded, encodeBugCache(bugs), "the encoding is deterministic")
+
+	decoded, err := decodeBugCache(encoded)
+	require.NoError(t, err)
+	require.Equal(t, bugs, decoded)
+
+	version, err := decodeCacheVersion(encoded)
+	require.NoError(t, err)
+	require.Equal(t, uint(formatVersion), version)
+
+	// fields unknown to this version are skipped
+	withUnknown := protoBuffer(encoded)
+	withUnknown.string(99, "from the future")
+	withUnknown.varint(100, 42)
+	decoded, err = decodeBugCache(withUnknown)
+	require.NoError(t, err)
+	require.Equal(t, bugs, decoded)
+
+	identities := map[entity.Id]*IdentityExcerpt{
+		"bbbb": {
+			Id:                "bbbb",
+			Name:              "René Descartes",
+			Login:             "rene",
+			ImmutableMetadata: map[string]string{"github-login": "rene"},
+			Trusted:           []entity.Id{"cccc"},
+		},
+		"cccc": {Id: "cccc", Name: "Isaac Newton"},
+	}
+
+	def := identityCacheDefinition
+	encodedIdentities := encodeExcerpts(formatVersion, identities, def.encodeExcerpt)
+	version, decodedIdentities, err := decodeExcerpts(encodedIdentities, def.decodeExcerpt, def.excerptId)
+	require.NoError(t, err)
+	require.Equal(t, uint(formatVersion), version)
+	require.Equal(t, identities, decodedIdentities)
+
+	_, err = decodeBugCache([]byte(cacheFileMagic + "\xff"))
+	require.Error(t, err)
+}
+
+func TestSubCache(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	isaac, err := backend.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+
+	// another kind of entity, stored in its own file
+	def := *identityCacheDefinition
+	def.typename = "other"
+	def.file = "other-cache"
+
+	var progress bytes.Buffer
+	backend.progress = &progress
+
+	sc := newSubCache(backend, &def)
+	require.NoError(t, sc.build())
+	require.Equal(t, "Building other cache... Done.\n", progress.String())
+	require.ElementsMatch(t, []entity.Id{rene.Id(), isaac.Id()}, sc.AllIds())
+	require.NoError(t, sc.write())
+
+	// read back from the file
+	sc = newSubCache(backend, &def)
+	migrated, err := sc.load()
+	require.NoError(t, err)
+	require.False(t, migrated)
+	require.ElementsMatch(t, []entity.Id{rene.Id(), isaac.Id()}, sc.AllIds())
+	require.Equal(t, 0, sc.LoadedCount())
+
+	excerpt, err := sc.ResolveExcerptPrefix(rene.Id().String()[:10])
+	require.NoError(t, err)
+	require.Equal(t, "René Descartes", excerpt.Name)
+
+	loaded, err := sc.Resolve(isaac.Id())
+	require.NoError(t, err)
+	require.Equal(t, "Isaac Newton", loaded.Name())
+	again, err := sc.ResolvePrefix(isaac.Id().String()[:10])
+	require.NoError(t, err)
+	require.True(t, loaded == again, "a single instance is loaded")
+	require.Equal(t, 1, sc.LoadedCount())
+
+	_, err = sc.ResolveMatcher(func(*IdentityExcerpt) bool { return true })
+	require.True(t, entity.IsErrMultipleMatch(err))
+	_, err = sc.ResolveExcerpt("unknown")
+	require.ErrorIs(t, err, identity.ErrIdentityNotExist)
+
+	require.Equal(t, []entity.Id{rene.Id()}, sc.Query(func(excerpt *IdentityExcerpt) bool {
+		return strings.HasPrefix(excerpt.Name, "René")
+	}))
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+	repoCache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := repoCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, repoCache.SetUserIdentity(rene))
+
+	var ids []entity.Id
+	for i := 0; i < 5; i++ {
+		b, _, err := repoCache.NewBug(fmt.Sprintf("title %d", i), "message")
+		require.NoError(t, err)
+		ids = append(ids, b.Id())
+	}
+
+	q, err := query.Parse("status:open author:rene sort:edit")
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	// concurrent readers
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				_, err := repoCache.QueryBugs(q)
+				require.NoError(t, err)
+				_, err = repoCache.QueryBugs(nil)
+				require.NoError(t, err)
+				for _, id := range repoCache.AllBugsIds() {
+					excerpt, err := repoCache.ResolveBugExcerpt(id)
+					require.NoError(t, err)
+					_ = excerpt.LenComments
+					b, err := repoCache.ResolveBug(id)
+					require.NoError(t, err)
+					_ = b.Snapshot().Comments
+				}
+				_, err = repoCache.ResolveIdentityExcerpt(rene.Id())
+				require.NoError(t, err)
+				repoCache.LoadedCount()
+				_ = repoCache.Statistics()
+			}
+		}()
+	}
+
+	// a single writer
+	for i := 0; i < 20; i++ {
+		b, err := repoCache.ResolveBug(ids[i%len(ids)])
+		require.NoError(t, err)
+		_, _, err = b.AddComment(fmt.Sprintf("comment %d", i))
+		require.NoError(t, err)
+		require.NoError(t, b.Commit())
+	}
+
+	close(done)
+	wg.Wait()
+
+	excerpt, err := repoCache.ResolveBugExcerpt(ids[0])
+	require.NoError(t, err)
+	require.Equal(t, 5, excerpt.LenComments)
+}
+
+func TestBugTemplates(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	templates, err := backend.BugTemplates()
+	require.NoError(t, err)
+	require.Empty(t, templates)
+
+	config := repo.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.template.feature.message", "Use case:"))
+	require.NoError(t, config.StoreString("git-bug.template.bug-report.message", `Steps:\nExpected:`))
+	require.NoError(t, config.StoreString("git-bug.template.bug-report.labels", "bug, triage"))
+
+	templates, err = backend.BugTemplates()
+	require.NoError(t, err)
+	require.Equal(t, []BugTemplate{
+		{Name: "bug-report", Message: "Steps:\nExpected:", Labels: []bug.Label{"bug", "triage"}},
+		{Name: "feature", Message: "Use case:"},
+	}, templates)
+
+	require.NoError(t, config.StoreString("git-bug.template.feature.color", "red"))
+	_, err = backend.BugTemplates()
+	require.Error(t, err)
+}
+
+// commentCountProjection count the comments of the bugs, and how many times
+// it has been called
+type commentCountProjection struct {
+	version int
+	calls   int
+}
+
+func (p *commentCountProjection) Name() string { return "comment-count" }
+
+func (p *commentCountProjection) Version() int { return p.version }
+
+func (p *commentCountProjection) Project(snap *bug.Snapshot) ([]byte, error) {
+	p.calls++
+	return []byte(fmt.Sprintf("%d", len(snap.Comments))), nil
+}
+
+func TestProjection(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	bug1, _, err := backend.NewBug("bug1", "message")
+	require.NoError(t, err)
+	bug2, _, err := backend.NewBug("bug2", "message")
+	require.NoError(t, err)
+
+	_, err = backend.ProjectionView("comment-count", bug1.Id())
+	require.ErrorIs(t, err, ErrProjectionNotRegistered)
+
+	p := &commentCountProjection{version: 1}
+	require.NoError(t, backend.RegisterProjection(p))
+	require.Error(t, backend.RegisterProjection(p))
+
+	views, err := backend.ProjectionViews("comment-count")
+	require.NoError(t, err)
+	require.Equal(t, map[entity.Id][]byte{bug1.Id(): []byte("1"), bug2.Id(): []byte("1")}, views)
+	require.Equal(t, 2, p.calls)
+
+	// only the changed bug is projected again
+	_, _, err = bug1.AddComment("comment")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit())
+
+	view, err := backend.ProjectionView("comment-count", bug1.Id())
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), view)
+	view, err = backend.ProjectionView("comment-count", bug2.Id())
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), view)
+	require.Equal(t, 3, p.calls)
+
+	require.NoError(t, backend.Close())
+
+	// the views are persisted for the same version
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	p = &commentCountProjection{version: 1}
+	require.NoError(t, backend.RegisterProjection(p))
+	_, err = backend.ProjectionViews("comment-count")
+	require.NoError(t, err)
+	require.Equal(t, 0, p.calls)
+
+	// and dropped by an invalidation
+	require.NoError(t, backend.InvalidateProjection("comment-count"))
+	_, err = backend.ProjectionViews("comment-count")
+	require.NoError(t, err)
+	require.Equal(t, 2, p.calls)
+	require.NoError(t, backend.Close())
+
+	// or another version
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	p = &commentCountProjection{version: 2}
+	require.NoError(t, backend.RegisterProjection(p))
+	views, err = backend.ProjectionViews("comment-count")
+	require.NoError(t, err)
+	require.Equal(t, map[entity.Id][]byte{bug1.Id(): []byte("2"), bug2.Id(): []byte("1")}, views)
+	require.Equal(t, 2, p.calls)
+	require.NoError(t, backend.Close())
+}
+
+// TestQueryBugsExcerptOnly check that every filter and sort is answered from
+// the excerpts, without loading a single bug. A new typed value of the bugs
+// must be added to the excerpts before being used in a query.
+func TestQueryBugsExcerptOnly(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	config := repo.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.field.priority.type", "enum"))
+	require.NoError(t, config.StoreString("git-bug.field.priority.values", "low,high"))
+	require.NoError(t, config.StoreString("git-bug.workflow.states", "triage,in-progress,done"))
+	require.NoError(t, config.StoreString("git-bug.workflow.done.status", "closed"))
+
+	backend, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(rene))
+
+	b1, _, err := backend.NewBug("first", "message")
+	require.NoError(t, err)
+	b2, _, err := backend.NewBug("second", "message")
+	require.NoError(t, err)
+
+	_, _, err = b1.ChangeLabels([]string{"bug"}, nil)
+	require.NoError(t, err)
+	_, err = b1.SetField("priority", "high")
+	require.NoError(t, err)
+	_, err = b1.SetAssignee([]entity.Id{rene.Id()}, nil)
+	require.NoError(t, err)
+	_, err = b1.AddRelation(bug.RelationBlocks, b2.Id())
+	require.NoError(t, err)
+	_, _, err = b1.AddComment("comment")
+	require.NoError(t, err)
+	_, err = b1.SetState("in-progress")
+	require.NoError(t, err)
+	require.NoError(t, b1.Commit())
+	_, err = b2.Close()
+	require.NoError(t, err)
+	require.NoError(t, b2.Commit())
+
+	require.NoError(t, backend.Close())
+
+	// a fresh cache, with only the excerpts
+	backend, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+	require.Zero(t, backend.loadedBugs.Len())
+
+	queries := []string{
+		"status:open",
+		"status:in-progress",
+		"status:triage",
+		"author:rene",
+		"actor:me",
+		"participant:rene",
+		"assignee:me",
+		"no:assignee",
+		"label:bug",
+		"no:label",
+		"title:first",
+		"blocks:" + b2.Id().Human(),
+		"metadata:origin:github",
+		"field:priority:high",
+		"awaiting:reporter",
+		"has:sync-conflict",
+		"is:unread",
+		"sort:id",
+		"sort:creation",
+		"sort:edit",
+		"sort:comments",
+		"sort:last-comment",
+		"sort:field.priority",
+		"sort:-field.priority,creation-asc",
+	}
+
+	for _, q := range queries {
+		parsed, err := query.Parse(q)
+		require.NoError(t, err, q)
+		_, err = backend.QueryBugs(parsed)
+		require.NoError(t, err, q)
+		require.Zero(t, backend.loadedBugs.Len(), "query %q loaded a bug", q)
+	}
+}
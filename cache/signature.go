@@ -0,0 +1,19 @@
+package cache
+
+import (
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+)
+
+// SignaturePolicy return how the unsigned operations brought by a merge are
+// handled, as defined in the repository configuration
+func (c *RepoCache) SignaturePolicy() (dag.SignaturePolicy, error) {
+	return dag.LoadSignaturePolicy(c.repo.AnyConfig())
+}
+
+// VerifyBugSignatures return the signature status of the commits holding the
+// operations of a bug, from the oldest
+func (c *RepoCache) VerifyBugSignatures(id entity.Id) ([]dag.CommitSignature, error) {
+	return bug.VerifySignatures(c.repo, c.resolvers, id)
+}
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// maxCachedSnapshots is the number of compiled snapshots kept in addition to
+// the ones of the loaded bugs, for the bugs evicted or read again from git.
+const maxCachedSnapshots = 100
+
+// snapshotKey identify the state of a bug. As the id of an operation is the
+// hash of its content, and operations are only ever added to a bug, the last
+// operation and the number of operations are enough to tell if a compiled
+// snapshot is still up to date.
+type snapshotKey struct {
+	lastOp entity.Id
+	ops    int
+}
+
+func snapshotKeyOf(b bug.Interface) (snapshotKey, bool) {
+	ops := b.Operations()
+	if len(ops) == 0 {
+		return snapshotKey{}, false
+	}
+	return snapshotKey{lastOp: ops[len(ops)-1].Id(), ops: len(ops)}, true
+}
+
+// snapshotCache keep the recently compiled snapshots, so that a bug read
+// again, after being evicted or updated from git, doesn't have to be
+// compiled again if no operation has been added since.
+//
+// The snapshots are shared and must not be modified.
+type snapshotCache struct {
+	snapshots *lru.Cache
+}
+
+func newSnapshotCache() *snapshotCache {
+	// we can ignore the error here as it would only fail if the size is negative.
+	snapshots, _ := lru.New(maxCachedSnapshots)
+	return &snapshotCache{snapshots: snapshots}
+}
+
+// get return the compiled snapshot of the bug in its current state, if known
+func (sc *snapshotCache) get(b bug.Interface) (*bug.Snapshot, bool) {
+	key, ok := snapshotKeyOf(b)
+	if !ok {
+		return nil, false
+	}
+	snap, ok := sc.snapshots.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return snap.(*bug.Snapshot), true
+}
+
+// add record the compiled snapshot of the bug in its current state
+func (sc *snapshotCache) add(b bug.Interface, snap *bug.Snapshot) {
+	if key, ok := snapshotKeyOf(b); ok {
+		sc.snapshots.Add(key, snap)
+	}
+}
+
+// clear drop all the snapshots, for example when the cache is rebuilt
+func (sc *snapshotCache) clear() {
+	sc.snapshots.Purge()
+}
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"sort"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// UnreferencedIdentities return the ids of the identities that no operation
+// points at, sorted by id. The user identity and the identities trusted by
+// another identity are always considered referenced.
+//
+// All the bugs are read from the repository to find the authors of their
+// operations, which makes it an expensive call.
+func (c *RepoCache) UnreferencedIdentities() ([]entity.Id, error) {
+	referenced := make(map[entity.Id]struct{})
+
+	for streamed := range bug.ReadAllWithResolver(c.repo, c.resolvers) {
+		if streamed.Err != nil {
+			return nil, streamed.Err
+		}
+		for _, op := range streamed.Bug.Operations() {
+			referenced[op.Author().Id()] = struct{}{}
+		}
+	}
+
+	isSet, err := c.IsUserIdentitySet()
+	if err != nil {
+		return nil, err
+	}
+	if isSet {
+		user, err := c.GetUserIdentityExcerpt()
+		if err != nil {
+			return nil, err
+		}
+		referenced[user.Id] = struct{}{}
+	}
+
+	c.identities.Query(func(excerpt *IdentityExcerpt) bool {
+		for _, trusted := range excerpt.Trusted {
+			referenced[trusted] = struct{}{}
+		}
+		return false
+	})
+
+	result := c.identities.Query(func(excerpt *IdentityExcerpt) bool {
+		_, ok := referenced[excerpt.Id]
+		return !ok
+	})
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+
+	return result, nil
+}
+
+// RemoveIdentities removes the given identities from the cache and repo.
+func (c *RepoCache) RemoveIdentities(ids []entity.Id) error {
+	for _, id := range ids {
+		err := c.identities.remove(id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.writeIdentityCache()
+}
@@ -0,0 +1,319 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// staleConfigKeyPrefix is the git config prefix under which the stale policy
+// is configured, as in "git-bug.stale.<key> = <value>"
+const staleConfigKeyPrefix = "git-bug.stale."
+
+const (
+	staleConfigKeyDays         = staleConfigKeyPrefix + "days"
+	staleConfigKeyCloseDays    = staleConfigKeyPrefix + "close-days"
+	staleConfigKeyLabel        = staleConfigKeyPrefix + "label"
+	staleConfigKeyMessage      = staleConfigKeyPrefix + "message"
+	staleConfigKeyCloseMessage = staleConfigKeyPrefix + "close-message"
+	staleConfigKeyExemptLabels = staleConfigKeyPrefix + "exempt-labels"
+)
+
+// defaultStaleLabel is the label of the stale bugs when none is configured
+const defaultStaleLabel bug.Label = "stale"
+
+// MetaKeyStale is the metadata recorded on the operations done by the stale
+// policy, holding the action ("mark", "unmark" or "close"). Those operations
+// don't count as an activity on the bug.
+const MetaKeyStale = "stale"
+
+// StalePolicy is the maintenance policy of the inactive bugs: an open bug
+// without activity for Days is commented on and labeled as stale, then closed
+// after CloseDays more days unless the activity resumes, in which case the
+// label is removed.
+type StalePolicy struct {
+	// Days is the number of days of inactivity after which a bug is stale. The
+	// policy is disabled if zero.
+	Days int
+	// CloseDays is the number of days after which a stale bug is closed. Stale
+	// bugs are never closed if zero.
+	CloseDays int
+	Label     bug.Label
+	// Message is the comment added when a bug is labeled as stale, if any
+	Message string
+	// CloseMessage is the comment added when a bug is closed, if any
+	CloseMessage string
+	// ExemptLabels are the labels of the bugs never considered stale
+	ExemptLabels []bug.Label
+}
+
+// Enabled return true if the policy applies to the bugs
+func (p StalePolicy) Enabled() bool {
+	return p.Days > 0
+}
+
+type StaleAction int
+
+const (
+	_ StaleAction = iota
+	// StaleMarked is a bug labeled as stale
+	StaleMarked
+	// StaleUnmarked is a stale bug whose activity resumed
+	StaleUnmarked
+	// StaleClosed is a stale bug closed
+	StaleClosed
+)
+
+func (a StaleAction) String() string {
+	switch a {
+	case StaleMarked:
+		return "marked as stale"
+	case StaleUnmarked:
+		return "no longer stale"
+	case StaleClosed:
+		return "closed as stale"
+	default:
+		return "unknown stale action"
+	}
+}
+
+// StaleResult is the action done on a bug by the stale policy, or the error
+// that prevented it
+type StaleResult struct {
+	Id     entity.Id
+	Action StaleAction
+	Err    error
+}
+
+// StalePolicy return the stale policy of the repository, as configured in the
+// git config.
+func (c *RepoCache) StalePolicy() (StalePolicy, error) {
+	return readStalePolicy(c.repo.AnyConfig())
+}
+
+func readStalePolicy(config repository.ConfigRead) (StalePolicy, error) {
+	result := StalePolicy{Label: defaultStaleLabel}
+
+	readDays := func(key string) (int, error) {
+		raw, err := config.ReadString(key)
+		if err == repository.ErrNoConfigEntry {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		days, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || days < 0 {
+			return 0, fmt.Errorf("invalid %s: %q is not a number of days", key, raw)
+		}
+		return days, nil
+	}
+
+	readString := func(key string) (string, error) {
+		value, err := config.ReadString(key)
+		if err == repository.ErrNoConfigEntry {
+			return "", nil
+		}
+		return value, err
+	}
+
+	var err error
+	if result.Days, err = readDays(staleConfigKeyDays); err != nil {
+		return StalePolicy{}, err
+	}
+	if result.CloseDays, err = readDays(staleConfigKeyCloseDays); err != nil {
+		return StalePolicy{}, err
+	}
+
+	label, err := readString(staleConfigKeyLabel)
+	if err != nil {
+		return StalePolicy{}, err
+	}
+	if label = strings.TrimSpace(label); label != "" {
+		result.Label = bug.Label(label)
+	}
+	if err := result.Label.Validate(); err != nil {
+		return StalePolicy{}, fmt.Errorf("invalid stale label: %w", err)
+	}
+
+	if result.Message, err = readString(staleConfigKeyMessage); err != nil {
+		return StalePolicy{}, err
+	}
+	if result.CloseMessage, err = readString(staleConfigKeyCloseMessage); err != nil {
+		return StalePolicy{}, err
+	}
+
+	exempt, err := readString(staleConfigKeyExemptLabels)
+	if err != nil {
+		return StalePolicy{}, err
+	}
+	for _, raw := range splitFreezeList(exempt) {
+		label := bug.Label(raw)
+		if err := label.Validate(); err != nil {
+			return StalePolicy{}, fmt.Errorf("invalid stale exempt label: %w", err)
+		}
+		result.ExemptLabels = append(result.ExemptLabels, label)
+	}
+
+	return result, nil
+}
+
+// ApplyStalePolicy apply the stale policy to the open bugs, as of now. The
+// changes are authored by the user identity, each bug in a single commit.
+//
+// A bug that can't be changed, for example because closing it is prevented by
+// the freeze mode, is reported with the error and left as is.
+func (c *RepoCache) ApplyStalePolicy(policy StalePolicy, now time.Time) ([]StaleResult, error) {
+	if !policy.Enabled() {
+		return nil, nil
+	}
+	if err := c.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	staleBefore := now.AddDate(0, 0, -policy.Days).Unix()
+
+	// the excerpts tell which bugs can't be stale, without reading them
+	var candidates []entity.Id
+	c.muBug.RLock()
+	for id, excerpt := range c.bugExcerpts {
+		if excerpt.Status != common.OpenStatus {
+			continue
+		}
+		if excerptHasAnyLabel(excerpt, policy.ExemptLabels) {
+			continue
+		}
+		if excerpt.EditUnixTime < staleBefore || excerptHasAnyLabel(excerpt, []bug.Label{policy.Label}) {
+			candidates = append(candidates, id)
+		}
+	}
+	c.muBug.RUnlock()
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i] < candidates[j]
+	})
+
+	var results []StaleResult
+	for _, id := range candidates {
+		b, err := c.ResolveBug(id)
+		if err != nil {
+			results = append(results, StaleResult{Id: id, Err: err})
+			continue
+		}
+
+		action, err := c.applyStalePolicy(b, policy, now)
+		if err != nil {
+			results = append(results, StaleResult{Id: id, Err: err})
+			continue
+		}
+		if action != 0 {
+			results = append(results, StaleResult{Id: id, Action: action})
+		}
+	}
+
+	return results, nil
+}
+
+func (c *RepoCache) applyStalePolicy(b *BugCache, policy StalePolicy, now time.Time) (StaleAction, error) {
+	var action StaleAction
+
+	err := b.Transaction(func(tx *BugTx) error {
+		snap := tx.Snapshot()
+
+		// the last activity, when the bug has been labeled as stale, and if
+		// there has been some activity since, in the order of the operations
+		var activity, marked int64
+		resumed := false
+		for _, op := range snap.Operations {
+			if lc, ok := op.(*bug.LabelChangeOperation); ok && labelIn(policy.Label, lc.Added) {
+				marked = op.Time().Unix()
+				resumed = false
+				continue
+			}
+			if _, ok := op.GetMetadata(MetaKeyStale); ok {
+				continue
+			}
+			if op.Time().Unix() > activity {
+				activity = op.Time().Unix()
+			}
+			resumed = true
+		}
+
+		isStale := hasLabel(snap, policy.Label)
+		metadata := func(value string) map[string]string {
+			return map[string]string{MetaKeyStale: value}
+		}
+
+		switch {
+		case !isStale && activity < now.AddDate(0, 0, -policy.Days).Unix():
+			if policy.Message != "" {
+				_, _, err := bug.AddComment(tx.cache.bug, tx.author.Identity, tx.unixTime, policy.Message, nil, metadata("mark"))
+				if err != nil {
+					return err
+				}
+			}
+			_, _, err := bug.ChangeLabels(tx.cache.bug, tx.author.Identity, tx.unixTime, []string{policy.Label.String()}, nil, metadata("mark"))
+			if err != nil {
+				return err
+			}
+			action = StaleMarked
+
+		case isStale && resumed:
+			_, _, err := bug.ChangeLabels(tx.cache.bug, tx.author.Identity, tx.unixTime, nil, []string{policy.Label.String()}, metadata("unmark"))
+			if err != nil {
+				return err
+			}
+			action = StaleUnmarked
+
+		case isStale && policy.CloseDays > 0 && marked < now.AddDate(0, 0, -policy.CloseDays).Unix():
+			freeze, err := c.freezeMetadata(snap, tx.author, false)
+			if err != nil {
+				return err
+			}
+			if policy.CloseMessage != "" {
+				_, _, err := bug.AddComment(tx.cache.bug, tx.author.Identity, tx.unixTime, policy.CloseMessage, nil, metadata("close"))
+				if err != nil {
+					return err
+				}
+			}
+			closeMetadata := metadata("close")
+			for key, value := range freeze {
+				closeMetadata[key] = value
+			}
+			_, err = bug.Close(tx.cache.bug, tx.author.Identity, tx.unixTime, closeMetadata)
+			if err != nil {
+				return err
+			}
+			action = StaleClosed
+		}
+
+		return nil
+	})
+
+	return action, err
+}
+
+func excerptHasAnyLabel(excerpt *BugExcerpt, labels []bug.Label) bool {
+	for _, l := range excerpt.Labels {
+		if labelIn(l, labels) {
+			return true
+		}
+	}
+	return false
+}
+
+func labelIn(label bug.Label, labels []bug.Label) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"sort"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+)
+
+// LabelCount hold the number of bugs having a label
+type LabelCount struct {
+	Label bug.Label
+	Count int
+}
+
+// RepoStatistics summarize all the bugs of the repository
+type RepoStatistics struct {
+	Open   int
+	Closed int
+	// number of bugs per label, sorted by label
+	Labels []LabelCount
+	// number of actions per month on all bugs, in chronological order
+	Timeline []ActivityCount
+}
+
+// repoStatistics is the incrementally maintained form of RepoStatistics
+type repoStatistics struct {
+	open   int
+	closed int
+	labels map[bug.Label]int
+	months map[time.Time]*ActivityCount
+}
+
+func newRepoStatistics() *repoStatistics {
+	return &repoStatistics{
+		labels: make(map[bug.Label]int),
+		months: make(map[time.Time]*ActivityCount),
+	}
+}
+
+// Statistics return the aggregates over all the bugs of the repository. They
+// are maintained as the bugs change, so this is cheap to call.
+func (c *RepoCache) Statistics() *RepoStatistics {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	result := &RepoStatistics{
+		Open:     c.stats.open,
+		Closed:   c.stats.closed,
+		Labels:   make([]LabelCount, 0, len(c.stats.labels)),
+		Timeline: make([]ActivityCount, 0, len(c.stats.months)),
+	}
+
+	for label, count := range c.stats.labels {
+		result.Labels = append(result.Labels, LabelCount{Label: label, Count: count})
+	}
+	sort.Slice(result.Labels, func(i, j int) bool {
+		return result.Labels[i].Label < result.Labels[j].Label
+	})
+
+	for _, count := range c.stats.months {
+		result.Timeline = append(result.Timeline, *count)
+	}
+	sort.Slice(result.Timeline, func(i, j int) bool {
+		return result.Timeline[i].Month.Before(result.Timeline[j].Month)
+	})
+
+	return result
+}
+
+// updateStatistics replace the contribution of a bug to the statistics.
+// old is nil for a new bug, new is nil for a removed bug.
+// c.muBug must be locked for writing.
+func (c *RepoCache) updateStatistics(old *BugExcerpt, new *BugExcerpt) {
+	if old != nil {
+		c.stats.add(old, -1)
+	}
+	if new != nil {
+		c.stats.add(new, 1)
+	}
+}
+
+// rebuildStatistics compute the statistics from the bug excerpts.
+// c.muBug must be locked for writing.
+func (c *RepoCache) rebuildStatistics() {
+	c.stats = newRepoStatistics()
+	for _, excerpt := range c.bugExcerpts {
+		c.stats.add(excerpt, 1)
+	}
+}
+
+// add the contribution of a bug to the statistics, multiplied by sign
+func (s *repoStatistics) add(excerpt *BugExcerpt, sign int) {
+	switch excerpt.Status {
+	case common.OpenStatus:
+		s.open += sign
+	case common.ClosedStatus:
+		s.closed += sign
+	}
+
+	for _, label := range excerpt.Labels {
+		s.labels[label] += sign
+		if s.labels[label] <= 0 {
+			delete(s.labels, label)
+		}
+	}
+
+	for _, event := range excerpt.Activity {
+		t := time.Unix(event.UnixTime, 0).UTC()
+		month := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		count, ok := s.months[month]
+		if !ok {
+			count = &ActivityCount{Month: month}
+			s.months[month] = count
+		}
+
+		switch event.Kind {
+		case ActivityAuthored:
+			count.Authored += sign
+		case ActivityCommented:
+			count.Commented += sign
+		case ActivityClosed:
+			count.Closed += sign
+		}
+
+		if count.Authored <= 0 && count.Commented <= 0 && count.Closed <= 0 {
+			delete(s.months, month)
+		}
+	}
+}
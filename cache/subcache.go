@@ -0,0 +1,618 @@
+package cache
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-git/go-billy/v5"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// subCacheDefinition describe a kind of entity managed by a SubCache: how to
+// read, wrap and remove the entities, and how to make and store their
+// excerpts.
+//
+// EntityT is the entity as read from git, ExcerptT the excerpt kept in memory
+// and on disk for all the entities, CacheT the wrapper of a loaded entity
+// given to the cache users.
+type subCacheDefinition[EntityT any, ExcerptT any, CacheT any] struct {
+	// the name of the entity kind, as reported when building the cache
+	typename string
+	// the name of the cache file
+	file string
+
+	listIds func(repo repository.ClockedRepo) ([]entity.Id, error)
+	read    func(c *RepoCache, id entity.Id) (EntityT, error)
+	remove  func(repo repository.ClockedRepo, id entity.Id) error
+
+	// wrap a freshly read entity for the cache users
+	wrap func(c *RepoCache, e EntityT) CacheT
+	// unwrap give back the entity of a loaded one
+	unwrap func(cached CacheT) EntityT
+
+	makeExcerpt func(e EntityT) ExcerptT
+	excerptId   func(excerpt ExcerptT) entity.Id
+
+	// indexKeys, if set, give the keys under which an excerpt is indexed, to
+	// find the entities by those keys without scanning all the excerpts
+	indexKeys func(excerpt ExcerptT) []string
+
+	// the protobuf encoding of an excerpt, see cache.proto
+	encodeExcerpt func(excerpt ExcerptT) protoBuffer
+	decodeExcerpt func(raw []byte) (ExcerptT, error)
+	// decodeLegacy decode a cache file written before the protobuf encoding
+	decodeLegacy func(content []byte) (uint, map[entity.Id]ExcerptT, error)
+
+	// migrations of the excerpts, indexed by the format version they upgrade
+	// from. A version listed in cacheMigrations but not here doesn't change
+	// the excerpts of this kind of entity.
+	migrations map[uint]func(c *RepoCache, excerpts map[entity.Id]ExcerptT) error
+
+	errNotExist      error
+	errMultipleMatch func(matching []entity.Id) error
+
+	// the event published when an entity is created or updated
+	updatedEvent func(id entity.Id) Event
+}
+
+// entitySubCache is the part of a SubCache managed by the RepoCache, whatever
+// the kind of entity
+type entitySubCache interface {
+	// kind return the name of the entity kind, as used in the journal
+	kind() string
+	load() (migrated bool, err error)
+	build() error
+	write() error
+	dropLoaded()
+	refresh(id entity.Id) error
+	close()
+}
+
+// SubCache is the part of the RepoCache managing one kind of entity. Like the
+// RepoCache does for the bugs, it keeps in memory and on disk an excerpt of
+// each entity, and a single loaded instance of each entity in use.
+//
+// A new kind of entity only need a subCacheDefinition to get the resolving,
+// querying, persistence, locking and rebuilding of the cache.
+type SubCache[EntityT any, ExcerptT any, CacheT any] struct {
+	def       *subCacheDefinition[EntityT, ExcerptT, CacheT]
+	repoCache *RepoCache
+
+	mu sync.RWMutex
+	// excerpt of all the entities
+	excerpts map[entity.Id]ExcerptT
+	// entities loaded in memory
+	cached map[entity.Id]CacheT
+	// the entities by index key, if the definition has some
+	index map[string]idSet
+}
+
+func newSubCache[EntityT any, ExcerptT any, CacheT any](repoCache *RepoCache, def *subCacheDefinition[EntityT, ExcerptT, CacheT]) *SubCache[EntityT, ExcerptT, CacheT] {
+	return &SubCache[EntityT, ExcerptT, CacheT]{
+		def:       def,
+		repoCache: repoCache,
+		excerpts:  make(map[entity.Id]ExcerptT),
+		cached:    make(map[entity.Id]CacheT),
+		index:     make(map[string]idSet),
+	}
+}
+
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) kind() string {
+	return sc.def.typename
+}
+
+// load read the cache file, migrating it from a previous format version if
+// needed
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) load() (migrated bool, err error) {
+	version, excerpts, err := sc.def.decodeFile(sc.repoCache.repo.LocalStorage())
+	if err != nil {
+		return false, err
+	}
+
+	if version != formatVersion {
+		err = sc.migrate(version, excerpts)
+		if err != nil {
+			return false, err
+		}
+		migrated = true
+	}
+
+	sc.setExcerpts(excerpts)
+	return migrated, nil
+}
+
+// migrate apply in order the migrations of the excerpts, up to the current
+// format version
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) migrate(version uint, excerpts map[entity.Id]ExcerptT) error {
+	for version < formatVersion {
+		if _, ok := cacheMigrations[version]; !ok {
+			return fmt.Errorf("no migration from the cache format version %v", version)
+		}
+		if migration, ok := sc.def.migrations[version]; ok {
+			if err := migration(sc.repoCache, excerpts); err != nil {
+				return err
+			}
+		}
+		version++
+	}
+
+	if version != formatVersion {
+		return fmt.Errorf("unknown cache format version %v", version)
+	}
+
+	return nil
+}
+
+// setExcerpts replace all the excerpts, for example after reading the cache
+// file again. The loaded entities might be outdated and are dropped.
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) setExcerpts(excerpts map[entity.Id]ExcerptT) {
+	if excerpts == nil {
+		// the legacy gob encoding skip the empty maps
+		excerpts = make(map[entity.Id]ExcerptT)
+	}
+
+	sc.mu.Lock()
+	sc.excerpts = excerpts
+	sc.cached = make(map[entity.Id]CacheT)
+	sc.rebuildIndex()
+	sc.mu.Unlock()
+}
+
+// write serialize on disk the cache file
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) write() error {
+	sc.mu.RLock()
+	data := encodeExcerpts(formatVersion, sc.excerpts, sc.def.encodeExcerpt)
+	sc.mu.RUnlock()
+
+	return writeCacheFile(sc.repoCache.repo.LocalStorage(), sc.def.file, data)
+}
+
+// build read all the entities from git to make their excerpts
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) build() error {
+	ids, err := sc.def.listIds(sc.repoCache.repo)
+	if err != nil {
+		return err
+	}
+
+	c := sc.repoCache
+	reporter := newBuildReporter(&c.buildProgress, c.progress, sc.def.typename, len(ids))
+
+	excerpts := make(map[entity.Id]ExcerptT, len(ids))
+
+	for _, id := range ids {
+		e, err := sc.def.read(c, id)
+		if err != nil {
+			reporter.finish(err)
+			return err
+		}
+
+		excerpts[id] = sc.def.makeExcerpt(e)
+		reporter.step()
+	}
+
+	sc.mu.Lock()
+	sc.excerpts = excerpts
+	sc.rebuildIndex()
+	sc.mu.Unlock()
+
+	reporter.finish(nil)
+
+	return nil
+}
+
+// close drop everything held in memory
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) close() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.excerpts = nil
+	sc.cached = make(map[entity.Id]CacheT)
+	sc.index = make(map[string]idSet)
+}
+
+// dropLoaded forget the loaded entities, to read them again from git
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) dropLoaded() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.cached = make(map[entity.Id]CacheT)
+}
+
+// entityUpdated is a callback to trigger when the excerpt of an entity
+// changed, that is each time an entity is updated
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) entityUpdated(id entity.Id) error {
+	if err := sc.repoCache.checkWritable(); err != nil {
+		return err
+	}
+
+	sc.mu.Lock()
+	cached, ok := sc.cached[id]
+	if !ok {
+		sc.mu.Unlock()
+		panic(fmt.Sprintf("missing %s in the cache", sc.def.typename))
+	}
+	sc.setExcerpt(id, sc.def.makeExcerpt(sc.def.unwrap(cached)))
+	sc.mu.Unlock()
+
+	sc.repoCache.events.Publish(sc.def.updatedEvent(id))
+
+	// we only need to write the cache file of this entity kind
+	return sc.repoCache.writeAfterUpdate(sc.write)
+}
+
+// add register a newly created entity. Its excerpt is made and written when
+// calling entityUpdated.
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) add(id entity.Id, cached CacheT) error {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if _, has := sc.cached[id]; has {
+		return fmt.Errorf("%s %s already exist in the cache", sc.def.typename, id)
+	}
+
+	sc.cached[id] = cached
+	return nil
+}
+
+// updateExcerpt replace the excerpt of an entity changed outside of the cache,
+// like by a merge. The loaded entity, if any, is dropped.
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) updateExcerpt(id entity.Id, e EntityT) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.setExcerpt(id, sc.def.makeExcerpt(e))
+	delete(sc.cached, id)
+}
+
+// refresh read again an entity from git to update its excerpt, or drop it if
+// the entity doesn't exist anymore. The loaded entity, if any, is dropped.
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) refresh(id entity.Id) error {
+	e, err := sc.def.read(sc.repoCache, id)
+	if errors.Is(err, sc.def.errNotExist) {
+		sc.mu.Lock()
+		defer sc.mu.Unlock()
+		delete(sc.cached, id)
+		sc.deleteExcerpt(id)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	sc.updateExcerpt(id, e)
+	return nil
+}
+
+// setExcerpt replace the excerpt of an entity, keeping the index up to date.
+// sc.mu must be locked for writing.
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) setExcerpt(id entity.Id, excerpt ExcerptT) {
+	sc.deleteExcerpt(id)
+	sc.excerpts[id] = excerpt
+	if sc.def.indexKeys != nil {
+		for _, key := range sc.def.indexKeys(excerpt) {
+			addToSet(sc.index, key, id)
+		}
+	}
+}
+
+// deleteExcerpt drop the excerpt of an entity, keeping the index up to date.
+// sc.mu must be locked for writing.
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) deleteExcerpt(id entity.Id) {
+	old, ok := sc.excerpts[id]
+	if !ok {
+		return
+	}
+	if sc.def.indexKeys != nil {
+		for _, key := range sc.def.indexKeys(old) {
+			removeFromSet(sc.index, key, id)
+		}
+	}
+	delete(sc.excerpts, id)
+}
+
+// rebuildIndex build the index from all the excerpts.
+// sc.mu must be locked for writing.
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) rebuildIndex() {
+	sc.index = make(map[string]idSet)
+	if sc.def.indexKeys == nil {
+		return
+	}
+	for id, excerpt := range sc.excerpts {
+		for _, key := range sc.def.indexKeys(excerpt) {
+			addToSet(sc.index, key, id)
+		}
+	}
+}
+
+// lookup return the ids of the entities indexed under the given key, sorted,
+// without scanning the excerpts. The definition must have indexKeys.
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) lookup(key string) []entity.Id {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	set := sc.index[key]
+	result := make([]entity.Id, 0, len(set))
+	for id := range set {
+		result = append(result, id)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
+
+// loaded return an entity if it's already loaded in memory
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) loaded(id entity.Id) (CacheT, bool) {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	cached, ok := sc.cached[id]
+	return cached, ok
+}
+
+// LoadedCount return the number of entities loaded in memory
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) LoadedCount() int {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	return len(sc.cached)
+}
+
+// ResolveExcerpt retrieve an excerpt matching the exact given id
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) ResolveExcerpt(id entity.Id) (ExcerptT, error) {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	excerpt, ok := sc.excerpts[id]
+	if !ok {
+		var zero ExcerptT
+		return zero, sc.def.errNotExist
+	}
+
+	return excerpt, nil
+}
+
+// Resolve retrieve an entity matching the exact given id, loading it if needed
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) Resolve(id entity.Id) (CacheT, error) {
+	if cached, ok := sc.loaded(id); ok {
+		return cached, nil
+	}
+
+	e, err := sc.def.read(sc.repoCache, id)
+	if err != nil {
+		var zero CacheT
+		return zero, err
+	}
+
+	return sc.register(id, e), nil
+}
+
+// register make an entity read from git the loaded one, unless it has already
+// been loaded concurrently
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) register(id entity.Id, e EntityT) CacheT {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if cached, ok := sc.cached[id]; ok {
+		return cached
+	}
+
+	cached := sc.def.wrap(sc.repoCache, e)
+	sc.cached[id] = cached
+
+	return cached
+}
+
+// ResolveExcerptPrefix retrieve an excerpt matching an id prefix. It fails if
+// multiple entities match.
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) ResolveExcerptPrefix(prefix string) (ExcerptT, error) {
+	return sc.ResolveExcerptMatcher(func(excerpt ExcerptT) bool {
+		return sc.def.excerptId(excerpt).HasPrefix(prefix)
+	})
+}
+
+// ResolvePrefix retrieve an entity matching an id prefix. It fails if multiple
+// entities match.
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) ResolvePrefix(prefix string) (CacheT, error) {
+	return sc.ResolveMatcher(func(excerpt ExcerptT) bool {
+		return sc.def.excerptId(excerpt).HasPrefix(prefix)
+	})
+}
+
+// ResolveExcerptMatcher retrieve the excerpt of the only entity matching f
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) ResolveExcerptMatcher(f func(ExcerptT) bool) (ExcerptT, error) {
+	id, err := sc.resolveMatcher(f)
+	if err != nil {
+		var zero ExcerptT
+		return zero, err
+	}
+	return sc.ResolveExcerpt(id)
+}
+
+// ResolveMatcher retrieve the only entity matching f
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) ResolveMatcher(f func(ExcerptT) bool) (CacheT, error) {
+	id, err := sc.resolveMatcher(f)
+	if err != nil {
+		var zero CacheT
+		return zero, err
+	}
+	return sc.Resolve(id)
+}
+
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) resolveMatcher(f func(ExcerptT) bool) (entity.Id, error) {
+	matching := sc.Query(f)
+
+	if len(matching) > 1 {
+		// sorted, for the candidates to be listed the same way each time
+		sort.Slice(matching, func(i, j int) bool {
+			return matching[i] < matching[j]
+		})
+		return entity.UnsetId, sc.def.errMultipleMatch(matching)
+	}
+
+	if len(matching) == 0 {
+		return entity.UnsetId, sc.def.errNotExist
+	}
+
+	return matching[0], nil
+}
+
+// Query return the ids of all the entities whose excerpt match f, in no
+// particular order
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) Query(f func(ExcerptT) bool) []entity.Id {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	// preallocate but empty
+	matching := make([]entity.Id, 0, 5)
+
+	for id, excerpt := range sc.excerpts {
+		if f(excerpt) {
+			matching = append(matching, id)
+		}
+	}
+
+	return matching
+}
+
+// AllIds return the ids of all the entities
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) AllIds() []entity.Id {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	result := make([]entity.Id, 0, len(sc.excerpts))
+	for id := range sc.excerpts {
+		result = append(result, id)
+	}
+
+	return result
+}
+
+// Remove removes an entity from the cache and repo given an id prefix, and
+// write the cache file
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) Remove(prefix string) error {
+	excerpt, err := sc.ResolveExcerptPrefix(prefix)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.repoCache.checkWritable(); err != nil {
+		return err
+	}
+
+	id := sc.def.excerptId(excerpt)
+
+	err = sc.repoCache.journalBegin(sc.kind(), id)
+	if err != nil {
+		return err
+	}
+
+	err = sc.remove(id)
+	if err != nil {
+		return err
+	}
+
+	err = sc.write()
+	if err != nil {
+		return err
+	}
+
+	return sc.repoCache.journalEnd(sc.kind(), id)
+}
+
+// remove removes an entity from the cache and repo, without writing the cache
+// file
+func (sc *SubCache[EntityT, ExcerptT, CacheT]) remove(id entity.Id) error {
+	if err := sc.repoCache.checkWritable(); err != nil {
+		return err
+	}
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	err := sc.def.remove(sc.repoCache.repo, id)
+	if err != nil {
+		return err
+	}
+
+	delete(sc.cached, id)
+	sc.deleteExcerpt(id)
+
+	return nil
+}
+
+// readFile decode the cache file, without modifying anything
+func (def *subCacheDefinition[EntityT, ExcerptT, CacheT]) readFile(storage billy.Filesystem) (map[entity.Id]ExcerptT, error) {
+	version, excerpts, err := def.decodeFile(storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != formatVersion {
+		return nil, fmt.Errorf("unknown cache format version %v", version)
+	}
+
+	return excerpts, nil
+}
+
+// decodeFile decode the cache file with the current types, whatever its
+// format version
+func (def *subCacheDefinition[EntityT, ExcerptT, CacheT]) decodeFile(storage billy.Filesystem) (uint, map[entity.Id]ExcerptT, error) {
+	content, err := readCacheFile(storage, def.file)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if !bytes.HasPrefix(content, []byte(cacheFileMagic)) {
+		return def.decodeLegacy(content)
+	}
+
+	version, excerpts, err := decodeExcerpts(content, def.decodeExcerpt, def.excerptId)
+	if err != nil {
+		return 0, nil, fmt.Errorf("decoding the %s cache: %w", def.typename, err)
+	}
+
+	return version, excerpts, nil
+}
+
+// encodeExcerpts encode a cache file made of a format version and a list of
+// excerpts, like the IdentityCache message of cache.proto
+func encodeExcerpts[ExcerptT any](version uint, excerpts map[entity.Id]ExcerptT, encode func(ExcerptT) protoBuffer) []byte {
+	b := protoBuffer(cacheFileMagic)
+	b.varint(fieldCacheVersion, uint64(version))
+	for _, id := range sortedExcerptIds(excerpts) {
+		b.message(fieldCacheExcerpts, encode(excerpts[id]))
+	}
+	return b
+}
+
+// decodeExcerpts decode a cache file encoded with encodeExcerpts
+func decodeExcerpts[ExcerptT any](content []byte, decode func([]byte) (ExcerptT, error), excerptId func(ExcerptT) entity.Id) (uint, map[entity.Id]ExcerptT, error) {
+	var version uint
+	excerpts := make(map[entity.Id]ExcerptT)
+
+	err := walkProto(content[len(cacheFileMagic):], func(num protowire.Number, v uint64, raw []byte) error {
+		switch num {
+		case fieldCacheVersion:
+			version = uint(v)
+		case fieldCacheExcerpts:
+			excerpt, err := decode(raw)
+			if err != nil {
+				return err
+			}
+			excerpts[excerptId(excerpt)] = excerpt
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return version, excerpts, nil
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// trackerConfigKeyPrefix is the git config prefix under which the related
+// trackers are declared, as in "git-bug.tracker.<name>.path = <path>"
+const trackerConfigKeyPrefix = "git-bug.tracker."
+
+// RelatedTracker is another repository holding bugs related to this one, like
+// a submodule or a sibling repository of the same organization.
+type RelatedTracker struct {
+	// Name is the name of the tracker, used to namespace the ids of its bugs
+	Name string
+	// Path is the absolute path of the repository
+	Path string
+}
+
+// RelatedTrackers return the trackers declared in the configuration of a
+// repository, sorted by name. A relative path is resolved from the working
+// tree of the repository, or from the directory holding it for a bare
+// repository.
+func RelatedTrackers(repo repository.ClockedRepo) ([]RelatedTracker, error) {
+	configs, err := repo.AnyConfig().ReadAll(trackerConfigKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []RelatedTracker
+	for key, value := range configs {
+		if !strings.HasSuffix(key, ".path") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(key, trackerConfigKeyPrefix), ".path")
+		if name == "" || name == defaultRepoName || strings.ContainsAny(name, "/ ") {
+			return nil, fmt.Errorf("invalid tracker name %q", name)
+		}
+
+		path := value
+		if !filepath.IsAbs(path) {
+			// the local storage is in the git directory of the repository
+			root := repo.LocalStorage().Root()
+			path = filepath.Join(root, "..", "..", path)
+		}
+
+		result = append(result, RelatedTracker{Name: name, Path: path})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
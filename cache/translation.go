@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// git config key of the command translating the titles and the messages, as
+// in "git-bug.translate.command = trans -brief -target". The target language is given
+// as the last argument, the text on the standard input, and the command
+// write the translation on its standard output. It can be a script calling
+// any translation service.
+const translateCommandConfigKey = "git-bug.translate.command"
+
+// git config key of the language the user want the bugs translated to, when
+// displayed on demand in the termui and the web UI
+const translateLanguageConfigKey = "git-bug.translate.language"
+
+// ErrNoTranslator is returned when no translation command is configured
+var ErrNoTranslator = fmt.Errorf("no translation command configured, see the %s git config key", translateCommandConfigKey)
+
+// Translator translate a text to a language, given as a tag like "fr"
+type Translator interface {
+	Translate(text string, lang string) (string, error)
+}
+
+// Translator return the Translator running the configured translation
+// command, or ErrNoTranslator.
+func (c *RepoCache) Translator() (Translator, error) {
+	command, err := c.repo.AnyConfig().ReadString(translateCommandConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return nil, ErrNoTranslator
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, ErrNoTranslator
+	}
+	return commandTranslator(args), nil
+}
+
+// TranslationLanguage return the configured language of the user, or an
+// empty string if not configured.
+func (c *RepoCache) TranslationLanguage() (string, error) {
+	lang, err := c.repo.AnyConfig().ReadString(translateLanguageConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := bug.ValidateLanguage(lang); err != nil {
+		return "", fmt.Errorf("%s: %w", translateLanguageConfigKey, err)
+	}
+	return lang, nil
+}
+
+// commandTranslator is a Translator running a command
+type commandTranslator []string
+
+func (ct commandTranslator) Translate(text string, lang string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(ct[0], append(ct[1:], lang)...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("translation command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	translation := strings.TrimSpace(stdout.String())
+	if translation == "" {
+		return "", errors.New("empty translation")
+	}
+	return translation, nil
+}
+
+// Translate store the translations in a language of the current title and
+// messages not translated yet, as annotations of the operations that set
+// them. It return the number of translated texts.
+func (c *BugCache) Translate(translator Translator, lang string) (int, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return 0, err
+	}
+
+	return c.TranslateRaw(author, time.Now().Unix(), translator, lang)
+}
+
+func (c *BugCache) TranslateRaw(author *IdentityCache, unixTime int64, translator Translator, lang string) (int, error) {
+	if err := bug.ValidateLanguage(lang); err != nil {
+		return 0, err
+	}
+
+	// translations are grouped by operation, as the title and the message of
+	// a bug are both set by its first operation
+	var targets []entity.Id
+	metadata := make(map[entity.Id]map[string]string)
+	count := 0
+
+	for _, source := range c.Snapshot().TranslationSources() {
+		if _, ok := source.Translation(lang); ok {
+			continue
+		}
+
+		translation, err := translator.Translate(source.Text, lang)
+		if err != nil {
+			return 0, err
+		}
+
+		target := source.Op.Id()
+		if _, ok := metadata[target]; !ok {
+			targets = append(targets, target)
+			metadata[target] = make(map[string]string)
+		}
+		for key, value := range source.TranslationMetadata(lang, translation) {
+			metadata[target][key] = value
+		}
+		count++
+	}
+
+	if count == 0 {
+		return 0, nil
+	}
+
+	// validate everything first, to not store only a part of the translations
+	ops := make([]bug.Operation, len(targets))
+	for i, target := range targets {
+		ops[i] = bug.NewSetMetadataOp(author.Identity, unixTime, target, metadata[target])
+		if err := ops[i].Validate(); err != nil {
+			return 0, fmt.Errorf("invalid translation: %w", err)
+		}
+	}
+
+	c.mu.Lock()
+	for _, op := range ops {
+		c.bug.Append(op)
+	}
+	c.mu.Unlock()
+
+	return count, c.notifyUpdated()
+}
@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// the interval at which a read-only cache check if the cache files have been
+// written by another process
+const watchInterval = time.Second
+
+// cacheFileState is what is used to detect a change of a cache file
+type cacheFileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// cacheFilesState is the state of the cache files, as they were last loaded
+type cacheFilesState struct {
+	bug      cacheFileState
+	identity cacheFileState
+}
+
+func (c *RepoCache) statCacheFile(name string) cacheFileState {
+	fi, err := c.repo.LocalStorage().Stat(name)
+	if err != nil {
+		return cacheFileState{}
+	}
+	return cacheFileState{modTime: fi.ModTime(), size: fi.Size()}
+}
+
+func (c *RepoCache) statCacheFiles() cacheFilesState {
+	return cacheFilesState{
+		bug:      c.statCacheFile(bugCacheFile),
+		identity: c.statCacheFile(identityCacheFile),
+	}
+}
+
+// watchCacheFiles reload the cache files each time they are written by the
+// process holding the lock, until the cache is closed. This allows any
+// number of read-only caches to coexist with a writer, like the web UI.
+func (c *RepoCache) watchCacheFiles(stop <-chan struct{}, loaded cacheFilesState) {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		current := c.statCacheFiles()
+		if current == loaded {
+			continue
+		}
+
+		// the file might be in the middle of being written, in which case
+		// the reload fails and is retried on the next tick
+		if err := c.reloadReadOnly(current.bug != loaded.bug, current.identity != loaded.identity); err != nil {
+			continue
+		}
+		loaded = current
+	}
+}
+
+// reloadReadOnly read again the cache files that changed, and notify the
+// changes of the bugs
+func (c *RepoCache) reloadReadOnly(bugs bool, identities bool) error {
+	if identities {
+		identityExcerpts, err := readIdentityCache(c.repo.LocalStorage())
+		if err != nil {
+			return err
+		}
+
+		c.identities.setExcerpts(identityExcerpts)
+	}
+
+	if !bugs {
+		return nil
+	}
+
+	bugExcerpts, err := readBugCache(c.repo.LocalStorage())
+	if err != nil {
+		return err
+	}
+
+	var created, updated []*BugExcerpt
+
+	c.muBug.Lock()
+	previous := c.bugExcerpts
+	for id, excerpt := range bugExcerpts {
+		old, ok := previous[id]
+		switch {
+		case !ok:
+			created = append(created, excerpt)
+		case old.EditLamportTime != excerpt.EditLamportTime || old.CreateLamportTime != excerpt.CreateLamportTime:
+			updated = append(updated, excerpt)
+		default:
+			continue
+		}
+		// the loaded bug is outdated, it will be read again from git
+		c.dropLoadedBug(id)
+	}
+	var removed []entity.Id
+	for id := range previous {
+		if _, ok := bugExcerpts[id]; !ok {
+			removed = append(removed, id)
+			c.dropLoadedBug(id)
+		}
+	}
+	c.bugExcerpts = bugExcerpts
+	c.rebuildActivityIndex()
+	c.rebuildStatistics()
+	c.rebuildBugIndex()
+	c.muBug.Unlock()
+
+	for _, id := range removed {
+		c.notifyExcerptRemoved(id)
+	}
+	for _, excerpt := range created {
+		c.notifyExcerptChanged(excerpt, true)
+		c.events.Publish(BugCreated{BugId: excerpt.Id})
+	}
+	for _, excerpt := range updated {
+		c.notifyExcerptChanged(excerpt, false)
+		c.events.Publish(BugUpdated{BugId: excerpt.Id})
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+)
+
+// Workflow return the states a bug can be in and the transitions between
+// them, as defined in the repository configuration
+func (c *RepoCache) Workflow() (*bug.Workflow, error) {
+	return bug.LoadWorkflow(c.repo.AnyConfig())
+}
+
+// State return the state of the bug in the workflow
+func (c *BugCache) State() (string, error) {
+	workflow, err := c.repoCache.Workflow()
+	if err != nil {
+		return "", err
+	}
+	snap := c.Snapshot()
+	return workflow.StateOf(snap.Status, snap.State), nil
+}
+
+// ValidateStatusChange check that the workflow allow the bug to move to a
+// new status and state. An empty state is the one implied by the status, as
+// when opening or closing the bug.
+//
+// The bridges importing a change done remotely don't go through this check.
+func (c *BugCache) ValidateStatusChange(status common.Status, state string) error {
+	return c.repoCache.validateStatusChange(c.Snapshot(), status, state)
+}
+
+func (c *RepoCache) validateStatusChange(snap *bug.Snapshot, status common.Status, state string) error {
+	workflow, err := c.Workflow()
+	if err != nil {
+		return err
+	}
+	from := workflow.StateOf(snap.Status, snap.State)
+	to := workflow.StateOf(status, state)
+	return workflow.ValidateTransition(from, to)
+}
+
+// resolveState return the status and the state to record to move a bug to a
+// state of the workflow. The state isn't recorded when it's the name of the
+// status, so that moving a bug in the default workflow is simply opening or
+// closing it.
+func (c *RepoCache) resolveState(state string) (common.Status, string, error) {
+	workflow, err := c.Workflow()
+	if err != nil {
+		return 0, "", err
+	}
+	// staying in the same state only needs the state to exist
+	if err := workflow.ValidateTransition(state, state); err != nil {
+		return 0, "", err
+	}
+	s, _ := workflow.State(state)
+	if s.Status.String() == state {
+		return s.Status, "", nil
+	}
+	return s.Status, state, nil
+}
+
+// SetState move the bug to a state of the workflow, checking the transition.
+// If the bug is closed and protected by the freeze mode, an ErrFrozen is
+// returned; SetStateConfirmed must be used instead.
+func (c *BugCache) SetState(state string) (*bug.SetStatusOperation, error) {
+	return c.setState(state, false)
+}
+
+// SetStateConfirmed move the bug to a state of the workflow, confirming the
+// closing if the bug is protected by the freeze mode
+func (c *BugCache) SetStateConfirmed(state string) (*bug.SetStatusOperation, error) {
+	return c.setState(state, true)
+}
+
+func (c *BugCache) setState(state string, confirmed bool) (*bug.SetStatusOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	status, _, err := c.repoCache.resolveState(state)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.ValidateStatusChange(status, state); err != nil {
+		return nil, err
+	}
+
+	var metadata map[string]string
+	if status == common.ClosedStatus {
+		metadata, err = c.FreezeMetadata(author, confirmed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.SetStateRaw(author, time.Now().Unix(), state, metadata)
+}
+
+// SetStateRaw move the bug to a state of the workflow, without checking the
+// transition
+func (c *BugCache) SetStateRaw(author *IdentityCache, unixTime int64, state string, metadata map[string]string) (*bug.SetStatusOperation, error) {
+	status, state, err := c.repoCache.resolveState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	op, err := bug.SetState(c.bug, author.Identity, unixTime, status, state, metadata)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return op, c.notifyUpdated()
+}
@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// workspacePathEnv is the environment variable overriding the path of the
+// workspace file
+const workspacePathEnv = "GIT_BUG_WORKSPACE"
+
+// Workspace is the list of the local repositories of a user, queried together
+// whatever the current directory. It's stored in a file holding the absolute
+// path of a repository per line.
+type Workspace struct {
+	path string
+	// Repos are the absolute paths of the repositories, in the order they
+	// were added
+	Repos []string
+}
+
+// DefaultWorkspacePath return the path of the workspace file of the user, in
+// its configuration directory, unless overridden with GIT_BUG_WORKSPACE.
+func DefaultWorkspacePath() (string, error) {
+	if path, ok := os.LookupEnv(workspacePathEnv); ok && path != "" {
+		return path, nil
+	}
+	ucd, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(ucd, "git-bug", "workspace"), nil
+}
+
+// LoadWorkspace read the workspace stored at path. A missing file is an empty
+// workspace. Empty lines and lines starting with "#" are ignored.
+func LoadWorkspace(path string) (*Workspace, error) {
+	w := &Workspace{path: path}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return w, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !filepath.IsAbs(line) {
+			return nil, fmt.Errorf("workspace %s: %q is not an absolute path", path, line)
+		}
+		w.Repos = append(w.Repos, filepath.Clean(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Add add a repository to the workspace. It returns false if the repository
+// is already part of it.
+func (w *Workspace) Add(repoPath string) (bool, error) {
+	abs, err := filepath.Abs(repoPath)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range w.Repos {
+		if r == abs {
+			return false, nil
+		}
+	}
+	w.Repos = append(w.Repos, abs)
+	return true, nil
+}
+
+// Remove remove a repository from the workspace. It returns false if the
+// repository isn't part of it.
+func (w *Workspace) Remove(repoPath string) (bool, error) {
+	abs, err := filepath.Abs(repoPath)
+	if err != nil {
+		return false, err
+	}
+	for i, r := range w.Repos {
+		if r == abs {
+			w.Repos = append(w.Repos[:i], w.Repos[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Save write the workspace back to its file, creating its directory if needed
+func (w *Workspace) Save() error {
+	if err := os.MkdirAll(filepath.Dir(w.path), 0755); err != nil {
+		return err
+	}
+
+	var content strings.Builder
+	for _, r := range w.Repos {
+		content.WriteString(r)
+		content.WriteString("\n")
+	}
+
+	return os.WriteFile(w.path, []byte(content.String()), 0644)
+}
+
+// RegisterWorkspace register the repositories of a workspace, each named
+// after its directory as RegisterRepositoryPath does, opening them with open.
+// A repository locked by another process is opened read-only.
+func (c *MultiRepoCache) RegisterWorkspace(w *Workspace, open func(path string) (repository.ClockedRepo, error)) error {
+	for _, path := range w.Repos {
+		if _, err := c.registerRepositoryPath(path, open, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+type auditLogOptions struct {
+	verify    string
+	signature string
+}
+
+func newAuditLogCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := auditLogOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "Export or verify an audit log of all the operations",
+		Long: `Export an audit log of all the operations of all the bugs, as JSON lines.
+
+Each line describe one operation: who did it, when, and what, with the complete operation. Each line also holds the hash of the previous one, forming a hash chain.
+
+The log is generated deterministically: the same repository always produce the same log, and a log generated later is an extension of the previous ones. This allow an auditor to verify a log against the repository with --verify.`,
+		Example: `Export the audit log, along with a detached signature:
+git bug audit-log --signature audit.jsonl.asc > audit.jsonl
+
+Verify a previously exported log against the repository:
+git bug audit-log --verify audit.jsonl
+`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runAuditLog(env, options)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVar(&options.verify, "verify", "",
+		"Verify the given audit log against the repository instead of exporting it")
+	flags.StringVar(&options.signature, "signature", "",
+		"Write an armored OpenPGP detached signature of the log in the given file, made with the key of the user identity")
+
+	return cmd
+}
+
+func runAuditLog(env *execenv.Env, opts auditLogOptions) error {
+	entries, err := env.Backend.AuditLog()
+	if err != nil {
+		return err
+	}
+
+	if opts.verify != "" {
+		f, err := os.Open(opts.verify)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		count, err := cache.VerifyAuditLog(f, entries)
+		if err != nil {
+			return err
+		}
+
+		env.Out.Printf("%d entries verified, %d new entries since\n", count, len(entries)-count)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	err = cache.WriteAuditLog(&buf, entries)
+	if err != nil {
+		return err
+	}
+
+	if opts.signature != "" {
+		err = signAuditLog(env, buf.Bytes(), opts.signature)
+		if err != nil {
+			return err
+		}
+	}
+
+	env.Out.Print(buf.String())
+
+	return nil
+}
+
+func signAuditLog(env *execenv.Env, log []byte, path string) error {
+	user, err := env.Backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	key, err := user.SigningKey(env.Backend)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return fmt.Errorf("the identity %s has no signing key", user.DisplayName())
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = openpgp.ArmoredDetachSign(f, key.PGPEntity(), bytes.NewReader(log), nil)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
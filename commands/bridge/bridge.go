@@ -21,6 +21,7 @@ func NewBridgeCommand() *cobra.Command {
 	}
 
 	cmd.AddCommand(newBridgeAuthCommand())
+	cmd.AddCommand(newBridgeHealthCommand())
 	cmd.AddCommand(newBridgeNewCommand())
 	cmd.AddCommand(newBridgePullCommand())
 	cmd.AddCommand(newBridgePushCommand())
@@ -0,0 +1,176 @@
+package bridgecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bridge"
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+type bridgeHealthOptions struct {
+	format string
+}
+
+func newBridgeHealthCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bridgeHealthOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "health [NAME]",
+		Short: "Show the health of the bridges",
+		Long: `Show the health of the bridges, as recorded by their imports and exports: the last successful pull and push, the consecutive failures and whether the remote API is rate limiting them.
+
+A webhook can be alerted when a bridge has been failing for too long, and again when it recovers, with the following git config keys:
+- git-bug.bridge-health.webhook: the URL receiving the alerts, as a JSON POST request
+- git-bug.bridge-health.alert-after: how long a bridge must have been failing before an alert, 1h by default
+
+The health is also available from the /metrics endpoint of the webui, for the monitoring systems.`,
+		Example: `Alert a webhook when a bridge has been failing for 30 minutes:
+git config git-bug.bridge-health.webhook https://example.com/hooks/git-bug
+git config git-bug.bridge-health.alert-after 30m
+git bug bridge health
+`,
+		PreRunE: execenv.LoadBackendOrReadOnly(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBridgeHealth(env, options, args)
+		}),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completion.Bridge(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.format, "format", "f", "default",
+		"Select the output formatting style. Valid values are [default,json]")
+	cmd.RegisterFlagCompletionFunc("format", completion.From([]string{"default", "json"}))
+
+	return cmd
+}
+
+func runBridgeHealth(env *execenv.Env, opts bridgeHealthOptions, args []string) error {
+	names, err := bridge.ConfiguredBridges(env.Backend)
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	if len(args) > 0 {
+		found := false
+		for _, name := range names {
+			if name == args[0] {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown bridge %s", args[0])
+		}
+		names = args
+	}
+
+	healths := make([]core.Health, len(names))
+	for i, name := range names {
+		healths[i], err = bridge.ReadHealth(env.Backend, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch opts.format {
+	case "json":
+		return bridgeHealthJsonFormatter(env, healths)
+	case "default":
+		return bridgeHealthDefaultFormatter(env, healths)
+	default:
+		return fmt.Errorf("unknown format %s", opts.format)
+	}
+}
+
+func bridgeHealthDefaultFormatter(env *execenv.Env, healths []core.Health) error {
+	for _, health := range healths {
+		status := colors.Green("healthy")
+		if health.Failing() {
+			status = colors.Red("failing since " + humanize.Time(health.FailingSince()))
+		}
+		env.Out.Printf("%s: %s\n", health.Name, status)
+		env.Out.Printf("  pull: %s\n", formatSyncHealth(health.Import))
+		env.Out.Printf("  push: %s\n", formatSyncHealth(health.Export))
+	}
+
+	return nil
+}
+
+func formatSyncHealth(sh core.SyncHealth) string {
+	var parts []string
+
+	if sh.LastSuccess.IsZero() {
+		parts = append(parts, "never succeeded")
+	} else {
+		parts = append(parts, "last success "+humanize.Time(sh.LastSuccess))
+	}
+	if sh.Failing() {
+		parts = append(parts, colors.Red(fmt.Sprintf("%d consecutive failures", sh.ConsecutiveFailures)))
+		parts = append(parts, "last error: "+sh.LastError)
+	}
+	if sh.RateLimited {
+		parts = append(parts, colors.Yellow("rate limited"))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+type JSONBridgeHealth struct {
+	Name    string         `json:"name"`
+	Failing bool           `json:"failing"`
+	Import  JSONSyncHealth `json:"import"`
+	Export  JSONSyncHealth `json:"export"`
+}
+
+type JSONSyncHealth struct {
+	LastSuccess         *time.Time `json:"last_success,omitempty"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	FailingSince        *time.Time `json:"failing_since,omitempty"`
+	LastError           string     `json:"last_error,omitempty"`
+	RateLimited         bool       `json:"rate_limited"`
+}
+
+func newJSONSyncHealth(sh core.SyncHealth) JSONSyncHealth {
+	result := JSONSyncHealth{
+		ConsecutiveFailures: sh.ConsecutiveFailures,
+		LastError:           sh.LastError,
+		RateLimited:         sh.RateLimited,
+	}
+	if !sh.LastSuccess.IsZero() {
+		result.LastSuccess = &sh.LastSuccess
+	}
+	if !sh.FailingSince.IsZero() {
+		result.FailingSince = &sh.FailingSince
+	}
+	return result
+}
+
+func bridgeHealthJsonFormatter(env *execenv.Env, healths []core.Health) error {
+	result := make([]JSONBridgeHealth, len(healths))
+	for i, health := range healths {
+		result[i] = JSONBridgeHealth{
+			Name:    health.Name,
+			Failing: health.Failing(),
+			Import:  newJSONSyncHealth(health.Import),
+			Export:  newJSONSyncHealth(health.Export),
+		}
+	}
+
+	jsonObject, _ := json.MarshalIndent(result, "", "    ")
+	env.Out.Printf("%s\n", jsonObject)
+	return nil
+}
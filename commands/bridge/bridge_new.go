@@ -23,6 +23,8 @@ type bridgeNewOptions struct {
 	params         core.BridgeParams
 	token          string
 	tokenStdin     bool
+	lazyClosed     bool
+	discussions    bool
 	nonInteractive bool
 }
 
@@ -39,6 +41,7 @@ func newBridgeNewCommand() *cobra.Command {
 [2]: gitlab
 [3]: jira
 [4]: launchpad-preview
+[5]: phabricator
 
 target: 1
 name [default]: default
@@ -81,6 +84,13 @@ git bug bridge new \
     --target=launchpad-preview \
     --url=https://bugs.launchpad.net/ubuntu/
 
+# For Phabricator
+git bug bridge new \
+    --name=default \
+    --target=phabricator \
+    --url=https://phabricator.example.com/tag/my-project/ \
+    --token=$(TOKEN)
+
 # For Gitlab
 git bug bridge new \
     --name=default \
@@ -108,6 +118,8 @@ git bug bridge new \
 	flags.BoolVar(&options.tokenStdin, "token-stdin", false, "Will read the token from stdin and ignore --token")
 	flags.StringVarP(&options.params.Owner, "owner", "o", "", "The owner of the remote repository")
 	flags.StringVarP(&options.params.Project, "project", "p", "", "The name of the remote repository")
+	flags.BoolVar(&options.lazyClosed, "lazy-closed", false, "Only import the open issues up front, the closed ones being imported on demand (ex: \"git bug show github#1234\")")
+	flags.BoolVar(&options.discussions, "discussions", false, "Also import the Github discussions, as bugs labeled \"discussion\"")
 	flags.BoolVar(&options.nonInteractive, "non-interactive", false, "Do not ask for user input")
 
 	return cmd
@@ -140,6 +152,14 @@ func runBridgeNew(env *execenv.Env, opts bridgeNewOptions) error {
 		opts.params.TokenRaw = opts.token
 	}
 
+	if opts.lazyClosed {
+		opts.params.LazyClosed = "true"
+	}
+
+	if opts.discussions {
+		opts.params.Discussions = "true"
+	}
+
 	if !opts.nonInteractive && opts.target == "" {
 		opts.target, err = promptTarget()
 		if err != nil {
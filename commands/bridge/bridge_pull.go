@@ -21,6 +21,7 @@ import (
 type bridgePullOptions struct {
 	importSince string
 	noResume    bool
+	streaming   bool
 }
 
 func newBridgePullCommand() *cobra.Command {
@@ -43,6 +44,7 @@ func newBridgePullCommand() *cobra.Command {
 
 	flags.BoolVarP(&options.noResume, "no-resume", "n", false, "force importing all bugs")
 	flags.StringVarP(&options.importSince, "since", "s", "", "import only bugs updated after the given date (ex: \"200h\" or \"june 2 2019\")")
+	flags.BoolVar(&options.streaming, "streaming", false, "import with a bounded memory, for very large bug trackers")
 
 	return cmd
 }
@@ -65,6 +67,10 @@ func runBridgePull(env *execenv.Env, opts bridgePullOptions, args []string) erro
 		return err
 	}
 
+	if opts.streaming {
+		b.EnableStreaming()
+	}
+
 	parentCtx := context.Background()
 	ctx, cancel := context.WithCancel(parentCtx)
 	defer cancel()
@@ -15,24 +15,35 @@ import (
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
+type bridgePushOptions struct {
+	allowConfidential bool
+}
+
 func newBridgePushCommand() *cobra.Command {
 	env := execenv.NewEnv()
+	options := bridgePushOptions{}
 
 	cmd := &cobra.Command{
 		Use:     "push [NAME]",
 		Short:   "Push updates to remote bug tracker",
 		PreRunE: execenv.LoadBackendEnsureUser(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
-			return runBridgePush(env, args)
+			return runBridgePush(env, options, args)
 		}),
 		Args:              cobra.MaximumNArgs(1),
 		ValidArgsFunction: completion.Bridge(env),
 	}
 
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVar(&options.allowConfidential, "allow-confidential", false,
+		"Also export the bugs and comments flagged as confidential")
+
 	return cmd
 }
 
-func runBridgePush(env *execenv.Env, args []string) error {
+func runBridgePush(env *execenv.Env, opts bridgePushOptions, args []string) error {
 	var b *core.Bridge
 	var err error
 
@@ -46,6 +57,13 @@ func runBridgePush(env *execenv.Env, args []string) error {
 		return err
 	}
 
+	if opts.allowConfidential {
+		err = b.AllowConfidential()
+		if err != nil {
+			return err
+		}
+	}
+
 	parentCtx := context.Background()
 	ctx, cancel := context.WithCancel(parentCtx)
 	defer cancel()
@@ -17,6 +17,7 @@ import (
 	"github.com/MichaelMure/git-bug/entities/bug"
 	"github.com/MichaelMure/git-bug/entities/common"
 	"github.com/MichaelMure/git-bug/query"
+	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/colors"
 )
 
@@ -26,12 +27,17 @@ type bugOptions struct {
 	metadataQuery    []string
 	participantQuery []string
 	actorQuery       []string
+	assigneeQuery    []string
 	labelQuery       []string
 	titleQuery       []string
 	noQuery          []string
+	me               bool
 	sortBy           string
 	sortDirection    string
 	outputFormat     string
+	explain          string
+	allTrackers      bool
+	unread           bool
 }
 
 func NewBugCommand() *cobra.Command {
@@ -55,8 +61,15 @@ git bug "foo bar" baz
 
 Use queries, flags, and full text search:
 git bug status:open --by creation "foo bar" baz
+
+Explain why a bug is or isn't part of a query results:
+git bug status:open label:ui --explain 2f0d3b1
+
+List the open bugs of this repository and of its related trackers:
+git config git-bug.tracker.lib.path ../lib
+git bug status:open --all-trackers
 `,
-		PreRunE: execenv.LoadBackend(env),
+		PreRunE: execenv.LoadBackendOrReadOnly(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
 			return runBug(env, options, args)
 		}),
@@ -67,8 +80,8 @@ git bug status:open --by creation "foo bar" baz
 	flags.SortFlags = false
 
 	flags.StringSliceVarP(&options.statusQuery, "status", "s", nil,
-		"Filter by status. Valid values are [open,closed]")
-	cmd.RegisterFlagCompletionFunc("status", completion.From([]string{"open", "closed"}))
+		"Filter by status or by state of the workflow. Valid values are [open,closed] and the states")
+	cmd.RegisterFlagCompletionFunc("status", completion.Status(env))
 	flags.StringSliceVarP(&options.authorQuery, "author", "a", nil,
 		"Filter by author")
 	flags.StringSliceVarP(&options.metadataQuery, "metadata", "m", nil,
@@ -77,20 +90,27 @@ git bug status:open --by creation "foo bar" baz
 	flags.StringSliceVarP(&options.participantQuery, "participant", "p", nil,
 		"Filter by participant")
 	cmd.RegisterFlagCompletionFunc("participant", completion.UserForQuery(env))
+	flags.BoolVar(&options.me, "me", false,
+		"Only show the bugs the user opened or commented on. Same as --participant me")
 	flags.StringSliceVarP(&options.actorQuery, "actor", "A", nil,
 		"Filter by actor")
 	cmd.RegisterFlagCompletionFunc("actor", completion.UserForQuery(env))
+	flags.StringSliceVar(&options.assigneeQuery, "assignee", nil,
+		"Filter by assignee")
+	cmd.RegisterFlagCompletionFunc("assignee", completion.UserForQuery(env))
 	flags.StringSliceVarP(&options.labelQuery, "label", "l", nil,
 		"Filter by label")
 	cmd.RegisterFlagCompletionFunc("label", completion.Label(env))
 	flags.StringSliceVarP(&options.titleQuery, "title", "t", nil,
 		"Filter by title")
+	flags.BoolVar(&options.unread, "unread", false,
+		"Only show the bugs edited since the user last read them. Same as is:unread")
 	flags.StringSliceVarP(&options.noQuery, "no", "n", nil,
-		"Filter by absence of something. Valid values are [label]")
+		"Filter by absence of something. Valid values are [label,assignee]")
 	cmd.RegisterFlagCompletionFunc("no", completion.Label(env))
 	flags.StringVarP(&options.sortBy, "by", "b", "creation",
-		"Sort the results by a characteristic. Valid values are [id,creation,edit]")
-	cmd.RegisterFlagCompletionFunc("by", completion.From([]string{"id", "creation", "edit"}))
+		"Sort the results by a characteristic. Valid values are [id,creation,edit,comments,last-comment]")
+	cmd.RegisterFlagCompletionFunc("by", completion.From([]string{"id", "creation", "edit", "comments", "last-comment"}))
 	flags.StringVarP(&options.sortDirection, "direction", "d", "asc",
 		"Select the sorting direction. Valid values are [asc,desc]")
 	cmd.RegisterFlagCompletionFunc("direction", completion.From([]string{"asc", "desc"}))
@@ -98,6 +118,11 @@ git bug status:open --by creation "foo bar" baz
 		"Select the output formatting style. Valid values are [default,plain,compact,id,json,org-mode]")
 	cmd.RegisterFlagCompletionFunc("format",
 		completion.From([]string{"default", "plain", "compact", "id", "json", "org-mode"}))
+	flags.StringVar(&options.explain, "explain", "",
+		"Instead of listing the bugs, explain which filters of the query match the given bug, and why")
+	cmd.RegisterFlagCompletionFunc("explain", completion.Bug(env))
+	flags.BoolVar(&options.allTrackers, "all-trackers", false,
+		"Also list the bugs of the related trackers declared as \"git-bug.tracker.<name>.path\" in the git config, with their ids prefixed by the name of the tracker")
 
 	const selectGroup = "select"
 	cmd.AddGroup(&cobra.Group{ID: selectGroup, Title: "Implicit selection"})
@@ -110,13 +135,25 @@ git bug status:open --by creation "foo bar" baz
 	addCmdWithGroup(newBugDeselectCommand(), selectGroup)
 	addCmdWithGroup(newBugSelectCommand(), selectGroup)
 
+	cmd.AddCommand(newBugAssignCommand())
+	cmd.AddCommand(newBugAttachCommand())
 	cmd.AddCommand(newBugCommentCommand())
+	cmd.AddCommand(newBugConflictsCommand())
+	cmd.AddCommand(newBugExportCommand())
+	cmd.AddCommand(newBugFieldCommand())
+	cmd.AddCommand(newBugGrepCommand())
 	cmd.AddCommand(newBugLabelCommand())
+	cmd.AddCommand(newBugLogCommand())
+	cmd.AddCommand(newBugMergeIntoCommand())
 	cmd.AddCommand(newBugNewCommand())
+	cmd.AddCommand(newBugRelationCommand())
+	cmd.AddCommand(newBugRequestInfoCommand())
 	cmd.AddCommand(newBugRmCommand())
 	cmd.AddCommand(newBugShowCommand())
 	cmd.AddCommand(newBugStatusCommand())
 	cmd.AddCommand(newBugTitleCommand())
+	cmd.AddCommand(newBugTranslateCommand())
+	cmd.AddCommand(newBugWhyClosedCommand())
 
 	return cmd
 }
@@ -142,36 +179,176 @@ func runBug(env *execenv.Env, opts bugOptions, args []string) error {
 		return err
 	}
 
-	allIds, err := env.Backend.QueryBugs(q)
+	if opts.explain != "" {
+		return explainBug(env, q, opts.explain)
+	}
+
+	var bugs []listedBug
+
+	if opts.allTrackers {
+		mrc := cache.NewMultiRepoCache()
+		defer mrc.Close()
+
+		mrc.AddDefaultRepoCache(env.Backend)
+		err = mrc.RegisterRelatedTrackers(env.Repo, func(path string) (repository.ClockedRepo, error) {
+			return repository.OpenGoGitRepo(path, execenv.GitBugNamespace, []repository.ClockLoader{bug.ClockLoader})
+		})
+		if err != nil {
+			return err
+		}
+
+		bugs, err = multiRepoBugs(mrc, q)
+		if err != nil {
+			return err
+		}
+	} else {
+		allIds, err := env.Backend.QueryBugs(q)
+		if err != nil {
+			return err
+		}
+
+		workflow, err := env.Backend.Workflow()
+		if err != nil {
+			return err
+		}
+
+		bugs = make([]listedBug, len(allIds))
+		for i, id := range allIds {
+			b, err := env.Backend.ResolveBugExcerpt(id)
+			if err != nil {
+				return err
+			}
+			bugs[i] = listedBug{RepoBugExcerpt: cache.RepoBugExcerpt{BugExcerpt: b}, backend: env.Backend, workflow: workflow}
+		}
+	}
+
+	return formatBugs(env, bugs, opts.outputFormat)
+}
+
+// ListMultiRepoBugs list the bugs of all the repositories of mrc matching the
+// query given as arguments, with their ids prefixed by the name of their
+// repository, in one of the output formats of "git bug".
+func ListMultiRepoBugs(env *execenv.Env, mrc *cache.MultiRepoCache, args []string, format string) error {
+	q := query.NewQuery()
+	if len(args) >= 1 {
+		var err error
+		q, err = query.Parse(repairQuery(args))
+		if err != nil {
+			return err
+		}
+	}
+
+	bugs, err := multiRepoBugs(mrc, q)
 	if err != nil {
 		return err
 	}
 
-	bugExcerpt := make([]*cache.BugExcerpt, len(allIds))
-	for i, id := range allIds {
-		b, err := env.Backend.ResolveBugExcerpt(id)
+	return formatBugs(env, bugs, format)
+}
+
+// multiRepoBugs return the bugs of all the repositories of mrc matching the query
+func multiRepoBugs(mrc *cache.MultiRepoCache, q *query.Query) ([]listedBug, error) {
+	excerpts, err := mrc.QueryBugs(q)
+	if err != nil {
+		return nil, err
+	}
+
+	bugs := make([]listedBug, len(excerpts))
+	workflows := make(map[*cache.RepoCache]*bug.Workflow)
+	for i, excerpt := range excerpts {
+		backend, err := mrc.DefaultRepo()
+		if excerpt.Repo != "" {
+			backend, err = mrc.ResolveRepo(excerpt.Repo)
+		}
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if _, ok := workflows[backend]; !ok {
+			workflows[backend], err = backend.Workflow()
+			if err != nil {
+				return nil, err
+			}
 		}
-		bugExcerpt[i] = b
+		bugs[i] = listedBug{RepoBugExcerpt: excerpt, backend: backend, workflow: workflows[backend]}
 	}
 
-	switch opts.outputFormat {
+	return bugs, nil
+}
+
+func formatBugs(env *execenv.Env, bugs []listedBug, format string) error {
+	switch format {
 	case "org-mode":
-		return bugsOrgmodeFormatter(env, bugExcerpt)
+		return bugsOrgmodeFormatter(env, bugs)
 	case "plain":
-		return bugsPlainFormatter(env, bugExcerpt)
+		return bugsPlainFormatter(env, bugs)
 	case "json":
-		return bugsJsonFormatter(env, bugExcerpt)
+		return bugsJsonFormatter(env, bugs)
 	case "compact":
-		return bugsCompactFormatter(env, bugExcerpt)
+		return bugsCompactFormatter(env, bugs)
 	case "id":
-		return bugsIDFormatter(env, bugExcerpt)
+		return bugsIDFormatter(env, bugs)
 	case "default":
-		return bugsDefaultFormatter(env, bugExcerpt)
+		return bugsDefaultFormatter(env, bugs)
 	default:
-		return fmt.Errorf("unknown format %s", opts.outputFormat)
+		return fmt.Errorf("unknown format %s", format)
+	}
+}
+
+// listedBug is a bug to list, with the cache of the repository holding it
+type listedBug struct {
+	cache.RepoBugExcerpt
+	backend  *cache.RepoCache
+	workflow *bug.Workflow
+}
+
+// state return the state of the bug in the workflow of its repository
+func (b listedBug) state() string {
+	return b.workflow.StateOf(b.Status, b.State)
+}
+
+func explainBug(env *execenv.Env, q *query.Query, prefix string) error {
+	excerpt, err := env.Backend.ResolveBugExcerptPrefix(prefix)
+	if err != nil {
+		return err
+	}
+
+	explanation, err := env.Backend.ExplainQuery(q, excerpt.Id)
+	if err != nil {
+		return err
+	}
+
+	env.Out.Printf("%s %s\n", colors.Cyan(excerpt.Id.Human()), excerpt.Title)
+
+	if len(explanation.Groups) == 0 {
+		env.Out.Println("the query has no filter, all bugs match")
+		return nil
+	}
+
+	for _, group := range explanation.Groups {
+		combine := "all of"
+		if group.AnyOf {
+			combine = "any of"
+		}
+		env.Out.Printf("%s (%s): %s\n", group.Qualifier, combine, explainVerdict(group.Matched))
+		for _, filter := range group.Filters {
+			env.Out.Printf("  %s %s: %s\n", explainVerdict(filter.Matched), filter.Filter, filter.Reason)
+		}
+	}
+
+	if explanation.Matched {
+		env.Out.Println(colors.Green("the bug matches the query"))
+	} else {
+		env.Out.Println(colors.Red("the bug doesn't match the query"))
+	}
+
+	return nil
+}
+
+func explainVerdict(matched bool) string {
+	if matched {
+		return colors.Green("match")
 	}
+	return colors.Red("no match")
 }
 
 func repairQuery(args []string) string {
@@ -190,10 +367,12 @@ func repairQuery(args []string) string {
 type JSONBugExcerpt struct {
 	Id         string       `json:"id"`
 	HumanId    string       `json:"human_id"`
+	Tracker    string       `json:"tracker,omitempty"`
 	CreateTime cmdjson.Time `json:"create_time"`
 	EditTime   cmdjson.Time `json:"edit_time"`
 
 	Status       string             `json:"status"`
+	State        string             `json:"state"`
 	Labels       []bug.Label        `json:"labels"`
 	Title        string             `json:"title"`
 	Actors       []cmdjson.Identity `json:"actors"`
@@ -204,22 +383,24 @@ type JSONBugExcerpt struct {
 	Metadata map[string]string `json:"metadata"`
 }
 
-func bugsJsonFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) error {
-	jsonBugs := make([]JSONBugExcerpt, len(bugExcerpts))
-	for i, b := range bugExcerpts {
+func bugsJsonFormatter(env *execenv.Env, bugs []listedBug) error {
+	jsonBugs := make([]JSONBugExcerpt, len(bugs))
+	for i, b := range bugs {
 		jsonBug := JSONBugExcerpt{
 			Id:         b.Id.String(),
 			HumanId:    b.Id.Human(),
+			Tracker:    b.Repo,
 			CreateTime: cmdjson.NewTime(b.CreateTime(), b.CreateLamportTime),
 			EditTime:   cmdjson.NewTime(b.EditTime(), b.EditLamportTime),
 			Status:     b.Status.String(),
+			State:      b.state(),
 			Labels:     b.Labels,
 			Title:      b.Title,
 			Comments:   b.LenComments,
 			Metadata:   b.CreateMetadata,
 		}
 
-		author, err := env.Backend.ResolveIdentityExcerpt(b.AuthorId)
+		author, err := b.backend.ResolveIdentityExcerpt(b.AuthorId)
 		if err != nil {
 			return err
 		}
@@ -227,7 +408,7 @@ func bugsJsonFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) error
 
 		jsonBug.Actors = make([]cmdjson.Identity, len(b.Actors))
 		for i, element := range b.Actors {
-			actor, err := env.Backend.ResolveIdentityExcerpt(element)
+			actor, err := b.backend.ResolveIdentityExcerpt(element)
 			if err != nil {
 				return err
 			}
@@ -236,7 +417,7 @@ func bugsJsonFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) error
 
 		jsonBug.Participants = make([]cmdjson.Identity, len(b.Participants))
 		for i, element := range b.Participants {
-			participant, err := env.Backend.ResolveIdentityExcerpt(element)
+			participant, err := b.backend.ResolveIdentityExcerpt(element)
 			if err != nil {
 				return err
 			}
@@ -250,24 +431,24 @@ func bugsJsonFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) error
 	return nil
 }
 
-func bugsCompactFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) error {
-	for _, b := range bugExcerpts {
-		author, err := env.Backend.ResolveIdentityExcerpt(b.AuthorId)
+func bugsCompactFormatter(env *execenv.Env, bugs []listedBug) error {
+	for _, b := range bugs {
+		author, err := b.backend.ResolveIdentityExcerpt(b.AuthorId)
 		if err != nil {
 			return err
 		}
 
 		var labelsTxt strings.Builder
 		for _, l := range b.Labels {
-			lc256 := l.Color().Term256()
+			lc256 := b.backend.LabelColor(l).Term256()
 			labelsTxt.WriteString(lc256.Escape())
 			labelsTxt.WriteString("◼")
 			labelsTxt.WriteString(lc256.Unescape())
 		}
 
 		env.Out.Printf("%s %s %s %s %s\n",
-			colors.Cyan(b.Id.Human()),
-			colors.Yellow(b.Status),
+			colors.Cyan(b.NamespacedHumanId()),
+			colors.Yellow(b.state()),
 			text.LeftPadMaxLine(strings.TrimSpace(b.Title), 40, 0),
 			text.LeftPadMaxLine(labelsTxt.String(), 5, 0),
 			colors.Magenta(text.TruncateMax(author.DisplayName(), 15)),
@@ -276,24 +457,24 @@ func bugsCompactFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) err
 	return nil
 }
 
-func bugsIDFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) error {
-	for _, b := range bugExcerpts {
-		env.Out.Println(b.Id.String())
+func bugsIDFormatter(env *execenv.Env, bugs []listedBug) error {
+	for _, b := range bugs {
+		env.Out.Println(b.NamespacedId())
 	}
 
 	return nil
 }
 
-func bugsDefaultFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) error {
-	for _, b := range bugExcerpts {
-		author, err := env.Backend.ResolveIdentityExcerpt(b.AuthorId)
+func bugsDefaultFormatter(env *execenv.Env, bugs []listedBug) error {
+	for _, b := range bugs {
+		author, err := b.backend.ResolveIdentityExcerpt(b.AuthorId)
 		if err != nil {
 			return err
 		}
 
 		var labelsTxt strings.Builder
 		for _, l := range b.Labels {
-			lc256 := l.Color().Term256()
+			lc256 := b.backend.LabelColor(l).Term256()
 			labelsTxt.WriteString(lc256.Escape())
 			labelsTxt.WriteString(" ◼")
 			labelsTxt.WriteString(lc256.Unescape())
@@ -313,8 +494,8 @@ func bugsDefaultFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) err
 		}
 
 		env.Out.Printf("%s\t%s\t%s\t%s\t%s\n",
-			colors.Cyan(b.Id.Human()),
-			colors.Yellow(b.Status),
+			colors.Cyan(b.NamespacedHumanId()),
+			colors.Yellow(b.state()),
 			titleFmt+labelsFmt,
 			colors.Magenta(authorFmt),
 			comments,
@@ -323,14 +504,14 @@ func bugsDefaultFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) err
 	return nil
 }
 
-func bugsPlainFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) error {
-	for _, b := range bugExcerpts {
-		env.Out.Printf("%s [%s] %s\n", b.Id.Human(), b.Status, strings.TrimSpace(b.Title))
+func bugsPlainFormatter(env *execenv.Env, bugs []listedBug) error {
+	for _, b := range bugs {
+		env.Out.Printf("%s [%s] %s\n", b.NamespacedHumanId(), b.state(), strings.TrimSpace(b.Title))
 	}
 	return nil
 }
 
-func bugsOrgmodeFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) error {
+func bugsOrgmodeFormatter(env *execenv.Env, bugs []listedBug) error {
 	// see https://orgmode.org/manual/Tags.html
 	orgTagRe := regexp.MustCompile("[^[:alpha:]_@]")
 	formatTag := func(l bug.Label) string {
@@ -343,7 +524,7 @@ func bugsOrgmodeFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) err
 
 	env.Out.Println("#+TODO: OPEN | CLOSED")
 
-	for _, b := range bugExcerpts {
+	for _, b := range bugs {
 		status := strings.ToUpper(b.Status.String())
 
 		var title string
@@ -353,7 +534,7 @@ func bugsOrgmodeFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) err
 			title = b.Title
 		}
 
-		author, err := env.Backend.ResolveIdentityExcerpt(b.AuthorId)
+		author, err := b.backend.ResolveIdentityExcerpt(b.AuthorId)
 		if err != nil {
 			return err
 		}
@@ -370,7 +551,7 @@ func bugsOrgmodeFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) err
 
 		env.Out.Printf("* %-6s %s %s %s: %s %s\n",
 			status,
-			b.Id.Human(),
+			b.NamespacedHumanId(),
 			formatTime(b.CreateTime()),
 			author.DisplayName(),
 			title,
@@ -381,7 +562,7 @@ func bugsOrgmodeFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) err
 
 		env.Out.Printf("** Actors:\n")
 		for _, element := range b.Actors {
-			actor, err := env.Backend.ResolveIdentityExcerpt(element)
+			actor, err := b.backend.ResolveIdentityExcerpt(element)
 			if err != nil {
 				return err
 			}
@@ -394,7 +575,7 @@ func bugsOrgmodeFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) err
 
 		env.Out.Printf("** Participants:\n")
 		for _, element := range b.Participants {
-			participant, err := env.Backend.ResolveIdentityExcerpt(element)
+			participant, err := b.backend.ResolveIdentityExcerpt(element)
 			if err != nil {
 				return err
 			}
@@ -412,11 +593,15 @@ func bugsOrgmodeFormatter(env *execenv.Env, bugExcerpts []*cache.BugExcerpt) err
 // Finish the command flags transformation into the query.Query
 func completeQuery(q *query.Query, opts bugOptions) error {
 	for _, str := range opts.statusQuery {
-		status, err := common.StatusFromString(str)
-		if err != nil {
+		// "open" and "closed" match all the states with that status
+		if status, err := common.StatusFromString(str); err == nil {
+			q.Status = append(q.Status, status)
+			continue
+		}
+		if err := bug.ValidateStateName(str); err != nil {
 			return err
 		}
-		q.Status = append(q.Status, status)
+		q.State = append(q.State, str)
 	}
 
 	q.Author = append(q.Author, opts.authorQuery...)
@@ -431,14 +616,23 @@ func completeQuery(q *query.Query, opts bugOptions) error {
 		q.Metadata = append(q.Metadata, pair)
 	}
 	q.Participant = append(q.Participant, opts.participantQuery...)
+	if opts.me {
+		q.Participant = append(q.Participant, "me")
+	}
 	q.Actor = append(q.Actor, opts.actorQuery...)
+	q.Assignee = append(q.Assignee, opts.assigneeQuery...)
 	q.Label = append(q.Label, opts.labelQuery...)
 	q.Title = append(q.Title, opts.titleQuery...)
+	if opts.unread {
+		q.Unread = true
+	}
 
 	for _, no := range opts.noQuery {
 		switch no {
 		case "label":
 			q.NoLabel = true
+		case "assignee":
+			q.NoAssignee = true
 		default:
 			return fmt.Errorf("unknown \"no\" filter %s", no)
 		}
@@ -451,6 +645,10 @@ func completeQuery(q *query.Query, opts bugOptions) error {
 		q.OrderBy = query.OrderByCreation
 	case "edit":
 		q.OrderBy = query.OrderByEdit
+	case "comments":
+		q.OrderBy = query.OrderByComments
+	case "last-comment":
+		q.OrderBy = query.OrderByLastComment
 	default:
 		return fmt.Errorf("unknown sort flag %s", opts.sortBy)
 	}
@@ -0,0 +1,75 @@
+package bugcmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func newBugAssignCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "assign [BUG_ID]",
+		Short: "Display the assignees of a bug",
+		Long: `Display the assignees of a bug.
+
+A bug can be assigned to several users. The assigned bugs can be listed with the "assignee:" query.`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugAssign(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	cmd.AddCommand(newBugAssignNewCommand())
+	cmd.AddCommand(newBugAssignRmCommand())
+
+	return cmd
+}
+
+func runBugAssign(env *execenv.Env, args []string) error {
+	b, _, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range b.Snapshot().Assignees {
+		excerpt, err := env.Backend.ResolveIdentityExcerpt(id)
+		if err != nil {
+			// the identity might not be known locally
+			env.Out.Println(colors.Cyan(id.Human()))
+			continue
+		}
+		env.Out.Printf("%s %s\n", colors.Cyan(id.Human()), excerpt.DisplayName())
+	}
+
+	return nil
+}
+
+// resolveAssignees resolve the users given as id prefixes, or "me" for the
+// user identity
+func resolveAssignees(env *execenv.Env, users []string) ([]entity.Id, error) {
+	ids := make([]entity.Id, 0, len(users))
+	for _, user := range users {
+		if user == "me" {
+			i, err := env.Backend.GetUserIdentity()
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, i.Id())
+			continue
+		}
+
+		i, err := env.Backend.ResolveIdentityPrefix(user)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, i.Id())
+	}
+	return ids, nil
+}
@@ -0,0 +1,52 @@
+package bugcmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newBugAssignNewCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "new [BUG_ID] USER...",
+		Short:   "Assign a bug to users",
+		Long:    `Assign a bug to users, given by their id prefix, or "me" for the user identity.`,
+		Example: `git bug bug assign new 2f9b7ae me 9fd3c21`,
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugAssignNew(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	return cmd
+}
+
+func runBugAssignNew(env *execenv.Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		return errors.New("at least one user is required")
+	}
+
+	added, err := resolveAssignees(env, args)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.SetAssignee(added, nil)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
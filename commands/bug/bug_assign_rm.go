@@ -0,0 +1,51 @@
+package bugcmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newBugAssignRmCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "rm [BUG_ID] USER...",
+		Short:   "Unassign users from a bug",
+		Long:    `Unassign users from a bug, given by their id prefix, or "me" for the user identity.`,
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugAssignRm(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	return cmd
+}
+
+func runBugAssignRm(env *execenv.Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		return errors.New("at least one user is required")
+	}
+
+	removed, err := resolveAssignees(env, args)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.SetAssignee(nil, removed)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
@@ -0,0 +1,43 @@
+package bugcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugAssign(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	user, err := env.Backend.GetUserIdentity()
+	require.NoError(t, err)
+
+	require.Error(t, runBugAssignNew(env, []string{bugID.Human()}))
+	require.Error(t, runBugAssignNew(env, []string{bugID.Human(), "unknown"}))
+
+	require.NoError(t, runBugAssignNew(env, []string{bugID.Human(), "me"}))
+
+	require.NoError(t, runBugAssign(env, []string{bugID.Human()}))
+	require.Equal(t, user.Id().Human()+" "+user.DisplayName()+"\n", env.Out.String())
+	env.Out.Reset()
+
+	opts := bugOptions{
+		sortDirection: "asc",
+		sortBy:        "creation",
+		outputFormat:  "id",
+	}
+
+	require.NoError(t, runBug(env, opts, []string{"assignee:" + user.Id().Human()}))
+	require.Equal(t, bugID.String()+"\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugAssignRm(env, []string{bugID.Human(), user.Id().Human()}))
+
+	require.NoError(t, runBugAssign(env, []string{bugID.Human()}))
+	require.Empty(t, env.Out.String())
+
+	require.NoError(t, runBug(env, opts, []string{"no:assignee"}))
+	require.Equal(t, bugID.String()+"\n", env.Out.String())
+}
@@ -0,0 +1,21 @@
+package bugcmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newBugAttachCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attach",
+		Short: "Manage the files attached to a bug",
+		Long: `Manage the files attached to a bug.
+
+The files are stored in git, and pushed and pulled along with the bug. Their size is limited to 100MB by default, which can be changed with "git-bug.max-attachment-size" in the git config, 0 disabling the limit.`,
+	}
+
+	cmd.AddCommand(newBugAttachAddCommand())
+	cmd.AddCommand(newBugAttachGetCommand())
+	cmd.AddCommand(newBugAttachLsCommand())
+
+	return cmd
+}
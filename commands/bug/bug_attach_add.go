@@ -0,0 +1,73 @@
+package bugcmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+type bugAttachAddOptions struct {
+	name string
+}
+
+func newBugAttachAddCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugAttachAddOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add [BUG_ID] FILE",
+		Short: "Attach a file to a bug",
+		Long:  `Attach a file to a bug, under its file name unless another one is given. The content type of the file is detected from its name, or its content.`,
+		Example: `git bug bug attach add 2f9b7ae crash.log
+git bug bug attach add 2f9b7ae /tmp/out.png --name screenshot.png`,
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugAttachAdd(env, options, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.name, "name", "n", "",
+		"The name of the attached file, instead of the name of FILE")
+
+	return cmd
+}
+
+func runBugAttachAdd(env *execenv.Env, opts bugAttachAddOptions, args []string) error {
+	b, args, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("a file to attach is required")
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	name := opts.name
+	if name == "" {
+		name = filepath.Base(args[0])
+	}
+
+	op, err := b.Attach(name, data)
+	if err != nil {
+		return err
+	}
+
+	env.Out.Printf("%s %s\n", op.Hash, op.ContentType)
+
+	return b.Commit()
+}
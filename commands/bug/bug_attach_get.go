@@ -0,0 +1,68 @@
+package bugcmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+type bugAttachGetOptions struct {
+	output string
+}
+
+func newBugAttachGetCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugAttachGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get [BUG_ID] NAME|HASH",
+		Short: "Download a file attached to a bug",
+		Long:  `Download a file attached to a bug, given its name or a prefix of its hash. The file is written in the current directory under its name, unless another output is given. When several files have the same name, the last attached one is downloaded.`,
+		Example: `git bug bug attach get 2f9b7ae crash.log
+git bug bug attach get 2f9b7ae crash.log -o - | less`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugAttachGet(env, options, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.output, "output", "o", "",
+		"Write the file to this path instead, or to the standard output with \"-\"")
+
+	return cmd
+}
+
+func runBugAttachGet(env *execenv.Env, opts bugAttachGetOptions, args []string) error {
+	b, args, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("the name or hash of the attached file is required")
+	}
+
+	attachment, data, err := b.ReadAttachment(args[0])
+	if err != nil {
+		return err
+	}
+
+	switch opts.output {
+	case "-":
+		_, err = env.Out.Write(data)
+		return err
+	case "":
+		return os.WriteFile(attachment.Name, data, 0644)
+	default:
+		return os.WriteFile(opts.output, data, 0644)
+	}
+}
@@ -0,0 +1,47 @@
+package bugcmd
+
+import (
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func newBugAttachLsCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "ls [BUG_ID]",
+		Short:   "List the files attached to a bug",
+		Long:    `List the files attached to a bug, in the order they were attached, with their hash, size, content type and author.`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugAttachLs(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	return cmd
+}
+
+func runBugAttachLs(env *execenv.Env, args []string) error {
+	b, _, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range b.Snapshot().Attachments {
+		env.Out.Printf("%s %s\t%s\t%s\t%s\n",
+			colors.Cyan(attachment.Hash[:7]),
+			attachment.Name,
+			humanize.Bytes(uint64(attachment.Size)),
+			attachment.ContentType,
+			colors.Magenta(attachment.Author.DisplayName()),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package bugcmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugAttach(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "crash.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"error": "boom"}`), 0644))
+
+	require.NoError(t, runBugAttachAdd(env, bugAttachAddOptions{}, []string{bugID.Human(), path}))
+	require.Contains(t, env.Out.String(), " application/json\n")
+	env.Out.Reset()
+
+	require.NoError(t, runBugAttachAdd(env, bugAttachAddOptions{name: "other.json"}, []string{bugID.Human(), path}))
+	env.Out.Reset()
+
+	require.Error(t, runBugAttachAdd(env, bugAttachAddOptions{}, []string{bugID.Human()}))
+	require.Error(t, runBugAttachAdd(env, bugAttachAddOptions{}, []string{bugID.Human(), filepath.Join(dir, "missing")}))
+
+	require.NoError(t, runBugAttachLs(env, []string{bugID.Human()}))
+	require.Regexp(t, `^[0-9a-f]{7} crash.json\t17 B\tapplication/json\tJohn Doe\n[0-9a-f]{7} other.json\t17 B\tapplication/json\tJohn Doe\n$`, env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugAttachGet(env, bugAttachGetOptions{output: "-"}, []string{bugID.Human(), "other.json"}))
+	require.Equal(t, `{"error": "boom"}`, env.Out.String())
+	env.Out.Reset()
+
+	out := filepath.Join(dir, "out.json")
+	require.NoError(t, runBugAttachGet(env, bugAttachGetOptions{output: out}, []string{bugID.Human(), "crash.json"}))
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, `{"error": "boom"}`, string(data))
+
+	require.Error(t, runBugAttachGet(env, bugAttachGetOptions{output: "-"}, []string{bugID.Human(), "missing.json"}))
+}
@@ -25,6 +25,9 @@ func newBugCommentCommand() *cobra.Command {
 
 	cmd.AddCommand(newBugCommentNewCommand())
 	cmd.AddCommand(newBugCommentEditCommand())
+	cmd.AddCommand(newBugCommentRedactCommand())
+	cmd.AddCommand(newBugCommentRmCommand())
+	cmd.AddCommand(newBugCommentReactCommand())
 
 	return cmd
 }
@@ -1,12 +1,15 @@
 package bugcmd
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/MichaelMure/git-bug/commands/bug/select"
 	"github.com/MichaelMure/git-bug/commands/completion"
 	"github.com/MichaelMure/git-bug/commands/execenv"
 	"github.com/MichaelMure/git-bug/commands/input"
+	"github.com/MichaelMure/git-bug/entities/bug"
 	"github.com/MichaelMure/git-bug/util/text"
 )
 
@@ -14,6 +17,7 @@ type bugCommentNewOptions struct {
 	messageFile    string
 	message        string
 	nonInteractive bool
+	confidential   bool
 }
 
 func newBugCommentNewCommand() *cobra.Command {
@@ -39,6 +43,8 @@ func newBugCommentNewCommand() *cobra.Command {
 	flags.StringVarP(&options.message, "message", "m", "",
 		"Provide the new message from the command line")
 	flags.BoolVar(&options.nonInteractive, "non-interactive", false, "Do not ask for user input")
+	flags.BoolVar(&options.confidential, "confidential", false,
+		"Flag the comment as confidential, to keep it out of public remotes and bridges")
 
 	return cmd
 }
@@ -71,7 +77,17 @@ func runBugCommentNew(env *execenv.Env, opts bugCommentNewOptions, args []string
 		}
 	}
 
-	_, _, err = b.AddComment(text.Cleanup(opts.message))
+	author, err := env.Backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	var metadata map[string]string
+	if opts.confidential {
+		metadata = bug.ConfidentialMetadata()
+	}
+
+	_, _, err = b.AddCommentRaw(author, time.Now().Unix(), text.Cleanup(opts.message), nil, metadata)
 	if err != nil {
 		return err
 	}
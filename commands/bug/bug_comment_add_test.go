@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+	"github.com/MichaelMure/git-bug/entity"
 )
 
 func TestBugCommentNew(t *testing.T) {
@@ -16,3 +17,17 @@ func TestBugCommentNew(t *testing.T) {
 	require.NoError(t, runBugComment(env, []string{bugID.String()}))
 	requireCommentsEqual(t, golden, env)
 }
+
+func TestBugCommentNewConfidential(t *testing.T) {
+	env, bugID, _ := testenv.NewTestEnvAndBugWithComment(t)
+
+	require.Empty(t, env.Backend.ConfidentialBugs())
+
+	err := runBugCommentNew(env, bugCommentNewOptions{
+		message:      "secret",
+		confidential: true,
+	}, []string{bugID.String()})
+	require.NoError(t, err)
+
+	require.Equal(t, []entity.Id{bugID}, env.Backend.ConfidentialBugs())
+}
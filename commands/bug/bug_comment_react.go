@@ -0,0 +1,63 @@
+package bugcmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+type bugCommentReactOptions struct {
+	remove bool
+}
+
+func newBugCommentReactCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugCommentReactOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "react COMMENT_ID REACTION",
+		Short: "React to a comment on a bug with an emoji",
+		Long: `React to a comment on a bug with an emoji.
+
+The reaction is one of thumbs_up, thumbs_down, laugh, hooray, confused, heart, rocket or eyes. The emoji itself and the common aliases like +1 or tada are accepted as well.`,
+		Example: `git bug comment react 2dc7b4c +1
+git bug comment react 2dc7b4c heart --remove`,
+		Args:    cobra.ExactArgs(2),
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugCommentReact(env, options, args)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVarP(&options.remove, "remove", "r", false,
+		"Remove the reaction instead of adding it")
+
+	return cmd
+}
+
+func runBugCommentReact(env *execenv.Env, opts bugCommentReactOptions, args []string) error {
+	reaction, err := bug.ReactionFromString(args[1])
+	if err != nil {
+		return err
+	}
+
+	b, commentId, err := env.Backend.ResolveComment(args[0])
+	if err != nil {
+		return err
+	}
+
+	if opts.remove {
+		_, err = b.RemoveReaction(commentId, reaction)
+	} else {
+		_, err = b.AddReaction(commentId, reaction)
+	}
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
@@ -0,0 +1,27 @@
+package bugcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+func TestBugCommentReact(t *testing.T) {
+	env, bugID, commentID := testenv.NewTestEnvAndBugWithComment(t)
+
+	require.NoError(t, runBugCommentReact(env, bugCommentReactOptions{}, []string{commentID.Human(), "+1"}))
+	require.NoError(t, runBugCommentReact(env, bugCommentReactOptions{}, []string{commentID.Human(), "🎉"}))
+	require.NoError(t, runBugCommentReact(env, bugCommentReactOptions{remove: true}, []string{commentID.Human(), "thumbs_up"}))
+
+	b, err := env.Backend.ResolveBug(bugID)
+	require.NoError(t, err)
+	comment, err := b.Snapshot().SearchComment(commentID)
+	require.NoError(t, err)
+	require.Len(t, comment.Reactions, 1)
+	require.Equal(t, bug.ReactionHooray, comment.Reactions[0].Reaction)
+
+	require.Error(t, runBugCommentReact(env, bugCommentReactOptions{}, []string{commentID.Human(), "unicorn"}))
+}
@@ -0,0 +1,58 @@
+package bugcmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+type bugCommentRedactOptions struct {
+	message string
+}
+
+func newBugCommentRedactCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugCommentRedactOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "redact COMMENT_ID",
+		Short: "Hide the content of a comment on a bug",
+		Long: `Hide the content of a comment on a bug, including its previous versions and its files. The comment is kept, with a placeholder message, unless a cleaned message is given.
+
+The operations holding the original content are still stored in the git history of the bug. Any secret that has been pushed should be considered as leaked and rotated.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugCommentRedact(env, options, args)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.message, "message", "m", "",
+		"Replace the redacted content with a cleaned message")
+
+	return cmd
+}
+
+func runBugCommentRedact(env *execenv.Env, opts bugCommentRedactOptions, args []string) error {
+	b, commentId, err := env.Backend.ResolveComment(args[0])
+	if err != nil {
+		return err
+	}
+
+	_, err = b.RedactComment(commentId)
+	if err != nil {
+		return err
+	}
+
+	if opts.message != "" {
+		_, err = b.EditComment(commentId, opts.message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return b.Commit()
+}
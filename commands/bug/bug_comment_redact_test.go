@@ -0,0 +1,34 @@
+package bugcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugCommentRedact(t *testing.T) {
+	const golden = "testdata/comment/redact"
+
+	env, bugID, commentID := testenv.NewTestEnvAndBugWithComment(t)
+
+	require.NoError(t, runBugCommentRedact(env, bugCommentRedactOptions{}, []string{commentID.Human()}))
+
+	require.NoError(t, runBugComment(env, []string{bugID.Human()}))
+	requireCommentsEqual(t, golden, env)
+}
+
+func TestBugCommentRedactWithMessage(t *testing.T) {
+	const golden = "testdata/comment/redact-message"
+
+	env, bugID, commentID := testenv.NewTestEnvAndBugWithComment(t)
+
+	opts := bugCommentRedactOptions{
+		message: "this is a cleaned bug comment",
+	}
+	require.NoError(t, runBugCommentRedact(env, opts, []string{commentID.Human()}))
+
+	require.NoError(t, runBugComment(env, []string{bugID.Human()}))
+	requireCommentsEqual(t, golden, env)
+}
@@ -0,0 +1,40 @@
+package bugcmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newBugCommentRmCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "rm COMMENT_ID",
+		Short: "Delete a comment from a bug",
+		Long: `Delete a comment from a bug. The comment is hidden from the bug, including its previous versions and its files. The description of a bug can't be deleted, only redacted.
+
+The operations holding the original content are still stored in the git history of the bug. Any secret that has been pushed should be considered as leaked and rotated.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugCommentRm(env, args)
+		}),
+	}
+
+	return cmd
+}
+
+func runBugCommentRm(env *execenv.Env, args []string) error {
+	b, commentId, err := env.Backend.ResolveComment(args[0])
+	if err != nil {
+		return err
+	}
+
+	_, err = b.DeleteComment(commentId)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
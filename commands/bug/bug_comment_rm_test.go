@@ -0,0 +1,30 @@
+package bugcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugCommentRm(t *testing.T) {
+	const golden = "testdata/comment/rm"
+
+	env, bugID, commentID := testenv.NewTestEnvAndBugWithComment(t)
+
+	require.NoError(t, runBugCommentRm(env, []string{commentID.Human()}))
+
+	require.NoError(t, runBugComment(env, []string{bugID.Human()}))
+	requireCommentsEqual(t, golden, env)
+}
+
+func TestBugCommentRmDescription(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	b, err := env.Backend.ResolveBug(bugID)
+	require.NoError(t, err)
+	descriptionID := b.Snapshot().Comments[0].CombinedId()
+
+	require.Error(t, runBugCommentRm(env, []string{descriptionID.Human()}))
+}
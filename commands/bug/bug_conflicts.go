@@ -0,0 +1,131 @@
+package bugcmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/cmdjson"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+type bugConflictsOptions struct {
+	format string
+}
+
+func newBugConflictsCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugConflictsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "conflicts [BUG_ID]",
+		Short: "Show the changes of a bug overridden by concurrent ones when merging",
+		Long: `Show the changes of a bug overridden by concurrent ones when merging.
+
+When the same bug is edited on different clones before they are synchronized, both editions are merged without failing: all the changes are applied, in an order decided when merging. When both changed the same thing, like the title or the status, only the last change is visible. This command lists those automatic resolutions, for a human to review them and fix the bug if needed.`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugConflicts(env, options, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.format, "format", "f", "default",
+		"Select the output formatting style. Valid values are [default,json]")
+	cmd.RegisterFlagCompletionFunc("format", completion.From([]string{"default", "json"}))
+
+	return cmd
+}
+
+func runBugConflicts(env *execenv.Env, opts bugConflictsOptions, args []string) error {
+	b, _, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	snap := b.Snapshot()
+
+	switch opts.format {
+	case "default":
+		conflictsDefaultFormatter(env, snap)
+		return nil
+	case "json":
+		return conflictsJsonFormatter(env, snap)
+	default:
+		return fmt.Errorf("unknown format %s", opts.format)
+	}
+}
+
+func conflictsDefaultFormatter(env *execenv.Env, snap *bug.Snapshot) {
+	if len(snap.MergeConflicts) == 0 {
+		env.Out.Printf("no merge conflict on bug %s\n", snap.Id().Human())
+		return
+	}
+
+	for i, conflict := range snap.MergeConflicts {
+		if i > 0 {
+			env.Out.Println()
+		}
+		env.Out.Printf("%s %s\n", colors.Red("merge conflict"), conflict.Field)
+		env.Out.Printf("  kept        %s %q by %s on %s\n",
+			colors.Cyan(conflict.Kept.Id().Human()),
+			conflict.KeptValue,
+			colors.Magenta(conflict.Kept.Author().DisplayName()),
+			conflict.Kept.Time().Format("Mon Jan 2 15:04:05 2006 -0700"),
+		)
+		env.Out.Printf("  overridden  %s %q by %s on %s\n",
+			colors.Cyan(conflict.Overridden.Id().Human()),
+			conflict.OverriddenValue,
+			colors.Magenta(conflict.Overridden.Author().DisplayName()),
+			conflict.Overridden.Time().Format("Mon Jan 2 15:04:05 2006 -0700"),
+		)
+	}
+}
+
+type JSONMergeConflict struct {
+	Field      string             `json:"field"`
+	Kept       JSONConflictChange `json:"kept"`
+	Overridden JSONConflictChange `json:"overridden"`
+}
+
+type JSONConflictChange struct {
+	Operation string           `json:"operation"`
+	Value     string           `json:"value"`
+	Author    cmdjson.Identity `json:"author"`
+	Time      cmdjson.Time     `json:"time"`
+}
+
+func newJSONConflictChange(op bug.Operation, value string) JSONConflictChange {
+	return JSONConflictChange{
+		Operation: op.Id().String(),
+		Value:     value,
+		Author:    cmdjson.NewIdentity(op.Author()),
+		Time:      cmdjson.NewTime(op.Time(), 0),
+	}
+}
+
+func conflictsJsonFormatter(env *execenv.Env, snap *bug.Snapshot) error {
+	jsonConflicts := make([]JSONMergeConflict, len(snap.MergeConflicts))
+	for i, conflict := range snap.MergeConflicts {
+		jsonConflicts[i] = JSONMergeConflict{
+			Field:      conflict.Field,
+			Kept:       newJSONConflictChange(conflict.Kept, conflict.KeptValue),
+			Overridden: newJSONConflictChange(conflict.Overridden, conflict.OverriddenValue),
+		}
+	}
+
+	jsonObject, err := json.MarshalIndent(jsonConflicts, "", "    ")
+	if err != nil {
+		return err
+	}
+	env.Out.Printf("%s\n", jsonObject)
+	return nil
+}
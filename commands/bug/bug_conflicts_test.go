@@ -0,0 +1,36 @@
+package bugcmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugConflicts(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	// edits made on the same clone never conflict
+	b, err := env.Backend.ResolveBug(bugID)
+	require.NoError(t, err)
+	_, err = b.SetTitle("other title")
+	require.NoError(t, err)
+	_, err = b.SetTitle("last title")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	require.NoError(t, runBugConflicts(env, bugConflictsOptions{format: "default"}, []string{bugID.Human()}))
+	require.Equal(t, "no merge conflict on bug "+bugID.Human()+"\n", env.Out.String())
+
+	env.Out.Reset()
+	require.NoError(t, runBugConflicts(env, bugConflictsOptions{format: "json"}, []string{bugID.Human()}))
+
+	var conflicts []JSONMergeConflict
+	require.NoError(t, json.Unmarshal(env.Out.Bytes(), &conflicts))
+	require.NotNil(t, conflicts)
+	require.Empty(t, conflicts)
+
+	require.Error(t, runBugConflicts(env, bugConflictsOptions{format: "xml"}, []string{bugID.Human()}))
+}
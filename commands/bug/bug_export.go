@@ -0,0 +1,99 @@
+package bugcmd
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/cmdjson"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/query"
+)
+
+type bugExportOptions struct {
+	validate bool
+}
+
+type JSONBugExport struct {
+	FormatVersion int               `json:"format_version"`
+	Bugs          []JSONBugSnapshot `json:"bugs"`
+}
+
+func newBugExportCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugExportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export [QUERY]",
+		Short: "Export bugs as a JSON document",
+		Long: `Export the bugs matching the query, with all their comments, as a single JSON document.
+
+The format of the document is described by a JSON Schema, that can be used to validate documents produced by third party tools.`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugExport(env, options, args)
+		}),
+		ValidArgsFunction: completion.Ls(env),
+	}
+
+	cmd.AddCommand(newBugExportReportCommand())
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVar(&options.validate, "validate", false,
+		"Validate the document against the JSON Schema before writing it")
+
+	return cmd
+}
+
+func runBugExport(env *execenv.Env, opts bugExportOptions, args []string) error {
+	q := query.NewQuery()
+	if len(args) > 0 {
+		var err error
+		// either the shell or cobra remove the quotes, we need them back for the query parsing
+		q, err = query.Parse(repairQuery(args))
+		if err != nil {
+			return err
+		}
+	}
+
+	ids, err := env.Backend.QueryBugs(q)
+	if err != nil {
+		return err
+	}
+
+	export := JSONBugExport{
+		FormatVersion: cmdjson.BugExportFormatVersion,
+		Bugs:          make([]JSONBugSnapshot, len(ids)),
+	}
+
+	workflow, err := env.Backend.Workflow()
+	if err != nil {
+		return err
+	}
+
+	for i, id := range ids {
+		b, err := env.Backend.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+		export.Bugs[i] = NewJSONBugSnapshot(b.Snapshot(), workflow)
+	}
+
+	data, err := json.MarshalIndent(export, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	if opts.validate {
+		if err := cmdjson.ValidateBugExport(data); err != nil {
+			return err
+		}
+	}
+
+	env.Out.Printf("%s\n", data)
+
+	return nil
+}
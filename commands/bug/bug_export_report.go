@@ -0,0 +1,235 @@
+package bugcmd
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
+)
+
+//go:embed templates/report.md
+var reportTemplates embed.FS
+
+// reportPandocEnv is the environment variable overriding the pandoc
+// executable used to convert the report
+const reportPandocEnv = "GIT_BUG_PANDOC"
+
+// the time layout of the report
+const reportTimeLayout = "2006-01-02 15:04"
+
+type bugExportReportOptions struct {
+	query  string
+	format string
+	output string
+	title  string
+}
+
+func newBugExportReportCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugExportReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Export bugs as a report document",
+		Long: `Export the bugs matching a query as a single report document, for readers without access to the tracker: a summary table of the bugs, followed by the full thread of each of them, in the order of the query.
+
+The report is written in markdown, with a pandoc metadata block. The docx and pdf formats are converted from it with pandoc, which must be installed. The pandoc executable can be overridden with the GIT_BUG_PANDOC environment variable. The pdf format also needs a LaTeX engine.`,
+		Example: `git bug bug export report --query "status:open label:release" > report.md
+git bug bug export report --query "status:open sort:edit-desc" --format docx -o report.docx`,
+		Args:    cobra.NoArgs,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugExportReport(env, options)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.query, "query", "q", "",
+		"The query selecting and ordering the bugs, all of them by default")
+	flags.StringVarP(&options.format, "format", "f", "md",
+		"Select the format of the report. Valid values are [md,docx,pdf]")
+	cmd.RegisterFlagCompletionFunc("format", completion.From([]string{"md", "docx", "pdf"}))
+	flags.StringVarP(&options.output, "output", "o", "",
+		"Write the report to this file instead of the standard output, required for docx and pdf")
+	flags.StringVarP(&options.title, "title", "t", "Bug report",
+		"The title of the report")
+
+	return cmd
+}
+
+type reportData struct {
+	Title string
+	Date  string
+	Query string
+	Bugs  []reportBug
+}
+
+type reportBug struct {
+	HumanId    string
+	Title      string
+	State      string
+	Author     string
+	Labels     []string
+	Assignees  []string
+	CreateTime string
+	EditTime   string
+	Comments   []reportComment
+}
+
+type reportComment struct {
+	Author  string
+	Time    string
+	Message string
+}
+
+func runBugExportReport(env *execenv.Env, opts bugExportReportOptions) error {
+	switch opts.format {
+	case "md":
+	case "docx", "pdf":
+		if opts.output == "" {
+			return fmt.Errorf("an output file is required for the %s format", opts.format)
+		}
+	default:
+		return fmt.Errorf("unknown format %s", opts.format)
+	}
+
+	q, err := query.Parse(opts.query)
+	if err != nil {
+		return err
+	}
+
+	ids, err := env.Backend.QueryBugs(q)
+	if err != nil {
+		return err
+	}
+
+	workflow, err := env.Backend.Workflow()
+	if err != nil {
+		return err
+	}
+
+	data := reportData{
+		Title: opts.title,
+		Date:  time.Now().Format("2006-01-02"),
+		Query: opts.query,
+		Bugs:  make([]reportBug, len(ids)),
+	}
+
+	for i, id := range ids {
+		b, err := env.Backend.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+		snap := b.Snapshot()
+
+		rb := reportBug{
+			HumanId:    snap.Id().Human(),
+			Title:      snap.Title,
+			State:      workflow.StateOf(snap.Status, snap.State),
+			Author:     snap.Author.DisplayName(),
+			CreateTime: snap.CreateTime.Format(reportTimeLayout),
+			EditTime:   snap.EditTime().Format(reportTimeLayout),
+		}
+		for _, label := range snap.Labels {
+			rb.Labels = append(rb.Labels, label.String())
+		}
+		for _, assignee := range snap.Assignees {
+			rb.Assignees = append(rb.Assignees, reportIdentityName(env, assignee))
+		}
+		for _, comment := range snap.Comments {
+			rb.Comments = append(rb.Comments, reportComment{
+				Author:  comment.Author.DisplayName(),
+				Time:    comment.UnixTime().Time().Format(reportTimeLayout),
+				Message: comment.Message,
+			})
+		}
+		data.Bugs[i] = rb
+	}
+
+	tmpl, err := template.New("report.md").Funcs(reportFuncs).ParseFS(reportTemplates, "templates/report.md")
+	if err != nil {
+		return err
+	}
+
+	var markdown bytes.Buffer
+	if err := tmpl.Execute(&markdown, data); err != nil {
+		return err
+	}
+
+	if opts.format != "md" {
+		return convertReport(markdown.Bytes(), opts.format, opts.output)
+	}
+
+	if opts.output != "" {
+		return os.WriteFile(opts.output, markdown.Bytes(), 0644)
+	}
+
+	_, err = env.Out.Write(markdown.Bytes())
+	return err
+}
+
+// reportIdentityName return the name of an identity, or its id if it's not
+// known locally
+func reportIdentityName(env *execenv.Env, id entity.Id) string {
+	excerpt, err := env.Backend.ResolveIdentityExcerpt(id)
+	if err != nil {
+		return id.Human()
+	}
+	return excerpt.DisplayName()
+}
+
+// convertReport convert the markdown report with pandoc
+func convertReport(markdown []byte, format string, output string) error {
+	pandoc, ok := os.LookupEnv(reportPandocEnv)
+	if !ok {
+		pandoc = "pandoc"
+	}
+
+	path, err := exec.LookPath(pandoc)
+	if err != nil {
+		return fmt.Errorf("pandoc is required for the %s format: %w", format, err)
+	}
+
+	cmd := exec.Command(path, "--from", "markdown", "--to", format, "--output", output)
+	cmd.Stdin = bytes.NewReader(markdown)
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("converting the report with pandoc: %w", err)
+	}
+	return nil
+}
+
+// markdownEscaper escape the characters with a meaning in the inline
+// markdown of pandoc
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`<`, `\<`, `>`, `\>`, `#`, `\#`, `{`, `\{`, `}`, `\}`, `|`, `\|`,
+)
+
+var reportFuncs = template.FuncMap{
+	"join": strings.Join,
+	// inline escape a text to be written as is in a line of markdown, or
+	// in a cell of a pipe table
+	"inline": func(s string) string {
+		return markdownEscaper.Replace(strings.Join(strings.Fields(s), " "))
+	},
+	// yaml quote a value of the metadata block
+	"yaml": func(s string) string {
+		return strconv.Quote(s)
+	},
+}
@@ -0,0 +1,68 @@
+package bugcmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+	"github.com/MichaelMure/git-bug/commands/cmdjson"
+)
+
+func TestBugExport(t *testing.T) {
+	env, bugID, _ := testenv.NewTestEnvAndBugWithComment(t)
+
+	require.NoError(t, runBugExport(env, bugExportOptions{validate: true}, nil))
+
+	var export JSONBugExport
+	require.NoError(t, json.Unmarshal(env.Out.Bytes(), &export))
+	require.Equal(t, cmdjson.BugExportFormatVersion, export.FormatVersion)
+	require.Len(t, export.Bugs, 1)
+	require.Equal(t, bugID.String(), export.Bugs[0].Id)
+	require.Len(t, export.Bugs[0].Comments, 2)
+
+	require.NoError(t, cmdjson.ValidateBugExport(env.Out.Bytes()))
+
+	// a corrupted document is rejected
+	export.Bugs[0].Status = "wontfix"
+	data, err := json.Marshal(export)
+	require.NoError(t, err)
+	require.Error(t, cmdjson.ValidateBugExport(data))
+
+	require.Error(t, cmdjson.ValidateBugExport([]byte(`{"format_version": 2, "bugs": []}`)))
+	require.Error(t, cmdjson.ValidateBugExport([]byte(`{"bugs": [`)))
+}
+
+func TestBugExportReport(t *testing.T) {
+	env, bugID, _ := testenv.NewTestEnvAndBugWithComment(t)
+
+	other, _, err := env.Backend.NewBug("a | piped *title*", "the message")
+	require.NoError(t, err)
+	_, err = other.Close()
+	require.NoError(t, err)
+	require.NoError(t, other.Commit())
+
+	opts := bugExportReportOptions{query: "status:open", format: "md", title: "Weekly report"}
+	require.NoError(t, runBugExportReport(env, opts))
+
+	report := env.Out.String()
+	require.True(t, strings.HasPrefix(report, "---\ntitle: \"Weekly report\"\n"))
+	require.Contains(t, report, "Bugs matching `status:open`, 1 in total.")
+	require.Contains(t, report, "| ["+bugID.Human()+"](#bug-"+bugID.Human()+") | this is a bug title | open | John Doe |  | 2 |")
+	require.Contains(t, report, "# "+bugID.Human()+" this is a bug title {#bug-"+bugID.Human()+"}")
+	require.Contains(t, report, "this is a bug message")
+	require.Contains(t, report, "this is a bug comment")
+	require.NotContains(t, report, other.Id().Human())
+	env.Out.Reset()
+
+	opts.query = ""
+	require.NoError(t, runBugExportReport(env, opts))
+	require.Contains(t, env.Out.String(), "All the bugs, 2 in total.")
+	require.Contains(t, env.Out.String(), `a \| piped \*title\*`)
+	env.Out.Reset()
+
+	require.Error(t, runBugExportReport(env, bugExportReportOptions{format: "docx"}))
+	require.Error(t, runBugExportReport(env, bugExportReportOptions{format: "html"}))
+}
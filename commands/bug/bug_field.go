@@ -0,0 +1,52 @@
+package bugcmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newBugFieldCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "field [BUG_ID]",
+		Short: "Display the custom fields of a bug",
+		Long: `Display the custom fields of a bug.
+
+Custom fields are defined in the git config of the repository, with a type to validate their values:
+
+	git config git-bug.field.priority.type enum
+	git config git-bug.field.priority.values low,medium,high
+	git config git-bug.field.due.type date
+
+The supported types are "string", "enum", "number" and "date" (as in 2006-01-02).`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugField(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	cmd.AddCommand(newBugFieldSetCommand())
+	cmd.AddCommand(newBugFieldRmCommand())
+
+	return cmd
+}
+
+func runBugField(env *execenv.Env, args []string) error {
+	b, _, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	snap := b.Snapshot()
+
+	for _, name := range sortedFieldNames(snap) {
+		env.Out.Printf("%s: %s\n", name, snap.Fields[name])
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package bugcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newBugFieldRmCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "rm [BUG_ID] NAME",
+		Short:   "Unset a custom field of a bug",
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugFieldRm(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	return cmd
+}
+
+func runBugFieldRm(env *execenv.Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("a field name is required")
+	}
+
+	if _, ok := b.Snapshot().Fields[args[0]]; !ok {
+		return fmt.Errorf("field \"%s\" is not set", args[0])
+	}
+
+	_, err = b.SetField(args[0], "")
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
@@ -0,0 +1,52 @@
+package bugcmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+func newBugFieldSetCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "set [BUG_ID] NAME VALUE",
+		Short:   "Set the value of a custom field of a bug",
+		Example: `git bug bug field set 2f9b7ae priority high`,
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugFieldSet(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	return cmd
+}
+
+func runBugFieldSet(env *execenv.Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 2 {
+		return errors.New("a field name and a value are required")
+	}
+
+	value := text.CleanupOneLine(args[1])
+	if value == "" {
+		return errors.New("empty value, use \"git bug bug field rm\" to unset a field")
+	}
+
+	_, err = b.SetField(args[0], value)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
@@ -0,0 +1,45 @@
+package bugcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugField(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	config := env.Repo.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.field.priority.type", "enum"))
+	require.NoError(t, config.StoreString("git-bug.field.priority.values", "low,high"))
+	require.NoError(t, config.StoreString("git-bug.field.due.type", "date"))
+
+	require.NoError(t, runBugFieldSet(env, []string{bugID.Human(), "priority", "high"}))
+	require.NoError(t, runBugFieldSet(env, []string{bugID.Human(), "due", "2023-01-02"}))
+
+	require.Error(t, runBugFieldSet(env, []string{bugID.Human(), "priority", "urgent"}))
+	require.Error(t, runBugFieldSet(env, []string{bugID.Human(), "due", "tomorrow"}))
+	require.Error(t, runBugFieldSet(env, []string{bugID.Human(), "unknown", "value"}))
+
+	require.NoError(t, runBugField(env, []string{bugID.Human()}))
+	require.Equal(t, "due: 2023-01-02\npriority: high\n", env.Out.String())
+	env.Out.Reset()
+
+	opts := bugOptions{
+		sortDirection: "asc",
+		sortBy:        "creation",
+		outputFormat:  "id",
+	}
+
+	require.NoError(t, runBug(env, opts, []string{"field:priority:high"}))
+	require.Equal(t, bugID.String()+"\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugFieldRm(env, []string{bugID.Human(), "priority"}))
+	require.Error(t, runBugFieldRm(env, []string{bugID.Human(), "priority"}))
+
+	require.NoError(t, runBug(env, opts, []string{"field:priority:high"}))
+	require.Empty(t, env.Out.String())
+}
@@ -0,0 +1,133 @@
+package bugcmd
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/query"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+type bugGrepOptions struct {
+	ignoreCase bool
+	titleOnly  bool
+	idOnly     bool
+}
+
+func newBugGrepCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugGrepOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "grep PATTERN [QUERY]",
+		Short: "Search bugs content with a regular expression",
+		Long: `Search the title, description and comments of bugs with a regular expression.
+
+Each matching line is printed with the bug id and where it was found: "title", or the index of the comment ("0" being the description).
+
+An additional query can be given to restrict the search to a subset of the bugs.`,
+		Example: `Search for a panic in open bugs:
+git bug bug grep "panic: .*nil" status:open
+
+List the bugs mentioning a function, ignoring the case:
+git bug bug grep -i --id-only "compileMatcher"
+`,
+		Args:    cobra.MinimumNArgs(1),
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugGrep(env, options, args)
+		}),
+		ValidArgsFunction: completion.Ls(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVarP(&options.ignoreCase, "ignore-case", "i", false,
+		"Ignore case distinctions in both the pattern and the bugs content")
+	flags.BoolVarP(&options.titleOnly, "title", "t", false,
+		"Only search in the titles")
+	flags.BoolVarP(&options.idOnly, "id-only", "l", false,
+		"Only print the id of the matching bugs")
+
+	return cmd
+}
+
+func runBugGrep(env *execenv.Env, opts bugGrepOptions, args []string) error {
+	pattern := args[0]
+	if opts.ignoreCase {
+		pattern = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+
+	q := query.NewQuery()
+	if len(args) > 1 {
+		// either the shell or cobra remove the quotes, we need them back for the query parsing
+		q, err = query.Parse(repairQuery(args[1:]))
+		if err != nil {
+			return err
+		}
+	}
+
+	ids, err := env.Backend.QueryBugs(q)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		b, err := env.Backend.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+
+		snap := b.Snapshot()
+		human := colors.Cyan(snap.Id().Human())
+		found := false
+
+		printMatch := func(where string, line string) {
+			found = true
+			if !opts.idOnly {
+				env.Out.Printf("%s:%s: %s\n", human, colors.Green(where), highlightMatches(re, line))
+			}
+		}
+
+		grepLines(re, "title", snap.Title, printMatch)
+
+		if !opts.titleOnly {
+			for i, comment := range snap.Comments {
+				grepLines(re, strconv.Itoa(i), comment.Message, printMatch)
+			}
+		}
+
+		if found && opts.idOnly {
+			env.Out.Println(snap.Id().Human())
+		}
+	}
+
+	return nil
+}
+
+// grepLines call fn for each line of content matching the regex
+func grepLines(re *regexp.Regexp, where string, content string, fn func(where string, line string)) {
+	for _, line := range strings.Split(content, "\n") {
+		if re.MatchString(line) {
+			fn(where, strings.TrimRight(line, "\r"))
+		}
+	}
+}
+
+// highlightMatches return the line with the regex matches highlighted
+func highlightMatches(re *regexp.Regexp, line string) string {
+	return re.ReplaceAllStringFunc(line, func(match string) string {
+		return colors.Red(match)
+	})
+}
@@ -0,0 +1,32 @@
+package bugcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugGrep(t *testing.T) {
+	env, bugID, _ := testenv.NewTestEnvAndBugWithComment(t)
+
+	human := bugID.Human()
+
+	require.NoError(t, runBugGrep(env, bugGrepOptions{}, []string{"bug (title|comment)"}))
+	require.Equal(t, human+":title: this is a bug title\n"+human+":1: this is a bug comment\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugGrep(env, bugGrepOptions{titleOnly: true}, []string{"bug (title|comment)"}))
+	require.Equal(t, human+":title: this is a bug title\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugGrep(env, bugGrepOptions{ignoreCase: true, idOnly: true}, []string{"BUG MESSAGE"}))
+	require.Equal(t, human+"\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugGrep(env, bugGrepOptions{}, []string{"message", "status:closed"}))
+	require.Empty(t, env.Out.String())
+
+	require.Error(t, runBugGrep(env, bugGrepOptions{}, []string{"("}))
+}
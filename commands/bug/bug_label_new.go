@@ -1,39 +1,65 @@
 package bugcmd
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/MichaelMure/git-bug/commands/bug/select"
 	"github.com/MichaelMure/git-bug/commands/completion"
 	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/policy"
 	"github.com/MichaelMure/git-bug/util/text"
 )
 
+type bugLabelNewOptions struct {
+	noAutoAssign bool
+}
+
 func newBugLabelNewCommand() *cobra.Command {
 	env := execenv.NewEnv()
+	options := bugLabelNewOptions{}
 
 	cmd := &cobra.Command{
 		Use:     "new [BUG_ID] LABEL...",
 		Short:   "Add a label to a bug",
 		PreRunE: execenv.LoadBackendEnsureUser(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
-			return runBugLabelNew(env, args)
+			return runBugLabelNew(env, options, args)
 		}),
 		ValidArgsFunction: completion.BugAndLabels(env, true),
 	}
 
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVar(&options.noAutoAssign, "no-auto-assign", false,
+		"Don't apply the auto-assign policies of the added labels")
+
 	return cmd
 }
 
-func runBugLabelNew(env *execenv.Env, args []string) error {
+func runBugLabelNew(env *execenv.Env, opts bugLabelNewOptions, args []string) error {
 	b, args, err := _select.ResolveBug(env.Backend, args)
 	if err != nil {
 		return err
 	}
 
+	author, err := env.Backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	var metadata map[string]string
+	if opts.noAutoAssign {
+		metadata = map[string]string{
+			policy.MetaKeyNoAutoAssign: "true",
+		}
+	}
+
 	added := args
 
-	changes, _, err := b.ChangeLabels(text.CleanupOneLineArray(added), nil)
+	changes, _, err := b.ChangeLabelsRaw(author, time.Now().Unix(), text.CleanupOneLineArray(added), nil, metadata)
 
 	for _, change := range changes {
 		env.Out.Println(change)
@@ -0,0 +1,448 @@
+package bugcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/cmdjson"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+type bugLogOptions struct {
+	from   string
+	to     string
+	diff   bool
+	format string
+}
+
+func newBugLogCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugLogOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "log [BUG_ID]",
+		Short: "Show the operations of a bug, or what they changed",
+		Long: `Show the operations of a bug, in the order they are applied, or what they changed with --diff.
+
+With --from and --to, only the operations after the operation --from, up to the operation --to, are shown. With --diff, the changes between the state of the bug right after the operation --from, and the one right after the operation --to are shown instead: title, status, labels, assignees, custom fields, relations, comments and attachments. By default, the changes are the ones since the creation of the bug.
+
+The operations are designated by a prefix of their id, as shown by this command. This allows to review what changed between two synchronization points.`,
+		Example: `git bug bug log 2f1d
+git bug bug log 2f1d --diff --from 5e8b`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugLog(env, options, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVar(&options.from, "from", "",
+		"Start after the operation with the given id prefix")
+	flags.StringVar(&options.to, "to", "",
+		"Stop at the operation with the given id prefix, the last one by default")
+	flags.BoolVar(&options.diff, "diff", false,
+		"Show what changed instead of the operations")
+	flags.StringVarP(&options.format, "format", "f", "default",
+		"Select the output formatting style. Valid values are [default,json]")
+	cmd.RegisterFlagCompletionFunc("format", completion.From([]string{"default", "json"}))
+
+	return cmd
+}
+
+func runBugLog(env *execenv.Env, opts bugLogOptions, args []string) error {
+	b, _, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	ops := b.Snapshot().Operations
+
+	resolveOp := func(flag string, prefix string, def entity.Id) (entity.Id, error) {
+		if prefix == "" {
+			return def, nil
+		}
+		id, err := b.ResolveOperationPrefix(prefix)
+		if err != nil {
+			return "", fmt.Errorf("--%s %s: %w", flag, prefix, err)
+		}
+		return id, nil
+	}
+
+	// without --from, the diff starts from the creation, and the list
+	// include it
+	var fromDefault entity.Id
+	if opts.diff {
+		fromDefault = ops[0].Id()
+	}
+	from, err := resolveOp("from", opts.from, fromDefault)
+	if err != nil {
+		return err
+	}
+	to, err := resolveOp("to", opts.to, ops[len(ops)-1].Id())
+	if err != nil {
+		return err
+	}
+
+	if opts.diff {
+		diff, err := b.DiffSnapshots(from, to)
+		if err != nil {
+			return err
+		}
+
+		switch opts.format {
+		case "default":
+			logDiffDefaultFormatter(env, diff)
+			return nil
+		case "json":
+			return logDiffJsonFormatter(env, diff)
+		default:
+			return fmt.Errorf("unknown format %s", opts.format)
+		}
+	}
+
+	var selected []dag.Operation
+	started := from == ""
+	for _, op := range ops {
+		if started {
+			selected = append(selected, op)
+		}
+		if op.Id() == from {
+			started = true
+		}
+		if op.Id() == to {
+			break
+		}
+	}
+	if !started {
+		return fmt.Errorf("operation %s is after operation %s", from.Human(), to.Human())
+	}
+
+	switch opts.format {
+	case "default":
+		logDefaultFormatter(env, selected)
+		return nil
+	case "json":
+		return logJsonFormatter(env, selected)
+	default:
+		return fmt.Errorf("unknown format %s", opts.format)
+	}
+}
+
+// describeOperation return a short description of what an operation did
+func describeOperation(op dag.Operation) string {
+	quoted := func(values []bug.Label) string {
+		result := make([]string, len(values))
+		for i, value := range values {
+			result[i] = fmt.Sprintf("%q", value)
+		}
+		return strings.Join(result, ", ")
+	}
+
+	switch op := op.(type) {
+	case *bug.CreateOperation:
+		return fmt.Sprintf("created the bug %q", op.Title)
+	case *bug.SetTitleOperation:
+		return fmt.Sprintf("changed the title to %q", op.Title)
+	case *bug.AddCommentOperation:
+		return "commented"
+	case *bug.EditCommentOperation:
+		return fmt.Sprintf("edited the comment %s", op.Target.Human())
+	case *bug.RedactCommentOperation:
+		if op.Delete {
+			return fmt.Sprintf("deleted the comment %s", op.Target.Human())
+		}
+		return fmt.Sprintf("redacted the comment %s", op.Target.Human())
+	case *bug.ReactionOperation:
+		if op.Remove {
+			return fmt.Sprintf("removed the reaction %s from the comment %s", op.Reaction.Emoji(), op.Target.Human())
+		}
+		return fmt.Sprintf("reacted %s to the comment %s", op.Reaction.Emoji(), op.Target.Human())
+	case *bug.SetStatusOperation:
+		if op.State != "" {
+			return fmt.Sprintf("%s the bug, moving it to %s", op.Status.Action(), op.State)
+		}
+		return fmt.Sprintf("%s the bug", op.Status.Action())
+	case *bug.LabelChangeOperation:
+		var changes []string
+		if len(op.Added) > 0 {
+			changes = append(changes, "added "+quoted(op.Added))
+		}
+		if len(op.Removed) > 0 {
+			changes = append(changes, "removed "+quoted(op.Removed))
+		}
+		return strings.Join(changes, " and ")
+	case *bug.SetAssigneeOperation:
+		var changes []string
+		for _, id := range op.Added {
+			changes = append(changes, "assigned "+id.Human())
+		}
+		for _, id := range op.Removed {
+			changes = append(changes, "unassigned "+id.Human())
+		}
+		return strings.Join(changes, ", ")
+	case *bug.SetFieldOperation:
+		if op.Value == "" {
+			return fmt.Sprintf("unset the field %s", op.Name)
+		}
+		return fmt.Sprintf("set the field %s to %q", op.Name, op.Value)
+	case *bug.RelationAddOperation:
+		return fmt.Sprintf("added the relation %s %s", op.Relation, op.Target.Human())
+	case *bug.RelationRemoveOperation:
+		return fmt.Sprintf("removed the relation %s %s", op.Relation, op.Target.Human())
+	case *bug.AddAttachmentOperation:
+		return fmt.Sprintf("attached %s", op.Name)
+	case *bug.RequestInfoOperation:
+		return "requested more information"
+	case *bug.SyncConflictOperation:
+		return fmt.Sprintf("recorded a %s conflict on the %s", op.Bridge, op.Field)
+	case *dag.SetMetadataOperation[*bug.Snapshot]:
+		return fmt.Sprintf("annotated the operation %s", op.Target.Human())
+	case *dag.NoOpOperation[*bug.Snapshot]:
+		return "did nothing"
+	default:
+		return "unknown operation"
+	}
+}
+
+func logDefaultFormatter(env *execenv.Env, ops []dag.Operation) {
+	for _, op := range ops {
+		env.Out.Printf("%s %s %s %s\n",
+			colors.Cyan(op.Id().Human()),
+			op.Time().Format("2006-01-02 15:04"),
+			colors.Magenta(op.Author().DisplayName()),
+			describeOperation(op),
+		)
+	}
+}
+
+type JSONLogOperation struct {
+	Id          string           `json:"id"`
+	HumanId     string           `json:"human_id"`
+	Author      cmdjson.Identity `json:"author"`
+	Time        cmdjson.Time     `json:"time"`
+	Description string           `json:"description"`
+}
+
+func logJsonFormatter(env *execenv.Env, ops []dag.Operation) error {
+	jsonOps := make([]JSONLogOperation, len(ops))
+	for i, op := range ops {
+		jsonOps[i] = JSONLogOperation{
+			Id:          op.Id().String(),
+			HumanId:     op.Id().Human(),
+			Author:      cmdjson.NewIdentity(op.Author()),
+			Time:        cmdjson.NewTime(op.Time(), 0),
+			Description: describeOperation(op),
+		}
+	}
+
+	jsonObject, err := json.MarshalIndent(jsonOps, "", "    ")
+	if err != nil {
+		return err
+	}
+	env.Out.Printf("%s\n", jsonObject)
+	return nil
+}
+
+func logDiffDefaultFormatter(env *execenv.Env, diff *cache.SnapshotDiff) {
+	env.Out.Printf("changes from %s to %s\n", colors.Cyan(diff.From.Human()), colors.Cyan(diff.To.Human()))
+
+	if diff.IsEmpty() {
+		env.Out.Println("no change")
+		return
+	}
+
+	added := func(format string, a ...interface{}) {
+		env.Out.Printf("%s %s\n", colors.Green("+"), fmt.Sprintf(format, a...))
+	}
+	removed := func(format string, a ...interface{}) {
+		env.Out.Printf("%s %s\n", colors.Red("-"), fmt.Sprintf(format, a...))
+	}
+	changed := func(format string, a ...interface{}) {
+		env.Out.Printf("%s %s\n", colors.Yellow("~"), fmt.Sprintf(format, a...))
+	}
+
+	if diff.Title != nil {
+		changed("title: %q -> %q", diff.Title.From, diff.Title.To)
+	}
+	if diff.Status != nil {
+		changed("status: %s -> %s",
+			statusWithState(diff.Status.From.String(), diff.Status.FromState),
+			statusWithState(diff.Status.To.String(), diff.Status.ToState),
+		)
+	}
+	for _, label := range diff.LabelsAdded {
+		added("label %s", label)
+	}
+	for _, label := range diff.LabelsRemoved {
+		removed("label %s", label)
+	}
+	for _, id := range diff.AssigneesAdded {
+		added("assignee %s", id.Human())
+	}
+	for _, id := range diff.AssigneesRemoved {
+		removed("assignee %s", id.Human())
+	}
+	for _, field := range diff.Fields {
+		switch {
+		case field.From == "":
+			added("field %s: %q", field.Name, field.To)
+		case field.To == "":
+			removed("field %s: %q", field.Name, field.From)
+		default:
+			changed("field %s: %q -> %q", field.Name, field.From, field.To)
+		}
+	}
+	for _, relation := range diff.RelationsAdded {
+		added("relation %s", relation)
+	}
+	for _, relation := range diff.RelationsRemoved {
+		removed("relation %s", relation)
+	}
+	for _, attachment := range diff.AttachmentsAdded {
+		added("attachment %s", attachment.Name)
+	}
+	for _, comment := range diff.CommentsAdded {
+		added("comment %s by %s:\n%s", comment.CombinedId().Human(), comment.Author.DisplayName(), indent(comment.Message))
+	}
+	for _, change := range diff.CommentsEdited {
+		changed("comment %s edited, was:\n%s\nnow:\n%s", change.Comment.CombinedId().Human(), indent(change.From), indent(change.Comment.Message))
+	}
+	for _, comment := range diff.CommentsRemoved {
+		removed("comment %s by %s", comment.CombinedId().Human(), comment.Author.DisplayName())
+	}
+}
+
+func statusWithState(status string, state string) string {
+	if state == "" {
+		return status
+	}
+	return fmt.Sprintf("%s (%s)", status, state)
+}
+
+// indent a message under the line describing its change
+func indent(message string) string {
+	return "    " + strings.ReplaceAll(strings.TrimRight(message, "\n"), "\n", "\n    ")
+}
+
+type JSONSnapshotDiff struct {
+	From             string                     `json:"from"`
+	To               string                     `json:"to"`
+	Title            *JSONValueChange           `json:"title,omitempty"`
+	Status           *JSONValueChange           `json:"status,omitempty"`
+	LabelsAdded      []string                   `json:"labels_added"`
+	LabelsRemoved    []string                   `json:"labels_removed"`
+	AssigneesAdded   []string                   `json:"assignees_added"`
+	AssigneesRemoved []string                   `json:"assignees_removed"`
+	Fields           map[string]JSONValueChange `json:"fields"`
+	RelationsAdded   []string                   `json:"relations_added"`
+	RelationsRemoved []string                   `json:"relations_removed"`
+	AttachmentsAdded []string                   `json:"attachments_added"`
+	CommentsAdded    []JSONCommentChange        `json:"comments_added"`
+	CommentsEdited   []JSONCommentChange        `json:"comments_edited"`
+	CommentsRemoved  []JSONCommentChange        `json:"comments_removed"`
+}
+
+type JSONValueChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type JSONCommentChange struct {
+	Id      string           `json:"id"`
+	Author  cmdjson.Identity `json:"author"`
+	Message string           `json:"message"`
+	// Was is the message before an edition
+	Was string `json:"was,omitempty"`
+}
+
+func logDiffJsonFormatter(env *execenv.Env, diff *cache.SnapshotDiff) error {
+	jsonDiff := JSONSnapshotDiff{
+		From:             diff.From.String(),
+		To:               diff.To.String(),
+		LabelsAdded:      []string{},
+		LabelsRemoved:    []string{},
+		AssigneesAdded:   []string{},
+		AssigneesRemoved: []string{},
+		Fields:           make(map[string]JSONValueChange, len(diff.Fields)),
+		RelationsAdded:   []string{},
+		RelationsRemoved: []string{},
+		AttachmentsAdded: []string{},
+		CommentsAdded:    []JSONCommentChange{},
+		CommentsEdited:   []JSONCommentChange{},
+		CommentsRemoved:  []JSONCommentChange{},
+	}
+
+	for _, label := range diff.LabelsAdded {
+		jsonDiff.LabelsAdded = append(jsonDiff.LabelsAdded, label.String())
+	}
+	for _, label := range diff.LabelsRemoved {
+		jsonDiff.LabelsRemoved = append(jsonDiff.LabelsRemoved, label.String())
+	}
+	for _, id := range diff.AssigneesAdded {
+		jsonDiff.AssigneesAdded = append(jsonDiff.AssigneesAdded, id.String())
+	}
+	for _, id := range diff.AssigneesRemoved {
+		jsonDiff.AssigneesRemoved = append(jsonDiff.AssigneesRemoved, id.String())
+	}
+	for _, relation := range diff.RelationsAdded {
+		jsonDiff.RelationsAdded = append(jsonDiff.RelationsAdded, fmt.Sprintf("%s %s", relation.Type, relation.Target))
+	}
+	for _, relation := range diff.RelationsRemoved {
+		jsonDiff.RelationsRemoved = append(jsonDiff.RelationsRemoved, fmt.Sprintf("%s %s", relation.Type, relation.Target))
+	}
+	for _, attachment := range diff.AttachmentsAdded {
+		jsonDiff.AttachmentsAdded = append(jsonDiff.AttachmentsAdded, attachment.Name)
+	}
+
+	if diff.Title != nil {
+		jsonDiff.Title = &JSONValueChange{From: diff.Title.From, To: diff.Title.To}
+	}
+	if diff.Status != nil {
+		jsonDiff.Status = &JSONValueChange{
+			From: statusWithState(diff.Status.From.String(), diff.Status.FromState),
+			To:   statusWithState(diff.Status.To.String(), diff.Status.ToState),
+		}
+	}
+	for _, field := range diff.Fields {
+		jsonDiff.Fields[field.Name] = JSONValueChange{From: field.From, To: field.To}
+	}
+
+	newComment := func(comment bug.Comment) JSONCommentChange {
+		return JSONCommentChange{
+			Id:      comment.CombinedId().String(),
+			Author:  cmdjson.NewIdentity(comment.Author),
+			Message: comment.Message,
+		}
+	}
+	for _, comment := range diff.CommentsAdded {
+		jsonDiff.CommentsAdded = append(jsonDiff.CommentsAdded, newComment(comment))
+	}
+	for _, change := range diff.CommentsEdited {
+		jsonComment := newComment(change.Comment)
+		jsonComment.Was = change.From
+		jsonDiff.CommentsEdited = append(jsonDiff.CommentsEdited, jsonComment)
+	}
+	for _, comment := range diff.CommentsRemoved {
+		jsonDiff.CommentsRemoved = append(jsonDiff.CommentsRemoved, newComment(comment))
+	}
+
+	jsonObject, err := json.MarshalIndent(jsonDiff, "", "    ")
+	if err != nil {
+		return err
+	}
+	env.Out.Printf("%s\n", jsonObject)
+	return nil
+}
@@ -0,0 +1,67 @@
+package bugcmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugLog(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	b, err := env.Backend.ResolveBug(bugID)
+	require.NoError(t, err)
+	syncPoint := b.Snapshot().Operations[0].Id()
+	_, err = b.SetTitle("new title")
+	require.NoError(t, err)
+	_, _, err = b.ChangeLabels([]string{"bug"}, nil)
+	require.NoError(t, err)
+	_, _, err = b.AddComment("a comment")
+	require.NoError(t, err)
+	_, err = b.Close()
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	require.NoError(t, runBugLog(env, bugLogOptions{format: "default"}, []string{bugID.Human()}))
+	require.Contains(t, env.Out.String(), `created the bug "this is a bug title"`)
+	require.Contains(t, env.Out.String(), `changed the title to "new title"`)
+	require.Contains(t, env.Out.String(), `added "bug"`)
+	require.Contains(t, env.Out.String(), "closed the bug")
+
+	env.Out.Reset()
+	require.NoError(t, runBugLog(env, bugLogOptions{from: syncPoint.Human(), format: "json"}, []string{bugID.Human()}))
+	var ops []JSONLogOperation
+	require.NoError(t, json.Unmarshal(env.Out.Bytes(), &ops))
+	require.Len(t, ops, 4)
+	require.Equal(t, `changed the title to "new title"`, ops[0].Description)
+
+	env.Out.Reset()
+	require.NoError(t, runBugLog(env, bugLogOptions{diff: true, format: "default"}, []string{bugID.Human()}))
+	require.Contains(t, env.Out.String(), `title: "this is a bug title" -> "new title"`)
+	require.Contains(t, env.Out.String(), "status: open -> closed")
+	require.Contains(t, env.Out.String(), "label bug")
+	require.Contains(t, env.Out.String(), "a comment")
+
+	env.Out.Reset()
+	opts := bugLogOptions{diff: true, from: syncPoint.Human(), format: "json"}
+	require.NoError(t, runBugLog(env, opts, []string{bugID.Human()}))
+	var diff JSONSnapshotDiff
+	require.NoError(t, json.Unmarshal(env.Out.Bytes(), &diff))
+	require.Equal(t, syncPoint.String(), diff.From)
+	require.Equal(t, &JSONValueChange{From: "this is a bug title", To: "new title"}, diff.Title)
+	require.Equal(t, []string{"bug"}, diff.LabelsAdded)
+	require.Len(t, diff.CommentsAdded, 1)
+	require.Equal(t, "a comment", diff.CommentsAdded[0].Message)
+
+	// the operations can't be reversed
+	last := b.Snapshot().Operations[4].Id()
+	opts = bugLogOptions{diff: true, from: last.Human(), to: syncPoint.Human(), format: "default"}
+	require.Error(t, runBugLog(env, opts, []string{bugID.Human()}))
+	opts.diff = false
+	require.Error(t, runBugLog(env, opts, []string{bugID.Human()}))
+
+	require.Error(t, runBugLog(env, bugLogOptions{from: "unknown", format: "default"}, []string{bugID.Human()}))
+}
@@ -0,0 +1,61 @@
+package bugcmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+type bugMergeIntoOptions struct {
+	confirmFreeze bool
+}
+
+func newBugMergeIntoCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugMergeIntoOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "merge-into DUPLICATE_ID CANONICAL_ID",
+		Short: "Consolidate a duplicate bug into its canonical bug",
+		Long: `Consolidate a duplicate bug into its canonical bug, in one step.
+
+The comments of the duplicate are copied in the canonical bug, quoted and attributed to their original author. The duplicate is then labeled "duplicate", gets a comment pointing to the canonical bug, and is closed.`,
+		Example: `git bug merge-into 3b5e8a2 9fd3c21`,
+		Args:    cobra.ExactArgs(2),
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugMergeInto(env, options, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVar(&options.confirmFreeze, "confirm-freeze", false,
+		"Confirm the closing of a duplicate protected by the freeze mode")
+
+	return cmd
+}
+
+func runBugMergeInto(env *execenv.Env, opts bugMergeIntoOptions, args []string) error {
+	duplicate, err := env.Backend.ResolveBugPrefix(args[0])
+	if err != nil {
+		return err
+	}
+
+	canonical, err := env.Backend.ResolveBugPrefix(args[1])
+	if err != nil {
+		return err
+	}
+
+	err = env.Backend.MergeBugInto(duplicate, canonical, opts.confirmFreeze)
+	if err != nil {
+		return err
+	}
+
+	env.Out.Printf("%s merged into %s\n", duplicate.Id().Human(), canonical.Id().Human())
+
+	return nil
+}
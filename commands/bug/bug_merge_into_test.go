@@ -0,0 +1,33 @@
+package bugcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugMergeInto(t *testing.T) {
+	env, duplicateID := testenv.NewTestEnvAndBug(t)
+
+	canonical, _, err := env.Backend.NewBug("the canonical bug", "the message")
+	require.NoError(t, err)
+
+	require.NoError(t, runBugMergeInto(env, bugMergeIntoOptions{}, []string{duplicateID.Human(), canonical.Id().Human()}))
+	require.Equal(t, duplicateID.Human()+" merged into "+canonical.Id().Human()+"\n", env.Out.String())
+	env.Out.Reset()
+
+	opts := bugOptions{
+		sortDirection: "asc",
+		sortBy:        "creation",
+		outputFormat:  "id",
+	}
+
+	require.NoError(t, runBug(env, opts, []string{"status:open"}))
+	require.Equal(t, canonical.Id().String()+"\n", env.Out.String())
+	env.Out.Reset()
+
+	// merging again is refused
+	require.Error(t, runBugMergeInto(env, bugMergeIntoOptions{}, []string{duplicateID.Human(), canonical.Id().Human()}))
+}
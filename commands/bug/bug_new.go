@@ -1,10 +1,13 @@
 package bugcmd
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/MichaelMure/git-bug/commands/execenv"
 	"github.com/MichaelMure/git-bug/commands/input"
+	"github.com/MichaelMure/git-bug/entities/bug"
 	"github.com/MichaelMure/git-bug/util/text"
 )
 
@@ -13,6 +16,7 @@ type bugNewOptions struct {
 	message        string
 	messageFile    string
 	nonInteractive bool
+	confidential   bool
 }
 
 func newBugNewCommand() *cobra.Command {
@@ -38,6 +42,8 @@ func newBugNewCommand() *cobra.Command {
 	flags.StringVarP(&options.messageFile, "file", "F", "",
 		"Take the message from the given file. Use - to read the message from the standard input")
 	flags.BoolVar(&options.nonInteractive, "non-interactive", false, "Do not ask for user input")
+	flags.BoolVar(&options.confidential, "confidential", false,
+		"Flag the bug as confidential, to keep it out of public remotes and bridges")
 
 	return cmd
 }
@@ -63,9 +69,23 @@ func runBugNew(env *execenv.Env, opts bugNewOptions) error {
 		}
 	}
 
-	b, _, err := env.Backend.NewBug(
+	author, err := env.Backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	var metadata map[string]string
+	if opts.confidential {
+		metadata = bug.ConfidentialMetadata()
+	}
+
+	b, _, err := env.Backend.NewBugRaw(
+		author,
+		time.Now().Unix(),
 		text.CleanupOneLine(opts.title),
 		text.Cleanup(opts.message),
+		nil,
+		metadata,
 	)
 	if err != nil {
 		return err
@@ -0,0 +1,66 @@
+package bugcmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func newBugRelationCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "relation [BUG_ID]",
+		Short: "Display the relations of a bug to other bugs",
+		Long: `Display the relations of a bug to other bugs.
+
+A bug can be linked to another one with a relation type:
+- "blocks": the other bug depends on this one, and is shown as "blocked-by" from it
+- "duplicate-of": this bug is a duplicate of the other one, shown as "duplicated-by" from it
+- "related-to": a loose link between the two bugs
+
+The relations are recorded on this bug only. Those from other bugs are listed as well, from the cache.`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugRelation(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	cmd.AddCommand(newBugRelationNewCommand())
+	cmd.AddCommand(newBugRelationRmCommand())
+
+	return cmd
+}
+
+func runBugRelation(env *execenv.Env, args []string) error {
+	b, _, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	for _, relation := range b.Snapshot().Relations {
+		printRelatedBug(env, relation.Type.String(), relation.Target)
+	}
+
+	for _, relation := range env.Backend.IncomingRelations(b.Id()) {
+		printRelatedBug(env, relation.Type.Inverse(), relation.Source)
+	}
+
+	return nil
+}
+
+// printRelatedBug print a relation with the title of the other bug, if known
+// by the cache
+func printRelatedBug(env *execenv.Env, name string, id entity.Id) {
+	excerpt, err := env.Backend.ResolveBugExcerpt(id)
+	if err != nil {
+		env.Out.Printf("%s %s\n", name, colors.Cyan(id.Human()))
+		return
+	}
+	env.Out.Printf("%s %s [%s] %s\n", name, colors.Cyan(id.Human()), colors.Yellow(excerpt.Status), excerpt.Title)
+}
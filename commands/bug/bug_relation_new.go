@@ -0,0 +1,60 @@
+package bugcmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+func newBugRelationNewCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "new [BUG_ID] TYPE OTHER_BUG_ID",
+		Short: "Link a bug to another one",
+		Long: `Link a bug to another one, with a relation type: "blocks", "duplicate-of" or "related-to".
+
+The bugs having a relation can be listed with the "blocks:", "duplicate-of:" and "related-to:" queries.`,
+		Example: `git bug bug relation new 2f9b7ae blocks 9fd3c21`,
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugRelationNew(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	return cmd
+}
+
+func runBugRelationNew(env *execenv.Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 2 {
+		return errors.New("a relation type and the id of the other bug are required")
+	}
+
+	relType, err := bug.RelationTypeFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	other, err := env.Backend.ResolveBugPrefix(args[1])
+	if err != nil {
+		return err
+	}
+
+	_, err = b.AddRelation(relType, other.Id())
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
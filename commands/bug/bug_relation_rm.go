@@ -0,0 +1,59 @@
+package bugcmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+func newBugRelationRmCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "rm [BUG_ID] TYPE OTHER_BUG_ID",
+		Short:   "Remove a link from a bug to another one",
+		Example: `git bug bug relation rm 2f9b7ae blocks 9fd3c21`,
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugRelationRm(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	return cmd
+}
+
+func runBugRelationRm(env *execenv.Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 2 {
+		return errors.New("a relation type and the id of the other bug are required")
+	}
+
+	relType, err := bug.RelationTypeFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	// the other bug might not be known locally anymore, so the link is found
+	// from the relations of this bug
+	relation, err := b.ResolveRelation(relType, args[1])
+	if err != nil {
+		return err
+	}
+
+	_, err = b.RemoveRelation(relation.Type, relation.Target)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
@@ -0,0 +1,49 @@
+package bugcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugRelation(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	other, _, err := env.Backend.NewBug("the other bug", "the message")
+	require.NoError(t, err)
+
+	require.NoError(t, runBugRelationNew(env, []string{bugID.Human(), "blocks", other.Id().Human()}))
+	require.NoError(t, runBugRelationNew(env, []string{other.Id().Human(), "related-to", bugID.Human()}))
+
+	require.Error(t, runBugRelationNew(env, []string{bugID.Human(), "depends-on", other.Id().Human()}))
+	require.Error(t, runBugRelationNew(env, []string{bugID.Human(), "blocks", bugID.Human()}))
+	require.Error(t, runBugRelationNew(env, []string{bugID.Human(), "blocks"}))
+
+	require.NoError(t, runBugRelation(env, []string{bugID.Human()}))
+	require.Equal(t, "blocks "+other.Id().Human()+" [open] the other bug\n"+
+		"related-to "+other.Id().Human()+" [open] the other bug\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugRelation(env, []string{other.Id().Human()}))
+	require.Equal(t, "related-to "+bugID.Human()+" [open] this is a bug title\n"+
+		"blocked-by "+bugID.Human()+" [open] this is a bug title\n", env.Out.String())
+	env.Out.Reset()
+
+	opts := bugOptions{
+		sortDirection: "asc",
+		sortBy:        "creation",
+		outputFormat:  "id",
+	}
+
+	require.NoError(t, runBug(env, opts, []string{"blocks:" + other.Id().Human()}))
+	require.Equal(t, bugID.String()+"\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugRelationRm(env, []string{bugID.Human(), "blocks", other.Id().Human()}))
+	require.Error(t, runBugRelationRm(env, []string{bugID.Human(), "blocks", other.Id().Human()}))
+
+	require.NoError(t, runBug(env, opts, []string{"blocks:" + other.Id().Human()}))
+	require.Empty(t, env.Out.String())
+}
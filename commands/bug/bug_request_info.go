@@ -0,0 +1,42 @@
+package bugcmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newBugRequestInfoCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "request-info [BUG_ID]",
+		Short: "Ask the reporter of a bug for more information",
+		Long: `Ask the reporter of a bug for more information.
+
+The bug is then flagged as awaiting its reporter, until they comment on it. Those bugs can be listed with the "awaiting:reporter" query.`,
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugRequestInfo(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	return cmd
+}
+
+func runBugRequestInfo(env *execenv.Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.RequestInfo()
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
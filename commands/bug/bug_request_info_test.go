@@ -0,0 +1,32 @@
+package bugcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugRequestInfo(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	require.NoError(t, runBugRequestInfo(env, []string{bugID.Human()}))
+
+	opts := bugOptions{
+		sortDirection: "asc",
+		sortBy:        "creation",
+		outputFormat:  "id",
+	}
+
+	require.NoError(t, runBug(env, opts, []string{"awaiting:reporter"}))
+	require.Equal(t, bugID.String()+"\n", env.Out.String())
+	env.Out.Reset()
+
+	// the reporter answering lift the flag
+	require.NoError(t, runBugCommentNew(env, bugCommentNewOptions{message: "more info"}, []string{bugID.Human()}))
+	env.Out.Reset()
+
+	require.NoError(t, runBug(env, opts, []string{"awaiting:reporter"}))
+	require.Empty(t, env.Out.String())
+}
@@ -13,9 +13,9 @@ func newBugRmCommand() *cobra.Command {
 	env := execenv.NewEnv()
 
 	cmd := &cobra.Command{
-		Use:     "rm BUG_ID",
-		Short:   "Remove an existing bug",
-		Long:    "Remove an existing bug in the local repository. Note removing bugs that were imported from bridges will not remove the bug on the remote, and will only remove the local copy of the bug.",
+		Use:     "rm BUG_ID...",
+		Short:   "Remove existing bugs",
+		Long:    "Remove existing bugs in the local repository. Their refs are deleted, and they are dropped from the cache and the search index. Note removing bugs that were imported from bridges will not remove the bug on the remote, and will only remove the local copy of the bug.",
 		PreRunE: execenv.LoadBackendEnsureUser(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
 			return runBugRm(env, args)
@@ -34,13 +34,14 @@ func runBugRm(env *execenv.Env, args []string) (err error) {
 		return errors.New("you must provide a bug prefix to remove")
 	}
 
-	err = env.Backend.RemoveBug(args[0])
+	for _, prefix := range args {
+		err = env.Backend.RemoveBug(prefix)
+		if err != nil {
+			return
+		}
 
-	if err != nil {
-		return
+		env.Out.Printf("bug %s removed\n", prefix)
 	}
 
-	env.Out.Printf("bug %s removed\n", args[0])
-
 	return
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/commands/bug/testenv"
 )
 
@@ -17,3 +18,19 @@ func TestBugRm(t *testing.T) {
 	require.Equal(t, exp, env.Out.String())
 	env.Out.Reset()
 }
+
+func TestBugRmMultiple(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	b, _, err := env.Backend.NewBug("second", "message")
+	require.NoError(t, err)
+
+	require.NoError(t, runBugRm(env, []string{bugID.Human(), b.Id().Human()}))
+	require.Equal(t, "bug "+bugID.Human()+" removed\nbug "+b.Id().Human()+" removed\n", env.Out.String())
+	require.Empty(t, env.Backend.AllBugsIds())
+
+	// the removed bug can't be created again by committing it
+	_, _, err = b.AddComment("comment")
+	require.Error(t, err)
+	require.ErrorIs(t, b.Commit(), cache.ErrBugRemoved)
+}
@@ -1,24 +1,35 @@
 package bugcmd
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/MichaelMure/git-bug/bridge"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/commands/bug/select"
 	"github.com/MichaelMure/git-bug/commands/cmdjson"
 	"github.com/MichaelMure/git-bug/commands/completion"
 	"github.com/MichaelMure/git-bug/commands/execenv"
 	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/render"
 )
 
 type bugShowOptions struct {
-	fields string
-	format string
+	fields       string
+	format       string
+	expandQuotes bool
+	noPager      bool
+	at           string
 }
 
 func newBugShowCommand() *cobra.Command {
@@ -26,9 +37,21 @@ func newBugShowCommand() *cobra.Command {
 	options := bugShowOptions{}
 
 	cmd := &cobra.Command{
-		Use:     "show [BUG_ID]",
-		Short:   "Display the details of a bug",
-		PreRunE: execenv.LoadBackend(env),
+		Use:   "show [BUG_ID]",
+		Short: "Display the details of a bug",
+		Long: `Display the details of a bug.
+
+When written to a terminal, the default format is sent through a pager, taken from $GIT_BUG_PAGER or $PAGER, and defaulting to less. Long quotations in the comments are collapsed to their first line, unless --expand-quotes is given.
+
+Showing a bug in full marks it as read for the user identity, until its next edition. See the --unread flag of "git bug".
+
+The bug can also be designated by a reference to an issue of a remote bug-tracker, in the form "<bridge>#<issue>", where <bridge> is the name of a configured bridge or its target. If that issue has not been imported yet, it is imported on demand.
+
+With --at, the bug is shown as it was at a past date, with only the changes made up to then. The date is in the local time zone unless given, as "2006-01-02", "2006-01-02 15:04", "2006-01-02 15:04:05" or in the RFC 3339 format.`,
+		Example: `git bug show 2f1d
+git bug show github#1234
+git bug show 2f1d --at 2021-06-01`,
+		PreRunE: execenv.LoadBackendOrReadOnly(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
 			return runBugShow(env, options, args)
 		}),
@@ -39,28 +62,53 @@ func newBugShowCommand() *cobra.Command {
 	flags.SortFlags = false
 
 	fields := []string{"author", "authorEmail", "createTime", "lastEdit", "humanId",
-		"id", "labels", "shortId", "status", "title", "actors", "participants"}
+		"id", "labels", "shortId", "status", "state", "title", "actors", "participants", "fields"}
 	flags.StringVarP(&options.fields, "field", "", "",
 		"Select field to display. Valid values are ["+strings.Join(fields, ",")+"]")
 	cmd.RegisterFlagCompletionFunc("by", completion.From(fields))
 	flags.StringVarP(&options.format, "format", "f", "default",
 		"Select the output formatting style. Valid values are [default,json,org-mode]")
+	flags.BoolVar(&options.expandQuotes, "expand-quotes", false,
+		"Show the long quotations of the comments in full")
+	flags.BoolVar(&options.noPager, "no-pager", false,
+		"Don't send the output through a pager")
+	flags.StringVar(&options.at, "at", "",
+		"Show the bug as it was at the given date")
 
 	return cmd
 }
 
 func runBugShow(env *execenv.Env, opts bugShowOptions, args []string) error {
-	b, args, err := _select.ResolveBug(env.Backend, args)
+	b, args, err := resolveBugOrIssueRef(env, args)
 	if err != nil {
 		return err
 	}
 
 	snap := b.Snapshot()
+	past := opts.at != ""
+	if past {
+		at, err := parseShowDate(opts.at)
+		if err != nil {
+			return err
+		}
+		snap, err = b.SnapshotAt(at)
+		if err == cache.ErrBugNotCreatedYet {
+			return fmt.Errorf("the bug didn't exist yet at %s", at.Format(time.RFC1123))
+		}
+		if err != nil {
+			return err
+		}
+	}
 
 	if len(snap.Comments) == 0 {
 		return errors.New("invalid bug: no comment")
 	}
 
+	workflow, err := env.Backend.Workflow()
+	if err != nil {
+		return err
+	}
+
 	if opts.fields != "" {
 		switch opts.fields {
 		case "author":
@@ -91,8 +139,14 @@ func runBugShow(env *execenv.Env, opts bugShowOptions, args []string) error {
 			env.Out.Printf("%s\n", snap.Id().Human())
 		case "status":
 			env.Out.Printf("%s\n", snap.Status)
+		case "state":
+			env.Out.Printf("%s\n", workflow.StateOf(snap.Status, snap.State))
 		case "title":
 			env.Out.Printf("%s\n", snap.Title)
+		case "fields":
+			for _, name := range sortedFieldNames(snap) {
+				env.Out.Printf("%s: %s\n", name, snap.Fields[name])
+			}
 		default:
 			return fmt.Errorf("\nUnsupported field: %s\n", opts.fields)
 		}
@@ -100,28 +154,74 @@ func runBugShow(env *execenv.Env, opts bugShowOptions, args []string) error {
 		return nil
 	}
 
+	// the bug is read once shown in full. A read-only cache can't record it,
+	// and without user identity there is nobody to record it for. A past state
+	// doesn't show the latest changes.
+	if !past {
+		err = env.Backend.MarkAsRead(snap.Id())
+		if err != nil && !errors.Is(err, cache.ErrReadOnly) && !errors.Is(err, identity.ErrNoIdentitySet) {
+			return err
+		}
+	}
+
 	switch opts.format {
 	case "org-mode":
 		return showOrgModeFormatter(env, snap)
 	case "json":
-		return showJsonFormatter(env, snap)
+		return showJsonFormatter(env, snap, workflow)
 	case "default":
-		return showDefaultFormatter(env, snap)
+		if !opts.noPager {
+			stop := execenv.StartPager(env)
+			defer stop()
+		}
+		return showDefaultFormatter(env, snap, workflow, opts)
 	default:
 		return fmt.Errorf("unknown format %s", opts.format)
 	}
 }
 
-func showDefaultFormatter(env *execenv.Env, snapshot *bug.Snapshot) error {
+// showDateLayouts are the accepted formats of the --at date, in the local
+// time zone unless given
+var showDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+func parseShowDate(value string) (time.Time, error) {
+	for _, layout := range showDateLayouts {
+		t, err := time.ParseInLocation(layout, value, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q, expected a date like \"2006-01-02 15:04\"", value)
+}
+
+// resolveBugOrIssueRef resolve the bug to show, either from a reference to an
+// issue of a remote bug-tracker, or with the usual bug selection.
+func resolveBugOrIssueRef(env *execenv.Env, args []string) (*cache.BugCache, []string, error) {
+	if len(args) > 0 {
+		b, ok, err := bridge.ResolveIssueRef(context.Background(), env.Backend, args[0])
+		if ok {
+			return b, args[1:], err
+		}
+	}
+
+	return _select.ResolveBug(env.Backend, args)
+}
+
+func showDefaultFormatter(env *execenv.Env, snapshot *bug.Snapshot, workflow *bug.Workflow, opts bugShowOptions) error {
 	// Header
 	env.Out.Printf("%s [%s] %s\n\n",
 		colors.Cyan(snapshot.Id().Human()),
-		colors.Yellow(snapshot.Status),
+		colors.Yellow(workflow.StateOf(snapshot.Status, snapshot.State)),
 		snapshot.Title,
 	)
 
 	env.Out.Printf("%s opened this issue %s\n",
-		colors.Magenta(snapshot.Author.DisplayName()),
+		colors.Magenta(authorName(snapshot.Author)),
 		snapshot.CreateTime.String(),
 	)
 
@@ -129,16 +229,57 @@ func showDefaultFormatter(env *execenv.Env, snapshot *bug.Snapshot) error {
 		snapshot.EditTime().String(),
 	)
 
+	if canonical := cache.DuplicateOf(snapshot); canonical != "" {
+		env.Out.Printf("duplicate of: %s\n", colors.Cyan(canonical.Human()))
+	}
+
+	// Relations, the ones from other bugs being only known for the current state
+	relations := make(map[string][]string)
+	var relationNames []string
+	addRelation := func(name string, id entity.Id) {
+		if _, ok := relations[name]; !ok {
+			relationNames = append(relationNames, name)
+		}
+		relations[name] = append(relations[name], colors.Cyan(id.Human()))
+	}
+	for _, relation := range snapshot.Relations {
+		addRelation(relation.Type.String(), relation.Target)
+	}
+	if opts.at == "" {
+		for _, relation := range env.Backend.IncomingRelations(snapshot.Id()) {
+			addRelation(relation.Type.Inverse(), relation.Source)
+		}
+		for _, source := range env.Backend.Backlinks(snapshot.Id()) {
+			addRelation("referenced by", source)
+		}
+	}
+	for _, name := range relationNames {
+		env.Out.Printf("%s: %s\n", name, strings.Join(relations[name], ", "))
+	}
+
 	// Labels
 	var labels = make([]string, len(snapshot.Labels))
-	for i := range snapshot.Labels {
-		labels[i] = string(snapshot.Labels[i])
+	for i, label := range snapshot.Labels {
+		lc256 := env.Backend.LabelColor(label).Term256()
+		labels[i] = lc256.Escape() + label.String() + lc256.Unescape()
 	}
 
 	env.Out.Printf("labels: %s\n",
 		strings.Join(labels, ", "),
 	)
 
+	// Custom fields
+	if len(snapshot.Fields) > 0 {
+		var fields = make([]string, 0, len(snapshot.Fields))
+		for _, name := range sortedFieldNames(snapshot) {
+			fields = append(fields, fmt.Sprintf("%s=%s", name, snapshot.Fields[name]))
+		}
+
+		env.Out.Printf("fields: %s\n",
+			strings.Join(fields, ", "),
+		)
+	}
+
 	// Actors
 	var actors = make([]string, len(snapshot.Actors))
 	for i := range snapshot.Actors {
@@ -159,28 +300,56 @@ func showDefaultFormatter(env *execenv.Env, snapshot *bug.Snapshot) error {
 		strings.Join(participants, ", "),
 	)
 
+	// Synchronisation conflicts
+	for _, conflict := range snapshot.SyncConflicts {
+		env.Out.Printf("%s %s: %s differed, local %q, remote %q, %s kept\n",
+			colors.Red("sync conflict"),
+			conflict.Bridge,
+			conflict.Field,
+			conflict.Local,
+			conflict.Remote,
+			conflict.Winner,
+		)
+	}
+	if len(snapshot.SyncConflicts) > 0 {
+		env.Out.Printf("\n")
+	}
+
+	// Merge conflicts
+	if len(snapshot.MergeConflicts) > 0 {
+		env.Out.Printf("%s %d changes overridden by concurrent ones, see \"git bug bug conflicts\"\n\n",
+			colors.Red("merge conflicts"),
+			len(snapshot.MergeConflicts),
+		)
+	}
+
 	// Comments
 	indent := "  "
+	resolver := env.Backend.RefResolver()
 
 	for i, comment := range snapshot.Comments {
 		var message string
-		env.Out.Printf("%s%s #%d %s <%s>\n\n",
+		env.Out.Printf("%s%s #%d %s <%s> %s%s\n\n",
 			indent,
-			comment.CombinedId().Human(),
+			colors.Cyan(comment.CombinedId().Human()),
 			i,
-			comment.Author.DisplayName(),
+			colors.Magenta(authorName(comment.Author)),
 			comment.Author.Email(),
+			comment.FormatTimeRel(),
+			commentIndicators(comment),
 		)
 
 		if comment.Message == "" {
 			message = colors.BlackBold(colors.WhiteBg("No description provided."))
+		} else if opts.expandQuotes {
+			message = render.ANSI(comment.Message, resolver)
 		} else {
-			message = comment.Message
+			message = collapseQuotes(render.ANSI(comment.Message, resolver))
 		}
 
 		env.Out.Printf("%s%s\n\n\n",
 			indent,
-			message,
+			strings.ReplaceAll(message, "\n", "\n"+indent),
 		)
 	}
 
@@ -193,8 +362,11 @@ type JSONBugSnapshot struct {
 	CreateTime   cmdjson.Time       `json:"create_time"`
 	EditTime     cmdjson.Time       `json:"edit_time"`
 	Status       string             `json:"status"`
+	State        string             `json:"state"`
 	Labels       []bug.Label        `json:"labels"`
 	Title        string             `json:"title"`
+	Fields       map[string]string  `json:"fields,omitempty"`
+	Relations    []bug.Relation     `json:"relations,omitempty"`
 	Author       cmdjson.Identity   `json:"author"`
 	Actors       []cmdjson.Identity `json:"actors"`
 	Participants []cmdjson.Identity `json:"participants"`
@@ -202,30 +374,52 @@ type JSONBugSnapshot struct {
 }
 
 type JSONBugComment struct {
-	Id      string           `json:"id"`
-	HumanId string           `json:"human_id"`
-	Author  cmdjson.Identity `json:"author"`
-	Message string           `json:"message"`
+	Id        string            `json:"id"`
+	HumanId   string            `json:"human_id"`
+	Author    cmdjson.Identity  `json:"author"`
+	Message   string            `json:"message"`
+	Reactions []JSONBugReaction `json:"reactions,omitempty"`
+}
+
+type JSONBugReaction struct {
+	Reaction string             `json:"reaction"`
+	Authors  []cmdjson.Identity `json:"authors"`
 }
 
 func NewJSONComment(comment bug.Comment) JSONBugComment {
-	return JSONBugComment{
+	jsonComment := JSONBugComment{
 		Id:      comment.CombinedId().String(),
 		HumanId: comment.CombinedId().Human(),
 		Author:  cmdjson.NewIdentity(comment.Author),
 		Message: comment.Message,
 	}
+
+	for _, group := range comment.Reactions {
+		reaction := JSONBugReaction{
+			Reaction: group.Reaction.String(),
+			Authors:  make([]cmdjson.Identity, len(group.Authors)),
+		}
+		for i, author := range group.Authors {
+			reaction.Authors[i] = cmdjson.NewIdentity(author)
+		}
+		jsonComment.Reactions = append(jsonComment.Reactions, reaction)
+	}
+
+	return jsonComment
 }
 
-func showJsonFormatter(env *execenv.Env, snapshot *bug.Snapshot) error {
+func NewJSONBugSnapshot(snapshot *bug.Snapshot, workflow *bug.Workflow) JSONBugSnapshot {
 	jsonBug := JSONBugSnapshot{
 		Id:         snapshot.Id().String(),
 		HumanId:    snapshot.Id().Human(),
 		CreateTime: cmdjson.NewTime(snapshot.CreateTime, 0),
 		EditTime:   cmdjson.NewTime(snapshot.EditTime(), 0),
 		Status:     snapshot.Status.String(),
+		State:      workflow.StateOf(snapshot.Status, snapshot.State),
 		Labels:     snapshot.Labels,
 		Title:      snapshot.Title,
+		Fields:     snapshot.Fields,
+		Relations:  snapshot.Relations,
 		Author:     cmdjson.NewIdentity(snapshot.Author),
 	}
 
@@ -244,6 +438,12 @@ func showJsonFormatter(env *execenv.Env, snapshot *bug.Snapshot) error {
 		jsonBug.Comments[i] = NewJSONComment(comment)
 	}
 
+	return jsonBug
+}
+
+func showJsonFormatter(env *execenv.Env, snapshot *bug.Snapshot, workflow *bug.Workflow) error {
+	jsonBug := NewJSONBugSnapshot(snapshot, workflow)
+
 	jsonObject, _ := json.MarshalIndent(jsonBug, "", "    ")
 	env.Out.Printf("%s\n", jsonObject)
 
@@ -270,6 +470,10 @@ func showOrgModeFormatter(env *execenv.Env, snapshot *bug.Snapshot) error {
 		snapshot.EditTime().String(),
 	)
 
+	if canonical := cache.DuplicateOf(snapshot); canonical != "" {
+		env.Out.Printf("duplicate of: %s\n", colors.Cyan(canonical.Human()))
+	}
+
 	// Labels
 	var labels = make([]string, len(snapshot.Labels))
 	for i, label := range snapshot.Labels {
@@ -283,6 +487,14 @@ func showOrgModeFormatter(env *execenv.Env, snapshot *bug.Snapshot) error {
 		)
 	}
 
+	// Custom fields
+	if len(snapshot.Fields) > 0 {
+		env.Out.Printf("* Fields:\n")
+		for _, name := range sortedFieldNames(snapshot) {
+			env.Out.Printf("** %s: %s\n", name, snapshot.Fields[name])
+		}
+	}
+
 	// Actors
 	var actors = make([]string, len(snapshot.Actors))
 	for i, actor := range snapshot.Actors {
@@ -327,3 +539,71 @@ func showOrgModeFormatter(env *execenv.Env, snapshot *bug.Snapshot) error {
 
 	return nil
 }
+
+// sortedFieldNames return the names of the custom fields set on the bug,
+// sorted
+func sortedFieldNames(snapshot *bug.Snapshot) []string {
+	names := make([]string, 0, len(snapshot.Fields))
+	for name := range snapshot.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// commentIndicators return the indicators shown next to the author of a
+// comment, for example the number of attached files or of each reaction
+func commentIndicators(comment bug.Comment) string {
+	var result strings.Builder
+	if len(comment.Files) > 0 {
+		result.WriteString(colors.Yellow(fmt.Sprintf(" 📎 %d", len(comment.Files))))
+	}
+	for _, group := range comment.Reactions {
+		result.WriteString(fmt.Sprintf(" %s %d", group.Emoji(), len(group.Authors)))
+	}
+	return result.String()
+}
+
+// quotations longer than this number of lines are collapsed
+const maxQuoteLines = 3
+
+// collapseQuotes collapse the long quotations of a message, as in the
+// replies of an email thread, to their first line.
+func collapseQuotes(message string) string {
+	lines := strings.Split(message, "\n")
+	result := make([]string, 0, len(lines))
+
+	for i := 0; i < len(lines); {
+		if !strings.HasPrefix(lines[i], ">") {
+			result = append(result, lines[i])
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(lines) && strings.HasPrefix(lines[end], ">") {
+			end++
+		}
+
+		if end-i > maxQuoteLines {
+			result = append(result,
+				lines[i],
+				colors.BlackBold(fmt.Sprintf("> [%d more quoted lines]", end-i-1)),
+			)
+		} else {
+			result = append(result, lines[i:end]...)
+		}
+		i = end
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// authorName return the name to display for an author, the bots being marked
+// as such to not be mistaken for a person
+func authorName(author identity.Interface) string {
+	if identity.IsBotIdentity(author) {
+		return author.DisplayName() + " (bot)"
+	}
+	return author.DisplayName()
+}
@@ -0,0 +1,57 @@
+package bugcmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugShowCollapseQuotes(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	err := runBugCommentNew(env, bugCommentNewOptions{
+		message: "> one\n> two\n> three\n> four\nI agree\n> short\nme too",
+	}, []string{bugID.String()})
+	require.NoError(t, err)
+	env.Out.Reset()
+
+	require.NoError(t, runBugShow(env, bugShowOptions{format: "default"}, []string{bugID.String()}))
+	require.Contains(t, env.Out.String(), "  > one\n  > [3 more quoted lines]\n  I agree\n  > short\n  me too\n")
+	require.NotContains(t, env.Out.String(), "> two")
+	env.Out.Reset()
+
+	require.NoError(t, runBugShow(env, bugShowOptions{format: "default", expandQuotes: true}, []string{bugID.String()}))
+	require.Contains(t, env.Out.String(), "  > one\n  > two\n  > three\n  > four\n  I agree\n")
+}
+
+func TestBugShowAt(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	err := runBugShow(env, bugShowOptions{format: "default", at: "2000-01-01"}, []string{bugID.String()})
+	require.ErrorContains(t, err, "the bug didn't exist yet")
+
+	err = runBugShow(env, bugShowOptions{format: "default", at: "yesterday"}, []string{bugID.String()})
+	require.ErrorContains(t, err, "invalid date")
+
+	at := time.Now().Add(time.Hour).Format(time.RFC3339)
+	require.NoError(t, runBugShow(env, bugShowOptions{format: "default", at: at}, []string{bugID.String()}))
+	require.Contains(t, env.Out.String(), "this is a bug title")
+}
+
+func TestBugShowBacklinks(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	other, _, err := env.Backend.NewBug("other bug", "caused by #"+bugID.Human())
+	require.NoError(t, err)
+
+	require.NoError(t, runBugShow(env, bugShowOptions{format: "default"}, []string{bugID.String()}))
+	require.Contains(t, env.Out.String(), "referenced by: "+other.Id().Human()+"\n")
+	env.Out.Reset()
+
+	require.NoError(t, runBugShow(env, bugShowOptions{format: "default"}, []string{other.Id().String()}))
+	require.Contains(t, env.Out.String(), "  caused by #"+bugID.Human()+"\n")
+	require.NotContains(t, env.Out.String(), "referenced by")
+}
@@ -12,8 +12,18 @@ func newBugStatusCommand() *cobra.Command {
 	env := execenv.NewEnv()
 
 	cmd := &cobra.Command{
-		Use:     "status [BUG_ID]",
-		Short:   "Display the status of a bug",
+		Use:   "status [BUG_ID]",
+		Short: "Display the status of a bug",
+		Long: `Display the status of a bug, as its state in the workflow of the repository.
+
+By default, a bug is either "open" or "closed". A workflow with more states, each of them open or closed, can be defined in the git config of the repository, along with the states a bug can move to from each of them:
+
+	git config git-bug.workflow.states triage,in-progress,review,done
+	git config git-bug.workflow.triage.next in-progress,done
+	git config git-bug.workflow.review.next in-progress,done
+	git config git-bug.workflow.done.status closed
+
+A state without "next" can move to any state, and an empty "next" makes a final state. Opening a bug moves it to the first open state, and closing it to the first closed state.`,
 		PreRunE: execenv.LoadBackend(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
 			return runBugStatus(env, args)
@@ -22,7 +32,9 @@ func newBugStatusCommand() *cobra.Command {
 	}
 
 	cmd.AddCommand(newBugStatusCloseCommand())
+	cmd.AddCommand(newBugStatusLsCommand())
 	cmd.AddCommand(newBugStatusOpenCommand())
+	cmd.AddCommand(newBugStatusSetCommand())
 
 	return cmd
 }
@@ -33,9 +45,12 @@ func runBugStatus(env *execenv.Env, args []string) error {
 		return err
 	}
 
-	snap := b.Snapshot()
+	state, err := b.State()
+	if err != nil {
+		return err
+	}
 
-	env.Out.Println(snap.Status)
+	env.Out.Println(state)
 
 	return nil
 }
@@ -8,29 +8,47 @@ import (
 	"github.com/MichaelMure/git-bug/commands/execenv"
 )
 
+type bugStatusCloseOptions struct {
+	confirmFreeze bool
+}
+
 func newBugStatusCloseCommand() *cobra.Command {
 	env := execenv.NewEnv()
+	options := bugStatusCloseOptions{}
 
 	cmd := &cobra.Command{
-		Use:     "close [BUG_ID]",
-		Short:   "Mark a bug as closed",
+		Use:   "close [BUG_ID]",
+		Short: "Mark a bug as closed",
+		Long: `Mark a bug as closed.
+
+While the repository is frozen (see "git bug freeze"), closing a bug carrying one of the protected labels requires --confirm-freeze, and to be one of the approvers if any are configured.`,
 		PreRunE: execenv.LoadBackendEnsureUser(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
-			return runBugStatusClose(env, args)
+			return runBugStatusClose(env, options, args)
 		}),
 		ValidArgsFunction: completion.Bug(env),
 	}
 
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVar(&options.confirmFreeze, "confirm-freeze", false,
+		"Confirm the closing of a bug protected by the freeze mode")
+
 	return cmd
 }
 
-func runBugStatusClose(env *execenv.Env, args []string) error {
+func runBugStatusClose(env *execenv.Env, opts bugStatusCloseOptions, args []string) error {
 	b, args, err := _select.ResolveBug(env.Backend, args)
 	if err != nil {
 		return err
 	}
 
-	_, err = b.Close()
+	if opts.confirmFreeze {
+		_, err = b.CloseConfirmed()
+	} else {
+		_, err = b.Close()
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,43 @@
+package bugcmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func newBugStatusLsCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "ls",
+		Short:   "List the states of the workflow",
+		Long:    `List the states of the workflow of the repository, with their status and the states a bug can move to from them.`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugStatusLs(env)
+		}),
+	}
+
+	return cmd
+}
+
+func runBugStatusLs(env *execenv.Env) error {
+	workflow, err := env.Backend.Workflow()
+	if err != nil {
+		return err
+	}
+
+	for _, state := range workflow.States {
+		next := "final"
+		if states := workflow.NextStates(state.Name); len(states) > 0 {
+			next = "-> " + strings.Join(states, ", ")
+		}
+		env.Out.Printf("%s\t%s\t%s\n", colors.Yellow(state.Name), state.Status, next)
+	}
+
+	return nil
+}
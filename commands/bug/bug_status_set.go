@@ -0,0 +1,64 @@
+package bugcmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+type bugStatusSetOptions struct {
+	confirmFreeze bool
+}
+
+func newBugStatusSetCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugStatusSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set [BUG_ID] STATE",
+		Short: "Move a bug to a state of the workflow",
+		Long: `Move a bug to a state of the workflow, if the workflow allow it from the current state.
+
+While the repository is frozen (see "git bug freeze"), closing a bug carrying one of the protected labels requires --confirm-freeze, and to be one of the approvers if any are configured.`,
+		Example: `git bug bug status set 2f9b7ae in-progress`,
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugStatusSet(env, options, args)
+		}),
+		ValidArgsFunction: completion.BugAndState(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVar(&options.confirmFreeze, "confirm-freeze", false,
+		"Confirm the closing of a bug protected by the freeze mode")
+
+	return cmd
+}
+
+func runBugStatusSet(env *execenv.Env, opts bugStatusSetOptions, args []string) error {
+	b, args, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("a state is required")
+	}
+
+	if opts.confirmFreeze {
+		_, err = b.SetStateConfirmed(args[0])
+	} else {
+		_, err = b.SetState(args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
@@ -0,0 +1,58 @@
+package bugcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugStatus(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	require.NoError(t, runBugStatus(env, []string{bugID.Human()}))
+	require.Equal(t, "open\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugStatusSet(env, bugStatusSetOptions{}, []string{bugID.Human(), "closed"}))
+	require.NoError(t, runBugStatus(env, []string{bugID.Human()}))
+	require.Equal(t, "closed\n", env.Out.String())
+	env.Out.Reset()
+
+	config := env.Repo.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.workflow.states", "triage,in-progress,done"))
+	require.NoError(t, config.StoreString("git-bug.workflow.triage.next", "in-progress"))
+	require.NoError(t, config.StoreString("git-bug.workflow.done.status", "closed"))
+
+	// a closed bug is in the first closed state
+	require.NoError(t, runBugStatus(env, []string{bugID.Human()}))
+	require.Equal(t, "done\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugStatusOpen(env, []string{bugID.Human()}))
+	require.Error(t, runBugStatusSet(env, bugStatusSetOptions{}, []string{bugID.Human(), "done"}))
+	require.Error(t, runBugStatusSet(env, bugStatusSetOptions{}, []string{bugID.Human(), "unknown"}))
+	require.NoError(t, runBugStatusSet(env, bugStatusSetOptions{}, []string{bugID.Human(), "in-progress"}))
+
+	require.NoError(t, runBugStatus(env, []string{bugID.Human()}))
+	require.Equal(t, "in-progress\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBugStatusLs(env))
+	require.Equal(t, "triage\topen\t-> in-progress\nin-progress\topen\t-> triage, done\ndone\tclosed\t-> triage, in-progress\n", env.Out.String())
+	env.Out.Reset()
+
+	opts := bugOptions{
+		sortDirection: "asc",
+		sortBy:        "creation",
+		outputFormat:  "id",
+	}
+
+	require.NoError(t, runBug(env, opts, []string{"status:in-progress"}))
+	require.Equal(t, bugID.String()+"\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runBug(env, opts, []string{"status:triage"}))
+	require.Empty(t, env.Out.String())
+}
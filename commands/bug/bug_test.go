@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/commands/bug/testenv"
 )
 
@@ -101,3 +102,60 @@ $`
 		require.Len(t, bugs, 1)
 	})
 }
+
+func TestBug_Explain(t *testing.T) {
+	env, bugId := testenv.NewTestEnvAndBug(t)
+
+	opts := bugOptions{
+		sortDirection: "asc",
+		sortBy:        "creation",
+		outputFormat:  "default",
+		statusQuery:   []string{"closed"},
+		explain:       bugId.Human(),
+	}
+
+	require.NoError(t, runBug(env, opts, []string{"label:bug"}))
+	require.Equal(t, fmt.Sprintf(`%s this is a bug title
+status (any of): no match
+  no match status:closed: status is open
+label (all of): no match
+  no match label:bug: no labels
+the bug doesn't match the query
+`, bugId.Human()), env.Out.String())
+}
+
+// BenchmarkBug_ExcerptOnly make sure that listing bugs, whatever the output
+// format, only ever touch the excerpts and never load a bug or an identity.
+func BenchmarkBug_ExcerptOnly(b *testing.B) {
+	env, _ := testenv.NewTestEnvAndBug(b)
+
+	// start again from the cache on disk, with nothing loaded in memory
+	require.NoError(b, env.Backend.Close())
+	backend, err := cache.NewRepoCache(env.Repo)
+	require.NoError(b, err)
+	b.Cleanup(func() {
+		_ = backend.Close()
+	})
+	env.Backend = backend
+
+	formats := []string{"default", "plain", "compact", "id", "json", "org-mode"}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, format := range formats {
+			opts := bugOptions{
+				sortDirection: "asc",
+				sortBy:        "creation",
+				outputFormat:  format,
+			}
+			require.NoError(b, runBug(env, opts, []string{}))
+		}
+	}
+
+	b.StopTimer()
+
+	bugs, identities := backend.LoadedCount()
+	require.Zero(b, bugs)
+	require.Zero(b, identities)
+}
@@ -0,0 +1,168 @@
+package bugcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+type bugTranslateOptions struct {
+	lang   string
+	format string
+}
+
+func newBugTranslateCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugTranslateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "translate [BUG_ID]",
+		Short: "Translate the title and the comments of a bug",
+		Long: `Translate the title and the comments of a bug with the configured translation command, and show the translations.
+
+The command is configured with the git-bug.translate.command git config key. It is given the target language as its last argument and the text on its standard input, and write the translation on its standard output. It can be a script calling any translation service.
+
+The translations are stored in the bug as annotations of the operations that set the texts, so that they are shared with the other users of the repository and shown on demand in the termui and the web UI. They are machine translations: the original texts stay the authoritative ones. Only the texts not translated yet are sent to the command, and an edited comment is translated again.
+
+The language defaults to the one configured with the git-bug.translate.language git config key. Without translation command, only the stored translations are shown.`,
+		Example: `git config git-bug.translate.command "trans -brief -target"
+git bug bug translate 2f1d --lang fr`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugTranslate(env, options, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.lang, "lang", "l", "",
+		"The language to translate to, as a tag like \"fr\" or \"pt-BR\"")
+	flags.StringVarP(&options.format, "format", "f", "default",
+		"Select the output formatting style. Valid values are [default,json]")
+	cmd.RegisterFlagCompletionFunc("format", completion.From([]string{"default", "json"}))
+
+	return cmd
+}
+
+func runBugTranslate(env *execenv.Env, opts bugTranslateOptions, args []string) error {
+	b, _, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	lang := opts.lang
+	if lang == "" {
+		lang, err = env.Backend.TranslationLanguage()
+		if err != nil {
+			return err
+		}
+		if lang == "" {
+			return errors.New("no language given with --lang or configured with git-bug.translate.language")
+		}
+	}
+	if err := bug.ValidateLanguage(lang); err != nil {
+		return err
+	}
+
+	translator, err := env.Backend.Translator()
+	switch {
+	case err == cache.ErrNoTranslator:
+		translations := b.Snapshot().Translations(lang)
+		if translations.Title == "" && len(translations.Messages) == 0 {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		count, err := b.Translate(translator, lang)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			if err := b.Commit(); err != nil {
+				return err
+			}
+			env.Err.Printf("%d texts translated\n", count)
+		}
+	}
+
+	snap := b.Snapshot()
+
+	switch opts.format {
+	case "default":
+		translateDefaultFormatter(env, snap, snap.Translations(lang))
+		return nil
+	case "json":
+		return translateJsonFormatter(env, snap, snap.Translations(lang))
+	default:
+		return fmt.Errorf("unknown format %s", opts.format)
+	}
+}
+
+func translateDefaultFormatter(env *execenv.Env, snap *bug.Snapshot, translations bug.Translations) {
+	title := translations.Title
+	if title == "" {
+		title = snap.Title
+	}
+	env.Out.Printf("%s %s\n", colors.Cyan(snap.Id().Human()), title)
+
+	for i, comment := range snap.Comments {
+		message, ok := translations.Messages[comment.CombinedId()]
+		if !ok {
+			continue
+		}
+		env.Out.Printf("\n%s #%d %s:\n\n%s\n",
+			colors.Cyan(comment.CombinedId().Human()),
+			i,
+			colors.Magenta(comment.Author.DisplayName()),
+			message,
+		)
+	}
+}
+
+type JSONBugTranslations struct {
+	Id       string                   `json:"id"`
+	Lang     string                   `json:"lang"`
+	Title    string                   `json:"title,omitempty"`
+	Comments []JSONCommentTranslation `json:"comments"`
+}
+
+type JSONCommentTranslation struct {
+	Id      string `json:"id"`
+	Message string `json:"message"`
+}
+
+func translateJsonFormatter(env *execenv.Env, snap *bug.Snapshot, translations bug.Translations) error {
+	jsonTranslations := JSONBugTranslations{
+		Id:       snap.Id().String(),
+		Lang:     translations.Lang,
+		Title:    translations.Title,
+		Comments: []JSONCommentTranslation{},
+	}
+	for _, comment := range snap.Comments {
+		if message, ok := translations.Messages[comment.CombinedId()]; ok {
+			jsonTranslations.Comments = append(jsonTranslations.Comments, JSONCommentTranslation{
+				Id:      comment.CombinedId().String(),
+				Message: message,
+			})
+		}
+	}
+
+	jsonObject, err := json.MarshalIndent(jsonTranslations, "", "    ")
+	if err != nil {
+		return err
+	}
+	env.Out.Printf("%s\n", jsonObject)
+	return nil
+}
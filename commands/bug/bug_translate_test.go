@@ -0,0 +1,73 @@
+package bugcmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugTranslate(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the translation command is a shell script")
+	}
+
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	opts := bugTranslateOptions{lang: "fr", format: "json"}
+
+	// without translation command nor stored translation
+	require.Error(t, runBugTranslate(env, opts, []string{bugID.Human()}))
+
+	script := filepath.Join(t.TempDir(), "translate")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\necho \"[$1] $(cat)\"\n"), 0755))
+	config := env.Repo.LocalConfig()
+	require.NoError(t, config.StoreString("git-bug.translate.command", script))
+
+	require.NoError(t, runBugTranslate(env, opts, []string{bugID.Human()}))
+
+	var translations JSONBugTranslations
+	require.NoError(t, json.Unmarshal(jsonOutput(env.Out.Bytes()), &translations))
+	require.Equal(t, "fr", translations.Lang)
+	require.Equal(t, "[fr] this is a bug title", translations.Title)
+	require.Len(t, translations.Comments, 1)
+	require.Equal(t, "[fr] this is a bug message", translations.Comments[0].Message)
+
+	// an edited comment is translated again
+	b, err := env.Backend.ResolveBug(bugID)
+	require.NoError(t, err)
+	_, _, err = b.EditCreateComment("edited message")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	// the stored translations are still shown without translation command
+	require.NoError(t, config.RemoveAll("git-bug.translate"))
+	env.Out.Reset()
+	require.NoError(t, runBugTranslate(env, opts, []string{bugID.Human()}))
+	require.NoError(t, json.Unmarshal(jsonOutput(env.Out.Bytes()), &translations))
+	require.Equal(t, "[fr] this is a bug title", translations.Title)
+	require.Empty(t, translations.Comments)
+
+	require.NoError(t, config.StoreString("git-bug.translate.command", script))
+	env.Out.Reset()
+	require.NoError(t, runBugTranslate(env, opts, []string{bugID.Human()}))
+	require.NoError(t, json.Unmarshal(jsonOutput(env.Out.Bytes()), &translations))
+	require.Len(t, translations.Comments, 1)
+	require.Equal(t, "[fr] edited message", translations.Comments[0].Message)
+
+	require.Error(t, runBugTranslate(env, bugTranslateOptions{lang: "not a language"}, []string{bugID.Human()}))
+}
+
+// jsonOutput skip the messages written on the error output before the json
+func jsonOutput(out []byte) []byte {
+	if i := bytes.IndexByte(out, '{'); i >= 0 {
+		return out[i:]
+	}
+	return out
+}
@@ -0,0 +1,132 @@
+package bugcmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	text "github.com/MichaelMure/go-term-text"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/cmdjson"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+type bugWhyClosedOptions struct {
+	format string
+}
+
+func newBugWhyClosedCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := bugWhyClosedOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "why-closed [BUG_ID]",
+		Short: "Show who closed a bug, when and why",
+		Long: `Show who closed a bug, when and why.
+
+The closing comment is the comment posted by the same person around the closure, if any. The referenced commits are the commit hashes found in the metadata of the closure, as set by the bridges, and in the comments posted around the closure.`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runBugWhyClosed(env, options, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.format, "format", "f", "default",
+		"Select the output formatting style. Valid values are [default,json]")
+	cmd.RegisterFlagCompletionFunc("format", completion.From([]string{"default", "json"}))
+
+	return cmd
+}
+
+func runBugWhyClosed(env *execenv.Env, opts bugWhyClosedOptions, args []string) error {
+	b, _, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	snap := b.Snapshot()
+
+	closure, err := snap.LastClosure()
+	if err == bug.ErrBugNotClosed {
+		return fmt.Errorf("bug %s is open", snap.Id().Human())
+	}
+	if err != nil {
+		return err
+	}
+
+	switch opts.format {
+	case "default":
+		whyClosedDefaultFormatter(env, snap, closure)
+		return nil
+	case "json":
+		return whyClosedJsonFormatter(env, snap, closure)
+	default:
+		return fmt.Errorf("unknown format %s", opts.format)
+	}
+}
+
+func whyClosedDefaultFormatter(env *execenv.Env, snap *bug.Snapshot, closure bug.Closure) {
+	env.Out.Printf("%s %s\n", colors.Cyan(snap.Id().Human()), snap.Title)
+	env.Out.Printf("closed by %s <%s> on %s\n",
+		colors.Magenta(closure.Author.DisplayName()),
+		closure.Author.Email(),
+		closure.Time.Format("Mon Jan 2 15:04:05 2006 -0700"),
+	)
+
+	if closure.Comment != nil {
+		env.Out.Printf("\nclosing comment %s:\n", colors.Cyan(closure.Comment.CombinedId().Human()))
+		env.Out.Println(text.LeftPadLines(closure.Comment.Message, 4))
+	}
+
+	if len(closure.Commits) > 0 {
+		env.Out.Printf("\nreferenced commits:\n")
+		for _, commit := range closure.Commits {
+			env.Out.Printf("    %s\n", colors.Yellow(commit))
+		}
+	}
+}
+
+type JSONBugClosure struct {
+	Id        string           `json:"id"`
+	HumanId   string           `json:"human_id"`
+	Title     string           `json:"title"`
+	Operation string           `json:"operation"`
+	Author    cmdjson.Identity `json:"author"`
+	Time      cmdjson.Time     `json:"time"`
+	Comment   *JSONBugComment  `json:"comment,omitempty"`
+	Commits   []string         `json:"commits"`
+}
+
+func whyClosedJsonFormatter(env *execenv.Env, snap *bug.Snapshot, closure bug.Closure) error {
+	jsonClosure := JSONBugClosure{
+		Id:        snap.Id().String(),
+		HumanId:   snap.Id().Human(),
+		Title:     snap.Title,
+		Operation: closure.OperationId.String(),
+		Author:    cmdjson.NewIdentity(closure.Author),
+		Time:      cmdjson.NewTime(closure.Time, 0),
+		Commits:   closure.Commits,
+	}
+	if jsonClosure.Commits == nil {
+		jsonClosure.Commits = []string{}
+	}
+	if closure.Comment != nil {
+		comment := NewJSONComment(*closure.Comment)
+		jsonClosure.Comment = &comment
+	}
+
+	jsonObject, err := json.MarshalIndent(jsonClosure, "", "    ")
+	if err != nil {
+		return err
+	}
+	env.Out.Printf("%s\n", jsonObject)
+	return nil
+}
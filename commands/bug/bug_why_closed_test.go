@@ -0,0 +1,38 @@
+package bugcmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestBugWhyClosed(t *testing.T) {
+	env, bugID := testenv.NewTestEnvAndBug(t)
+
+	require.Error(t, runBugWhyClosed(env, bugWhyClosedOptions{format: "default"}, []string{bugID.Human()}))
+
+	b, err := env.Backend.ResolveBug(bugID)
+	require.NoError(t, err)
+	_, _, err = b.AddComment("fixed in 1a2b3c4")
+	require.NoError(t, err)
+	_, err = b.Close()
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	require.NoError(t, runBugWhyClosed(env, bugWhyClosedOptions{format: "default"}, []string{bugID.Human()}))
+	require.Contains(t, env.Out.String(), "closing comment")
+	require.Contains(t, env.Out.String(), "1a2b3c4")
+
+	env.Out.Reset()
+	require.NoError(t, runBugWhyClosed(env, bugWhyClosedOptions{format: "json"}, []string{bugID.Human()}))
+
+	var closure JSONBugClosure
+	require.NoError(t, json.Unmarshal(env.Out.Bytes(), &closure))
+	require.Equal(t, bugID.String(), closure.Id)
+	require.NotNil(t, closure.Comment)
+	require.Equal(t, "fixed in 1a2b3c4", closure.Comment.Message)
+	require.Equal(t, []string{"1a2b3c4"}, closure.Commits)
+}
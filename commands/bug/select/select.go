@@ -34,7 +34,7 @@ func ResolveBug(repo *cache.RepoCache, args []string) (*cache.BugCache, []string
 			return b, args[1:], nil
 		}
 
-		if err != bug.ErrBugNotExist {
+		if !entity.IsErrNotFound(err) {
 			return nil, nil, err
 		}
 	}
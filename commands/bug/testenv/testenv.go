@@ -14,7 +14,7 @@ const (
 	testUserEmail = "jdoe@example.com"
 )
 
-func NewTestEnvAndUser(t *testing.T) (*execenv.Env, entity.Id) {
+func NewTestEnvAndUser(t testing.TB) (*execenv.Env, entity.Id) {
 	t.Helper()
 
 	testEnv := execenv.NewTestEnv(t)
@@ -33,7 +33,7 @@ const (
 	testBugMessage = "this is a bug message"
 )
 
-func NewTestEnvAndBug(t *testing.T) (*execenv.Env, entity.Id) {
+func NewTestEnvAndBug(t testing.TB) (*execenv.Env, entity.Id) {
 	t.Helper()
 
 	testEnv, _ := NewTestEnvAndUser(t)
@@ -48,7 +48,7 @@ const (
 	testCommentMessage = "this is a bug comment"
 )
 
-func NewTestEnvAndBugWithComment(t *testing.T) (*execenv.Env, entity.Id, entity.CombinedId) {
+func NewTestEnvAndBugWithComment(t testing.TB) (*execenv.Env, entity.Id, entity.CombinedId) {
 	t.Helper()
 
 	env, bugID := NewTestEnvAndBug(t)
@@ -0,0 +1,245 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func newCacheCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the git-bug cache",
+		Long: `Manage the git-bug cache.
+
+The cache keeps an excerpt of every bug, and the recently used bugs fully loaded in memory. The least recently used ones are evicted once there are more than "git-bug.cache.max-loaded-bugs" (1000 by default) of them, or once their estimated size exceeds "git-bug.cache.max-loaded-bytes" (no limit by default).`,
+		Example: `Keep at most 200 bugs or about 64MB of bugs loaded in memory:
+git config git-bug.cache.max-loaded-bugs 200
+git config git-bug.cache.max-loaded-bytes 67108864
+`,
+	}
+
+	cmd.AddCommand(newCacheBuildCommand())
+	cmd.AddCommand(newCacheInspectCommand())
+
+	return cmd
+}
+
+type cacheBuildOptions struct {
+	prefetch int
+}
+
+func newCacheBuildCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := cacheBuildOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "build",
+		Short: "Rebuild the cache from the git repository",
+		Long: `Rebuild the cache from the git repository.
+
+With --prefetch, the most recently edited bugs are also fully loaded, which is useful to warm up the cache of a freshly cloned repository.
+
+The same warm up can be done automatically the first time the cache is built, typically after a clone, by setting the "git-bug.cache.prefetch" git config to the number of bugs to prefetch.`,
+		Example: `Rebuild the cache and prefetch the 200 most recently edited bugs:
+git bug cache build --prefetch 200
+
+Always prefetch 200 bugs after a clone:
+git config --global git-bug.cache.prefetch 200
+`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runCacheBuild(env, options)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.IntVar(&options.prefetch, "prefetch", 0,
+		"Load the N most recently edited bugs after building the cache")
+
+	return cmd
+}
+
+func runCacheBuild(env *execenv.Env, opts cacheBuildOptions) error {
+	err := env.Backend.Rebuild()
+	if err != nil {
+		return err
+	}
+
+	if opts.prefetch <= 0 {
+		return nil
+	}
+
+	n, err := env.Backend.Prefetch(opts.prefetch)
+	if err != nil {
+		return err
+	}
+
+	env.Out.Printf("%d bugs prefetched\n", n)
+
+	return nil
+}
+
+type cacheInspectOptions struct {
+	bugs   bool
+	schema bool
+	format string
+}
+
+func newCacheInspectCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := cacheInspectOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "Describe the content of the cache",
+		Long: `Describe the content of the cache: the format version and size of the cache files, the number of cached entities, and the entities stored in git but missing from the cache (or the opposite).
+
+This helps to understand why a bug doesn't show up in "git bug bug": a missing or outdated cache can be fixed with "git bug cache build".
+
+The cache is only read: this command never takes the repository lock and never rebuilds the cache.`,
+		Example: `List the excerpt of each cached bug:
+git bug cache inspect --bugs
+
+Dump the description of the cache format:
+git bug cache inspect --schema
+`,
+		Args:    cobra.NoArgs,
+		PreRunE: execenv.LoadRepo(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCacheInspect(env, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVar(&options.bugs, "bugs", false,
+		"Also list the excerpt of each cached bug")
+	flags.BoolVar(&options.schema, "schema", false,
+		"Dump the machine-readable description of the cache format instead, as JSON")
+	flags.StringVarP(&options.format, "format", "f", "default",
+		"Select the output formatting style. Valid values are [default,json]")
+	cmd.RegisterFlagCompletionFunc("format", completion.From([]string{"default", "json"}))
+
+	return cmd
+}
+
+func runCacheInspect(env *execenv.Env, opts cacheInspectOptions) error {
+	if opts.schema {
+		jsonObject, _ := json.MarshalIndent(cache.DescribeCacheSchema(), "", "    ")
+		env.Out.Printf("%s\n", jsonObject)
+		return nil
+	}
+
+	inspection, err := cache.InspectCache(env.Repo)
+	if err != nil {
+		return err
+	}
+
+	switch opts.format {
+	case "json":
+		return cacheInspectJsonFormatter(env, inspection, opts)
+	case "default":
+		return cacheInspectDefaultFormatter(env, inspection, opts)
+	default:
+		return fmt.Errorf("unknown format %s", opts.format)
+	}
+}
+
+func cacheInspectDefaultFormatter(env *execenv.Env, inspection *cache.CacheInspection, opts cacheInspectOptions) error {
+	env.Out.Printf("format version: %d\n", inspection.FormatVersion)
+
+	for _, file := range inspection.Files {
+		switch {
+		case !file.Exist:
+			env.Out.Printf("%s: %s\n", file.Name, colors.Yellow("not built yet"))
+		case file.Err != "":
+			env.Out.Printf("%s: %d bytes, version %d, %s\n", file.Name, file.Size, file.Version, colors.Red(file.Err))
+		default:
+			env.Out.Printf("%s: %d bytes, version %d, %d entries\n", file.Name, file.Size, file.Version, file.Entries)
+		}
+	}
+
+	printIds := func(title string, ids []entity.Id) {
+		if len(ids) == 0 {
+			return
+		}
+		env.Out.Printf("%s: %d\n", title, len(ids))
+		for _, id := range ids {
+			env.Out.Printf("  %s\n", id)
+		}
+	}
+
+	printIds("bugs missing from the cache", inspection.MissingBugs)
+	printIds("identities missing from the cache", inspection.MissingIdentities)
+	printIds("bugs no longer in git", inspection.StaleBugs)
+
+	if !opts.bugs {
+		return nil
+	}
+
+	env.Out.Println()
+	for _, excerpt := range inspection.Bugs {
+		labels := make([]string, len(excerpt.Labels))
+		for i, l := range excerpt.Labels {
+			labels[i] = l.String()
+		}
+		env.Out.Printf("%s %s\t%s\tedited %s (lamport %d)\t%d comments\t[%s]\n",
+			colors.Cyan(excerpt.Id.Human()),
+			colors.Yellow(excerpt.Status),
+			excerpt.Title,
+			excerpt.EditTime().Format(time.RFC3339),
+			excerpt.EditLamportTime,
+			excerpt.LenComments,
+			strings.Join(labels, ", "),
+		)
+	}
+
+	return nil
+}
+
+type jsonCacheInspection struct {
+	FormatVersion     uint                  `json:"format_version"`
+	Files             []cache.CacheFileInfo `json:"files"`
+	MissingBugs       []string              `json:"missing_bugs"`
+	MissingIdentities []string              `json:"missing_identities"`
+	StaleBugs         []string              `json:"stale_bugs"`
+	Bugs              []*cache.BugExcerpt   `json:"bugs,omitempty"`
+}
+
+func cacheInspectJsonFormatter(env *execenv.Env, inspection *cache.CacheInspection, opts cacheInspectOptions) error {
+	result := jsonCacheInspection{
+		FormatVersion:     inspection.FormatVersion,
+		Files:             inspection.Files,
+		MissingBugs:       toStrings(inspection.MissingBugs),
+		MissingIdentities: toStrings(inspection.MissingIdentities),
+		StaleBugs:         toStrings(inspection.StaleBugs),
+	}
+	if opts.bugs {
+		result.Bugs = inspection.Bugs
+	}
+
+	jsonObject, _ := json.MarshalIndent(result, "", "    ")
+	env.Out.Printf("%s\n", jsonObject)
+	return nil
+}
+
+func toStrings(ids []entity.Id) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+type cloneTrackerOptions struct {
+	remote string
+}
+
+func newCloneTrackerCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := cloneTrackerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "clone-tracker URL",
+		Short: "Join a project already using git-bug",
+		Long: `Join a project already using git-bug, in one step: the remote is added if needed, and configured so that a plain "git fetch" also retrieve the bugs and identities. They are then fetched, merged, and the cache is built.
+
+If no user identity is set yet, only the identities are merged, so that you can adopt yours with "git bug user adopt" or create one with "git bug user new". The bugs are then merged with "git bug pull".`,
+		Example: `git bug clone-tracker https://github.com/MichaelMure/git-bug.git
+git bug clone-tracker --remote upstream git@example.com:project.git`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runCloneTracker(env, options, args)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.remote, "remote", "r", "origin",
+		"Name of the git remote to add or reuse")
+
+	return cmd
+}
+
+func runCloneTracker(env *execenv.Env, opts cloneTrackerOptions, args []string) error {
+	err := env.Repo.SetupRemote(opts.remote, args[0], []string{"bugs", "identities"})
+	if err != nil {
+		return err
+	}
+
+	env.Out.Println("Fetching remote ...")
+
+	stdout, err := env.Backend.Fetch(opts.remote)
+	if err != nil {
+		return err
+	}
+
+	env.Out.Println(stdout)
+
+	_, err = env.Backend.GetUserIdentity()
+	if err != nil && err != identity.ErrNoIdentitySet {
+		return err
+	}
+	noUser := err == identity.ErrNoIdentitySet
+
+	env.Out.Println("Merging data ...")
+
+	results := env.Backend.MergeAll(opts.remote)
+	if noUser {
+		results = env.Backend.MergeIdentities(opts.remote)
+	}
+
+	for result := range results {
+		if result.Err != nil {
+			env.Err.Println(result.Err)
+		}
+
+		if result.Status != entity.MergeStatusNothing {
+			env.Out.Printf("%s: %s\n", result.Id.Human(), result)
+		}
+	}
+
+	err = env.Backend.Rebuild()
+	if err != nil {
+		return err
+	}
+
+	env.Out.Printf("%d bugs and %d identities available\n",
+		len(env.Backend.AllBugsIds()), len(env.Backend.AllIdentityIds()))
+
+	if noUser {
+		env.Out.Printf("No user identity is set, so the bugs have not been merged yet. "+
+			"Adopt your identity with \"git bug user adopt\" or create one with \"git bug user new\", "+
+			"then run \"git bug pull %s\".\n", opts.remote)
+	}
+
+	return nil
+}
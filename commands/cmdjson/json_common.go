@@ -33,6 +33,25 @@ func NewIdentityFromExcerpt(excerpt *cache.IdentityExcerpt) Identity {
 	}
 }
 
+// PluginContext is given to a plugin run with "git bug x-<name>", as JSON in
+// the GIT_BUG_PLUGIN_CONTEXT environment variable. The fields are omitted when
+// unknown, like outside of a repository.
+type PluginContext struct {
+	// Version of the format, changed only when a field is removed or changes
+	// meaning
+	Version int `json:"version"`
+	// Executable is the path of the git-bug executable, to run other commands
+	Executable string `json:"executable,omitempty"`
+	// RepoPath is the path of the git directory of the repository
+	RepoPath string `json:"repo_path,omitempty"`
+	// StoragePath is the path of the local storage of git-bug in the repository
+	StoragePath string `json:"storage_path,omitempty"`
+	// DaemonSocket is the unix socket of the running daemon of the repository
+	DaemonSocket string `json:"daemon_socket,omitempty"`
+	// Identity is the identity of the user
+	Identity *Identity `json:"identity,omitempty"`
+}
+
 type Time struct {
 	Timestamp int64        `json:"timestamp"`
 	Time      time.Time    `json:"time"`
@@ -0,0 +1,60 @@
+package cmdjson
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// BugExportFormatVersion is the current version of the bug export document format
+const BugExportFormatVersion = 1
+
+// BugExportSchema is the JSON Schema of the document produced by `git bug bug export`.
+//
+//go:embed bug_export.schema.json
+var BugExportSchema []byte
+
+const bugExportSchemaURL = "https://github.com/MichaelMure/git-bug/bug-export.schema.json"
+
+var (
+	bugExportSchemaOnce     sync.Once
+	bugExportSchemaCompiled *jsonschema.Schema
+	bugExportSchemaErr      error
+)
+
+func compiledBugExportSchema() (*jsonschema.Schema, error) {
+	bugExportSchemaOnce.Do(func() {
+		compiler := jsonschema.NewCompiler()
+		compiler.AssertFormat = true
+		err := compiler.AddResource(bugExportSchemaURL, bytes.NewReader(BugExportSchema))
+		if err != nil {
+			bugExportSchemaErr = err
+			return
+		}
+		bugExportSchemaCompiled, bugExportSchemaErr = compiler.Compile(bugExportSchemaURL)
+	})
+	return bugExportSchemaCompiled, bugExportSchemaErr
+}
+
+// ValidateBugExport check that the given document conform to the bug export
+// JSON Schema. This should be used to check a document, possibly produced by
+// a third party, before importing it.
+func ValidateBugExport(data []byte) error {
+	schema, err := compiledBugExportSchema()
+	if err != nil {
+		return err
+	}
+
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return fmt.Errorf("invalid JSON: %v", err)
+	}
+
+	return schema.Validate(doc)
+}
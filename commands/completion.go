@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	json2 "github.com/MichaelMure/git-bug/commands/cmdjson"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+// addCompletionDataCommand add the data sub-command to the completion command,
+// creating the default cobra one if needed so that the shell scripts are still
+// available.
+func addCompletionDataCommand(root *cobra.Command) {
+	root.InitDefaultCompletionCmd()
+
+	for _, child := range root.Commands() {
+		if child.Name() == "completion" {
+			child.AddCommand(newCompletionDataCommand())
+			return
+		}
+	}
+}
+
+func newCompletionDataCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "data",
+		Short: "Dump the data useful for completion as JSON",
+		Long: `Dump as JSON the bug ids and titles, the labels, the users and the saved queries, for use by external completion frameworks (fzf pickers, zsh widgets ...).
+
+The data is read from the cache only: this command never takes the repository lock, never writes anything and never touches the network, so it's safe to run while another git-bug process is running. If the cache has not been built yet, run "git bug cache build" first.
+
+Saved queries are read from the git config, as "git-bug.query.<name>".`,
+		Example: `Pick a bug with fzf:
+git bug completion data | jq -r '.bugs[] | "\(.human_id) \(.title)"' | fzf
+
+Save a query:
+git config git-bug.query.mine "status:open author:me"
+`,
+		Args:    cobra.NoArgs,
+		PreRunE: execenv.LoadRepo(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCompletionData(env)
+		},
+	}
+
+	return cmd
+}
+
+type completionBug struct {
+	Id      string   `json:"id"`
+	HumanId string   `json:"human_id"`
+	Title   string   `json:"title"`
+	Status  string   `json:"status"`
+	Labels  []string `json:"labels"`
+}
+
+type completionData struct {
+	Bugs         []completionBug   `json:"bugs"`
+	Labels       []string          `json:"labels"`
+	Users        []json2.Identity  `json:"users"`
+	SavedQueries map[string]string `json:"saved_queries"`
+}
+
+func runCompletionData(env *execenv.Env) error {
+	data, err := cache.ReadCompletionData(env.Repo, env.Repo.AnyConfig())
+	if err != nil {
+		return err
+	}
+
+	result := completionData{
+		Bugs:         make([]completionBug, len(data.Bugs)),
+		Labels:       make([]string, len(data.Labels)),
+		Users:        make([]json2.Identity, len(data.Identities)),
+		SavedQueries: data.SavedQueries,
+	}
+
+	for i, excerpt := range data.Bugs {
+		labels := make([]string, len(excerpt.Labels))
+		for j, l := range excerpt.Labels {
+			labels[j] = l.String()
+		}
+		result.Bugs[i] = completionBug{
+			Id:      excerpt.Id.String(),
+			HumanId: excerpt.Id.Human(),
+			Title:   excerpt.Title,
+			Status:  excerpt.Status.String(),
+			Labels:  labels,
+		}
+	}
+
+	for i, l := range data.Labels {
+		result.Labels[i] = l.String()
+	}
+
+	for i, excerpt := range data.Identities {
+		result.Users[i] = json2.NewIdentityFromExcerpt(excerpt)
+	}
+
+	jsonObject, _ := json.MarshalIndent(result, "", "    ")
+	env.Out.Printf("%s\n", jsonObject)
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/MichaelMure/git-bug/commands/bug/select"
 	"github.com/MichaelMure/git-bug/commands/execenv"
 	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
 )
 
 type ValidArgsFunction func(cmd *cobra.Command, args []string, toComplete string) (completions []string, directives cobra.ShellCompDirective)
@@ -169,6 +170,24 @@ func From(choices []string) ValidArgsFunction {
 	}
 }
 
+// WorkspaceRepo complete the repositories of the workspace of the user
+func WorkspaceRepo() ValidArgsFunction {
+	return func(cmd *cobra.Command, args []string, toComplete string) (completions []string, directives cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		path, err := cache.DefaultWorkspacePath()
+		if err != nil {
+			return handleError(err)
+		}
+		w, err := cache.LoadWorkspace(path)
+		if err != nil {
+			return handleError(err)
+		}
+		return w.Repos, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
 func GitRemote(env *execenv.Env) ValidArgsFunction {
 	return func(cmd *cobra.Command, args []string, toComplete string) (completions []string, directives cobra.ShellCompDirective) {
 		if err := execenv.LoadBackend(env)(cmd, args); err != nil {
@@ -216,8 +235,20 @@ func Label(env *execenv.Env) ValidArgsFunction {
 func Ls(env *execenv.Env) ValidArgsFunction {
 	return func(cmd *cobra.Command, args []string, toComplete string) (completions []string, directives cobra.ShellCompDirective) {
 		if strings.HasPrefix(toComplete, "status:") {
-			completions = append(completions, "status:open\tOpen bugs")
-			completions = append(completions, "status:closed\tClosed bugs")
+			if err := execenv.LoadBackend(env)(cmd, args); err != nil {
+				return handleError(err)
+			}
+			defer func() {
+				_ = env.Backend.Close()
+			}()
+
+			statuses, err := statusCompletions(env.Backend)
+			if err != nil {
+				return handleError(err)
+			}
+			for _, status := range statuses {
+				completions = append(completions, "status:"+status)
+			}
 			return completions, cobra.ShellCompDirectiveDefault
 		}
 
@@ -284,13 +315,85 @@ func Ls(env *execenv.Env) ValidArgsFunction {
 			"label:\tFilter by label",
 			"no:\tExclude bugs by label",
 			"participant:\tFilter by participant",
-			"status:\tFilter by open/close status",
+			"status:\tFilter by open/close status or by state",
 			"title:\tFilter by title",
 		}
 		return completions, cobra.ShellCompDirectiveNoSpace
 	}
 }
 
+// Status complete the statuses, and the states of the workflow
+func Status(env *execenv.Env) ValidArgsFunction {
+	return func(cmd *cobra.Command, args []string, toComplete string) (completions []string, directives cobra.ShellCompDirective) {
+		if err := execenv.LoadBackend(env)(cmd, args); err != nil {
+			return handleError(err)
+		}
+		defer func() {
+			_ = env.Backend.Close()
+		}()
+
+		completions, err := statusCompletions(env.Backend)
+		if err != nil {
+			return handleError(err)
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func statusCompletions(backend *cache.RepoCache) ([]string, error) {
+	workflow, err := backend.Workflow()
+	if err != nil {
+		return nil, err
+	}
+
+	completions := []string{"open\tOpen bugs", "closed\tClosed bugs"}
+	for _, state := range workflow.States {
+		if _, err := common.StatusFromString(state.Name); err == nil {
+			continue
+		}
+		completions = append(completions, fmt.Sprintf("%s\t%s state", state.Name, state.Status))
+	}
+	return completions, nil
+}
+
+// BugAndState complete a bug, then the states of the workflow it can move to
+func BugAndState(env *execenv.Env) ValidArgsFunction {
+	return func(cmd *cobra.Command, args []string, toComplete string) (completions []string, directives cobra.ShellCompDirective) {
+		if err := execenv.LoadBackend(env)(cmd, args); err != nil {
+			return handleError(err)
+		}
+		defer func() {
+			_ = env.Backend.Close()
+		}()
+
+		b, args, err := _select.ResolveBug(env.Backend, args)
+		if err == _select.ErrNoValidId {
+			// we need a bug first to complete the states
+			return bugWithBackend(env.Backend, toComplete)
+		}
+		if err != nil {
+			return handleError(err)
+		}
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		workflow, err := env.Backend.Workflow()
+		if err != nil {
+			return handleError(err)
+		}
+		state, err := b.State()
+		if err != nil {
+			return handleError(err)
+		}
+
+		for _, next := range workflow.NextStates(state) {
+			completions = append(completions, next+"\t"+"State")
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
 func User(env *execenv.Env) ValidArgsFunction {
 	return func(cmd *cobra.Command, args []string, toComplete string) (completions []string, directives cobra.ShellCompDirective) {
 		if err := execenv.LoadBackend(env)(cmd, args); err != nil {
@@ -338,6 +441,7 @@ func UserForQuery(env *execenv.Env) ValidArgsFunction {
 			}
 			completions[i] = handle + "\t" + user.DisplayName()
 		}
+		completions = append(completions, "me\tYour own identity")
 		return completions, cobra.ShellCompDirectiveNoFileComp
 	}
 }
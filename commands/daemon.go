@@ -0,0 +1,423 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bridge"
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+// the name of the unix socket of the daemon, in the git-bug directory of the
+// repository
+const daemonSocketFile = "daemon.sock"
+
+// daemonCommands are the commands the CLI let a running daemon execute. They
+// must not read the standard input, open an editor or write files given as a
+// relative path, as they run in the daemon process.
+var daemonCommands = map[string]bool{
+	"audit-log":         true,
+	"bridge health":     true,
+	"bug":               true,
+	"bug comment":       true,
+	"bug deselect":      true,
+	"bug field":         true,
+	"bug field rm":      true,
+	"bug field set":     true,
+	"bug grep":          true,
+	"bug label":         true,
+	"bug label new":     true,
+	"bug label rm":      true,
+	"bug merge-into":    true,
+	"bug request-info":  true,
+	"bug rm":            true,
+	"bug select":        true,
+	"bug show":          true,
+	"bug status":        true,
+	"bug status close":  true,
+	"bug status open":   true,
+	"bug title":         true,
+	"feed":              true,
+	"feed ack":          true,
+	"label":             true,
+	"plumbing read-ops": true,
+	"user":              true,
+	"user show":         true,
+	"user trust":        true,
+	"user untrust":      true,
+}
+
+// daemonRequest is sent by the CLI to the daemon, to run a command
+type daemonRequest struct {
+	Args []string
+}
+
+// daemonMessage is sent by the daemon to the CLI: the output of the command,
+// then its exit code
+type daemonMessage struct {
+	Out      []byte `json:",omitempty"`
+	Err      []byte `json:",omitempty"`
+	Done     bool   `json:",omitempty"`
+	ExitCode int    `json:",omitempty"`
+}
+
+type daemonOptions struct {
+	staleEvery time.Duration
+	syncEvery  time.Duration
+}
+
+func newDaemonCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := daemonOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Keep the cache loaded and run the commands on behalf of the CLI",
+		Long: `Keep the cache loaded and run the commands on behalf of the CLI.
+
+The daemon owns the cache of the repository, and listen on a unix socket in the git-bug directory of the repository. While it runs, the commands that only query or edit the bugs without any interaction are sent to the daemon instead of loading the cache each time, and don't fail because the repository is locked.
+
+The interactive commands, like the ones opening an editor, still load the cache themselves and can't be used while the daemon runs.
+
+The daemon also applies the stale policy, if one is configured: the open bugs without activity for "git-bug.stale.days" days are commented on and labeled as stale, then closed after "git-bug.stale.close-days" more days unless the activity resumes, in which case the label is removed. The policy is configured with the following keys under "git-bug.stale":
+- days: the number of days of inactivity after which a bug is stale, the policy is disabled if unset
+- close-days: the number of days after which a stale bug is closed, never if unset
+- label: the label of the stale bugs, "stale" by default
+- message: the comment added when a bug is labeled as stale
+- close-message: the comment added when a stale bug is closed
+- exempt-labels: the labels of the bugs never considered stale, comma separated
+
+With --sync-every, the daemon also pulls and pushes all the configured bridges periodically. Their health is recorded, to be checked with "git bug bridge health" or the /metrics endpoint of the webui, and a webhook can be alerted when a bridge has been failing for too long.
+
+The changes are authored by the user identity running the daemon.
+`,
+		Example: `Label the bugs inactive for 60 days as stale, and close them 7 days later:
+git config git-bug.stale.days 60
+git config git-bug.stale.close-days 7
+git config git-bug.stale.message "This bug has been inactive for 60 days and will be closed in 7 days."
+git config git-bug.stale.exempt-labels "security, pinned"
+git bug daemon
+
+Synchronize the bridges every 10 minutes:
+git bug daemon --sync-every 10m
+`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runDaemon(env, options)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.DurationVar(&options.staleEvery, "stale-every", time.Hour,
+		"How often the stale policy is applied")
+	flags.DurationVar(&options.syncEvery, "sync-every", 0,
+		"How often the configured bridges are pulled and pushed, never if 0")
+
+	return cmd
+}
+
+func runDaemon(env *execenv.Env, opts daemonOptions) error {
+	path, err := daemonSocketPath(env.Repo)
+	if err != nil {
+		return err
+	}
+
+	// as the daemon holds the lock of the repository, a socket left there can
+	// only be the one of a daemon that crashed
+	_ = os.Remove(path)
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return err
+	}
+
+	// the cache has a single writer, the commands are run one at a time
+	var mu sync.Mutex
+
+	stop := make(chan struct{})
+
+	// on interrupt, stop accepting commands and let the running one finish,
+	// before the backend is closed
+	interrupt.RegisterCleaner(func() error {
+		env.Out.Println("Daemon is shutting down...")
+		close(stop)
+		// closing the listener also remove the socket
+		_ = listener.Close()
+		mu.Lock()
+		return nil
+	})
+
+	go runPeriodically(&mu, opts.staleEvery, stop, func(ctx context.Context) {
+		applyStalePolicy(env)
+	})
+	go runPeriodically(&mu, opts.syncEvery, stop, func(ctx context.Context) {
+		syncBridges(ctx, env)
+	})
+
+	env.Out.Printf("Daemon listening on %s\n", path)
+
+	for {
+		conn, err := listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			defer conn.Close()
+
+			var req daemonRequest
+			if err := json.NewDecoder(conn).Decode(&req); err != nil {
+				env.Err.Printf("Invalid request: %v\n", err)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			serveDaemonRequest(env, conn, req)
+		}()
+	}
+
+	return nil
+}
+
+// runPeriodically run a task of the daemon periodically, until stop is
+// closed, which also cancel the context of the running task. The task reads
+// its configuration each time, so that it can be changed while the daemon
+// runs.
+func runPeriodically(mu *sync.Mutex, every time.Duration, stop <-chan struct{}, task func(ctx context.Context)) {
+	if every <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	for {
+		mu.Lock()
+		select {
+		case <-stop:
+			mu.Unlock()
+			return
+		default:
+		}
+		task(ctx)
+		mu.Unlock()
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func applyStalePolicy(env *execenv.Env) {
+	policy, err := env.Backend.StalePolicy()
+	if err != nil {
+		env.Err.Printf("Stale policy: %v\n", err)
+		return
+	}
+
+	results, err := env.Backend.ApplyStalePolicy(policy, time.Now())
+	if err != nil {
+		env.Err.Printf("Stale policy: %v\n", err)
+		return
+	}
+
+	for _, result := range results {
+		if result.Err != nil {
+			env.Err.Printf("Stale policy: %s: %v\n", result.Id.Human(), result.Err)
+			continue
+		}
+		env.Out.Printf("%s: %s\n", result.Id.Human(), result.Action)
+	}
+}
+
+// syncBridges pull then push all the configured bridges, which record their
+// health
+func syncBridges(ctx context.Context, env *execenv.Env) {
+	names, err := bridge.ConfiguredBridges(env.Backend)
+	if err != nil {
+		env.Err.Printf("Bridge sync: %v\n", err)
+		return
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		b, err := bridge.LoadBridge(env.Backend, name)
+		if err != nil {
+			env.Err.Printf("Bridge sync: %s: %v\n", name, err)
+			continue
+		}
+
+		imported, err := b.ImportAll(ctx)
+		if err != nil {
+			env.Err.Printf("Bridge sync: %s: pull: %v\n", name, err)
+		} else {
+			for result := range imported {
+				if result.Event == core.ImportEventError || result.Event == core.ImportEventWarning {
+					env.Err.Printf("Bridge sync: %s: pull: %s\n", name, result)
+				}
+			}
+		}
+
+		exported, err := b.ExportAll(ctx, time.Time{})
+		if err != nil {
+			env.Err.Printf("Bridge sync: %s: push: %v\n", name, err)
+		} else {
+			for result := range exported {
+				if result.Event == core.ExportEventError || result.Event == core.ExportEventWarning {
+					env.Err.Printf("Bridge sync: %s: push: %s\n", name, result)
+				}
+			}
+		}
+	}
+}
+
+// serveDaemonRequest run a command with the repository and the backend of the
+// daemon, streaming its output to the CLI
+func serveDaemonRequest(env *execenv.Env, conn net.Conn, req daemonRequest) {
+	stream := &daemonStream{enc: json.NewEncoder(conn)}
+	stdout := daemonWriter{stream: stream}
+	stderr := daemonWriter{stream: stream, stderr: true}
+
+	root := NewRootCommand()
+	root.SetArgs(req.Args)
+	root.SetOut(stdout)
+	root.SetErr(stderr)
+
+	ctx := execenv.WithDaemon(context.Background(), env.Repo, env.Backend, stdout, stderr)
+
+	exitCode := 0
+	if err := root.ExecuteContext(ctx); err != nil {
+		exitCode = 1
+	}
+
+	_ = stream.send(daemonMessage{Done: true, ExitCode: exitCode})
+}
+
+// daemonStream send the messages of the daemon to the CLI
+type daemonStream struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func (s *daemonStream) send(msg daemonMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(msg)
+}
+
+// daemonWriter forward the output of a command run by the daemon to the CLI
+type daemonWriter struct {
+	stream *daemonStream
+	stderr bool
+}
+
+func (w daemonWriter) Write(p []byte) (int, error) {
+	// p can be reused by the caller once Write returns
+	data := append([]byte(nil), p...)
+
+	msg := daemonMessage{Out: data}
+	if w.stderr {
+		msg = daemonMessage{Err: data}
+	}
+	if err := w.stream.send(msg); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// daemonSocketPath return the path of the socket of the daemon of a repository
+func daemonSocketPath(repo repository.RepoCommonStorage) (string, error) {
+	root := repo.LocalStorage().Root()
+	if root == "" {
+		return "", errors.New("the daemon needs a repository on disk")
+	}
+	return filepath.Join(root, daemonSocketFile), nil
+}
+
+// runWithDaemon send the command to the daemon of the repository, if one is
+// running and the command can run there. It returns false if the command has
+// to be run by the CLI itself.
+func runWithDaemon(root *cobra.Command, args []string) (exitCode int, ok bool) {
+	cmd, _, err := root.Find(args)
+	if err != nil || cmd == root {
+		return 0, false
+	}
+	path := strings.TrimPrefix(cmd.CommandPath(), root.Name()+" ")
+	if !daemonCommands[path] {
+		return 0, false
+	}
+
+	repo, err := openRepoForDaemon()
+	if err != nil {
+		return 0, false
+	}
+	socket, err := daemonSocketPath(repo)
+	_ = repo.Close()
+	if err != nil {
+		return 0, false
+	}
+
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		// no daemon running
+		return 0, false
+	}
+	defer conn.Close()
+
+	err = json.NewEncoder(conn).Encode(daemonRequest{Args: args})
+	if err != nil {
+		return 0, false
+	}
+
+	dec := json.NewDecoder(conn)
+	for {
+		var msg daemonMessage
+		if err := dec.Decode(&msg); err != nil {
+			_, _ = os.Stderr.WriteString("lost the connection with the daemon: " + err.Error() + "\n")
+			return 1, true
+		}
+		_, _ = os.Stdout.Write(msg.Out)
+		_, _ = os.Stderr.Write(msg.Err)
+		if msg.Done {
+			return msg.ExitCode, true
+		}
+	}
+}
+
+// openRepoForDaemon open the repository of the current directory, only to
+// find the socket of its daemon
+func openRepoForDaemon() (repository.ClockedRepo, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	return repository.OpenGoGitRepo(cwd, execenv.GitBugNamespace, nil)
+}
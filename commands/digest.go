@@ -0,0 +1,219 @@
+package commands
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/araddon/dateparse"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	digestSmtpHostConfigKey     = "git-bug.smtp.host"
+	digestSmtpPortConfigKey     = "git-bug.smtp.port"
+	digestSmtpUsernameConfigKey = "git-bug.smtp.username"
+	digestSmtpPasswordConfigKey = "git-bug.smtp.password"
+	digestSmtpFromConfigKey     = "git-bug.smtp.from"
+
+	// the environment variable overriding the SMTP password of the config
+	digestSmtpPasswordEnv = "GIT_BUG_SMTP_PASSWORD"
+)
+
+type digestOptions struct {
+	since  string
+	to     []string
+	maxHot int
+}
+
+func newDigestCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := digestOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "digest",
+		Short: "Summarize the recent activity on the bugs, and send it by email",
+		Long: `Compose a readable summary of the recent activity on the bugs: the new bugs, the closed bugs and the most discussed ones. The summary is sent by email to the --to addresses, or printed if none is given.
+
+The digest can be sent regularly with a scheduler like cron, with the same --since period as the schedule.
+
+Available git config:
+  git-bug.smtp.host [string]: the SMTP server to send the emails with
+  git-bug.smtp.port [int]: the port of the SMTP server, default to 587
+  git-bug.smtp.username [string]: the username to authenticate with, if any
+  git-bug.smtp.password [string]: the password to authenticate with. It can also be given with the GIT_BUG_SMTP_PASSWORD environment variable.
+  git-bug.smtp.from [string]: the sender address of the emails
+`,
+		Example: `Print the digest of the last week:
+git bug digest --since 1w
+
+Send it to the team:
+git bug digest --since 1w --to team@example.com
+`,
+		PreRunE: execenv.LoadBackendOrReadOnly(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runDigest(env, options)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.since, "since", "s", "1w",
+		"The period to summarize, as a duration (ex: 1w, 3d, 12h) or a date")
+	flags.StringSliceVar(&options.to, "to", nil,
+		"Send the digest by email to these addresses instead of printing it")
+	flags.IntVar(&options.maxHot, "max-hot", 5,
+		"The maximum number of most discussed bugs to list")
+
+	return cmd
+}
+
+func runDigest(env *execenv.Env, opts digestOptions) error {
+	since, err := parseDigestSince(opts.since, time.Now())
+	if err != nil {
+		return err
+	}
+
+	digest := env.Backend.Digest(since, opts.maxHot)
+	body := formatDigest(env.Backend, digest)
+
+	if len(opts.to) == 0 {
+		env.Out.Print(body)
+		return nil
+	}
+
+	subject := fmt.Sprintf("git-bug digest: %d new and %d closed bugs since %s",
+		len(digest.Created), len(digest.Closed), since.Format("Jan 2 2006"))
+
+	err = sendDigest(env.Repo.AnyConfig(), opts.to, subject, body)
+	if err != nil {
+		return err
+	}
+
+	env.Out.Printf("Digest sent to %s\n", strings.Join(opts.to, ", "))
+	return nil
+}
+
+// parseDigestSince parse the start of the period of a digest, given either as
+// a duration, which can also be in days (d) or weeks (w), or as a date
+func parseDigestSince(since string, now time.Time) (time.Time, error) {
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, err := strconv.Atoi(strings.TrimSuffix(since, suffix)); err == nil && strings.HasSuffix(since, suffix) {
+			return now.Add(-time.Duration(n) * unit), nil
+		}
+	}
+
+	duration, err := time.ParseDuration(since)
+	if err == nil {
+		return now.Add(-duration), nil
+	}
+
+	return dateparse.ParseLocal(since)
+}
+
+func formatDigest(backend *cache.RepoCache, digest *cache.Digest) string {
+	var sb strings.Builder
+
+	_, _ = fmt.Fprintf(&sb, "Activity since %s\n", digest.Since.Format("Mon Jan 2 15:04:05 2006"))
+
+	if digest.IsEmpty() {
+		sb.WriteString("\nNothing happened.\n")
+		return sb.String()
+	}
+
+	author := func(excerpt *cache.BugExcerpt) string {
+		i, err := backend.ResolveIdentityExcerpt(excerpt.AuthorId)
+		if err != nil {
+			return ""
+		}
+		return i.DisplayName()
+	}
+
+	if len(digest.Created) > 0 {
+		_, _ = fmt.Fprintf(&sb, "\nNew bugs (%d):\n", len(digest.Created))
+		for _, excerpt := range digest.Created {
+			_, _ = fmt.Fprintf(&sb, "  %s  %s (by %s)\n", excerpt.Id.Human(), excerpt.Title, author(excerpt))
+		}
+	}
+
+	if len(digest.Closed) > 0 {
+		_, _ = fmt.Fprintf(&sb, "\nClosed bugs (%d):\n", len(digest.Closed))
+		for _, excerpt := range digest.Closed {
+			_, _ = fmt.Fprintf(&sb, "  %s  %s\n", excerpt.Id.Human(), excerpt.Title)
+		}
+	}
+
+	if len(digest.Hot) > 0 {
+		sb.WriteString("\nHot discussions:\n")
+		for _, hot := range digest.Hot {
+			_, _ = fmt.Fprintf(&sb, "  %s  %s (%d new comments)\n", hot.Excerpt.Id.Human(), hot.Excerpt.Title, hot.Comments)
+		}
+	}
+
+	return sb.String()
+}
+
+// sendDigest send the digest by email, using the SMTP server of the config
+func sendDigest(config repository.ConfigRead, to []string, subject string, body string) error {
+	host, err := config.ReadString(digestSmtpHostConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return fmt.Errorf("no SMTP server configured, set it with \"git config %s <host>\"", digestSmtpHostConfigKey)
+	} else if err != nil {
+		return err
+	}
+
+	port := 587
+	portStr, err := config.ReadString(digestSmtpPortConfigKey)
+	if err == nil {
+		port, err = strconv.Atoi(portStr)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", digestSmtpPortConfigKey, err)
+		}
+	} else if err != repository.ErrNoConfigEntry {
+		return err
+	}
+
+	from, err := config.ReadString(digestSmtpFromConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return fmt.Errorf("no sender address configured, set it with \"git config %s <address>\"", digestSmtpFromConfigKey)
+	} else if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	username, err := config.ReadString(digestSmtpUsernameConfigKey)
+	if err == nil {
+		password, ok := os.LookupEnv(digestSmtpPasswordEnv)
+		if !ok {
+			password, err = config.ReadString(digestSmtpPasswordConfigKey)
+			if err != nil && err != repository.ErrNoConfigEntry {
+				return err
+			}
+		}
+		auth = smtp.PlainAuth("", username, password, host)
+	} else if err != repository.ErrNoConfigEntry {
+		return err
+	}
+
+	var msg strings.Builder
+	_, _ = fmt.Fprintf(&msg, "From: %s\r\n", from)
+	_, _ = fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(to, ", "))
+	_, _ = fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	_, _ = fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	return smtp.SendMail(addr, auth, from, to, []byte(msg.String()))
+}
@@ -0,0 +1,42 @@
+package execenv
+
+import (
+	"context"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+type daemonKey struct{}
+
+// daemon is what a daemon provide to the commands it runs on behalf of the CLI
+type daemon struct {
+	repo    repository.ClockedRepo
+	backend *cache.RepoCache
+	out     io.Writer
+	err     io.Writer
+}
+
+// WithDaemon return a context to run a command in a daemon. Instead of loading
+// them, the command use the repository and the backend already loaded by the
+// daemon, and write its output to the given writers.
+func WithDaemon(ctx context.Context, repo repository.ClockedRepo, backend *cache.RepoCache, out io.Writer, err io.Writer) context.Context {
+	return context.WithValue(ctx, daemonKey{}, &daemon{
+		repo:    repo,
+		backend: backend,
+		out:     out,
+		err:     err,
+	})
+}
+
+// daemonOf return the daemon running the command, if any
+func daemonOf(cmd *cobra.Command) (*daemon, bool) {
+	if cmd.Context() == nil {
+		return nil, false
+	}
+	d, ok := cmd.Context().Value(daemonKey{}).(*daemon)
+	return d, ok
+}
@@ -11,12 +11,13 @@ import (
 	"github.com/MichaelMure/git-bug/entities/bug"
 	"github.com/MichaelMure/git-bug/entities/identity"
 	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/i18n"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
 const RootCommandName = "git-bug"
 
-const gitBugNamespace = "git-bug"
+const GitBugNamespace = "git-bug"
 
 // Env is the environment of a command
 type Env struct {
@@ -24,6 +25,9 @@ type Env struct {
 	Backend *cache.RepoCache
 	Out     Out
 	Err     Out
+
+	// the backend is owned by a daemon and must not be closed
+	sharedBackend bool
 }
 
 func NewEnv() *Env {
@@ -51,20 +55,32 @@ type Out interface {
 	Reset()
 }
 
+// out is the Out of a real command. Messages are translated for the locale
+// in use: the format of Printf, or the message if a single string is given to
+// Print and Println.
 type out struct {
 	io.Writer
 }
 
 func (o out) Printf(format string, a ...interface{}) {
-	_, _ = fmt.Fprintf(o, format, a...)
+	_, _ = fmt.Fprintf(o, i18n.T(format), a...)
 }
 
 func (o out) Print(a ...interface{}) {
-	_, _ = fmt.Fprint(o, a...)
+	_, _ = fmt.Fprint(o, translateSingle(a)...)
 }
 
 func (o out) Println(a ...interface{}) {
-	_, _ = fmt.Fprintln(o, a...)
+	_, _ = fmt.Fprintln(o, translateSingle(a)...)
+}
+
+func translateSingle(a []interface{}) []interface{} {
+	if len(a) == 1 {
+		if msg, ok := a[0].(string); ok {
+			return []interface{}{i18n.T(msg)}
+		}
+	}
+	return a
 }
 
 func (o out) String() string {
@@ -82,12 +98,19 @@ func (o out) Reset() {
 // LoadRepo is a pre-run function that load the repository for use in a command
 func LoadRepo(env *Env) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
+		if d, ok := daemonOf(cmd); ok {
+			env.Repo = d.repo
+			env.Out = out{Writer: d.out}
+			env.Err = out{Writer: d.err}
+			return nil
+		}
+
 		cwd, err := os.Getwd()
 		if err != nil {
 			return fmt.Errorf("unable to get the current working directory: %q", err)
 		}
 
-		env.Repo, err = repository.OpenGoGitRepo(cwd, gitBugNamespace, []repository.ClockLoader{bug.ClockLoader})
+		env.Repo, err = repository.OpenGoGitRepo(cwd, GitBugNamespace, []repository.ClockLoader{bug.ClockLoader})
 		if err == repository.ErrNotARepo {
 			return fmt.Errorf("%s must be run from within a git Repo", RootCommandName)
 		}
@@ -128,6 +151,12 @@ func LoadBackend(env *Env) func(*cobra.Command, []string) error {
 			return err
 		}
 
+		if d, ok := daemonOf(cmd); ok {
+			env.Backend = d.backend
+			env.sharedBackend = true
+			return nil
+		}
+
 		env.Backend, err = cache.NewRepoCache(env.Repo)
 		if err != nil {
 			return err
@@ -169,6 +198,21 @@ func LoadBackendEnsureUser(env *Env) func(*cobra.Command, []string) error {
 	}
 }
 
+// LoadBackendOrReadOnly is the same as LoadBackend, but if the repository is locked by another
+// process, like a running web UI, the cache is opened read-only instead. Use this pre-run
+// function for commands that only read.
+func LoadBackendOrReadOnly(env *Env) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := LoadBackend(env)(cmd, args)
+		if !cache.IsErrLocked(err) {
+			return err
+		}
+
+		env.Backend, err = cache.NewRepoCacheReadOnly(env.Repo)
+		return err
+	}
+}
+
 // CloseBackend is a wrapper for a RunE function that will close the Backend properly
 // if it has been opened.
 // This wrapper style is necessary because a Cobra PostE function does not run if RunE return an error.
@@ -179,6 +223,10 @@ func CloseBackend(env *Env, runE func(cmd *cobra.Command, args []string) error)
 		if env.Backend == nil {
 			return nil
 		}
+		if env.sharedBackend {
+			env.Backend = nil
+			return errRun
+		}
 		err := env.Backend.Close()
 		env.Backend = nil
 
@@ -26,7 +26,7 @@ func (te *TestOut) Println(a ...interface{}) {
 	_, _ = fmt.Fprintln(te.Buffer, a...)
 }
 
-func NewTestEnv(t *testing.T) *Env {
+func NewTestEnv(t testing.TB) *Env {
 	t.Helper()
 
 	repo := repository.CreateGoGitTestRepo(t, false)
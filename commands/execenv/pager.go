@@ -0,0 +1,68 @@
+package execenv
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+)
+
+// the pager used when $PAGER is not set
+const defaultPager = "less"
+
+// StartPager redirect the output of the command to a pager, when it is written
+// to a terminal. The pager is taken from $GIT_BUG_PAGER, then $PAGER, and
+// defaults to less. Setting it to "cat" or to an empty value disable the pager.
+//
+// As git does, less is configured to exit right away if the output fits in one
+// screen, and to keep the colors, unless $LESS is already set.
+//
+// The returned function must be called when the command is done, to wait for
+// the pager to exit and restore the output.
+func StartPager(env *Env) func() {
+	noop := func() {}
+
+	o, ok := env.Out.(out)
+	if !ok || o.Writer != os.Stdout || !isatty.IsTerminal(os.Stdout.Fd()) {
+		return noop
+	}
+
+	pager, ok := os.LookupEnv("GIT_BUG_PAGER")
+	if !ok {
+		pager, ok = os.LookupEnv("PAGER")
+	}
+	if !ok {
+		pager = defaultPager
+	}
+
+	args := strings.Fields(pager)
+	if len(args) == 0 || args[0] == "cat" {
+		return noop
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	if _, ok := os.LookupEnv("LESS"); !ok {
+		cmd.Env = append(cmd.Env, "LESS=FRX")
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return noop
+	}
+	if err := cmd.Start(); err != nil {
+		// no usable pager, write directly to the terminal
+		return noop
+	}
+
+	env.Out = out{Writer: stdin}
+
+	return func() {
+		_ = stdin.Close()
+		_ = cmd.Wait()
+		env.Out = o
+	}
+}
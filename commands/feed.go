@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+type feedOptions struct {
+	consumer string
+	after    uint64
+	limit    int
+}
+
+func newFeedCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := feedOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "feed",
+		Short: "Read the ordered feed of the changes of the repository",
+		Long: `Read the change feed of the repository, as JSON lines: the new operations of the bugs and the removed bugs, in order, each with a sequence number that never changes.
+
+The feed let an external system, like a data warehouse or a chat bot, consume the changes of the repository exactly once and resume after a downtime. A consumer reads the entries after its cursor with --consumer, processes them, then stores the sequence number of the last processed entry as its new cursor with "git bug feed ack".
+
+The feed is kept in the git-bug directory of the repository and is never pushed. It's also available through the web API.`,
+		Example: `Read the next 100 changes for the "warehouse" consumer, then acknowledge them:
+git bug feed --consumer warehouse --limit 100
+git bug feed ack warehouse 1342
+`,
+		Args:    cobra.NoArgs,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runFeed(env, options, cmd.Flags().Changed("after"))
+		}),
+	}
+
+	cmd.AddCommand(newFeedAckCommand())
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.consumer, "consumer", "c", "",
+		"Read the entries after the cursor of the given consumer")
+	flags.Uint64Var(&options.after, "after", 0,
+		"Read the entries after the given sequence number, instead of after the cursor of the consumer")
+	flags.IntVarP(&options.limit, "limit", "n", 0,
+		"Read at most the given number of entries")
+
+	return cmd
+}
+
+func runFeed(env *execenv.Env, opts feedOptions, afterSet bool) error {
+	after := opts.after
+	if opts.consumer != "" && !afterSet {
+		var err error
+		after, err = env.Backend.ChangeFeedCursor(opts.consumer)
+		if err != nil {
+			return err
+		}
+	}
+
+	entries, err := env.Backend.ChangeFeed(after, opts.limit)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		env.Out.Printf("%s\n", data)
+	}
+
+	return nil
+}
+
+func newFeedAckCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "ack CONSUMER SEQ",
+		Short:   "Store the cursor of a consumer of the change feed",
+		Long:    `Store the sequence number of the last entry of the change feed processed by a consumer. The next "git bug feed --consumer" start after it.`,
+		Args:    cobra.ExactArgs(2),
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runFeedAck(env, args)
+		}),
+	}
+
+	return cmd
+}
+
+func runFeedAck(env *execenv.Env, args []string) error {
+	seq, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid sequence number %q", args[1])
+	}
+
+	return env.Backend.SetChangeFeedCursor(args[0], seq)
+}
@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+func newFreezeCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "Show the freeze mode of the repository",
+		Long: `Show the freeze mode of the repository.
+
+While the repository is frozen, typically during the stabilization of a release, closing a bug carrying one of the protected labels ("release-blocker" by default) requires an explicit confirmation with "git bug status close --confirm-freeze". If approvers are configured, only they can confirm.
+
+The bridges are not affected: a bug closed remotely is still closed when imported.
+
+The freeze mode is kept in the git config, under "git-bug.freeze.active", "git-bug.freeze.labels" and "git-bug.freeze.approvers".`,
+		PreRunE: execenv.LoadBackendOrReadOnly(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runFreeze(env)
+		}),
+	}
+
+	cmd.AddCommand(newFreezeOnCommand())
+	cmd.AddCommand(newFreezeOffCommand())
+
+	return cmd
+}
+
+func runFreeze(env *execenv.Env) error {
+	freeze, err := env.Backend.Freeze()
+	if err != nil {
+		return err
+	}
+
+	if freeze.Active {
+		env.Out.Println("active")
+	} else {
+		env.Out.Println("inactive")
+	}
+
+	labels := make([]string, len(freeze.Labels))
+	for i, label := range freeze.Labels {
+		labels[i] = label.String()
+	}
+	env.Out.Printf("labels: %s\n", strings.Join(labels, ", "))
+
+	if len(freeze.Approvers) > 0 {
+		env.Out.Printf("approvers: %s\n", strings.Join(freeze.Approvers, ", "))
+	} else {
+		env.Out.Println("approvers: anyone")
+	}
+
+	return nil
+}
+
+type freezeOnOptions struct {
+	labels    []string
+	approvers []string
+}
+
+func newFreezeOnCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := freezeOnOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "on",
+		Short: "Freeze the repository",
+		Long: `Freeze the repository.
+
+Without --label or --approver, the protected labels and the approvers previously configured are kept.`,
+		Example: `Freeze the repository, the closing of the release blockers being confirmed by the release manager:
+git bug freeze on --label release-blocker --approver alice
+`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runFreezeOn(env, options)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringSliceVarP(&options.labels, "label", "l", nil,
+		"The label of the protected bugs, can be repeated")
+	flags.StringSliceVarP(&options.approvers, "approver", "a", nil,
+		"The login, email or id prefix of an identity allowed to confirm, can be repeated")
+
+	return cmd
+}
+
+func runFreezeOn(env *execenv.Env, opts freezeOnOptions) error {
+	freeze, err := env.Backend.Freeze()
+	if err != nil {
+		return err
+	}
+
+	freeze.Active = true
+	if len(opts.labels) > 0 {
+		freeze.Labels = make([]bug.Label, len(opts.labels))
+		for i, label := range opts.labels {
+			freeze.Labels[i] = bug.Label(label)
+		}
+	}
+	if len(opts.approvers) > 0 {
+		freeze.Approvers = opts.approvers
+	}
+
+	err = env.Backend.SetFreeze(freeze)
+	if err != nil {
+		return err
+	}
+
+	return runFreeze(env)
+}
+
+func newFreezeOffCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "off",
+		Short:   "Unfreeze the repository",
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runFreezeOff(env)
+		}),
+	}
+
+	return cmd
+}
+
+func runFreezeOff(env *execenv.Env) error {
+	freeze, err := env.Backend.Freeze()
+	if err != nil {
+		return err
+	}
+
+	freeze.Active = false
+
+	return env.Backend.SetFreeze(freeze)
+}
@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/github"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func newImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import bugs from files, without a configured bridge",
+	}
+
+	cmd.AddCommand(newImportGithubArchiveCommand())
+
+	return cmd
+}
+
+type importGithubArchiveOptions struct {
+	repository string
+}
+
+func newImportGithubArchiveCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := importGithubArchiveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "github-archive PATH...",
+		Short: "Import the issues of Github archives, offline",
+		Long: `Import the issues of Github archives, without using the Github API.
+
+Two kinds of archives are supported, and can be mixed:
+- the data export of a repository or an organization, as a directory or a .tar.gz file
+- the event files of GH Archive (https://www.gharchive.org), compressed or not
+
+The issues, comments, labels, closing, reopening and renaming are imported as "git bug bridge pull" would, so that a github bridge can take over later. The edition history of the issues and comments is not part of the archives: only their last known version is imported.
+
+As the GH Archive files hold the events of every public repository, the --repository flag should be used to select one.`,
+		Example: `git bug import github-archive --repository octo-org/octo-repo migration_archive.tar.gz
+git bug import github-archive --repository octo-org/octo-repo 2015-01-*.json.gz`,
+		Args:    cobra.MinimumNArgs(1),
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runImportGithubArchive(env, options, args)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.repository, "repository", "r", "",
+		"Import only the issues of the given repository, like \"owner/project\"")
+
+	return cmd
+}
+
+func runImportGithubArchive(env *execenv.Env, opts importGithubArchiveOptions, args []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	interrupt.RegisterCleaner(func() error {
+		env.Err.Println("Received interrupt signal, stopping the import...")
+		cancel()
+		return nil
+	})
+
+	events, err := github.ImportArchive(ctx, env.Backend, args, opts.repository)
+	if err != nil {
+		return err
+	}
+
+	importedIssues := 0
+	importedIdentities := 0
+	for result := range events {
+		switch result.Event {
+		case core.ImportEventNothing:
+			// filtered
+
+		case core.ImportEventBug:
+			importedIssues++
+			env.Out.Println(result.String())
+
+		case core.ImportEventIdentity:
+			importedIdentities++
+			env.Out.Println(result.String())
+
+		case core.ImportEventError:
+			if result.Err != context.Canceled {
+				env.Out.Println(result.String())
+			}
+
+		default:
+			env.Out.Println(result.String())
+		}
+	}
+
+	env.Out.Printf("imported %d issues and %d identities\n", importedIssues, importedIdentities)
+
+	return nil
+}
@@ -205,6 +205,8 @@ const queryTemplate = `%s
 # - sort:id, sort:id-desc, sort:id-asc
 # - sort:creation, sort:creation-desc, sort:creation-asc
 # - sort:edit, sort:edit-desc, sort:edit-asc
+# - sort:comments, sort:comments-desc, sort:comments-asc
+# - sort:last-comment, sort:last-comment-desc, sort:last-comment-asc
 #
 # Notes
 # 
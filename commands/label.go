@@ -15,7 +15,7 @@ func newLabelCommand() *cobra.Command {
 		Long: `List valid labels.
 
 Note: in the future, a proper label policy could be implemented where valid labels are defined in a configuration file. Until that, the default behavior is to return the list of labels already used.`,
-		PreRunE: execenv.LoadBackend(env),
+		PreRunE: execenv.LoadBackendOrReadOnly(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
 			return runLabel(env)
 		}),
@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/commands/cmdjson"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// pluginCommandPrefix start the name of the commands running a plugin, as in
+// "git bug x-report"
+const pluginCommandPrefix = "x-"
+
+// pluginBinaryPrefix start the name of the executables of the plugins, found
+// in the PATH, as in "git-bug-report"
+const pluginBinaryPrefix = "git-bug-"
+
+// pluginContextEnv is the environment variable holding the context given to
+// a plugin, as JSON
+const pluginContextEnv = "GIT_BUG_PLUGIN_CONTEXT"
+
+// pluginContextVersion is the version of the format of the context given to
+// the plugins. It changes only when a field is removed or changes meaning.
+const pluginContextVersion = 1
+
+// runPlugin run the plugin named by the first argument, if it starts with
+// "x-". It returns false if the command isn't for a plugin.
+func runPlugin(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (exitCode int, ok bool) {
+	if len(args) == 0 || !strings.HasPrefix(args[0], pluginCommandPrefix) {
+		return 0, false
+	}
+
+	name := strings.TrimPrefix(args[0], pluginCommandPrefix)
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		_, _ = fmt.Fprintf(stderr, "Error: invalid plugin name %q\n", name)
+		return 1, true
+	}
+
+	path, err := exec.LookPath(pluginBinaryPrefix + name)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: unknown plugin %q, no %s%s found in the PATH\n", name, pluginBinaryPrefix, name)
+		return 1, true
+	}
+
+	pluginCtx, err := json.Marshal(newPluginContext())
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1, true
+	}
+
+	cmd := exec.Command(path, args[1:]...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.Env = append(os.Environ(), pluginContextEnv+"="+string(pluginCtx))
+
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &exitErr):
+		return exitErr.ExitCode(), true
+	case err != nil:
+		_, _ = fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1, true
+	}
+	return 0, true
+}
+
+// newPluginContext return the context of a plugin run from the current
+// directory. Outside of a repository, only the executable is known.
+func newPluginContext() cmdjson.PluginContext {
+	result := cmdjson.PluginContext{Version: pluginContextVersion}
+
+	if exe, err := os.Executable(); err == nil {
+		result.Executable = exe
+	}
+
+	repo, err := openRepoForDaemon()
+	if err != nil {
+		return result
+	}
+	defer repo.Close()
+
+	storage := repo.LocalStorage().Root()
+	if storage != "" {
+		result.RepoPath = filepath.Dir(storage)
+		result.StoragePath = storage
+	}
+
+	if socket, ok := runningDaemonSocket(repo); ok {
+		result.DaemonSocket = socket
+	}
+
+	// the identity is read from git, as the cache might be locked by a daemon
+	if i, err := identity.GetUserIdentity(repo); err == nil {
+		user := cmdjson.NewIdentity(i)
+		result.Identity = &user
+	}
+
+	return result
+}
+
+// runningDaemonSocket return the socket of the daemon of the repository, if
+// one is running
+func runningDaemonSocket(repo repository.RepoCommonStorage) (string, bool) {
+	socket, err := daemonSocketPath(repo)
+	if err != nil {
+		return "", false
+	}
+	// a socket file can be left by a daemon that crashed
+	conn, err := net.DialTimeout("unix", socket, time.Second)
+	if err != nil {
+		return "", false
+	}
+	_ = conn.Close()
+	return socket, true
+}
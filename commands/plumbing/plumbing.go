@@ -0,0 +1,105 @@
+package plumbingcmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity/dag"
+)
+
+func NewPlumbingCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plumbing",
+		Short: "Low-level commands with a stable output, for scripts",
+		Long: `Low-level commands with a stable output, for scripts.
+
+Like git, git-bug is split between porcelain and plumbing commands. The porcelain commands (bug, bug new, bug show ...) are meant for humans: their output is translated, colored, and can change between versions. The plumbing commands work directly on the operations stored in git, and their input and output format is guaranteed to stay compatible.
+
+Operations are exchanged as JSON objects, one per line. The common fields are:
+- type: the kind of operation, one of create, set-title, add-comment, edit-comment, set-status, label-change, set-metadata, request-info, sync-conflict, set-assignee, set-field, redact-comment, reaction, relation-add, relation-remove, add-attachment or noop
+- timestamp: the unix time of the operation
+- metadata: an object of string values, if any
+- id: the id of the operation (output only)
+- author: the id of the identity that authored the operation (output only)
+
+The other fields depend on the type:
+- create: title, message, files
+- set-title: title, was
+- add-comment: message, files
+- edit-comment: target (the id of the operation that created the comment), message, files
+- set-status: status ("open" or "closed")
+- label-change: added, removed (the labels)
+- set-assignee: added, removed (the ids of the identities)
+- set-field: name, value (empty to unset the field)
+- redact-comment: target (the id of the operation that created the comment), delete (true to remove the comment instead of only hiding its content)
+- reaction: target (the id of the operation that created the comment), reaction (one of thumbs_up, thumbs_down, laugh, hooray, confused, heart, rocket or eyes), remove (true when the reaction is removed)
+- relation-add, relation-remove: relation (one of blocks, duplicate-of or related-to), target (the id of the other bug)
+- add-attachment: name, hash (of the git blob holding the file), size (in bytes), content_type
+`,
+	}
+
+	cmd.AddCommand(newPlumbingReadOpsCommand())
+	cmd.AddCommand(newPlumbingWriteOpCommand())
+
+	return cmd
+}
+
+// opTypeNames are the names of the operation types in the plumbing format.
+// They are part of the stable format and must never change.
+var opTypeNames = map[dag.OperationType]string{
+	bug.CreateOp:         "create",
+	bug.SetTitleOp:       "set-title",
+	bug.AddCommentOp:     "add-comment",
+	bug.SetStatusOp:      "set-status",
+	bug.LabelChangeOp:    "label-change",
+	bug.EditCommentOp:    "edit-comment",
+	bug.NoOpOp:           "noop",
+	bug.SetMetadataOp:    "set-metadata",
+	bug.RequestInfoOp:    "request-info",
+	bug.SyncConflictOp:   "sync-conflict",
+	bug.SetAssigneeOp:    "set-assignee",
+	bug.SetFieldOp:       "set-field",
+	bug.RedactCommentOp:  "redact-comment",
+	bug.ReactionOp:       "reaction",
+	bug.RelationAddOp:    "relation-add",
+	bug.RelationRemoveOp: "relation-remove",
+	bug.AddAttachmentOp:  "add-attachment",
+}
+
+// marshalOperation encode an operation in the plumbing format, as a single
+// line of JSON with sorted keys.
+func marshalOperation(op dag.Operation) ([]byte, error) {
+	raw, err := json.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, err
+	}
+
+	name, ok := opTypeNames[op.Type()]
+	if !ok {
+		return nil, fmt.Errorf("unknown operation type %v", op.Type())
+	}
+
+	// the nonce is only a storage detail
+	delete(fields, "nonce")
+
+	fields["type"] = name
+	fields["id"] = op.Id()
+	fields["author"] = op.Author().Id()
+
+	if op, ok := op.(*bug.SetStatusOperation); ok {
+		fields["status"] = op.Status.String()
+	}
+
+	return json.Marshal(fields)
+}
@@ -0,0 +1,49 @@
+package plumbingcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newPlumbingReadOpsCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "read-ops BUG_ID",
+		Short: "Output the operations of a bug",
+		Long: `Output the operations of a bug, in order, one JSON object per line.
+
+See "git bug plumbing --help" for the format.`,
+		Example: `git bug plumbing read-ops 2f9b7ae | jq -r 'select(.type == "add-comment") | .message'`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runPlumbingReadOps(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	return cmd
+}
+
+func runPlumbingReadOps(env *execenv.Env, args []string) error {
+	b, err := env.Backend.ResolveBugPrefix(args[0])
+	if err != nil {
+		return err
+	}
+
+	for _, op := range b.Snapshot().Operations {
+		line, err := marshalOperation(op)
+		if err != nil {
+			return err
+		}
+		// bypass the translation of env.Out, the output is stable
+		_, _ = fmt.Fprintf(env.Out, "%s\n", line)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package plumbingcmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func TestPlumbingReadWriteOps(t *testing.T) {
+	env, bugId := testenv.NewTestEnvAndBug(t)
+	out := env.Out.(*execenv.TestOut)
+
+	input := filepath.Join(t.TempDir(), "ops.jsonl")
+	err := os.WriteFile(input, []byte(strings.Join([]string{
+		`{"type":"add-comment","timestamp":1600000000,"message":"a comment"}`,
+		``,
+		`{"type":"label-change","added":["bug","ui"],"metadata":{"origin":"script"}}`,
+		`{"type":"set-status","status":"closed"}`,
+	}, "\n")), 0644)
+	require.NoError(t, err)
+
+	err = runPlumbingWriteOp(env, plumbingWriteOpOptions{file: input}, []string{bugId.Human()})
+	require.NoError(t, err)
+
+	written := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, written, 3)
+	out.Reset()
+
+	require.NoError(t, runPlumbingReadOps(env, []string{bugId.Human()}))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 4)
+
+	var ops []map[string]interface{}
+	for _, line := range lines {
+		var op map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &op))
+		ops = append(ops, op)
+	}
+
+	require.Equal(t, "create", ops[0]["type"])
+	require.Equal(t, "this is a bug title", ops[0]["title"])
+	require.NotContains(t, ops[0], "nonce")
+
+	require.Equal(t, "add-comment", ops[1]["type"])
+	require.Equal(t, "a comment", ops[1]["message"])
+	require.Equal(t, float64(1600000000), ops[1]["timestamp"])
+	require.Equal(t, written[0], ops[1]["id"])
+
+	require.Equal(t, "label-change", ops[2]["type"])
+	require.Equal(t, []interface{}{"bug", "ui"}, ops[2]["added"])
+	require.Equal(t, map[string]interface{}{"origin": "script"}, ops[2]["metadata"])
+
+	require.Equal(t, "set-status", ops[3]["type"])
+	require.Equal(t, "closed", ops[3]["status"])
+
+	for _, op := range ops {
+		require.Equal(t, ops[0]["author"], op["author"])
+	}
+}
+
+func TestPlumbingWriteOpInvalid(t *testing.T) {
+	env, bugId := testenv.NewTestEnvAndBug(t)
+
+	input := filepath.Join(t.TempDir(), "ops.jsonl")
+	err := os.WriteFile(input, []byte(strings.Join([]string{
+		`{"type":"add-comment","message":"a comment"}`,
+		`{"type":"create","title":"nope"}`,
+	}, "\n")), 0644)
+	require.NoError(t, err)
+
+	err = runPlumbingWriteOp(env, plumbingWriteOpOptions{file: input}, []string{bugId.Human()})
+	require.EqualError(t, err, `line 2: unsupported operation type "create"`)
+}
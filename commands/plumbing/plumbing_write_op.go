@@ -0,0 +1,217 @@
+package plumbingcmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+)
+
+type plumbingWriteOpOptions struct {
+	file string
+}
+
+func newPlumbingWriteOpCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := plumbingWriteOpOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "write-op BUG_ID",
+		Short: "Append operations to a bug",
+		Long: `Append operations to a bug, read as one JSON object per line. The operations are authored by the current user, and committed together only if they are all valid. The id of each operation is written, one per line.
+
+The supported types are set-title, add-comment, edit-comment, set-status, label-change, set-assignee, set-field, redact-comment, reaction, relation-add, relation-remove and request-info. If the timestamp is omitted, the current time is used. See "git bug plumbing --help" for the format.`,
+		Example: `echo '{"type":"add-comment","message":"fixed in v1.2"}' | git bug plumbing write-op 2f9b7ae`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runPlumbingWriteOp(env, options, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.file, "file", "F", "-",
+		"Read the operations from the file specified, or stdin with \"-\"")
+
+	return cmd
+}
+
+// writtenOp is an operation in the plumbing format, as accepted by write-op
+type writtenOp struct {
+	Type      string            `json:"type"`
+	Timestamp int64             `json:"timestamp"`
+	Metadata  map[string]string `json:"metadata"`
+	Title     string            `json:"title"`
+	Name      string            `json:"name"`
+	Value     string            `json:"value"`
+	Message   string            `json:"message"`
+	Target    entity.Id         `json:"target"`
+	Status    string            `json:"status"`
+	Added     []string          `json:"added"`
+	Removed   []string          `json:"removed"`
+	Delete    bool              `json:"delete"`
+	Reaction  string            `json:"reaction"`
+	Remove    bool              `json:"remove"`
+	Relation  string            `json:"relation"`
+}
+
+func runPlumbingWriteOp(env *execenv.Env, opts plumbingWriteOpOptions, args []string) error {
+	b, err := env.Backend.ResolveBugPrefix(args[0])
+	if err != nil {
+		return err
+	}
+
+	author, err := env.Backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	if opts.file != "-" {
+		f, err := os.Open(opts.file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var ids []entity.Id
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var written writtenOp
+		if err := json.Unmarshal(scanner.Bytes(), &written); err != nil {
+			return fmt.Errorf("line %d: %w", lineNum, err)
+		}
+
+		op, err := applyWrittenOp(b, author, written)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", lineNum, err)
+		}
+		ids = append(ids, op.Id())
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		return fmt.Errorf("no operation to write")
+	}
+
+	if err := b.Commit(); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		_, _ = fmt.Fprintf(env.Out, "%s\n", id)
+	}
+
+	return nil
+}
+
+func applyWrittenOp(b *cache.BugCache, author *cache.IdentityCache, written writtenOp) (dag.Operation, error) {
+	unixTime := written.Timestamp
+	if unixTime == 0 {
+		unixTime = time.Now().Unix()
+	}
+
+	switch written.Type {
+	case "set-title":
+		return b.SetTitleRaw(author, unixTime, written.Title, written.Metadata)
+
+	case "add-comment":
+		_, op, err := b.AddCommentRaw(author, unixTime, written.Message, nil, written.Metadata)
+		return op, err
+
+	case "edit-comment":
+		if err := written.Target.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid target: %w", err)
+		}
+		target := entity.CombineIds(b.Id(), written.Target)
+		return b.EditCommentRaw(author, unixTime, target, written.Message, written.Metadata)
+
+	case "set-status":
+		status, err := common.StatusFromString(written.Status)
+		if err != nil {
+			return nil, err
+		}
+		if status == common.ClosedStatus {
+			return b.CloseRaw(author, unixTime, written.Metadata)
+		}
+		return b.OpenRaw(author, unixTime, written.Metadata)
+
+	case "label-change":
+		return b.ForceChangeLabelsRaw(author, unixTime, written.Added, written.Removed, written.Metadata)
+
+	case "set-assignee":
+		added := make([]entity.Id, len(written.Added))
+		for i, id := range written.Added {
+			added[i] = entity.Id(id)
+		}
+		removed := make([]entity.Id, len(written.Removed))
+		for i, id := range written.Removed {
+			removed[i] = entity.Id(id)
+		}
+		return b.SetAssigneeRaw(author, unixTime, added, removed, written.Metadata)
+
+	case "set-field":
+		return b.SetFieldRaw(author, unixTime, written.Name, written.Value, written.Metadata)
+
+	case "redact-comment":
+		if err := written.Target.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid target: %w", err)
+		}
+		target := entity.CombineIds(b.Id(), written.Target)
+		if written.Delete {
+			return b.DeleteCommentRaw(author, unixTime, target, written.Metadata)
+		}
+		return b.RedactCommentRaw(author, unixTime, target, written.Metadata)
+
+	case "reaction":
+		if err := written.Target.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid target: %w", err)
+		}
+		target := entity.CombineIds(b.Id(), written.Target)
+		reaction := bug.Reaction(written.Reaction)
+		if written.Remove {
+			return b.RemoveReactionRaw(author, unixTime, target, reaction, written.Metadata)
+		}
+		return b.AddReactionRaw(author, unixTime, target, reaction, written.Metadata)
+
+	case "relation-add":
+		return b.AddRelationRaw(author, unixTime, bug.RelationType(written.Relation), written.Target, written.Metadata)
+
+	case "relation-remove":
+		return b.RemoveRelationRaw(author, unixTime, bug.RelationType(written.Relation), written.Target, written.Metadata)
+
+	case "request-info":
+		return b.RequestInfoRaw(author, unixTime, written.Metadata)
+
+	case "":
+		return nil, fmt.Errorf("missing operation type")
+
+	default:
+		return nil, fmt.Errorf("unsupported operation type \"%s\"", written.Type)
+	}
+}
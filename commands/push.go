@@ -2,6 +2,7 @@ package commands
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -9,23 +10,37 @@ import (
 	"github.com/MichaelMure/git-bug/commands/execenv"
 )
 
+type pushOptions struct {
+	allowConfidential bool
+}
+
 func newPushCommand() *cobra.Command {
 	env := execenv.NewEnv()
+	options := pushOptions{}
 
 	cmd := &cobra.Command{
-		Use:     "push [REMOTE]",
-		Short:   "Push updates to a git remote",
+		Use:   "push [REMOTE]",
+		Short: "Push updates to a git remote",
+		Long: `Push updates to a git remote.
+
+If the remote is configured as public with the "git-bug.remote.<REMOTE>.public" git config, the push is refused when any bug holds operations flagged as confidential.`,
 		PreRunE: execenv.LoadBackend(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
-			return runPush(env, args)
+			return runPush(env, options, args)
 		}),
 		ValidArgsFunction: completion.GitRemote(env),
 	}
 
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVar(&options.allowConfidential, "allow-confidential", false,
+		"Push to a public remote even if some bugs hold confidential data")
+
 	return cmd
 }
 
-func runPush(env *execenv.Env, args []string) error {
+func runPush(env *execenv.Env, opts pushOptions, args []string) error {
 	if len(args) > 1 {
 		return errors.New("Only pushing to one remote at a time is supported")
 	}
@@ -35,6 +50,20 @@ func runPush(env *execenv.Env, args []string) error {
 		remote = args[0]
 	}
 
+	if !opts.allowConfidential {
+		public, err := env.Backend.IsPublicRemote(remote)
+		if err != nil {
+			return err
+		}
+
+		if confidential := env.Backend.ConfidentialBugs(); public && len(confidential) > 0 {
+			for _, id := range confidential {
+				env.Err.Printf("%s holds confidential data\n", id.Human())
+			}
+			return fmt.Errorf("refusing to push confidential data to the public remote %s, use --allow-confidential to override", remote)
+		}
+	}
+
 	stdout, err := env.Backend.Push(remote)
 	if err != nil {
 		return err
@@ -12,6 +12,7 @@ import (
 
 	"github.com/MichaelMure/git-bug/commands/bug"
 	"github.com/MichaelMure/git-bug/commands/execenv"
+	plumbingcmd "github.com/MichaelMure/git-bug/commands/plumbing"
 )
 
 // These variables are initialized externally during the build. See the Makefile.
@@ -29,6 +30,15 @@ git-bug use git objects to store the bug tracking separated from the files
 history. As bugs are regular git objects, they can be pushed and pulled from/to
 the same git remote you are already using to collaborate with other people.
 
+Installed in the PATH, git-bug can also be invoked as "git bug". Like git, the
+commands are split between porcelain commands meant for humans, and plumbing
+commands with a stable input and output meant for scripts.
+
+git-bug can be extended with plugins: "git bug x-<name>" runs the
+"git-bug-<name>" executable found in the PATH, with the remaining arguments and
+the context of the repository as JSON in the GIT_BUG_PLUGIN_CONTEXT environment
+variable. See doc/plugins.md.
+
 `,
 
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
@@ -59,10 +69,12 @@ the same git remote you are already using to collaborate with other people.
 	const entityGroup = "entity"
 	const uiGroup = "ui"
 	const remoteGroup = "remote"
+	const plumbingGroup = "plumbing"
 
 	cmd.AddGroup(&cobra.Group{ID: entityGroup, Title: "Entities"})
 	cmd.AddGroup(&cobra.Group{ID: uiGroup, Title: "User interfaces"})
 	cmd.AddGroup(&cobra.Group{ID: remoteGroup, Title: "Interaction with the outside world"})
+	cmd.AddGroup(&cobra.Group{ID: plumbingGroup, Title: "Plumbing, with a stable output for scripts"})
 
 	addCmdWithGroup := func(child *cobra.Command, groupID string) {
 		cmd.AddCommand(child)
@@ -76,18 +88,46 @@ the same git remote you are already using to collaborate with other people.
 	addCmdWithGroup(newTermUICommand(), uiGroup)
 	addCmdWithGroup(newWebUICommand(), uiGroup)
 
+	addCmdWithGroup(newCloneTrackerCommand(), remoteGroup)
 	addCmdWithGroup(newPullCommand(), remoteGroup)
 	addCmdWithGroup(newPushCommand(), remoteGroup)
 	addCmdWithGroup(bridgecmd.NewBridgeCommand(), remoteGroup)
-
+	addCmdWithGroup(newDigestCommand(), remoteGroup)
+	addCmdWithGroup(newImportCommand(), remoteGroup)
+	addCmdWithGroup(newFeedCommand(), remoteGroup)
+
+	addCmdWithGroup(plumbingcmd.NewPlumbingCommand(), plumbingGroup)
+
+	cmd.AddCommand(newAuditLogCommand())
+	cmd.AddCommand(newCacheCommand())
+	cmd.AddCommand(newDaemonCommand())
+	cmd.AddCommand(newFreezeCommand())
+	cmd.AddCommand(newSquashIdentitiesCommand())
+	cmd.AddCommand(newSimulateCommand())
 	cmd.AddCommand(newCommandsCommand())
+	cmd.AddCommand(newVerifyCommand())
 	cmd.AddCommand(newVersionCommand())
+	cmd.AddCommand(newWorkspaceCommand())
+
+	addCompletionDataCommand(cmd)
 
 	return cmd
 }
 
 func Execute() {
-	if err := NewRootCommand().Execute(); err != nil {
+	// the plugins are external executables, run before anything is loaded
+	if exitCode, ok := runPlugin(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); ok {
+		os.Exit(exitCode)
+	}
+
+	root := NewRootCommand()
+
+	// let a running daemon do the work, as it has the cache already loaded
+	if exitCode, ok := runWithDaemon(root, os.Args[1:]); ok {
+		os.Exit(exitCode)
+	}
+
+	if err := root.Execute(); err != nil {
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/policy"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+type simulateOptions struct {
+	policies []string
+}
+
+func newSimulateCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := simulateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "simulate [BUG_ID]",
+		Short: "Replay the operations of a bug through the policies",
+		Long: `Replay the operations of a bug through the policies defined in the git config, and report which rules would fire. Nothing is committed, which allow to test access control, auto-labeling or validation rules before enabling them.
+
+A policy is defined by a set of keys under "git-bug.policy.<name>":
+- rule: the kind of rule, "acl", "auto-label", "auto-assign" or "validate"
+- operations: for an acl, the operation kinds restricted (create, title, comment, edit-comment, status, label, request-info, sync-conflict, assign), comma separated
+- allow: for an acl, the logins, emails or id prefixes allowed, comma separated
+- pattern: the regular expression that triggers an auto-label, or that is forbidden by a validation
+- label: for an auto-label, the label to add, for an auto-assign, the label that triggers the assignment
+- assignee: for an auto-assign, the login or id prefix of the identity to assign
+- max-length: for a validation, the maximum length of a title`,
+		Example: `Only allow two maintainers to close and reopen bugs:
+git config git-bug.policy.maintainers.rule acl
+git config git-bug.policy.maintainers.operations status
+git config git-bug.policy.maintainers.allow "rene@descartes.fr, isaac@newton.uk"
+
+Label the crash reports:
+git config git-bug.policy.crash.rule auto-label
+git config git-bug.policy.crash.pattern "(?i)panic|segfault"
+git config git-bug.policy.crash.label crash
+
+Assign the bugs labeled "webui" to alice when the label is committed or merged:
+git config git-bug.policy.webui-owner.rule auto-assign
+git config git-bug.policy.webui-owner.label webui
+git config git-bug.policy.webui-owner.assignee alice
+
+Check a bug against those policies:
+git bug simulate 2f9b7ae
+`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runSimulate(env, options, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringSliceVarP(&options.policies, "policy", "p", nil,
+		"Only replay through the given policies")
+
+	return cmd
+}
+
+func runSimulate(env *execenv.Env, opts simulateOptions, args []string) error {
+	b, _, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	policies, err := policy.Load(env.Backend.AnyConfig())
+	if err != nil {
+		return err
+	}
+
+	if len(opts.policies) > 0 {
+		policies, err = filterPolicies(policies, opts.policies)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(policies) == 0 {
+		return fmt.Errorf("no policy defined, see \"git bug simulate --help\"")
+	}
+
+	report := policy.Simulate(b, policies)
+
+	for _, firing := range report.Firings {
+		op := firing.Operation
+		env.Out.Printf("%s %s %-13s %s %s: %s\n",
+			colors.Cyan(op.Id().Human()),
+			op.Time().Format(time.RFC822),
+			policy.OperationKind(op),
+			colors.Magenta(op.Author().DisplayName()),
+			colors.Yellow(firing.Policy),
+			firing.Reason,
+		)
+	}
+
+	env.Out.Printf("%d rules fired on %d operations\n", len(report.Firings), report.Operations)
+
+	return nil
+}
+
+func filterPolicies(policies []policy.Policy, names []string) ([]policy.Policy, error) {
+	byName := make(map[string]policy.Policy, len(policies))
+	for _, p := range policies {
+		byName[p.Name] = p
+	}
+
+	result := make([]policy.Policy, 0, len(names))
+	for _, name := range names {
+		p, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown policy \"%s\"", name)
+		}
+		result = append(result, p)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+type squashIdentitiesOptions struct {
+	dryRun bool
+}
+
+func newSquashIdentitiesCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := squashIdentitiesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "squash-identities",
+		Short: "Remove the identities that no operation points at",
+		Long: `Remove the identities that no operation points at, typically the stale identities left behind by the bridges after years of imports.
+
+The user identity and the identities trusted by another identity are always kept.
+
+The removal is only local: an identity can come back when pulling from a remote that still holds it. As the bugs of the remotes are not considered, it's better to do a pull first.`,
+		Example: `List the identities that would be removed:
+git bug squash-identities --dry-run
+`,
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runSquashIdentities(env, options)
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVarP(&options.dryRun, "dry-run", "n", false,
+		"Only report the identities that would be removed")
+
+	return cmd
+}
+
+func runSquashIdentities(env *execenv.Env, opts squashIdentitiesOptions) error {
+	ids, err := env.Backend.UnreferencedIdentities()
+	if err != nil {
+		return err
+	}
+
+	excerpts := make([]*cache.IdentityExcerpt, len(ids))
+	for i, id := range ids {
+		excerpts[i], err = env.Backend.ResolveIdentityExcerpt(id)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !opts.dryRun {
+		err = env.Backend.RemoveIdentities(ids)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, excerpt := range excerpts {
+		env.Out.Printf("%s %s\n", colors.Cyan(excerpt.Id.Human()), excerpt.DisplayName())
+	}
+
+	if opts.dryRun {
+		env.Out.Printf("%d unreferenced identities would be removed\n", len(ids))
+	} else {
+		env.Out.Printf("%d unreferenced identities removed\n", len(ids))
+	}
+
+	return nil
+}
@@ -7,22 +7,47 @@ import (
 	"github.com/MichaelMure/git-bug/termui"
 )
 
+type termUIOptions struct {
+	accessible bool
+}
+
 func newTermUICommand() *cobra.Command {
 	env := execenv.NewEnv()
+	options := termUIOptions{}
 
 	cmd := &cobra.Command{
 		Use:     "termui",
 		Aliases: []string{"tui"},
 		Short:   "Launch the terminal UI",
+		Long: `Launch the terminal UI.
+
+Press "b" in the list of bugs to switch to a board, showing the bugs matching the same query as one lane per status. A bug can be moved to another lane with "<" and ">", which change its status right away.
+
+Press "n" to create a new bug: after its title, a template, the labels and an assignee are chosen in turn, and the bug is created once confirmed. Press "e" when confirming to complete the title and description in the editor instead. The templates are defined in the git config, with "git-bug.template.<name>.message" holding the initial description ("\n" for a new line), and "git-bug.template.<name>.labels" a comma separated list of labels.
+
+The accessible mode is friendlier to screen readers and low vision: a single linear navigation order, no box drawing or decorative glyphs, a high contrast theme and an explicit announcement of the state changes, above the help bar. It can be enabled permanently with the "git-bug.termui.accessible" git config.`,
+		Example: `Always use the accessible mode:
+git config --global git-bug.termui.accessible true
+
+Define a template for the new bugs:
+git config git-bug.template.bug-report.message "Steps to reproduce:\n\nExpected behavior:\n"
+git config git-bug.template.bug-report.labels "bug, triage"
+`,
 		PreRunE: execenv.LoadBackendEnsureUser(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
-			return runTermUI(env)
+			return runTermUI(env, options)
 		}),
 	}
 
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVar(&options.accessible, "accessible", false,
+		"Use the accessible mode, friendlier to screen readers")
+
 	return cmd
 }
 
-func runTermUI(env *execenv.Env) error {
-	return termui.Run(env.Backend)
+func runTermUI(env *execenv.Env, opts termUIOptions) error {
+	return termui.Run(env.Backend, termui.Options{Accessible: opts.accessible})
 }
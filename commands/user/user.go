@@ -3,6 +3,7 @@ package usercmd
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/commands/completion"
 	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/query"
 	"github.com/MichaelMure/git-bug/util/colors"
 )
 
@@ -23,17 +25,29 @@ func NewUserCommand() *cobra.Command {
 	options := userOptions{}
 
 	cmd := &cobra.Command{
-		Use:     "user",
-		Short:   "List identities",
-		PreRunE: execenv.LoadBackend(env),
+		Use:   "user [QUERY]",
+		Short: "List identities",
+		Long: `List the identities.
+
+You can pass a query to filter and order the list, matching the name, email or login of the identities.`,
+		Example: `List the identities with an email at example.com, the most recently active first:
+git bug user email:example.com sort:activity
+
+Search the identities by name, email or login:
+git bug user rene`,
+		PreRunE: execenv.LoadBackendOrReadOnly(env),
 		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
-			return runUser(env, options)
+			return runUser(env, options, args)
 		}),
 	}
 
 	cmd.AddCommand(newUserNewCommand())
 	cmd.AddCommand(newUserShowCommand())
 	cmd.AddCommand(newUserAdoptCommand())
+	cmd.AddCommand(newUserKeyCommand())
+	cmd.AddCommand(newUserBotCommand())
+	cmd.AddCommand(newUserTrustCommand())
+	cmd.AddCommand(newUserUntrustCommand())
 
 	flags := cmd.Flags()
 	flags.SortFlags = false
@@ -45,8 +59,21 @@ func NewUserCommand() *cobra.Command {
 	return cmd
 }
 
-func runUser(env *execenv.Env, opts userOptions) error {
-	ids := env.Backend.AllIdentityIds()
+func runUser(env *execenv.Env, opts userOptions, args []string) error {
+	q := query.NewIdentityQuery()
+	if len(args) >= 1 {
+		var err error
+		q, err = query.ParseIdentity(repairQuery(args))
+		if err != nil {
+			return err
+		}
+	}
+
+	ids, err := env.Backend.QueryIdentities(q)
+	if err != nil {
+		return err
+	}
+
 	var users []*cache.IdentityExcerpt
 	for _, id := range ids {
 		user, err := env.Backend.ResolveIdentityExcerpt(id)
@@ -68,9 +95,21 @@ func runUser(env *execenv.Env, opts userOptions) error {
 
 func userDefaultFormatter(env *execenv.Env, users []*cache.IdentityExcerpt) error {
 	for _, user := range users {
-		env.Out.Printf("%s %s\n",
+		bot := ""
+		if user.IsBot() {
+			bot = colors.Yellow(" (bot)")
+		}
+
+		trust := ""
+		if trustedBy := env.Backend.IdentityTrustedBy(user.Id); len(trustedBy) > 0 {
+			trust = colors.Green(fmt.Sprintf(" (trusted by %d)", len(trustedBy)))
+		}
+
+		env.Out.Printf("%s %s%s%s\n",
 			colors.Cyan(user.Id.Human()),
 			user.DisplayName(),
+			bot,
+			trust,
 		)
 	}
 
@@ -87,3 +126,18 @@ func userJsonFormatter(env *execenv.Env, users []*cache.IdentityExcerpt) error {
 	env.Out.Printf("%s\n", jsonObject)
 	return nil
 }
+
+// repairQuery quote back the values with spaces, as either the shell or cobra
+// remove the quotes
+func repairQuery(args []string) string {
+	for i, arg := range args {
+		split := strings.Split(arg, ":")
+		for j, s := range split {
+			if strings.Contains(s, " ") {
+				split[j] = fmt.Sprintf("\"%s\"", s)
+			}
+		}
+		args[i] = strings.Join(split, ":")
+	}
+	return strings.Join(args, " ")
+}
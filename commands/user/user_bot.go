@@ -0,0 +1,124 @@
+package usercmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newUserBotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bot",
+		Short: "Manage the bot identities used by automation",
+		Long: `Manage the bot identities, used by automation like a CI to author operations without masquerading as a person.
+
+A bot can't be the user identity: it authors operations only through the web API, authenticated with its API token in an "Authorization: Bearer <token>" header. The bots are displayed as such, and can be filtered out of the bugs with "-author:type:bot".`,
+		Example: `Create a bot, then renew its API token:
+git bug user bot new "CI"
+git bug user bot token 3ab9b2`,
+	}
+
+	cmd.AddCommand(newUserBotNewCommand())
+	cmd.AddCommand(newUserBotTokenCommand())
+	cmd.AddCommand(newUserBotRevokeCommand())
+
+	return cmd
+}
+
+func newUserBotNewCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "new NAME",
+		Short: "Create a new bot identity and its API token",
+		Long: `Create a new bot identity, and print its id then its API token.
+
+Only a hash of the token is stored, in the local git config: it can't be read again, but can be renewed with "git bug user bot token".`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: execenv.LoadBackend(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runUserBotNew(env, args[0])
+		}),
+	}
+
+	return cmd
+}
+
+func runUserBotNew(env *execenv.Env, name string) error {
+	bot, err := env.Backend.NewBotIdentity(name)
+	if err != nil {
+		return err
+	}
+
+	token, err := env.Backend.NewBotToken(bot.Id())
+	if err != nil {
+		return err
+	}
+
+	env.Out.Println(bot.Id())
+	env.Out.Println(token)
+
+	return nil
+}
+
+func newUserBotTokenCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:               "token BOT_ID",
+		Short:             "Renew the API token of a bot",
+		Long:              `Generate a new API token for a bot and print it. The previous token stops working.`,
+		Args:              cobra.ExactArgs(1),
+		PreRunE:           execenv.LoadBackend(env),
+		ValidArgsFunction: completion.User(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runUserBotToken(env, args[0])
+		}),
+	}
+
+	return cmd
+}
+
+func runUserBotToken(env *execenv.Env, prefix string) error {
+	bot, err := env.Backend.ResolveIdentityPrefix(prefix)
+	if err != nil {
+		return err
+	}
+
+	token, err := env.Backend.NewBotToken(bot.Id())
+	if err != nil {
+		return err
+	}
+
+	env.Out.Println(token)
+
+	return nil
+}
+
+func newUserBotRevokeCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:               "revoke BOT_ID",
+		Short:             "Revoke the API token of a bot",
+		Long:              `Revoke the API token of a bot, which can't author operations anymore until a new token is generated.`,
+		Args:              cobra.ExactArgs(1),
+		PreRunE:           execenv.LoadBackend(env),
+		ValidArgsFunction: completion.User(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runUserBotRevoke(env, args[0])
+		}),
+	}
+
+	return cmd
+}
+
+func runUserBotRevoke(env *execenv.Env, prefix string) error {
+	bot, err := env.Backend.ResolveIdentityPrefix(prefix)
+	if err != nil {
+		return err
+	}
+
+	return env.Backend.RevokeBotToken(bot.Id())
+}
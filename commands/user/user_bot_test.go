@@ -0,0 +1,37 @@
+package usercmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+func TestUserBot(t *testing.T) {
+	env, _ := testenv.NewTestEnvAndUser(t)
+
+	require.NoError(t, runUserBotNew(env, "CI"))
+	lines := strings.Split(strings.TrimSpace(env.Out.String()), "\n")
+	require.Len(t, lines, 2)
+	botId, token := entity.Id(lines[0]), lines[1]
+	env.Out.Reset()
+
+	bot, err := env.Backend.ResolveBotToken(token)
+	require.NoError(t, err)
+	require.Equal(t, botId, bot.Id())
+
+	require.NoError(t, runUser(env, userOptions{format: "default"}, nil))
+	require.Contains(t, env.Out.String(), "CI (bot)")
+	env.Out.Reset()
+
+	require.NoError(t, runUserBotToken(env, botId.Human()))
+	renewed := strings.TrimSpace(env.Out.String())
+	require.NotEqual(t, token, renewed)
+
+	require.NoError(t, runUserBotRevoke(env, botId.Human()))
+	_, err = env.Backend.ResolveBotToken(renewed)
+	require.Error(t, err)
+}
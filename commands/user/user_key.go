@@ -0,0 +1,88 @@
+package usercmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newUserKeyCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "key [USER_ID]",
+		Short:   "List the OpenPGP keys of an identity",
+		Long:    `List the fingerprints of the OpenPGP keys of an identity, used to sign its operations. Without an id, the keys of the user identity are listed.`,
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: execenv.LoadBackendOrReadOnly(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runUserKey(env, args)
+		}),
+		ValidArgsFunction: completion.User(env),
+	}
+
+	cmd.AddCommand(newUserKeyGenerateCommand())
+
+	return cmd
+}
+
+func runUserKey(env *execenv.Env, args []string) error {
+	var id *cache.IdentityCache
+	var err error
+	if len(args) == 1 {
+		id, err = env.Backend.ResolveIdentityPrefix(args[0])
+	} else {
+		id, err = env.Backend.GetUserIdentity()
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, key := range id.Keys() {
+		env.Out.Println(key.Fingerprint())
+	}
+
+	return nil
+}
+
+func newUserKeyGenerateCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate an OpenPGP key to sign the operations of the user",
+		Long: `Generate an OpenPGP key to sign the operations of the user identity from now on.
+
+The private key is kept in the git-bug keyring of the user, and the public key is added to the identity, shared with the other users when pushing. Once the identity has a key, its operations without a valid signature are refused by everyone.`,
+		Args:    cobra.NoArgs,
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runUserKeyGenerate(env)
+		}),
+	}
+
+	return cmd
+}
+
+func runUserKeyGenerate(env *execenv.Env) error {
+	user, err := env.Backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	key, err := user.AddSigningKey()
+	if err != nil {
+		return err
+	}
+
+	err = user.Commit()
+	if err != nil {
+		return err
+	}
+
+	env.Out.Println(key.Fingerprint())
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package usercmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestUserKey(t *testing.T) {
+	env, _ := testenv.NewTestEnvAndUser(t)
+
+	require.NoError(t, runUserKey(env, nil))
+	require.Empty(t, env.Out.String())
+
+	require.NoError(t, runUserKeyGenerate(env))
+	fingerprint := strings.TrimSpace(env.Out.String())
+	require.NotEmpty(t, fingerprint)
+	env.Out.Reset()
+
+	require.NoError(t, runUserKey(env, nil))
+	require.Equal(t, fingerprint+"\n", env.Out.String())
+}
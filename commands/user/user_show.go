@@ -10,10 +10,13 @@ import (
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/commands/completion"
 	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/colors"
 )
 
 type userShowOptions struct {
-	fields string
+	fields   string
+	activity bool
 }
 
 func newUserShowCommand() *cobra.Command {
@@ -37,6 +40,8 @@ func newUserShowCommand() *cobra.Command {
 	flags.StringVarP(&options.fields, "field", "f", "",
 		"Select field to display. Valid values are ["+strings.Join(fields, ",")+"]")
 	cmd.RegisterFlagCompletionFunc("field", completion.From(fields))
+	flags.BoolVar(&options.activity, "activity", false,
+		"Display the bugs the user authored, commented on and closed, and the activity over time")
 
 	return cmd
 }
@@ -58,6 +63,10 @@ func runUserShow(env *execenv.Env, opts userShowOptions, args []string) error {
 		return err
 	}
 
+	if opts.activity {
+		return showActivity(env, id)
+	}
+
 	if opts.fields != "" {
 		switch opts.fields {
 		case "email":
@@ -106,3 +115,39 @@ func runUserShow(env *execenv.Env, opts userShowOptions, args []string) error {
 
 	return nil
 }
+
+func showActivity(env *execenv.Env, id *cache.IdentityCache) error {
+	activity := env.Backend.IdentityActivity(id.Id())
+
+	printBugs := func(title string, ids []entity.Id) error {
+		env.Out.Printf("%s: %d\n", title, len(ids))
+		for _, bugId := range ids {
+			excerpt, err := env.Backend.ResolveBugExcerpt(bugId)
+			if err != nil {
+				return err
+			}
+			env.Out.Printf("    %s %s\n", colors.Cyan(bugId.Human()), excerpt.Title)
+		}
+		return nil
+	}
+
+	env.Out.Printf("%s\n", id.DisplayName())
+
+	if err := printBugs("Authored", activity.Authored); err != nil {
+		return err
+	}
+	if err := printBugs("Commented", activity.Commented); err != nil {
+		return err
+	}
+	if err := printBugs("Closed", activity.Closed); err != nil {
+		return err
+	}
+
+	env.Out.Println("Activity:")
+	for _, count := range activity.Timeline {
+		env.Out.Printf("    %s  authored: %d  commented: %d  closed: %d\n",
+			count.Month.Format("2006-01"), count.Authored, count.Commented, count.Closed)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package usercmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+)
+
+func TestUserQuery(t *testing.T) {
+	env, userID := testenv.NewTestEnvAndUser(t)
+
+	other, err := env.Backend.NewIdentity("Jane Doe", "jane@example.com")
+	require.NoError(t, err)
+
+	require.NoError(t, runUser(env, userOptions{format: "default"}, []string{"email:jane@"}))
+	require.Equal(t, other.Id().Human()+" Jane Doe\n", env.Out.String())
+	env.Out.Reset()
+
+	require.NoError(t, runUser(env, userOptions{format: "default"}, []string{"sort:name-desc"}))
+	require.Equal(t, userID.Human()+" John Doe\n"+other.Id().Human()+" Jane Doe\n", env.Out.String())
+	env.Out.Reset()
+
+	require.Error(t, runUser(env, userOptions{format: "default"}, []string{"status:open"}))
+}
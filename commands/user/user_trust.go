@@ -0,0 +1,57 @@
+package usercmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newUserTrustCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "trust USER_ID",
+		Short: "Vouch for an identity",
+		Long: `Vouch for an identity.
+
+The trust statement is recorded in your own identity, and shared with the other users when pushing.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runUserTrust(env, args)
+		}),
+		ValidArgsFunction: completion.User(env),
+	}
+
+	return cmd
+}
+
+func runUserTrust(env *execenv.Env, args []string) error {
+	target, err := env.Backend.ResolveIdentityPrefix(args[0])
+	if err != nil {
+		return err
+	}
+
+	user, err := env.Backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	if user.Id() == target.Id() {
+		return fmt.Errorf("you can't vouch for your own identity")
+	}
+
+	user.Trust(target.Id())
+
+	err = user.CommitAsNeeded()
+	if err != nil {
+		return err
+	}
+
+	env.Out.Printf("You now trust %s\n", target.DisplayName())
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package usercmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/commands/bug/testenv"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+func TestUserTrust(t *testing.T) {
+	env, userID := testenv.NewTestEnvAndUser(t)
+
+	other, err := env.Backend.NewIdentity("Jane Doe", "jane@example.com")
+	require.NoError(t, err)
+
+	require.Error(t, runUserTrust(env, []string{userID.Human()}))
+
+	require.NoError(t, runUserTrust(env, []string{other.Id().Human()}))
+	require.Equal(t, "You now trust Jane Doe\n", env.Out.String())
+	require.Equal(t, []entity.Id{userID}, env.Backend.IdentityTrustedBy(other.Id()))
+	env.Out.Reset()
+
+	require.NoError(t, runUser(env, userOptions{format: "default"}, nil))
+	require.Contains(t, env.Out.String(), other.Id().Human()+" Jane Doe (trusted by 1)\n")
+	env.Out.Reset()
+
+	require.NoError(t, runUserUntrust(env, []string{other.Id().Human()}))
+	require.Equal(t, "You don't trust Jane Doe anymore\n", env.Out.String())
+	require.Empty(t, env.Backend.IdentityTrustedBy(other.Id()))
+}
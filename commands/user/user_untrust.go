@@ -0,0 +1,48 @@
+package usercmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+)
+
+func newUserUntrustCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:     "untrust USER_ID",
+		Short:   "Revoke your trust in an identity",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: execenv.LoadBackendEnsureUser(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runUserUntrust(env, args)
+		}),
+		ValidArgsFunction: completion.User(env),
+	}
+
+	return cmd
+}
+
+func runUserUntrust(env *execenv.Env, args []string) error {
+	target, err := env.Backend.ResolveIdentityPrefix(args[0])
+	if err != nil {
+		return err
+	}
+
+	user, err := env.Backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	user.Untrust(target.Id())
+
+	err = user.CommitAsNeeded()
+	if err != nil {
+		return err
+	}
+
+	env.Out.Printf("You don't trust %s anymore\n", target.DisplayName())
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/commands/bug/select"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/policy"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func newVerifyCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "verify [BUG_ID]",
+		Short: "Verify the signatures of the operations of a bug",
+		Long: `Verify the OpenPGP signatures of the operations of a bug, and report for each of them whether it's signed, unsigned, or has an invalid signature.
+
+An operation is signed when its author has a key, as generated with "git bug user key generate". Once an author has a key, its operations without a valid signature are invalid: they have been forged or tampered with. Such operations are always refused when pulling.
+
+The unsigned operations, made by authors without key, are accepted when pulling, unless "git-bug.signature.policy" is set in the git config:
+- optional: the default, the unsigned operations are accepted
+- warn: the unsigned operations are accepted, but reported
+- required: a bug bringing unsigned operations is refused
+
+The command fails if an operation has an invalid signature, or is unsigned while the policy is "required".`,
+		Example: `Require the operations to be signed when pulling, and check a bug:
+git config git-bug.signature.policy required
+git bug verify 2f9b7ae
+`,
+		PreRunE: execenv.LoadBackendOrReadOnly(env),
+		RunE: execenv.CloseBackend(env, func(cmd *cobra.Command, args []string) error {
+			return runVerify(env, args)
+		}),
+		ValidArgsFunction: completion.Bug(env),
+	}
+
+	return cmd
+}
+
+func runVerify(env *execenv.Env, args []string) error {
+	b, _, err := _select.ResolveBug(env.Backend, args)
+	if err != nil {
+		return err
+	}
+
+	signaturePolicy, err := env.Backend.SignaturePolicy()
+	if err != nil {
+		return err
+	}
+
+	commits, err := env.Backend.VerifyBugSignatures(b.Id())
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[dag.SignatureStatus]int)
+	var total int
+
+	for _, commit := range commits {
+		status := commit.Status.String()
+		switch commit.Status {
+		case dag.SignatureStatusValid:
+			status = colors.Green(status)
+		case dag.SignatureStatusUnsigned:
+			status = colors.Yellow(status)
+		case dag.SignatureStatusInvalid:
+			status = colors.Red(status)
+		}
+
+		for _, op := range commit.Operations {
+			kind := "unknown"
+			if op, ok := op.(bug.Operation); ok {
+				kind = policy.OperationKind(op)
+			}
+			env.Out.Printf("%s %s %-13s %s %s\n",
+				colors.Cyan(op.Id().Human()),
+				op.Time().Format(time.RFC822),
+				kind,
+				colors.Magenta(commit.Author.DisplayName()),
+				status,
+			)
+		}
+		if commit.Status == dag.SignatureStatusInvalid {
+			env.Out.Printf("  %s\n", commit.Reason)
+		}
+
+		counts[commit.Status] += len(commit.Operations)
+		total += len(commit.Operations)
+	}
+
+	env.Out.Printf("%d operations: %d signed, %d unsigned, %d invalid\n",
+		total,
+		counts[dag.SignatureStatusValid],
+		counts[dag.SignatureStatusUnsigned],
+		counts[dag.SignatureStatusInvalid],
+	)
+
+	if counts[dag.SignatureStatusInvalid] > 0 {
+		return fmt.Errorf("some operations have an invalid signature")
+	}
+	if signaturePolicy == dag.SignatureRequired && counts[dag.SignatureStatusUnsigned] > 0 {
+		return fmt.Errorf("some operations are not signed, as required by the signature policy")
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// the first file descriptor passed by systemd, after stdin, stdout and stderr
+const systemdListenFdsStart = 3
+
+// systemdListener return the socket passed by systemd when the web UI is
+// started by socket activation, or nil if it's not the case.
+// See sd_listen_fds(3).
+func systemdListener() (net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, nil
+	}
+
+	fds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || fds == 0 {
+		return nil, nil
+	}
+	if fds > 1 {
+		return nil, fmt.Errorf("expected a single socket from systemd, got %d", fds)
+	}
+
+	// don't pass the socket to the processes started by the web UI
+	_ = os.Unsetenv("LISTEN_PID")
+	_ = os.Unsetenv("LISTEN_FDS")
+	_ = os.Unsetenv("LISTEN_FDNAMES")
+
+	f := os.NewFile(uintptr(systemdListenFdsStart), "systemd socket")
+	defer f.Close()
+
+	// the file descriptor is duplicated, f can be closed
+	return net.FileListener(f)
+}
+
+// idleTracker record the activity of the web UI, to shut it down after a
+// period without any request. The long running requests, like the GraphQL
+// subscriptions, keep the web UI active until they end.
+type idleTracker struct {
+	mu sync.Mutex
+	// number of requests in progress
+	active int
+	// end of the last request, or start of the web UI
+	last time.Time
+}
+
+func newIdleTracker() *idleTracker {
+	return &idleTracker{last: time.Now()}
+}
+
+// middleware record the requests handled by next
+func (t *idleTracker) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.mu.Lock()
+		t.active++
+		t.mu.Unlock()
+
+		defer func() {
+			t.mu.Lock()
+			t.active--
+			t.last = time.Now()
+			t.mu.Unlock()
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// idleFor return how long the web UI has been without any request in
+// progress, or 0 if a request is in progress
+func (t *idleTracker) idleFor() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.active > 0 {
+		return 0
+	}
+	return time.Since(t.last)
+}
+
+// wait block until the web UI has been idle for timeout
+func (t *idleTracker) wait(timeout time.Duration) {
+	for {
+		idle := t.idleFor()
+		if idle >= timeout {
+			return
+		}
+		time.Sleep(timeout - idle)
+	}
+}
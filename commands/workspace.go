@@ -0,0 +1,175 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	bugcmd "github.com/MichaelMure/git-bug/commands/bug"
+	"github.com/MichaelMure/git-bug/commands/completion"
+	"github.com/MichaelMure/git-bug/commands/execenv"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func newWorkspaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "workspace",
+		Short: "Query the bugs of several local repositories at once",
+		Long: `Query the bugs of several local repositories at once.
+
+The workspace is the list of the repositories of the user, stored in the "git-bug/workspace" file of the user configuration directory, or in the file given by the GIT_BUG_WORKSPACE environment variable. It can be queried from anywhere, the ids of the bugs being prefixed by the name of the directory of their repository.`,
+		Example: `git bug workspace add ~/src/project
+git bug workspace add ~/src/lib
+git bug workspace ls status:open label:ui`,
+	}
+
+	cmd.AddCommand(newWorkspaceAddCommand())
+	cmd.AddCommand(newWorkspaceLsCommand())
+	cmd.AddCommand(newWorkspaceRmCommand())
+
+	return cmd
+}
+
+func newWorkspaceAddCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "add [PATH]",
+		Short: "Add a repository to the workspace",
+		Long:  `Add a repository to the workspace. Without a path, the repository of the current directory is added.`,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkspaceAdd(env, args)
+		},
+	}
+
+	return cmd
+}
+
+func runWorkspaceAdd(env *execenv.Env, args []string) error {
+	path := "."
+	if len(args) == 1 {
+		path = args[0]
+	}
+
+	// only a repository can be added
+	repo, err := openWorkspaceRepo(path)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	_ = repo.Close()
+
+	w, err := loadWorkspace()
+	if err != nil {
+		return err
+	}
+
+	added, err := w.Add(path)
+	if err != nil {
+		return err
+	}
+	if !added {
+		env.Out.Printf("%s is already part of the workspace\n", path)
+		return nil
+	}
+
+	return w.Save()
+}
+
+type workspaceLsOptions struct {
+	outputFormat string
+}
+
+func newWorkspaceLsCommand() *cobra.Command {
+	env := execenv.NewEnv()
+	options := workspaceLsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "ls [QUERY]",
+		Short: "List the bugs of all the repositories of the workspace",
+		Long: `List the bugs of all the repositories of the workspace matching a query, in the query language of "git bug". The ids of the bugs are prefixed by the name of the directory of their repository, with a numeric suffix for the directories with the same name, as in "project-2/2f0d3b1".
+
+A repository locked by another git-bug process, like a running web UI, is opened read-only.`,
+		Example: `git bug workspace ls status:open sort:edit-desc`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkspaceLs(env, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.outputFormat, "format", "f", "default",
+		"Select the output formatting style. Valid values are [default,plain,compact,id,json,org-mode]")
+	cmd.RegisterFlagCompletionFunc("format",
+		completion.From([]string{"default", "plain", "compact", "id", "json", "org-mode"}))
+
+	return cmd
+}
+
+func runWorkspaceLs(env *execenv.Env, opts workspaceLsOptions, args []string) error {
+	w, err := loadWorkspace()
+	if err != nil {
+		return err
+	}
+	if len(w.Repos) == 0 {
+		return fmt.Errorf("the workspace is empty, add a repository with \"git bug workspace add\"")
+	}
+
+	mrc := cache.NewMultiRepoCache()
+	defer mrc.Close()
+
+	err = mrc.RegisterWorkspace(w, openWorkspaceRepo)
+	if err != nil {
+		return err
+	}
+
+	return bugcmd.ListMultiRepoBugs(env, mrc, args, opts.outputFormat)
+}
+
+func newWorkspaceRmCommand() *cobra.Command {
+	env := execenv.NewEnv()
+
+	cmd := &cobra.Command{
+		Use:   "rm PATH",
+		Short: "Remove a repository from the workspace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkspaceRm(env, args)
+		},
+		ValidArgsFunction: completion.WorkspaceRepo(),
+	}
+
+	return cmd
+}
+
+func runWorkspaceRm(env *execenv.Env, args []string) error {
+	w, err := loadWorkspace()
+	if err != nil {
+		return err
+	}
+
+	removed, err := w.Remove(args[0])
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return fmt.Errorf("%s is not part of the workspace", args[0])
+	}
+
+	return w.Save()
+}
+
+func loadWorkspace() (*cache.Workspace, error) {
+	path, err := cache.DefaultWorkspacePath()
+	if err != nil {
+		return nil, err
+	}
+	return cache.LoadWorkspace(path)
+}
+
+func openWorkspaceRepo(path string) (repository.ClockedRepo, error) {
+	return repository.OpenGoGitRepo(path, execenv.GitBugNamespace, []repository.ClockLoader{bug.ClockLoader})
+}
@@ -0,0 +1,67 @@
+package bug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/text"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+// Attachment is a file attached to a bug, stored as a git blob and
+// distributed with the operation that attached it
+type Attachment struct {
+	// Name is the file name, without directory
+	Name string
+	Hash repository.Hash
+	// Size is the size of the file in bytes
+	Size int64
+	// ContentType is the MIME type of the file, as detected when attached
+	ContentType string
+
+	Author identity.Interface
+	// Time when the file was attached.
+	// Should be used only for human display, never for ordering as we can't rely on it in a distributed system.
+	UnixTime timestamp.Timestamp
+}
+
+// SearchAttachment return the last attachment with the given name, or whose
+// hash starts with the given prefix
+func (snap *Snapshot) SearchAttachment(nameOrHash string) (*Attachment, error) {
+	var matching []*Attachment
+	for i := len(snap.Attachments) - 1; i >= 0; i-- {
+		attachment := &snap.Attachments[i]
+		if attachment.Name == nameOrHash {
+			return attachment, nil
+		}
+		if strings.HasPrefix(string(attachment.Hash), nameOrHash) {
+			matching = append(matching, attachment)
+		}
+	}
+
+	switch {
+	case len(matching) == 0:
+		return nil, fmt.Errorf("no attachment matching \"%s\"", nameOrHash)
+	case len(matching) > 1 && matching[0].Hash != matching[len(matching)-1].Hash:
+		return nil, fmt.Errorf("multiple attachments matching \"%s\"", nameOrHash)
+	default:
+		return matching[0], nil
+	}
+}
+
+// validateAttachmentName check that a file name is usable on its own, when
+// downloading the attachment
+func validateAttachmentName(name string) error {
+	if text.Empty(name) {
+		return fmt.Errorf("name is not set")
+	}
+	if !text.SafeOneLine(name) {
+		return fmt.Errorf("name is not fully printable")
+	}
+	if strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return fmt.Errorf("name \"%s\" is not a plain file name", name)
+	}
+	return nil
+}
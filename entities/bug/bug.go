@@ -20,11 +20,17 @@ var _ entity.Interface = &Bug{}
 // 4: with DAG entity framework
 const formatVersion = 4
 
+// the number of operations between two checkpoints of the compiled bug, see
+// checkpoint.go
+const checkpointInterval = 500
+
 var def = dag.Definition{
 	Typename:             "bug",
 	Namespace:            "bugs",
 	OperationUnmarshaler: operationUnmarshaler,
 	FormatVersion:        formatVersion,
+	Checkpointer:         compileCheckpoint,
+	CheckpointInterval:   checkpointInterval,
 }
 
 var ClockLoader = dag.ClockLoader(def)
@@ -150,14 +156,63 @@ func (bug *Bug) Operations() []Operation {
 	return result
 }
 
-// Compile a bug in an easily usable snapshot
+// Compile a bug in an easily usable snapshot. Only the operations after the
+// latest checkpoint of the bug are applied.
 func (bug *Bug) Compile() *Snapshot {
+	return bug.compile(bug.Operations())
+}
+
+// Replay compile the bug operation by operation. Before each operation is
+// applied, fn is called with the state of the bug at that point. The snapshot
+// given to fn is modified by the following operations and should not be
+// retained. Nothing is written.
+func (bug *Bug) Replay(fn func(before *Snapshot, op Operation)) *Snapshot {
+	snap := bug.replay(bug.Operations(), fn)
+	snap.MergeConflicts = bug.mergeConflicts(snap.Operations)
+	return snap
+}
+
+// CompilePrefix compile the bug with only its n first operations, as it was
+// before the following ones were added.
+func (bug *Bug) CompilePrefix(n int) *Snapshot {
+	ops := bug.Operations()
+	if n < len(ops) {
+		ops = ops[:n]
+	}
+	return bug.compile(ops)
+}
+
+// compile the first operations of the bug, starting from its latest
+// checkpoint if it covers only some of them
+func (bug *Bug) compile(ops []Operation) *Snapshot {
+	var snap *Snapshot
+	if restored, n, ok := bug.restoreCheckpoint(ops); ok {
+		snap = applyOps(restored, ops[n:], nil)
+	} else {
+		snap = bug.replay(ops, nil)
+	}
+	snap.MergeConflicts = bug.mergeConflicts(snap.Operations)
+	return snap
+}
+
+func (bug *Bug) replay(ops []Operation, fn func(before *Snapshot, op Operation)) *Snapshot {
 	snap := &Snapshot{
 		id:     bug.Id(),
 		Status: common.OpenStatus,
 	}
+	return applyOps(snap, ops, fn)
+}
 
-	for _, op := range bug.Operations() {
+// applyOps apply the operations on the snapshot, see Replay
+func applyOps(snap *Snapshot, ops []Operation, fn func(before *Snapshot, op Operation)) *Snapshot {
+	for _, op := range ops {
+		if dag.IsUnknownOperation(op) {
+			// written by a more recent version of git-bug, kept as is
+			continue
+		}
+		if fn != nil {
+			fn(snap, op)
+		}
 		op.Apply(snap)
 		snap.Operations = append(snap.Operations, op)
 	}
@@ -52,6 +52,13 @@ func MergeAll(repo repository.ClockedRepo, resolvers entity.Resolvers, remote st
 	return out
 }
 
+// VerifySignatures return the signature status of the commits holding the operations of a
+// bug, from the oldest. Contrary to reading the bug, an invalid signature is reported instead
+// of failing.
+func VerifySignatures(repo repository.ClockedRepo, resolvers entity.Resolvers, id entity.Id) ([]dag.CommitSignature, error) {
+	return dag.VerifySignatures(def, repo, resolvers, id)
+}
+
 // Remove will remove a local bug from its entity.Id
 func Remove(repo repository.ClockedRepo, id entity.Id) error {
 	return dag.Remove(def, repo, id)
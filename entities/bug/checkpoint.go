@@ -0,0 +1,537 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+// A checkpoint is the Snapshot of a bug compiled from its first operations,
+// stored with them every checkpointInterval operations, so that compiling a
+// long-lived bug only applies the operations after the latest one.
+//
+// The identities are stored by id: all the identities of a snapshot are the
+// authors of its operations, which are loaded anyway, so they are taken from
+// there when restoring it. The operations themselves are not stored either.
+
+// the kinds of the timeline items in a checkpoint
+const (
+	checkpointKindCreate  = "create"
+	checkpointKindComment = "comment"
+	checkpointKindStatus  = "status"
+	checkpointKindTitle   = "title"
+	checkpointKindLabels  = "labels"
+)
+
+type checkpointSnapshot struct {
+	Status           common.Status            `json:"status"`
+	Title            string                   `json:"title"`
+	Comments         []checkpointComment      `json:"comments"`
+	Labels           []Label                  `json:"labels"`
+	Author           entity.Id                `json:"author"`
+	Actors           []entity.Id              `json:"actors"`
+	Participants     []entity.Id              `json:"participants"`
+	CreateTime       int64                    `json:"create_time"`
+	State            string                   `json:"state"`
+	AwaitingReporter bool                     `json:"awaiting_reporter"`
+	Assignees        []entity.Id              `json:"assignees"`
+	Fields           map[string]string        `json:"fields"`
+	Relations        []Relation               `json:"relations"`
+	Attachments      []checkpointAttachment   `json:"attachments"`
+	SyncConflicts    []checkpointSyncConflict `json:"sync_conflicts"`
+	Timeline         []checkpointTimelineItem `json:"timeline"`
+}
+
+type checkpointComment struct {
+	CombinedId entity.CombinedId         `json:"id"`
+	TargetId   entity.Id                 `json:"target"`
+	Author     entity.Id                 `json:"author"`
+	Message    string                    `json:"message"`
+	Files      []repository.Hash         `json:"files"`
+	Reactions  []checkpointReactionGroup `json:"reactions"`
+	UnixTime   timestamp.Timestamp       `json:"time"`
+}
+
+type checkpointReactionGroup struct {
+	Reaction Reaction    `json:"reaction"`
+	Authors  []entity.Id `json:"authors"`
+}
+
+type checkpointAttachment struct {
+	Name        string              `json:"name"`
+	Hash        repository.Hash     `json:"hash"`
+	Size        int64               `json:"size"`
+	ContentType string              `json:"content_type"`
+	Author      entity.Id           `json:"author"`
+	UnixTime    timestamp.Timestamp `json:"time"`
+}
+
+type checkpointSyncConflict struct {
+	OperationId entity.Id           `json:"operation"`
+	Author      entity.Id           `json:"author"`
+	UnixTime    timestamp.Timestamp `json:"time"`
+	Bridge      string              `json:"bridge"`
+	Field       string              `json:"field"`
+	Local       string              `json:"local"`
+	Remote      string              `json:"remote"`
+	Winner      SyncSide            `json:"winner"`
+}
+
+// checkpointTimelineItem hold one of the timeline items, depending on its
+// kind
+type checkpointTimelineItem struct {
+	Kind       string              `json:"kind"`
+	CombinedId entity.CombinedId   `json:"id"`
+	Author     entity.Id           `json:"author"`
+	UnixTime   timestamp.Timestamp `json:"time,omitempty"`
+
+	Comment *checkpointCommentItem  `json:"comment,omitempty"`
+	Status  *checkpointStatusChange `json:"status,omitempty"`
+	Title   *checkpointTitleChange  `json:"title,omitempty"`
+	Labels  *checkpointLabelChange  `json:"labels,omitempty"`
+}
+
+type checkpointCommentItem struct {
+	Message   string                    `json:"message"`
+	Files     []repository.Hash         `json:"files"`
+	CreatedAt timestamp.Timestamp       `json:"created_at"`
+	LastEdit  timestamp.Timestamp       `json:"last_edit"`
+	History   []checkpointHistoryStep   `json:"history"`
+	Redacted  bool                      `json:"redacted"`
+	Deleted   bool                      `json:"deleted"`
+	Reactions []checkpointReactionGroup `json:"reactions"`
+}
+
+type checkpointHistoryStep struct {
+	Author   entity.Id           `json:"author"`
+	Message  string              `json:"message"`
+	UnixTime timestamp.Timestamp `json:"time"`
+}
+
+type checkpointStatusChange struct {
+	Status common.Status `json:"status"`
+	State  string        `json:"state"`
+}
+
+type checkpointTitleChange struct {
+	Title string `json:"title"`
+	Was   string `json:"was"`
+}
+
+type checkpointLabelChange struct {
+	Added   []Label `json:"added"`
+	Removed []Label `json:"removed"`
+}
+
+// compileCheckpoint is the dag.Definition's Checkpointer: it compiles the
+// operations into an encoded checkpoint
+func compileCheckpoint(ops []dag.Operation) ([]byte, error) {
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("no operations to compile")
+	}
+
+	bugOps := make([]Operation, len(ops))
+	for i, op := range ops {
+		bugOp, ok := op.(Operation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected operation type %T", op)
+		}
+		bugOps[i] = bugOp
+	}
+
+	snap := &Snapshot{
+		id:     ops[0].Id(),
+		Status: common.OpenStatus,
+	}
+	applyOps(snap, bugOps, nil)
+
+	return encodeCheckpoint(snap)
+}
+
+func encodeCheckpoint(snap *Snapshot) ([]byte, error) {
+	cs := checkpointSnapshot{
+		Status:           snap.Status,
+		Title:            snap.Title,
+		Labels:           snap.Labels,
+		Author:           identityId(snap.Author),
+		Actors:           identityIds(snap.Actors),
+		Participants:     identityIds(snap.Participants),
+		State:            snap.State,
+		AwaitingReporter: snap.AwaitingReporter,
+		Assignees:        snap.Assignees,
+		Fields:           snap.Fields,
+		Relations:        snap.Relations,
+	}
+
+	if !snap.CreateTime.IsZero() {
+		cs.CreateTime = snap.CreateTime.Unix()
+	}
+
+	if snap.Comments != nil {
+		cs.Comments = make([]checkpointComment, len(snap.Comments))
+		for i, c := range snap.Comments {
+			cs.Comments[i] = checkpointComment{
+				CombinedId: c.combinedId,
+				TargetId:   c.targetId,
+				Author:     identityId(c.Author),
+				Message:    c.Message,
+				Files:      c.Files,
+				Reactions:  encodeReactions(c.Reactions),
+				UnixTime:   c.unixTime,
+			}
+		}
+	}
+
+	if snap.Attachments != nil {
+		cs.Attachments = make([]checkpointAttachment, len(snap.Attachments))
+		for i, a := range snap.Attachments {
+			cs.Attachments[i] = checkpointAttachment{
+				Name:        a.Name,
+				Hash:        a.Hash,
+				Size:        a.Size,
+				ContentType: a.ContentType,
+				Author:      identityId(a.Author),
+				UnixTime:    a.UnixTime,
+			}
+		}
+	}
+
+	if snap.SyncConflicts != nil {
+		cs.SyncConflicts = make([]checkpointSyncConflict, len(snap.SyncConflicts))
+		for i, c := range snap.SyncConflicts {
+			cs.SyncConflicts[i] = checkpointSyncConflict{
+				OperationId: c.OperationId,
+				Author:      identityId(c.Author),
+				UnixTime:    c.UnixTime,
+				Bridge:      c.Bridge,
+				Field:       c.Field,
+				Local:       c.Local,
+				Remote:      c.Remote,
+				Winner:      c.Winner,
+			}
+		}
+	}
+
+	if snap.Timeline != nil {
+		cs.Timeline = make([]checkpointTimelineItem, len(snap.Timeline))
+		for i, item := range snap.Timeline {
+			encoded, err := encodeTimelineItem(item)
+			if err != nil {
+				return nil, err
+			}
+			cs.Timeline[i] = encoded
+		}
+	}
+
+	return json.Marshal(cs)
+}
+
+func encodeTimelineItem(item TimelineItem) (checkpointTimelineItem, error) {
+	switch item := item.(type) {
+	case *CreateTimelineItem:
+		return encodeCommentItem(checkpointKindCreate, &item.CommentTimelineItem), nil
+	case *AddCommentTimelineItem:
+		return encodeCommentItem(checkpointKindComment, &item.CommentTimelineItem), nil
+	case *SetStatusTimelineItem:
+		return checkpointTimelineItem{
+			Kind:       checkpointKindStatus,
+			CombinedId: item.combinedId,
+			Author:     identityId(item.Author),
+			UnixTime:   item.UnixTime,
+			Status:     &checkpointStatusChange{Status: item.Status, State: item.State},
+		}, nil
+	case *SetTitleTimelineItem:
+		return checkpointTimelineItem{
+			Kind:       checkpointKindTitle,
+			CombinedId: item.combinedId,
+			Author:     identityId(item.Author),
+			UnixTime:   item.UnixTime,
+			Title:      &checkpointTitleChange{Title: item.Title, Was: item.Was},
+		}, nil
+	case *LabelChangeTimelineItem:
+		return checkpointTimelineItem{
+			Kind:       checkpointKindLabels,
+			CombinedId: item.combinedId,
+			Author:     identityId(item.Author),
+			UnixTime:   item.UnixTime,
+			Labels:     &checkpointLabelChange{Added: item.Added, Removed: item.Removed},
+		}, nil
+	default:
+		return checkpointTimelineItem{}, fmt.Errorf("unknown timeline item type %T", item)
+	}
+}
+
+func encodeCommentItem(kind string, item *CommentTimelineItem) checkpointTimelineItem {
+	comment := &checkpointCommentItem{
+		Message:   item.Message,
+		Files:     item.Files,
+		CreatedAt: item.CreatedAt,
+		LastEdit:  item.LastEdit,
+		Redacted:  item.Redacted,
+		Deleted:   item.Deleted,
+		Reactions: encodeReactions(item.Reactions),
+	}
+	if item.History != nil {
+		comment.History = make([]checkpointHistoryStep, len(item.History))
+		for i, step := range item.History {
+			comment.History[i] = checkpointHistoryStep{
+				Author:   identityId(step.Author),
+				Message:  step.Message,
+				UnixTime: step.UnixTime,
+			}
+		}
+	}
+
+	return checkpointTimelineItem{
+		Kind:       kind,
+		CombinedId: item.combinedId,
+		Author:     identityId(item.Author),
+		Comment:    comment,
+	}
+}
+
+func encodeReactions(groups []ReactionGroup) []checkpointReactionGroup {
+	if groups == nil {
+		return nil
+	}
+	result := make([]checkpointReactionGroup, len(groups))
+	for i, group := range groups {
+		result[i] = checkpointReactionGroup{
+			Reaction: group.Reaction,
+			Authors:  identityIds(group.Authors),
+		}
+	}
+	return result
+}
+
+func identityId(i identity.Interface) entity.Id {
+	if i == nil {
+		return ""
+	}
+	return i.Id()
+}
+
+func identityIds(identities []identity.Interface) []entity.Id {
+	if identities == nil {
+		return nil
+	}
+	result := make([]entity.Id, len(identities))
+	for i, identity := range identities {
+		result[i] = identityId(identity)
+	}
+	return result
+}
+
+// restoreCheckpoint decode the latest checkpoint of the bug, if it covers at
+// most the given operations. It returns the snapshot as compiled from the
+// operations it covers, and their number. An unusable checkpoint is ignored.
+func (bug *Bug) restoreCheckpoint(ops []Operation) (*Snapshot, int, bool) {
+	data, n := bug.Entity.Checkpoint()
+	if data == nil || n > len(ops) {
+		return nil, 0, false
+	}
+
+	var cs checkpointSnapshot
+	if err := json.Unmarshal(data, &cs); err != nil {
+		return nil, 0, false
+	}
+
+	authors := make(map[entity.Id]identity.Interface)
+	for _, op := range ops[:n] {
+		authors[op.Author().Id()] = op.Author()
+	}
+
+	snap, err := decodeCheckpoint(bug.Id(), cs, authors)
+	if err != nil {
+		return nil, 0, false
+	}
+
+	// the operations are not part of the checkpoint, but some of them only
+	// change the other operations, like the metadata ones
+	for _, op := range ops[:n] {
+		if dag.IsUnknownOperation(op) {
+			continue
+		}
+		snap.Operations = append(snap.Operations, op)
+		if _, ok := op.(dag.OperationDoesntChangeSnapshot); ok {
+			op.Apply(snap)
+		}
+	}
+
+	return snap, n, true
+}
+
+func decodeCheckpoint(id entity.Id, cs checkpointSnapshot, authors map[entity.Id]identity.Interface) (*Snapshot, error) {
+	var err error
+	resolve := func(id entity.Id) identity.Interface {
+		if id == "" {
+			return nil
+		}
+		i, ok := authors[id]
+		if !ok && err == nil {
+			err = fmt.Errorf("unknown identity %s in checkpoint", id)
+		}
+		return i
+	}
+	resolveAll := func(ids []entity.Id) []identity.Interface {
+		if ids == nil {
+			return nil
+		}
+		result := make([]identity.Interface, len(ids))
+		for i, id := range ids {
+			result[i] = resolve(id)
+		}
+		return result
+	}
+	reactions := func(groups []checkpointReactionGroup) []ReactionGroup {
+		if groups == nil {
+			return nil
+		}
+		result := make([]ReactionGroup, len(groups))
+		for i, group := range groups {
+			result[i] = ReactionGroup{Reaction: group.Reaction, Authors: resolveAll(group.Authors)}
+		}
+		return result
+	}
+
+	snap := &Snapshot{
+		id:               id,
+		Status:           cs.Status,
+		Title:            cs.Title,
+		Labels:           cs.Labels,
+		Author:           resolve(cs.Author),
+		Actors:           resolveAll(cs.Actors),
+		Participants:     resolveAll(cs.Participants),
+		State:            cs.State,
+		AwaitingReporter: cs.AwaitingReporter,
+		Assignees:        cs.Assignees,
+		Fields:           cs.Fields,
+		Relations:        cs.Relations,
+	}
+
+	if cs.CreateTime != 0 {
+		snap.CreateTime = time.Unix(cs.CreateTime, 0)
+	}
+
+	if cs.Comments != nil {
+		snap.Comments = make([]Comment, len(cs.Comments))
+		for i, c := range cs.Comments {
+			snap.Comments[i] = Comment{
+				combinedId: c.CombinedId,
+				targetId:   c.TargetId,
+				Author:     resolve(c.Author),
+				Message:    c.Message,
+				Files:      c.Files,
+				Reactions:  reactions(c.Reactions),
+				unixTime:   c.UnixTime,
+			}
+		}
+	}
+
+	if cs.Attachments != nil {
+		snap.Attachments = make([]Attachment, len(cs.Attachments))
+		for i, a := range cs.Attachments {
+			snap.Attachments[i] = Attachment{
+				Name:        a.Name,
+				Hash:        a.Hash,
+				Size:        a.Size,
+				ContentType: a.ContentType,
+				Author:      resolve(a.Author),
+				UnixTime:    a.UnixTime,
+			}
+		}
+	}
+
+	if cs.SyncConflicts != nil {
+		snap.SyncConflicts = make([]SyncConflict, len(cs.SyncConflicts))
+		for i, c := range cs.SyncConflicts {
+			snap.SyncConflicts[i] = SyncConflict{
+				OperationId: c.OperationId,
+				Author:      resolve(c.Author),
+				UnixTime:    c.UnixTime,
+				Bridge:      c.Bridge,
+				Field:       c.Field,
+				Local:       c.Local,
+				Remote:      c.Remote,
+				Winner:      c.Winner,
+			}
+		}
+	}
+
+	if cs.Timeline != nil {
+		snap.Timeline = make([]TimelineItem, len(cs.Timeline))
+		for i, item := range cs.Timeline {
+			switch {
+			case (item.Kind == checkpointKindCreate || item.Kind == checkpointKindComment) && item.Comment != nil:
+				comment := CommentTimelineItem{
+					combinedId: item.CombinedId,
+					Author:     resolve(item.Author),
+					Message:    item.Comment.Message,
+					Files:      item.Comment.Files,
+					CreatedAt:  item.Comment.CreatedAt,
+					LastEdit:   item.Comment.LastEdit,
+					Redacted:   item.Comment.Redacted,
+					Deleted:    item.Comment.Deleted,
+					Reactions:  reactions(item.Comment.Reactions),
+				}
+				if item.Comment.History != nil {
+					comment.History = make([]CommentHistoryStep, len(item.Comment.History))
+					for j, step := range item.Comment.History {
+						comment.History[j] = CommentHistoryStep{
+							Author:   resolve(step.Author),
+							Message:  step.Message,
+							UnixTime: step.UnixTime,
+						}
+					}
+				}
+				if item.Kind == checkpointKindCreate {
+					snap.Timeline[i] = &CreateTimelineItem{CommentTimelineItem: comment}
+				} else {
+					snap.Timeline[i] = &AddCommentTimelineItem{CommentTimelineItem: comment}
+				}
+
+			case item.Kind == checkpointKindStatus && item.Status != nil:
+				snap.Timeline[i] = &SetStatusTimelineItem{
+					combinedId: item.CombinedId,
+					Author:     resolve(item.Author),
+					UnixTime:   item.UnixTime,
+					Status:     item.Status.Status,
+					State:      item.Status.State,
+				}
+
+			case item.Kind == checkpointKindTitle && item.Title != nil:
+				snap.Timeline[i] = &SetTitleTimelineItem{
+					combinedId: item.CombinedId,
+					Author:     resolve(item.Author),
+					UnixTime:   item.UnixTime,
+					Title:      item.Title.Title,
+					Was:        item.Title.Was,
+				}
+
+			case item.Kind == checkpointKindLabels && item.Labels != nil:
+				snap.Timeline[i] = &LabelChangeTimelineItem{
+					combinedId: item.CombinedId,
+					Author:     resolve(item.Author),
+					UnixTime:   item.UnixTime,
+					Added:      item.Labels.Added,
+					Removed:    item.Labels.Removed,
+				}
+
+			default:
+				return nil, fmt.Errorf("invalid timeline item %q in checkpoint", item.Kind)
+			}
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
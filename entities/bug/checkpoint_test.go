@@ -0,0 +1,106 @@
+package bug
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestCheckpoint(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, rene.Commit(repo))
+	isaac, err := identity.NewIdentity(repo, "Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+	require.NoError(t, isaac.Commit(repo))
+
+	unix := time.Now().Unix()
+
+	b, create, err := Create(rene, unix, "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	hash, err := repo.StoreData([]byte("data"))
+	require.NoError(t, err)
+
+	// each operation has its own time, so that none is dropped as a duplicate
+	// of another when reading the bug
+	var lastComment entity.Id
+	appendOps := func(count int) {
+		for i := 0; i < count; i++ {
+			unix++
+			author := rene
+			if (i/10)%2 == 1 {
+				author = isaac
+			}
+			switch i % 12 {
+			case 0:
+				op := NewAddCommentOp(author, unix, fmt.Sprintf("comment %d", i), nil)
+				lastComment = op.Id()
+				b.Append(op)
+			case 1:
+				b.Append(NewEditCommentOp(author, unix, lastComment, "edited", nil))
+			case 2:
+				b.Append(NewReactionOp(author, unix, lastComment, ReactionHeart, false))
+			case 3:
+				b.Append(NewLabelChangeOperation(author, unix, []Label{Label(fmt.Sprintf("l%d", i%5))}, nil))
+			case 4:
+				b.Append(NewSetStatusOp(author, unix, common.Status(1+(i/12)%2)))
+			case 5:
+				b.Append(NewSetTitleOp(author, unix, fmt.Sprintf("title %d", i), "title"))
+			case 6:
+				b.Append(NewSetMetadataOp(author, unix, lastComment, map[string]string{"key": "value"}))
+			case 7:
+				b.Append(NewSetFieldOp(author, unix, "priority", fmt.Sprint(i)))
+			case 8:
+				b.Append(NewSetAssigneeOp(author, unix, []entity.Id{isaac.Id()}, nil))
+			case 9:
+				b.Append(NewAddAttachmentOp(author, unix, fmt.Sprintf("file%d", i), hash, 4, "text/plain"))
+			case 10:
+				b.Append(NewSyncConflictOp(author, unix, "github", "title", "a", "b", SyncSideLocal))
+			case 11:
+				b.Append(NewRedactCommentOp(author, unix, lastComment, false))
+			}
+		}
+	}
+
+	appendOps(checkpointInterval + 50)
+	require.NoError(t, b.Commit(repo))
+
+	read, err := Read(repo, b.Id())
+	require.NoError(t, err)
+
+	_, n := read.Checkpoint()
+	require.Greater(t, n, 0)
+	requireCheckpointCompile(t, read)
+
+	// the operations after the checkpoint are applied on top of it
+	b = read
+	appendOps(30)
+	requireCheckpointCompile(t, b)
+	require.NoError(t, b.Commit(repo))
+
+	read, err = Read(repo, b.Id())
+	require.NoError(t, err)
+	requireCheckpointCompile(t, read)
+	require.Equal(t, create.Id(), read.Compile().Operations[0].Id())
+}
+
+// requireCheckpointCompile check that compiling the bug from its checkpoint
+// give the same result as applying all the operations
+func requireCheckpointCompile(t *testing.T, b *Bug) {
+	t.Helper()
+
+	_, _, ok := b.restoreCheckpoint(b.Operations())
+	require.True(t, ok)
+
+	require.Equal(t, b.replay(b.Operations(), nil), b.Compile())
+}
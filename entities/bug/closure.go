@@ -0,0 +1,136 @@
+package bug
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// ErrBugNotClosed is returned when asking how a bug that is open was closed
+var ErrBugNotClosed = errors.New("the bug is not closed")
+
+// closureWindow is how close to the closure a comment has to be posted to be
+// considered part of it
+const closureWindow = 10 * time.Minute
+
+// a commit hash, abbreviated or not, with at least one digit and one letter
+// to not mistake a number or a word for a hash
+var commitHashRegexp = regexp.MustCompile(`\b[0-9a-f]{7,40}\b`)
+var hasDigitRegexp = regexp.MustCompile(`[0-9]`)
+var hasLetterRegexp = regexp.MustCompile(`[a-f]`)
+
+// Closure is the provenance of the closing of a bug
+type Closure struct {
+	// OperationId is the id of the operation that closed the bug
+	OperationId entity.Id
+	Author      identity.Interface
+	Time        time.Time
+	// Comment is the comment posted with the closure by its author, if any
+	Comment *Comment
+	// Commits are the commit hashes referenced by the closing operation
+	// metadata or the comments posted around the closure, in order, without
+	// duplicates
+	Commits []string
+}
+
+// LastClosure return how the bug has been closed, that is the last operation
+// that closed it. It returns ErrBugNotClosed if the bug is open.
+func (snap *Snapshot) LastClosure() (Closure, error) {
+	if snap.Status != common.ClosedStatus {
+		return Closure{}, ErrBugNotClosed
+	}
+
+	var closing *SetStatusOperation
+	for i := len(snap.Operations) - 1; i >= 0; i-- {
+		if op, ok := snap.Operations[i].(*SetStatusOperation); ok && op.Status == common.ClosedStatus {
+			closing = op
+			break
+		}
+	}
+	if closing == nil {
+		return Closure{}, ErrBugNotClosed
+	}
+
+	closure := Closure{
+		OperationId: closing.Id(),
+		Author:      closing.Author(),
+		Time:        closing.Time(),
+	}
+
+	metadata := closing.AllMetadata()
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var commits []string
+	for _, key := range keys {
+		commits = append(commits, ReferencedCommits(metadata[key])...)
+	}
+
+	var nearby []Comment
+	for i := range snap.Comments[1:] {
+		comment := snap.Comments[i+1]
+		delta := comment.unixTime.Time().Sub(closure.Time)
+		if delta < -closureWindow || delta > closureWindow {
+			continue
+		}
+		nearby = append(nearby, comment)
+
+		if comment.Author.Id() != closure.Author.Id() {
+			continue
+		}
+		if closure.Comment == nil || absDuration(delta) < absDuration(closure.Comment.unixTime.Time().Sub(closure.Time)) {
+			closure.Comment = &snap.Comments[i+1]
+		}
+	}
+
+	if closure.Comment != nil {
+		commits = append(commits, ReferencedCommits(closure.Comment.Message)...)
+	}
+	for _, comment := range nearby {
+		commits = append(commits, ReferencedCommits(comment.Message)...)
+	}
+
+	closure.Commits = dedupStrings(commits)
+
+	return closure, nil
+}
+
+// ReferencedCommits return the commit hashes written in a text, like in
+// "fixed in 1a2b3c4", in order, without duplicates
+func ReferencedCommits(text string) []string {
+	var result []string
+	for _, match := range commitHashRegexp.FindAllString(text, -1) {
+		if hasDigitRegexp.MatchString(match) && hasLetterRegexp.MatchString(match) {
+			result = append(result, match)
+		}
+	}
+	return dedupStrings(result)
+}
+
+func dedupStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	var result []string
+	for _, value := range values {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+	return result
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,57 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLastClosure(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	reporter, err := identity.NewIdentity(repo, "Reporter", "reporter@example.com")
+	require.NoError(t, err)
+	maintainer, err := identity.NewIdentity(repo, "Maintainer", "maintainer@example.com")
+	require.NoError(t, err)
+
+	b, _, err := Create(reporter, 1000, "title", "crash in 1234567 and deadbeef", nil, nil)
+	require.NoError(t, err)
+
+	_, err = b.Compile().LastClosure()
+	require.ErrorIs(t, err, ErrBugNotClosed)
+
+	_, err = Close(b, maintainer, 2000, nil)
+	require.NoError(t, err)
+	_, err = Open(b, reporter, 3000, nil)
+	require.NoError(t, err)
+
+	_, _, err = AddComment(b, reporter, 10000, "still there in 0a1b2c3", nil, nil)
+	require.NoError(t, err)
+	_, _, err = AddComment(b, maintainer, 20000, "fixed by 9f8e7d6c5b4a", nil, nil)
+	require.NoError(t, err)
+	closing, err := Close(b, maintainer, 20000, map[string]string{"commit": "abcdef0123"})
+	require.NoError(t, err)
+	_, _, err = AddComment(b, reporter, 20100, "thanks, confirmed with 9f8e7d6c5b4a", nil, nil)
+	require.NoError(t, err)
+
+	closure, err := b.Compile().LastClosure()
+	require.NoError(t, err)
+	require.Equal(t, closing.Id(), closure.OperationId)
+	require.Equal(t, maintainer.Id(), closure.Author.Id())
+	require.Equal(t, int64(20000), closure.Time.Unix())
+	require.NotNil(t, closure.Comment)
+	require.Equal(t, "fixed by 9f8e7d6c5b4a", closure.Comment.Message)
+	// the description and the old comments are too far from the closure
+	require.Equal(t, []string{"abcdef0123", "9f8e7d6c5b4a"}, closure.Commits)
+}
+
+func TestReferencedCommits(t *testing.T) {
+	require.Equal(t, []string{"1a2b3c4", "0123456789abcdef0123456789abcdef01234567"},
+		ReferencedCommits("fixed in 1a2b3c4, see 0123456789abcdef0123456789abcdef01234567 and 1a2b3c4"))
+
+	// numbers, words and ids too long are not commits
+	require.Empty(t, ReferencedCommits("1234567 deadbeef facade 0123456789abcdef0123456789abcdef012345678"))
+}
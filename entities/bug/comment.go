@@ -22,6 +22,9 @@ type Comment struct {
 	Message string
 	Files   []repository.Hash
 
+	// Reactions are the emoji reactions to the comment
+	Reactions []ReactionGroup
+
 	// Creation time of the comment.
 	// Should be used only for human display, never for ordering as we can't rely on it in a distributed system.
 	unixTime timestamp.Timestamp
@@ -48,5 +51,15 @@ func (c Comment) FormatTime() string {
 	return c.unixTime.Time().Format("Mon Jan 2 15:04:05 2006 +0200")
 }
 
+// UnixTime return the creation time of the comment
+func (c Comment) UnixTime() timestamp.Timestamp {
+	return c.unixTime
+}
+
+// Structure return the code blocks, links and mentions of the message
+func (c Comment) Structure() MessageStructure {
+	return ParseMessageStructure(c.Message)
+}
+
 // IsAuthored is a sign post method for gqlgen
 func (c Comment) IsAuthored() {}
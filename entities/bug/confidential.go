@@ -0,0 +1,30 @@
+package bug
+
+import "github.com/MichaelMure/git-bug/entity/dag"
+
+// MetadataKeyConfidential is the operation metadata key flagging an operation
+// as confidential. Confidential operations are never pushed to public remotes
+// or exported through bridges, unless explicitly allowed.
+const MetadataKeyConfidential = "confidential"
+
+// ConfidentialMetadata return the metadata to attach to an operation to flag it
+// as confidential.
+func ConfidentialMetadata() map[string]string {
+	return map[string]string{MetadataKeyConfidential: "true"}
+}
+
+// IsConfidential return true if the operation has been flagged as confidential.
+func IsConfidential(op dag.Operation) bool {
+	val, ok := op.GetMetadata(MetadataKeyConfidential)
+	return ok && val == "true"
+}
+
+// HasConfidential return true if any operation of the bug is confidential.
+func (snap *Snapshot) HasConfidential() bool {
+	for _, op := range snap.Operations {
+		if IsConfidential(op) {
+			return true
+		}
+	}
+	return false
+}
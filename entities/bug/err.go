@@ -1,12 +1,10 @@
 package bug
 
 import (
-	"errors"
-
 	"github.com/MichaelMure/git-bug/entity"
 )
 
-var ErrBugNotExist = errors.New("bug doesn't exist")
+var ErrBugNotExist = entity.NewErrNotFound("bug")
 
 func NewErrMultipleMatchBug(matching []entity.Id) *entity.ErrMultipleMatch {
 	return entity.NewErrMultipleMatch("bug", matching)
@@ -0,0 +1,157 @@
+package bug
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// fieldConfigKeyPrefix is the git config prefix under which the custom fields
+// are defined, as in "git-bug.field.<name>.<key> = <value>"
+const fieldConfigKeyPrefix = "git-bug.field."
+
+const (
+	fieldConfigKeyType   = "type"
+	fieldConfigKeyValues = "values"
+)
+
+// FieldDateLayout is the format of the value of a date field
+const FieldDateLayout = "2006-01-02"
+
+// FieldType is the type of the value of a custom field
+type FieldType string
+
+const (
+	FieldTypeString FieldType = "string"
+	FieldTypeEnum   FieldType = "enum"
+	FieldTypeNumber FieldType = "number"
+	FieldTypeDate   FieldType = "date"
+)
+
+// FieldDefinition is a custom field of the bugs, as defined in the repository
+// configuration
+type FieldDefinition struct {
+	Name string
+	Type FieldType
+	// Values are the allowed values of an enum field
+	Values []string
+}
+
+// ValidateValue check that the value is valid for the field. An empty value,
+// which unset the field, is always valid.
+func (def FieldDefinition) ValidateValue(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	switch def.Type {
+	case FieldTypeString:
+		return nil
+	case FieldTypeEnum:
+		for _, v := range def.Values {
+			if v == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid value \"%s\" for field %s, expected one of %s",
+			value, def.Name, strings.Join(def.Values, ", "))
+	case FieldTypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("invalid value \"%s\" for field %s, expected a number", value, def.Name)
+		}
+		return nil
+	case FieldTypeDate:
+		if _, err := time.Parse(FieldDateLayout, value); err != nil {
+			return fmt.Errorf("invalid value \"%s\" for field %s, expected a date like %s",
+				value, def.Name, FieldDateLayout)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown type \"%s\" for field %s", def.Type, def.Name)
+	}
+}
+
+// LoadFieldDefinitions read the custom fields defined in the git config,
+// sorted by name.
+//
+// Each field is defined by a set of keys under "git-bug.field.<name>":
+//
+//	type    the type of the value: "string", "enum", "number" or "date"
+//	values  the allowed values of an enum, comma separated
+func LoadFieldDefinitions(config repository.ConfigRead) ([]FieldDefinition, error) {
+	raw, err := config.ReadAll(fieldConfigKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]map[string]string)
+	for key, value := range raw {
+		if !strings.HasPrefix(key, fieldConfigKeyPrefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(key, fieldConfigKeyPrefix)
+		i := strings.LastIndex(rest, ".")
+		if i <= 0 {
+			continue
+		}
+		name, key := rest[:i], rest[i+1:]
+		if byName[name] == nil {
+			byName[name] = make(map[string]string)
+		}
+		byName[name][key] = value
+	}
+
+	result := make([]FieldDefinition, 0, len(byName))
+	for name, keys := range byName {
+		if err := validateFieldName(name); err != nil {
+			return nil, err
+		}
+
+		def := FieldDefinition{
+			Name: name,
+			Type: FieldType(keys[fieldConfigKeyType]),
+		}
+
+		switch def.Type {
+		case FieldTypeString, FieldTypeNumber, FieldTypeDate:
+		case FieldTypeEnum:
+			for _, v := range strings.Split(keys[fieldConfigKeyValues], ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					def.Values = append(def.Values, v)
+				}
+			}
+			if len(def.Values) == 0 {
+				return nil, fmt.Errorf("field %s: an enum needs at least one value", name)
+			}
+		case "":
+			return nil, fmt.Errorf("field %s: missing type", name)
+		default:
+			return nil, fmt.Errorf("field %s: unknown type \"%s\"", name, def.Type)
+		}
+
+		result = append(result, def)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// validateFieldName check that a field name can be used in the configuration
+// and in a query, as in "field:<name>:<value>"
+func validateFieldName(name string) error {
+	if name == "" {
+		return fmt.Errorf("empty field name")
+	}
+	if !text.SafeOneLine(name) || strings.ContainsAny(name, " .:\"") {
+		return fmt.Errorf("invalid field name \"%s\"", name)
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package bug
 
 import (
 	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"image/color"
+	"strings"
 
 	fcolor "github.com/fatih/color"
 
@@ -70,6 +72,21 @@ func (lc LabelColor) RGBA() color.RGBA {
 	return color.RGBA(lc)
 }
 
+// LabelColorFromHex parse a color in the hexadecimal notation of the web,
+// with or without the leading "#", like "#d73a4a" or "d73a4a"
+func LabelColorFromHex(s string) (LabelColor, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(s, "#"))
+	if err != nil || len(raw) != 3 {
+		return LabelColor{}, fmt.Errorf("invalid color %q, expected #rrggbb", s)
+	}
+	return LabelColor{R: raw[0], G: raw[1], B: raw[2], A: 255}, nil
+}
+
+// Hex return the color in the hexadecimal notation of the web, like "#d73a4a"
+func (lc LabelColor) Hex() string {
+	return fmt.Sprintf("#%.2x%.2x%.2x", lc.R, lc.G, lc.B)
+}
+
 func (lc LabelColor) Term256() Term256 {
 	red := Term256(lc.R) * 6 / 256
 	green := Term256(lc.G) * 6 / 256
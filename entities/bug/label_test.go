@@ -33,3 +33,19 @@ func TestLabelRGBAEqual(t *testing.T) {
 
 	require.Equal(t, color1, color2)
 }
+
+func TestLabelColorHex(t *testing.T) {
+	c, err := LabelColorFromHex("#D73a4a")
+	require.NoError(t, err)
+	require.Equal(t, LabelColor{R: 215, G: 58, B: 74, A: 255}, c)
+	require.Equal(t, "#d73a4a", c.Hex())
+
+	c, err = LabelColorFromHex("0075ca")
+	require.NoError(t, err)
+	require.Equal(t, "#0075ca", c.Hex())
+
+	for _, invalid := range []string{"", "#fff", "#0075cag", "red"} {
+		_, err = LabelColorFromHex(invalid)
+		require.Error(t, err, invalid)
+	}
+}
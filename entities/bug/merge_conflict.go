@@ -0,0 +1,119 @@
+package bug
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/MichaelMure/git-bug/entity/dag"
+)
+
+// MergeConflict is a change of the bug overridden by a concurrent one, made on
+// another clone before both were merged. Merging never fails: the operations
+// are all applied, in the order decided when merging, so only the change of
+// the last one is visible. The conflict is recorded for a human to review
+// this automatic resolution.
+type MergeConflict struct {
+	// Field is what both operations changed, like "title", "status",
+	// "label bug", "field priority", "assignee <id>" or "comment <id>"
+	Field string
+	// Overridden is the operation whose change has been replaced
+	Overridden Operation
+	// OverriddenValue is the value set by the Overridden operation
+	OverriddenValue string
+	// Kept is the operation whose change is visible
+	Kept Operation
+	// KeptValue is the value set by the Kept operation
+	KeptValue string
+}
+
+// conflictingChanges return the values set by an operation that a
+// concurrent operation can override, by field. Other operations only add to
+// the bug and can't conflict.
+func conflictingChanges(op Operation) map[string]string {
+	switch op := op.(type) {
+	case *SetTitleOperation:
+		return map[string]string{"title": op.Title}
+
+	case *SetStatusOperation:
+		value := op.Status.String()
+		if op.State != "" {
+			value = fmt.Sprintf("%s (%s)", value, op.State)
+		}
+		return map[string]string{"status": value}
+
+	case *SetFieldOperation:
+		return map[string]string{"field " + op.Name: op.Value}
+
+	case *EditCommentOperation:
+		return map[string]string{"comment " + op.Target.String(): op.Message}
+
+	case *LabelChangeOperation:
+		changes := make(map[string]string, len(op.Added)+len(op.Removed))
+		for _, label := range op.Added {
+			changes["label "+label.String()] = "added"
+		}
+		for _, label := range op.Removed {
+			changes["label "+label.String()] = "removed"
+		}
+		return changes
+
+	case *SetAssigneeOperation:
+		changes := make(map[string]string, len(op.Added)+len(op.Removed))
+		for _, id := range op.Added {
+			changes["assignee "+id.String()] = "added"
+		}
+		for _, id := range op.Removed {
+			changes["assignee "+id.String()] = "removed"
+		}
+		return changes
+	}
+
+	return nil
+}
+
+// mergeConflicts find the operations whose change has been overridden by a
+// concurrent one, in the order the operations are applied
+func (bug *Bug) mergeConflicts(ops []dag.Operation) []MergeConflict {
+	type change struct {
+		op    Operation
+		value string
+	}
+	last := make(map[string]change)
+
+	var conflicts []MergeConflict
+
+	for _, op := range ops {
+		op, ok := op.(Operation)
+		if !ok {
+			continue
+		}
+
+		changes := conflictingChanges(op)
+		fields := make([]string, 0, len(changes))
+		for field := range changes {
+			fields = append(fields, field)
+		}
+		// for a stable result
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			value := changes[field]
+			previous, ok := last[field]
+			last[field] = change{op: op, value: value}
+
+			if !ok || previous.value == value || !bug.Concurrent(previous.op.Id(), op.Id()) {
+				continue
+			}
+
+			conflicts = append(conflicts, MergeConflict{
+				Field:           field,
+				Overridden:      previous.op,
+				OverriddenValue: previous.value,
+				Kept:            op,
+				KeptValue:       value,
+			})
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,56 @@
+package bug
+
+import (
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// MessageExternalizeThreshold is the size in bytes above which the message of an
+// operation is not stored inline in the operation pack, but in a separate git blob.
+// This keeps the operation packs small, and the merges fast, even when a bug
+// carries a very large text (think logs or stack traces pasted in a comment).
+var MessageExternalizeThreshold = 64 * 1024
+
+// messageRef return the reference to use for storing the given message outside
+// of the operation pack, or an empty Id if the message is small enough to be
+// stored inline.
+func messageRef(message string) entity.Id {
+	if len(message) <= MessageExternalizeThreshold {
+		return ""
+	}
+	return entity.DeriveId([]byte(message))
+}
+
+// externalMessage implement dag.OperationWithExternalData.GetExternalData for an
+// operation holding a single message.
+func externalMessage(ref entity.Id, message string) map[entity.Id][]byte {
+	if ref == "" {
+		return nil
+	}
+	return map[entity.Id][]byte{ref: []byte(message)}
+}
+
+// setExternalMessage implement dag.OperationWithExternalData.SetExternalData for
+// an operation holding a single message.
+func setExternalMessage(ref entity.Id, message *string, dataRef entity.Id, data []byte) error {
+	if ref == "" || ref != dataRef {
+		return fmt.Errorf("unexpected external data %s", dataRef)
+	}
+	*message = string(data)
+	return nil
+}
+
+// validateMessageRef check that an externalized message match its reference.
+func validateMessageRef(ref entity.Id, message string) error {
+	if ref == "" {
+		return nil
+	}
+	if err := ref.Validate(); err != nil {
+		return fmt.Errorf("invalid message reference: %v", err)
+	}
+	if !ref.IsDerivedFrom([]byte(message)) {
+		return fmt.Errorf("message doesn't match its reference")
+	}
+	return nil
+}
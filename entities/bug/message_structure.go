@@ -0,0 +1,183 @@
+package bug
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// CodeBlock is a fenced block of code in a markdown message
+type CodeBlock struct {
+	// Language is the language of the code, as given after the opening fence,
+	// or guessed from the code otherwise. It's empty if unknown.
+	Language string
+	// LanguageGuessed is true when the language has been guessed from the code
+	LanguageGuessed bool
+	Code            string
+}
+
+// MessageStructure is what a markdown message contains, for the clients to
+// highlight the code or extract reproduction snippets without parsing the
+// markdown themselves
+type MessageStructure struct {
+	CodeBlocks []CodeBlock
+	// Links are the URLs linked or written in the text, in order, without
+	// duplicates
+	Links []string
+	// Mentions are the logins mentioned with @login, in order, without
+	// duplicates
+	Mentions []string
+}
+
+var (
+	inlineCodeRegexp   = regexp.MustCompile("`+[^`]*`+")
+	markdownLinkRegexp = regexp.MustCompile(`\]\((https?://[^)\s]+)`)
+	bareLinkRegexp     = regexp.MustCompile(`https?://[^\s<>()\[\]"']+`)
+	mentionRegexp      = regexp.MustCompile(`(?:^|[^\w@./])@([A-Za-z0-9][A-Za-z0-9_-]*)`)
+)
+
+// ParseMessageStructure extract the code blocks, links and mentions of a
+// markdown message. The links and mentions in the code are ignored.
+func ParseMessageStructure(message string) MessageStructure {
+	result := MessageStructure{
+		CodeBlocks: []CodeBlock{},
+		Links:      []string{},
+		Mentions:   []string{},
+	}
+	var text strings.Builder
+
+	var block *CodeBlock
+	var fence string
+	var code []string
+
+	for _, line := range strings.Split(message, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if block == nil {
+			if f := openingFence(trimmed); f != "" {
+				fence = f
+				block = &CodeBlock{Language: fenceLanguage(trimmed[len(f):])}
+				code = nil
+				continue
+			}
+			text.WriteString(line)
+			text.WriteString("\n")
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			result.CodeBlocks = append(result.CodeBlocks, closeCodeBlock(block, code))
+			block = nil
+			continue
+		}
+		code = append(code, line)
+	}
+
+	// an unclosed fence run to the end of the message
+	if block != nil {
+		result.CodeBlocks = append(result.CodeBlocks, closeCodeBlock(block, code))
+	}
+
+	prose := inlineCodeRegexp.ReplaceAllString(text.String(), " ")
+
+	seenLinks := make(map[string]bool)
+	addLink := func(link string) {
+		link = strings.TrimRight(link, ".,;:!?")
+		if !seenLinks[link] {
+			seenLinks[link] = true
+			result.Links = append(result.Links, link)
+		}
+	}
+	for _, match := range markdownLinkRegexp.FindAllStringSubmatch(prose, -1) {
+		addLink(match[1])
+	}
+	for _, link := range bareLinkRegexp.FindAllString(prose, -1) {
+		addLink(link)
+	}
+
+	seenMentions := make(map[string]bool)
+	for _, match := range mentionRegexp.FindAllStringSubmatch(prose, -1) {
+		login := strings.TrimRight(match[1], "-_")
+		if !seenMentions[login] {
+			seenMentions[login] = true
+			result.Mentions = append(result.Mentions, login)
+		}
+	}
+
+	return result
+}
+
+// openingFence return the fence opening a code block, if the line is one
+func openingFence(line string) string {
+	for _, c := range []string{"`", "~"} {
+		n := len(line) - len(strings.TrimLeft(line, c))
+		if n >= 3 {
+			// the info string of a backtick fence can't contain a backtick
+			if c == "`" && strings.Contains(line[n:], "`") {
+				return ""
+			}
+			return line[:n]
+		}
+	}
+	return ""
+}
+
+// fenceLanguage return the language given by the info string of a fence,
+// like "go" in "```go title=main.go"
+func fenceLanguage(info string) string {
+	fields := strings.Fields(info)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToLower(strings.Trim(fields[0], "{}."))
+}
+
+func closeCodeBlock(block *CodeBlock, code []string) CodeBlock {
+	block.Code = strings.Join(code, "\n")
+	if block.Language == "" {
+		block.Language = guessLanguage(block.Code)
+		block.LanguageGuessed = block.Language != ""
+	}
+	return *block
+}
+
+// guessLanguage recognize the common languages of the code pasted in a bug,
+// with a few simple signs. It returns an empty string if unsure.
+func guessLanguage(code string) string {
+	trimmed := strings.TrimSpace(code)
+	firstLine, _, _ := strings.Cut(trimmed, "\n")
+
+	if strings.HasPrefix(firstLine, "#!") {
+		switch {
+		case strings.Contains(firstLine, "python"):
+			return "python"
+		case strings.Contains(firstLine, "node"):
+			return "javascript"
+		case strings.Contains(firstLine, "sh"):
+			return "shell"
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(trimmed, "<?php"):
+		return "php"
+	case strings.HasPrefix(trimmed, "Traceback (most recent call last):"):
+		return "python"
+	case strings.HasPrefix(trimmed, "diff --git") || strings.HasPrefix(trimmed, "--- a/"):
+		return "diff"
+	case strings.HasPrefix(trimmed, "$ "):
+		return "shell"
+	case (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(trimmed)):
+		return "json"
+	case strings.HasPrefix(trimmed, "package ") && strings.Contains(trimmed, "func "):
+		return "go"
+	case strings.HasPrefix(trimmed, "panic: ") || strings.HasPrefix(trimmed, "goroutine "):
+		return "go"
+	case strings.Contains(trimmed, "#include <"):
+		return "c"
+	case strings.HasPrefix(trimmed, "<") && strings.HasSuffix(trimmed, ">"):
+		return "html"
+	}
+
+	return ""
+}
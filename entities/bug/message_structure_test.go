@@ -0,0 +1,63 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMessageStructure(t *testing.T) {
+	message := "Crash when opening a bug, reported by @rene and @isaac-newton (cc @rene).\n" +
+		"See [the logs](https://example.com/logs) and https://example.com/issue/42.\n" +
+		"Not a mention: rene@descartes.fr, not a link: `https://example.com/code`\n" +
+		"\n" +
+		"```Go title=main.go\n" +
+		"fmt.Println(\"@notamention https://example.com/notalink\")\n" +
+		"```\n" +
+		"\n" +
+		"~~~~\n" +
+		"panic: runtime error: invalid memory address\n" +
+		"```\n" +
+		"~~~~\n" +
+		"\n" +
+		"```\n" +
+		"some output\n" +
+		"```\n" +
+		"```json\n" +
+		"{\"unclosed\": true}"
+
+	structure := ParseMessageStructure(message)
+
+	require.Equal(t, []string{"rene", "isaac-newton"}, structure.Mentions)
+	require.Equal(t, []string{"https://example.com/logs", "https://example.com/issue/42"}, structure.Links)
+	require.Equal(t, []CodeBlock{
+		{Language: "go", Code: "fmt.Println(\"@notamention https://example.com/notalink\")"},
+		{Language: "go", LanguageGuessed: true, Code: "panic: runtime error: invalid memory address\n```"},
+		{Code: "some output"},
+		{Language: "json", Code: "{\"unclosed\": true}"},
+	}, structure.CodeBlocks)
+
+	empty := ParseMessageStructure("")
+	require.Empty(t, empty.CodeBlocks)
+	require.NotNil(t, empty.Links)
+}
+
+func TestGuessLanguage(t *testing.T) {
+	cases := map[string]string{
+		"#!/usr/bin/env python3\nprint('hi')":        "python",
+		"#!/bin/bash\necho hi":                       "shell",
+		"$ git bug ls\nerror":                        "shell",
+		"Traceback (most recent call last):\n  File": "python",
+		"diff --git a/foo b/foo":                     "diff",
+		"{\"a\": [1, 2]}":                            "json",
+		"{not json":                                  "",
+		"package main\n\nfunc main() {}":             "go",
+		"#include <stdio.h>\nint main() {}":          "c",
+		"<div><p>hello</p></div>":                    "html",
+		"just some words":                            "",
+	}
+
+	for code, expected := range cases {
+		require.Equal(t, expected, guessLanguage(code), code)
+	}
+}
@@ -0,0 +1,90 @@
+package bug
+
+import (
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+var _ Operation = &AddAttachmentOperation{}
+var _ dag.OperationWithFiles = &AddAttachmentOperation{}
+
+// AddAttachmentOperation attach a file to the bug, after its creation. The
+// file is stored as a git blob, referenced by the operation so that it's
+// pushed and pulled along.
+type AddAttachmentOperation struct {
+	dag.OpBase
+	Name        string          `json:"name"`
+	Hash        repository.Hash `json:"hash"`
+	Size        int64           `json:"size"`
+	ContentType string          `json:"content_type"`
+}
+
+func (op *AddAttachmentOperation) Id() entity.Id {
+	return dag.IdOperation(op, &op.OpBase)
+}
+
+func (op *AddAttachmentOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.Author())
+
+	snapshot.Attachments = append(snapshot.Attachments, Attachment{
+		Name:        op.Name,
+		Hash:        op.Hash,
+		Size:        op.Size,
+		ContentType: op.ContentType,
+		Author:      op.Author(),
+		UnixTime:    timestamp.Timestamp(op.UnixTime),
+	})
+}
+
+func (op *AddAttachmentOperation) GetFiles() []repository.Hash {
+	return []repository.Hash{op.Hash}
+}
+
+func (op *AddAttachmentOperation) Validate() error {
+	if err := op.OpBase.Validate(op, AddAttachmentOp); err != nil {
+		return err
+	}
+
+	if err := validateAttachmentName(op.Name); err != nil {
+		return err
+	}
+
+	if !op.Hash.IsValid() {
+		return fmt.Errorf("invalid file hash")
+	}
+
+	if op.Size < 0 {
+		return fmt.Errorf("negative size")
+	}
+
+	return nil
+}
+
+func NewAddAttachmentOp(author identity.Interface, unixTime int64, name string, hash repository.Hash, size int64, contentType string) *AddAttachmentOperation {
+	return &AddAttachmentOperation{
+		OpBase:      newOpBase(AddAttachmentOp, author, unixTime),
+		Name:        name,
+		Hash:        hash,
+		Size:        size,
+		ContentType: contentType,
+	}
+}
+
+// AddAttachment is a convenience function to attach a file, already stored
+// in git, to a bug
+func AddAttachment(b Interface, author identity.Interface, unixTime int64, name string, hash repository.Hash, size int64, contentType string, metadata map[string]string) (*AddAttachmentOperation, error) {
+	op := NewAddAttachmentOp(author, unixTime, name, hash, size, contentType)
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
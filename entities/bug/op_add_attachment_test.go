@@ -0,0 +1,78 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestAddAttachment(t *testing.T) {
+	snapshot := Snapshot{}
+
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+
+	create := NewCreateOp(rene, unix, "title", "create", nil)
+	create.Apply(&snapshot)
+
+	logs, err := repo.StoreData([]byte("some logs"))
+	require.NoError(t, err)
+	trace, err := repo.StoreData([]byte("a trace"))
+	require.NoError(t, err)
+
+	NewAddAttachmentOp(rene, unix, "app.log", logs, 9, "text/plain").Apply(&snapshot)
+	NewAddAttachmentOp(rene, unix, "trace.txt", trace, 7, "text/plain").Apply(&snapshot)
+
+	require.Len(t, snapshot.Attachments, 2)
+	require.Equal(t, "app.log", snapshot.Attachments[0].Name)
+	require.Equal(t, logs, snapshot.Attachments[0].Hash)
+	require.Equal(t, int64(9), snapshot.Attachments[0].Size)
+	require.Equal(t, rene, snapshot.Attachments[0].Author)
+
+	found, err := snapshot.SearchAttachment("trace.txt")
+	require.NoError(t, err)
+	require.Equal(t, trace, found.Hash)
+
+	found, err = snapshot.SearchAttachment(string(logs)[:8])
+	require.NoError(t, err)
+	require.Equal(t, "app.log", found.Name)
+
+	_, err = snapshot.SearchAttachment("missing")
+	require.Error(t, err)
+}
+
+func TestAddAttachmentValidate(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	hash, err := repo.StoreData([]byte("data"))
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+
+	require.NoError(t, NewAddAttachmentOp(rene, unix, "file.txt", hash, 4, "text/plain").Validate())
+	require.Error(t, NewAddAttachmentOp(rene, unix, "", hash, 4, "text/plain").Validate())
+	require.Error(t, NewAddAttachmentOp(rene, unix, "../file.txt", hash, 4, "text/plain").Validate())
+	require.Error(t, NewAddAttachmentOp(rene, unix, "file.txt", "not a hash", 4, "text/plain").Validate())
+	require.Error(t, NewAddAttachmentOp(rene, unix, "file.txt", hash, -1, "text/plain").Validate())
+}
+
+func TestAddAttachmentSerialize(t *testing.T) {
+	hash := repository.Hash("3426a1488292d8f3f3c59ca679681336542b986f")
+
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*AddAttachmentOperation, entity.Resolvers) {
+		return NewAddAttachmentOp(author, unixTime, "file.txt", hash, 4, "text/plain"), nil
+	})
+}
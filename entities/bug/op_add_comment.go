@@ -1,6 +1,7 @@
 package bug
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/MichaelMure/git-bug/entities/identity"
@@ -13,11 +14,15 @@ import (
 
 var _ Operation = &AddCommentOperation{}
 var _ dag.OperationWithFiles = &AddCommentOperation{}
+var _ dag.OperationWithExternalData = &AddCommentOperation{}
 
 // AddCommentOperation will add a new comment in the bug
 type AddCommentOperation struct {
 	dag.OpBase
 	Message string `json:"message"`
+	// MessageRef is set when the message is too large to be stored inline,
+	// and is stored in a separate git blob instead.
+	MessageRef entity.Id `json:"message_ref,omitempty"`
 	// TODO: change for a map[string]util.hash to store the filename ?
 	Files []repository.Hash `json:"files"`
 }
@@ -30,6 +35,10 @@ func (op *AddCommentOperation) Apply(snapshot *Snapshot) {
 	snapshot.addActor(op.Author())
 	snapshot.addParticipant(op.Author())
 
+	if snapshot.Author != nil && op.Author().Id() == snapshot.Author.Id() {
+		snapshot.AwaitingReporter = false
+	}
+
 	opId := op.Id()
 
 	comment := Comment{
@@ -54,6 +63,24 @@ func (op *AddCommentOperation) GetFiles() []repository.Hash {
 	return op.Files
 }
 
+func (op *AddCommentOperation) GetExternalData() map[entity.Id][]byte {
+	return externalMessage(op.MessageRef, op.Message)
+}
+
+func (op *AddCommentOperation) SetExternalData(ref entity.Id, data []byte) error {
+	return setExternalMessage(op.MessageRef, &op.Message, ref, data)
+}
+
+// MarshalJSON exclude the message from the serialized data when it's stored externally
+func (op *AddCommentOperation) MarshalJSON() ([]byte, error) {
+	type alias AddCommentOperation
+	aux := alias(*op)
+	if aux.MessageRef != "" {
+		aux.Message = ""
+	}
+	return json.Marshal(aux)
+}
+
 func (op *AddCommentOperation) Validate() error {
 	if err := op.OpBase.Validate(op, AddCommentOp); err != nil {
 		return err
@@ -63,14 +90,19 @@ func (op *AddCommentOperation) Validate() error {
 		return fmt.Errorf("message is not fully printable")
 	}
 
+	if err := validateMessageRef(op.MessageRef, op.Message); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func NewAddCommentOp(author identity.Interface, unixTime int64, message string, files []repository.Hash) *AddCommentOperation {
 	return &AddCommentOperation{
-		OpBase:  dag.NewOpBase(AddCommentOp, author, unixTime),
-		Message: message,
-		Files:   files,
+		OpBase:     newOpBase(AddCommentOp, author, unixTime),
+		Message:    message,
+		MessageRef: messageRef(message),
+		Files:      files,
 	}
 }
 
@@ -1,6 +1,7 @@
 package bug
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/MichaelMure/git-bug/entities/identity"
@@ -13,13 +14,17 @@ import (
 
 var _ Operation = &CreateOperation{}
 var _ dag.OperationWithFiles = &CreateOperation{}
+var _ dag.OperationWithExternalData = &CreateOperation{}
 
 // CreateOperation define the initial creation of a bug
 type CreateOperation struct {
 	dag.OpBase
-	Title   string            `json:"title"`
-	Message string            `json:"message"`
-	Files   []repository.Hash `json:"files"`
+	Title   string `json:"title"`
+	Message string `json:"message"`
+	// MessageRef is set when the message is too large to be stored inline,
+	// and is stored in a separate git blob instead.
+	MessageRef entity.Id         `json:"message_ref,omitempty"`
+	Files      []repository.Hash `json:"files"`
 }
 
 func (op *CreateOperation) Id() entity.Id {
@@ -64,6 +69,24 @@ func (op *CreateOperation) GetFiles() []repository.Hash {
 	return op.Files
 }
 
+func (op *CreateOperation) GetExternalData() map[entity.Id][]byte {
+	return externalMessage(op.MessageRef, op.Message)
+}
+
+func (op *CreateOperation) SetExternalData(ref entity.Id, data []byte) error {
+	return setExternalMessage(op.MessageRef, &op.Message, ref, data)
+}
+
+// MarshalJSON exclude the message from the serialized data when it's stored externally
+func (op *CreateOperation) MarshalJSON() ([]byte, error) {
+	type alias CreateOperation
+	aux := alias(*op)
+	if aux.MessageRef != "" {
+		aux.Message = ""
+	}
+	return json.Marshal(aux)
+}
+
 func (op *CreateOperation) Validate() error {
 	if err := op.OpBase.Validate(op, CreateOp); err != nil {
 		return err
@@ -80,15 +103,20 @@ func (op *CreateOperation) Validate() error {
 		return fmt.Errorf("message is not fully printable")
 	}
 
+	if err := validateMessageRef(op.MessageRef, op.Message); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func NewCreateOp(author identity.Interface, unixTime int64, title, message string, files []repository.Hash) *CreateOperation {
 	return &CreateOperation{
-		OpBase:  dag.NewOpBase(CreateOp, author, unixTime),
-		Title:   title,
-		Message: message,
-		Files:   files,
+		OpBase:     newOpBase(CreateOp, author, unixTime),
+		Title:      title,
+		Message:    message,
+		MessageRef: messageRef(message),
+		Files:      files,
 	}
 }
 
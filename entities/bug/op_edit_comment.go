@@ -1,6 +1,7 @@
 package bug
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -16,13 +17,17 @@ import (
 
 var _ Operation = &EditCommentOperation{}
 var _ dag.OperationWithFiles = &EditCommentOperation{}
+var _ dag.OperationWithExternalData = &EditCommentOperation{}
 
 // EditCommentOperation will change a comment in the bug
 type EditCommentOperation struct {
 	dag.OpBase
-	Target  entity.Id         `json:"target"`
-	Message string            `json:"message"`
-	Files   []repository.Hash `json:"files"`
+	Target  entity.Id `json:"target"`
+	Message string    `json:"message"`
+	// MessageRef is set when the message is too large to be stored inline,
+	// and is stored in a separate git blob instead.
+	MessageRef entity.Id         `json:"message_ref,omitempty"`
+	Files      []repository.Hash `json:"files"`
 }
 
 func (op *EditCommentOperation) Id() entity.Id {
@@ -61,6 +66,10 @@ func (op *EditCommentOperation) Apply(snapshot *Snapshot) {
 	case *CreateTimelineItem:
 		target.Append(comment)
 	case *AddCommentTimelineItem:
+		if target.Deleted {
+			// a deleted comment can't be edited anymore
+			return
+		}
 		target.Append(comment)
 	default:
 		// somehow, the target matched on something that is not a comment
@@ -85,6 +94,24 @@ func (op *EditCommentOperation) GetFiles() []repository.Hash {
 	return op.Files
 }
 
+func (op *EditCommentOperation) GetExternalData() map[entity.Id][]byte {
+	return externalMessage(op.MessageRef, op.Message)
+}
+
+func (op *EditCommentOperation) SetExternalData(ref entity.Id, data []byte) error {
+	return setExternalMessage(op.MessageRef, &op.Message, ref, data)
+}
+
+// MarshalJSON exclude the message from the serialized data when it's stored externally
+func (op *EditCommentOperation) MarshalJSON() ([]byte, error) {
+	type alias EditCommentOperation
+	aux := alias(*op)
+	if aux.MessageRef != "" {
+		aux.Message = ""
+	}
+	return json.Marshal(aux)
+}
+
 func (op *EditCommentOperation) Validate() error {
 	if err := op.OpBase.Validate(op, EditCommentOp); err != nil {
 		return err
@@ -98,15 +125,20 @@ func (op *EditCommentOperation) Validate() error {
 		return fmt.Errorf("message is not fully printable")
 	}
 
+	if err := validateMessageRef(op.MessageRef, op.Message); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func NewEditCommentOp(author identity.Interface, unixTime int64, target entity.Id, message string, files []repository.Hash) *EditCommentOperation {
 	return &EditCommentOperation{
-		OpBase:  dag.NewOpBase(EditCommentOp, author, unixTime),
-		Target:  target,
-		Message: message,
-		Files:   files,
+		OpBase:     newOpBase(EditCommentOp, author, unixTime),
+		Target:     target,
+		Message:    message,
+		MessageRef: messageRef(message),
+		Files:      files,
 	}
 }
 
@@ -125,6 +157,5 @@ func EditComment(b Interface, author identity.Interface, unixTime int64, target
 
 // EditCreateComment is a convenience function to edit the body of a bug (the first comment)
 func EditCreateComment(b Interface, author identity.Interface, unixTime int64, message string, files []repository.Hash, metadata map[string]string) (entity.CombinedId, *EditCommentOperation, error) {
-	createOp := b.FirstOp().(*CreateOperation)
-	return EditComment(b, author, unixTime, createOp.Id(), message, files, metadata)
+	return EditComment(b, author, unixTime, b.FirstOp().Id(), message, files, metadata)
 }
@@ -98,7 +98,7 @@ func (op *LabelChangeOperation) Validate() error {
 
 func NewLabelChangeOperation(author identity.Interface, unixTime int64, added, removed []Label) *LabelChangeOperation {
 	return &LabelChangeOperation{
-		OpBase:  dag.NewOpBase(LabelChangeOp, author, unixTime),
+		OpBase:  newOpBase(LabelChangeOp, author, unixTime),
 		Added:   added,
 		Removed: removed,
 	}
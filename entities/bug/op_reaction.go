@@ -0,0 +1,110 @@
+package bug
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+)
+
+var _ Operation = &ReactionOperation{}
+
+// ReactionOperation add or remove the reaction of its author to a comment
+type ReactionOperation struct {
+	dag.OpBase
+	Target   entity.Id `json:"target"`
+	Reaction Reaction  `json:"reaction"`
+	Remove   bool      `json:"remove,omitempty"`
+}
+
+func (op *ReactionOperation) Id() entity.Id {
+	return dag.IdOperation(op, &op.OpBase)
+}
+
+func (op *ReactionOperation) Apply(snapshot *Snapshot) {
+	combinedId := entity.CombineIds(snapshot.Id(), op.Target)
+
+	var item *CommentTimelineItem
+	for _, timelineItem := range snapshot.Timeline {
+		if timelineItem.CombinedId() != combinedId {
+			continue
+		}
+		switch timelineItem := timelineItem.(type) {
+		case *CreateTimelineItem:
+			item = &timelineItem.CommentTimelineItem
+		case *AddCommentTimelineItem:
+			item = &timelineItem.CommentTimelineItem
+		}
+		break
+	}
+
+	if item == nil || item.Deleted {
+		// Target not found, or deleted: the reaction is a no-op
+		return
+	}
+
+	snapshot.addActor(op.Author())
+
+	if op.Remove {
+		item.Reactions = removeReaction(item.Reactions, op.Reaction, op.Author())
+	} else {
+		item.Reactions = addReaction(item.Reactions, op.Reaction, op.Author())
+	}
+
+	for i := range snapshot.Comments {
+		if snapshot.Comments[i].CombinedId() == combinedId {
+			snapshot.Comments[i].Reactions = item.Reactions
+			break
+		}
+	}
+}
+
+func (op *ReactionOperation) Validate() error {
+	if err := op.OpBase.Validate(op, ReactionOp); err != nil {
+		return err
+	}
+
+	if err := op.Target.Validate(); err != nil {
+		return errors.Wrap(err, "target hash is invalid")
+	}
+
+	if err := op.Reaction.Validate(); err != nil {
+		return errors.Wrap(err, "reaction")
+	}
+
+	return nil
+}
+
+func NewReactionOp(author identity.Interface, unixTime int64, target entity.Id, reaction Reaction, remove bool) *ReactionOperation {
+	return &ReactionOperation{
+		OpBase:   newOpBase(ReactionOp, author, unixTime),
+		Target:   target,
+		Reaction: reaction,
+		Remove:   remove,
+	}
+}
+
+// AddReaction is a convenience function to add the reaction of the author to a
+// comment
+func AddReaction(b Interface, author identity.Interface, unixTime int64, target entity.Id, reaction Reaction, metadata map[string]string) (*ReactionOperation, error) {
+	return react(b, author, unixTime, target, reaction, false, metadata)
+}
+
+// RemoveReaction is a convenience function to remove the reaction of the
+// author to a comment
+func RemoveReaction(b Interface, author identity.Interface, unixTime int64, target entity.Id, reaction Reaction, metadata map[string]string) (*ReactionOperation, error) {
+	return react(b, author, unixTime, target, reaction, true, metadata)
+}
+
+func react(b Interface, author identity.Interface, unixTime int64, target entity.Id, reaction Reaction, remove bool, metadata map[string]string) (*ReactionOperation, error) {
+	op := NewReactionOp(author, unixTime, target, reaction, remove)
+	for key, val := range metadata {
+		op.SetMetadata(key, val)
+	}
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
@@ -0,0 +1,91 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestReaction(t *testing.T) {
+	snapshot := Snapshot{}
+
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	isaac, err := identity.NewIdentity(repo, "Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+
+	create := NewCreateOp(rene, unix, "title", "create", nil)
+	create.Apply(&snapshot)
+
+	comment := NewAddCommentOp(rene, unix, "comment", nil)
+	comment.Apply(&snapshot)
+
+	NewReactionOp(rene, unix, comment.Id(), ReactionHeart, false).Apply(&snapshot)
+	NewReactionOp(isaac, unix, comment.Id(), ReactionThumbsUp, false).Apply(&snapshot)
+	NewReactionOp(isaac, unix, comment.Id(), ReactionHeart, false).Apply(&snapshot)
+	// reacting twice is a no-op
+	NewReactionOp(isaac, unix, comment.Id(), ReactionHeart, false).Apply(&snapshot)
+	NewReactionOp(isaac, unix, create.Id(), ReactionRocket, false).Apply(&snapshot)
+
+	item := snapshot.Timeline[1].(*AddCommentTimelineItem)
+	require.Len(t, item.Reactions, 2)
+	require.Equal(t, ReactionHeart, item.Reactions[0].Reaction)
+	require.Len(t, item.Reactions[0].Authors, 2)
+	require.Equal(t, ReactionThumbsUp, item.Reactions[1].Reaction)
+	require.Len(t, item.Reactions[1].Authors, 1)
+	require.Equal(t, item.Reactions, snapshot.Comments[1].Reactions)
+
+	require.Len(t, snapshot.Comments[0].Reactions, 1)
+	require.Equal(t, ReactionRocket, snapshot.Comments[0].Reactions[0].Reaction)
+
+	// an edition keep the reactions
+	NewEditCommentOp(rene, unix, comment.Id(), "edited", nil).Apply(&snapshot)
+	require.Len(t, snapshot.Comments[1].Reactions, 2)
+
+	NewReactionOp(isaac, unix, comment.Id(), ReactionHeart, true).Apply(&snapshot)
+	NewReactionOp(isaac, unix, comment.Id(), ReactionThumbsUp, true).Apply(&snapshot)
+
+	require.Len(t, item.Reactions, 1)
+	require.Equal(t, ReactionHeart, item.Reactions[0].Reaction)
+	require.Equal(t, rene.Id(), item.Reactions[0].Authors[0].Id())
+	require.Equal(t, item.Reactions, snapshot.Comments[1].Reactions)
+
+	// a deleted comment can't get reactions
+	NewRedactCommentOp(rene, unix, comment.Id(), true).Apply(&snapshot)
+	NewReactionOp(isaac, unix, comment.Id(), ReactionEyes, false).Apply(&snapshot)
+	require.Len(t, item.Reactions, 1)
+}
+
+func TestReactionFromString(t *testing.T) {
+	for _, str := range []string{"heart", "HEART", ":heart:", "❤️", "❤"} {
+		reaction, err := ReactionFromString(str)
+		require.NoError(t, err)
+		require.Equal(t, ReactionHeart, reaction)
+	}
+
+	reaction, err := ReactionFromString("+1")
+	require.NoError(t, err)
+	require.Equal(t, ReactionThumbsUp, reaction)
+
+	_, err = ReactionFromString("unicorn")
+	require.Error(t, err)
+}
+
+func TestReactionSerialize(t *testing.T) {
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*ReactionOperation, entity.Resolvers) {
+		return NewReactionOp(author, unixTime, "target", ReactionHooray, false), nil
+	})
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*ReactionOperation, entity.Resolvers) {
+		return NewReactionOp(author, unixTime, "target", ReactionHooray, true), nil
+	})
+}
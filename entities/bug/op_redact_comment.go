@@ -0,0 +1,127 @@
+package bug
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+var _ Operation = &RedactCommentOperation{}
+
+// RedactedMessage replace the message, and its previous versions, of a
+// redacted or deleted comment
+const RedactedMessage = "[redacted]"
+
+// RedactCommentOperation retract a comment. Its message, its previous
+// versions and its files are hidden from the bug, and the comment is either
+// kept as redacted, or removed from the comments if deleted. The description
+// of the bug can only be redacted.
+//
+// As the history of a bug is never rewritten, the operations holding the
+// content are still stored in git: a secret that has been pushed should be
+// considered as leaked anyway.
+type RedactCommentOperation struct {
+	dag.OpBase
+	Target entity.Id `json:"target"`
+	Delete bool      `json:"delete,omitempty"`
+}
+
+func (op *RedactCommentOperation) Id() entity.Id {
+	return dag.IdOperation(op, &op.OpBase)
+}
+
+func (op *RedactCommentOperation) Apply(snapshot *Snapshot) {
+	// Todo: currently any message can be redacted, even by a different author
+	// crypto signature are needed.
+
+	combinedId := entity.CombineIds(snapshot.Id(), op.Target)
+
+	var item *CommentTimelineItem
+	deletable := false
+	for _, timelineItem := range snapshot.Timeline {
+		if timelineItem.CombinedId() != combinedId {
+			continue
+		}
+		switch timelineItem := timelineItem.(type) {
+		case *CreateTimelineItem:
+			item = &timelineItem.CommentTimelineItem
+		case *AddCommentTimelineItem:
+			item = &timelineItem.CommentTimelineItem
+			deletable = true
+		}
+		break
+	}
+
+	if item == nil || item.Deleted {
+		// Target not found, or already gone: the redaction is a no-op
+		return
+	}
+
+	snapshot.addActor(op.Author())
+
+	deleted := op.Delete && deletable
+	item.Redact(deleted, timestamp.Timestamp(op.UnixTime))
+
+	for i := range snapshot.Comments {
+		if snapshot.Comments[i].CombinedId() != combinedId {
+			continue
+		}
+		if deleted {
+			snapshot.Comments = append(snapshot.Comments[:i], snapshot.Comments[i+1:]...)
+		} else {
+			snapshot.Comments[i].Message = RedactedMessage
+			snapshot.Comments[i].Files = nil
+		}
+		break
+	}
+}
+
+func (op *RedactCommentOperation) Validate() error {
+	if err := op.OpBase.Validate(op, RedactCommentOp); err != nil {
+		return err
+	}
+
+	if err := op.Target.Validate(); err != nil {
+		return errors.Wrap(err, "target hash is invalid")
+	}
+
+	return nil
+}
+
+func NewRedactCommentOp(author identity.Interface, unixTime int64, target entity.Id, delete bool) *RedactCommentOperation {
+	return &RedactCommentOperation{
+		OpBase: newOpBase(RedactCommentOp, author, unixTime),
+		Target: target,
+		Delete: delete,
+	}
+}
+
+// RedactComment is a convenience function to apply the operation, hiding the
+// content of a comment. The comment can be edited again later.
+func RedactComment(b Interface, author identity.Interface, unixTime int64, target entity.Id, metadata map[string]string) (entity.CombinedId, *RedactCommentOperation, error) {
+	return redactComment(b, author, unixTime, target, false, metadata)
+}
+
+// DeleteComment is a convenience function to apply the operation, removing a
+// comment from the bug. The description of the bug can't be deleted.
+func DeleteComment(b Interface, author identity.Interface, unixTime int64, target entity.Id, metadata map[string]string) (entity.CombinedId, *RedactCommentOperation, error) {
+	if target == b.FirstOp().Id() {
+		return entity.UnsetCombinedId, nil, errors.New("the description of a bug can't be deleted, only redacted")
+	}
+	return redactComment(b, author, unixTime, target, true, metadata)
+}
+
+func redactComment(b Interface, author identity.Interface, unixTime int64, target entity.Id, delete bool, metadata map[string]string) (entity.CombinedId, *RedactCommentOperation, error) {
+	op := NewRedactCommentOp(author, unixTime, target, delete)
+	for key, val := range metadata {
+		op.SetMetadata(key, val)
+	}
+	if err := op.Validate(); err != nil {
+		return entity.UnsetCombinedId, nil, err
+	}
+	b.Append(op)
+	return entity.CombineIds(b.Id(), target), op, nil
+}
@@ -0,0 +1,92 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestRedact(t *testing.T) {
+	snapshot := Snapshot{}
+
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+
+	create := NewCreateOp(rene, unix, "title", "create", nil)
+	create.Apply(&snapshot)
+
+	comment1 := NewAddCommentOp(rene, unix, "secret", []repository.Hash{"hash1"})
+	comment1.Apply(&snapshot)
+
+	edit := NewEditCommentOp(rene, unix, comment1.Id(), "secret again", nil)
+	edit.Apply(&snapshot)
+
+	comment2 := NewAddCommentOp(rene, unix, "comment 2", nil)
+	comment2.Apply(&snapshot)
+
+	redact := NewRedactCommentOp(rene, unix, comment1.Id(), false)
+	redact.Apply(&snapshot)
+
+	item1 := snapshot.Timeline[1].(*AddCommentTimelineItem)
+	require.True(t, item1.Redacted)
+	require.False(t, item1.Deleted)
+	require.Equal(t, RedactedMessage, item1.Message)
+	require.Empty(t, item1.Files)
+	require.Len(t, item1.History, 2)
+	for _, step := range item1.History {
+		require.Equal(t, RedactedMessage, step.Message)
+	}
+	require.Len(t, snapshot.Comments, 3)
+	require.Equal(t, RedactedMessage, snapshot.Comments[1].Message)
+	require.Empty(t, snapshot.Comments[1].Files)
+
+	// a redacted comment can be edited again
+	edit2 := NewEditCommentOp(rene, unix, comment1.Id(), "cleaned", nil)
+	edit2.Apply(&snapshot)
+	require.Equal(t, "cleaned", snapshot.Comments[1].Message)
+
+	del := NewRedactCommentOp(rene, unix, comment2.Id(), true)
+	del.Apply(&snapshot)
+
+	item2 := snapshot.Timeline[2].(*AddCommentTimelineItem)
+	require.True(t, item2.Deleted)
+	require.Equal(t, RedactedMessage, item2.Message)
+	require.Len(t, snapshot.Comments, 2)
+	require.Equal(t, "create", snapshot.Comments[0].Message)
+	require.Equal(t, "cleaned", snapshot.Comments[1].Message)
+
+	// a deleted comment can't be edited anymore
+	edit3 := NewEditCommentOp(rene, unix, comment2.Id(), "back again", nil)
+	edit3.Apply(&snapshot)
+	require.Equal(t, RedactedMessage, item2.Message)
+	require.Len(t, snapshot.Comments, 2)
+
+	// the description of the bug is only redacted, even if deleted
+	delCreate := NewRedactCommentOp(rene, unix, create.Id(), true)
+	delCreate.Apply(&snapshot)
+
+	createItem := snapshot.Timeline[0].(*CreateTimelineItem)
+	require.True(t, createItem.Redacted)
+	require.False(t, createItem.Deleted)
+	require.Len(t, snapshot.Comments, 2)
+	require.Equal(t, RedactedMessage, snapshot.Comments[0].Message)
+}
+
+func TestRedactCommentSerialize(t *testing.T) {
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*RedactCommentOperation, entity.Resolvers) {
+		return NewRedactCommentOp(author, unixTime, "target", false), nil
+	})
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*RedactCommentOperation, entity.Resolvers) {
+		return NewRedactCommentOp(author, unixTime, "target", true), nil
+	})
+}
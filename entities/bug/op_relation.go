@@ -0,0 +1,113 @@
+package bug
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+)
+
+var _ Operation = &RelationAddOperation{}
+var _ Operation = &RelationRemoveOperation{}
+
+// RelationAddOperation link the bug to another one, with a relation type.
+// The other bug is referenced by its id, and is not changed.
+type RelationAddOperation struct {
+	dag.OpBase
+	Relation RelationType `json:"relation"`
+	Target   entity.Id    `json:"target"`
+}
+
+func (op *RelationAddOperation) Id() entity.Id {
+	return dag.IdOperation(op, &op.OpBase)
+}
+
+func (op *RelationAddOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.Author())
+	snapshot.Relations = addRelation(snapshot.Relations, Relation{Type: op.Relation, Target: op.Target})
+}
+
+func (op *RelationAddOperation) Validate() error {
+	if err := op.OpBase.Validate(op, RelationAddOp); err != nil {
+		return err
+	}
+	return validateRelation(op.Relation, op.Target)
+}
+
+func NewRelationAddOp(author identity.Interface, unixTime int64, relType RelationType, target entity.Id) *RelationAddOperation {
+	return &RelationAddOperation{
+		OpBase:   newOpBase(RelationAddOp, author, unixTime),
+		Relation: relType,
+		Target:   target,
+	}
+}
+
+// RelationRemoveOperation remove a link from the bug to another one. Removing
+// a link that doesn't exist is a no-op.
+type RelationRemoveOperation struct {
+	dag.OpBase
+	Relation RelationType `json:"relation"`
+	Target   entity.Id    `json:"target"`
+}
+
+func (op *RelationRemoveOperation) Id() entity.Id {
+	return dag.IdOperation(op, &op.OpBase)
+}
+
+func (op *RelationRemoveOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.Author())
+	snapshot.Relations = removeRelation(snapshot.Relations, Relation{Type: op.Relation, Target: op.Target})
+}
+
+func (op *RelationRemoveOperation) Validate() error {
+	if err := op.OpBase.Validate(op, RelationRemoveOp); err != nil {
+		return err
+	}
+	return validateRelation(op.Relation, op.Target)
+}
+
+func NewRelationRemoveOp(author identity.Interface, unixTime int64, relType RelationType, target entity.Id) *RelationRemoveOperation {
+	return &RelationRemoveOperation{
+		OpBase:   newOpBase(RelationRemoveOp, author, unixTime),
+		Relation: relType,
+		Target:   target,
+	}
+}
+
+func validateRelation(relType RelationType, target entity.Id) error {
+	if err := relType.Validate(); err != nil {
+		return err
+	}
+	if err := target.Validate(); err != nil {
+		return errors.Wrap(err, "target bug id is invalid")
+	}
+	return nil
+}
+
+// AddRelation is a convenience function to link a bug to another one
+func AddRelation(b Interface, author identity.Interface, unixTime int64, relType RelationType, target entity.Id, metadata map[string]string) (*RelationAddOperation, error) {
+	op := NewRelationAddOp(author, unixTime, relType, target)
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
+
+// RemoveRelation is a convenience function to remove a link from a bug to
+// another one
+func RemoveRelation(b Interface, author identity.Interface, unixTime int64, relType RelationType, target entity.Id, metadata map[string]string) (*RelationRemoveOperation, error) {
+	op := NewRelationRemoveOp(author, unixTime, relType, target)
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
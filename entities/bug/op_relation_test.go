@@ -0,0 +1,85 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestRelation(t *testing.T) {
+	snapshot := Snapshot{}
+
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+
+	create := NewCreateOp(rene, unix, "title", "create", nil)
+	create.Apply(&snapshot)
+
+	other := entity.DeriveId([]byte("other"))
+	another := entity.DeriveId([]byte("another"))
+
+	NewRelationAddOp(rene, unix, RelationRelatedTo, other).Apply(&snapshot)
+	NewRelationAddOp(rene, unix, RelationBlocks, another).Apply(&snapshot)
+	NewRelationAddOp(rene, unix, RelationBlocks, other).Apply(&snapshot)
+	// adding twice is a no-op
+	NewRelationAddOp(rene, unix, RelationBlocks, other).Apply(&snapshot)
+
+	require.Len(t, snapshot.Relations, 3)
+	require.Equal(t, RelationBlocks, snapshot.Relations[0].Type)
+	require.Equal(t, RelationBlocks, snapshot.Relations[1].Type)
+	require.Equal(t, RelationRelatedTo, snapshot.Relations[2].Type)
+	require.ElementsMatch(t, []entity.Id{other, another}, snapshot.RelatedBugs(RelationBlocks))
+	require.Equal(t, []entity.Id{other}, snapshot.RelatedBugs(RelationRelatedTo))
+	require.Empty(t, snapshot.RelatedBugs(RelationDuplicateOf))
+
+	NewRelationRemoveOp(rene, unix, RelationBlocks, other).Apply(&snapshot)
+	// removing a missing relation is a no-op
+	NewRelationRemoveOp(rene, unix, RelationDuplicateOf, other).Apply(&snapshot)
+
+	require.Equal(t, []Relation{
+		{Type: RelationBlocks, Target: another},
+		{Type: RelationRelatedTo, Target: other},
+	}, snapshot.Relations)
+}
+
+func TestRelationSerialize(t *testing.T) {
+	target := entity.DeriveId([]byte("target"))
+
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*RelationAddOperation, entity.Resolvers) {
+		return NewRelationAddOp(author, unixTime, RelationBlocks, target), nil
+	})
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*RelationRemoveOperation, entity.Resolvers) {
+		return NewRelationRemoveOp(author, unixTime, RelationDuplicateOf, target), nil
+	})
+}
+
+func TestRelationValidate(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	target := entity.DeriveId([]byte("target"))
+
+	require.NoError(t, NewRelationAddOp(rene, 1, RelationBlocks, target).Validate())
+	require.Error(t, NewRelationAddOp(rene, 1, "depends", target).Validate())
+	require.Error(t, NewRelationAddOp(rene, 1, RelationBlocks, "invalid").Validate())
+	require.Error(t, NewRelationRemoveOp(rene, 1, "", target).Validate())
+
+	relType, err := RelationTypeFromString(" Duplicate-Of ")
+	require.NoError(t, err)
+	require.Equal(t, RelationDuplicateOf, relType)
+
+	_, err = RelationTypeFromString("depends-on")
+	require.Error(t, err)
+}
@@ -0,0 +1,47 @@
+package bug
+
+import (
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+)
+
+var _ Operation = &RequestInfoOperation{}
+
+// RequestInfoOperation flag a bug as awaiting more information from its reporter.
+// The flag is automatically lifted when the reporter comments on the bug.
+type RequestInfoOperation struct {
+	dag.OpBase
+}
+
+func (op *RequestInfoOperation) Id() entity.Id {
+	return dag.IdOperation(op, &op.OpBase)
+}
+
+func (op *RequestInfoOperation) Apply(snapshot *Snapshot) {
+	snapshot.AwaitingReporter = true
+	snapshot.addActor(op.Author())
+}
+
+func (op *RequestInfoOperation) Validate() error {
+	return op.OpBase.Validate(op, RequestInfoOp)
+}
+
+func NewRequestInfoOp(author identity.Interface, unixTime int64) *RequestInfoOperation {
+	return &RequestInfoOperation{
+		OpBase: newOpBase(RequestInfoOp, author, unixTime),
+	}
+}
+
+// RequestInfo is a convenience function to ask the reporter of a bug for more information
+func RequestInfo(b Interface, author identity.Interface, unixTime int64, metadata map[string]string) (*RequestInfoOperation, error) {
+	op := NewRequestInfoOp(author, unixTime)
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
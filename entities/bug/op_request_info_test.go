@@ -0,0 +1,44 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestRequestInfoSerialize(t *testing.T) {
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*RequestInfoOperation, entity.Resolvers) {
+		return NewRequestInfoOp(author, unixTime), nil
+	})
+}
+
+func TestRequestInfo(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	reporter, err := identity.NewIdentity(repo, "Reporter", "reporter@example.com")
+	require.NoError(t, err)
+	maintainer, err := identity.NewIdentity(repo, "Maintainer", "maintainer@example.com")
+	require.NoError(t, err)
+
+	b, _, err := Create(reporter, 1, "title", "message", nil, nil)
+	require.NoError(t, err)
+	require.False(t, b.Compile().AwaitingReporter)
+
+	_, err = RequestInfo(b, maintainer, 2, nil)
+	require.NoError(t, err)
+	require.True(t, b.Compile().AwaitingReporter)
+
+	// another participant commenting doesn't lift the flag
+	_, _, err = AddComment(b, maintainer, 3, "any news?", nil, nil)
+	require.NoError(t, err)
+	require.True(t, b.Compile().AwaitingReporter)
+
+	_, _, err = AddComment(b, reporter, 4, "here you go", nil, nil)
+	require.NoError(t, err)
+	require.False(t, b.Compile().AwaitingReporter)
+}
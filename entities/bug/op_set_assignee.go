@@ -0,0 +1,98 @@
+package bug
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+)
+
+var _ Operation = &SetAssigneeOperation{}
+
+// SetAssigneeOperation add or remove identities from the assignees of a bug.
+// A bug can have multiple assignees. They are referenced by their id.
+type SetAssigneeOperation struct {
+	dag.OpBase
+	Added   []entity.Id `json:"added"`
+	Removed []entity.Id `json:"removed"`
+}
+
+func (op *SetAssigneeOperation) Id() entity.Id {
+	return dag.IdOperation(op, &op.OpBase)
+}
+
+func (op *SetAssigneeOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.Author())
+
+AddLoop:
+	for _, added := range op.Added {
+		for _, id := range snapshot.Assignees {
+			if id == added {
+				continue AddLoop
+			}
+		}
+		snapshot.Assignees = append(snapshot.Assignees, added)
+	}
+
+	for _, removed := range op.Removed {
+		for i, id := range snapshot.Assignees {
+			if id == removed {
+				snapshot.Assignees = append(snapshot.Assignees[:i], snapshot.Assignees[i+1:]...)
+				break
+			}
+		}
+	}
+
+	sort.Slice(snapshot.Assignees, func(i, j int) bool {
+		return snapshot.Assignees[i] < snapshot.Assignees[j]
+	})
+}
+
+func (op *SetAssigneeOperation) Validate() error {
+	if err := op.OpBase.Validate(op, SetAssigneeOp); err != nil {
+		return err
+	}
+
+	for _, id := range op.Added {
+		if err := id.Validate(); err != nil {
+			return errors.Wrap(err, "added assignee")
+		}
+	}
+
+	for _, id := range op.Removed {
+		if err := id.Validate(); err != nil {
+			return errors.Wrap(err, "removed assignee")
+		}
+	}
+
+	if len(op.Added)+len(op.Removed) <= 0 {
+		return fmt.Errorf("no assignee change")
+	}
+
+	return nil
+}
+
+func NewSetAssigneeOp(author identity.Interface, unixTime int64, added, removed []entity.Id) *SetAssigneeOperation {
+	return &SetAssigneeOperation{
+		OpBase:  newOpBase(SetAssigneeOp, author, unixTime),
+		Added:   added,
+		Removed: removed,
+	}
+}
+
+// SetAssignee is a convenience function to change the assignees of a bug
+func SetAssignee(b Interface, author identity.Interface, unixTime int64, added, removed []entity.Id, metadata map[string]string) (*SetAssigneeOperation, error) {
+	op := NewSetAssigneeOp(author, unixTime, added, removed)
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
@@ -0,0 +1,46 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSetAssigneeSerialize(t *testing.T) {
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*SetAssigneeOperation, entity.Resolvers) {
+		return NewSetAssigneeOp(author, unixTime, []entity.Id{author.Id()}, nil), nil
+	})
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*SetAssigneeOperation, entity.Resolvers) {
+		return NewSetAssigneeOp(author, unixTime, nil, []entity.Id{author.Id()}), nil
+	})
+}
+
+func TestSetAssignee(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	isaac, err := identity.NewIdentity(repo, "Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+
+	b, _, err := Create(rene, 1, "title", "message", nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, b.Compile().Assignees)
+
+	_, err = SetAssignee(b, rene, 2, []entity.Id{rene.Id(), isaac.Id()}, nil, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entity.Id{rene.Id(), isaac.Id()}, b.Compile().Assignees)
+
+	// assigning twice is harmless
+	_, err = SetAssignee(b, isaac, 3, []entity.Id{isaac.Id()}, []entity.Id{rene.Id()}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{isaac.Id()}, b.Compile().Assignees)
+
+	_, err = SetAssignee(b, isaac, 4, nil, nil, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package bug
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+var _ Operation = &SetFieldOperation{}
+
+// SetFieldOperation set the value of a custom field of a bug. An empty value
+// unset the field.
+//
+// The value is checked against the definition of the field when committed
+// locally, but not when merged, as the definitions can differ between clones.
+type SetFieldOperation struct {
+	dag.OpBase
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func (op *SetFieldOperation) Id() entity.Id {
+	return dag.IdOperation(op, &op.OpBase)
+}
+
+func (op *SetFieldOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.Author())
+
+	if op.Value == "" {
+		delete(snapshot.Fields, op.Name)
+		return
+	}
+
+	if snapshot.Fields == nil {
+		snapshot.Fields = make(map[string]string)
+	}
+	snapshot.Fields[op.Name] = op.Value
+}
+
+func (op *SetFieldOperation) Validate() error {
+	if err := op.OpBase.Validate(op, SetFieldOp); err != nil {
+		return err
+	}
+
+	if err := validateFieldName(op.Name); err != nil {
+		return errors.Wrap(err, "name")
+	}
+
+	if !text.SafeOneLine(op.Value) {
+		return fmt.Errorf("value is not fully printable")
+	}
+
+	return nil
+}
+
+func NewSetFieldOp(author identity.Interface, unixTime int64, name string, value string) *SetFieldOperation {
+	return &SetFieldOperation{
+		OpBase: newOpBase(SetFieldOp, author, unixTime),
+		Name:   name,
+		Value:  value,
+	}
+}
+
+// SetField is a convenience function to set the value of a custom field
+func SetField(b Interface, author identity.Interface, unixTime int64, name string, value string, metadata map[string]string) (*SetFieldOperation, error) {
+	op := NewSetFieldOp(author, unixTime, name, value)
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
@@ -0,0 +1,94 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSetFieldSerialize(t *testing.T) {
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*SetFieldOperation, entity.Resolvers) {
+		return NewSetFieldOp(author, unixTime, "priority", "high"), nil
+	})
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*SetFieldOperation, entity.Resolvers) {
+		return NewSetFieldOp(author, unixTime, "priority", ""), nil
+	})
+}
+
+func TestSetField(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, _, err := Create(rene, 1, "title", "message", nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, b.Compile().Fields)
+
+	_, err = SetField(b, rene, 2, "priority", "high", nil)
+	require.NoError(t, err)
+	_, err = SetField(b, rene, 3, "due", "2023-01-02", nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"priority": "high", "due": "2023-01-02"}, b.Compile().Fields)
+
+	_, err = SetField(b, rene, 4, "priority", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"due": "2023-01-02"}, b.Compile().Fields)
+
+	_, err = SetField(b, rene, 5, "not valid", "high", nil)
+	require.Error(t, err)
+	_, err = SetField(b, rene, 5, "priority", "multi\nline", nil)
+	require.Error(t, err)
+}
+
+func TestLoadFieldDefinitions(t *testing.T) {
+	repo := repository.NewMockRepo()
+	config := repo.LocalConfig()
+
+	require.NoError(t, config.StoreString("git-bug.field.priority.type", "enum"))
+	require.NoError(t, config.StoreString("git-bug.field.priority.values", "low, medium,high"))
+	require.NoError(t, config.StoreString("git-bug.field.estimate.type", "number"))
+	require.NoError(t, config.StoreString("git-bug.field.due.type", "date"))
+	require.NoError(t, config.StoreString("git-bug.field.team.type", "string"))
+
+	defs, err := LoadFieldDefinitions(config)
+	require.NoError(t, err)
+	require.Equal(t, []FieldDefinition{
+		{Name: "due", Type: FieldTypeDate},
+		{Name: "estimate", Type: FieldTypeNumber},
+		{Name: "priority", Type: FieldTypeEnum, Values: []string{"low", "medium", "high"}},
+		{Name: "team", Type: FieldTypeString},
+	}, defs)
+
+	tests := []struct {
+		def   FieldDefinition
+		value string
+		valid bool
+	}{
+		{defs[0], "2023-01-02", true},
+		{defs[0], "tomorrow", false},
+		{defs[1], "3.5", true},
+		{defs[1], "a lot", false},
+		{defs[2], "medium", true},
+		{defs[2], "urgent", false},
+		{defs[3], "anything", true},
+		{defs[2], "", true},
+	}
+	for _, tc := range tests {
+		err := tc.def.ValidateValue(tc.value)
+		if tc.valid {
+			require.NoError(t, err, tc.value)
+		} else {
+			require.Error(t, err, tc.value)
+		}
+	}
+
+	require.NoError(t, config.StoreString("git-bug.field.size.type", "enum"))
+	_, err = LoadFieldDefinitions(config)
+	require.Error(t, err)
+}
@@ -16,6 +16,9 @@ var _ Operation = &SetStatusOperation{}
 type SetStatusOperation struct {
 	dag.OpBase
 	Status common.Status `json:"status"`
+	// State is the state of the workflow the bug moved to, if it's not the
+	// one implied by the status
+	State string `json:"state,omitempty"`
 }
 
 func (op *SetStatusOperation) Id() entity.Id {
@@ -24,6 +27,7 @@ func (op *SetStatusOperation) Id() entity.Id {
 
 func (op *SetStatusOperation) Apply(snapshot *Snapshot) {
 	snapshot.Status = op.Status
+	snapshot.State = op.State
 	snapshot.addActor(op.Author())
 
 	id := op.Id()
@@ -33,6 +37,7 @@ func (op *SetStatusOperation) Apply(snapshot *Snapshot) {
 		Author:     op.Author(),
 		UnixTime:   timestamp.Timestamp(op.UnixTime),
 		Status:     op.Status,
+		State:      op.State,
 	}
 
 	snapshot.Timeline = append(snapshot.Timeline, item)
@@ -47,12 +52,18 @@ func (op *SetStatusOperation) Validate() error {
 		return errors.Wrap(err, "status")
 	}
 
+	if op.State != "" {
+		if err := ValidateStateName(op.State); err != nil {
+			return errors.Wrap(err, "state")
+		}
+	}
+
 	return nil
 }
 
 func NewSetStatusOp(author identity.Interface, unixTime int64, status common.Status) *SetStatusOperation {
 	return &SetStatusOperation{
-		OpBase: dag.NewOpBase(SetStatusOp, author, unixTime),
+		OpBase: newOpBase(SetStatusOp, author, unixTime),
 		Status: status,
 	}
 }
@@ -62,6 +73,8 @@ type SetStatusTimelineItem struct {
 	Author     identity.Interface
 	UnixTime   timestamp.Timestamp
 	Status     common.Status
+	// State is the state of the workflow the bug moved to, if recorded
+	State string
 }
 
 func (s SetStatusTimelineItem) CombinedId() entity.CombinedId {
@@ -84,6 +97,21 @@ func Open(b Interface, author identity.Interface, unixTime int64, metadata map[s
 	return op, nil
 }
 
+// SetState is a convenience function to move a bug to a state of the
+// workflow, open or closed depending on the status
+func SetState(b Interface, author identity.Interface, unixTime int64, status common.Status, state string, metadata map[string]string) (*SetStatusOperation, error) {
+	op := NewSetStatusOp(author, unixTime, status)
+	op.State = state
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
+
 // Close is a convenience function to change a bugs state to Close
 func Close(b Interface, author identity.Interface, unixTime int64, metadata map[string]string) (*SetStatusOperation, error) {
 	op := NewSetStatusOp(author, unixTime, common.ClosedStatus)
@@ -14,3 +14,11 @@ func TestSetStatusSerialize(t *testing.T) {
 		return NewSetStatusOp(author, unixTime, common.ClosedStatus), nil
 	})
 }
+
+func TestSetStatusStateSerialize(t *testing.T) {
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*SetStatusOperation, entity.Resolvers) {
+		op := NewSetStatusOp(author, unixTime, common.OpenStatus)
+		op.State = "in-progress"
+		return op, nil
+	})
+}
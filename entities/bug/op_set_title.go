@@ -62,7 +62,7 @@ func (op *SetTitleOperation) Validate() error {
 
 func NewSetTitleOp(author identity.Interface, unixTime int64, title string, was string) *SetTitleOperation {
 	return &SetTitleOperation{
-		OpBase: dag.NewOpBase(SetTitleOp, author, unixTime),
+		OpBase: newOpBase(SetTitleOp, author, unixTime),
 		Title:  title,
 		Was:    was,
 	}
@@ -96,8 +96,8 @@ func SetTitle(b Interface, author identity.Interface, unixTime int64, title stri
 	var was string
 	if lastTitleOp != nil {
 		was = lastTitleOp.Title
-	} else {
-		was = b.FirstOp().(*CreateOperation).Title
+	} else if createOp, ok := b.FirstOp().(*CreateOperation); ok {
+		was = createOp.Title
 	}
 
 	op := NewSetTitleOp(author, unixTime, title, was)
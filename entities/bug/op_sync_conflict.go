@@ -0,0 +1,134 @@
+package bug
+
+import (
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+var _ Operation = &SyncConflictOperation{}
+
+// SyncSide designate one side of a bridge synchronisation
+type SyncSide string
+
+const (
+	// SyncSideLocal is the git-bug side of the synchronisation
+	SyncSideLocal SyncSide = "local"
+	// SyncSideRemote is the bug tracker the bridge synchronize with
+	SyncSideRemote SyncSide = "remote"
+)
+
+func (s SyncSide) Validate() error {
+	switch s {
+	case SyncSideLocal, SyncSideRemote:
+		return nil
+	default:
+		return fmt.Errorf("unknown sync side %q", string(s))
+	}
+}
+
+// SyncConflict is a conflict that a bridge couldn't resolve automatically,
+// recorded on the bug so that a human can review the reconciliation.
+type SyncConflict struct {
+	OperationId entity.Id
+	Author      identity.Interface
+	UnixTime    timestamp.Timestamp
+	// Bridge is the name of the bridge that detected the conflict
+	Bridge string
+	// Field is the part of the bug that differed, for example "title"
+	Field  string
+	Local  string
+	Remote string
+	// Winner is the side whose value has been kept
+	Winner SyncSide
+}
+
+// SyncConflictOperation annotate a bug with a conflict detected during a
+// bidirectional synchronisation, that couldn't be resolved automatically.
+// It doesn't change the bug data itself.
+type SyncConflictOperation struct {
+	dag.OpBase
+	Bridge string   `json:"bridge"`
+	Field  string   `json:"field"`
+	Local  string   `json:"local"`
+	Remote string   `json:"remote"`
+	Winner SyncSide `json:"winner"`
+}
+
+func (op *SyncConflictOperation) Id() entity.Id {
+	return dag.IdOperation(op, &op.OpBase)
+}
+
+func (op *SyncConflictOperation) Apply(snapshot *Snapshot) {
+	snapshot.SyncConflicts = append(snapshot.SyncConflicts, SyncConflict{
+		OperationId: op.Id(),
+		Author:      op.Author(),
+		UnixTime:    timestamp.Timestamp(op.UnixTime),
+		Bridge:      op.Bridge,
+		Field:       op.Field,
+		Local:       op.Local,
+		Remote:      op.Remote,
+		Winner:      op.Winner,
+	})
+}
+
+func (op *SyncConflictOperation) Validate() error {
+	if err := op.OpBase.Validate(op, SyncConflictOp); err != nil {
+		return err
+	}
+
+	if text.Empty(op.Bridge) {
+		return fmt.Errorf("bridge is empty")
+	}
+
+	if !text.SafeOneLine(op.Bridge) {
+		return fmt.Errorf("bridge has unsafe characters")
+	}
+
+	if text.Empty(op.Field) {
+		return fmt.Errorf("field is empty")
+	}
+
+	if !text.SafeOneLine(op.Field) {
+		return fmt.Errorf("field has unsafe characters")
+	}
+
+	if !text.Safe(op.Local) {
+		return fmt.Errorf("local value is not fully printable")
+	}
+
+	if !text.Safe(op.Remote) {
+		return fmt.Errorf("remote value is not fully printable")
+	}
+
+	return op.Winner.Validate()
+}
+
+func NewSyncConflictOp(author identity.Interface, unixTime int64, bridge, field, local, remote string, winner SyncSide) *SyncConflictOperation {
+	return &SyncConflictOperation{
+		OpBase: newOpBase(SyncConflictOp, author, unixTime),
+		Bridge: bridge,
+		Field:  field,
+		Local:  local,
+		Remote: remote,
+		Winner: winner,
+	}
+}
+
+// RecordSyncConflict is a convenience function to annotate a bug with a synchronisation conflict
+func RecordSyncConflict(b Interface, author identity.Interface, unixTime int64, bridge, field, local, remote string, winner SyncSide, metadata map[string]string) (*SyncConflictOperation, error) {
+	op := NewSyncConflictOp(author, unixTime, bridge, field, local, remote, winner)
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
@@ -0,0 +1,40 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/entity/dag"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSyncConflictSerialize(t *testing.T) {
+	dag.SerializeRoundTripTest(t, operationUnmarshaler, func(author identity.Interface, unixTime int64) (*SyncConflictOperation, entity.Resolvers) {
+		return NewSyncConflictOp(author, unixTime, "github", "title", "local", "remote", SyncSideRemote), nil
+	})
+}
+
+func TestSyncConflict(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, _, err := Create(rene, 1, "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	_, err = RecordSyncConflict(b, rene, 2, "github", "title", "local", "remote", "neither", nil)
+	require.Error(t, err)
+
+	op, err := RecordSyncConflict(b, rene, 2, "github", "title", "local", "remote", SyncSideRemote, nil)
+	require.NoError(t, err)
+
+	snap := b.Compile()
+	require.Equal(t, "title", snap.Title)
+	require.Len(t, snap.SyncConflicts, 1)
+	require.Equal(t, op.Id(), snap.SyncConflicts[0].OperationId)
+	require.Equal(t, SyncSideRemote, snap.SyncConflicts[0].Winner)
+}
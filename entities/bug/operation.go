@@ -2,8 +2,8 @@ package bug
 
 import (
 	"encoding/json"
-	"fmt"
 
+	"github.com/MichaelMure/git-bug/entities/identity"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/entity/dag"
 )
@@ -18,8 +18,31 @@ const (
 	EditCommentOp
 	NoOpOp
 	SetMetadataOp
+	RequestInfoOp
+	SyncConflictOp
+	SetAssigneeOp
+	SetFieldOp
+	RedactCommentOp
+	ReactionOp
+	RelationAddOp
+	RelationRemoveOp
+	AddAttachmentOp
 )
 
+// operationSchemas are the current schema versions of the operations, when
+// not the initial one (see dag.OpBase.SchemaVersion). An operation with a more
+// recent schema version, or of an unknown type, is read as a
+// dag.UnknownOperation: it is preserved but doesn't change the bug.
+var operationSchemas = map[dag.OperationType]uint{}
+
+// newOpBase return the dag.OpBase of a new operation of the given type, with
+// its current schema version
+func newOpBase(opType dag.OperationType, author identity.Interface, unixTime int64) dag.OpBase {
+	base := dag.NewOpBase(opType, author, unixTime)
+	base.SchemaVersion = operationSchemas[opType]
+	return base
+}
+
 // Operation define the interface to fulfill for an edit operation of a Bug
 type Operation interface {
 	dag.Operation
@@ -31,16 +54,23 @@ type Operation interface {
 // make sure that package external operations do conform to our interface
 var _ Operation = &dag.NoOpOperation[*Snapshot]{}
 var _ Operation = &dag.SetMetadataOperation[*Snapshot]{}
+var _ Operation = &dag.UnknownOperation[*Snapshot]{}
 
 func operationUnmarshaler(raw json.RawMessage, resolvers entity.Resolvers) (dag.Operation, error) {
 	var t struct {
 		OperationType dag.OperationType `json:"type"`
+		SchemaVersion uint              `json:"schema"`
 	}
 
 	if err := json.Unmarshal(raw, &t); err != nil {
 		return nil, err
 	}
 
+	if t.SchemaVersion > operationSchemas[t.OperationType] {
+		// written by a more recent version of git-bug
+		return dag.NewUnknownOperation[*Snapshot](raw)
+	}
+
 	var op dag.Operation
 
 	switch t.OperationType {
@@ -60,8 +90,27 @@ func operationUnmarshaler(raw json.RawMessage, resolvers entity.Resolvers) (dag.
 		op = &SetStatusOperation{}
 	case SetTitleOp:
 		op = &SetTitleOperation{}
+	case RequestInfoOp:
+		op = &RequestInfoOperation{}
+	case SyncConflictOp:
+		op = &SyncConflictOperation{}
+	case SetAssigneeOp:
+		op = &SetAssigneeOperation{}
+	case SetFieldOp:
+		op = &SetFieldOperation{}
+	case RedactCommentOp:
+		op = &RedactCommentOperation{}
+	case ReactionOp:
+		op = &ReactionOperation{}
+	case RelationAddOp:
+		op = &RelationAddOperation{}
+	case RelationRemoveOp:
+		op = &RelationRemoveOperation{}
+	case AddAttachmentOp:
+		op = &AddAttachmentOperation{}
 	default:
-		panic(fmt.Sprintf("unknown operation type %v", t.OperationType))
+		// written by a more recent version of git-bug
+		return dag.NewUnknownOperation[*Snapshot](raw)
 	}
 
 	err := json.Unmarshal(raw, &op)
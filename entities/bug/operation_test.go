@@ -1,6 +1,7 @@
 package bug
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -130,3 +131,43 @@ func TestID(t *testing.T) {
 		require.Equal(t, id1, id3)
 	}
 }
+
+func TestUnknownOperation(t *testing.T) {
+	repo := repository.NewMockRepoClock()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, _, err := Create(rene, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+
+	for name, raw := range map[string]string{
+		"unknown type":   `{"type":999,"timestamp":1234,"nonce":"AAAA","reactions":["+1"]}`,
+		"future version": `{"type":3,"schema":42,"timestamp":1234,"nonce":"AAAA","message":"hello","thread":"x"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			op, err := operationUnmarshaler(json.RawMessage(raw), nil)
+			require.NoError(t, err)
+			require.True(t, dag.IsUnknownOperation(op))
+
+			// written back as is
+			data, err := json.Marshal(op)
+			require.NoError(t, err)
+			require.Equal(t, raw, string(data))
+		})
+	}
+
+	unknown, err := operationUnmarshaler(json.RawMessage(`{"type":999,"timestamp":1234,"nonce":"AAAA"}`), nil)
+	require.NoError(t, err)
+	b.Append(unknown.(Operation))
+	_, _, err = AddComment(b, rene, unix, "comment", nil, nil)
+	require.NoError(t, err)
+
+	// the unknown operation doesn't change the bug
+	snap := b.Compile()
+	require.Len(t, snap.Operations, 2)
+	require.Len(t, snap.Comments, 2)
+	require.Len(t, b.Operations(), 3)
+}
@@ -0,0 +1,176 @@
+package bug
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+)
+
+// Reaction is an emoji reaction to a comment. The set of reactions is the one
+// common to the forges, so that they can be mapped by the bridges.
+type Reaction string
+
+const (
+	ReactionThumbsUp   Reaction = "thumbs_up"
+	ReactionThumbsDown Reaction = "thumbs_down"
+	ReactionLaugh      Reaction = "laugh"
+	ReactionHooray     Reaction = "hooray"
+	ReactionConfused   Reaction = "confused"
+	ReactionHeart      Reaction = "heart"
+	ReactionRocket     Reaction = "rocket"
+	ReactionEyes       Reaction = "eyes"
+)
+
+// Reactions are all the valid reactions, in display order
+var Reactions = []Reaction{
+	ReactionThumbsUp,
+	ReactionThumbsDown,
+	ReactionLaugh,
+	ReactionHooray,
+	ReactionConfused,
+	ReactionHeart,
+	ReactionRocket,
+	ReactionEyes,
+}
+
+var reactionEmojis = map[Reaction]string{
+	ReactionThumbsUp:   "👍",
+	ReactionThumbsDown: "👎",
+	ReactionLaugh:      "😄",
+	ReactionHooray:     "🎉",
+	ReactionConfused:   "😕",
+	ReactionHeart:      "❤️",
+	ReactionRocket:     "🚀",
+	ReactionEyes:       "👀",
+}
+
+// other common names of the reactions, as used by the forges and chat tools
+var reactionAliases = map[string]Reaction{
+	"+1":         ReactionThumbsUp,
+	"thumbsup":   ReactionThumbsUp,
+	"-1":         ReactionThumbsDown,
+	"thumbsdown": ReactionThumbsDown,
+	"smile":      ReactionLaugh,
+	"laughing":   ReactionLaugh,
+	"tada":       ReactionHooray,
+	"❤":          ReactionHeart,
+}
+
+// ReactionFromString parse a reaction from its name, one of its common
+// aliases (like "+1" or "tada") or its emoji.
+func ReactionFromString(str string) (Reaction, error) {
+	cleaned := strings.ToLower(strings.Trim(strings.TrimSpace(str), ":"))
+
+	for _, reaction := range Reactions {
+		if cleaned == string(reaction) || cleaned == reactionEmojis[reaction] {
+			return reaction, nil
+		}
+	}
+	if reaction, ok := reactionAliases[cleaned]; ok {
+		return reaction, nil
+	}
+
+	return "", fmt.Errorf("unknown reaction \"%s\"", str)
+}
+
+func (r Reaction) String() string {
+	return string(r)
+}
+
+// Emoji return the unicode emoji of the reaction
+func (r Reaction) Emoji() string {
+	return reactionEmojis[r]
+}
+
+func (r Reaction) Validate() error {
+	if _, ok := reactionEmojis[r]; !ok {
+		return fmt.Errorf("unknown reaction \"%s\"", r)
+	}
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface from gqlgen
+func (r Reaction) MarshalGQL(w io.Writer) {
+	_, _ = fmt.Fprint(w, strconv.Quote(strings.ToUpper(string(r))))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface from gqlgen
+func (r *Reaction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+	reaction := Reaction(strings.ToLower(str))
+	if err := reaction.Validate(); err != nil {
+		return fmt.Errorf("%s is not a valid Reaction", str)
+	}
+	*r = reaction
+	return nil
+}
+
+// ReactionGroup hold the authors of the same reaction to a comment
+type ReactionGroup struct {
+	Reaction Reaction
+	Authors  []identity.Interface
+}
+
+// Emoji return the unicode emoji of the reaction
+func (rg ReactionGroup) Emoji() string {
+	return rg.Reaction.Emoji()
+}
+
+// addReaction return the groups with the reaction of the author added, the
+// groups being kept in the order of the first use of each reaction.
+func addReaction(groups []ReactionGroup, reaction Reaction, author identity.Interface) []ReactionGroup {
+	result := make([]ReactionGroup, 0, len(groups)+1)
+	found := false
+	for _, group := range groups {
+		if group.Reaction == reaction {
+			found = true
+			if !hasAuthor(group.Authors, author) {
+				authors := make([]identity.Interface, len(group.Authors), len(group.Authors)+1)
+				copy(authors, group.Authors)
+				group.Authors = append(authors, author)
+			}
+		}
+		result = append(result, group)
+	}
+	if !found {
+		result = append(result, ReactionGroup{Reaction: reaction, Authors: []identity.Interface{author}})
+	}
+	return result
+}
+
+// removeReaction return the groups without the reaction of the author. A
+// reaction left without authors is removed.
+func removeReaction(groups []ReactionGroup, reaction Reaction, author identity.Interface) []ReactionGroup {
+	result := make([]ReactionGroup, 0, len(groups))
+	for _, group := range groups {
+		if group.Reaction == reaction {
+			authors := make([]identity.Interface, 0, len(group.Authors))
+			for _, a := range group.Authors {
+				if a.Id() != author.Id() {
+					authors = append(authors, a)
+				}
+			}
+			if len(authors) == 0 {
+				continue
+			}
+			group.Authors = authors
+		}
+		result = append(result, group)
+	}
+	return result
+}
+
+func hasAuthor(authors []identity.Interface, author identity.Interface) bool {
+	for _, a := range authors {
+		if a.Id() == author.Id() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package bug
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// RelationType is the kind of link from a bug to another one
+type RelationType string
+
+const (
+	// RelationBlocks mean that the other bug can't be resolved before this one,
+	// in other words that it depends on this one
+	RelationBlocks RelationType = "blocks"
+	// RelationDuplicateOf mean that this bug is a duplicate of the other one
+	RelationDuplicateOf RelationType = "duplicate-of"
+	// RelationRelatedTo is a loose link between the two bugs
+	RelationRelatedTo RelationType = "related-to"
+)
+
+// RelationTypes are all the valid relation types
+var RelationTypes = []RelationType{
+	RelationBlocks,
+	RelationDuplicateOf,
+	RelationRelatedTo,
+}
+
+// RelationTypeFromString parse a relation type from its name
+func RelationTypeFromString(str string) (RelationType, error) {
+	cleaned := strings.ToLower(strings.TrimSpace(str))
+
+	for _, relType := range RelationTypes {
+		if cleaned == string(relType) {
+			return relType, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown relation type \"%s\"", str)
+}
+
+func (t RelationType) String() string {
+	return string(t)
+}
+
+func (t RelationType) Validate() error {
+	for _, relType := range RelationTypes {
+		if t == relType {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown relation type \"%s\"", string(t))
+}
+
+// Inverse return the name of the relation as seen from the other bug
+func (t RelationType) Inverse() string {
+	switch t {
+	case RelationBlocks:
+		return "blocked-by"
+	case RelationDuplicateOf:
+		return "duplicated-by"
+	default:
+		return string(t)
+	}
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (t *RelationType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	relType := RelationType(strings.ReplaceAll(strings.ToLower(str), "_", "-"))
+	if err := relType.Validate(); err != nil {
+		return err
+	}
+	*t = relType
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface.
+func (t RelationType) MarshalGQL(w io.Writer) {
+	name := strings.ReplaceAll(strings.ToUpper(string(t)), "-", "_")
+	_, _ = fmt.Fprint(w, strconv.Quote(name))
+}
+
+// Relation is a link from a bug to another one
+type Relation struct {
+	Type   RelationType `json:"type"`
+	Target entity.Id    `json:"target"`
+}
+
+func (r Relation) String() string {
+	return fmt.Sprintf("%s %s", r.Type, r.Target.Human())
+}
+
+func addRelation(relations []Relation, relation Relation) []Relation {
+	for _, r := range relations {
+		if r == relation {
+			return relations
+		}
+	}
+
+	relations = append(relations, relation)
+	sort.Slice(relations, func(i, j int) bool {
+		if relations[i].Type != relations[j].Type {
+			return relations[i].Type < relations[j].Type
+		}
+		return relations[i].Target < relations[j].Target
+	})
+	return relations
+}
+
+func removeRelation(relations []Relation, relation Relation) []Relation {
+	for i, r := range relations {
+		if r == relation {
+			return append(relations[:i], relations[i+1:]...)
+		}
+	}
+	return relations
+}
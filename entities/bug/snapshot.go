@@ -1,7 +1,6 @@
 package bug
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/MichaelMure/git-bug/entities/common"
@@ -25,6 +24,35 @@ type Snapshot struct {
 	Participants []identity.Interface
 	CreateTime   time.Time
 
+	// State is the state of the workflow the bug was last moved to, empty
+	// if only opened or closed since. See Workflow.StateOf.
+	State string
+
+	// AwaitingReporter is true when more information has been requested from
+	// the reporter, and they haven't commented since.
+	AwaitingReporter bool
+
+	// Assignees are the ids of the identities the bug is assigned to, sorted
+	Assignees []entity.Id
+
+	// Fields are the values of the custom fields, by name
+	Fields map[string]string
+
+	// Relations are the links from the bug to other bugs, sorted by type then
+	// target
+	Relations []Relation
+
+	// Attachments are the files attached to the bug after its creation, in
+	// the order they were attached
+	Attachments []Attachment
+
+	// SyncConflicts are the conflicts a bridge couldn't resolve automatically
+	SyncConflicts []SyncConflict
+
+	// MergeConflicts are the changes overridden by a concurrent one when
+	// merging the edits of different clones
+	MergeConflicts []MergeConflict
+
 	Timeline []TimelineItem
 
 	Operations []dag.Operation
@@ -65,7 +93,7 @@ func (snap *Snapshot) SearchTimelineItem(id entity.CombinedId) (TimelineItem, er
 		}
 	}
 
-	return nil, fmt.Errorf("timeline item not found")
+	return nil, entity.NewErrNotFound("timeline item")
 }
 
 // SearchComment will search for a comment matching the given id
@@ -76,7 +104,7 @@ func (snap *Snapshot) SearchComment(id entity.CombinedId) (*Comment, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("comment not found")
+	return nil, entity.NewErrNotFound("comment")
 }
 
 // SearchCommentByOpId will search for a comment generated by the given operation Id
@@ -87,7 +115,7 @@ func (snap *Snapshot) SearchCommentByOpId(id entity.Id) (*Comment, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("comment not found")
+	return nil, entity.NewErrNotFound("comment")
 }
 
 // append the operation author to the actors list
@@ -152,5 +180,16 @@ func (snap *Snapshot) HasAnyActor(ids ...entity.Id) bool {
 	return false
 }
 
+// RelatedBugs return the ids of the bugs linked with the given relation type
+func (snap *Snapshot) RelatedBugs(relType RelationType) []entity.Id {
+	var result []entity.Id
+	for _, relation := range snap.Relations {
+		if relation.Type == relType {
+			result = append(result, relation.Target)
+		}
+	}
+	return result
+}
+
 // IsAuthored is a sign post method for gqlgen
 func (snap *Snapshot) IsAuthored() {}
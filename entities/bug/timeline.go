@@ -33,6 +33,14 @@ type CommentTimelineItem struct {
 	CreatedAt  timestamp.Timestamp
 	LastEdit   timestamp.Timestamp
 	History    []CommentHistoryStep
+	// Redacted is true when the previous content of the comment has been
+	// hidden, see RedactCommentOperation
+	Redacted bool
+	// Deleted is true when the comment has been deleted, only its tombstone
+	// being left in the timeline
+	Deleted bool
+	// Reactions are the emoji reactions to the comment, see ReactionOperation
+	Reactions []ReactionGroup
 }
 
 func NewCommentTimelineItem(comment Comment) CommentTimelineItem {
@@ -69,6 +77,18 @@ func (c *CommentTimelineItem) Append(comment Comment) {
 	})
 }
 
+// Redact hide the content of the comment, and its previous versions
+func (c *CommentTimelineItem) Redact(deleted bool, unixTime timestamp.Timestamp) {
+	c.Message = RedactedMessage
+	c.Files = nil
+	c.LastEdit = unixTime
+	for i := range c.History {
+		c.History[i].Message = RedactedMessage
+	}
+	c.Redacted = true
+	c.Deleted = deleted
+}
+
 // Edited say if the comment was edited
 func (c *CommentTimelineItem) Edited() bool {
 	return len(c.History) > 1
@@ -78,3 +98,8 @@ func (c *CommentTimelineItem) Edited() bool {
 func (c *CommentTimelineItem) MessageIsEmpty() bool {
 	return len(strings.TrimSpace(c.Message)) == 0
 }
+
+// Structure return the code blocks, links and mentions of the message
+func (c *CommentTimelineItem) Structure() MessageStructure {
+	return ParseMessageStructure(c.Message)
+}
@@ -0,0 +1,189 @@
+package bug
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// metadataKeyTranslationPrefix is the prefix of the operation metadata holding
+// the machine translations of the title or the message set by the operation,
+// as in "translation.<lang>.title" and "translation.<lang>.message".
+//
+// Translations are annotations: they don't change the bug and the original
+// text stays the authoritative one. As a metadata can't be replaced, a new
+// version of a text, like an edited comment, needs a new translation.
+const metadataKeyTranslationPrefix = "translation."
+
+// TranslationPart is the text of an operation a translation is about
+type TranslationPart string
+
+const (
+	TranslationTitle   TranslationPart = "title"
+	TranslationMessage TranslationPart = "message"
+)
+
+var languageRegexp = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+// ValidateLanguage check that a language is a tag like "fr" or "pt-BR"
+func ValidateLanguage(lang string) error {
+	if !languageRegexp.MatchString(lang) {
+		return fmt.Errorf("invalid language \"%s\", expected a tag like \"fr\" or \"pt-BR\"", lang)
+	}
+	return nil
+}
+
+func translationMetadataKey(lang string, part TranslationPart) string {
+	return metadataKeyTranslationPrefix + lang + "." + string(part)
+}
+
+// TranslationSource is a current text of the bug, with the operation that set
+// it and holds its translations
+type TranslationSource struct {
+	Op   Operation
+	Part TranslationPart
+	// Comment is the comment of a message, unset for the title
+	Comment entity.CombinedId
+	Text    string
+}
+
+// Translation return the stored translation of the text in a language
+func (s TranslationSource) Translation(lang string) (string, bool) {
+	return s.Op.GetMetadata(translationMetadataKey(lang, s.Part))
+}
+
+// TranslationMetadata return the metadata to set on the operation of the text
+// to store its translation in a language
+func (s TranslationSource) TranslationMetadata(lang string, translation string) map[string]string {
+	return map[string]string{translationMetadataKey(lang, s.Part): translation}
+}
+
+// TranslationSources return the current title and messages of the bug, in
+// the order of the comments. Empty messages are skipped.
+func (snap *Snapshot) TranslationSources() []TranslationSource {
+	var title Operation
+	messages := make(map[entity.Id]Operation)
+
+	for _, op := range snap.Operations {
+		switch op := op.(type) {
+		case *CreateOperation:
+			title = op
+			messages[op.Id()] = op
+		case *SetTitleOperation:
+			title = op
+		case *AddCommentOperation:
+			messages[op.Id()] = op
+		case *EditCommentOperation:
+			if _, ok := messages[op.Target]; ok {
+				messages[op.Target] = op
+			}
+		}
+	}
+
+	var result []TranslationSource
+
+	// the operations are only a source if they set the current text, which
+	// is not the case for an edition ignored by a deleted or redacted comment
+	switch op := title.(type) {
+	case *CreateOperation:
+		if op.Title == snap.Title {
+			result = append(result, TranslationSource{Op: op, Part: TranslationTitle, Text: op.Title})
+		}
+	case *SetTitleOperation:
+		if op.Title == snap.Title {
+			result = append(result, TranslationSource{Op: op, Part: TranslationTitle, Text: op.Title})
+		}
+	}
+
+	for _, comment := range snap.Comments {
+		if comment.Message == "" {
+			continue
+		}
+
+		var message string
+		op := messages[comment.TargetId()]
+		switch op := op.(type) {
+		case *CreateOperation:
+			message = op.Message
+		case *AddCommentOperation:
+			message = op.Message
+		case *EditCommentOperation:
+			message = op.Message
+		}
+		if message != comment.Message {
+			continue
+		}
+
+		result = append(result, TranslationSource{
+			Op:      op,
+			Part:    TranslationMessage,
+			Comment: comment.CombinedId(),
+			Text:    message,
+		})
+	}
+
+	return result
+}
+
+// Translations are the stored translations of the current title and messages
+// of a bug in a language
+type Translations struct {
+	Lang string
+	// Title is the translated title, empty if not translated
+	Title string
+	// Messages are the translated messages, by comment
+	Messages map[entity.CombinedId]string
+}
+
+// Translations return the stored translations of the current title and
+// messages in a language
+func (snap *Snapshot) Translations(lang string) Translations {
+	result := Translations{
+		Lang:     lang,
+		Messages: make(map[entity.CombinedId]string),
+	}
+
+	for _, source := range snap.TranslationSources() {
+		translation, ok := source.Translation(lang)
+		if !ok {
+			continue
+		}
+		switch source.Part {
+		case TranslationTitle:
+			result.Title = translation
+		case TranslationMessage:
+			result.Messages[source.Comment] = translation
+		}
+	}
+
+	return result
+}
+
+// TranslationLanguages return the languages with a stored translation of the
+// current title or of a current message, sorted
+func (snap *Snapshot) TranslationLanguages() []string {
+	set := make(map[string]struct{})
+
+	for _, source := range snap.TranslationSources() {
+		suffix := "." + string(source.Part)
+		for key := range source.Op.AllMetadata() {
+			if !strings.HasPrefix(key, metadataKeyTranslationPrefix) || !strings.HasSuffix(key, suffix) {
+				continue
+			}
+			lang := strings.TrimSuffix(strings.TrimPrefix(key, metadataKeyTranslationPrefix), suffix)
+			if ValidateLanguage(lang) == nil {
+				set[lang] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(set))
+	for lang := range set {
+		result = append(result, lang)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,72 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestTranslations(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+
+	b, create, err := Create(rene, unix, "title", "message", nil, nil)
+	require.NoError(t, err)
+	comment1 := NewAddCommentOp(rene, unix+1, "comment 1", nil)
+	b.Append(comment1)
+	comment2 := NewAddCommentOp(rene, unix+2, "comment 2", nil)
+	b.Append(comment2)
+
+	snap := b.Compile()
+	sources := snap.TranslationSources()
+	require.Len(t, sources, 4)
+	require.Equal(t, TranslationTitle, sources[0].Part)
+	require.Equal(t, create.Id(), sources[0].Op.Id())
+	require.Equal(t, "message", sources[1].Text)
+	require.Equal(t, "comment 2", sources[3].Text)
+
+	for _, source := range sources {
+		_, ok := source.Translation("fr")
+		require.False(t, ok)
+		b.Append(NewSetMetadataOp(rene, unix+3, source.Op.Id(), source.TranslationMetadata("fr", "fr "+source.Text)))
+	}
+
+	snap = b.Compile()
+	require.Equal(t, []string{"fr"}, snap.TranslationLanguages())
+	translations := snap.Translations("fr")
+	require.Equal(t, "fr title", translations.Title)
+	require.Len(t, translations.Messages, 3)
+	require.Equal(t, "fr comment 1", translations.Messages[snap.Comments[1].CombinedId()])
+	require.Empty(t, snap.Translations("de").Messages)
+
+	// a new title and an edited comment need new translations, a redacted
+	// comment can't be translated anymore
+	b.Append(NewSetTitleOp(rene, unix+4, "new title", "title"))
+	edit := NewEditCommentOp(rene, unix+5, comment1.Id(), "edited comment 1", nil)
+	b.Append(edit)
+	b.Append(NewRedactCommentOp(rene, unix+6, comment2.Id(), false))
+
+	snap = b.Compile()
+	translations = snap.Translations("fr")
+	require.Empty(t, translations.Title)
+	require.Equal(t, "fr message", translations.Messages[snap.Comments[0].CombinedId()])
+	require.Len(t, translations.Messages, 1)
+
+	sources = snap.TranslationSources()
+	require.Len(t, sources, 3)
+	require.Equal(t, "new title", sources[0].Text)
+	require.Equal(t, edit.Id(), sources[2].Op.Id())
+	require.Equal(t, "edited comment 1", sources[2].Text)
+
+	require.NoError(t, ValidateLanguage("pt-BR"))
+	require.Error(t, ValidateLanguage("french language"))
+	require.Error(t, ValidateLanguage(""))
+}
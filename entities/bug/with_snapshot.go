@@ -7,9 +7,15 @@ import (
 var _ Interface = &WithSnapshot{}
 
 // WithSnapshot encapsulate a Bug and maintain the corresponding Snapshot efficiently
+//
+// Once returned by Compile, a Snapshot is never modified anymore, so that it
+// can be read concurrently. A change then invalidates the Snapshot instead,
+// which is compiled again when needed.
 type WithSnapshot struct {
 	*Bug
 	snap *Snapshot
+	// the snapshot has been returned by Compile
+	shared bool
 }
 
 func (b *WithSnapshot) Compile() *Snapshot {
@@ -17,9 +23,27 @@ func (b *WithSnapshot) Compile() *Snapshot {
 		snap := b.Bug.Compile()
 		b.snap = snap
 	}
+	b.shared = true
 	return b.snap
 }
 
+// SharedSnapshot return the Snapshot if it has already been returned by
+// Compile, without changing anything. This allows concurrent readers to share
+// the Snapshot without an exclusive lock.
+func (b *WithSnapshot) SharedSnapshot() (*Snapshot, bool) {
+	if b.snap == nil || !b.shared {
+		return nil, false
+	}
+	return b.snap, true
+}
+
+// UseSnapshot set the Snapshot of the bug in its current state, compiled
+// elsewhere, as if returned by Compile. It must not be modified anymore.
+func (b *WithSnapshot) UseSnapshot(snap *Snapshot) {
+	b.snap = snap
+	b.shared = true
+}
+
 // Append intercept Bug.Append() to update the snapshot efficiently
 func (b *WithSnapshot) Append(op Operation) {
 	b.Bug.Append(op)
@@ -28,23 +52,43 @@ func (b *WithSnapshot) Append(op Operation) {
 		return
 	}
 
+	if b.shared {
+		b.snap = nil
+		b.shared = false
+		return
+	}
+
 	op.Apply(b.snap)
 	b.snap.Operations = append(b.snap.Operations, op)
 }
 
+// DiscardStaged intercept Bug.DiscardStaged() to invalidate the snapshot
+func (b *WithSnapshot) DiscardStaged(n int) {
+	b.Bug.DiscardStaged(n)
+	b.snap = nil
+	b.shared = false
+}
+
 // Commit intercept Bug.Commit() to update the snapshot efficiently
 func (b *WithSnapshot) Commit(repo repository.ClockedRepo) error {
 	err := b.Bug.Commit(repo)
 
 	if err != nil {
 		b.snap = nil
+		b.shared = false
 		return err
 	}
 
 	// Commit() shouldn't change anything of the bug state apart from the
 	// initial ID set
 
-	if b.snap == nil {
+	if b.snap == nil || b.snap.id == b.Bug.Id() {
+		return nil
+	}
+
+	if b.shared {
+		b.snap = nil
+		b.shared = false
 		return nil
 	}
 
@@ -0,0 +1,240 @@
+package bug
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// workflowConfigKeyPrefix is the git config prefix under which the workflow
+// of the bugs is defined, as in "git-bug.workflow.<state>.<key> = <value>"
+const workflowConfigKeyPrefix = "git-bug.workflow."
+
+// workflowConfigKeyStates is the git config key listing the states of the
+// workflow, in order
+const workflowConfigKeyStates = workflowConfigKeyPrefix + "states"
+
+const (
+	workflowConfigKeyStatus = "status"
+	workflowConfigKeyNext   = "next"
+)
+
+// WorkflowState is a state of the workflow of the bugs
+type WorkflowState struct {
+	Name string
+	// Status is whether a bug in this state is open or closed
+	Status common.Status
+	// Next are the states a bug can move to from this state. When nil, any
+	// state can follow.
+	Next []string
+}
+
+// Workflow is the set of states a bug can be in, and the transitions allowed
+// between them. Each state is either open or closed, so that everything
+// knowing only about the status keeps working.
+//
+// A bug only ever opened or closed, like the bugs created before the workflow
+// was defined or by a bridge, is in the first open or closed state.
+type Workflow struct {
+	States []WorkflowState
+}
+
+// DefaultWorkflow return the workflow used when none is defined: a bug is
+// either "open" or "closed", and can freely move between the two.
+func DefaultWorkflow() *Workflow {
+	return &Workflow{
+		States: []WorkflowState{
+			{Name: common.OpenStatus.String(), Status: common.OpenStatus},
+			{Name: common.ClosedStatus.String(), Status: common.ClosedStatus},
+		},
+	}
+}
+
+// IsDefault return true if the workflow is the default one, where a bug is
+// simply opened or closed
+func (w *Workflow) IsDefault() bool {
+	return reflect.DeepEqual(w, DefaultWorkflow())
+}
+
+// LoadWorkflow read the workflow defined in the git config, or return the
+// default one if there is none.
+//
+// The states are listed in order in "git-bug.workflow.states", comma
+// separated, and each of them can have keys under "git-bug.workflow.<state>":
+//
+//	status  "open" (the default) or "closed"
+//	next    the states a bug can move to, comma separated. If not set, any
+//	        state can follow; if empty, the state is final.
+func LoadWorkflow(config repository.ConfigRead) (*Workflow, error) {
+	raw, err := config.ReadAll(workflowConfigKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	names, ok := raw[workflowConfigKeyStates]
+	if !ok {
+		return DefaultWorkflow(), nil
+	}
+
+	result := &Workflow{}
+	for _, name := range splitConfigList(names) {
+		if err := ValidateStateName(name); err != nil {
+			return nil, err
+		}
+		if _, ok := result.State(name); ok {
+			return nil, fmt.Errorf("workflow: duplicated state %s", name)
+		}
+
+		state := WorkflowState{Name: name, Status: common.OpenStatus}
+
+		if value, ok := raw[workflowConfigKeyPrefix+name+"."+workflowConfigKeyStatus]; ok {
+			state.Status, err = common.StatusFromString(value)
+			if err != nil {
+				return nil, fmt.Errorf("workflow: state %s: %v \"%s\"", name, err, value)
+			}
+		}
+		if value, ok := raw[workflowConfigKeyPrefix+name+"."+workflowConfigKeyNext]; ok {
+			state.Next = append([]string{}, splitConfigList(value)...)
+		}
+
+		result.States = append(result.States, state)
+	}
+
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Validate check that the workflow is consistent
+func (w *Workflow) Validate() error {
+	var hasOpen, hasClosed bool
+
+	for _, state := range w.States {
+		switch state.Status {
+		case common.OpenStatus:
+			hasOpen = true
+		case common.ClosedStatus:
+			hasClosed = true
+		default:
+			return fmt.Errorf("workflow: state %s: invalid status", state.Name)
+		}
+
+		// the states named after a status are still matched as such in a query
+		if s, err := common.StatusFromString(state.Name); err == nil && s != state.Status {
+			return fmt.Errorf("workflow: state %s must have the status %s", state.Name, s)
+		}
+
+		for _, next := range state.Next {
+			if _, ok := w.State(next); !ok {
+				return fmt.Errorf("workflow: state %s: unknown next state %s", state.Name, next)
+			}
+		}
+	}
+
+	if !hasOpen || !hasClosed {
+		return fmt.Errorf("workflow: at least one open and one closed state are needed")
+	}
+
+	return nil
+}
+
+// State return the state of the workflow with the given name
+func (w *Workflow) State(name string) (WorkflowState, bool) {
+	for _, state := range w.States {
+		if state.Name == name {
+			return state, true
+		}
+	}
+	return WorkflowState{}, false
+}
+
+// StateOf return the state of a bug, given its status and the state recorded
+// with it, if any.
+func (w *Workflow) StateOf(status common.Status, state string) string {
+	if state != "" {
+		return state
+	}
+	for _, s := range w.States {
+		if s.Status == status {
+			return s.Name
+		}
+	}
+	return status.String()
+}
+
+// NextStates return the states a bug can move to from the given state. A
+// bug in a state unknown to the workflow, recorded with another definition
+// of the workflow, can move to any state.
+func (w *Workflow) NextStates(from string) []string {
+	state, ok := w.State(from)
+	if ok && state.Next != nil {
+		return state.Next
+	}
+
+	result := make([]string, 0, len(w.States))
+	for _, s := range w.States {
+		if s.Name != from {
+			result = append(result, s.Name)
+		}
+	}
+	return result
+}
+
+// ValidateTransition check that a bug can move from a state to another. Staying
+// in the same state is always allowed.
+func (w *Workflow) ValidateTransition(from string, to string) error {
+	if _, ok := w.State(to); !ok {
+		return fmt.Errorf("unknown state \"%s\", expected one of %s", to, strings.Join(w.names(), ", "))
+	}
+	if from == to {
+		return nil
+	}
+
+	next := w.NextStates(from)
+	for _, n := range next {
+		if n == to {
+			return nil
+		}
+	}
+
+	if len(next) == 0 {
+		return fmt.Errorf("a bug can't move from %s, which is a final state", from)
+	}
+	return fmt.Errorf("a bug can't move from %s to %s, only to %s", from, to, strings.Join(next, ", "))
+}
+
+func (w *Workflow) names() []string {
+	result := make([]string, len(w.States))
+	for i, state := range w.States {
+		result[i] = state.Name
+	}
+	return result
+}
+
+// ValidateStateName check that a state name can be used in the configuration
+// and in a query, as in "status:<name>"
+func ValidateStateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("empty state name")
+	}
+	if !text.SafeOneLine(name) || strings.ContainsAny(name, " ,.:\"") {
+		return fmt.Errorf("invalid state name \"%s\"", name)
+	}
+	return nil
+}
+
+func splitConfigList(value string) []string {
+	var result []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
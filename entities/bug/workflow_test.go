@@ -0,0 +1,87 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLoadWorkflow(t *testing.T) {
+	config := repository.NewMemConfig()
+
+	w, err := LoadWorkflow(config)
+	require.NoError(t, err)
+	require.Equal(t, DefaultWorkflow(), w)
+	require.Equal(t, "open", w.StateOf(common.OpenStatus, ""))
+	require.Equal(t, "closed", w.StateOf(common.ClosedStatus, ""))
+	require.NoError(t, w.ValidateTransition("closed", "open"))
+
+	require.NoError(t, config.StoreString("git-bug.workflow.states", "triage, in-progress,review,done,wontfix"))
+	require.NoError(t, config.StoreString("git-bug.workflow.triage.next", "in-progress,wontfix"))
+	require.NoError(t, config.StoreString("git-bug.workflow.review.next", "in-progress,done"))
+	require.NoError(t, config.StoreString("git-bug.workflow.done.status", "closed"))
+	require.NoError(t, config.StoreString("git-bug.workflow.done.next", ""))
+	require.NoError(t, config.StoreString("git-bug.workflow.wontfix.status", "closed"))
+
+	w, err = LoadWorkflow(config)
+	require.NoError(t, err)
+	require.Len(t, w.States, 5)
+	require.Equal(t, WorkflowState{Name: "done", Status: common.ClosedStatus, Next: []string{}}, w.States[3])
+	require.Equal(t, "triage", w.StateOf(common.OpenStatus, ""))
+	require.Equal(t, "done", w.StateOf(common.ClosedStatus, ""))
+	require.Equal(t, "review", w.StateOf(common.OpenStatus, "review"))
+
+	require.NoError(t, w.ValidateTransition("triage", "in-progress"))
+	require.NoError(t, w.ValidateTransition("triage", "triage"))
+	require.NoError(t, w.ValidateTransition("in-progress", "done"))
+	require.NoError(t, w.ValidateTransition("wontfix", "triage"))
+	require.Error(t, w.ValidateTransition("triage", "done"))
+	require.Error(t, w.ValidateTransition("done", "triage"))
+	require.Error(t, w.ValidateTransition("triage", "unknown"))
+	// a state from another definition of the workflow
+	require.NoError(t, w.ValidateTransition("blocked", "review"))
+
+	require.Equal(t, []string{"triage", "in-progress", "review", "done"}, w.NextStates("wontfix"))
+}
+
+func TestLoadWorkflowInvalid(t *testing.T) {
+	cases := map[string]map[string]string{
+		"no closed state": {
+			"git-bug.workflow.states": "todo,doing",
+		},
+		"unknown next state": {
+			"git-bug.workflow.states":      "todo,done",
+			"git-bug.workflow.done.status": "closed",
+			"git-bug.workflow.todo.next":   "doing",
+		},
+		"invalid status": {
+			"git-bug.workflow.states":      "todo,done",
+			"git-bug.workflow.done.status": "finished",
+		},
+		"state named after the other status": {
+			"git-bug.workflow.states": "todo,closed",
+		},
+		"duplicated state": {
+			"git-bug.workflow.states":      "todo,done,todo",
+			"git-bug.workflow.done.status": "closed",
+		},
+		"invalid name": {
+			"git-bug.workflow.states":      "to do,done",
+			"git-bug.workflow.done.status": "closed",
+		},
+	}
+
+	for name, values := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := repository.NewMemConfig()
+			for key, value := range values {
+				require.NoError(t, config.StoreString(key, value))
+			}
+			_, err := LoadWorkflow(config)
+			require.Error(t, err)
+		})
+	}
+}
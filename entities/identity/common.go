@@ -2,13 +2,12 @@ package identity
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	"github.com/MichaelMure/git-bug/entity"
 )
 
-var ErrIdentityNotExist = errors.New("identity doesn't exist")
+var ErrIdentityNotExist = entity.NewErrNotFound("identity")
 
 func NewErrMultipleMatch(matching []entity.Id) *entity.ErrMultipleMatch {
 	return entity.NewErrMultipleMatch("identity", matching)
@@ -308,6 +308,27 @@ func (i *Identity) Mutate(repo repository.RepoClock, f func(orig *Mutator)) erro
 	return nil
 }
 
+// AddSigningKey generate a new keypair, store its private key in the keyring of the repository
+// and add its public key to a new version of the identity, so that the operations of its
+// author are signed from now on. The identity still needs to be committed.
+func (i *Identity) AddSigningKey(repo repository.ClockedRepo) (*Key, error) {
+	key := GenerateKey()
+
+	err := key.storePrivate(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.Mutate(repo, func(orig *Mutator) {
+		orig.Keys = append(orig.Keys, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
 // Write the identity into the Repository. In particular, this ensure that
 // the Id is properly set.
 func (i *Identity) Commit(repo repository.ClockedRepo) error {
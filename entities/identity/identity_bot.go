@@ -0,0 +1,44 @@
+package identity
+
+import (
+	"errors"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// BotMetadataKey is the immutable metadata marking an identity as a bot,
+// used by automation like a CI rather than by a person
+const BotMetadataKey = "git-bug-bot"
+
+// ErrBotUserIdentity is returned when trying to act as a bot identity
+// locally. A bot can only author operations through an API token.
+var ErrBotUserIdentity = errors.New("a bot identity can't be the user identity, use an API token instead")
+
+// NewBotIdentity create a new identity marked as a bot. The marker is part of
+// its first version, and can't be removed later.
+func NewBotIdentity(repo repository.RepoClock, name string) (*Identity, error) {
+	i, err := NewIdentityFull(repo, name, "", "", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	i.SetMetadata(BotMetadataKey, "true")
+	return i, nil
+}
+
+// IsBot return true if the identity is a bot
+func (i *Identity) IsBot() bool {
+	return IsBotMetadata(i.ImmutableMetadata())
+}
+
+// IsBotMetadata return true if the immutable metadata of an identity mark it
+// as a bot
+func IsBotMetadata(metadata map[string]string) bool {
+	return metadata[BotMetadataKey] == "true"
+}
+
+// IsBotIdentity return true if the identity is a loaded bot identity. The
+// identities not fully loaded, like an IdentityStub, are never bots.
+func IsBotIdentity(i Interface) bool {
+	bot, ok := i.(interface{ IsBot() bool })
+	return ok && bot.IsBot()
+}
@@ -290,3 +290,30 @@ func TestIdentityRemove(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, ids, 0)
 }
+
+func TestBotIdentity(t *testing.T) {
+	repo := makeIdentityTestRepo(t)
+
+	bot, err := NewBotIdentity(repo, "CI")
+	require.NoError(t, err)
+	require.NoError(t, bot.Commit(repo))
+	require.True(t, bot.IsBot())
+	require.True(t, IsBotIdentity(bot))
+
+	human, err := NewIdentity(repo, "René Descartes", "rene.descartes@example.com")
+	require.NoError(t, err)
+	require.NoError(t, human.Commit(repo))
+	require.False(t, human.IsBot())
+
+	// the marker can't be removed
+	bot.SetMetadata(BotMetadataKey, "false")
+	require.NoError(t, bot.Commit(repo))
+	require.True(t, bot.IsBot())
+
+	require.ErrorIs(t, SetUserIdentity(repo, bot), ErrBotUserIdentity)
+
+	// a bot set as the user identity by other means is refused
+	require.NoError(t, repo.LocalConfig().StoreString(identityConfigKey, bot.Id().String()))
+	_, err = GetUserIdentity(repo)
+	require.ErrorIs(t, err, ErrBotUserIdentity)
+}
@@ -12,6 +12,9 @@ import (
 
 // SetUserIdentity store the user identity's id in the git config
 func SetUserIdentity(repo repository.RepoConfig, identity *Identity) error {
+	if identity.IsBot() {
+		return ErrBotUserIdentity
+	}
 	return repo.LocalConfig().StoreString(identityConfigKey, identity.Id().String())
 }
 
@@ -30,6 +33,12 @@ func GetUserIdentity(repo repository.Repo) (*Identity, error) {
 		}
 		return nil, err
 	}
+	if err != nil {
+		return nil, err
+	}
+	if i.IsBot() {
+		return nil, ErrBotUserIdentity
+	}
 
 	return i, nil
 }
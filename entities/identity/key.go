@@ -58,6 +58,11 @@ func (k *Key) Private() *packet.PrivateKey {
 	return k.private
 }
 
+// Fingerprint return the fingerprint of the public key, in hexadecimal
+func (k *Key) Fingerprint() string {
+	return fmt.Sprintf("%X", k.public.Fingerprint)
+}
+
 func (k *Key) Validate() error {
 	if k.public == nil {
 		return fmt.Errorf("nil public key")
@@ -0,0 +1,51 @@
+package identity
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// The trust statements of an identity toward other identities are stored as
+// mutable metadata of the trusting identity. This way, the trust graph is
+// versioned, signed and shared along the identities themselves.
+const trustMetadataPrefix = "git-bug-trust-"
+
+const (
+	trustValue   = "trusted"
+	untrustValue = "untrusted"
+)
+
+// Trust record that this identity vouches for the given identity.
+// The change needs to be committed.
+func (i *Identity) Trust(target entity.Id) {
+	i.SetMetadata(trustMetadataPrefix+target.String(), trustValue)
+}
+
+// Untrust revoke a previous trust statement toward the given identity.
+// The change needs to be committed.
+func (i *Identity) Untrust(target entity.Id) {
+	i.SetMetadata(trustMetadataPrefix+target.String(), untrustValue)
+}
+
+// Trusted return the ids of the identities currently trusted by this identity,
+// sorted by id.
+func (i *Identity) Trusted() []entity.Id {
+	return TrustedFromMetadata(i.MutableMetadata())
+}
+
+// TrustedFromMetadata extract the trusted identities from a set of mutable metadata.
+func TrustedFromMetadata(metadata map[string]string) []entity.Id {
+	var result []entity.Id
+	for key, value := range metadata {
+		if !strings.HasPrefix(key, trustMetadataPrefix) || value != trustValue {
+			continue
+		}
+		result = append(result, entity.Id(strings.TrimPrefix(key, trustMetadataPrefix)))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
@@ -0,0 +1,42 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+func TestTrust(t *testing.T) {
+	repo := makeIdentityTestRepo(t)
+
+	alice, err := NewIdentity(repo, "Alice", "alice@example.com")
+	require.NoError(t, err)
+	require.NoError(t, alice.Commit(repo))
+
+	bob, err := NewIdentity(repo, "Bob", "bob@example.com")
+	require.NoError(t, err)
+	require.NoError(t, bob.Commit(repo))
+
+	carol, err := NewIdentity(repo, "Carol", "carol@example.com")
+	require.NoError(t, err)
+	require.NoError(t, carol.Commit(repo))
+
+	require.Empty(t, alice.Trusted())
+
+	alice.Trust(bob.Id())
+	alice.Trust(carol.Id())
+	require.NoError(t, alice.Commit(repo))
+
+	loaded, err := ReadLocal(repo, alice.Id())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entity.Id{bob.Id(), carol.Id()}, loaded.Trusted())
+
+	loaded.Untrust(bob.Id())
+	require.NoError(t, loaded.Commit(repo))
+
+	loaded, err = ReadLocal(repo, alice.Id())
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{carol.Id()}, loaded.Trusted())
+}
@@ -132,6 +132,7 @@ func (v *version) Clone() *version {
 	copy(clone.nonce, v.nonce)
 
 	// not copying metadata
+	clone.metadata = nil
 
 	return &clone
 }
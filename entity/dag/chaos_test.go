@@ -0,0 +1,49 @@
+package dag
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+func TestChaos(t *testing.T) {
+	_, _, _, _, def := makeTestContext()
+
+	ChaosTest(t, def, ChaosConfig{}, ChaosWorkload{
+		Create: func(r *ChaosReplica, rnd *rand.Rand) (entity.Id, error) {
+			e := New(def)
+			e.Append(newOp1(r.Author, fmt.Sprintf("created by %s", r.Name)))
+			if err := e.Commit(r.Repo); err != nil {
+				return "", err
+			}
+			return e.Id(), nil
+		},
+		Edit: func(r *ChaosReplica, rnd *rand.Rand, id entity.Id) error {
+			e, err := Read(def, r.Repo, r.Resolvers, id)
+			if err != nil {
+				return err
+			}
+			for n := 1 + rnd.Intn(3); n > 0; n-- {
+				if rnd.Intn(2) == 0 {
+					e.Append(newOp1(r.Author, fmt.Sprintf("%d", rnd.Int())))
+				} else {
+					e.Append(newOp2(r.Author, fmt.Sprintf("%d", rnd.Int())))
+				}
+			}
+			return e.Commit(r.Repo)
+		},
+		Summary: func(r *ChaosReplica, id entity.Id) (interface{}, error) {
+			e, err := Read(def, r.Repo, r.Resolvers, id)
+			if err != nil {
+				return nil, err
+			}
+			var ids []entity.Id
+			for _, op := range e.Operations() {
+				ids = append(ids, op.Id())
+			}
+			return ids, nil
+		},
+	})
+}
@@ -0,0 +1,275 @@
+package dag
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// ChaosConfig is the configuration of a ChaosTest. The zero values are
+// replaced by the defaults.
+type ChaosConfig struct {
+	// Seed of the randomized schedule. If zero, a random seed is used. The
+	// seed is logged, to be able to replay a failing schedule.
+	Seed int64
+	// Replicas is the number of simulated repositories (default 4)
+	Replicas int
+	// Rounds is the number of rounds of edition and synchronization (default 8)
+	Rounds int
+	// Actions is the maximum number of local actions of each replica in a
+	// round (default 3)
+	Actions int
+	// Syncs is the number of pulls between the replicas of a same partition
+	// in a round (default 4)
+	Syncs int
+}
+
+func (c *ChaosConfig) setDefaults() {
+	if c.Seed == 0 {
+		c.Seed = time.Now().UnixNano()
+	}
+	if c.Replicas == 0 {
+		c.Replicas = 4
+	}
+	if c.Rounds == 0 {
+		c.Rounds = 8
+	}
+	if c.Actions == 0 {
+		c.Actions = 3
+	}
+	if c.Syncs == 0 {
+		c.Syncs = 4
+	}
+}
+
+// ChaosReplica is one of the repositories simulated by a ChaosTest
+type ChaosReplica struct {
+	Name      string
+	Repo      repository.TestedRepo
+	Author    identity.Interface
+	Resolvers entity.Resolvers
+
+	// skew is the offset of the wall clock of the replica
+	skew time.Duration
+}
+
+// UnixTime return a time for a new operation, from the skewed wall clock of
+// the replica and a random jitter, so that the wall clocks of the operations
+// don't reflect their order.
+func (r *ChaosReplica) UnixTime(rnd *rand.Rand) int64 {
+	jitter := time.Duration(rnd.Intn(3600)-1800) * time.Second
+	return time.Now().Add(r.skew + jitter).Unix()
+}
+
+// ChaosWorkload is the part of a ChaosTest specific to a kind of entity
+type ChaosWorkload struct {
+	// Create create and commit a new entity, and return its id
+	Create func(r *ChaosReplica, rnd *rand.Rand) (entity.Id, error)
+	// Edit append some random operations to an existing entity, and commit them
+	Edit func(r *ChaosReplica, rnd *rand.Rand, id entity.Id) error
+	// Summary return a comparable summary of the compiled entity, that must be
+	// the same on every replica once they converged
+	Summary func(r *ChaosReplica, id entity.Id) (interface{}, error)
+}
+
+// ChaosTest simulate a set of repositories concurrently creating and editing
+// the entities of a definition, and synchronizing them while partitioned in
+// random groups, following a randomized schedule. Once the partitions are
+// healed, it checks that all the repositories converged to the same refs and
+// the same compiled entities, and that their lamport clocks are consistent.
+func ChaosTest(t *testing.T, def Definition, cfg ChaosConfig, workload ChaosWorkload) {
+	t.Helper()
+
+	cfg.setDefaults()
+	t.Logf("chaos test seed: %d", cfg.Seed)
+	rnd := rand.New(rand.NewSource(cfg.Seed))
+
+	replicas := makeChaosReplicas(t, cfg, rnd)
+
+	for round := 0; round < cfg.Rounds; round++ {
+		// local actions, concurrently as the replicas don't share anything
+		seeds := make([]int64, len(replicas))
+		for i := range seeds {
+			seeds[i] = rnd.Int63()
+		}
+
+		var wg sync.WaitGroup
+		errs := make([]error, len(replicas))
+		for i, r := range replicas {
+			wg.Add(1)
+			go func(i int, r *ChaosReplica) {
+				defer wg.Done()
+				errs[i] = chaosActions(def, r, rand.New(rand.NewSource(seeds[i])), cfg.Actions, workload)
+			}(i, r)
+		}
+		wg.Wait()
+		for i, err := range errs {
+			require.NoError(t, err, "round %d, replica %s", round, replicas[i].Name)
+		}
+
+		// synchronization inside the partitions
+		partition := make([]int, len(replicas))
+		groups := 1 + rnd.Intn(len(replicas))
+		for i := range partition {
+			partition[i] = rnd.Intn(groups)
+		}
+		for s := 0; s < cfg.Syncs; s++ {
+			a, b := rnd.Intn(len(replicas)), rnd.Intn(len(replicas))
+			if a == b || partition[a] != partition[b] {
+				continue
+			}
+			_, err := chaosPull(def, replicas[a], replicas[b])
+			require.NoError(t, err, "round %d, %s pulling from %s", round, replicas[a].Name, replicas[b].Name)
+		}
+	}
+
+	// heal the partitions, until nothing changes anymore
+	converged := false
+	for pass := 0; pass < 2*len(replicas)+2 && !converged; pass++ {
+		converged = true
+		for _, a := range replicas {
+			for _, b := range replicas {
+				if a == b {
+					continue
+				}
+				changed, err := chaosPull(def, a, b)
+				require.NoError(t, err, "healing, %s pulling from %s", a.Name, b.Name)
+				converged = converged && !changed
+			}
+		}
+	}
+	require.True(t, converged, "the replicas didn't converge")
+
+	assertChaosConvergence(t, def, replicas, workload)
+}
+
+func makeChaosReplicas(t *testing.T, cfg ChaosConfig, rnd *rand.Rand) []*ChaosReplica {
+	replicas := make([]*ChaosReplica, cfg.Replicas)
+	for i := range replicas {
+		replicas[i] = &ChaosReplica{
+			Name: fmt.Sprintf("replica%d", i),
+			Repo: repository.CreateGoGitTestRepo(t, false),
+			skew: time.Duration(rnd.Intn(48)-24) * time.Hour,
+		}
+	}
+
+	for _, a := range replicas {
+		for _, b := range replicas {
+			if a != b {
+				require.NoError(t, a.Repo.AddRemote(b.Name, b.Repo.GetLocalRemote()))
+			}
+		}
+	}
+
+	// every replica has its own author, known by all the replicas
+	for _, r := range replicas {
+		author, err := identity.NewIdentity(r.Repo, r.Name, r.Name+"@example.com")
+		require.NoError(t, err)
+		require.NoError(t, author.Commit(r.Repo))
+		r.Author = author
+	}
+	for _, a := range replicas {
+		for _, b := range replicas {
+			if a != b {
+				require.NoError(t, identity.Pull(a.Repo, b.Name))
+			}
+		}
+		a.Resolvers = entity.Resolvers{
+			&identity.Identity{}: identity.NewSimpleResolver(a.Repo),
+		}
+	}
+
+	return replicas
+}
+
+// chaosActions perform a random number of local actions on a replica
+func chaosActions(def Definition, r *ChaosReplica, rnd *rand.Rand, maxActions int, workload ChaosWorkload) error {
+	for n := rnd.Intn(maxActions + 1); n > 0; n-- {
+		ids, err := ListLocalIds(def, r.Repo)
+		if err != nil {
+			return err
+		}
+
+		if len(ids) == 0 || rnd.Intn(4) == 0 {
+			_, err = workload.Create(r, rnd)
+		} else {
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			err = workload.Edit(r, rnd, ids[rnd.Intn(len(ids))])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chaosPull fetch and merge the entities of a replica into another, and tell
+// if something changed
+func chaosPull(def Definition, into *ChaosReplica, from *ChaosReplica) (bool, error) {
+	_, err := Fetch(def, into.Repo, from.Name)
+	if err != nil {
+		return false, err
+	}
+
+	changed := false
+	for result := range MergeAll(def, into.Repo, into.Resolvers, from.Name, into.Author) {
+		switch result.Status {
+		case entity.MergeStatusError:
+			return false, result.Err
+		case entity.MergeStatusInvalid:
+			return false, fmt.Errorf("invalid entity %s: %s", result.Id, result.Reason)
+		case entity.MergeStatusNew, entity.MergeStatusUpdated:
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+func assertChaosConvergence(t *testing.T, def Definition, replicas []*ChaosReplica, workload ChaosWorkload) {
+	t.Helper()
+
+	ref := replicas[0]
+	ids, err := ListLocalIds(def, ref.Repo)
+	require.NoError(t, err)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, r := range replicas {
+		localIds, err := ListLocalIds(def, r.Repo)
+		require.NoError(t, err)
+		require.ElementsMatch(t, ids, localIds, "entities of %s", r.Name)
+
+		createClock, err := r.Repo.GetOrCreateClock(fmt.Sprintf(creationClockPattern, def.Namespace))
+		require.NoError(t, err)
+		editClock, err := r.Repo.GetOrCreateClock(fmt.Sprintf(editClockPattern, def.Namespace))
+		require.NoError(t, err)
+
+		for _, id := range ids {
+			refName := fmt.Sprintf(refsPattern, def.Namespace, id.String())
+			expectedHash, err := ref.Repo.ResolveRef(refName)
+			require.NoError(t, err)
+			hash, err := r.Repo.ResolveRef(refName)
+			require.NoError(t, err)
+			require.Equal(t, expectedHash, hash, "ref of %s on %s", id.Human(), r.Name)
+
+			expected, err := workload.Summary(ref, id)
+			require.NoError(t, err)
+			summary, err := workload.Summary(r, id)
+			require.NoError(t, err)
+			require.Equal(t, expected, summary, "entity %s on %s", id.Human(), r.Name)
+
+			e, err := Read(def, r.Repo, r.Resolvers, id)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, createClock.Time(), e.CreateLamportTime(), "creation clock of %s", r.Name)
+			require.GreaterOrEqual(t, editClock.Time(), e.EditLamportTime(), "edition clock of %s", r.Name)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package dag
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+const checkpointEntryName = "checkpoint"
+
+// checkpoint is a compiled state of an entity, stored in the git tree of an
+// operationPack, so that the operations before it don't need to be replayed.
+//
+// As the operations of concurrent editions are ordered only when reading the
+// entity, a checkpoint is only valid if the operations it was compiled from
+// are still the first ones in the same order. This is checked with a hash of
+// their ids.
+type checkpoint struct {
+	// Ops is the number of operations compiled in the checkpoint
+	Ops int `json:"ops"`
+	// OpsHash is derived from the ids of those operations, in order
+	OpsHash entity.Id `json:"ops_hash"`
+	// Data is the checkpoint itself, as encoded by the Definition's Checkpointer
+	Data json.RawMessage `json:"data"`
+}
+
+func (c *checkpoint) Validate() error {
+	if c.Ops <= 0 {
+		return fmt.Errorf("checkpoint without operations")
+	}
+	if err := c.OpsHash.Validate(); err != nil {
+		return fmt.Errorf("invalid checkpoint operations hash: %w", err)
+	}
+	if len(c.Data) == 0 {
+		return fmt.Errorf("empty checkpoint")
+	}
+	return nil
+}
+
+// opsHash derive an Id from the ids of the operations, in order
+func opsHash(ops []Operation) entity.Id {
+	var sb strings.Builder
+	for _, op := range ops {
+		sb.WriteString(op.Id().String())
+		sb.WriteString("\n")
+	}
+	return entity.DeriveId([]byte(sb.String()))
+}
+
+// latestCheckpoint return the checkpoint covering the most operations that
+// still matches the ordered operations, or nil if there is none.
+func latestCheckpoint(checkpoints []*checkpoint, ops []Operation) *checkpoint {
+	var latest *checkpoint
+	for _, c := range checkpoints {
+		if c.Ops > len(ops) || (latest != nil && c.Ops <= latest.Ops) {
+			continue
+		}
+		if opsHash(ops[:c.Ops]) == c.OpsHash {
+			latest = c
+		}
+	}
+	return latest
+}
+
+// makeCheckpoint compile a checkpoint if the operations about to be committed
+// reach the next checkpoint interval, or return nil.
+func (e *Entity) makeCheckpoint(toCommit []Operation) (*checkpoint, error) {
+	if e.Checkpointer == nil || e.CheckpointInterval <= 0 {
+		return nil, nil
+	}
+
+	count := len(e.ops) + len(toCommit)
+	if count/e.CheckpointInterval == len(e.ops)/e.CheckpointInterval {
+		return nil, nil
+	}
+
+	ops := make([]Operation, 0, count)
+	ops = append(ops, e.ops...)
+	ops = append(ops, toCommit...)
+
+	data, err := e.Checkpointer(ops)
+	if err != nil {
+		return nil, fmt.Errorf("can't compile the %s checkpoint: %w", e.Typename, err)
+	}
+
+	return &checkpoint{
+		Ops:     count,
+		OpsHash: opsHash(ops),
+		Data:    data,
+	}, nil
+}
+
+// Checkpoint return the latest checkpoint of the stored operations, as encoded
+// by the Definition's Checkpointer, and the number of operations it has been
+// compiled from. Those are the first operations returned by Operations(),
+// the following ones still need to be applied on top of it.
+// If there is no usable checkpoint, it returns nil and 0.
+//
+// A checkpoint is written by the author of the operations that reached it,
+// and is as trustworthy as their signature: it should be ignored when a
+// complete verification of the entity is required.
+func (e *Entity) Checkpoint() ([]byte, int) {
+	if e.checkpoint == nil {
+		return nil, 0
+	}
+	return e.checkpoint.Data, e.checkpoint.Ops
+}
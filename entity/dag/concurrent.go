@@ -0,0 +1,87 @@
+package dag
+
+import (
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// branches record the shape of the DAG of an Entity with merge commits, to
+// know which of its operations have been made concurrently.
+type branches struct {
+	// the commit holding each operation
+	opCommit map[entity.Id]repository.Hash
+	// the parents of each commit
+	parents map[repository.Hash][]repository.Hash
+	// the edit time of each commit, always higher than the one of its parents
+	editTime map[repository.Hash]lamport.Time
+}
+
+func newBranches(commits []repository.Commit, oppMap map[repository.Hash]*operationPack) *branches {
+	br := &branches{
+		opCommit: make(map[entity.Id]repository.Hash),
+		parents:  make(map[repository.Hash][]repository.Hash, len(commits)),
+		editTime: make(map[repository.Hash]lamport.Time, len(commits)),
+	}
+
+	for _, commit := range commits {
+		opp := oppMap[commit.Hash]
+		br.parents[commit.Hash] = commit.Parents
+		br.editTime[commit.Hash] = opp.EditTime
+		for _, op := range opp.Operations {
+			br.opCommit[op.Id()] = commit.Hash
+		}
+	}
+
+	return br
+}
+
+// isAncestor tell if the commit a is an ancestor of the commit b
+func (br *branches) isAncestor(a, b repository.Hash) bool {
+	visited := make(map[repository.Hash]struct{})
+	queue := []repository.Hash{b}
+
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+
+		for _, parent := range br.parents[hash] {
+			if parent == a {
+				return true
+			}
+			if _, ok := visited[parent]; ok {
+				continue
+			}
+			visited[parent] = struct{}{}
+			// the clocks are increasing along the DAG, no need to go further
+			// back than a
+			if br.editTime[parent] > br.editTime[a] {
+				queue = append(queue, parent)
+			}
+		}
+	}
+
+	return false
+}
+
+// Concurrent tell if two stored operations have been made concurrently, on
+// different branches of the DAG later merged, that is without the author of
+// one of them being aware of the other. Their order has then been decided
+// when merging, see read.
+func (e *Entity) Concurrent(a, b entity.Id) bool {
+	if e.branches == nil {
+		// no merge commit
+		return false
+	}
+
+	commitA, ok := e.branches.opCommit[a]
+	if !ok {
+		return false
+	}
+	commitB, ok := e.branches.opCommit[b]
+	if !ok || commitA == commitB {
+		return false
+	}
+
+	return !e.branches.isAncestor(commitA, commitB) && !e.branches.isAncestor(commitB, commitA)
+}
@@ -0,0 +1,102 @@
+package dag
+
+import (
+	"encoding/json"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// contentId return an identifier of the content of an operation: its
+// serialized data and its author, but not its random nonce. Two operations
+// with the same contentId are the same change, recorded twice, for example
+// when a bridge imported the same remote event from two clones before they
+// synchronized, or when the same commits were replayed on both sides.
+func contentId(op Operation) (entity.Id, error) {
+	data, err := json.Marshal(op)
+	if err != nil {
+		return "", err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+	delete(fields, "nonce")
+
+	if op.Author() != nil {
+		author, err := json.Marshal(op.Author().Id())
+		if err != nil {
+			return "", err
+		}
+		fields["author"] = author
+	}
+
+	// a map is serialized with its keys sorted
+	data, err = json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+
+	return entity.DeriveId(data), nil
+}
+
+// dedupOperations remove from ordered operations the ones with the same
+// content as a previous one, and return the ids of the removed operations.
+//
+// As the operations are read in the same order on every clone, the same
+// operation is kept everywhere. The operations referencing a removed one
+// behave as if their target doesn't exist.
+func dedupOperations(ops []Operation) ([]Operation, []entity.Id, error) {
+	// the content is only compared for the operations of the same type and
+	// time, to not serialize all of them again on every read
+	type key struct {
+		opType   OperationType
+		unixTime int64
+	}
+	candidates := make(map[key][]Operation)
+	contentIds := make(map[Operation]entity.Id)
+
+	getContentId := func(op Operation) (entity.Id, error) {
+		if id, ok := contentIds[op]; ok {
+			return id, nil
+		}
+		id, err := contentId(op)
+		if err != nil {
+			return "", err
+		}
+		contentIds[op] = id
+		return id, nil
+	}
+
+	result := make([]Operation, 0, len(ops))
+	var duplicates []entity.Id
+
+	for _, op := range ops {
+		k := key{opType: op.Type(), unixTime: op.Time().Unix()}
+
+		duplicate := false
+		for _, other := range candidates[k] {
+			otherId, err := getContentId(other)
+			if err != nil {
+				return nil, nil, err
+			}
+			id, err := getContentId(op)
+			if err != nil {
+				return nil, nil, err
+			}
+			if id == otherId {
+				duplicate = true
+				break
+			}
+		}
+
+		if duplicate {
+			duplicates = append(duplicates, op.Id())
+			continue
+		}
+		candidates[k] = append(candidates[k], op)
+		result = append(result, op)
+	}
+
+	return result, duplicates, nil
+}
@@ -31,6 +31,12 @@ type Definition struct {
 	OperationUnmarshaler OperationUnmarshaler
 	// the expected format version number, that can be used for data migration/upgrade
 	FormatVersion uint
+	// optional, a function compiling the given operations into a JSON checkpoint
+	// of the entity state, stored with the operations so that reading a long-lived
+	// entity doesn't require to replay all of them. See Entity.Checkpoint.
+	Checkpointer func(ops []Operation) ([]byte, error)
+	// the number of operations between two checkpoints, if a Checkpointer is set
+	CheckpointInterval int
 }
 
 // Entity is a data structure stored in a chain of git objects, supporting actions like Push, Pull and Merge.
@@ -49,6 +55,15 @@ type Entity struct {
 	staging []Operation
 
 	lastCommit repository.Hash
+
+	// the ids of the stored operations dropped as duplicates of others
+	duplicates []entity.Id
+
+	// the latest checkpoint matching the stored operations, if any
+	checkpoint *checkpoint
+
+	// the shape of the DAG, if it has merge commits
+	branches *branches
 }
 
 // New create an empty Entity
@@ -87,39 +102,15 @@ func read(def Definition, repo repository.ClockedRepo, resolvers entity.Resolver
 		return nil, err
 	}
 
-	// Perform a breadth-first search to get a topological order of the DAG where we discover the
-	// parents commit and go back in time up to the chronological root
-
-	queue := make([]repository.Hash, 0, 32)
-	visited := make(map[repository.Hash]struct{})
-	BFSOrder := make([]repository.Commit, 0, 32)
-
-	queue = append(queue, rootHash)
-	visited[rootHash] = struct{}{}
-
-	for len(queue) > 0 {
-		// pop
-		hash := queue[0]
-		queue = queue[1:]
-
-		commit, err := repo.ReadCommit(hash)
-		if err != nil {
-			return nil, err
-		}
-
-		BFSOrder = append(BFSOrder, commit)
-
-		for _, parent := range commit.Parents {
-			if _, ok := visited[parent]; !ok {
-				queue = append(queue, parent)
-				// mark as visited
-				visited[parent] = struct{}{}
-			}
-		}
+	commits, err := readCommits(repo, rootHash)
+	if err != nil {
+		return nil, err
 	}
 
-	// Now, we can reverse this topological order and read the commits in an order where
-	// we are sure to have read all the chronological ancestors when we read a commit.
+	// Now, we order the commits so that all the chronological ancestors of a commit are read
+	// before it. Note: a reversed BFS order is not enough, as a merge can join branches of
+	// different length.
+	topoOrder := topologicalOrder(commits, rootHash)
 
 	// Next step is to:
 	// 1) read the operationPacks
@@ -127,10 +118,11 @@ func read(def Definition, repo repository.ClockedRepo, resolvers entity.Resolver
 
 	oppMap := make(map[repository.Hash]*operationPack)
 	var opsCount int
+	var checkpoints []*checkpoint
+	var hasMerge bool
 
-	for i := len(BFSOrder) - 1; i >= 0; i-- {
-		commit := BFSOrder[i]
-		isFirstCommit := i == len(BFSOrder)-1
+	for i, commit := range topoOrder {
+		isFirstCommit := i == 0
 		isMerge := len(commit.Parents) > 1
 
 		// Verify DAG structure: single chronological root, so only the root
@@ -153,6 +145,7 @@ func read(def Definition, repo repository.ClockedRepo, resolvers entity.Resolver
 		if isMerge && len(opp.Operations) > 0 {
 			return nil, fmt.Errorf("merge commit cannot have operations")
 		}
+		hasMerge = hasMerge || isMerge
 
 		// Check that the create lamport clock is set (not checked in Validate() as it's optional)
 		if isFirstCommit && opp.CreateTime <= 0 {
@@ -163,7 +156,7 @@ func read(def Definition, repo repository.ClockedRepo, resolvers entity.Resolver
 		for _, parentHash := range commit.Parents {
 			parentPack, ok := oppMap[parentHash]
 			if !ok {
-				panic("DFS failed")
+				panic("topological sort failed")
 			}
 
 			if parentPack.EditTime >= opp.EditTime {
@@ -181,6 +174,9 @@ func read(def Definition, repo repository.ClockedRepo, resolvers entity.Resolver
 
 		oppMap[commit.Hash] = opp
 		opsCount += len(opp.Operations)
+		if opp.Checkpoint != nil {
+			checkpoints = append(checkpoints, opp.Checkpoint)
+		}
 	}
 
 	// The clocks are fine, we witness them
@@ -232,15 +228,97 @@ func read(def Definition, repo repository.ClockedRepo, resolvers entity.Resolver
 		}
 	}
 
+	// The same change can be recorded twice on different branches of the DAG,
+	// only the first one is kept.
+	ops, duplicates, err := dedupOperations(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	var b *branches
+	if hasMerge {
+		b = newBranches(topoOrder, oppMap)
+	}
+
 	return &Entity{
 		Definition: def,
 		ops:        ops,
 		lastCommit: rootHash,
 		createTime: createTime,
 		editTime:   editTime,
+		duplicates: duplicates,
+		checkpoint: latestCheckpoint(checkpoints, ops),
+		branches:   b,
 	}, nil
 }
 
+// readCommits perform a breadth-first search to discover all the commits of the DAG, going
+// back in time from the head up to the chronological root
+func readCommits(repo repository.RepoData, rootHash repository.Hash) (map[repository.Hash]repository.Commit, error) {
+	queue := make([]repository.Hash, 0, 32)
+	commits := make(map[repository.Hash]repository.Commit)
+
+	queue = append(queue, rootHash)
+	commits[rootHash] = repository.Commit{}
+
+	for len(queue) > 0 {
+		// pop
+		hash := queue[0]
+		queue = queue[1:]
+
+		commit, err := repo.ReadCommit(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		commits[hash] = commit
+
+		for _, parent := range commit.Parents {
+			if _, ok := commits[parent]; !ok {
+				queue = append(queue, parent)
+				// mark as visited
+				commits[parent] = repository.Commit{}
+			}
+		}
+	}
+
+	return commits, nil
+}
+
+// topologicalOrder return the commits of a DAG, ordered so that the parents of a commit
+// always come before it. The ordering is a depth-first post-order from the head.
+func topologicalOrder(commits map[repository.Hash]repository.Commit, head repository.Hash) []repository.Commit {
+	type frame struct {
+		hash repository.Hash
+		next int
+	}
+
+	order := make([]repository.Commit, 0, len(commits))
+	done := make(map[repository.Hash]struct{}, len(commits))
+	stack := []frame{{hash: head}}
+	done[head] = struct{}{}
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		parents := commits[top.hash].Parents
+
+		if top.next < len(parents) {
+			parent := parents[top.next]
+			top.next++
+			if _, ok := done[parent]; !ok {
+				done[parent] = struct{}{}
+				stack = append(stack, frame{hash: parent})
+			}
+			continue
+		}
+
+		order = append(order, commits[top.hash])
+		stack = stack[:len(stack)-1]
+	}
+
+	return order
+}
+
 // readClockNoCheck fetch from git, read and witness the clocks of an Entity at an arbitrary git reference.
 // Note: readClockNoCheck does not verify the integrity of the Entity and could witness incorrect or incomplete
 // clocks if so. If data integrity check is a requirement, a flow similar to read without actually reading/decoding
@@ -392,6 +470,11 @@ func (e *Entity) Operations() []Operation {
 	return append(e.ops, e.staging...)
 }
 
+// StagedOperations return the operations appended but not committed yet
+func (e *Entity) StagedOperations() []Operation {
+	return append([]Operation(nil), e.staging...)
+}
+
 // FirstOp lookup for the very first operation of the Entity
 func (e *Entity) FirstOp() Operation {
 	for _, op := range e.ops {
@@ -419,6 +502,17 @@ func (e *Entity) Append(op Operation) {
 	e.staging = append(e.staging, op)
 }
 
+// DiscardStaged remove the staged operations after the first n ones, for
+// example to roll back a set of changes that failed half-way
+func (e *Entity) DiscardStaged(n int) {
+	switch {
+	case n <= 0:
+		e.staging = nil
+	case n < len(e.staging):
+		e.staging = e.staging[:n]
+	}
+}
+
 // NeedCommit indicate if the in-memory state changed and need to be commit in the repository
 func (e *Entity) NeedCommit() bool {
 	return len(e.staging) > 0
@@ -470,6 +564,11 @@ func (e *Entity) Commit(repo repository.ClockedRepo) error {
 			EditTime:   e.editTime,
 		}
 
+		opp.Checkpoint, err = e.makeCheckpoint(toCommit)
+		if err != nil {
+			return err
+		}
+
 		if e.lastCommit == "" {
 			e.createTime, err = repo.Increment(fmt.Sprintf(creationClockPattern, e.Namespace))
 			if err != nil {
@@ -490,6 +589,9 @@ func (e *Entity) Commit(repo repository.ClockedRepo) error {
 
 		e.lastCommit = commitHash
 		e.ops = append(e.ops, toCommit...)
+		if opp.Checkpoint != nil {
+			e.checkpoint = opp.Checkpoint
+		}
 	}
 
 	// not strictly necessary but make equality testing easier in tests
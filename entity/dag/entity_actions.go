@@ -66,6 +66,14 @@ func Pull(def Definition, repo repository.ClockedRepo, resolvers entity.Resolver
 //    a merge commit with an empty operationPack is created to join both branch and form a DAG.
 //    --> emit entity.MergeStatusUpdated
 //
+// The unsigned operations brought by the merge are handled according to the SignaturePolicy of
+// the repository: reported in entity.MergeResult.UnsignedOperations, or refused with
+// entity.MergeStatusInvalid.
+//
+// The operations with the same content as another one, like the same remote event imported by
+// a bridge on both sides, are dropped when reading the Entity and reported in
+// entity.MergeResult.DuplicateOperations.
+//
 // Note: an author is necessary for the case where a merge commit is created, as this commit will
 // have an author and may be signed if a signing key is available.
 func MergeAll(def Definition, repo repository.ClockedRepo, resolvers entity.Resolvers, remote string, author identity.Interface) <-chan entity.MergeResult {
@@ -74,6 +82,12 @@ func MergeAll(def Definition, repo repository.ClockedRepo, resolvers entity.Reso
 	go func() {
 		defer close(out)
 
+		policy, err := LoadSignaturePolicy(repo.AnyConfig())
+		if err != nil {
+			out <- entity.MergeResult{Err: err}
+			return
+		}
+
 		remoteRefSpec := fmt.Sprintf("refs/remotes/%s/%s/", remote, def.Namespace)
 		remoteRefs, err := repo.ListRefs(remoteRefSpec)
 		if err != nil {
@@ -82,7 +96,7 @@ func MergeAll(def Definition, repo repository.ClockedRepo, resolvers entity.Reso
 		}
 
 		for _, remoteRef := range remoteRefs {
-			out <- merge(def, repo, resolvers, remoteRef, author)
+			out <- merge(def, repo, resolvers, remoteRef, author, policy)
 		}
 	}()
 
@@ -91,7 +105,7 @@ func MergeAll(def Definition, repo repository.ClockedRepo, resolvers entity.Reso
 
 // merge perform a merge to make sure a local Entity is up-to-date.
 // See MergeAll for more details.
-func merge(def Definition, repo repository.ClockedRepo, resolvers entity.Resolvers, remoteRef string, author identity.Interface) entity.MergeResult {
+func merge(def Definition, repo repository.ClockedRepo, resolvers entity.Resolvers, remoteRef string, author identity.Interface, policy SignaturePolicy) entity.MergeResult {
 	id := entity.RefToId(remoteRef)
 
 	if err := id.Validate(); err != nil {
@@ -121,13 +135,23 @@ func merge(def Definition, repo repository.ClockedRepo, resolvers entity.Resolve
 	}
 
 	if !localExist {
+		unsigned := unsignedOperations(nil, remoteEntity.Operations())
+		if policy == SignatureRequired && len(unsigned) > 0 {
+			return newMergeUnsignedStatus(id, unsigned)
+		}
+
 		// the bug is not local yet, simply create the reference
 		err := repo.CopyRef(remoteRef, localRef)
 		if err != nil {
 			return entity.NewMergeError(err, id)
 		}
 
-		return entity.NewMergeNewStatus(id, remoteEntity)
+		result := entity.NewMergeNewStatus(id, remoteEntity)
+		result.AddedOperations, _ = mergeChanges(nil, remoteEntity.Operations())
+		if policy == SignatureWarn {
+			result.UnsignedOperations = unsigned
+		}
+		return result
 	}
 
 	localCommit, err := repo.ResolveRef(localRef)
@@ -180,12 +204,29 @@ func merge(def Definition, repo repository.ClockedRepo, resolvers entity.Resolve
 		}
 	}
 
+	localEntity, err := read(def, repo, resolvers, localRef)
+	if err != nil {
+		return entity.NewMergeError(err, id)
+	}
+
+	unsigned := unsignedOperations(localEntity.Operations(), remoteEntity.Operations())
+	if policy == SignatureRequired && len(unsigned) > 0 {
+		return newMergeUnsignedStatus(id, unsigned)
+	}
+	if policy != SignatureWarn {
+		unsigned = nil
+	}
+
 	if fastForwardPossible {
 		err = repo.UpdateRef(localRef, remoteCommit)
 		if err != nil {
 			return entity.NewMergeError(err, id)
 		}
-		return entity.NewMergeUpdatedStatus(id, remoteEntity)
+		result := entity.NewMergeUpdatedStatus(id, remoteEntity)
+		result.AddedOperations, result.Reordered = mergeChanges(localEntity.Operations(), remoteEntity.Operations())
+		result.DuplicateOperations = newDuplicates(localEntity, remoteEntity)
+		result.UnsignedOperations = unsigned
+		return result
 	}
 
 	// SCENARIO 5
@@ -197,11 +238,6 @@ func merge(def Definition, repo repository.ClockedRepo, resolvers entity.Resolve
 	// an empty operationPack.
 	// First step is to collect those clocks.
 
-	localEntity, err := read(def, repo, resolvers, localRef)
-	if err != nil {
-		return entity.NewMergeError(err, id)
-	}
-
 	editTime, err := repo.Increment(fmt.Sprintf(editClockPattern, def.Namespace))
 	if err != nil {
 		return entity.NewMergeError(err, id)
@@ -225,10 +261,63 @@ func merge(def Definition, repo repository.ClockedRepo, resolvers entity.Resolve
 		return entity.NewMergeError(err, id)
 	}
 
-	// Note: we don't need to update localEntity state (lastCommit, operations...) as we
-	// discard it entirely anyway.
+	// read the merged state, to know how the operations have been ordered
+	mergedEntity, err := read(def, repo, resolvers, localRef)
+	if err != nil {
+		return entity.NewMergeError(err, id)
+	}
+
+	result := entity.NewMergeUpdatedStatus(id, mergedEntity)
+	result.AddedOperations, result.Reordered = mergeChanges(localEntity.Operations(), mergedEntity.Operations())
+	result.DuplicateOperations = newDuplicates(localEntity, mergedEntity)
+	result.UnsignedOperations = unsigned
+	return result
+}
+
+// newMergeUnsignedStatus return the status of an Entity refused by the
+// signature policy
+func newMergeUnsignedStatus(id entity.Id, unsigned []entity.Id) entity.MergeResult {
+	return entity.NewMergeInvalidStatus(id,
+		fmt.Sprintf("operation %s is not signed, as required by the signature policy", unsigned[0].Human()))
+}
+
+// newDuplicates return the ids of the operations dropped as duplicates after
+// a merge, that were not already before
+func newDuplicates(before *Entity, after *Entity) []entity.Id {
+	existing := make(map[entity.Id]struct{}, len(before.duplicates))
+	for _, id := range before.duplicates {
+		existing[id] = struct{}{}
+	}
+
+	var result []entity.Id
+	for _, id := range after.duplicates {
+		if _, ok := existing[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// mergeChanges compare the operations of an Entity before and after a merge,
+// and return the ids of the added operations, and whether some of them are
+// now ordered before operations that were already there.
+func mergeChanges(before []Operation, after []Operation) (added []entity.Id, reordered bool) {
+	existing := make(map[entity.Id]struct{}, len(before))
+	for _, op := range before {
+		existing[op.Id()] = struct{}{}
+	}
+
+	for _, op := range after {
+		if _, ok := existing[op.Id()]; ok {
+			if len(added) > 0 {
+				reordered = true
+			}
+			continue
+		}
+		added = append(added, op.Id())
+	}
 
-	return entity.NewMergeUpdatedStatus(id, localEntity)
+	return added, reordered
 }
 
 // Remove delete an Entity.
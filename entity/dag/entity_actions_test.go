@@ -125,6 +125,11 @@ func assertMergeResults(t *testing.T, expected []entity.MergeResult, results <-c
 		case entity.MergeStatusNew, entity.MergeStatusUpdated:
 			require.NotNil(t, result.Entity)
 			require.Equal(t, expected[i].Id, result.Entity.Id())
+
+			if expected[i].AddedOperations != nil {
+				require.Equal(t, expected[i].AddedOperations, result.AddedOperations)
+				require.Equal(t, expected[i].Reordered, result.Reordered)
+			}
 		}
 
 		i++
@@ -232,12 +237,14 @@ func TestMerge(t *testing.T) {
 
 	assertMergeResults(t, []entity.MergeResult{
 		{
-			Id:     e1A.Id(),
-			Status: entity.MergeStatusNew,
+			Id:              e1A.Id(),
+			Status:          entity.MergeStatusNew,
+			AddedOperations: []entity.Id{e1A.Operations()[0].Id()},
 		},
 		{
-			Id:     e2A.Id(),
-			Status: entity.MergeStatusNew,
+			Id:              e2A.Id(),
+			Status:          entity.MergeStatusNew,
+			AddedOperations: []entity.Id{e2A.Operations()[0].Id()},
 		},
 	}, results)
 
@@ -301,12 +308,14 @@ func TestMerge(t *testing.T) {
 
 	assertMergeResults(t, []entity.MergeResult{
 		{
-			Id:     e1A.Id(),
-			Status: entity.MergeStatusUpdated,
+			Id:              e1A.Id(),
+			Status:          entity.MergeStatusUpdated,
+			AddedOperations: []entity.Id{e1A.Operations()[1].Id()},
 		},
 		{
-			Id:     e2A.Id(),
-			Status: entity.MergeStatusUpdated,
+			Id:              e2A.Id(),
+			Status:          entity.MergeStatusUpdated,
+			AddedOperations: []entity.Id{e2A.Operations()[1].Id()},
 		},
 	}, results)
 
@@ -383,6 +392,155 @@ func TestMerge(t *testing.T) {
 	assertEqualRefs(t, repoA, repoB, "refs/"+def.Namespace)
 }
 
+func TestMergeDuplicates(t *testing.T) {
+	repoA, repoB, _, id1, _, resolvers, def := makeTestContextRemote(t)
+
+	e := New(def)
+	e.Append(newOp1(id1, "foo"))
+	require.NoError(t, e.Commit(repoA))
+
+	_, err := Push(def, repoA, "remote")
+	require.NoError(t, err)
+	require.NoError(t, Pull(def, repoB, resolvers, "remote", id1))
+
+	// the same change is recorded on both sides, like a remote event imported
+	// by a bridge on two clones
+	eA, err := Read(def, repoA, resolvers, e.Id())
+	require.NoError(t, err)
+	opA := newOp2(id1, "imported")
+	opA.SetMetadata("origin", "remote-1")
+	eA.Append(opA)
+	require.NoError(t, eA.Commit(repoA))
+
+	eB, err := Read(def, repoB, resolvers, e.Id())
+	require.NoError(t, err)
+	opB := newOp2(id1, "imported")
+	opB.SetMetadata("origin", "remote-1")
+	eB.Append(opB)
+	// the same content with another metadata is not a duplicate
+	opOther := newOp2(id1, "imported")
+	opOther.SetMetadata("origin", "remote-2")
+	eB.Append(opOther)
+	require.NoError(t, eB.Commit(repoB))
+	require.NotEqual(t, opA.Id(), opB.Id())
+
+	_, err = Push(def, repoA, "remote")
+	require.NoError(t, err)
+	_, err = Fetch(def, repoB, "remote")
+	require.NoError(t, err)
+
+	var results []entity.MergeResult
+	for result := range MergeAll(def, repoB, resolvers, "remote", id1) {
+		require.NoError(t, result.Err)
+		results = append(results, result)
+	}
+	require.Len(t, results, 1)
+	require.Equal(t, entity.MergeStatusUpdated, results[0].Status)
+	require.Len(t, results[0].DuplicateOperations, 1)
+
+	_, err = Push(def, repoB, "remote")
+	require.NoError(t, err)
+	require.NoError(t, Pull(def, repoA, resolvers, "remote", id1))
+
+	// both sides keep the same operation
+	var kept []entity.Id
+	for _, repo := range []repository.ClockedRepo{repoA, repoB} {
+		merged, err := Read(def, repo, resolvers, e.Id())
+		require.NoError(t, err)
+		require.NoError(t, merged.Validate())
+		require.Len(t, merged.Operations(), 3)
+		require.Contains(t, []entity.Id{opA.Id(), opB.Id()}, merged.Operations()[1].Id())
+		require.Equal(t, opOther.Id(), merged.Operations()[2].Id())
+		kept = append(kept, merged.Operations()[1].Id())
+	}
+	require.Equal(t, kept[0], kept[1])
+}
+
+func TestMergeConcurrent(t *testing.T) {
+	repoA, repoB, _, id1, id2, resolvers, def := makeTestContextRemote(t)
+
+	e := New(def)
+	root := newOp1(id1, "foo")
+	e.Append(root)
+	require.NoError(t, e.Commit(repoA))
+
+	_, err := Push(def, repoA, "remote")
+	require.NoError(t, err)
+	require.NoError(t, Pull(def, repoB, resolvers, "remote", id1))
+
+	// without a merge, nothing is concurrent
+	opA1 := newOp2(id1, "A1")
+	e.Append(opA1)
+	require.NoError(t, e.Commit(repoA))
+	opA2 := newOp2(id1, "A2")
+	e.Append(opA2)
+	require.NoError(t, e.Commit(repoA))
+
+	eA, err := Read(def, repoA, resolvers, e.Id())
+	require.NoError(t, err)
+	require.False(t, eA.Concurrent(opA1.Id(), opA2.Id()))
+
+	eB, err := Read(def, repoB, resolvers, e.Id())
+	require.NoError(t, err)
+	opB := newOp2(id2, "B")
+	eB.Append(opB)
+	require.NoError(t, eB.Commit(repoB))
+
+	_, err = Push(def, repoA, "remote")
+	require.NoError(t, err)
+	require.NoError(t, Pull(def, repoB, resolvers, "remote", id1))
+
+	merged, err := Read(def, repoB, resolvers, e.Id())
+	require.NoError(t, err)
+	require.Len(t, merged.Operations(), 4)
+
+	require.True(t, merged.Concurrent(opA1.Id(), opB.Id()))
+	require.True(t, merged.Concurrent(opB.Id(), opA2.Id()))
+	require.False(t, merged.Concurrent(opA1.Id(), opA2.Id()))
+	require.False(t, merged.Concurrent(root.Id(), opB.Id()))
+	require.False(t, merged.Concurrent(root.Id(), root.Id()))
+
+	// an operation made after the merge is aware of all the others
+	opC := newOp2(id1, "C")
+	merged.Append(opC)
+	require.NoError(t, merged.Commit(repoB))
+	merged, err = Read(def, repoB, resolvers, e.Id())
+	require.NoError(t, err)
+	require.False(t, merged.Concurrent(opB.Id(), opC.Id()))
+	require.False(t, merged.Concurrent(opA2.Id(), opC.Id()))
+}
+
+func TestMergeChanges(t *testing.T) {
+	_, id1, _, _, _ := makeTestContext()
+
+	opA := newOp1(id1, "a")
+	opB := newOp1(id1, "b")
+	opC := newOp1(id1, "c")
+	opD := newOp1(id1, "d")
+
+	added, reordered := mergeChanges([]Operation{opA, opB}, []Operation{opA, opB, opC, opD})
+	require.Equal(t, []entity.Id{opC.Id(), opD.Id()}, added)
+	require.False(t, reordered)
+
+	added, reordered = mergeChanges([]Operation{opA, opB}, []Operation{opA, opC, opB})
+	require.Equal(t, []entity.Id{opC.Id()}, added)
+	require.True(t, reordered)
+
+	added, reordered = mergeChanges([]Operation{opA, opB}, []Operation{opA, opB})
+	require.Empty(t, added)
+	require.False(t, reordered)
+
+	result := entity.NewMergeUpdatedStatus("", nil)
+	result.AddedOperations, result.Reordered = added, reordered
+	require.Equal(t, "updated", result.String())
+	result.AddedOperations = []entity.Id{opC.Id(), opD.Id()}
+	require.Equal(t, "updated, 2 operations added", result.String())
+	result.Reordered = true
+	require.Equal(t, "updated, 2 operations added before local operations", result.String())
+	result.DuplicateOperations = []entity.Id{opA.Id()}
+	require.Equal(t, "updated, 2 operations added before local operations, 1 operation ignored as duplicates", result.String())
+}
+
 func TestRemove(t *testing.T) {
 	repoA, _, _, id1, _, resolvers, def := makeTestContextRemote(t)
 
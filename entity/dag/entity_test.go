@@ -1,6 +1,7 @@
 package dag
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -49,6 +50,71 @@ func TestWriteReadMultipleAuthor(t *testing.T) {
 	assertEqualEntities(t, entity, read)
 }
 
+func TestDiscardStaged(t *testing.T) {
+	repo, id1, _, resolver, def := makeTestContext()
+
+	entity := New(def)
+	entity.Append(newOp1(id1, "foo"))
+	require.NoError(t, entity.Commit(repo))
+
+	entity.Append(newOp2(id1, "bar"))
+	entity.Append(newOp2(id1, "foobar"))
+
+	entity.DiscardStaged(1)
+	require.Len(t, entity.StagedOperations(), 1)
+	require.Len(t, entity.Operations(), 2)
+
+	entity.DiscardStaged(0)
+	require.False(t, entity.NeedCommit())
+	require.Len(t, entity.Operations(), 1)
+
+	read, err := Read(def, repo, resolver, entity.Id())
+	require.NoError(t, err)
+
+	assertEqualEntities(t, entity, read)
+}
+
+func TestCheckpoint(t *testing.T) {
+	repo, id1, id2, resolver, def := makeTestContext()
+
+	def.CheckpointInterval = 3
+	def.Checkpointer = func(ops []Operation) ([]byte, error) {
+		return json.Marshal(len(ops))
+	}
+
+	entity := New(def)
+	entity.Append(newOp1(id1, "foo"))
+	entity.Append(newOp2(id1, "bar"))
+	require.NoError(t, entity.Commit(repo))
+
+	data, n := entity.Checkpoint()
+	require.Nil(t, data)
+	require.Zero(t, n)
+
+	// the interval is reached in the middle of the commit
+	entity.Append(newOp2(id2, "foobar"))
+	entity.Append(newOp2(id2, "foobaz"))
+	require.NoError(t, entity.Commit(repo))
+
+	data, n = entity.Checkpoint()
+	require.Equal(t, "4", string(data))
+	require.Equal(t, 4, n)
+
+	read, err := Read(def, repo, resolver, entity.Id())
+	require.NoError(t, err)
+	assertEqualEntities(t, entity, read)
+
+	data, n = read.Checkpoint()
+	require.Equal(t, "4", string(data))
+	require.Equal(t, 4, n)
+
+	// a checkpoint of operations now ordered differently is not used
+	ops := read.Operations()
+	reordered := []Operation{ops[0], ops[2], ops[1], ops[3]}
+	require.Nil(t, latestCheckpoint([]*checkpoint{read.checkpoint}, reordered))
+	require.Nil(t, latestCheckpoint([]*checkpoint{read.checkpoint}, ops[:3]))
+}
+
 func assertEqualEntities(t *testing.T, a, b *Entity) {
 	t.Helper()
 
@@ -57,13 +123,19 @@ func assertEqualEntities(t *testing.T, a, b *Entity) {
 
 	backOpUnA := a.Definition.OperationUnmarshaler
 	backOpUnB := b.Definition.OperationUnmarshaler
+	backCheckpointerA := a.Definition.Checkpointer
+	backCheckpointerB := b.Definition.Checkpointer
 
 	a.Definition.OperationUnmarshaler = nil
 	b.Definition.OperationUnmarshaler = nil
+	a.Definition.Checkpointer = nil
+	b.Definition.Checkpointer = nil
 
 	defer func() {
 		a.Definition.OperationUnmarshaler = backOpUnA
 		b.Definition.OperationUnmarshaler = backOpUnB
+		a.Definition.Checkpointer = backCheckpointerA
+		b.Definition.Checkpointer = backCheckpointerB
 	}()
 
 	require.Equal(t, a, b)
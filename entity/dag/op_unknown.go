@@ -0,0 +1,66 @@
+package dag
+
+import (
+	"encoding/json"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+var _ Operation = &UnknownOperation[Snapshot]{}
+var _ OperationDoesntChangeSnapshot = &UnknownOperation[Snapshot]{}
+
+// UnknownOperation is an operation this version of git-bug can't interpret,
+// written by a more recent version: either an unknown type of operation, or a
+// known type with a more recent schema version.
+//
+// The operation is kept as it was read, so that it is written back byte for
+// byte, keeping its Id, when the entity is rewritten or pushed. It doesn't
+// change the entity state.
+type UnknownOperation[SnapT Snapshot] struct {
+	OpBase
+	raw json.RawMessage
+}
+
+// NewUnknownOperation decode the common part of an operation, and keep the
+// rest as is.
+func NewUnknownOperation[SnapT Snapshot](raw json.RawMessage) (*UnknownOperation[SnapT], error) {
+	op := &UnknownOperation[SnapT]{
+		raw: append(json.RawMessage(nil), raw...),
+	}
+	if err := json.Unmarshal(raw, &op.OpBase); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (op *UnknownOperation[SnapT]) Id() entity.Id {
+	return IdOperation(op, &op.OpBase)
+}
+
+func (op *UnknownOperation[SnapT]) Apply(snapshot SnapT) {
+	// Nothing to do
+}
+
+func (op *UnknownOperation[SnapT]) Validate() error {
+	return op.OpBase.Validate(op, op.OperationType)
+}
+
+func (op *UnknownOperation[SnapT]) DoesntChangeSnapshot() {}
+
+// Raw return the operation as it was read
+func (op *UnknownOperation[SnapT]) Raw() json.RawMessage {
+	return op.raw
+}
+
+func (op *UnknownOperation[SnapT]) MarshalJSON() ([]byte, error) {
+	return op.raw, nil
+}
+
+func (op *UnknownOperation[SnapT]) isUnknown() {}
+
+// IsUnknownOperation return true if the operation is an UnknownOperation,
+// that can't be interpreted by this version of git-bug.
+func IsUnknownOperation(op Operation) bool {
+	_, ok := op.(interface{ isUnknown() })
+	return ok
+}
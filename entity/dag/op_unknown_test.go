@@ -0,0 +1,67 @@
+package dag
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+func TestUnknownOperation(t *testing.T) {
+	repo, id1, _, resolver, def := makeTestContext()
+
+	bar := newOp2(id1, "bar")
+	bar.UnixTime = 1
+
+	e := New(def)
+	e.Append(newOp1(id1, "foo"))
+	e.Append(bar)
+	require.NoError(t, e.Commit(repo))
+
+	// an older version, only knowing about op1
+	old := def
+	old.OperationUnmarshaler = func(raw json.RawMessage, resolvers entity.Resolvers) (Operation, error) {
+		op, err := unmarshaler(raw, resolvers)
+		if err != nil {
+			return nil, err
+		}
+		if op.Type() == Op1 {
+			return op, nil
+		}
+		return NewUnknownOperation[*snapshotMock](raw)
+	}
+
+	read, err := Read(old, repo, resolver, e.Id())
+	require.NoError(t, err)
+
+	ops := read.Operations()
+	require.Len(t, ops, 2)
+	require.False(t, IsUnknownOperation(ops[0]))
+	require.True(t, IsUnknownOperation(ops[1]))
+
+	// the operation is kept as is
+	unknown := ops[1].(*UnknownOperation[*snapshotMock])
+	require.Equal(t, Op2, unknown.Type())
+	require.Equal(t, e.Operations()[1].Id(), unknown.Id())
+	require.Equal(t, id1.Id(), unknown.Author().Id())
+	require.NoError(t, unknown.Validate())
+
+	raw, err := json.Marshal(unknown)
+	require.NoError(t, err)
+	require.JSONEq(t, string(unknown.Raw()), string(raw))
+
+	var decoded op2
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	require.Equal(t, "bar", decoded.Field2)
+
+	// and the entity can still be changed
+	read.Append(newOp1(id1, "foobar"))
+	require.NoError(t, read.Commit(repo))
+
+	read, err = Read(def, repo, resolver, e.Id())
+	require.NoError(t, err)
+	require.Len(t, read.Operations(), 3)
+	require.Equal(t, "bar", read.Operations()[1].(*op2).Field2)
+}
@@ -55,12 +55,18 @@ type Operation interface {
 	// AllMetadata return all metadata for this operation
 	AllMetadata() map[string]string
 
+	// Signed return true if the operation is stored in a commit signed with a
+	// valid key of its author
+	Signed() bool
+
 	// setId allow to set the Id, used when unmarshalling only
 	setId(id entity.Id)
 	// setAuthor allow to set the author, used when unmarshalling only
 	setAuthor(author identity.Interface)
 	// setExtraMetadataImmutable add a metadata not carried by the operation itself on the operation
 	setExtraMetadataImmutable(key string, value string)
+	// setSigned mark the operation as stored in a commit with a valid signature
+	setSigned()
 }
 
 // OperationWithFiles is an optional extension for an Operation that has files dependency, stored in git.
@@ -73,6 +79,20 @@ type OperationWithFiles interface {
 	GetFiles() []repository.Hash
 }
 
+// OperationWithExternalData is an optional extension for an Operation that store some of its data outside
+// the operation pack, in separate git blobs. This allows to keep the operation packs small when an Operation
+// carries a large payload, like a very long text.
+//
+// The external data is referenced by a content derived Id (see entity.DeriveId), kept in the Operation's
+// serialized data. The Operation is expected to exclude the externalized data from its serialized form.
+type OperationWithExternalData interface {
+	// GetExternalData return the external data of this operation, indexed by their content derived Id.
+	GetExternalData() map[entity.Id][]byte
+	// SetExternalData is called when reading the operation from storage, once for each of the
+	// external data referenced.
+	SetExternalData(ref entity.Id, data []byte) error
+}
+
 // OperationDoesntChangeSnapshot is an interface signaling that the Operation implementing it doesn't change the
 // snapshot, for example a metadata operation that act on other operations.
 type OperationDoesntChangeSnapshot interface {
@@ -93,7 +113,11 @@ type OpBase struct {
 	author identity.Interface
 
 	OperationType OperationType `json:"type"`
-	UnixTime      int64         `json:"timestamp"`
+	// SchemaVersion is the version of the serialized form of this type of
+	// operation, increased when it changes in a way the previous versions of
+	// git-bug can't interpret. Not serialized if zero, the initial version.
+	SchemaVersion uint  `json:"schema,omitempty"`
+	UnixTime      int64 `json:"timestamp"`
 
 	// mandatory random bytes to ensure a better randomness of the data used to later generate the ID
 	// len(Nonce) should be > 20 and < 64 bytes
@@ -104,6 +128,10 @@ type OpBase struct {
 	// Not serialized. Store the extra metadata in memory,
 	// compiled from SetMetadataOperation.
 	extraMetadata map[string]string
+
+	// Not serialized. True if the operation is stored in a commit
+	// with a verified signature of its author.
+	signed bool
 }
 
 func NewOpBase(opType OperationType, author identity.Interface, unixTime int64) OpBase {
@@ -202,6 +230,16 @@ func (base *OpBase) Author() identity.Interface {
 	return base.author
 }
 
+// Signed return true if the operation is stored in a commit signed with a
+// valid key of its author, and that signature has been verified.
+func (base *OpBase) Signed() bool {
+	return base.signed
+}
+
+func (base *OpBase) setSigned() {
+	base.signed = true
+}
+
 // IdIsSet returns true if the id has been set already
 func (base *OpBase) IdIsSet() bool {
 	return base.id != "" && base.id != entity.UnsetId
@@ -3,6 +3,7 @@ package dag
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -18,6 +19,7 @@ import (
 
 const opsEntryName = "ops"
 const extraEntryName = "extra"
+const externalEntryPrefix = "external-"
 const versionEntryPrefix = "version-"
 const createClockEntryPrefix = "create-clock-"
 const editClockEntryPrefix = "edit-clock-"
@@ -38,6 +40,9 @@ type operationPack struct {
 	// Encode the entity's logical time of last edition across all entities of the same type.
 	// Exist on all operationPack
 	EditTime lamport.Time
+	// An optional compiled state of the entity, up to the last Operation of this operationPack.
+	// Stored in its own blob, so it doesn't change the Id.
+	Checkpoint *checkpoint
 }
 
 func (opp *operationPack) Id() entity.Id {
@@ -118,6 +123,7 @@ func (opp *operationPack) Write(def Definition, repo repository.Repo, parentComm
 	// - format version
 	// - clocks
 	// - extra data
+	// - checkpoint
 	tree := []repository.TreeEntry{
 		{ObjectType: repository.Blob, Hash: emptyBlobHash,
 			Name: fmt.Sprintf(versionEntryPrefix+"%d", def.FormatVersion)},
@@ -133,7 +139,11 @@ func (opp *operationPack) Write(def Definition, repo repository.Repo, parentComm
 			Name:       fmt.Sprintf(createClockEntryPrefix+"%d", opp.CreateTime),
 		})
 	}
-	if extraTree := opp.makeExtraTree(); len(extraTree) > 0 {
+	extraTree, err := opp.makeExtraTree(repo)
+	if err != nil {
+		return "", err
+	}
+	if len(extraTree) > 0 {
 		extraTreeHash, err := repo.StoreTree(extraTree)
 		if err != nil {
 			return "", err
@@ -144,6 +154,21 @@ func (opp *operationPack) Write(def Definition, repo repository.Repo, parentComm
 			Name:       extraEntryName,
 		})
 	}
+	if opp.Checkpoint != nil {
+		data, err := json.Marshal(opp.Checkpoint)
+		if err != nil {
+			return "", err
+		}
+		checkpointHash, err := repo.StoreData(data)
+		if err != nil {
+			return "", err
+		}
+		tree = append(tree, repository.TreeEntry{
+			ObjectType: repository.Blob,
+			Hash:       checkpointHash,
+			Name:       checkpointEntryName,
+		})
+	}
 
 	// Store the tree
 	treeHash, err := repo.StoreTree(tree)
@@ -171,21 +196,27 @@ func (opp *operationPack) Write(def Definition, repo repository.Repo, parentComm
 		return "", err
 	}
 
+	if signingKey != nil {
+		for _, op := range opp.Operations {
+			op.setSigned()
+		}
+	}
+
 	return commitHash, nil
 }
 
-func (opp *operationPack) makeExtraTree() []repository.TreeEntry {
+func (opp *operationPack) makeExtraTree(repo repository.RepoData) ([]repository.TreeEntry, error) {
 	var tree []repository.TreeEntry
 	counter := 0
 	added := make(map[repository.Hash]interface{})
 
-	for _, ops := range opp.Operations {
-		ops, ok := ops.(OperationWithFiles)
+	for _, op := range opp.Operations {
+		op, ok := op.(OperationWithFiles)
 		if !ok {
 			continue
 		}
 
-		for _, file := range ops.GetFiles() {
+		for _, file := range op.GetFiles() {
 			if _, has := added[file]; !has {
 				tree = append(tree, repository.TreeEntry{
 					ObjectType: repository.Blob,
@@ -200,13 +231,127 @@ func (opp *operationPack) makeExtraTree() []repository.TreeEntry {
 		}
 	}
 
-	return tree
+	// External data are stored in their own blob, and named after their content
+	// derived Id, so that they can be found again when reading.
+	var externalTree []repository.TreeEntry
+	externalAdded := make(map[entity.Id]interface{})
+
+	for _, op := range opp.Operations {
+		op, ok := op.(OperationWithExternalData)
+		if !ok {
+			continue
+		}
+
+		for ref, data := range op.GetExternalData() {
+			if _, has := externalAdded[ref]; has {
+				continue
+			}
+			hash, err := repo.StoreData(data)
+			if err != nil {
+				return nil, err
+			}
+			externalTree = append(externalTree, repository.TreeEntry{
+				ObjectType: repository.Blob,
+				Hash:       hash,
+				Name:       externalEntryPrefix + ref.String(),
+			})
+			externalAdded[ref] = struct{}{}
+		}
+	}
+
+	// keep a stable ordering, as the map iteration order is random
+	sort.Slice(externalTree, func(i, j int) bool {
+		return externalTree[i].Name < externalTree[j].Name
+	})
+
+	return append(tree, externalTree...), nil
 }
 
-// readOperationPack read the operationPack encoded in git at the given Tree hash.
+// readExternalData read the external data stored in the extra tree, and hand it over to the
+// operations referencing it.
+func readExternalData(repo repository.RepoData, extraTreeHash repository.Hash, ops []Operation) error {
+	entries, err := repo.ReadTree(extraTreeHash)
+	if err != nil {
+		return err
+	}
+
+	hashes := make(map[entity.Id]repository.Hash)
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name, externalEntryPrefix) {
+			hashes[entity.Id(strings.TrimPrefix(entry.Name, externalEntryPrefix))] = entry.Hash
+		}
+	}
+
+	for _, op := range ops {
+		op, ok := op.(OperationWithExternalData)
+		if !ok {
+			continue
+		}
+
+		for ref := range op.GetExternalData() {
+			hash, ok := hashes[ref]
+			if !ok {
+				return fmt.Errorf("missing external data %s", ref)
+			}
+			data, err := repo.ReadData(hash)
+			if err != nil {
+				return errors.Wrap(err, "failed to read external data")
+			}
+			if !ref.IsDerivedFrom(data) {
+				return fmt.Errorf("external data %s doesn't match its content", ref)
+			}
+			if err := op.SetExternalData(ref, data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// readOperationPack read the operationPack encoded in git at the given Tree hash, and verify
+// its signature if its author had keys at the time.
 //
 // Validity of the Lamport clocks is left for the caller to decide.
 func readOperationPack(def Definition, repo repository.RepoData, resolvers entity.Resolvers, commit repository.Commit) (*operationPack, error) {
+	opp, err := readOperationPackUnverified(def, repo, resolvers, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := verifySignature(def, commit, opp)
+	if err != nil {
+		return nil, err
+	}
+	if signed {
+		for _, op := range opp.Operations {
+			op.setSigned()
+		}
+	}
+
+	return opp, nil
+}
+
+// verifySignature check the signature of the commit holding an operationPack, if its author had
+// keys at the time. It returns true if the signature is valid, false if no signature is expected.
+func verifySignature(def Definition, commit repository.Commit, opp *operationPack) (bool, error) {
+	keys := opp.Author.ValidKeysAtTime(fmt.Sprintf(editClockPattern, def.Namespace), opp.EditTime)
+	if len(keys) == 0 {
+		return false, nil
+	}
+	if commit.Signature == nil {
+		return false, fmt.Errorf("signature failure: missing signature")
+	}
+	keyring := PGPKeyring(keys)
+	_, err := openpgp.CheckDetachedSignature(keyring, commit.SignedData, commit.Signature, nil)
+	if err != nil {
+		return false, fmt.Errorf("signature failure: %v", err)
+	}
+	return true, nil
+}
+
+// readOperationPackUnverified is readOperationPack, without checking the signature
+func readOperationPackUnverified(def Definition, repo repository.RepoData, resolvers entity.Resolvers, commit repository.Commit) (*operationPack, error) {
 	entries, err := repo.ReadTree(commit.TreeHash)
 	if err != nil {
 		return nil, err
@@ -239,9 +384,27 @@ func readOperationPack(def Definition, repo repository.RepoData, resolvers entit
 	var ops []Operation
 	var createTime lamport.Time
 	var editTime lamport.Time
+	var extraTreeHash repository.Hash
+	var cp *checkpoint
 
 	for _, entry := range entries {
 		switch {
+		case entry.Name == extraEntryName:
+			extraTreeHash = entry.Hash
+
+		case entry.Name == checkpointEntryName:
+			data, err := repo.ReadData(entry.Hash)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to read git blob data")
+			}
+			cp = &checkpoint{}
+			if err := json.Unmarshal(data, cp); err != nil {
+				return nil, errors.Wrap(err, "can't read checkpoint")
+			}
+			if err := cp.Validate(); err != nil {
+				return nil, err
+			}
+
 		case entry.Name == opsEntryName:
 			data, err := repo.ReadData(entry.Hash)
 			if err != nil {
@@ -269,13 +432,10 @@ func readOperationPack(def Definition, repo repository.RepoData, resolvers entit
 		}
 	}
 
-	// Verify signature if we expect one
-	keys := author.ValidKeysAtTime(fmt.Sprintf(editClockPattern, def.Namespace), editTime)
-	if len(keys) > 0 {
-		keyring := PGPKeyring(keys)
-		_, err = openpgp.CheckDetachedSignature(keyring, commit.SignedData, commit.Signature, nil)
+	if extraTreeHash != "" {
+		err = readExternalData(repo, extraTreeHash, ops)
 		if err != nil {
-			return nil, fmt.Errorf("signature failure: %v", err)
+			return nil, err
 		}
 	}
 
@@ -285,6 +445,7 @@ func readOperationPack(def Definition, repo repository.RepoData, resolvers entit
 		Operations: ops,
 		CreateTime: createTime,
 		EditTime:   editTime,
+		Checkpoint: cp,
 	}, nil
 }
 
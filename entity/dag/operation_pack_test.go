@@ -37,6 +37,10 @@ func TestOperationPackReadWrite(t *testing.T) {
 		op.Id()
 	}
 	require.Equal(t, opp, opp2)
+
+	for _, op := range opp2.Operations {
+		require.False(t, op.(interface{ Signed() bool }).Signed())
+	}
 }
 
 func TestOperationPackSignedReadWrite(t *testing.T) {
@@ -71,6 +75,10 @@ func TestOperationPackSignedReadWrite(t *testing.T) {
 		op.Id()
 	}
 	require.Equal(t, opp, opp2)
+
+	for _, op := range opp2.Operations {
+		require.True(t, op.(interface{ Signed() bool }).Signed())
+	}
 }
 
 func TestOperationPackFiles(t *testing.T) {
@@ -0,0 +1,173 @@
+package dag
+
+import (
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// signaturePolicyConfigKey is the git config key defining what to do of the
+// unsigned operations brought by a merge
+const signaturePolicyConfigKey = "git-bug.signature.policy"
+
+// SignaturePolicy define how the unsigned operations brought by a merge are
+// handled. The operations with an invalid signature, forged or tampered with,
+// are always refused.
+type SignaturePolicy int
+
+const (
+	// SignatureOptional accept the unsigned operations
+	SignatureOptional SignaturePolicy = iota
+	// SignatureWarn accept the unsigned operations, but report them in
+	// entity.MergeResult.UnsignedOperations
+	SignatureWarn
+	// SignatureRequired refuse to merge an entity bringing unsigned operations
+	SignatureRequired
+)
+
+func (p SignaturePolicy) String() string {
+	switch p {
+	case SignatureOptional:
+		return "optional"
+	case SignatureWarn:
+		return "warn"
+	case SignatureRequired:
+		return "required"
+	default:
+		return "unknown"
+	}
+}
+
+// LoadSignaturePolicy read the signature policy in "git-bug.signature.policy",
+// one of "optional" (the default), "warn" or "required".
+func LoadSignaturePolicy(config repository.ConfigRead) (SignaturePolicy, error) {
+	value, err := config.ReadString(signaturePolicyConfigKey)
+	if err == repository.ErrNoConfigEntry {
+		return SignatureOptional, nil
+	}
+	if err != nil {
+		return SignatureOptional, err
+	}
+
+	for _, p := range []SignaturePolicy{SignatureOptional, SignatureWarn, SignatureRequired} {
+		if p.String() == value {
+			return p, nil
+		}
+	}
+	return SignatureOptional, fmt.Errorf("invalid %s \"%s\", expected one of optional, warn or required", signaturePolicyConfigKey, value)
+}
+
+// unsignedOperations return the ids of the operations of after, not in
+// before, that are not signed
+func unsignedOperations(before []Operation, after []Operation) []entity.Id {
+	existing := make(map[entity.Id]struct{}, len(before))
+	for _, op := range before {
+		existing[op.Id()] = struct{}{}
+	}
+
+	var result []entity.Id
+	for _, op := range after {
+		if _, ok := existing[op.Id()]; ok {
+			continue
+		}
+		if !op.Signed() {
+			result = append(result, op.Id())
+		}
+	}
+	return result
+}
+
+// SignatureStatus is the status of the signature of a commit holding some
+// operations
+type SignatureStatus int
+
+const (
+	_ SignatureStatus = iota
+	// SignatureStatusUnsigned is for an author without key at the time: no
+	// signature is expected
+	SignatureStatusUnsigned
+	// SignatureStatusValid is for a signature made with a valid key of the
+	// author at the time
+	SignatureStatusValid
+	// SignatureStatusInvalid is for a missing or wrong signature while the
+	// author had keys at the time: the operations have been forged or
+	// tampered with
+	SignatureStatusInvalid
+)
+
+func (s SignatureStatus) String() string {
+	switch s {
+	case SignatureStatusUnsigned:
+		return "unsigned"
+	case SignatureStatusValid:
+		return "signed"
+	case SignatureStatusInvalid:
+		return "invalid"
+	default:
+		return "unknown"
+	}
+}
+
+// CommitSignature is the signature status of the operations stored in a
+// commit of an Entity
+type CommitSignature struct {
+	Commit     repository.Hash
+	Author     identity.Interface
+	Operations []Operation
+	Status     SignatureStatus
+	// Reason explain why the signature is invalid
+	Reason string
+}
+
+// VerifySignatures return the signature status of the commits holding the
+// operations of an Entity, from the oldest. The merge commits, without
+// operations, are skipped.
+//
+// Contrary to Read, an invalid signature doesn't fail but is reported.
+func VerifySignatures(def Definition, repo repository.ClockedRepo, resolvers entity.Resolvers, id entity.Id) ([]CommitSignature, error) {
+	rootHash, err := repo.ResolveRef(fmt.Sprintf(refsPattern, def.Namespace, id.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := readCommits(repo, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []CommitSignature
+	for _, commit := range topologicalOrder(commits, rootHash) {
+		opp, err := readOperationPackUnverified(def, repo, resolvers, commit)
+		if err != nil {
+			return nil, err
+		}
+		if len(opp.Operations) == 0 {
+			continue
+		}
+
+		signature := CommitSignature{
+			Commit:     commit.Hash,
+			Author:     opp.Author,
+			Operations: opp.Operations,
+			Status:     SignatureStatusUnsigned,
+		}
+
+		signed, err := verifySignature(def, commit, opp)
+		switch {
+		case err != nil:
+			signature.Status = SignatureStatusInvalid
+			signature.Reason = err.Error()
+		case signed:
+			signature.Status = SignatureStatusValid
+			for _, op := range opp.Operations {
+				op.setSigned()
+			}
+		}
+
+		result = append(result, signature)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package dag
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLoadSignaturePolicy(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	policy, err := LoadSignaturePolicy(repo.AnyConfig())
+	require.NoError(t, err)
+	require.Equal(t, SignatureOptional, policy)
+
+	require.NoError(t, repo.LocalConfig().StoreString(signaturePolicyConfigKey, "required"))
+	policy, err = LoadSignaturePolicy(repo.AnyConfig())
+	require.NoError(t, err)
+	require.Equal(t, SignatureRequired, policy)
+
+	require.NoError(t, repo.LocalConfig().StoreString(signaturePolicyConfigKey, "sometimes"))
+	_, err = LoadSignaturePolicy(repo.AnyConfig())
+	require.Error(t, err)
+}
+
+func TestVerifySignatures(t *testing.T) {
+	repo, id1, id2, resolvers, def := makeTestContext()
+
+	err := id1.(*identity.Identity).Mutate(repo, func(orig *identity.Mutator) {
+		orig.Keys = append(orig.Keys, identity.GenerateKey())
+	})
+	require.NoError(t, err)
+
+	e := New(def)
+	e.Append(newOp1(id1, "foo"))
+	require.NoError(t, e.Commit(repo))
+	e.Append(newOp2(id2, "bar"))
+	require.NoError(t, e.Commit(repo))
+
+	commits, err := VerifySignatures(def, repo, resolvers, e.Id())
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, SignatureStatusValid, commits[0].Status)
+	require.True(t, commits[0].Operations[0].Signed())
+	require.Equal(t, SignatureStatusUnsigned, commits[1].Status)
+	require.False(t, commits[1].Operations[0].Signed())
+
+	// strip the signature of the first commit, as a forged copy would be
+	commit, err := repo.ReadCommit(commits[0].Commit)
+	require.NoError(t, err)
+	forged, err := repo.StoreCommit(commit.TreeHash, commit.Parents...)
+	require.NoError(t, err)
+	ref := fmt.Sprintf(refsPattern, def.Namespace, e.Id().String())
+	require.NoError(t, repo.UpdateRef(ref, forged))
+
+	_, err = Read(def, repo, resolvers, e.Id())
+	require.Error(t, err)
+
+	commits, err = VerifySignatures(def, repo, resolvers, e.Id())
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.Equal(t, SignatureStatusInvalid, commits[0].Status)
+	require.NotEmpty(t, commits[0].Reason)
+}
+
+func TestMergeSignaturePolicy(t *testing.T) {
+	repoA, repoB, _, id1, _, resolvers, def := makeTestContextRemote(t)
+
+	e := New(def)
+	e.Append(newOp1(id1, "foo"))
+	require.NoError(t, e.Commit(repoA))
+
+	_, err := Push(def, repoA, "remote")
+	require.NoError(t, err)
+	_, err = Fetch(def, repoB, "remote")
+	require.NoError(t, err)
+
+	merge := func() entity.MergeResult {
+		var results []entity.MergeResult
+		for result := range MergeAll(def, repoB, resolvers, "remote", id1) {
+			results = append(results, result)
+		}
+		require.Len(t, results, 1)
+		return results[0]
+	}
+
+	require.NoError(t, repoB.LocalConfig().StoreString(signaturePolicyConfigKey, "required"))
+	result := merge()
+	require.Equal(t, entity.MergeStatusInvalid, result.Status)
+
+	require.NoError(t, repoB.LocalConfig().StoreString(signaturePolicyConfigKey, "warn"))
+	result = merge()
+	require.NoError(t, result.Err)
+	require.Equal(t, entity.MergeStatusNew, result.Status)
+	require.Equal(t, []entity.Id{e.FirstOp().Id()}, result.UnsignedOperations)
+}
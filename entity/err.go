@@ -1,10 +1,32 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
 
+// ErrNotFound is returned when the requested entity doesn't exist
+type ErrNotFound struct {
+	typename string
+}
+
+func NewErrNotFound(typename string) *ErrNotFound {
+	return &ErrNotFound{typename: typename}
+}
+
+func (e ErrNotFound) Error() string {
+	return fmt.Sprintf("%s doesn't exist", e.typename)
+}
+
+// IsErrNotFound return true if the error, or any error it wraps, is an ErrNotFound
+func IsErrNotFound(err error) bool {
+	var target *ErrNotFound
+	return errors.As(err, &target)
+}
+
+// ErrMultipleMatch is returned when a prefix or a matcher is ambiguous and
+// designates multiple entities
 type ErrMultipleMatch struct {
 	entityType string
 	Matching   []Id
@@ -26,9 +48,10 @@ func (e ErrMultipleMatch) Error() string {
 		strings.Join(matching, "\n"))
 }
 
+// IsErrMultipleMatch return true if the error, or any error it wraps, is an ErrMultipleMatch
 func IsErrMultipleMatch(err error) bool {
-	_, ok := err.(*ErrMultipleMatch)
-	return ok
+	var target *ErrMultipleMatch
+	return errors.As(err, &target)
 }
 
 type ErrInvalidFormat struct {
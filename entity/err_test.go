@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrKinds(t *testing.T) {
+	notFound := NewErrNotFound("bug")
+	require.Equal(t, "bug doesn't exist", notFound.Error())
+	require.True(t, IsErrNotFound(notFound))
+	require.True(t, IsErrNotFound(fmt.Errorf("wrapped: %w", notFound)))
+	require.False(t, IsErrNotFound(fmt.Errorf("bug doesn't exist")))
+
+	multiple := NewErrMultipleMatch("bug", []Id{"1234", "5678"})
+	require.Equal(t, []Id{"1234", "5678"}, multiple.Matching)
+	require.True(t, IsErrMultipleMatch(multiple))
+	require.True(t, IsErrMultipleMatch(fmt.Errorf("wrapped: %w", multiple)))
+	require.False(t, IsErrMultipleMatch(notFound))
+}
@@ -1,7 +1,6 @@
 package entity
 
 import (
-	"crypto/sha256"
 	"fmt"
 	"io"
 	"strings"
@@ -18,13 +17,10 @@ const UnsetId = Id("unset")
 // Id is an identifier for an entity or part of an entity
 type Id string
 
-// DeriveId generate an Id from the serialization of the object or part of the object.
+// DeriveId generate an Id from the serialization of the object or part of the object,
+// with the default IdScheme.
 func DeriveId(data []byte) Id {
-	// My understanding is that sha256 is enough to prevent collision (git use that, so ...?)
-	// If you read this code, I'd be happy to be schooled.
-
-	sum := sha256.Sum256(data)
-	return Id(fmt.Sprintf("%x", sum))
+	return DeriveIdWithScheme(defaultIdScheme, data)
 }
 
 // String return the identifier as a string
@@ -35,11 +31,21 @@ func (i Id) String() string {
 // Human return the identifier, shortened for human consumption
 func (i Id) Human() string {
 	format := fmt.Sprintf("%%.%ds", humanIdLength)
-	return fmt.Sprintf(format, i)
+	tag, body := i.split()
+	if tag == "" {
+		return fmt.Sprintf(format, body)
+	}
+	return tag + idTagSeparator + fmt.Sprintf(format, body)
 }
 
+// HasPrefix tell if the Id start with the given prefix. The version tag of the
+// Id can be omitted.
 func (i Id) HasPrefix(prefix string) bool {
-	return strings.HasPrefix(string(i), prefix)
+	if strings.HasPrefix(string(i), prefix) {
+		return true
+	}
+	tag, body := i.split()
+	return tag != "" && strings.HasPrefix(body, prefix)
 }
 
 // UnmarshalGQL implement the Unmarshaler interface for gqlgen
@@ -65,17 +71,10 @@ func (i Id) MarshalGQL(w io.Writer) {
 
 // Validate tell if the Id is valid
 func (i Id) Validate() error {
-	// Special case to detect outdated repo
-	if len(i) == 40 {
-		return fmt.Errorf("outdated repository format, please use https://github.com/MichaelMure/git-bug-migration to upgrade")
+	scheme, err := i.Scheme()
+	if err != nil {
+		return err
 	}
-	if len(i) != idLength {
-		return fmt.Errorf("invalid length")
-	}
-	for _, r := range i {
-		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
-			return fmt.Errorf("invalid character")
-		}
-	}
-	return nil
+	_, body := i.split()
+	return scheme.ValidateBody(body)
 }
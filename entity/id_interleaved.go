@@ -110,17 +110,22 @@ func (ci CombinedId) SecondaryPrefix() string {
 // 7:    4P, 3S
 // 10:   6P, 4S
 // 16:  11P, 5S
+//
+// The version tags of the Ids are not kept, only their bodies are interleaved.
 func CombineIds(primary Id, secondary Id) CombinedId {
 	var id strings.Builder
 
+	_, primaryBody := primary.split()
+	_, secondaryBody := secondary.split()
+
 	for i := 0; i < idLength; i++ {
 		switch {
 		default:
-			id.WriteByte(primary[0])
-			primary = primary[1:]
+			id.WriteByte(primaryBody[0])
+			primaryBody = primaryBody[1:]
 		case i == 1, i == 3, i == 5, i == 9, i >= 10 && i%5 == 4:
-			id.WriteByte(secondary[0])
-			secondary = secondary[1:]
+			id.WriteByte(secondaryBody[0])
+			secondaryBody = secondaryBody[1:]
 		}
 	}
 
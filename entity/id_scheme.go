@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// idTagSeparator separate the version tag of an Id from its body.
+// It must be valid in a git ref name, as the Ids are used in the refs.
+const idTagSeparator = "-"
+
+// IdScheme define how the Ids are derived from the data they identify.
+//
+// The Ids of a scheme are formatted as "<tag>-<body>", except for the original
+// scheme which has no tag, to keep the existing repositories valid. This gives
+// a path to migrate to a different hash, or to human-prefixed Ids, while
+// keeping the existing Ids resolvable.
+//
+// The body of an Id must be made of at least idLength lowercase letters and
+// digits, so that it can be interleaved in a CombinedId.
+type IdScheme interface {
+	// Tag return the version tag embedded in the Ids of this scheme.
+	Tag() string
+	// Derive compute the body of the Id of the given data.
+	Derive(data []byte) string
+	// ValidateBody check that the body of an Id is valid for this scheme.
+	ValidateBody(body string) error
+}
+
+// Sha256IdScheme is the original IdScheme: an untagged hex encoded sha-256.
+var Sha256IdScheme IdScheme = sha256IdScheme{}
+
+var idSchemes = map[string]IdScheme{
+	Sha256IdScheme.Tag(): Sha256IdScheme,
+}
+
+var defaultIdScheme = Sha256IdScheme
+
+// RegisterIdScheme make the Ids of a scheme valid. It must be called before
+// any Id of this scheme is used, typically in an init function.
+func RegisterIdScheme(scheme IdScheme) error {
+	tag := scheme.Tag()
+	if tag == "" {
+		return fmt.Errorf("an id scheme needs a tag")
+	}
+	if err := validateIdChars(tag); err != nil {
+		return fmt.Errorf("invalid id scheme tag %q: %v", tag, err)
+	}
+	if _, ok := idSchemes[tag]; ok {
+		return fmt.Errorf("id scheme %q is already registered", tag)
+	}
+	idSchemes[tag] = scheme
+	return nil
+}
+
+// SetDefaultIdScheme change the scheme used by DeriveId to derive the new Ids,
+// given its tag. The existing Ids keep being valid with their own scheme.
+func SetDefaultIdScheme(tag string) error {
+	scheme, ok := idSchemes[tag]
+	if !ok {
+		return fmt.Errorf("unknown id scheme %q", tag)
+	}
+	defaultIdScheme = scheme
+	return nil
+}
+
+// DeriveIdWithScheme generate an Id from the serialization of the object or
+// part of the object, with the given scheme.
+func DeriveIdWithScheme(scheme IdScheme, data []byte) Id {
+	body := scheme.Derive(data)
+	if scheme.Tag() == "" {
+		return Id(body)
+	}
+	return Id(scheme.Tag() + idTagSeparator + body)
+}
+
+// Scheme return the IdScheme the Id has been derived with.
+func (i Id) Scheme() (IdScheme, error) {
+	tag, _ := i.split()
+	scheme, ok := idSchemes[tag]
+	if !ok {
+		return nil, fmt.Errorf("unknown id scheme %q", tag)
+	}
+	return scheme, nil
+}
+
+// IsDerivedFrom tell if the Id has been derived from the given data, with the
+// scheme of the Id.
+func (i Id) IsDerivedFrom(data []byte) bool {
+	scheme, err := i.Scheme()
+	if err != nil {
+		return false
+	}
+	return DeriveIdWithScheme(scheme, data) == i
+}
+
+// split return the version tag and the body of the Id.
+func (i Id) split() (tag string, body string) {
+	tag, body, found := strings.Cut(string(i), idTagSeparator)
+	if !found {
+		return "", string(i)
+	}
+	return tag, body
+}
+
+func validateIdChars(s string) error {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return fmt.Errorf("invalid character")
+		}
+	}
+	return nil
+}
+
+type sha256IdScheme struct{}
+
+func (sha256IdScheme) Tag() string {
+	return ""
+}
+
+func (sha256IdScheme) Derive(data []byte) string {
+	// My understanding is that sha256 is enough to prevent collision (git use that, so ...?)
+	// If you read this code, I'd be happy to be schooled.
+
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf("%x", sum)
+}
+
+func (sha256IdScheme) ValidateBody(body string) error {
+	// Special case to detect outdated repo
+	if len(body) == 40 {
+		return fmt.Errorf("outdated repository format, please use https://github.com/MichaelMure/git-bug-migration to upgrade")
+	}
+	if len(body) != idLength {
+		return fmt.Errorf("invalid length")
+	}
+	return validateIdChars(body)
+}
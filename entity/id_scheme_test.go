@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type sha512IdScheme struct{}
+
+func (sha512IdScheme) Tag() string {
+	return "v2"
+}
+
+func (sha512IdScheme) Derive(data []byte) string {
+	return fmt.Sprintf("%x", sha512.Sum512(data))
+}
+
+func (sha512IdScheme) ValidateBody(body string) error {
+	if len(body) != 2*sha512.Size {
+		return fmt.Errorf("invalid length")
+	}
+	return validateIdChars(body)
+}
+
+func TestIdScheme(t *testing.T) {
+	data := []byte("data")
+
+	legacy := DeriveId(data)
+	require.NoError(t, legacy.Validate())
+	require.Len(t, legacy, idLength)
+	require.True(t, legacy.IsDerivedFrom(data))
+
+	require.Error(t, SetDefaultIdScheme("v2"))
+	require.NoError(t, RegisterIdScheme(sha512IdScheme{}))
+	require.Error(t, RegisterIdScheme(sha512IdScheme{}))
+	require.NoError(t, SetDefaultIdScheme("v2"))
+	defer func() {
+		require.NoError(t, SetDefaultIdScheme(Sha256IdScheme.Tag()))
+		delete(idSchemes, "v2")
+	}()
+
+	id := DeriveId(data)
+	require.NoError(t, id.Validate())
+	require.Equal(t, "v2-"+sha512IdScheme{}.Derive(data), id.String())
+	require.True(t, id.IsDerivedFrom(data))
+	require.False(t, id.IsDerivedFrom([]byte("other")))
+
+	// the existing ids are still valid, and verified with their own scheme
+	require.NoError(t, legacy.Validate())
+	require.True(t, legacy.IsDerivedFrom(data))
+
+	require.Equal(t, "v2-"+id.String()[3:10], id.Human())
+	require.True(t, id.HasPrefix("v2-"+id.String()[3:10]))
+	require.True(t, id.HasPrefix(id.String()[3:10]))
+
+	require.Error(t, Id("v3-"+id.String()[3:]).Validate())
+	require.Error(t, Id("v2-"+legacy.String()).Validate())
+
+	// only the bodies are interleaved
+	combined := CombineIds(id, legacy)
+	require.NoError(t, combined.Validate())
+	require.True(t, id.HasPrefix(combined.PrimaryPrefix()))
+}
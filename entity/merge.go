@@ -29,16 +29,53 @@ type MergeResult struct {
 
 	// Only set for New or Updated status
 	Entity Interface
+
+	// Only set for New or Updated status, for the entities made of operations:
+	// the ids of the operations added locally by the merge, in order
+	AddedOperations []Id
+
+	// Only set for Updated status: true when some of the added operations
+	// have been ordered before operations already present locally, that is
+	// when the merge changed the order of the local history
+	Reordered bool
+
+	// Only set for Updated status: the ids of the operations dropped by the
+	// merge as duplicates of other operations, as when the same change has
+	// been recorded on both sides. Only the first of them in the history is
+	// kept, which can be either the local or the remote one.
+	DuplicateOperations []Id
+
+	// Only set for New or Updated status, when the signature policy ask for
+	// it: the ids of the added operations that are not signed
+	UnsignedOperations []Id
 }
 
 func (mr MergeResult) String() string {
 	switch mr.Status {
 	case MergeStatusNew:
+		if len(mr.UnsignedOperations) > 0 {
+			return fmt.Sprintf("new, %s not signed", pluralOperations(len(mr.UnsignedOperations)))
+		}
 		return "new"
 	case MergeStatusInvalid:
 		return fmt.Sprintf("invalid data: %s", mr.Reason)
 	case MergeStatusUpdated:
-		return "updated"
+		var result string
+		switch {
+		case len(mr.AddedOperations) == 0:
+			result = "updated"
+		case mr.Reordered:
+			result = fmt.Sprintf("updated, %s added before local operations", pluralOperations(len(mr.AddedOperations)))
+		default:
+			result = fmt.Sprintf("updated, %s added", pluralOperations(len(mr.AddedOperations)))
+		}
+		if len(mr.DuplicateOperations) > 0 {
+			result += fmt.Sprintf(", %s ignored as duplicates", pluralOperations(len(mr.DuplicateOperations)))
+		}
+		if len(mr.UnsignedOperations) > 0 {
+			result += fmt.Sprintf(", %s not signed", pluralOperations(len(mr.UnsignedOperations)))
+		}
+		return result
 	case MergeStatusNothing:
 		return "nothing to do"
 	case MergeStatusError:
@@ -51,6 +88,13 @@ func (mr MergeResult) String() string {
 	}
 }
 
+func pluralOperations(count int) string {
+	if count == 1 {
+		return "1 operation"
+	}
+	return fmt.Sprintf("%d operations", count)
+}
+
 func NewMergeNewStatus(id Id, entity Interface) MergeResult {
 	return MergeResult{
 		Id:     id,
@@ -81,6 +81,6 @@ func MakeResolver(entities ...Interface) Resolver {
 				return entity, nil
 			}
 		}
-		return nil, fmt.Errorf("entity not found")
+		return nil, NewErrNotFound("entity")
 	})
 }
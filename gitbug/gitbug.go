@@ -0,0 +1,155 @@
+// Package gitbug is the entry point to embed git-bug in a Go program. It
+// wires the git repository and the cache the same way the git-bug commands do.
+//
+//	gb, err := gitbug.Open("/path/to/repo", gitbug.ReadOnly())
+//	if err != nil {
+//		return err
+//	}
+//	defer gb.Close()
+//
+//	for _, id := range gb.Cache.AllBugsIds() {
+//		...
+//	}
+package gitbug
+
+import (
+	"io"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// Namespace is the namespace used by git-bug in the git repository
+const Namespace = "git-bug"
+
+// GitBug is a git repository opened with its git-bug cache
+type GitBug struct {
+	Repo  repository.ClockedRepo
+	Cache *cache.RepoCache
+
+	stop []func()
+}
+
+type options struct {
+	cacheOptions  cache.RepoCacheOptions
+	repo          repository.ClockedRepo
+	eventHandlers []func(cache.Event)
+}
+
+// Option tune how a repository is opened
+type Option func(opts *options)
+
+// ReadOnly open the cache without taking the repository lock, and refuse
+// any change. The cache must have already been built by a previous git-bug
+// usage. Multiple read-only instances can coexist with a running git-bug.
+func ReadOnly() Option {
+	return func(opts *options) {
+		opts.cacheOptions.ReadOnly = true
+	}
+}
+
+// NoLock doesn't take the repository lock. The caller is responsible for
+// making sure that no other git-bug process writes concurrently.
+func NoLock() Option {
+	return func(opts *options) {
+		opts.cacheOptions.NoLock = true
+	}
+}
+
+// WithName set the name of the repository, as reported by the cache
+func WithName(name string) Option {
+	return func(opts *options) {
+		opts.cacheOptions.Name = name
+	}
+}
+
+// WithRepository use an already opened repository instead of opening the
+// path given to Open. This allows to use a custom storage backend, for
+// example an in-memory repository.
+func WithRepository(repo repository.ClockedRepo) Option {
+	return func(opts *options) {
+		opts.repo = repo
+	}
+}
+
+// WithProgress write the progress of the cache build, if it needs to be
+// built, to w instead of os.Stderr. Use io.Discard to silence it.
+func WithProgress(w io.Writer) Option {
+	return func(opts *options) {
+		opts.cacheOptions.Progress = w
+	}
+}
+
+// WithEventHandler call handler for each event emitted by the cache, in a
+// dedicated goroutine, until the GitBug is closed.
+func WithEventHandler(handler func(cache.Event)) Option {
+	return func(opts *options) {
+		opts.eventHandlers = append(opts.eventHandlers, handler)
+	}
+}
+
+// Open open the git repository at path, or one of its parent, and its
+// git-bug cache.
+func Open(path string, opts ...Option) (*GitBug, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	repo := o.repo
+	if repo == nil {
+		var err error
+		repo, err = repository.OpenGoGitRepo(path, Namespace, []repository.ClockLoader{bug.ClockLoader})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c, err := cache.NewRepoCacheWithOptions(repo, o.cacheOptions)
+	if err != nil {
+		if o.repo == nil {
+			_ = repo.Close()
+		}
+		return nil, err
+	}
+
+	gb := &GitBug{
+		Repo:  repo,
+		Cache: c,
+	}
+
+	for _, handler := range o.eventHandlers {
+		gb.handleEvents(handler)
+	}
+
+	return gb, nil
+}
+
+func (gb *GitBug) handleEvents(handler func(cache.Event)) {
+	events, unsubscribe := gb.Cache.Subscribe()
+	finished := make(chan struct{})
+
+	go func() {
+		defer close(finished)
+		for event := range events {
+			handler(event)
+		}
+	}()
+
+	gb.stop = append(gb.stop, func() {
+		unsubscribe()
+		<-finished
+	})
+}
+
+// Close release the cache and the repository. The event handlers are
+// guaranteed to not be called after Close returns.
+func (gb *GitBug) Close() error {
+	for _, stop := range gb.stop {
+		stop()
+	}
+	gb.stop = nil
+
+	return gb.Cache.Close()
+}
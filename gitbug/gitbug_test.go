@@ -0,0 +1,66 @@
+package gitbug
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := repository.InitGoGitRepo(dir, Namespace)
+	require.NoError(t, err)
+	require.NoError(t, repo.Close())
+
+	var progress bytes.Buffer
+	var events []cache.Event
+
+	gb, err := Open(dir, WithProgress(&progress), WithEventHandler(func(event cache.Event) {
+		events = append(events, event)
+	}))
+	require.NoError(t, err)
+	require.Contains(t, progress.String(), "Building bug cache")
+
+	rene, err := gb.Cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, gb.Cache.SetUserIdentity(rene))
+	b, _, err := gb.Cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	// a read-only instance can be opened alongside
+	ro, err := Open(dir, ReadOnly())
+	require.NoError(t, err)
+	require.Equal(t, []string{b.Id().String()}, toStrings(ro.Cache.AllBugsIds()))
+	_, _, err = ro.Cache.NewBug("other", "message")
+	require.ErrorIs(t, err, cache.ErrReadOnly)
+	require.NoError(t, ro.Close())
+
+	require.NoError(t, gb.Close())
+	require.Contains(t, events, cache.Event(cache.BugCreated{BugId: b.Id()}))
+
+	// the lock has been released
+	gb, err = Open(dir)
+	require.NoError(t, err)
+	require.NoError(t, gb.Close())
+}
+
+func TestWithRepository(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(t, false)
+
+	gb, err := Open("", WithRepository(repo), WithProgress(&bytes.Buffer{}))
+	require.NoError(t, err)
+	require.Equal(t, repo, gb.Repo)
+	require.NoError(t, gb.Close())
+}
+
+func toStrings[T interface{ String() string }](values []T) []string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = v.String()
+	}
+	return result
+}
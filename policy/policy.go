@@ -0,0 +1,197 @@
+// Package policy implement rules that can be checked against the operations
+// of a bug, like access control, auto-labeling or validation, and a sandbox
+// to replay the operations of a bug through them without changing anything.
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// configKeyPrefix is the git config prefix under which the policies are
+// defined, as in "git-bug.policy.<name>.<key> = <value>"
+const configKeyPrefix = "git-bug.policy."
+
+const (
+	configKeyRule       = "rule"
+	configKeyOperations = "operations"
+	configKeyAllow      = "allow"
+	configKeyPattern    = "pattern"
+	configKeyLabel      = "label"
+	configKeyMaxLength  = "max-length"
+	configKeyAssignee   = "assignee"
+)
+
+// Rule is a check done on a single operation.
+type Rule interface {
+	// Check return a non-empty reason if the rule would fire for the operation.
+	// snap is the state of the bug before the operation is applied, and
+	// should not be retained.
+	Check(snap *bug.Snapshot, op bug.Operation) string
+}
+
+// Policy is a named Rule, as configured by the user
+type Policy struct {
+	Name string
+	Rule Rule
+}
+
+// Load read the policies defined in the git config, sorted by name.
+//
+// Each policy is defined by a set of keys under "git-bug.policy.<name>":
+//
+//	rule        the kind of rule: "acl", "auto-label", "auto-assign" or "validate"
+//	operations  the operation kinds the acl applies to, comma separated
+//	allow       the logins, emails or id prefixes allowed by the acl, comma separated
+//	pattern     the regular expression matched by auto-label, or forbidden by validate
+//	label       the label added by auto-label, or that triggers auto-assign
+//	assignee    the login or id prefix of the identity assigned by auto-assign
+//	max-length  the maximum length of a title for validate
+func Load(config repository.ConfigRead) ([]Policy, error) {
+	raw, err := config.ReadAll(configKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]map[string]string)
+	for key, value := range raw {
+		if !strings.HasPrefix(key, configKeyPrefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(key, configKeyPrefix)
+		i := strings.LastIndex(rest, ".")
+		if i <= 0 {
+			continue
+		}
+		name, field := rest[:i], rest[i+1:]
+		if byName[name] == nil {
+			byName[name] = make(map[string]string)
+		}
+		byName[name][field] = value
+	}
+
+	result := make([]Policy, 0, len(byName))
+	for name, fields := range byName {
+		rule, err := newRule(fields)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %w", name, err)
+		}
+		result = append(result, Policy{Name: name, Rule: rule})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+func newRule(fields map[string]string) (Rule, error) {
+	switch fields[configKeyRule] {
+	case "acl":
+		return &ACLRule{
+			Operations: splitList(fields[configKeyOperations]),
+			Allow:      splitList(fields[configKeyAllow]),
+		}, nil
+
+	case "auto-label":
+		pattern, err := compilePattern(fields[configKeyPattern])
+		if err != nil {
+			return nil, err
+		}
+		if pattern == nil {
+			return nil, fmt.Errorf("missing %s", configKeyPattern)
+		}
+		label := strings.TrimSpace(fields[configKeyLabel])
+		if label == "" {
+			return nil, fmt.Errorf("missing %s", configKeyLabel)
+		}
+		return &AutoLabelRule{Pattern: pattern, Label: bug.Label(label)}, nil
+
+	case "auto-assign":
+		label := strings.TrimSpace(fields[configKeyLabel])
+		if label == "" {
+			return nil, fmt.Errorf("missing %s", configKeyLabel)
+		}
+		assignee := strings.TrimSpace(fields[configKeyAssignee])
+		if assignee == "" {
+			return nil, fmt.Errorf("missing %s", configKeyAssignee)
+		}
+		return &AutoAssignRule{Label: bug.Label(label), Assignee: assignee}, nil
+
+	case "validate":
+		pattern, err := compilePattern(fields[configKeyPattern])
+		if err != nil {
+			return nil, err
+		}
+		var maxLength int
+		if raw, ok := fields[configKeyMaxLength]; ok {
+			maxLength, err = strconv.Atoi(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", configKeyMaxLength, err)
+			}
+		}
+		return &ValidateRule{Forbidden: pattern, MaxTitleLength: maxLength}, nil
+
+	case "":
+		return nil, fmt.Errorf("missing %s", configKeyRule)
+
+	default:
+		return nil, fmt.Errorf("unknown rule \"%s\"", fields[configKeyRule])
+	}
+}
+
+func compilePattern(raw string) (*regexp.Regexp, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	pattern, err := regexp.Compile(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", configKeyPattern, err)
+	}
+	return pattern, nil
+}
+
+func splitList(raw string) []string {
+	var result []string
+	for _, s := range strings.Split(raw, ",") {
+		s = strings.TrimSpace(s)
+		if s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// OperationKind return a short name for the kind of operation, as used in the
+// "operations" key of an acl.
+func OperationKind(op bug.Operation) string {
+	switch op.(type) {
+	case *bug.CreateOperation:
+		return "create"
+	case *bug.SetTitleOperation:
+		return "title"
+	case *bug.AddCommentOperation:
+		return "comment"
+	case *bug.EditCommentOperation:
+		return "edit-comment"
+	case *bug.SetStatusOperation:
+		return "status"
+	case *bug.LabelChangeOperation:
+		return "label"
+	case *bug.RequestInfoOperation:
+		return "request-info"
+	case *bug.SyncConflictOperation:
+		return "sync-conflict"
+	case *bug.SetAssigneeOperation:
+		return "assign"
+	default:
+		return "other"
+	}
+}
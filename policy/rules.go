@@ -0,0 +1,184 @@
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+var _ Rule = &ACLRule{}
+
+// ACLRule fire when an operation is authored by someone not allowed to
+type ACLRule struct {
+	// Operations are the operation kinds restricted, all of them if empty
+	Operations []string
+	// Allow are the logins, emails or id prefixes of the allowed authors
+	Allow []string
+}
+
+func (r *ACLRule) Check(_ *bug.Snapshot, op bug.Operation) string {
+	kind := OperationKind(op)
+	if kind == "other" {
+		return ""
+	}
+
+	if len(r.Operations) > 0 && !contains(r.Operations, kind) {
+		return ""
+	}
+
+	author := op.Author()
+	for _, allowed := range r.Allow {
+		if allowed == author.Login() || allowed == author.Email() || author.Id().HasPrefix(allowed) {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("%s is not allowed to %s", author.DisplayName(), kind)
+}
+
+var _ Rule = &AutoLabelRule{}
+
+// AutoLabelRule fire when a text matching the pattern is written in a bug
+// that doesn't have the label yet.
+type AutoLabelRule struct {
+	Pattern *regexp.Regexp
+	Label   bug.Label
+}
+
+func (r *AutoLabelRule) Check(snap *bug.Snapshot, op bug.Operation) string {
+	for _, l := range snap.Labels {
+		if l == r.Label {
+			return ""
+		}
+	}
+
+	for _, text := range operationTexts(op) {
+		if r.Pattern.MatchString(text) {
+			return fmt.Sprintf("would add the label \"%s\"", r.Label)
+		}
+	}
+
+	return ""
+}
+
+var _ Rule = &AutoAssignRule{}
+
+// MetaKeyNoAutoAssign is the metadata key that, when set on a label change,
+// opts it out of the auto-assign rules.
+const MetaKeyNoAutoAssign = "no-auto-assign"
+
+// MetaKeyAutoAssignPolicy is the metadata key set on the assignments done
+// by the auto-assign rules, holding the name of the policies.
+const MetaKeyAutoAssignPolicy = "auto-assign-policy"
+
+// AutoAssignRule fire when a label is applied to a bug, to assign it to a
+// default owner. Unlike the other rules, it is enforced by the cache when
+// the label change is committed or merged.
+type AutoAssignRule struct {
+	Label bug.Label
+	// Assignee is the login or id prefix of the identity to assign
+	Assignee string
+}
+
+func (r *AutoAssignRule) Check(snap *bug.Snapshot, op bug.Operation) string {
+	if !r.Triggered(snap, op) {
+		return ""
+	}
+	return fmt.Sprintf("would assign %s", r.Assignee)
+}
+
+// Triggered return true if op apply the label of the rule, and is not opted
+// out of the auto-assignment.
+func (r *AutoAssignRule) Triggered(snap *bug.Snapshot, op bug.Operation) bool {
+	labelOp, ok := op.(*bug.LabelChangeOperation)
+	if !ok {
+		return false
+	}
+	if _, ok := labelOp.GetMetadata(MetaKeyNoAutoAssign); ok {
+		return false
+	}
+
+	for _, l := range snap.Labels {
+		if l == r.Label {
+			return false
+		}
+	}
+
+	for _, l := range labelOp.Added {
+		if l == r.Label {
+			return true
+		}
+	}
+
+	return false
+}
+
+var _ Rule = &ValidateRule{}
+
+// ValidateRule fire when a text written in a bug is not valid
+type ValidateRule struct {
+	// Forbidden, if set, must not match any text
+	Forbidden *regexp.Regexp
+	// MaxTitleLength, if non-zero, is the maximum length of a title
+	MaxTitleLength int
+}
+
+func (r *ValidateRule) Check(_ *bug.Snapshot, op bug.Operation) string {
+	var reasons []string
+
+	if r.MaxTitleLength > 0 {
+		if title, ok := operationTitle(op); ok && len([]rune(title)) > r.MaxTitleLength {
+			reasons = append(reasons, fmt.Sprintf("title longer than %d characters", r.MaxTitleLength))
+		}
+	}
+
+	if r.Forbidden != nil {
+		for _, text := range operationTexts(op) {
+			if r.Forbidden.MatchString(text) {
+				reasons = append(reasons, fmt.Sprintf("text matching \"%s\"", r.Forbidden))
+				break
+			}
+		}
+	}
+
+	return strings.Join(reasons, ", ")
+}
+
+// operationTitle return the title set by the operation, if any
+func operationTitle(op bug.Operation) (string, bool) {
+	switch op := op.(type) {
+	case *bug.CreateOperation:
+		return op.Title, true
+	case *bug.SetTitleOperation:
+		return op.Title, true
+	default:
+		return "", false
+	}
+}
+
+// operationTexts return the user written texts of the operation
+func operationTexts(op bug.Operation) []string {
+	switch op := op.(type) {
+	case *bug.CreateOperation:
+		return []string{op.Title, op.Message}
+	case *bug.SetTitleOperation:
+		return []string{op.Title}
+	case *bug.AddCommentOperation:
+		return []string{op.Message}
+	case *bug.EditCommentOperation:
+		return []string{op.Message}
+	default:
+		return nil
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
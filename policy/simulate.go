@@ -0,0 +1,48 @@
+package policy
+
+import (
+	"github.com/MichaelMure/git-bug/entities/bug"
+)
+
+// Replayer is a bug that can be replayed operation by operation, like a
+// bug.Bug or a cache.BugCache.
+type Replayer interface {
+	Replay(fn func(before *bug.Snapshot, op bug.Operation)) *bug.Snapshot
+}
+
+// Firing is a policy that would fire on an operation
+type Firing struct {
+	Policy    string
+	Operation bug.Operation
+	Reason    string
+}
+
+// Report is the result of a simulation
+type Report struct {
+	// Operations is the number of operations replayed
+	Operations int
+	// Firings are in the order of the operations, then of the policies
+	Firings []Firing
+}
+
+// Simulate replay the operations of a bug through the policies, and report
+// which would fire. Nothing is committed: neither the bug nor the repository
+// are changed.
+func Simulate(b Replayer, policies []Policy) Report {
+	var report Report
+
+	b.Replay(func(before *bug.Snapshot, op bug.Operation) {
+		report.Operations++
+		for _, p := range policies {
+			if reason := p.Rule.Check(before, op); reason != "" {
+				report.Firings = append(report.Firings, Firing{
+					Policy:    p.Name,
+					Operation: op,
+					Reason:    reason,
+				})
+			}
+		}
+	})
+
+	return report
+}
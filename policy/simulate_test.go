@@ -0,0 +1,103 @@
+package policy
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entities/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLoad(t *testing.T) {
+	config := repository.NewMemConfig()
+	require.NoError(t, config.StoreString("git-bug.policy.maintainers.rule", "acl"))
+	require.NoError(t, config.StoreString("git-bug.policy.maintainers.operations", "status, label"))
+	require.NoError(t, config.StoreString("git-bug.policy.maintainers.allow", "rene@descartes.fr"))
+	require.NoError(t, config.StoreString("git-bug.policy.crash.rule", "auto-label"))
+	require.NoError(t, config.StoreString("git-bug.policy.crash.pattern", "(?i)panic"))
+	require.NoError(t, config.StoreString("git-bug.policy.crash.label", "crash"))
+
+	policies, err := Load(config)
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	require.Equal(t, "crash", policies[0].Name)
+	require.Equal(t, "maintainers", policies[1].Name)
+	require.Equal(t, []string{"status", "label"}, policies[1].Rule.(*ACLRule).Operations)
+
+	require.NoError(t, config.StoreString("git-bug.policy.broken.rule", "unknown"))
+	_, err = Load(config)
+	require.Error(t, err)
+}
+
+func TestSimulate(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	isaac, err := identity.NewIdentity(repo, "Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+
+	b, _, err := bug.Create(isaac, 1, "a very long title for a bug", "it panics", nil, nil)
+	require.NoError(t, err)
+	_, _, err = bug.ChangeLabels(b, rene, 2, []string{"crash"}, nil, nil)
+	require.NoError(t, err)
+	_, _, err = bug.AddComment(b, isaac, 3, "another panic", nil, nil)
+	require.NoError(t, err)
+	_, err = bug.Close(b, isaac, 4, nil)
+	require.NoError(t, err)
+
+	policies := []Policy{
+		{Name: "maintainers", Rule: &ACLRule{Operations: []string{"status", "label"}, Allow: []string{"rene@descartes.fr"}}},
+		{Name: "crash", Rule: &AutoLabelRule{Pattern: regexp.MustCompile("panic"), Label: "crash"}},
+		{Name: "short-titles", Rule: &ValidateRule{MaxTitleLength: 10}},
+	}
+
+	before := len(b.Operations())
+
+	report := Simulate(b, policies)
+	require.Equal(t, 4, report.Operations)
+	require.Len(t, report.Firings, 3)
+
+	// the creation, before the label is added
+	require.Equal(t, "crash", report.Firings[0].Policy)
+	require.Equal(t, "short-titles", report.Firings[1].Policy)
+	require.Equal(t, "title longer than 10 characters", report.Firings[1].Reason)
+	// the label is there for the comment, but closing is restricted
+	require.Equal(t, "maintainers", report.Firings[2].Policy)
+	require.IsType(t, &bug.SetStatusOperation{}, report.Firings[2].Operation)
+
+	// nothing has been committed
+	require.Len(t, b.Operations(), before)
+}
+
+func TestAutoAssignRule(t *testing.T) {
+	repo := repository.NewMockRepo()
+
+	rene, err := identity.NewIdentity(repo, "René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, _, err := bug.Create(rene, 1, "title", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = bug.ChangeLabels(b, rene, 2, []string{"webui"}, nil, nil)
+	require.NoError(t, err)
+	// already applied
+	_, err = bug.ForceChangeLabels(b, rene, 3, []string{"webui"}, nil, nil)
+	require.NoError(t, err)
+	_, _, err = bug.ChangeLabels(b, rene, 4, nil, []string{"webui"}, nil)
+	require.NoError(t, err)
+	// opted out
+	_, _, err = bug.ChangeLabels(b, rene, 5, []string{"webui"}, nil, map[string]string{
+		MetaKeyNoAutoAssign: "true",
+	})
+	require.NoError(t, err)
+
+	report := Simulate(b, []Policy{
+		{Name: "webui-owner", Rule: &AutoAssignRule{Label: "webui", Assignee: "alice"}},
+	})
+	require.Len(t, report.Firings, 1)
+	require.Equal(t, "would assign alice", report.Firings[0].Reason)
+	require.Equal(t, b.Operations()[1], report.Firings[0].Operation)
+}
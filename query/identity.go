@@ -0,0 +1,124 @@
+package query
+
+import (
+	"fmt"
+)
+
+// IdentityQuery is the intermediary representation of an identity's query,
+// like "name:rene sort:activity". As Query does for the bugs, it doesn't do
+// anything by itself and need to be interpreted by the domain of application.
+type IdentityQuery struct {
+	// Search are terms matching the name, email or login of the identities
+	Search
+	IdentityFilters
+	IdentityOrderBy
+	OrderDirection
+}
+
+// IdentityFilters is a collection of filters on the identities. All the
+// values match case-insensitive substrings, and the identities have to match
+// every filter, and one value of each filter.
+type IdentityFilters struct {
+	Name  []string
+	Email []string
+	Login []string
+}
+
+type IdentityOrderBy int
+
+const (
+	_ IdentityOrderBy = iota
+	IdentityOrderById
+	IdentityOrderByName
+	// IdentityOrderByActivity sort by the time of the last activity on a bug
+	IdentityOrderByActivity
+)
+
+// NewIdentityQuery return an identity query with the default sorting (name-asc).
+func NewIdentityQuery() *IdentityQuery {
+	return &IdentityQuery{
+		IdentityOrderBy: IdentityOrderByName,
+		OrderDirection:  OrderAscending,
+	}
+}
+
+// ParseIdentity parse an identity query DSL
+//
+// Ex: "email:example.com sort:activity"
+//
+// Supported filter qualifiers and syntax are described in docs/queries.md
+func ParseIdentity(query string) (*IdentityQuery, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	q := NewIdentityQuery()
+	sortingDone := false
+
+	for _, t := range tokens {
+		switch t.kind {
+		case tokenKindSearch:
+			q.Search = append(q.Search, t.term)
+
+		case tokenKindKV:
+			switch t.qualifier {
+			case "name":
+				q.Name = append(q.Name, t.value)
+			case "email":
+				q.Email = append(q.Email, t.value)
+			case "login":
+				q.Login = append(q.Login, t.value)
+			case "sort":
+				if sortingDone {
+					return nil, fmt.Errorf("multiple sorting")
+				}
+				err = parseIdentitySorting(q, t.value)
+				if err != nil {
+					return nil, err
+				}
+				sortingDone = true
+
+			default:
+				return nil, fmt.Errorf("unknown qualifier \"%s\"", t.qualifier)
+			}
+
+		case tokenKindKVV:
+			return nil, fmt.Errorf("unknown qualifier \"%s:%s\"", t.qualifier, t.subQualifier)
+		}
+	}
+	return q, nil
+}
+
+func parseIdentitySorting(q *IdentityQuery, value string) error {
+	switch value {
+	// default ASC
+	case "id-desc":
+		q.IdentityOrderBy = IdentityOrderById
+		q.OrderDirection = OrderDescending
+	case "id", "id-asc":
+		q.IdentityOrderBy = IdentityOrderById
+		q.OrderDirection = OrderAscending
+
+	// default ASC
+	case "name-desc":
+		q.IdentityOrderBy = IdentityOrderByName
+		q.OrderDirection = OrderDescending
+	case "name", "name-asc":
+		q.IdentityOrderBy = IdentityOrderByName
+		q.OrderDirection = OrderAscending
+
+	// default DESC
+	case "activity", "activity-desc":
+		q.IdentityOrderBy = IdentityOrderByActivity
+		q.OrderDirection = OrderDescending
+	case "activity-asc":
+		q.IdentityOrderBy = IdentityOrderByActivity
+		q.OrderDirection = OrderAscending
+
+	default:
+		return fmt.Errorf("unknown sorting %s", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIdentity(t *testing.T) {
+	var tests = []struct {
+		input  string
+		output *IdentityQuery
+	}{
+		{"", NewIdentityQuery()},
+		{"rene", &IdentityQuery{
+			Search:          []string{"rene"},
+			IdentityOrderBy: IdentityOrderByName,
+			OrderDirection:  OrderAscending,
+		}},
+		{`name:"René Descartes" name:isaac email:example.com login:rd`, &IdentityQuery{
+			IdentityFilters: IdentityFilters{
+				Name:  []string{"René Descartes", "isaac"},
+				Email: []string{"example.com"},
+				Login: []string{"rd"},
+			},
+			IdentityOrderBy: IdentityOrderByName,
+			OrderDirection:  OrderAscending,
+		}},
+		{"sort:activity", &IdentityQuery{
+			IdentityOrderBy: IdentityOrderByActivity,
+			OrderDirection:  OrderDescending,
+		}},
+		{"sort:name-desc", &IdentityQuery{
+			IdentityOrderBy: IdentityOrderByName,
+			OrderDirection:  OrderDescending,
+		}},
+		{"sort:id", &IdentityQuery{
+			IdentityOrderBy: IdentityOrderById,
+			OrderDirection:  OrderAscending,
+		}},
+		{"sort:edit", nil},
+		{"sort:name sort:id", nil},
+		{"status:open", nil},
+		{"metadata:key:value", nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			query, err := ParseIdentity(tc.input)
+			if tc.output == nil {
+				require.Error(t, err)
+				require.Nil(t, query)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.output, query)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package query
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/MichaelMure/git-bug/entities/bug"
 	"github.com/MichaelMure/git-bug/entities/common"
 )
 
@@ -32,28 +34,59 @@ func Parse(query string) (*Query, error) {
 		case tokenKindKV:
 			switch t.qualifier {
 			case "status", "state":
-				status, err := common.StatusFromString(t.value)
-				if err != nil {
+				// "open" and "closed" match all the states with that status
+				if status, err := common.StatusFromString(t.value); err == nil {
+					q.Status = append(q.Status, status)
+					break
+				}
+				if err := bug.ValidateStateName(t.value); err != nil {
 					return nil, err
 				}
-				q.Status = append(q.Status, status)
+				q.State = append(q.State, t.value)
 			case "author":
 				q.Author = append(q.Author, t.value)
 			case "actor":
 				q.Actor = append(q.Actor, t.value)
 			case "participant":
 				q.Participant = append(q.Participant, t.value)
+			case "assignee":
+				q.Assignee = append(q.Assignee, t.value)
 			case "label":
 				q.Label = append(q.Label, t.value)
 			case "title":
 				q.Title = append(q.Title, t.value)
+			case "blocks", "duplicate-of", "related-to":
+				q.Relation = append(q.Relation, StringPair{Key: t.qualifier, Value: t.value})
 			case "no":
 				switch t.value {
 				case "label":
 					q.NoLabel = true
+				case "assignee":
+					q.NoAssignee = true
 				default:
 					return nil, fmt.Errorf("unknown \"no\" filter \"%s\"", t.value)
 				}
+			case "awaiting":
+				switch t.value {
+				case "reporter":
+					q.AwaitingReporter = true
+				default:
+					return nil, fmt.Errorf("unknown \"awaiting\" filter \"%s\"", t.value)
+				}
+			case "has":
+				switch t.value {
+				case "sync-conflict":
+					q.SyncConflict = true
+				default:
+					return nil, fmt.Errorf("unknown \"has\" filter \"%s\"", t.value)
+				}
+			case "is":
+				switch t.value {
+				case "unread":
+					q.Unread = true
+				default:
+					return nil, fmt.Errorf("unknown \"is\" filter \"%s\"", t.value)
+				}
 			case "sort":
 				if sortingDone {
 					return nil, fmt.Errorf("multiple sorting")
@@ -72,6 +105,20 @@ func Parse(query string) (*Query, error) {
 			switch t.qualifier {
 			case "metadata":
 				q.Metadata = append(q.Metadata, StringPair{Key: t.subQualifier, Value: t.value})
+			case "field":
+				q.Field = append(q.Field, StringPair{Key: t.subQualifier, Value: t.value})
+			case "author", "-author":
+				if t.subQualifier != "type" {
+					return nil, fmt.Errorf("unknown qualifier \"%s:%s\"", t.qualifier, t.subQualifier)
+				}
+				if t.value != AuthorTypeBot && t.value != AuthorTypeHuman {
+					return nil, fmt.Errorf("unknown author type \"%s\", expected bot or human", t.value)
+				}
+				if t.qualifier == "author" {
+					q.AuthorType = append(q.AuthorType, t.value)
+				} else {
+					q.NotAuthorType = append(q.NotAuthorType, t.value)
+				}
 
 			default:
 				return nil, fmt.Errorf("unknown qualifier \"%s:%s\"", t.qualifier, t.subQualifier)
@@ -81,35 +128,110 @@ func Parse(query string) (*Query, error) {
 	return q, nil
 }
 
+// fieldSortPrefix introduce a custom field in a sort key, as in
+// "sort:field.priority"
+const fieldSortPrefix = "field."
+
+// parseSorting parse a list of sort keys separated by commas, as in
+// "sort:field.priority,-edit"
 func parseSorting(q *Query, value string) error {
+	for i, raw := range strings.Split(value, ",") {
+		key, err := parseSortKey(raw)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			q.OrderBy = key.OrderBy
+			q.OrderDirection = key.OrderDirection
+			q.OrderField = key.Field
+		} else {
+			q.ThenBy = append(q.ThenBy, key)
+		}
+	}
+
+	return nil
+}
+
+// parseSortKey parse a single sort key. A "-" or "+" prefix force a
+// descending or ascending order, instead of the default one of the key.
+func parseSortKey(value string) (SortKey, error) {
+	var key SortKey
+	var forced OrderDirection
+
+	switch {
+	case strings.HasPrefix(value, "-"):
+		forced = OrderDescending
+		value = value[1:]
+	case strings.HasPrefix(value, "+"):
+		forced = OrderAscending
+		value = value[1:]
+	}
+
+	if strings.HasPrefix(value, fieldSortPrefix) {
+		// default ASC, in the order of the values of an enum
+		key.OrderBy = OrderByField
+		key.OrderDirection = OrderAscending
+		key.Field = strings.TrimPrefix(value, fieldSortPrefix)
+		if strings.HasSuffix(key.Field, "-desc") {
+			key.Field = strings.TrimSuffix(key.Field, "-desc")
+			key.OrderDirection = OrderDescending
+		} else if strings.HasSuffix(key.Field, "-asc") {
+			key.Field = strings.TrimSuffix(key.Field, "-asc")
+		}
+		if key.Field == "" {
+			return SortKey{}, fmt.Errorf("missing field name in sorting %s", value)
+		}
+	} else {
+		var err error
+		key.OrderBy, key.OrderDirection, err = parseSortName(value)
+		if err != nil {
+			return SortKey{}, err
+		}
+	}
+
+	if forced != 0 {
+		if value != strings.TrimSuffix(strings.TrimSuffix(value, "-asc"), "-desc") && forced != key.OrderDirection {
+			return SortKey{}, fmt.Errorf("conflicting directions in sorting %s", value)
+		}
+		key.OrderDirection = forced
+	}
+
+	return key, nil
+}
+
+func parseSortName(value string) (OrderBy, OrderDirection, error) {
 	switch value {
 	// default ASC
 	case "id-desc":
-		q.OrderBy = OrderById
-		q.OrderDirection = OrderDescending
+		return OrderById, OrderDescending, nil
 	case "id", "id-asc":
-		q.OrderBy = OrderById
-		q.OrderDirection = OrderAscending
+		return OrderById, OrderAscending, nil
 
 	// default DESC
 	case "creation", "creation-desc":
-		q.OrderBy = OrderByCreation
-		q.OrderDirection = OrderDescending
+		return OrderByCreation, OrderDescending, nil
 	case "creation-asc":
-		q.OrderBy = OrderByCreation
-		q.OrderDirection = OrderAscending
+		return OrderByCreation, OrderAscending, nil
 
 	// default DESC
 	case "edit", "edit-desc":
-		q.OrderBy = OrderByEdit
-		q.OrderDirection = OrderDescending
+		return OrderByEdit, OrderDescending, nil
 	case "edit-asc":
-		q.OrderBy = OrderByEdit
-		q.OrderDirection = OrderAscending
+		return OrderByEdit, OrderAscending, nil
+
+	// default DESC
+	case "comments", "comments-desc":
+		return OrderByComments, OrderDescending, nil
+	case "comments-asc":
+		return OrderByComments, OrderAscending, nil
+
+	// default DESC
+	case "last-comment", "last-comment-desc":
+		return OrderByLastComment, OrderDescending, nil
+	case "last-comment-asc":
+		return OrderByLastComment, OrderAscending, nil
 
 	default:
-		return fmt.Errorf("unknown sorting %s", value)
+		return 0, 0, fmt.Errorf("unknown sorting %s", value)
 	}
-
-	return nil
 }
@@ -23,7 +23,13 @@ func TestParse(t *testing.T) {
 		{"status:closed", &Query{
 			Filters: Filters{Status: []common.Status{common.ClosedStatus}},
 		}},
-		{"status:unknown", nil},
+		{"status:in-progress", &Query{
+			Filters: Filters{State: []string{"in-progress"}},
+		}},
+		{"state:review", &Query{
+			Filters: Filters{State: []string{"review"}},
+		}},
+		{"status:in.progress", nil},
 
 		{"author:rene", &Query{
 			Filters: Filters{Author: []string{"rene"}},
@@ -53,14 +59,77 @@ func TestParse(t *testing.T) {
 			Filters: Filters{Title: []string{"Bug titleTwo"}},
 		}},
 
+		{"blocks:a1b2c3", &Query{
+			Filters: Filters{Relation: []StringPair{{Key: "blocks", Value: "a1b2c3"}}},
+		}},
+		{"duplicate-of:a1b2c3 related-to:d4e5f6", &Query{
+			Filters: Filters{Relation: []StringPair{
+				{Key: "duplicate-of", Value: "a1b2c3"},
+				{Key: "related-to", Value: "d4e5f6"},
+			}},
+		}},
+
 		{"no:label", &Query{
 			Filters: Filters{NoLabel: true},
 		}},
+		{"assignee:rene assignee:me", &Query{
+			Filters: Filters{Assignee: []string{"rene", "me"}},
+		}},
+		{"no:assignee", &Query{
+			Filters: Filters{NoAssignee: true},
+		}},
+
+		{"awaiting:reporter", &Query{
+			Filters: Filters{AwaitingReporter: true},
+		}},
+		{"awaiting:unknown", nil},
+
+		{"has:sync-conflict", &Query{
+			Filters: Filters{SyncConflict: true},
+		}},
+		{"has:unknown", nil},
+
+		{"is:unread", &Query{
+			Filters: Filters{Unread: true},
+		}},
+		{"is:unknown", nil},
 
 		{"sort:edit", &Query{
 			OrderBy: OrderByEdit,
 		}},
+		{"sort:comments", &Query{
+			OrderBy: OrderByComments,
+		}},
+		{"sort:last-comment-asc", &Query{
+			OrderBy:        OrderByLastComment,
+			OrderDirection: OrderAscending,
+		}},
 		{"sort:unknown", nil},
+		{"sort:field.priority,-edit,id", &Query{
+			OrderBy:        OrderByField,
+			OrderDirection: OrderAscending,
+			OrderField:     "priority",
+			ThenBy: []SortKey{
+				{OrderBy: OrderByEdit, OrderDirection: OrderDescending},
+				{OrderBy: OrderById, OrderDirection: OrderAscending},
+			},
+		}},
+		{"sort:-field.priority", &Query{
+			OrderBy:        OrderByField,
+			OrderDirection: OrderDescending,
+			OrderField:     "priority",
+		}},
+		{"sort:+comments,field.team-desc", &Query{
+			OrderBy:        OrderByComments,
+			OrderDirection: OrderAscending,
+			ThenBy: []SortKey{
+				{OrderBy: OrderByField, OrderDirection: OrderDescending, Field: "team"},
+			},
+		}},
+		{"sort:edit,unknown", nil},
+		{"sort:edit,", nil},
+		{"sort:-edit-asc", nil},
+		{"sort:field.", nil},
 
 		{"label:\"foo:bar\"", &Query{
 			Filters: Filters{Label: []string{"foo:bar"}},
@@ -70,6 +139,18 @@ func TestParse(t *testing.T) {
 		{`metadata:key:"https://www.example.com/"`, &Query{
 			Filters: Filters{Metadata: []StringPair{{"key", "https://www.example.com/"}}},
 		}},
+		{`field:priority:high field:team:"core team"`, &Query{
+			Filters: Filters{Field: []StringPair{{"priority", "high"}, {"team", "core team"}}},
+		}},
+
+		{"author:type:bot", &Query{
+			Filters: Filters{AuthorType: []string{AuthorTypeBot}},
+		}},
+		{"-author:type:bot", &Query{
+			Filters: Filters{NotAuthorType: []string{AuthorTypeBot}},
+		}},
+		{"author:type:robot", nil},
+		{"-author:name:bot", nil},
 
 		// Search
 		{"search", &Query{
@@ -109,6 +190,8 @@ func TestParse(t *testing.T) {
 				if tc.output.OrderDirection != 0 {
 					require.Equal(t, tc.output.OrderDirection, query.OrderDirection)
 				}
+				require.Equal(t, tc.output.OrderField, query.OrderField)
+				require.Equal(t, tc.output.ThenBy, query.ThenBy)
 				require.Equal(t, tc.output.Filters, query.Filters)
 			}
 		})
@@ -13,6 +13,11 @@ type Query struct {
 	Filters
 	OrderBy
 	OrderDirection
+	// OrderField is the name of the custom field to sort by, with OrderByField
+	OrderField string
+	// ThenBy are the secondary sort keys, each one ordering the bugs that
+	// the previous keys consider equal
+	ThenBy []SortKey
 }
 
 // NewQuery return an identity query with the default sorting (creation-desc).
@@ -23,6 +28,16 @@ func NewQuery() *Query {
 	}
 }
 
+// SortKeys return all the sort keys of the query, the primary one first
+func (q *Query) SortKeys() []SortKey {
+	primary := SortKey{
+		OrderBy:        q.OrderBy,
+		OrderDirection: q.OrderDirection,
+		Field:          q.OrderField,
+	}
+	return append([]SortKey{primary}, q.ThenBy...)
+}
+
 type Search []string
 
 // StringPair is a key/value pair of strings
@@ -36,13 +51,42 @@ type Filters struct {
 	Status      []common.Status
 	Author      []string
 	Metadata    []StringPair
+	Field       []StringPair
 	Actor       []string
 	Participant []string
 	Label       []string
 	Title       []string
 	NoLabel     bool
+	// Assignee match the identities the bugs are assigned to
+	Assignee []string
+	// NoAssignee match the bugs assigned to nobody
+	NoAssignee bool
+	// Relation match the bugs with a relation to another bug, with the type
+	// of relation as key and a prefix of the id of the other bug as value
+	Relation []StringPair
+	// State match the state of the workflow the bugs are in
+	State []string
+	// AwaitingReporter match the bugs waiting for more information from their reporter
+	AwaitingReporter bool
+	// SyncConflict match the bugs with a synchronisation conflict recorded by a bridge
+	SyncConflict bool
+	// Unread match the bugs edited since the user last read them
+	Unread bool
+	// AuthorType match the bugs opened by a bot or a human, as in
+	// "author:type:bot"
+	AuthorType []string
+	// NotAuthorType exclude the bugs opened by a bot or a human, as in
+	// "-author:type:bot"
+	NotAuthorType []string
 }
 
+const (
+	// AuthorTypeBot is the type of the bot identities, used by automation
+	AuthorTypeBot = "bot"
+	// AuthorTypeHuman is the type of the other identities
+	AuthorTypeHuman = "human"
+)
+
 type OrderBy int
 
 const (
@@ -50,6 +94,10 @@ const (
 	OrderById
 	OrderByCreation
 	OrderByEdit
+	OrderByComments
+	OrderByLastComment
+	// OrderByField sort by the value of a custom field
+	OrderByField
 )
 
 type OrderDirection int
@@ -59,3 +107,11 @@ const (
 	OrderAscending
 	OrderDescending
 )
+
+// SortKey is one of the keys of a sorting
+type SortKey struct {
+	OrderBy
+	OrderDirection
+	// Field is the name of the custom field, with OrderByField
+	Field string
+}
@@ -85,6 +85,9 @@ func (m *mergedConfig) ReadAll(keyPrefix string) (map[string]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	if values == nil {
+		values = make(map[string]string, len(locals))
+	}
 	for k, val := range locals {
 		values[k] = val
 	}
@@ -217,12 +217,16 @@ func isGitDir(path string) (bool, error) {
 }
 
 func (repo *GoGitRepo) Close() error {
+	repo.indexesMutex.Lock()
+	defer repo.indexesMutex.Unlock()
+
 	var firstErr error
-	for _, index := range repo.indexes {
+	for name, index := range repo.indexes {
 		err := index.Close()
 		if err != nil && firstErr == nil {
 			firstErr = err
 		}
+		delete(repo.indexes, name)
 	}
 	return firstErr
 }
@@ -403,6 +407,51 @@ func (repo *GoGitRepo) FetchRefs(remote string, prefix string) (string, error) {
 	return buf.String(), nil
 }
 
+// SetupRemote make sure that a remote with the given URL exist, creating it if needed,
+// and that a plain git fetch from it also retrieve the refs matching the given directory
+// prefixes, with the same refspec as FetchRefs.
+func (repo *GoGitRepo) SetupRemote(name string, url string, prefixes []string) error {
+	repo.rMutex.Lock()
+	defer repo.rMutex.Unlock()
+
+	cfg, err := repo.r.Config()
+	if err != nil {
+		return err
+	}
+
+	remote, ok := cfg.Remotes[name]
+	if !ok {
+		remote = &config.RemoteConfig{
+			Name:  name,
+			URLs:  []string{url},
+			Fetch: []config.RefSpec{config.RefSpec(fmt.Sprintf("+refs/heads/*:refs/remotes/%s/*", name))},
+		}
+		cfg.Remotes[name] = remote
+	} else if len(remote.URLs) == 0 || remote.URLs[0] != url {
+		return fmt.Errorf("the remote %s already exists with a different url", name)
+	}
+
+	for _, prefix := range prefixes {
+		refspec := config.RefSpec(fmt.Sprintf("refs/%s/*:refs/remotes/%s/%s/*", prefix, name, prefix))
+		found := false
+		for _, existing := range remote.Fetch {
+			if existing == refspec {
+				found = true
+				break
+			}
+		}
+		if !found {
+			remote.Fetch = append(remote.Fetch, refspec)
+		}
+	}
+
+	if err := remote.Validate(); err != nil {
+		return err
+	}
+
+	return repo.r.SetConfig(cfg)
+}
+
 // PushRefs push git refs matching a directory prefix to a remote
 // Ex: prefix="foo" will push any local refs matching "refs/foo/*" to the remote.
 // The equivalent git refspec would be "refs/foo/*:refs/foo/*"
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/server"
+)
+
+var _ RepoGitServer = &GoGitRepo{}
+
+// GitServer return a git server exposing only the refs under the given
+// prefixes, as in "refs/bugs/". Only the fast-forward updates of those refs
+// are accepted, and they can't be deleted.
+func (repo *GoGitRepo) GitServer(refPrefixes []string) transport.Transport {
+	return server.NewServer(&refsLoader{storer: &refsStorer{
+		Storer:   repo.r.Storer,
+		repo:     repo,
+		prefixes: refPrefixes,
+	}})
+}
+
+// refsLoader always serve the same storer, whatever the endpoint
+type refsLoader struct {
+	storer storer.Storer
+}
+
+func (l *refsLoader) Load(_ *transport.Endpoint) (storer.Storer, error) {
+	return l.storer, nil
+}
+
+// refsStorer is a storer.Storer hiding the refs outside of some prefixes,
+// and refusing the updates that are not fast-forward
+type refsStorer struct {
+	storer.Storer
+	repo     *GoGitRepo
+	prefixes []string
+}
+
+func (s *refsStorer) served(name plumbing.ReferenceName) bool {
+	for _, prefix := range s.prefixes {
+		if strings.HasPrefix(name.String(), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *refsStorer) Reference(name plumbing.ReferenceName) (*plumbing.Reference, error) {
+	if !s.served(name) {
+		return nil, plumbing.ErrReferenceNotFound
+	}
+	return s.Storer.Reference(name)
+}
+
+func (s *refsStorer) IterReferences() (storer.ReferenceIter, error) {
+	iter, err := s.Storer.IterReferences()
+	if err != nil {
+		return nil, err
+	}
+	return storer.NewReferenceFilteredIter(func(ref *plumbing.Reference) bool {
+		return s.served(ref.Name())
+	}, iter), nil
+}
+
+func (s *refsStorer) SetReference(ref *plumbing.Reference) error {
+	return s.CheckAndSetReference(ref, nil)
+}
+
+func (s *refsStorer) CheckAndSetReference(ref, old *plumbing.Reference) error {
+	if !s.served(ref.Name()) {
+		return fmt.Errorf("ref %s is not served", ref.Name())
+	}
+
+	s.repo.rMutex.Lock()
+	defer s.repo.rMutex.Unlock()
+
+	current, err := s.Storer.Reference(ref.Name())
+	if err == plumbing.ErrReferenceNotFound {
+		return s.Storer.CheckAndSetReference(ref, old)
+	}
+	if err != nil {
+		return err
+	}
+
+	currentCommit, err := object.GetCommit(s.Storer, current.Hash())
+	if err != nil {
+		return err
+	}
+	newCommit, err := object.GetCommit(s.Storer, ref.Hash())
+	if err != nil {
+		return err
+	}
+	ff, err := currentCommit.IsAncestor(newCommit)
+	if err != nil {
+		return err
+	}
+	if !ff {
+		return fmt.Errorf("non-fast-forward update of %s, pull first", ref.Name())
+	}
+
+	return s.Storer.CheckAndSetReference(ref, current)
+}
+
+func (s *refsStorer) RemoveReference(name plumbing.ReferenceName) error {
+	return fmt.Errorf("ref %s can't be deleted", name)
+}
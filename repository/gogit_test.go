@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"os"
 	"path"
 	"path/filepath"
 	"testing"
@@ -86,3 +87,30 @@ func TestGoGitRepo_Indexes(t *testing.T) {
 	require.NoError(t, err)
 	require.NoDirExists(t, filepath.Join(plainRoot, ".git", namespace, "indexes", "a"))
 }
+
+func TestGoGitRepo_SetupRemote(t *testing.T) {
+	repo := CreateGoGitTestRepo(t, false)
+
+	err := repo.SetupRemote("upstream", "https://example.com/project.git", []string{"bugs", "identities"})
+	require.NoError(t, err)
+
+	// setting up again is a no-op
+	err = repo.SetupRemote("upstream", "https://example.com/project.git", []string{"bugs"})
+	require.NoError(t, err)
+
+	remotes, err := repo.GetRemotes()
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/project.git", remotes["upstream"])
+
+	gitConfig, err := os.ReadFile(filepath.Join(goGitRepoDir(t, repo), ".git", "config"))
+	require.NoError(t, err)
+	require.Contains(t, string(gitConfig), `[remote "upstream"]
+	url = https://example.com/project.git
+	fetch = +refs/heads/*:refs/remotes/upstream/*
+	fetch = refs/bugs/*:refs/remotes/upstream/bugs/*
+	fetch = refs/identities/*:refs/remotes/upstream/identities/*
+`)
+
+	err = repo.SetupRemote("upstream", "https://example.com/other.git", nil)
+	require.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/99designs/keyring"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 )
 
 const namespace = "git-bug"
@@ -50,6 +51,11 @@ func CreateGoGitTestRepo(t testing.TB, bare bool) TestedRepo {
 	}
 }
 
+// GitServer forward to the GoGitRepo wrapped by CreateGoGitTestRepo
+func (rk replaceKeyring) GitServer(refPrefixes []string) transport.Transport {
+	return rk.TestedRepo.(RepoGitServer).GitServer(refPrefixes)
+}
+
 func SetupGoGitReposAndRemote(t *testing.T) (repoA, repoB, remote TestedRepo) {
 	t.Helper()
 
@@ -210,6 +210,10 @@ func (r *mockRepoData) PushRefs(remote string, prefix string) (string, error) {
 	panic("implement me")
 }
 
+func (r *mockRepoData) SetupRemote(name string, url string, prefixes []string) error {
+	panic("implement me")
+}
+
 func (r *mockRepoData) StoreData(data []byte) (Hash, error) {
 	rawHash := sha1.Sum(data)
 	hash := Hash(fmt.Sprintf("%x", rawHash))
@@ -8,6 +8,7 @@ import (
 	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/blevesearch/bleve"
 	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 
 	"github.com/MichaelMure/git-bug/util/lamport"
 )
@@ -113,6 +114,11 @@ type RepoData interface {
 	// the remote state.
 	PushRefs(remote string, prefix string) (string, error)
 
+	// SetupRemote make sure that a remote with the given URL exist, creating it if needed,
+	// and that a plain git fetch from it also retrieve the refs matching the given directory
+	// prefixes, with the same refspec as FetchRefs.
+	SetupRemote(name string, url string, prefixes []string) error
+
 	// StoreData will store arbitrary data and return the corresponding hash
 	StoreData(data []byte) (Hash, error)
 
@@ -168,6 +174,15 @@ type RepoData interface {
 }
 
 // RepoClock give access to Lamport clocks
+// RepoGitServer is implemented by the repositories able to serve some of
+// their refs to the git clients, whatever the transport.
+type RepoGitServer interface {
+	// GitServer return a git server exposing only the refs under the given
+	// prefixes, as in "refs/bugs/". Only the fast-forward updates of those
+	// refs are accepted, and they can't be deleted.
+	GitServer(refPrefixes []string) transport.Transport
+}
+
 type RepoClock interface {
 	// AllClocks return all the known clocks
 	AllClocks() (map[string]lamport.Clock, error)
@@ -0,0 +1,130 @@
+package termui
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/i18n"
+)
+
+// accessibleConfigKey is the git config key enabling the accessible mode
+const accessibleConfigKey = "git-bug.termui.accessible"
+
+const announcementView = "announcementView"
+
+// textual replacement of the keys of the help bars that are only glyphs
+var accessibleKeys = map[string]string{
+	"←↓↑→,hjkl": "arrows,hjkl",
+	"↓↑,jk":     "up/down,jk",
+	"↵":         "enter",
+}
+
+// Options configure the terminal UI
+type Options struct {
+	// Accessible enable a mode friendlier to screen readers and low vision:
+	// a single linear navigation order, no box drawing or decorative glyphs,
+	// a high contrast theme and an explicit announcement of the state changes.
+	Accessible bool
+}
+
+// isAccessible tell if the accessible mode is enabled, either with the options
+// or with the git config.
+func isAccessible(repo *cache.RepoCache, opts Options) (bool, error) {
+	if opts.Accessible {
+		return true, nil
+	}
+
+	accessible, err := repo.AnyConfig().ReadBool(accessibleConfigKey)
+	if errors.Is(err, repository.ErrNoConfigEntry) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("invalid value for %s: %v", accessibleConfigKey, err)
+	}
+
+	return accessible, nil
+}
+
+// announce record a change of state, shown on a dedicated line in accessible
+// mode so that a screen reader can pick it up.
+func announce(format string, a ...interface{}) {
+	if !ui.accessible {
+		return
+	}
+	ui.announcement = i18n.Tf(format, a...)
+}
+
+// layoutAnnouncement render the last announcement, just above the help bar
+func layoutAnnouncement(g *gocui.Gui) error {
+	if !ui.accessible {
+		return nil
+	}
+
+	maxX, maxY := g.Size()
+	if maxY < 4 {
+		return nil
+	}
+
+	v, err := g.SetView(announcementView, -1, maxY-4, maxX, maxY-2, 0)
+	if err != nil {
+		if !errors.Is(err, gocui.ErrUnknownView) {
+			return err
+		}
+
+		v.Frame = false
+	}
+
+	v.Clear()
+	_, _ = fmt.Fprint(v, colors.Bold(ui.announcement))
+
+	_, err = g.SetViewOnTop(announcementView)
+	return err
+}
+
+// helpBarStyle render an entry of a help bar, in high contrast in accessible mode
+func helpBarStyle(s string) string {
+	if ui != nil && ui.accessible {
+		return colors.Black(colors.WhiteBg(s))
+	}
+	return colors.White(colors.BlueBg(s))
+}
+
+// helpBarKeys return the keys to display in a help bar, spelled out in
+// accessible mode when they are only glyphs
+func helpBarKeys(keys string) string {
+	if ui != nil && ui.accessible {
+		if spelled, ok := accessibleKeys[keys]; ok {
+			return spelled
+		}
+	}
+	return keys
+}
+
+// labelMarker return the colored square shown before a label, omitted in
+// accessible mode
+func labelMarker(label bug.Label) string {
+	if ui != nil && ui.accessible {
+		return ""
+	}
+	color := label.Color()
+	if ui != nil && ui.cache != nil {
+		color = ui.cache.LabelColor(label)
+	}
+	lc256 := color.Term256()
+	return lc256.Escape() + "◼ " + lc256.Unescape()
+}
+
+// accent apply a color to a text, except in accessible mode where the default
+// foreground color give the best contrast
+func accent(color func(a ...interface{}) string, s string) string {
+	if ui != nil && ui.accessible {
+		return s
+	}
+	return color(s)
+}
@@ -0,0 +1,389 @@
+package termui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	text "github.com/MichaelMure/go-term-text"
+	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entities/common"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/i18n"
+)
+
+const boardLaneViewPrefix = "boardLaneView"
+const boardInstructionView = "boardInstructionView"
+
+var boardHelp = helpBar{
+	{"q", "Back to the list"},
+	{"←↓↑→,hjkl", "Navigation"},
+	{"<>,HL", "Move to lane"},
+	{"↵", "Open bug"},
+}
+
+// boardLanes are the statuses shown as lanes, in order
+var boardLanes = []common.Status{common.OpenStatus, common.ClosedStatus}
+
+// board show the bugs matching the query of the bug table as lanes, one per
+// status, ignoring the status filter of the query.
+type board struct {
+	repo  *cache.RepoCache
+	lanes []boardLane
+	// index of the selected lane
+	selected int
+}
+
+type boardLane struct {
+	status   common.Status
+	excerpts []*cache.BugExcerpt
+	cursor   int
+	scroll   int
+}
+
+func newBoard(c *cache.RepoCache) *board {
+	lanes := make([]boardLane, len(boardLanes))
+	for i, status := range boardLanes {
+		lanes[i].status = status
+	}
+	return &board{
+		repo:  c,
+		lanes: lanes,
+	}
+}
+
+func boardLaneView(i int) string {
+	return fmt.Sprintf("%s%d", boardLaneViewPrefix, i)
+}
+
+func (bo *board) layout(g *gocui.Gui) error {
+	maxX, maxY := g.Size()
+
+	if maxY < 4 {
+		// window too small !
+		return nil
+	}
+
+	if err := bo.load(); err != nil {
+		return err
+	}
+
+	laneWidth := maxX / len(bo.lanes)
+
+	for i := range bo.lanes {
+		lane := &bo.lanes[i]
+		x0 := i * laneWidth
+		x1 := x0 + laneWidth - 1
+		if i == len(bo.lanes)-1 {
+			x1 = maxX - 1
+		}
+
+		v, err := g.SetView(boardLaneView(i), x0, 0, x1, maxY-2, 0)
+		if err != nil {
+			if !errors.Is(err, gocui.ErrUnknownView) {
+				return err
+			}
+			v.SelBgColor = gocui.ColorWhite
+			v.SelFgColor = gocui.ColorBlack
+		}
+
+		v.Title = fmt.Sprintf("%s (%d)", i18n.T(lane.status.String()), len(lane.excerpts))
+		v.Highlight = i == bo.selected
+
+		width, height := v.Size()
+		lane.clamp(height)
+
+		v.Clear()
+		bo.renderLane(v, lane, width, height)
+	}
+
+	v, err := g.SetView(boardInstructionView, -1, maxY-2, maxX, maxY, 0)
+	if err != nil {
+		if !errors.Is(err, gocui.ErrUnknownView) {
+			return err
+		}
+
+		v.Frame = false
+		v.FgColor = gocui.ColorWhite
+	}
+	v.Clear()
+	_, _ = fmt.Fprint(v, boardHelp.Render(maxX))
+
+	_, err = g.SetCurrentView(boardLaneView(bo.selected))
+	return err
+}
+
+// load query the bugs and dispatch them in their lane
+func (bo *board) load() error {
+	q := *ui.bugTable.query
+	q.Filters.Status = nil
+
+	ids, err := bo.repo.QueryBugs(&q)
+	if err != nil {
+		return err
+	}
+
+	for i := range bo.lanes {
+		bo.lanes[i].excerpts = bo.lanes[i].excerpts[:0]
+	}
+
+	for _, id := range ids {
+		excerpt, err := bo.repo.ResolveBugExcerpt(id)
+		if err != nil {
+			return err
+		}
+		for i := range bo.lanes {
+			if bo.lanes[i].status == excerpt.Status {
+				bo.lanes[i].excerpts = append(bo.lanes[i].excerpts, excerpt)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// clamp keep the cursor in the lane, and visible
+func (l *boardLane) clamp(height int) {
+	l.cursor = minInt(l.cursor, len(l.excerpts)-1)
+	l.cursor = maxInt(l.cursor, 0)
+
+	if l.cursor < l.scroll {
+		l.scroll = l.cursor
+	}
+	if height > 0 && l.cursor >= l.scroll+height {
+		l.scroll = l.cursor - height + 1
+	}
+}
+
+func (bo *board) renderLane(v *gocui.View, lane *boardLane, width int, height int) {
+	end := minInt(len(lane.excerpts), lane.scroll+height)
+
+	for _, excerpt := range lane.excerpts[lane.scroll:end] {
+		id := excerpt.Id.Human()
+		title := text.LeftPadMaxLine(strings.TrimSpace(excerpt.Title), width-len(id)-1, 0)
+		_, _ = fmt.Fprintf(v, "%s %s\n", accent(colors.Cyan, id), title)
+	}
+
+	_ = v.SetHighlight(lane.cursor-lane.scroll, true)
+}
+
+func (bo *board) keybindings(g *gocui.Gui) error {
+	for i := range bo.lanes {
+		view := boardLaneView(i)
+
+		// Back to the list
+		if err := g.SetKeybinding(view, 'q', gocui.ModNone, bo.back); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding(view, 'b', gocui.ModNone, bo.back); err != nil {
+			return err
+		}
+
+		// Down
+		if err := g.SetKeybinding(view, 'j', gocui.ModNone, bo.cursorDown); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding(view, gocui.KeyArrowDown, gocui.ModNone, bo.cursorDown); err != nil {
+			return err
+		}
+		// Up
+		if err := g.SetKeybinding(view, 'k', gocui.ModNone, bo.cursorUp); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding(view, gocui.KeyArrowUp, gocui.ModNone, bo.cursorUp); err != nil {
+			return err
+		}
+		// Previous lane
+		if err := g.SetKeybinding(view, 'h', gocui.ModNone, bo.previousLane); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding(view, gocui.KeyArrowLeft, gocui.ModNone, bo.previousLane); err != nil {
+			return err
+		}
+		// Next lane
+		if err := g.SetKeybinding(view, 'l', gocui.ModNone, bo.nextLane); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding(view, gocui.KeyArrowRight, gocui.ModNone, bo.nextLane); err != nil {
+			return err
+		}
+
+		// Move the bug to the previous lane
+		if err := g.SetKeybinding(view, '<', gocui.ModNone, bo.moveToPreviousLane); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding(view, 'H', gocui.ModNone, bo.moveToPreviousLane); err != nil {
+			return err
+		}
+		// Move the bug to the next lane
+		if err := g.SetKeybinding(view, '>', gocui.ModNone, bo.moveToNextLane); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding(view, 'L', gocui.ModNone, bo.moveToNextLane); err != nil {
+			return err
+		}
+
+		// Open bug
+		if err := g.SetKeybinding(view, gocui.KeyEnter, gocui.ModNone, bo.openBug); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bo *board) disable(g *gocui.Gui) error {
+	for i := range bo.lanes {
+		if err := g.DeleteView(boardLaneView(i)); err != nil && !errors.Is(err, gocui.ErrUnknownView) {
+			return err
+		}
+	}
+	if err := g.DeleteView(boardInstructionView); err != nil && !errors.Is(err, gocui.ErrUnknownView) {
+		return err
+	}
+	return nil
+}
+
+func (bo *board) lane() *boardLane {
+	return &bo.lanes[bo.selected]
+}
+
+// selectedBug return the excerpt under the cursor, if any
+func (bo *board) selectedBug() *cache.BugExcerpt {
+	lane := bo.lane()
+	if lane.cursor < 0 || lane.cursor >= len(lane.excerpts) {
+		return nil
+	}
+	return lane.excerpts[lane.cursor]
+}
+
+// announceSelected announce the lane and the bug under the cursor
+func (bo *board) announceSelected() {
+	lane := bo.lane()
+	excerpt := bo.selectedBug()
+	if excerpt == nil {
+		announce("Lane %s, empty", lane.status)
+		return
+	}
+	announce("Lane %s, bug %d of %d, %s: %s",
+		lane.status, lane.cursor+1, len(lane.excerpts), excerpt.Id.Human(), excerpt.Title)
+}
+
+func (bo *board) back(g *gocui.Gui, v *gocui.View) error {
+	return ui.activateWindow(ui.bugTable)
+}
+
+func (bo *board) cursorDown(g *gocui.Gui, v *gocui.View) error {
+	lane := bo.lane()
+	lane.cursor = minInt(lane.cursor+1, len(lane.excerpts)-1)
+	bo.announceSelected()
+	return nil
+}
+
+func (bo *board) cursorUp(g *gocui.Gui, v *gocui.View) error {
+	lane := bo.lane()
+	lane.cursor = maxInt(lane.cursor-1, 0)
+	bo.announceSelected()
+	return nil
+}
+
+func (bo *board) previousLane(g *gocui.Gui, v *gocui.View) error {
+	bo.selected = maxInt(bo.selected-1, 0)
+	bo.announceSelected()
+	return nil
+}
+
+func (bo *board) nextLane(g *gocui.Gui, v *gocui.View) error {
+	bo.selected = minInt(bo.selected+1, len(bo.lanes)-1)
+	bo.announceSelected()
+	return nil
+}
+
+func (bo *board) moveToPreviousLane(g *gocui.Gui, v *gocui.View) error {
+	if bo.selected == 0 {
+		return nil
+	}
+	return bo.moveTo(bo.selected - 1)
+}
+
+func (bo *board) moveToNextLane(g *gocui.Gui, v *gocui.View) error {
+	if bo.selected == len(bo.lanes)-1 {
+		return nil
+	}
+	return bo.moveTo(bo.selected + 1)
+}
+
+// moveTo change the status of the selected bug to the one of the given lane,
+// and commit it right away. The selection follow the bug.
+func (bo *board) moveTo(target int) error {
+	excerpt := bo.selectedBug()
+	if excerpt == nil {
+		return nil
+	}
+
+	b, err := bo.repo.ResolveBug(excerpt.Id)
+	if err != nil {
+		return err
+	}
+
+	status := bo.lanes[target].status
+	switch status {
+	case common.OpenStatus:
+		_, err = b.Open()
+	case common.ClosedStatus:
+		_, err = b.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return err
+	}
+
+	if err := bo.load(); err != nil {
+		return err
+	}
+
+	bo.selected = target
+	lane := bo.lane()
+	lane.cursor = indexOfExcerpt(lane.excerpts, b.Id())
+	announce("Bug %s moved to %s", b.Id().Human(), status)
+
+	return nil
+}
+
+func indexOfExcerpt(excerpts []*cache.BugExcerpt, id entity.Id) int {
+	for i, excerpt := range excerpts {
+		if excerpt.Id == id {
+			return i
+		}
+	}
+	return 0
+}
+
+func (bo *board) openBug(g *gocui.Gui, v *gocui.View) error {
+	excerpt := bo.selectedBug()
+	if excerpt == nil {
+		return nil
+	}
+	b, err := bo.repo.ResolveBug(excerpt.Id)
+	if err != nil {
+		return err
+	}
+	ui.showBug.SetBug(b)
+	ui.showBug.origin = bo
+	announce("Showing bug %s: %s", b.Id().Human(), b.Snapshot().Title)
+	return ui.activateWindow(ui.showBug)
+}
+
+// showBoard switch from the bug table to the board, keeping its query
+func (bt *bugTable) showBoard(g *gocui.Gui, v *gocui.View) error {
+	announce("Showing the board of %s", bt.queryStr)
+	return ui.activateWindow(ui.board)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/query"
 	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/i18n"
 )
 
 const bugTableView = "bugTableView"
@@ -30,6 +31,7 @@ var bugTableHelp = helpBar{
 	{"←↓↑→,hjkl", "Navigation"},
 	{"↵", "Open bug"},
 	{"n", "New bug"},
+	{"b", "Board"},
 	{"i", "Pull"},
 	{"o", "Push"},
 }
@@ -200,6 +202,12 @@ func (bt *bugTable) keybindings(g *gocui.Gui) error {
 		return err
 	}
 
+	// Board
+	if err := g.SetKeybinding(bugTableView, 'b', gocui.ModNone,
+		bt.showBoard); err != nil {
+		return err
+	}
+
 	// Pull
 	if err := g.SetKeybinding(bugTableView, 'i', gocui.ModNone,
 		bt.pull); err != nil {
@@ -308,17 +316,33 @@ func (bt *bugTable) render(v *gocui.View, maxX int) {
 		}
 		if excerpt.LenComments-1 > 999 {
 			summaryTxt = "  ∞"
+			if ui.accessible {
+				summaryTxt = "999+"
+			}
 		}
 
 		var labelsTxt strings.Builder
 		for _, l := range excerpt.Labels {
 			labelsTxt.WriteString(" ")
-			lc256 := l.Color().Term256()
+			if ui.accessible {
+				// the label colors mean nothing to a screen reader
+				labelsTxt.WriteString(l.String())
+				continue
+			}
+			lc256 := bt.repo.LabelColor(l).Term256()
 			labelsTxt.WriteString(lc256.Escape())
 			labelsTxt.WriteString("◼")
 			labelsTxt.WriteString(lc256.Unescape())
 		}
 
+		var badge string
+		if excerpt.AwaitingReporter {
+			badge = colors.YellowBg("needs-info") + " "
+		}
+		if excerpt.SyncConflict {
+			badge += colors.RedBg("sync-conflict") + " "
+		}
+
 		author, err := bt.repo.ResolveIdentityExcerpt(excerpt.AuthorId)
 		if err != nil {
 			panic(err)
@@ -326,18 +350,23 @@ func (bt *bugTable) render(v *gocui.View, maxX int) {
 
 		id := text.LeftPadMaxLine(excerpt.Id.Human(), columnWidths["id"], 0)
 		status := text.LeftPadMaxLine(excerpt.Status.String(), columnWidths["status"], 0)
-		labels := text.TruncateMax(labelsTxt.String(), minInt(columnWidths["title"]-2, 10))
-		title := text.LeftPadMaxLine(strings.TrimSpace(excerpt.Title), columnWidths["title"]-text.Len(labels), 0)
+		maxLabels := 10
+		if ui.accessible {
+			maxLabels = columnWidths["title"] / 2
+		}
+		labels := text.TruncateMax(labelsTxt.String(), minInt(columnWidths["title"]-2, maxLabels))
+		title := text.LeftPadMaxLine(strings.TrimSpace(excerpt.Title), columnWidths["title"]-text.Len(labels)-text.Len(badge), 0)
 		authorTxt := text.LeftPadMaxLine(author.DisplayName(), columnWidths["author"], 0)
 		comments := text.LeftPadMaxLine(summaryTxt, columnWidths["comments"], 0)
 		lastEdit := text.LeftPadMaxLine(humanize.Time(excerpt.EditTime()), columnWidths["lastEdit"], 1)
 
-		_, _ = fmt.Fprintf(v, "%s %s %s%s %s %s %s\n",
-			colors.Cyan(id),
-			colors.Yellow(status),
+		_, _ = fmt.Fprintf(v, "%s %s %s%s%s %s %s %s\n",
+			accent(colors.Cyan, id),
+			accent(colors.Yellow, status),
+			badge,
 			title,
 			labels,
-			colors.Magenta(authorTxt),
+			accent(colors.Magenta, authorTxt),
 			comments,
 			lastEdit,
 		)
@@ -349,18 +378,18 @@ func (bt *bugTable) render(v *gocui.View, maxX int) {
 func (bt *bugTable) renderHeader(v *gocui.View, maxX int) {
 	columnWidths := bt.getColumnWidths(maxX)
 
-	id := text.LeftPadMaxLine("ID", columnWidths["id"], 0)
-	status := text.LeftPadMaxLine("STATUS", columnWidths["status"], 0)
-	title := text.LeftPadMaxLine("TITLE", columnWidths["title"], 0)
-	author := text.LeftPadMaxLine("AUTHOR", columnWidths["author"], 0)
-	comments := text.LeftPadMaxLine("CMT", columnWidths["comments"], 0)
-	lastEdit := text.LeftPadMaxLine("LAST EDIT", columnWidths["lastEdit"], 1)
+	id := text.LeftPadMaxLine(i18n.T("ID"), columnWidths["id"], 0)
+	status := text.LeftPadMaxLine(i18n.T("STATUS"), columnWidths["status"], 0)
+	title := text.LeftPadMaxLine(i18n.T("TITLE"), columnWidths["title"], 0)
+	author := text.LeftPadMaxLine(i18n.T("AUTHOR"), columnWidths["author"], 0)
+	comments := text.LeftPadMaxLine(i18n.T("CMT"), columnWidths["comments"], 0)
+	lastEdit := text.LeftPadMaxLine(i18n.T("LAST EDIT"), columnWidths["lastEdit"], 1)
 
 	_, _ = fmt.Fprintf(v, "%s %s %s %s %s %s\n", id, status, title, author, comments, lastEdit)
 }
 
 func (bt *bugTable) renderFooter(v *gocui.View, maxX int) {
-	_, _ = fmt.Fprintf(v, " \nShowing %d of %d bugs", len(bt.excerpts), len(bt.allIds))
+	_, _ = fmt.Fprint(v, i18n.Tf(" \nShowing %d of %d bugs", len(bt.excerpts), len(bt.allIds)))
 }
 
 func (bt *bugTable) renderHelp(v *gocui.View, maxX int) {
@@ -379,10 +408,13 @@ func (bt *bugTable) cursorDown(g *gocui.Gui, v *gocui.View) error {
 		bt.pageCursor += max
 		bt.selectCursor = 0
 
-		return bt.doPaginate(max)
+		err := bt.doPaginate(max)
+		bt.announceSelected()
+		return err
 	}
 
 	bt.selectCursor = minInt(bt.selectCursor+1, bt.getTableLength()-1)
+	bt.announceSelected()
 
 	return nil
 }
@@ -399,14 +431,28 @@ func (bt *bugTable) cursorUp(g *gocui.Gui, v *gocui.View) error {
 		bt.pageCursor = maxInt(0, bt.pageCursor-max)
 		bt.selectCursor = max - 1
 
-		return bt.doPaginate(max)
+		err := bt.doPaginate(max)
+		bt.announceSelected()
+		return err
 	}
 
 	bt.selectCursor = maxInt(bt.selectCursor-1, 0)
+	bt.announceSelected()
 
 	return nil
 }
 
+// announceSelected announce the bug under the cursor
+func (bt *bugTable) announceSelected() {
+	if bt.selectCursor < 0 || bt.selectCursor >= len(bt.excerpts) {
+		return
+	}
+	excerpt := bt.excerpts[bt.selectCursor]
+	announce("Bug %d of %d, %s, %s: %s",
+		bt.pageCursor+bt.selectCursor+1, len(bt.allIds),
+		excerpt.Id.Human(), excerpt.Status, excerpt.Title)
+}
+
 func (bt *bugTable) cursorClamp(v *gocui.View) error {
 	y := bt.selectCursor
 
@@ -443,7 +489,7 @@ func (bt *bugTable) previousPage(g *gocui.Gui, v *gocui.View) error {
 }
 
 func (bt *bugTable) newBug(g *gocui.Gui, v *gocui.View) error {
-	return newBugWithEditor(bt.repo)
+	return ui.bugWizard.start(g)
 }
 
 func (bt *bugTable) openBug(g *gocui.Gui, v *gocui.View) error {
@@ -457,6 +503,7 @@ func (bt *bugTable) openBug(g *gocui.Gui, v *gocui.View) error {
 		return err
 	}
 	ui.showBug.SetBug(b)
+	announce("Showing bug %s: %s", b.Id().Human(), b.Snapshot().Title)
 	return ui.activateWindow(ui.showBug)
 }
 
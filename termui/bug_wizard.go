@@ -0,0 +1,506 @@
+package termui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/input"
+	"github.com/MichaelMure/git-bug/entities/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/i18n"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+const bugWizardView = "bugWizardView"
+const bugWizardInstructionsView = "bugWizardInstructionsView"
+
+var bugWizardChoiceHelp = helpBar{
+	{"Esc", "Abort"},
+	{"↓↑,jk", "Nav"},
+	{"↵", "Next"},
+}
+
+var bugWizardLabelsHelp = helpBar{
+	{"Esc", "Abort"},
+	{"↓↑,jk", "Nav"},
+	{"space,x", "Select"},
+	{"a", "Add label"},
+	{"↵", "Next"},
+}
+
+var bugWizardConfirmHelp = helpBar{
+	{"Esc", "Abort"},
+	{"↵", "Create"},
+	{"e", "Edit the title and description"},
+}
+
+type bugWizardStep int
+
+const (
+	bugWizardTemplate bugWizardStep = iota
+	bugWizardLabels
+	bugWizardAssignee
+	bugWizardConfirm
+)
+
+// bugWizard is the creation flow of a new bug, without leaving the interface:
+// after the title, a template, the labels and an assignee are picked, and the
+// bug is created with a single confirmation. The title and description can
+// still be completed in the editor, if requested.
+type bugWizard struct {
+	cache *cache.RepoCache
+	step  bugWizardStep
+
+	title     string
+	templates []cache.BugTemplate
+	// the selected template, -1 for none
+	template int
+
+	labels      []bug.Label
+	labelSelect []bool
+
+	identities []*cache.IdentityExcerpt
+	// the selected assignee, -1 for none
+	assignee int
+
+	// the item under the cursor, in the current step
+	selected int
+}
+
+func newBugWizard(c *cache.RepoCache) *bugWizard {
+	return &bugWizard{cache: c}
+}
+
+// start ask for the title of the new bug, then show the following steps
+func (bw *bugWizard) start(g *gocui.Gui) error {
+	templates, err := bw.cache.BugTemplates()
+	if err != nil {
+		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+		return nil
+	}
+
+	c := ui.inputPopup.Activate("Title of the new bug")
+
+	go func() {
+		title := text.CleanupOneLine(<-c)
+
+		g.Update(func(g *gocui.Gui) error {
+			if title == "" {
+				ui.msgPopup.Activate(msgPopupErrorTitle, "Empty title, aborting.")
+				return nil
+			}
+
+			bw.reset(title, templates)
+			return ui.activateWindow(bw)
+		})
+	}()
+
+	return nil
+}
+
+func (bw *bugWizard) reset(title string, templates []cache.BugTemplate) {
+	bw.title = title
+	bw.templates = templates
+	bw.template = -1
+	bw.labels = bw.cache.ValidLabels()
+	bw.labelSelect = make([]bool, len(bw.labels))
+	bw.identities = nil
+	for _, id := range bw.cache.AllIdentityIds() {
+		excerpt, err := bw.cache.ResolveIdentityExcerpt(id)
+		if err == nil {
+			bw.identities = append(bw.identities, excerpt)
+		}
+	}
+	bw.assignee = -1
+	bw.selected = 0
+
+	bw.step = bugWizardTemplate
+	if len(templates) == 0 {
+		bw.step = bugWizardLabels
+	}
+	bw.announceStep()
+}
+
+func (bw *bugWizard) keybindings(g *gocui.Gui) error {
+	// Abort
+	if err := g.SetKeybinding(bugWizardView, gocui.KeyEsc, gocui.ModNone, bw.abort); err != nil {
+		return err
+	}
+	// Up
+	if err := g.SetKeybinding(bugWizardView, gocui.KeyArrowUp, gocui.ModNone, bw.selectPrevious); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(bugWizardView, 'k', gocui.ModNone, bw.selectPrevious); err != nil {
+		return err
+	}
+	// Down
+	if err := g.SetKeybinding(bugWizardView, gocui.KeyArrowDown, gocui.ModNone, bw.selectNext); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(bugWizardView, 'j', gocui.ModNone, bw.selectNext); err != nil {
+		return err
+	}
+	// Select a label
+	if err := g.SetKeybinding(bugWizardView, gocui.KeySpace, gocui.ModNone, bw.toggleLabel); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(bugWizardView, 'x', gocui.ModNone, bw.toggleLabel); err != nil {
+		return err
+	}
+	// Add a label
+	if err := g.SetKeybinding(bugWizardView, 'a', gocui.ModNone, bw.addLabel); err != nil {
+		return err
+	}
+	// Next step, or create
+	if err := g.SetKeybinding(bugWizardView, gocui.KeyEnter, gocui.ModNone, bw.next); err != nil {
+		return err
+	}
+	// Create with the editor
+	if err := g.SetKeybinding(bugWizardView, 'e', gocui.ModNone, bw.editAndCreate); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (bw *bugWizard) layout(g *gocui.Gui) error {
+	maxX, maxY := g.Size()
+
+	if maxY < 4 {
+		// window too small !
+		return nil
+	}
+
+	v, err := g.SetView(bugWizardView, 0, 0, maxX-1, maxY-2, 0)
+	if err != nil {
+		if !errors.Is(err, gocui.ErrUnknownView) {
+			return err
+		}
+
+		v.Frame = true
+		v.SelBgColor = gocui.ColorWhite
+		v.SelFgColor = gocui.ColorBlack
+	}
+
+	v.Title = i18n.Tf("New bug: %s", bw.title)
+	v.Highlight = bw.step != bugWizardConfirm
+	v.Clear()
+	_, _ = fmt.Fprint(v, bw.render())
+
+	// the first line is the title of the step
+	line := bw.selected + 1
+	_, height := v.Size()
+	originY := 0
+	if line >= height {
+		originY = line - height + 1
+	}
+	if err := v.SetOrigin(0, originY); err != nil {
+		return err
+	}
+	if err := v.SetCursor(0, line-originY); err != nil {
+		return err
+	}
+
+	v, err = g.SetView(bugWizardInstructionsView, -1, maxY-2, maxX, maxY, 0)
+	if err != nil {
+		if !errors.Is(err, gocui.ErrUnknownView) {
+			return err
+		}
+		v.Frame = false
+		v.FgColor = gocui.ColorWhite
+	}
+	v.Clear()
+	_, _ = fmt.Fprint(v, bw.help().Render(maxX))
+
+	_, err = g.SetCurrentView(bugWizardView)
+	return err
+}
+
+func (bw *bugWizard) disable(g *gocui.Gui) error {
+	for _, view := range []string{bugWizardView, bugWizardInstructionsView} {
+		if err := g.DeleteView(view); err != nil && !errors.Is(err, gocui.ErrUnknownView) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bw *bugWizard) help() helpBar {
+	switch bw.step {
+	case bugWizardLabels:
+		return bugWizardLabelsHelp
+	case bugWizardConfirm:
+		return bugWizardConfirmHelp
+	default:
+		return bugWizardChoiceHelp
+	}
+}
+
+// render write the current step, the first line being its title, and the
+// following ones its items
+func (bw *bugWizard) render() string {
+	var b strings.Builder
+
+	choice := func(item string) {
+		b.WriteString("  " + item + "\n")
+	}
+
+	switch bw.step {
+	case bugWizardTemplate:
+		b.WriteString(i18n.T("Template:") + "\n")
+		choice(i18n.T("none"))
+		for _, template := range bw.templates {
+			choice(template.Name)
+		}
+
+	case bugWizardLabels:
+		b.WriteString(i18n.T("Labels:") + "\n")
+		for i, label := range bw.labels {
+			if bw.labelSelect[i] {
+				b.WriteString(" [x] ")
+			} else {
+				b.WriteString(" [ ] ")
+			}
+			b.WriteString(labelMarker(label) + label.String() + "\n")
+		}
+
+	case bugWizardAssignee:
+		b.WriteString(i18n.T("Assignee:") + "\n")
+		choice(i18n.T("nobody"))
+		for _, excerpt := range bw.identities {
+			choice(excerpt.DisplayName())
+		}
+
+	case bugWizardConfirm:
+		b.WriteString(i18n.T("Create the bug?") + "\n\n")
+		b.WriteString(i18n.Tf("Title: %s", bw.title) + "\n")
+		if bw.template >= 0 {
+			b.WriteString(i18n.Tf("Template: %s", bw.templates[bw.template].Name) + "\n")
+		}
+		if labels := bw.selectedLabels(); len(labels) > 0 {
+			b.WriteString(i18n.Tf("Labels: %s", strings.Join(labels, ", ")) + "\n")
+		}
+		if bw.assignee >= 0 {
+			b.WriteString(i18n.Tf("Assignee: %s", bw.identities[bw.assignee].DisplayName()) + "\n")
+		}
+		if message := bw.message(); message != "" {
+			b.WriteString("\n" + message + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// itemCount return the number of items of the current step
+func (bw *bugWizard) itemCount() int {
+	switch bw.step {
+	case bugWizardTemplate:
+		return len(bw.templates) + 1
+	case bugWizardLabels:
+		return len(bw.labels)
+	case bugWizardAssignee:
+		return len(bw.identities) + 1
+	default:
+		return 0
+	}
+}
+
+func (bw *bugWizard) announceStep() {
+	switch bw.step {
+	case bugWizardTemplate:
+		announce("Choose a template")
+	case bugWizardLabels:
+		announce("Choose the labels")
+	case bugWizardAssignee:
+		announce("Choose an assignee")
+	case bugWizardConfirm:
+		announce("Create the bug?")
+	}
+}
+
+func (bw *bugWizard) selectPrevious(g *gocui.Gui, v *gocui.View) error {
+	bw.selected = maxInt(0, bw.selected-1)
+	return nil
+}
+
+func (bw *bugWizard) selectNext(g *gocui.Gui, v *gocui.View) error {
+	bw.selected = maxInt(0, minInt(bw.itemCount()-1, bw.selected+1))
+	return nil
+}
+
+func (bw *bugWizard) toggleLabel(g *gocui.Gui, v *gocui.View) error {
+	if bw.step != bugWizardLabels || bw.selected >= len(bw.labels) {
+		return nil
+	}
+	bw.labelSelect[bw.selected] = !bw.labelSelect[bw.selected]
+	return nil
+}
+
+func (bw *bugWizard) addLabel(g *gocui.Gui, v *gocui.View) error {
+	if bw.step != bugWizardLabels {
+		return nil
+	}
+
+	c := ui.inputPopup.Activate("Add a new label")
+
+	go func() {
+		input := <-c
+
+		// Standardize label format
+		input = strings.TrimSuffix(input, "\n")
+		input = strings.Replace(input, " ", "-", -1)
+
+		g.Update(func(g *gocui.Gui) error {
+			if input != "" {
+				bw.selectLabel(bug.Label(input))
+			}
+			return nil
+		})
+	}()
+
+	return nil
+}
+
+// selectLabel select a label, adding it to the list if needed
+func (bw *bugWizard) selectLabel(label bug.Label) {
+	for i, l := range bw.labels {
+		if l == label {
+			bw.labelSelect[i] = true
+			bw.selected = i
+			return
+		}
+	}
+	bw.labels = append(bw.labels, label)
+	bw.labelSelect = append(bw.labelSelect, true)
+	bw.selected = len(bw.labels) - 1
+}
+
+func (bw *bugWizard) next(g *gocui.Gui, v *gocui.View) error {
+	switch bw.step {
+	case bugWizardTemplate:
+		bw.template = bw.selected - 1
+		if bw.template >= 0 {
+			for _, label := range bw.templates[bw.template].Labels {
+				bw.selectLabel(label)
+			}
+		}
+		bw.step = bugWizardLabels
+
+	case bugWizardLabels:
+		bw.step = bugWizardAssignee
+
+	case bugWizardAssignee:
+		bw.assignee = bw.selected - 1
+		bw.step = bugWizardConfirm
+
+	case bugWizardConfirm:
+		b, err := bw.create(bw.title, bw.message())
+		if err != nil {
+			ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+			return ui.activateWindow(ui.bugTable)
+		}
+		ui.showBug.SetBug(b)
+		return ui.activateWindow(ui.showBug)
+	}
+
+	bw.selected = 0
+	bw.announceStep()
+	return nil
+}
+
+func (bw *bugWizard) editAndCreate(g *gocui.Gui, v *gocui.View) error {
+	if bw.step != bugWizardConfirm {
+		return nil
+	}
+
+	// As for the other uses of the editor, gocui is stopped entirely, then
+	// started again.
+	ui.g.Close()
+	ui.g = nil
+
+	title, message, err := input.BugCreateEditorInput(ui.cache, bw.title, bw.message())
+	if err != nil && err != input.ErrEmptyTitle {
+		return err
+	}
+
+	if err == input.ErrEmptyTitle {
+		ui.msgPopup.Activate(msgPopupErrorTitle, "Empty title, aborting.")
+		ui.activeWindow = ui.bugTable
+		initGui(nil)
+		return errTerminateMainloop
+	}
+
+	b, err := bw.create(title, message)
+	if err != nil {
+		return err
+	}
+
+	ui.activeWindow = ui.bugTable
+	initGui(func(ui *termUI) error {
+		ui.showBug.SetBug(b)
+		return ui.activateWindow(ui.showBug)
+	})
+
+	return errTerminateMainloop
+}
+
+func (bw *bugWizard) abort(g *gocui.Gui, v *gocui.View) error {
+	return ui.activateWindow(ui.bugTable)
+}
+
+// message return the initial description of the bug, from the template
+func (bw *bugWizard) message() string {
+	if bw.template < 0 {
+		return ""
+	}
+	return bw.templates[bw.template].Message
+}
+
+func (bw *bugWizard) selectedLabels() []string {
+	var result []string
+	for i, label := range bw.labels {
+		if bw.labelSelect[i] {
+			result = append(result, label.String())
+		}
+	}
+	return result
+}
+
+// create write the new bug, then set its labels and assignee at once
+func (bw *bugWizard) create(title string, message string) (*cache.BugCache, error) {
+	author, err := bw.cache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	b, _, err := bw.cache.NewBugRaw(author, time.Now().Unix(),
+		text.CleanupOneLine(title), text.Cleanup(message), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := bw.selectedLabels()
+	if len(labels) == 0 && bw.assignee < 0 {
+		return b, nil
+	}
+
+	err = b.Transaction(func(tx *cache.BugTx) error {
+		if len(labels) > 0 {
+			if _, _, err := tx.ChangeLabels(labels, nil); err != nil {
+				return err
+			}
+		}
+		if bw.assignee >= 0 {
+			if _, err := tx.SetAssignee([]entity.Id{bw.identities[bw.assignee].Id}, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return b, err
+}
@@ -6,7 +6,7 @@ import (
 
 	text "github.com/MichaelMure/go-term-text"
 
-	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/i18n"
 )
 
 type helpBar []struct {
@@ -17,14 +17,21 @@ type helpBar []struct {
 func (hb helpBar) Render(maxX int) string {
 	var builder strings.Builder
 	for _, entry := range hb {
-		builder.WriteString(colors.White(colors.BlueBg(fmt.Sprintf("[%s] %s", entry.keys, entry.text))))
+		builder.WriteString(helpBarStyle(fmt.Sprintf("[%s] %s", helpBarKeys(entry.keys), i18n.T(entry.text))))
 		builder.WriteByte(' ')
 	}
 
 	l := text.Len(builder.String())
-	if l < maxX {
-		builder.WriteString(colors.White(colors.BlueBg(strings.Repeat(" ", maxX-l))))
+	if l >= maxX {
+		return builder.String()
 	}
 
-	return builder.String()
+	padding := helpBarStyle(strings.Repeat(" ", maxX-l))
+
+	// for a right to left language, align the entries on the right
+	if i18n.TextDirection() == i18n.RightToLeft {
+		return padding + builder.String()
+	}
+
+	return builder.String() + padding
 }
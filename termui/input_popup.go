@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 
 	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/util/i18n"
 )
 
 const inputPopupView = "inputPopupView"
@@ -101,7 +103,7 @@ func (ip *inputPopup) ActivateWithContent(title string, content string) <-chan s
 }
 
 func (ip *inputPopup) Activate(title string) <-chan string {
-	ip.title = title
+	ip.title = i18n.T(title)
 	ip.active = true
 	ip.c = make(chan string)
 	return ip.c
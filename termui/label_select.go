@@ -136,9 +136,7 @@ func (ls *labelSelect) layout(g *gocui.Gui) error {
 			selectBox = " [x] "
 		}
 
-		lc := label.Color()
-		lc256 := lc.Term256()
-		labelStr := lc256.Escape() + "◼ " + lc256.Unescape() + label.String()
+		labelStr := labelMarker(label) + label.String()
 		_, _ = fmt.Fprint(v, selectBox, labelStr)
 
 		y0 += 2
@@ -6,6 +6,8 @@ import (
 
 	text "github.com/MichaelMure/go-term-text"
 	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/util/i18n"
 )
 
 const msgPopupView = "msgPopupView"
@@ -81,8 +83,8 @@ func (ep *msgPopup) close(g *gocui.Gui, v *gocui.View) error {
 
 func (ep *msgPopup) Activate(title string, message string) {
 	ep.active = true
-	ep.title = title
-	ep.message = message
+	ep.title = i18n.T(title)
+	ep.message = i18n.T(message)
 }
 
 func (ep *msgPopup) UpdateMessage(message string) {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	text "github.com/MichaelMure/go-term-text"
@@ -14,6 +15,8 @@ import (
 	"github.com/MichaelMure/git-bug/entities/common"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/i18n"
+	"github.com/MichaelMure/git-bug/util/render"
 )
 
 const showBugView = "showBugView"
@@ -30,17 +33,24 @@ var showBugHelp = helpBar{
 	{"e", "Edit"},
 	{"c", "Comment"},
 	{"t", "Change title"},
+	{"T", "Translate"},
 }
 
 type showBug struct {
-	cache              *cache.RepoCache
-	bug                *cache.BugCache
+	cache *cache.RepoCache
+	bug   *cache.BugCache
+	unpin func()
+	// the window to return to, the bug table if nil
+	origin             window
 	childViews         []string
 	mainSelectableView []string
 	sideSelectableView []string
 	selected           string
 	isOnSide           bool
 	scroll             int
+	// the language of the machine translations shown instead of the original
+	// texts, if any
+	lang string
 }
 
 func newShowBug(cache *cache.RepoCache) *showBug {
@@ -50,10 +60,17 @@ func newShowBug(cache *cache.RepoCache) *showBug {
 }
 
 func (sb *showBug) SetBug(bug *cache.BugCache) {
+	// keep the bug loaded in the cache while it's displayed and edited
+	if sb.unpin != nil {
+		sb.unpin()
+	}
 	sb.bug = bug
+	sb.unpin = bug.Pin()
+	sb.origin = nil
 	sb.scroll = 0
 	sb.selected = ""
 	sb.isOnSide = false
+	sb.lang = ""
 }
 
 func (sb *showBug) layout(g *gocui.Gui) error {
@@ -196,6 +213,12 @@ func (sb *showBug) keybindings(g *gocui.Gui) error {
 		return err
 	}
 
+	// Translate
+	if err := g.SetKeybinding(showBugView, 'T', gocui.ModNone,
+		sb.toggleTranslation); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -218,16 +241,38 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 
 	sb.mainSelectableView = nil
 
+	var translations bug.Translations
+	title := snap.Title
+	if sb.lang != "" {
+		translations = snap.Translations(sb.lang)
+		if translations.Title != "" {
+			title = translations.Title
+		}
+	}
+
 	createTimelineItem := snap.Timeline[0].(*bug.CreateTimelineItem)
 
 	edited := ""
 	if createTimelineItem.Edited() {
 		edited = " (edited)"
 	}
+	edited += reactionsSummary(createTimelineItem.Reactions)
+	if sb.lang != "" {
+		edited += fmt.Sprintf(" (translated to %s)", sb.lang)
+	}
+
+	awaiting := ""
+	if snap.AwaitingReporter {
+		awaiting = " " + colors.YellowBg("needs-info")
+	}
+	if len(snap.SyncConflicts) > 0 {
+		awaiting += " " + colors.RedBg("sync-conflict")
+	}
 
-	bugHeader := fmt.Sprintf("[%s] %s\n\n[%s] %s opened this bug on %s%s",
+	bugHeader := fmt.Sprintf("[%s]%s %s\n\n[%s] %s opened this bug on %s%s",
 		colors.Cyan(snap.Id().Human()),
-		colors.Bold(snap.Title),
+		awaiting,
+		colors.Bold(title),
 		colors.Yellow(snap.Status),
 		colors.Magenta(snap.Author.DisplayName()),
 		snap.CreateTime.Format(timeLayout),
@@ -258,7 +303,7 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			if op.MessageIsEmpty() {
 				content, lines = text.WrapLeftPadded(emptyMessagePlaceholder(), maxX-1, 4)
 			} else {
-				content, lines = text.WrapLeftPadded(op.Message, maxX-1, 4)
+				content, lines = text.WrapLeftPadded(render.ANSI(translatedMessage(translations, op.CombinedId(), op.Message), sb.cache.RefResolver()), maxX-1, 4)
 			}
 
 			v, err := sb.createOpView(g, viewName, x0, y0, maxX+1, lines, true)
@@ -273,12 +318,13 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			if op.Edited() {
 				edited = " (edited)"
 			}
+			edited += reactionsSummary(op.Reactions)
 
 			var message string
 			if op.MessageIsEmpty() {
 				message, _ = text.WrapLeftPadded(emptyMessagePlaceholder(), maxX-1, 4)
 			} else {
-				message, _ = text.WrapLeftPadded(op.Message, maxX-1, 4)
+				message, _ = text.WrapLeftPadded(render.ANSI(translatedMessage(translations, op.CombinedId(), op.Message), sb.cache.RefResolver()), maxX-1, 4)
 			}
 
 			content := fmt.Sprintf("%s commented on %s%s\n\n%s",
@@ -378,6 +424,15 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 	return nil
 }
 
+// translatedMessage return the translation of a message if shown and stored,
+// or the original message
+func translatedMessage(translations bug.Translations, id entity.CombinedId, message string) string {
+	if translation, ok := translations.Messages[id]; ok {
+		return translation
+	}
+	return message
+}
+
 // emptyMessagePlaceholder return a formatted placeholder for an empty message
 func emptyMessagePlaceholder() string {
 	return colors.BlackBold(colors.WhiteBg("No description provided."))
@@ -431,17 +486,46 @@ func (sb *showBug) renderSidebar(g *gocui.Gui, sideView *gocui.View) error {
 
 	labelStr := make([]string, len(snap.Labels))
 	for i, l := range snap.Labels {
-		lc := l.Color()
-		lc256 := lc.Term256()
-		labelStr[i] = lc256.Escape() + "◼ " + lc256.Unescape() + l.String()
+		labelStr[i] = labelMarker(l) + l.String()
 	}
 
 	labels := strings.Join(labelStr, "\n")
 	labels, lines := text.WrapLeftPadded(labels, maxX, 2)
 
 	content := fmt.Sprintf("%s\n\n%s", colors.Bold("  Labels"), labels)
+	height := lines + 2
+
+	// the custom fields are only displayed, they are edited with the cli
+	if len(snap.Fields) > 0 {
+		names := make([]string, 0, len(snap.Fields))
+		for name := range snap.Fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fieldStr := make([]string, len(names))
+		for i, name := range names {
+			fieldStr[i] = fmt.Sprintf("%s: %s", name, snap.Fields[name])
+		}
+
+		fields, fieldLines := text.WrapLeftPadded(strings.Join(fieldStr, "\n"), maxX, 2)
+		content += fmt.Sprintf("\n\n%s\n\n%s", colors.Bold("  Fields"), fields)
+		height += fieldLines + 3
+	}
+
+	// the relations are only displayed, they are edited with the cli
+	if len(snap.Relations) > 0 {
+		relationStr := make([]string, len(snap.Relations))
+		for i, relation := range snap.Relations {
+			relationStr[i] = relation.String()
+		}
 
-	v, err := sb.createSideView(g, "sideLabels", x0, y0, maxX, lines+2)
+		relations, relationLines := text.WrapLeftPadded(strings.Join(relationStr, "\n"), maxX, 2)
+		content += fmt.Sprintf("\n\n%s\n\n%s", colors.Bold("  Relations"), relations)
+		height += relationLines + 3
+	}
+
+	v, err := sb.createSideView(g, "sideLabels", x0, y0, maxX, height)
 	if err != nil {
 		return err
 	}
@@ -456,7 +540,11 @@ func (sb *showBug) saveAndBack(g *gocui.Gui, v *gocui.View) error {
 	if err != nil {
 		return err
 	}
-	err = ui.activateWindow(ui.bugTable)
+	origin := sb.origin
+	if origin == nil {
+		origin = ui.bugTable
+	}
+	err = ui.activateWindow(origin)
 	if err != nil {
 		return err
 	}
@@ -505,12 +593,7 @@ func (sb *showBug) scrollDown(g *gocui.Gui, v *gocui.View) error {
 }
 
 func (sb *showBug) selectPrevious(g *gocui.Gui, v *gocui.View) error {
-	var selectable []string
-	if sb.isOnSide {
-		selectable = sb.sideSelectableView
-	} else {
-		selectable = sb.mainSelectableView
-	}
+	selectable := sb.selectable()
 
 	for i, name := range selectable {
 		if name == sb.selected {
@@ -520,7 +603,7 @@ func (sb *showBug) selectPrevious(g *gocui.Gui, v *gocui.View) error {
 			}
 
 			sb.selected = selectable[maxInt(i-1, 0)]
-			return sb.focusView(g)
+			return sb.focusSelected(g)
 		}
 	}
 
@@ -528,21 +611,16 @@ func (sb *showBug) selectPrevious(g *gocui.Gui, v *gocui.View) error {
 		sb.selected = selectable[0]
 	}
 
-	return sb.focusView(g)
+	return sb.focusSelected(g)
 }
 
 func (sb *showBug) selectNext(g *gocui.Gui, v *gocui.View) error {
-	var selectable []string
-	if sb.isOnSide {
-		selectable = sb.sideSelectableView
-	} else {
-		selectable = sb.mainSelectableView
-	}
+	selectable := sb.selectable()
 
 	for i, name := range selectable {
 		if name == sb.selected {
 			sb.selected = selectable[minInt(i+1, len(selectable)-1)]
-			return sb.focusView(g)
+			return sb.focusSelected(g)
 		}
 	}
 
@@ -550,9 +628,87 @@ func (sb *showBug) selectNext(g *gocui.Gui, v *gocui.View) error {
 		sb.selected = selectable[0]
 	}
 
+	return sb.focusSelected(g)
+}
+
+// selectable return the views that can be selected with up and down. In
+// accessible mode, the main and side views form a single linear list.
+func (sb *showBug) selectable() []string {
+	switch {
+	case ui.accessible:
+		selectable := make([]string, 0, len(sb.mainSelectableView)+len(sb.sideSelectableView))
+		selectable = append(selectable, sb.mainSelectableView...)
+		return append(selectable, sb.sideSelectableView...)
+	case sb.isOnSide:
+		return sb.sideSelectableView
+	default:
+		return sb.mainSelectableView
+	}
+}
+
+// focusSelected bring the selected view into sight, and announce it
+func (sb *showBug) focusSelected(g *gocui.Gui) error {
+	if ui.accessible {
+		sb.isOnSide = false
+		for _, name := range sb.sideSelectableView {
+			if name == sb.selected {
+				sb.isOnSide = true
+			}
+		}
+		sb.announceSelected()
+	}
+
 	return sb.focusView(g)
 }
 
+// announceSelected describe the selected item of the timeline or sidebar
+func (sb *showBug) announceSelected() {
+	selectable := sb.selectable()
+	position := 0
+	for i, name := range selectable {
+		if name == sb.selected {
+			position = i + 1
+		}
+	}
+
+	snap := sb.bug.Snapshot()
+
+	var description string
+	if sb.isOnSide {
+		labels := make([]string, len(snap.Labels))
+		for i, l := range snap.Labels {
+			labels[i] = l.String()
+		}
+		description = i18n.Tf("Labels: %s", strings.Join(labels, ", "))
+	} else {
+		id := entity.CombinedId(sb.selected)
+		item, err := snap.SearchTimelineItem(id)
+		if err != nil {
+			return
+		}
+		description = describeTimelineItem(item)
+	}
+
+	announce("%s (%d of %d)", description, position, len(selectable))
+}
+
+func describeTimelineItem(item bug.TimelineItem) string {
+	switch item := item.(type) {
+	case *bug.CreateTimelineItem:
+		return i18n.Tf("Description by %s", item.Author.DisplayName())
+	case *bug.AddCommentTimelineItem:
+		return i18n.Tf("Comment by %s", item.Author.DisplayName())
+	case *bug.LabelChangeTimelineItem:
+		return i18n.Tf("Label change by %s", item.Author.DisplayName())
+	case *bug.SetStatusTimelineItem:
+		return i18n.Tf("Status changed to %s by %s", item.Status, item.Author.DisplayName())
+	case *bug.SetTitleTimelineItem:
+		return i18n.Tf("Title changed to %s by %s", item.Title, item.Author.DisplayName())
+	default:
+		return i18n.T("Timeline item")
+	}
+}
+
 func (sb *showBug) left(g *gocui.Gui, v *gocui.View) error {
 	if sb.isOnSide {
 		sb.isOnSide = false
@@ -627,15 +783,56 @@ func (sb *showBug) toggleOpenClose(g *gocui.Gui, v *gocui.View) error {
 	switch sb.bug.Snapshot().Status {
 	case common.OpenStatus:
 		_, err := sb.bug.Close()
+		announce("Bug %s closed", sb.bug.Id().Human())
 		return err
 	case common.ClosedStatus:
 		_, err := sb.bug.Open()
+		announce("Bug %s reopened", sb.bug.Id().Human())
 		return err
 	default:
 		return nil
 	}
 }
 
+// toggleTranslation switch between the original texts and their machine
+// translations to the configured language. The texts not translated yet are
+// translated with the configured command, if any.
+func (sb *showBug) toggleTranslation(g *gocui.Gui, v *gocui.View) error {
+	if sb.lang != "" {
+		sb.lang = ""
+		announce("Original texts shown")
+		return nil
+	}
+
+	lang, err := sb.cache.TranslationLanguage()
+	if err != nil {
+		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+		return nil
+	}
+	if lang == "" {
+		ui.msgPopup.Activate(msgPopupErrorTitle, "No language configured, see the git-bug.translate.language git config key.")
+		return nil
+	}
+
+	translator, err := sb.cache.Translator()
+	switch {
+	case errors.Is(err, cache.ErrNoTranslator):
+		// only the stored translations are shown
+	case err != nil:
+		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+		return nil
+	default:
+		if _, err := sb.bug.Translate(translator, lang); err != nil {
+			ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+			return nil
+		}
+	}
+
+	sb.lang = lang
+	announce("Machine translation to %s shown", lang)
+	return nil
+}
+
 func (sb *showBug) edit(g *gocui.Gui, v *gocui.View) error {
 	snap := sb.bug.Snapshot()
 
@@ -669,3 +866,13 @@ func (sb *showBug) editLabels(g *gocui.Gui, snap *bug.Snapshot) error {
 	ui.labelSelect.SetBug(sb.cache, sb.bug)
 	return ui.activateWindow(ui.labelSelect)
 }
+
+// reactionsSummary return the count of each reaction to a comment, to be shown
+// next to its header
+func reactionsSummary(groups []bug.ReactionGroup) string {
+	var result strings.Builder
+	for _, group := range groups {
+		_, _ = fmt.Fprintf(&result, " %s %d", group.Emoji(), len(group.Authors))
+	}
+	return result.String()
+}
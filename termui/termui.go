@@ -23,9 +23,15 @@ type termUI struct {
 
 	activeWindow window
 
+	// accessible mode, see Options
+	accessible   bool
+	announcement string
+
 	bugTable    *bugTable
+	board       *board
 	showBug     *showBug
 	labelSelect *labelSelect
+	bugWizard   *bugWizard
 	msgPopup    *msgPopup
 	inputPopup  *inputPopup
 }
@@ -49,22 +55,35 @@ type window interface {
 }
 
 // Run will launch the termUI in the terminal
-func Run(cache *cache.RepoCache) error {
+func Run(cache *cache.RepoCache, opts Options) error {
+	accessible, err := isAccessible(cache, opts)
+	if err != nil {
+		return err
+	}
+
 	ui = &termUI{
+		accessible:  accessible,
 		gError:      make(chan error, 1),
 		cache:       cache,
 		bugTable:    newBugTable(cache),
+		board:       newBoard(cache),
 		showBug:     newShowBug(cache),
 		labelSelect: newLabelSelect(),
+		bugWizard:   newBugWizard(cache),
 		msgPopup:    newMsgPopup(),
 		inputPopup:  newInputPopup(),
 	}
 
 	ui.activeWindow = ui.bugTable
 
+	// refresh the screen each time the data change, for example during a pull
+	events, unsubscribe := cache.Subscribe()
+	defer unsubscribe()
+	go refreshOnEvents(events)
+
 	initGui(nil)
 
-	err := <-ui.gError
+	err = <-ui.gError
 
 	type errorStack interface {
 		ErrorStack() string
@@ -80,6 +99,15 @@ func Run(cache *cache.RepoCache) error {
 	return nil
 }
 
+// refreshOnEvents trigger a new layout of the UI for each event received
+func refreshOnEvents(events <-chan cache.Event) {
+	for range events {
+		if g := ui.g; g != nil {
+			g.Update(func(*gocui.Gui) error { return nil })
+		}
+	}
+}
+
 func initGui(action func(ui *termUI) error) {
 	g, err := gocui.NewGui(gocui.Output256, false)
 
@@ -94,6 +122,13 @@ func initGui(action func(ui *termUI) error) {
 
 	ui.g.InputEsc = true
 
+	if ui.accessible {
+		// frames drawn with plain ASCII, and a visible focus
+		ui.g.ASCII = true
+		ui.g.Highlight = true
+		ui.g.SelFrameColor = gocui.ColorWhite | gocui.AttrBold
+	}
+
 	err = keybindings(ui.g)
 
 	if err != nil {
@@ -130,6 +165,10 @@ func layout(g *gocui.Gui) error {
 		return err
 	}
 
+	if err := layoutAnnouncement(g); err != nil {
+		return err
+	}
+
 	if err := ui.msgPopup.layout(g); err != nil {
 		return err
 	}
@@ -151,6 +190,10 @@ func keybindings(g *gocui.Gui) error {
 		return err
 	}
 
+	if err := ui.board.keybindings(g); err != nil {
+		return err
+	}
+
 	if err := ui.showBug.keybindings(g); err != nil {
 		return err
 	}
@@ -159,6 +202,10 @@ func keybindings(g *gocui.Gui) error {
 		return err
 	}
 
+	if err := ui.bugWizard.keybindings(g); err != nil {
+		return err
+	}
+
 	if err := ui.msgPopup.keybindings(g); err != nil {
 		return err
 	}
@@ -174,49 +221,6 @@ func quit(g *gocui.Gui, v *gocui.View) error {
 	return gocui.ErrQuit
 }
 
-func newBugWithEditor(repo *cache.RepoCache) error {
-	// This is somewhat hacky.
-	// As there is no way to pause gocui, run the editor and restart gocui,
-	// we have to stop it entirely and start a new one later.
-	//
-	// - an error channel is used to route the returned error of this new
-	// 		instance into the original launch function
-	// - a custom error (errTerminateMainloop) is used to terminate the original
-	//		instance's mainLoop. This error is then filtered.
-
-	ui.g.Close()
-	ui.g = nil
-
-	title, message, err := input.BugCreateEditorInput(ui.cache, "", "")
-
-	if err != nil && err != input.ErrEmptyTitle {
-		return err
-	}
-
-	var b *cache.BugCache
-	if err == input.ErrEmptyTitle {
-		ui.msgPopup.Activate(msgPopupErrorTitle, "Empty title, aborting.")
-		initGui(nil)
-
-		return errTerminateMainloop
-	} else {
-		b, _, err = repo.NewBug(
-			text.CleanupOneLine(title),
-			text.Cleanup(message),
-		)
-		if err != nil {
-			return err
-		}
-
-		initGui(func(ui *termUI) error {
-			ui.showBug.SetBug(b)
-			return ui.activateWindow(ui.showBug)
-		})
-
-		return errTerminateMainloop
-	}
-}
-
 func addCommentWithEditor(bug *cache.BugCache) error {
 	// This is somewhat hacky.
 	// As there is no way to pause gocui, run the editor and restart gocui,
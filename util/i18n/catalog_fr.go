@@ -0,0 +1,99 @@
+package i18n
+
+func init() {
+	Register("fr", map[string]string{
+		// TUI
+		"Quit":                            "Quitter",
+		"Search":                          "Rechercher",
+		"Navigation":                      "Navigation",
+		"Open bug":                        "Ouvrir le bug",
+		"New bug":                         "Nouveau bug",
+		"Pull":                            "Récupérer",
+		"Push":                            "Publier",
+		"Save and close":                  "Enregistrer et fermer",
+		"Nav":                             "Nav",
+		"Add item":                        "Ajouter",
+		"Save and return":                 "Enregistrer et revenir",
+		"Toggle open/close":               "Ouvrir/fermer",
+		"Edit":                            "Modifier",
+		"Comment":                         "Commenter",
+		"Change title":                    "Changer le titre",
+		"Error":                           "Erreur",
+		"ID":                              "ID",
+		"STATUS":                          "STATUT",
+		"TITLE":                           "TITRE",
+		"AUTHOR":                          "AUTEUR",
+		"CMT":                             "COM",
+		"LAST EDIT":                       "MODIFIÉ",
+		" \nShowing %d of %d bugs":        " \n%d bugs affichés sur %d",
+		"Add a new label":                 "Ajouter une étiquette",
+		"Selected field is not editable.": "Le champ sélectionné n'est pas modifiable.",
+		"No changes found, aborting.":     "Aucun changement, abandon.",
+		"Board":                           "Tableau",
+		"Back to the list":                "Retour à la liste",
+		"Move to lane":                    "Changer de colonne",
+		"open":                            "ouvert",
+		"closed":                          "fermé",
+		"Abort":                           "Abandonner",
+		"Next":                            "Suivant",
+		"Select":                          "Sélectionner",
+		"Add label":                       "Ajouter une étiquette",
+		"Create":                          "Créer",
+		"Edit the title and description":  "Modifier le titre et la description",
+		"Title of the new bug":            "Titre du nouveau bug",
+		"New bug: %s":                     "Nouveau bug : %s",
+		"Template:":                       "Modèle :",
+		"Labels:":                         "Étiquettes :",
+		"Assignee:":                       "Assigné :",
+		"none":                            "aucun",
+		"nobody":                          "personne",
+		"Create the bug?":                 "Créer le bug ?",
+		"Title: %s":                       "Titre : %s",
+		"Template: %s":                    "Modèle : %s",
+		"Labels: %s":                      "Étiquettes : %s",
+		"Assignee: %s":                    "Assigné : %s",
+		"Choose a template":               "Choisissez un modèle",
+		"Choose the labels":               "Choisissez les étiquettes",
+		"Choose an assignee":              "Choisissez un assigné",
+
+		// CLI
+		"Empty title, aborting.":   "Titre vide, abandon.",
+		"Empty message, aborting.": "Message vide, abandon.",
+		"No change, aborting.":     "Aucun changement, abandon.",
+		"No message given. Use -m or -F option to specify a message. Aborting.": "Aucun message donné. Utilisez l'option -m ou -F pour le spécifier. Abandon.",
+		"No title given. Use -m or -F option to specify a title. Aborting.":     "Aucun titre donné. Utilisez l'option -m ou -F pour le spécifier. Abandon.",
+		"%s created":                         "%s créé",
+		"%s opened this issue %s":            "%s a ouvert ce ticket %s",
+		"This was last edited at %s":         "Dernière modification le %s",
+		"labels: %s":                         "étiquettes : %s",
+		"actors: %s":                         "acteurs : %s",
+		"participants: %s":                   "participants : %s",
+		"selected bug %s: %s":                "bug %s sélectionné : %s",
+		"bug %s removed":                     "bug %s supprimé",
+		"Author: %s":                         "Auteur : %s",
+		"Date: %s":                           "Date : %s",
+		"You now trust %s":                   "Vous faites maintenant confiance à %s",
+		"You don't trust %s anymore":         "Vous ne faites plus confiance à %s",
+		"Your identity is now: %s":           "Votre identité est maintenant : %s",
+		"Name: %s":                           "Nom : %s",
+		"Email: %s":                          "E-mail : %s",
+		"Login: %s":                          "Identifiant : %s",
+		"Last modification: %s":              "Dernière modification : %s",
+		"Metadata:":                          "Métadonnées :",
+		"Activity:":                          "Activité :",
+		"Fetching remote ...":                "Récupération du dépôt distant ...",
+		"Merging data ...":                   "Fusion des données ...",
+		"%s holds confidential data":         "%s contient des données confidentielles",
+		"%d bugs prefetched":                 "%d bugs préchargés",
+		"%d unreferenced identities removed": "%d identités non référencées supprimées",
+		"%d unreferenced identities would be removed":         "%d identités non référencées seraient supprimées",
+		"%d entries verified, %d new entries since":           "%d entrées vérifiées, %d nouvelles entrées depuis",
+		"Successfully configured bridge: %s":                  "Pont configuré avec succès : %s",
+		"Successfully removed bridge configuration %v":        "Configuration du pont %v supprimée avec succès",
+		"imported %d issues and %d identities with %s bridge": "%d tickets et %d identités importés avec le pont %s",
+		"token %s added":            "jeton %s ajouté",
+		"Press Ctrl+c to quit":      "Appuyez sur Ctrl+c pour quitter",
+		"WebUI is shutting down...": "Arrêt de l'interface web...",
+		"WebUI stopped":             "Interface web arrêtée",
+	})
+}
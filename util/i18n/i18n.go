@@ -0,0 +1,172 @@
+// Package i18n provides the localization of the user-facing strings of the CLI
+// and the TUI.
+//
+// Messages are identified by their english text, which is also what is shown
+// when no translation is available. Translations are provided by catalogs,
+// registered per language.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// EnvLocale, when set, override the locale given by the usual LC_ALL,
+// LC_MESSAGES and LANG environment variables.
+const EnvLocale = "GIT_BUG_LOCALE"
+
+// EnvTextDirection, when set to "ltr" or "rtl", override the text direction
+// derived from the locale.
+const EnvTextDirection = "GIT_BUG_TEXT_DIRECTION"
+
+// Direction is the direction in which a text is written
+type Direction int
+
+const (
+	LeftToRight Direction = iota
+	RightToLeft
+)
+
+// languages written from right to left
+var rtlLanguages = map[string]bool{
+	"ar": true, // Arabic
+	"dv": true, // Divehi
+	"fa": true, // Persian
+	"he": true, // Hebrew
+	"ps": true, // Pashto
+	"ur": true, // Urdu
+	"yi": true, // Yiddish
+}
+
+var mu sync.RWMutex
+var catalogs = make(map[string]map[string]string)
+var current struct {
+	once      sync.Once
+	locale    string
+	direction Direction
+}
+
+// Register add a catalog of translations for the given language ("fr") or
+// language and region ("fr_CA"). Keys are the english messages.
+func Register(language string, catalog map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	existing, ok := catalogs[language]
+	if !ok {
+		existing = make(map[string]string, len(catalog))
+		catalogs[language] = existing
+	}
+	for msg, translated := range catalog {
+		existing[msg] = translated
+	}
+}
+
+// DetectLocale return the locale configured in the environment, normalized to
+// the "language_REGION" form, or an empty string if none is configured.
+func DetectLocale() string {
+	for _, env := range []string{EnvLocale, "LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(env); value != "" {
+			return normalizeLocale(value)
+		}
+	}
+	return ""
+}
+
+// normalizeLocale strip the encoding and modifier of a POSIX locale, like in
+// "fr_FR.UTF-8@euro".
+func normalizeLocale(locale string) string {
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	locale = strings.ReplaceAll(locale, "-", "_")
+	if locale == "C" || locale == "POSIX" {
+		return ""
+	}
+	return locale
+}
+
+func ensureInit() {
+	current.once.Do(func() {
+		setLocale(DetectLocale())
+	})
+}
+
+// SetLocale change the locale in use, overriding the one of the environment.
+func SetLocale(locale string) {
+	ensureInit()
+	setLocale(normalizeLocale(locale))
+}
+
+func setLocale(locale string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	current.locale = locale
+	current.direction = LeftToRight
+	if rtlLanguages[language(locale)] {
+		current.direction = RightToLeft
+	}
+
+	switch strings.ToLower(os.Getenv(EnvTextDirection)) {
+	case "ltr":
+		current.direction = LeftToRight
+	case "rtl":
+		current.direction = RightToLeft
+	}
+}
+
+// Locale return the locale in use, or an empty string for the default english.
+func Locale() string {
+	ensureInit()
+	mu.RLock()
+	defer mu.RUnlock()
+	return current.locale
+}
+
+// TextDirection return the direction of the text for the locale in use.
+func TextDirection() Direction {
+	ensureInit()
+	mu.RLock()
+	defer mu.RUnlock()
+	return current.direction
+}
+
+func language(locale string) string {
+	if i := strings.IndexByte(locale, '_'); i >= 0 {
+		return locale[:i]
+	}
+	return locale
+}
+
+// T return the translation of the given message for the locale in use, or the
+// message itself if no translation is available. Trailing newlines are not
+// part of the message and are kept as is.
+func T(msg string) string {
+	ensureInit()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if current.locale == "" {
+		return msg
+	}
+
+	trimmed := strings.TrimRight(msg, "\n")
+	suffix := msg[len(trimmed):]
+
+	// first try with the region, then with the language only
+	for _, key := range []string{current.locale, language(current.locale)} {
+		if translated, ok := catalogs[key][trimmed]; ok {
+			return translated + suffix
+		}
+	}
+
+	return msg
+}
+
+// Tf translate the format with T, then format it with the given arguments.
+func Tf(format string, a ...interface{}) string {
+	return fmt.Sprintf(T(format), a...)
+}
@@ -0,0 +1,61 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	require.Equal(t, "fr_FR", normalizeLocale("fr_FR.UTF-8"))
+	require.Equal(t, "fr_FR", normalizeLocale("fr_FR@euro"))
+	require.Equal(t, "pt_BR", normalizeLocale("pt-BR"))
+	require.Equal(t, "", normalizeLocale("C"))
+	require.Equal(t, "", normalizeLocale("POSIX.UTF-8"))
+}
+
+func TestDetectLocale(t *testing.T) {
+	t.Setenv(EnvLocale, "")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "de_DE.UTF-8")
+	t.Setenv("LANG", "fr_FR.UTF-8")
+	require.Equal(t, "de_DE", DetectLocale())
+
+	t.Setenv(EnvLocale, "ar")
+	require.Equal(t, "ar", DetectLocale())
+}
+
+func TestTranslate(t *testing.T) {
+	t.Setenv(EnvTextDirection, "")
+
+	Register("xx_YY", map[string]string{"Hello %s": "Region hello %s"})
+	Register("xx", map[string]string{
+		"Hello %s": "Language hello %s",
+		"Quit":     "Leave",
+	})
+
+	SetLocale("xx_YY.UTF-8")
+	defer SetLocale("")
+
+	require.Equal(t, "Region hello bob\n", Tf("Hello %s\n", "bob"))
+	require.Equal(t, "Leave", T("Quit"))
+	require.Equal(t, "Unknown", T("Unknown"))
+	require.Equal(t, LeftToRight, TextDirection())
+
+	SetLocale("C")
+	require.Equal(t, "Quit", T("Quit"))
+}
+
+func TestTextDirection(t *testing.T) {
+	defer SetLocale("")
+
+	t.Setenv(EnvTextDirection, "")
+	SetLocale("he_IL")
+	require.Equal(t, RightToLeft, TextDirection())
+	SetLocale("fr_FR")
+	require.Equal(t, LeftToRight, TextDirection())
+
+	t.Setenv(EnvTextDirection, "rtl")
+	SetLocale("fr_FR")
+	require.Equal(t, RightToLeft, TextDirection())
+}
@@ -0,0 +1,185 @@
+package render
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// RefKind is the kind of entity a reference in a message designate
+type RefKind int
+
+const (
+	// RefBug is a bug id prefix after a "#", as in #1234abc
+	RefBug RefKind = iota + 1
+	// RefUser is a login after a "@", as in @octocat
+	RefUser
+	// RefCommit is a git commit hash, complete or abbreviated
+	RefCommit
+)
+
+// Ref is a reference to a bug, an identity or a commit in the text of a
+// message
+type Ref struct {
+	Kind RefKind
+	// Value is the bug id prefix, the login or the commit hash, without the
+	// leading "#" or "@"
+	Value string
+	// Start and End are the position of the reference in the text, including
+	// the leading "#" or "@"
+	Start, End int
+}
+
+var (
+	// the length of a bug id prefix is the same as for the CLI, up to a
+	// complete id
+	bugRefRegexp  = regexp.MustCompile(`(?:^|[^\w&#/])#([0-9a-f]{7,64})\b`)
+	userRefRegexp = regexp.MustCompile(`(?:^|[^\w@./])@([A-Za-z0-9][A-Za-z0-9_-]*)`)
+	// a commit hash is not part of a path, an URL or another reference
+	commitRefRegexp  = regexp.MustCompile(`(?:^|[^\w#@/.:-])([0-9a-f]{7,40})\b`)
+	inlineCodeRegexp = regexp.MustCompile("`+[^`]*`+")
+	headingRegexp    = regexp.MustCompile(`^ {0,3}#{1,6}(?:\s|$)`)
+)
+
+// FindRefs return the references in a text, ordered by position. The text is
+// expected to be prose: the code has to be removed before, see BugRefs.
+func FindRefs(text string) []Ref {
+	var refs []Ref
+
+	for _, m := range bugRefRegexp.FindAllStringSubmatchIndex(text, -1) {
+		refs = append(refs, Ref{Kind: RefBug, Value: text[m[2]:m[3]], Start: m[2] - 1, End: m[3]})
+	}
+
+	for _, m := range userRefRegexp.FindAllStringSubmatchIndex(text, -1) {
+		login := strings.TrimRight(text[m[2]:m[3]], "-_")
+		refs = append(refs, Ref{Kind: RefUser, Value: login, Start: m[2] - 1, End: m[2] + len(login)})
+	}
+
+	for _, m := range commitRefRegexp.FindAllStringSubmatchIndex(text, -1) {
+		hash := text[m[2]:m[3]]
+		// a word made only of digits or only of letters is very unlikely to
+		// be a hash
+		if !strings.ContainsAny(hash, "0123456789") || !strings.ContainsAny(hash, "abcdef") {
+			continue
+		}
+		refs = append(refs, Ref{Kind: RefCommit, Value: hash, Start: m[2], End: m[3]})
+	}
+
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Start < refs[j].Start })
+	return refs
+}
+
+// BugRefs return the bug id prefixes referenced in a markdown message, in
+// order, without duplicates. The references in the code are ignored.
+func BugRefs(message string) []string {
+	var result []string
+	seen := make(map[string]bool)
+
+	for _, p := range split(message) {
+		if p.kind == partCode {
+			continue
+		}
+		for _, ref := range FindRefs(prose(p)) {
+			if ref.Kind == RefBug && !seen[ref.Value] {
+				seen[ref.Value] = true
+				result = append(result, ref.Value)
+			}
+		}
+	}
+
+	return result
+}
+
+type partKind int
+
+const (
+	partProse partKind = iota
+	partCode
+	// a heading line, kept whole to be styled at once
+	partHeading
+)
+
+// part is a piece of a markdown message, either prose or code
+type part struct {
+	kind partKind
+	text string
+}
+
+// prose return the text of a part with its inline code blanked out, keeping
+// the positions
+func prose(p part) string {
+	if p.kind != partHeading {
+		return p.text
+	}
+	return inlineCodeRegexp.ReplaceAllStringFunc(p.text, func(code string) string {
+		return strings.Repeat(" ", len(code))
+	})
+}
+
+// split cut a markdown message in parts of prose and of code, the fenced
+// blocks and the inline code spans being code, so that the references are
+// only looked for in the prose. Joining the parts give back the message.
+func split(message string) []part {
+	var parts []part
+	add := func(kind partKind, text string) {
+		if text == "" {
+			return
+		}
+		if n := len(parts); n > 0 && parts[n-1].kind == kind && kind != partHeading {
+			parts[n-1].text += text
+			return
+		}
+		parts = append(parts, part{kind: kind, text: text})
+	}
+
+	var fence string
+	for _, line := range strings.SplitAfter(message, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if fence != "" {
+			add(partCode, line)
+			if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+				fence = ""
+			}
+			continue
+		}
+
+		if f := openingFence(trimmed); f != "" {
+			fence = f
+			add(partCode, line)
+			continue
+		}
+
+		if headingRegexp.MatchString(line) {
+			body := strings.TrimRight(line, "\n")
+			add(partHeading, body)
+			add(partProse, line[len(body):])
+			continue
+		}
+
+		last := 0
+		for _, m := range inlineCodeRegexp.FindAllStringIndex(line, -1) {
+			add(partProse, line[last:m[0]])
+			add(partCode, line[m[0]:m[1]])
+			last = m[1]
+		}
+		add(partProse, line[last:])
+	}
+
+	return parts
+}
+
+// openingFence return the fence opening a code block, if the line is one
+func openingFence(line string) string {
+	for _, c := range []string{"`", "~"} {
+		n := len(line) - len(strings.TrimLeft(line, c))
+		if n >= 3 {
+			// the info string of a backtick fence can't contain a backtick
+			if c == "`" && strings.Contains(line[n:], "`") {
+				return ""
+			}
+			return line[:n]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,163 @@
+// Package render turn the markdown messages of the bugs into text for the
+// terminal and HTML for the web, with the references to the other bugs, to
+// the identities and to the commits highlighted or linked.
+package render
+
+import (
+	"bytes"
+	"html"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+// Resolver find the entities designated by the references of a message. A
+// reference that can't be resolved is rendered as plain text.
+type Resolver interface {
+	// ResolveBugRef return the id of the bug matching an id prefix, if there
+	// is exactly one
+	ResolveBugRef(prefix string) (entity.Id, bool)
+	// ResolveUserRef return the id of the identity with the given login
+	ResolveUserRef(login string) (entity.Id, bool)
+}
+
+// Links build the URLs of the references in the HTML. A nil function, or an
+// empty URL, leave the reference unlinked.
+type Links struct {
+	Bug    func(id entity.Id) string
+	User   func(id entity.Id) string
+	Commit func(hash string) string
+}
+
+// ANSI render a markdown message for the terminal: the references to the
+// bugs, the identities and the commits are colored, and the headings are in
+// bold. The rest of the message, including the code, is left untouched.
+func ANSI(message string, resolver Resolver) string {
+	var out strings.Builder
+
+	for _, p := range split(message) {
+		switch p.kind {
+		case partCode:
+			out.WriteString(p.text)
+		case partHeading:
+			out.WriteString(colors.Bold(p.text))
+		default:
+			last := 0
+			for _, ref := range FindRefs(p.text) {
+				raw := p.text[ref.Start:ref.End]
+				out.WriteString(p.text[last:ref.Start])
+				out.WriteString(ansiRef(ref, raw, resolver))
+				last = ref.End
+			}
+			out.WriteString(p.text[last:])
+		}
+	}
+
+	return out.String()
+}
+
+func ansiRef(ref Ref, raw string, resolver Resolver) string {
+	switch ref.Kind {
+	case RefBug:
+		if _, ok := resolver.ResolveBugRef(ref.Value); ok {
+			return colors.Cyan(raw)
+		}
+	case RefUser:
+		if _, ok := resolver.ResolveUserRef(ref.Value); ok {
+			return colors.Magenta(raw)
+		}
+	case RefCommit:
+		return colors.Yellow(raw)
+	}
+	return raw
+}
+
+// HTML render a markdown message as HTML, with the references linked. The raw
+// HTML of the message is dropped and only the safe links are kept, so that
+// the result can be embedded in a page as is.
+func HTML(message string, resolver Resolver, links Links) template.HTML {
+	renderer := &htmlRenderer{
+		HTMLRenderer: blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{
+			Flags: blackfriday.SkipHTML | blackfriday.Safelink | blackfriday.NofollowLinks |
+				blackfriday.NoreferrerLinks | blackfriday.HrefTargetBlank,
+		}),
+		resolver: resolver,
+		links:    links,
+	}
+
+	output := blackfriday.Run([]byte(message),
+		blackfriday.WithRenderer(renderer),
+		blackfriday.WithExtensions(blackfriday.CommonExtensions),
+	)
+
+	return template.HTML(bytes.TrimSpace(output))
+}
+
+// htmlRenderer is the blackfriday HTML renderer, linking the references in
+// the text outside the code and the existing links
+type htmlRenderer struct {
+	*blackfriday.HTMLRenderer
+	resolver Resolver
+	links    Links
+}
+
+func (r *htmlRenderer) RenderNode(w io.Writer, node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+	if node.Type != blackfriday.Text || insideLink(node) {
+		return r.HTMLRenderer.RenderNode(w, node, entering)
+	}
+
+	text := string(node.Literal)
+	last := 0
+	for _, ref := range FindRefs(text) {
+		raw := text[ref.Start:ref.End]
+		_, _ = io.WriteString(w, html.EscapeString(text[last:ref.Start]))
+		_, _ = io.WriteString(w, r.refHTML(ref, raw))
+		last = ref.End
+	}
+	_, _ = io.WriteString(w, html.EscapeString(text[last:]))
+
+	return blackfriday.GoToNext
+}
+
+func (r *htmlRenderer) refHTML(ref Ref, raw string) string {
+	var url, class string
+
+	switch ref.Kind {
+	case RefBug:
+		class = "bug-ref"
+		if id, ok := r.resolver.ResolveBugRef(ref.Value); ok && r.links.Bug != nil {
+			url = r.links.Bug(id)
+		}
+	case RefUser:
+		class = "user-ref"
+		if id, ok := r.resolver.ResolveUserRef(ref.Value); ok && r.links.User != nil {
+			url = r.links.User(id)
+		}
+	case RefCommit:
+		class = "commit-ref"
+		if r.links.Commit != nil {
+			url = r.links.Commit(ref.Value)
+		}
+	}
+
+	if url == "" {
+		return html.EscapeString(raw)
+	}
+	return `<a class="` + class + `" href="` + html.EscapeString(url) + `">` + html.EscapeString(raw) + `</a>`
+}
+
+// insideLink tell if a node is part of a link, whose text must not be linked
+// again
+func insideLink(node *blackfriday.Node) bool {
+	for n := node.Parent; n != nil; n = n.Parent {
+		if n.Type == blackfriday.Link {
+			return true
+		}
+	}
+	return false
+}
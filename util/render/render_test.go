@@ -0,0 +1,86 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+type fakeResolver struct{}
+
+func (fakeResolver) ResolveBugRef(prefix string) (entity.Id, bool) {
+	if prefix == "1234abc" {
+		return "1234abc000000000000000000000000000000000000000000000000000000000", true
+	}
+	return "", false
+}
+
+func (fakeResolver) ResolveUserRef(login string) (entity.Id, bool) {
+	if login == "octocat" {
+		return "cafe000000000000000000000000000000000000000000000000000000000000", true
+	}
+	return "", false
+}
+
+func TestFindRefs(t *testing.T) {
+	require.Equal(t, []Ref{
+		{Kind: RefBug, Value: "1234abc", Start: 4, End: 12},
+		{Kind: RefUser, Value: "octocat", Start: 17, End: 25},
+		{Kind: RefCommit, Value: "9fceb02d0ae5", Start: 36, End: 48},
+	}, FindRefs("see #1234abc and @octocat, fixed in 9fceb02d0ae5"))
+
+	// not references
+	require.Empty(t, FindRefs("#123 deadbeef 1234567 https://example.com/commit/9fceb02d0ae5 a@b.com"))
+	require.Empty(t, FindRefs("page#1234abc &#1234abc1;"))
+}
+
+func TestBugRefs(t *testing.T) {
+	message := "Same as #1234abc, and #5678def.\n\n" +
+		"```\n#9999aaa in code\n```\n" +
+		"Not `#8888bbb` either, but #1234abc again.\n" +
+		"## About #7777ccc\n"
+
+	require.Equal(t, []string{"1234abc", "5678def", "7777ccc"}, BugRefs(message))
+	require.Empty(t, BugRefs("nothing here"))
+}
+
+func TestANSI(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = noColor }()
+
+	message := "# Title\nsee #1234abc, not #5678def, @octocat and 9fceb02\n`#1234abc`\n"
+	out := ANSI(message, fakeResolver{})
+
+	require.Contains(t, out, "\x1b[1m# Title\x1b[0m\n")
+	require.Contains(t, out, "\x1b[36m#1234abc\x1b[0m")
+	require.Contains(t, out, " #5678def,")
+	require.Contains(t, out, "\x1b[35m@octocat\x1b[0m")
+	require.Contains(t, out, "\x1b[33m9fceb02\x1b[0m")
+	require.Contains(t, out, "\n`#1234abc`\n")
+
+	color.NoColor = true
+	require.Equal(t, message, ANSI(message, fakeResolver{}))
+}
+
+func TestHTML(t *testing.T) {
+	links := Links{
+		Bug:    func(id entity.Id) string { return "/bug/" + id.String() },
+		Commit: func(hash string) string { return "https://example.com/commit/" + hash },
+	}
+
+	out := string(HTML("see #1234abc, #5678def and @octocat in 9fceb02\n\n"+
+		"`#1234abc` [#1234abc](https://example.com)\n\n"+
+		"<script>alert(1)</script> [x](javascript:alert(1))", fakeResolver{}, links))
+
+	require.Contains(t, out, `<a class="bug-ref" href="/bug/1234abc000000000000000000000000000000000000000000000000000000000">#1234abc</a>`)
+	require.Contains(t, out, `, #5678def and @octocat in `)
+	require.Contains(t, out, `<a class="commit-ref" href="https://example.com/commit/9fceb02">9fceb02</a>`)
+	require.Contains(t, out, `<code>#1234abc</code>`)
+	require.Contains(t, out, `>#1234abc</a></p>`)
+	require.NotContains(t, out, "<script>")
+	require.NotContains(t, out, "javascript:")
+}
@@ -0,0 +1,36 @@
+package webui
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+)
+
+// NewDevProxyHandler return a http.Handler that forward every request to a
+// running frontend development server (webpack, vite ...) instead of serving
+// the packed assets. This allow to iterate on the web UI with live reloading,
+// while still talking to the real GraphQL backend.
+func NewDevProxyHandler(target string) (http.Handler, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid dev server address %q: scheme should be http or https", target)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid dev server address %q: missing host", target)
+	}
+
+	proxy := httputil.NewSingleHostReverseProxy(u)
+
+	// the dev server usually check the Host header for its hot-reload websocket
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		req.Host = u.Host
+	}
+
+	return proxy, nil
+}
@@ -0,0 +1,72 @@
+package webui
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+)
+
+// VerifyAssets walk the given file system and make sure that every file can be
+// fully read, and that the root index.html is present. With the packed assets,
+// reading a file entirely also verify the checksum of the compressed data, which
+// allow to detect a corrupted or incomplete build early instead of serving a
+// broken web UI.
+func VerifyAssets(fs http.FileSystem) error {
+	index, err := fs.Open("/index.html")
+	if err != nil {
+		return fmt.Errorf("missing index.html: %v", err)
+	}
+	_ = index.Close()
+
+	return verifyDir(fs, "/")
+}
+
+func verifyDir(fs http.FileSystem, dir string) error {
+	d, err := fs.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	infos, err := d.Readdir(-1)
+	if err != nil {
+		return fmt.Errorf("%s: %v", dir, err)
+	}
+
+	for _, info := range infos {
+		p := path.Join(dir, info.Name())
+
+		if info.IsDir() {
+			if err := verifyDir(fs, p); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := verifyFile(fs, p, info); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func verifyFile(fs http.FileSystem, p string, info os.FileInfo) error {
+	f, err := fs.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	n, err := io.Copy(io.Discard, f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", p, err)
+	}
+	if n != info.Size() {
+		return fmt.Errorf("%s: expected %d bytes, read %d", p, info.Size(), n)
+	}
+
+	return nil
+}
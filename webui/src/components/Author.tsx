@@ -20,6 +20,7 @@ const Author = ({ author, ...props }: Props) => {
         underline="hover"
       >
         {author.displayName}
+        {author.isBot && ' (bot)'}
       </Link>
     </Tooltip>
   );
@@ -1,4 +1,5 @@
 import makeStyles from '@mui/styles/makeStyles';
+import { Link } from 'react-router-dom';
 
 import BugTitleForm from 'src/components/BugTitleForm/BugTitleForm';
 import IfLoggedIn from 'src/components/IfLoggedIn/IfLoggedIn';
@@ -65,6 +66,27 @@ const useStyles = makeStyles((theme) => ({
   noLabel: {
     ...theme.typography.body2,
   },
+  fieldsTitle: {
+    display: 'block',
+    marginTop: theme.spacing(2),
+  },
+  fieldList: {
+    ...theme.typography.body2,
+    margin: 0,
+  },
+  fieldName: {
+    color: theme.palette.text.secondary,
+  },
+  relationList: {
+    ...theme.typography.body2,
+    listStyle: 'none',
+    padding: 0,
+    margin: 0,
+  },
+  relationType: {
+    color: theme.palette.text.secondary,
+    marginRight: theme.spacing(1),
+  },
   commentForm: {
     marginTop: theme.spacing(2),
     marginLeft: 48,
@@ -108,6 +130,50 @@ function Bug({ bug }: Props) {
               </li>
             ))}
           </ul>
+          <span
+            className={`${classes.rightSidebarTitle} ${classes.fieldsTitle}`}
+          >
+            State
+          </span>
+          <div>{bug.state}</div>
+          {bug.fields.length > 0 && (
+            <>
+              <span
+                className={`${classes.rightSidebarTitle} ${classes.fieldsTitle}`}
+              >
+                Fields
+              </span>
+              <dl className={classes.fieldList}>
+                {bug.fields.map((f) => (
+                  <div key={f.name}>
+                    <dt className={classes.fieldName}>{f.name}</dt>
+                    <dd>{f.value}</dd>
+                  </div>
+                ))}
+              </dl>
+            </>
+          )}
+          {bug.relations.length > 0 && (
+            <>
+              <span
+                className={`${classes.rightSidebarTitle} ${classes.fieldsTitle}`}
+              >
+                Relations
+              </span>
+              <ul className={classes.relationList}>
+                {bug.relations.map((r) => (
+                  <li key={`${r.type}-${r.target}`}>
+                    <span className={classes.relationType}>
+                      {r.type.toLowerCase().replace('_', ' ')}
+                    </span>
+                    <Link to={`/bug/${r.target}`}>
+                      {r.target.substring(0, 7)}
+                    </Link>
+                  </li>
+                ))}
+              </ul>
+            </>
+          )}
         </div>
       </div>
     </main>
@@ -31,7 +31,11 @@ function SetStatus({ op }: Props) {
   return (
     <Typography className={classes.main}>
       <Author author={op.author} className={classes.author} />
-      <span> {status} this </span>
+      {op.state ? (
+        <span> moved this to {op.state} </span>
+      ) : (
+        <span> {status} this </span>
+      )}
       <Date date={op.date} />
     </Typography>
   );
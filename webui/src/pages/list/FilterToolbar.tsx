@@ -196,6 +196,8 @@ function FilterToolbar({ query, queryLocation }: Props) {
           ['creation-asc', 'Oldest'],
           ['edit', 'Recently updated'],
           ['edit-asc', 'Least recently updated'],
+          ['comments', 'Most commented'],
+          ['last-comment', 'Recently commented'],
         ]}
         itemActive={(key) => hasValue('sort', key)}
         to={(key) => pipe(toggleParam('sort', key), loc)(params)}
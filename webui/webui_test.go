@@ -0,0 +1,37 @@
+package webui
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyAssets(t *testing.T) {
+	require.NoError(t, VerifyAssets(WebUIAssets))
+
+	// no index.html
+	require.Error(t, VerifyAssets(http.Dir(t.TempDir())))
+}
+
+func TestDevProxyHandler(t *testing.T) {
+	devServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "dev:"+r.URL.Path)
+	}))
+	defer devServer.Close()
+
+	handler, err := NewDevProxyHandler(devServer.URL)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/static/main.js", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "dev:/static/main.js", rec.Body.String())
+
+	_, err = NewDevProxyHandler("localhost:3000")
+	require.Error(t, err)
+	_, err = NewDevProxyHandler("http://")
+	require.Error(t, err)
+}